@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"nebula-live/ent"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/pkg/eventbus"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// bootstrapAdminCommand 创建首个管理员的子命令名称
+const bootstrapAdminCommand = "bootstrap-admin"
+
+// runBootstrapAdmin 执行 bootstrap-admin 子命令：运行迁移、初始化RBAC数据并创建首个管理员
+//
+// 未通过参数指定的值取自配置 rbac.bootstrap_admin，用户名和邮箱默认为 admin 和 admin@localhost。
+func runBootstrapAdmin(args []string) error {
+	flags := flag.NewFlagSet(bootstrapAdminCommand, flag.ExitOnError)
+	username := flags.String("username", "", "admin username (default: rbac.bootstrap_admin.username or admin)")
+	email := flags.String("email", "", "admin email (default: rbac.bootstrap_admin.email or admin@localhost)")
+	password := flags.String("password", "", "admin password, a random one is generated when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var runErr error
+	fxApp := fx.New(
+		fx.NopLogger,
+		infrastructure.InfrastructureModule,
+		persistence.PersistenceModule,
+		service.ServiceModule,
+		fx.Invoke(func(cfg *config.Config, client *ent.Client, rbacService service.RBACService, userService service.UserService, eventBus *eventbus.Bus, webhookService service.WebhookService, zapLogger *zap.Logger) {
+			logger.Initialize(zapLogger)
+			defer persistence.CloseEntClient(client, zapLogger)
+			// 进程退出前投递创建管理员的Webhook事件
+			defer webhookService.Close(context.Background())
+			defer eventBus.Close(context.Background())
+
+			ctx := context.Background()
+			if runErr = persistence.RunMigrations(ctx, client, zapLogger); runErr != nil {
+				return
+			}
+			if runErr = rbacService.InitializeSystemData(ctx); runErr != nil {
+				return
+			}
+
+			adminConfig := cfg.RBAC.BootstrapAdmin
+			adminConfig.Username = firstNonEmpty(*username, adminConfig.Username, "admin")
+			adminConfig.Email = firstNonEmpty(*email, adminConfig.Email, "admin@localhost")
+			adminConfig.Password = firstNonEmpty(*password, adminConfig.Password)
+
+			created, err := bootstrapAdmin(ctx, userService, adminConfig)
+			if err != nil {
+				runErr = err
+				return
+			}
+			if !created {
+				fmt.Fprintln(os.Stdout, "An admin user already exists, nothing to do")
+			}
+		}),
+	)
+	if err := fxApp.Err(); err != nil {
+		return err
+	}
+
+	return runErr
+}
+
+// bootstrapAdmin 在没有管理员时创建首个管理员，并在标准输出打印一次登录凭证，返回是否创建了管理员
+func bootstrapAdmin(ctx context.Context, userService service.UserService, adminConfig config.BootstrapAdminConfig) (bool, error) {
+	user, password, err := userService.BootstrapAdmin(ctx, adminConfig.Username, adminConfig.Email, adminConfig.Password)
+	if err != nil {
+		return false, fmt.Errorf("failed to bootstrap admin user: %w", err)
+	}
+	if user == nil {
+		return false, nil
+	}
+
+	fmt.Fprintf(os.Stdout, "Created admin user\n  username: %s\n  email:    %s\n", user.Username, user.Email)
+	// 配置中指定的密码不回显
+	if adminConfig.Password == "" {
+		fmt.Fprintf(os.Stdout, "  password: %s\nThis password is shown only once, change it after logging in.\n", password)
+	}
+
+	return true, nil
+}
+
+// firstNonEmpty 返回第一个非空字符串
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
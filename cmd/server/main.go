@@ -2,22 +2,36 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	"nebula-live/ent"
 	"nebula-live/internal/app"
 	"nebula-live/internal/domain/service"
 	"nebula-live/internal/infrastructure"
+	"nebula-live/internal/infrastructure/config"
 	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/infrastructure/scheduler"
 	"nebula-live/internal/infrastructure/web/handler"
 	"nebula-live/internal/infrastructure/web/middleware"
 	"nebula-live/internal/infrastructure/web/router"
+	"nebula-live/internal/pkg/eventbus"
 	"nebula-live/pkg/logger"
 
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == bootstrapAdminCommand {
+		if err := runBootstrapAdmin(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	fxApp := fx.New(
 		// 禁用Fx详细日志
 		fx.NopLogger,
@@ -31,6 +45,9 @@ func main() {
 		// 服务层模块
 		service.ServiceModule,
 
+		// 后台调度模块
+		scheduler.SchedulerModule,
+
 		// 中间件模块
 		middleware.MiddlewareModule,
 
@@ -42,12 +59,26 @@ func main() {
 
 		// 应用层模块
 		app.AppModule,
-		fx.Invoke(func(lc fx.Lifecycle, server *app.Server, client *ent.Client, rbacService service.RBACService, zapLogger *zap.Logger) {
+		fx.Invoke(func(lc fx.Lifecycle, cfg *config.Config, server *app.Server, readiness *app.Readiness, client *ent.Client, rbacService service.RBACService, userService service.UserService, pushScheduler *scheduler.PushScheduler, roleCleanupJob *scheduler.RoleCleanupJob, jobWorker *scheduler.JobWorker, eventBus *eventbus.Bus, webhookService service.WebhookService, redisClient *redis.Client, zapLogger *zap.Logger) {
 			// 初始化全局logger
 			logger.Initialize(zapLogger)
 
 			lc.Append(fx.Hook{
 				OnStart: func(ctx context.Context) error {
+					startServer := func() {
+						logger.Info("Starting nebula-live server")
+						go func() {
+							if err := server.Start(); err != nil {
+								logger.Error("Server start error", zap.Error(err))
+							}
+						}()
+					}
+
+					// 先监听端口时，初始化完成前/readyz返回503
+					if cfg.Server.ListenBeforeReady {
+						startServer()
+					}
+
 					// 运行数据库迁移
 					if err := persistence.RunMigrations(ctx, client, zapLogger); err != nil {
 						zapLogger.Error("Failed to run migrations", zap.Error(err))
@@ -60,12 +91,33 @@ func main() {
 						return err
 					}
 
-					logger.Info("Starting nebula-live server")
-					go func() {
-						if err := server.Start(); err != nil {
-							logger.Error("Server start error", zap.Error(err))
+					// 配置了首个管理员时在没有管理员的情况下创建
+					if cfg.RBAC.BootstrapAdmin.Username != "" {
+						if _, err := bootstrapAdmin(ctx, userService, cfg.RBAC.BootstrapAdmin); err != nil {
+							zapLogger.Error("Failed to bootstrap admin user", zap.Error(err))
+							return err
 						}
-					}()
+					}
+
+					// 启动定时推送调度器
+					if err := pushScheduler.Start(ctx); err != nil {
+						zapLogger.Error("Failed to start push scheduler", zap.Error(err))
+						return err
+					}
+
+					// 启动过期角色清理任务
+					roleCleanupJob.Start()
+
+					// 启动异步任务工作器，继续执行上次运行遗留的任务
+					if err := jobWorker.Start(ctx); err != nil {
+						zapLogger.Error("Failed to start job worker", zap.Error(err))
+						return err
+					}
+
+					readiness.MarkReady()
+					if !cfg.Server.ListenBeforeReady {
+						startServer()
+					}
 					return nil
 				},
 				OnStop: func(ctx context.Context) error {
@@ -74,6 +126,30 @@ func main() {
 						logger.Error("Error stopping server", zap.Error(err))
 					}
 
+					// 停止定时推送调度器
+					pushScheduler.Stop()
+
+					// 停止过期角色清理任务
+					roleCleanupJob.Stop()
+
+					// 停止异步任务工作器，被中断的任务在下次启动时重新执行
+					jobWorker.Stop()
+
+					// 处理完已发布的领域事件，订阅者可能还会发起Webhook投递
+					if err := eventBus.Close(ctx); err != nil {
+						logger.Error("Error draining domain events", zap.Error(err))
+					}
+
+					// 等待进行中的Webhook投递完成
+					if err := webhookService.Close(ctx); err != nil {
+						logger.Error("Error waiting for webhook deliveries", zap.Error(err))
+					}
+
+					// 后台任务已停止并释放了分布式锁，关闭Redis连接
+					if err := persistence.CloseRedisClient(redisClient, zapLogger); err != nil {
+						logger.Error("Error closing redis connection", zap.Error(err))
+					}
+
 					// 关闭数据库连接
 					if err := persistence.CloseEntClient(client, zapLogger); err != nil {
 						logger.Error("Error closing database connection", zap.Error(err))
@@ -22,6 +22,241 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/jobs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the status and result of any asynchronous job, including jobs submitted by the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get Any Job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Job status",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid job ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/rbac/reinitialize": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Re-run the RBAC system data initialization: create missing system roles and permissions and grant the default permissions of the admin and user roles again. Existing roles, permissions and assignments are kept, so the call is idempotent (requires system:manage permission, can be disabled with rbac.allow_reinitialize)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Reinitialize RBAC System Data",
+                "responses": {
+                    "200": {
+                        "description": "System data reinitialized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden or reinitialization disabled",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/routes": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List all routes registered in the application with method, path and handler name (requires system:manage permission)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List Registered Routes",
+                "responses": {
+                    "200": {
+                        "description": "Registered routes",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ListRoutesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List webhook delivery attempts, newest first. Each retry is a separate entry sharing the delivery_id.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List Webhook Deliveries",
+                "parameters": [
+                    {
+                        "enum": [
+                            "user.created",
+                            "user.banned",
+                            "user.deleted"
+                        ],
+                        "type": "string",
+                        "description": "Filter by event type",
+                        "name": "event",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page, values above server.pagination.max_limit are clamped",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ListResponse-dto_WebhookDeliveryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown event type",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/login": {
             "post": {
                 "description": "Authenticate user with username and password",
@@ -54,7 +289,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid request parameters or captcha verification failed",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -76,6 +311,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
+                    },
+                    "503": {
+                        "description": "Captcha provider unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
                     }
                 }
             }
@@ -128,7 +369,7 @@ const docTemplate = `{
         },
         "/auth/refresh": {
             "post": {
-                "description": "Use refresh token to get a new access token",
+                "description": "Use refresh token to get a new access token. Each refresh token can be used only once; the response contains a new one. Reusing an old refresh token revokes its session.",
                 "consumes": [
                     "application/json"
                 ],
@@ -170,6 +411,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Account banned or inactive",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -211,7 +458,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid request parameters or captcha verification failed",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -227,13 +474,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
+                    },
+                    "503": {
+                        "description": "Captcha provider unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
                     }
                 }
             }
         },
-        "/live-streams/platforms": {
+        "/auth/sessions": {
             "get": {
-                "description": "Get a list of all supported live streaming platforms",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the current user's active login sessions",
                 "consumes": [
                     "application/json"
                 ],
@@ -241,14 +499,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Live Streaming"
+                    "Authentication"
                 ],
-                "summary": "Get Supported Streaming Platforms",
+                "summary": "List Active Sessions",
                 "responses": {
                     "200": {
-                        "description": "List of supported platforms",
+                        "description": "Active sessions",
                         "schema": {
-                            "$ref": "#/definitions/handler.SupportedPlatformsResponse"
+                            "$ref": "#/definitions/handler.ListSessionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Impersonation tokens cannot manage sessions",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "500": {
@@ -258,11 +528,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/live-streams/{platform}/rooms/{roomId}/info": {
-            "get": {
-                "description": "Get detailed information about a live stream room including title, owner, viewer count, etc.",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Revoke all of the current user's sessions except the one making the request",
                 "consumes": [
                     "application/json"
                 ],
@@ -270,46 +543,89 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Live Streaming"
+                    "Authentication"
                 ],
-                "summary": "Get Live Room Information",
-                "parameters": [
-                    {
-                        "enum": [
-                            "douyu",
-                            "bilibili"
-                        ],
-                        "type": "string",
-                        "example": "douyu",
-                        "description": "Streaming platform",
-                        "name": "platform",
-                        "in": "path",
-                        "required": true
+                "summary": "Revoke Other Sessions",
+                "responses": {
+                    "200": {
+                        "description": "Sessions revoked",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Impersonation tokens cannot manage sessions",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
                     },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sessions/{id}": {
+            "delete": {
+                "security": [
                     {
-                        "type": "string",
-                        "example": "534740",
-                        "description": "Room ID",
-                        "name": "roomId",
+                        "Bearer": []
+                    }
+                ],
+                "description": "Revoke one of the current user's sessions, its refresh token stops working immediately. Access tokens already issued stay valid until they expire.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Revoke Session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Room information retrieved successfully",
+                    "204": {
+                        "description": "Session revoked"
+                    },
+                    "400": {
+                        "description": "Invalid session ID",
                         "schema": {
-                            "$ref": "#/definitions/handler.RoomInfoResponse"
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request parameters",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Impersonation tokens cannot manage sessions",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "404": {
-                        "description": "Room not found",
+                        "description": "Session not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -323,9 +639,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/live-streams/{platform}/rooms/{roomId}/status": {
+        "/jobs/{id}": {
             "get": {
-                "description": "Get the current status of a live stream room on a specific platform",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the status and result of an asynchronous job submitted by the current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -333,46 +654,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Live Streaming"
+                    "Jobs"
                 ],
-                "summary": "Get Live Stream Status",
+                "summary": "Get Job",
                 "parameters": [
                     {
-                        "enum": [
-                            "douyu",
-                            "bilibili"
-                        ],
-                        "type": "string",
-                        "example": "douyu",
-                        "description": "Streaming platform",
-                        "name": "platform",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "example": "534740",
-                        "description": "Room ID",
-                        "name": "roomId",
+                        "type": "integer",
+                        "description": "Job ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Stream status retrieved successfully",
+                        "description": "Job status",
                         "schema": {
-                            "$ref": "#/definitions/handler.StreamStatusResponse"
+                            "$ref": "#/definitions/dto.JobResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid job ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "404": {
-                        "description": "Room not found",
+                        "description": "Job not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -386,14 +700,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/permissions": {
+        "/live-streams/my-subscriptions": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get list of permissions with pagination",
+                "description": "Get the current status and basic info of each live room the current user subscribes to, online rooms first, then offline rooms, then rooms whose status could not be fetched (with an error field). Room status is cached briefly per room (livestream.subscription_status_cache_ttl). At most the 200 most recent subscriptions are included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Live Subscriptions"
+                ],
+                "summary": "List My Subscribed Live Rooms with Status",
+                "responses": {
+                    "200": {
+                        "description": "Subscribed live rooms with their status",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LiveSubscriptionStatusesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/live-streams/platforms": {
+            "get": {
+                "description": "Get a list of all supported live streaming platforms",
                 "consumes": [
                     "application/json"
                 ],
@@ -401,9 +747,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Permission Management"
+                    "Live Streaming"
                 ],
-                "summary": "List Permissions",
+                "summary": "Get Supported Streaming Platforms",
+                "responses": {
+                    "200": {
+                        "description": "List of supported platforms",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SupportedPlatformsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/live-streams/subscriptions": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the current user's live room subscriptions with pagination, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Live Subscriptions"
+                ],
+                "summary": "List Live Subscriptions",
                 "parameters": [
                     {
                         "type": "integer",
@@ -415,16 +792,16 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 10,
-                        "description": "Items per page",
+                        "description": "Items per page, values above server.pagination.max_limit are clamped",
                         "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of permissions",
+                        "description": "List of the user's live subscriptions",
                         "schema": {
-                            "$ref": "#/definitions/handler.ListPermissionsResponse"
+                            "$ref": "#/definitions/dto.ListResponse-dto_LiveSubscriptionResponse"
                         }
                     },
                     "401": {
@@ -447,7 +824,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new permission in the system",
+                "description": "Subscribe the current user to a live room; platform aliases are stored as the canonical platform name, so a room can only be subscribed once",
                 "consumes": [
                     "application/json"
                 ],
@@ -455,29 +832,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Permission Management"
+                    "Live Subscriptions"
                 ],
-                "summary": "Create Permission",
+                "summary": "Subscribe to Live Room",
                 "parameters": [
                     {
-                        "description": "Permission creation data",
-                        "name": "permission",
+                        "description": "Live room to subscribe to",
+                        "name": "subscription",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreatePermissionRequest"
+                            "$ref": "#/definitions/dto.CreateLiveSubscriptionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Permission created successfully",
+                        "description": "Live room subscribed successfully",
                         "schema": {
-                            "$ref": "#/definitions/handler.PermissionResponse"
+                            "$ref": "#/definitions/dto.LiveSubscriptionResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid request parameters or unsupported platform",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -489,7 +866,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "Permission already exists",
+                        "description": "Live room already subscribed",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -501,52 +878,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/permissions/roles/{roleId}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all permissions assigned to a role",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Remove all of the current user's live room subscriptions at once",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Permission Management"
-                ],
-                "summary": "Get Role Permissions",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Role ID",
-                        "name": "roleId",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Live Subscriptions"
                 ],
+                "summary": "Unsubscribe from All Live Rooms",
                 "responses": {
                     "200": {
-                        "description": "List of role permissions",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/handler.PermissionResponse"
-                                }
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid role ID",
+                        "description": "Number of subscriptions that were removed",
                         "schema": {
-                            "$ref": "#/definitions/errors.APIError"
+                            "$ref": "#/definitions/dto.UnsubscribeAllResponse"
                         }
                     },
                     "401": {
@@ -555,12 +906,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Role not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -570,48 +915,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/permissions/users/{userId}": {
-            "get": {
+        "/live-streams/subscriptions/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all permissions for a user (through assigned roles)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Remove one of the current user's live room subscriptions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Permission Management"
+                    "Live Subscriptions"
                 ],
-                "summary": "Get User Permissions",
+                "summary": "Unsubscribe from Live Room",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Subscription ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of user permissions",
+                        "description": "Live room unsubscribed successfully",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/handler.PermissionResponse"
-                                }
+                                "type": "string"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid subscription ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -622,6 +961,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -631,14 +976,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/permissions/{id}": {
+        "/live-streams/{platform}/rooms/{roomId}/info": {
             "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Get permission information by ID",
+                "description": "Get detailed information about a live stream room including title, owner, viewer count, etc.",
                 "consumes": [
                     "application/json"
                 ],
@@ -646,39 +986,113 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Permission Management"
+                    "Live Streaming"
                 ],
-                "summary": "Get Permission",
+                "summary": "Get Live Room Information",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Permission ID",
-                        "name": "id",
+                        "type": "string",
+                        "example": "douyu",
+                        "description": "Streaming platform or an alias such as bili, b站, 斗鱼",
+                        "name": "platform",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "534740",
+                        "description": "Room ID",
+                        "name": "roomId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return stream URLs when the room is online and the platform supports it",
+                        "name": "include_streams",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform-specific stream quality code used with include_streams, e.g. bilibili qn 10000/400/250/150/80; empty selects the best",
+                        "name": "quality",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Permission retrieved successfully",
+                        "description": "Room information retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handler.PermissionResponse"
+                            "$ref": "#/definitions/handler.RoomInfoResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid permission ID",
+                        "description": "Invalid request parameters",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Room not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/live-streams/{platform}/rooms/{roomId}/resolve": {
+            "get": {
+                "description": "Resolve a short room ID (e.g. bilibili room 1) to the real room ID, short ID and owner UID; a real room ID resolves to itself",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Live Streaming"
+                ],
+                "summary": "Resolve Live Room ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "bilibili",
+                        "description": "Streaming platform or an alias, only platforms with short room IDs such as bilibili are supported",
+                        "name": "platform",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "1",
+                        "description": "Short or real room ID",
+                        "name": "roomId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Room ID resolved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RoomResolveResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters or platform without short room IDs",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "404": {
-                        "description": "Permission not found",
+                        "description": "Room not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -690,14 +1104,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Update permission information",
+            }
+        },
+        "/live-streams/{platform}/rooms/{roomId}/status": {
+            "get": {
+                "description": "Get the current status of a live stream room on a specific platform",
                 "consumes": [
                     "application/json"
                 ],
@@ -705,32 +1116,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Permission Management"
+                    "Live Streaming"
                 ],
-                "summary": "Update Permission",
+                "summary": "Get Live Stream Status",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Permission ID",
-                        "name": "id",
+                        "type": "string",
+                        "example": "douyu",
+                        "description": "Streaming platform or an alias such as bili, b站, 斗鱼",
+                        "name": "platform",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Permission update data",
-                        "name": "permission",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.UpdatePermissionRequest"
-                        }
+                        "type": "string",
+                        "example": "534740",
+                        "description": "Room ID",
+                        "name": "roomId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Permission updated successfully",
+                        "description": "Stream status retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handler.PermissionResponse"
+                            "$ref": "#/definitions/handler.StreamStatusResponse"
                         }
                     },
                     "400": {
@@ -739,14 +1150,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "404": {
-                        "description": "Permission not found",
+                        "description": "Room not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -758,14 +1163,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/permissions": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a permission from the system",
+                "description": "Get list of permissions with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -775,24 +1182,28 @@ const docTemplate = `{
                 "tags": [
                     "RBAC Permission Management"
                 ],
-                "summary": "Delete Permission",
+                "summary": "List Permissions",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Permission ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page, values above server.pagination.max_limit are clamped",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Permission deleted successfully"
-                    },
-                    "400": {
-                        "description": "Invalid permission ID",
+                    "200": {
+                        "description": "List of permissions",
                         "schema": {
-                            "$ref": "#/definitions/errors.APIError"
+                            "$ref": "#/definitions/handler.ListPermissionsResponse"
                         }
                     },
                     "401": {
@@ -801,18 +1212,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Cannot delete system permission",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "404": {
-                        "description": "Permission not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -820,16 +1219,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/permissions/{id}/assign": {
+            },
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Assign a permission to a role",
+                "description": "Create a new permission in the system",
                 "consumes": [
                     "application/json"
                 ],
@@ -839,33 +1236,23 @@ const docTemplate = `{
                 "tags": [
                     "RBAC Permission Management"
                 ],
-                "summary": "Assign Permission to Role",
+                "summary": "Create Permission",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Permission ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Role assignment data",
-                        "name": "assignment",
+                        "description": "Permission creation data",
+                        "name": "permission",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.AssignPermissionToRoleRequest"
+                            "$ref": "#/definitions/handler.CreatePermissionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Permission assigned successfully",
+                    "201": {
+                        "description": "Permission created successfully",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handler.PermissionResponse"
                         }
                     },
                     "400": {
@@ -880,14 +1267,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Permission or role not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "409": {
-                        "description": "Permission already assigned",
+                        "description": "Permission already exists",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -901,14 +1282,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/permissions/{id}/roles/{roleId}": {
-            "delete": {
+        "/permissions/batch": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Remove a permission from a role",
+                "description": "Create several permissions in one transaction. Permissions whose name already exists (or repeats an earlier item) are skipped and reported with status \"exists\". Any invalid item rejects the whole batch.",
                 "consumes": [
                     "application/json"
                 ],
@@ -918,31 +1299,29 @@ const docTemplate = `{
                 "tags": [
                     "RBAC Permission Management"
                 ],
-                "summary": "Remove Permission from Role",
+                "summary": "Create Permissions in Batch",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Permission ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Role ID",
-                        "name": "roleId",
-                        "in": "path",
-                        "required": true
+                        "description": "Permissions to create (1-100)",
+                        "name": "permissions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreatePermissionsBatchRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Permission removed successfully",
+                        "description": "All permissions already exist",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handler.CreatePermissionsBatchResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "At least one permission created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreatePermissionsBatchResponse"
                         }
                     },
                     "400": {
@@ -957,8 +1336,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "404": {
-                        "description": "Permission, role or role permission not found",
+                    "409": {
+                        "description": "A permission was created concurrently, retry the request",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -972,14 +1351,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/push-settings": {
+        "/permissions/by-action/users": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get current user's push notification settings with pagination",
+                "description": "List the users holding a permission through any of their active roles, including wildcard grants. Users granted it by several roles appear once",
                 "consumes": [
                     "application/json"
                 ],
@@ -987,39 +1366,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
+                    "RBAC Permission Management"
                 ],
-                "summary": "Get Push Settings",
+                "summary": "Get Users With Permission",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Permission resource, e.g. user",
+                        "name": "resource",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "bark"
-                        ],
                         "type": "string",
-                        "description": "Filter by provider",
-                        "name": "provider",
-                        "in": "query"
+                        "description": "Permission action, e.g. delete",
+                        "name": "action",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of user's push settings",
+                        "description": "Users holding the permission",
                         "schema": {
-                            "$ref": "#/definitions/dto.ListResponse-dto_UserPushSettingResponse"
+                            "$ref": "#/definitions/handler.PermissionUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing resource or action",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "401": {
@@ -1028,6 +1404,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1035,14 +1417,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/permissions/grouped": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new push notification setting for current user",
+                "description": "Get all permissions keyed by category for UI organization",
                 "consumes": [
                     "application/json"
                 ],
@@ -1050,31 +1434,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
-                ],
-                "summary": "Create Push Setting",
-                "parameters": [
-                    {
-                        "description": "Push setting creation data",
-                        "name": "setting",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateUserPushSettingRequest"
-                        }
-                    }
+                    "RBAC Permission Management"
                 ],
+                "summary": "List Permissions Grouped by Category",
                 "responses": {
-                    "201": {
-                        "description": "Push setting created successfully",
-                        "schema": {
-                            "$ref": "#/definitions/dto.UserPushSettingResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request parameters or validation failed",
+                    "200": {
+                        "description": "Permissions grouped by category",
                         "schema": {
-                            "$ref": "#/definitions/errors.APIError"
+                            "$ref": "#/definitions/handler.GroupedPermissionsResponse"
                         }
                     },
                     "401": {
@@ -1083,12 +1450,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "409": {
-                        "description": "Device already exists",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1098,9 +1459,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/push-settings/providers": {
+        "/permissions/roles/{roleId}": {
             "get": {
-                "description": "Get list of all supported push notification providers",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all permissions assigned to a role",
                 "consumes": [
                     "application/json"
                 ],
@@ -1108,23 +1474,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
+                    "RBAC Permission Management"
+                ],
+                "summary": "Get Role Permissions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "roleId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get Supported Push Providers",
                 "responses": {
                     "200": {
-                        "description": "List of supported providers with configuration options",
+                        "description": "List of role permissions",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/handler.PermissionResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid role ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     }
                 }
             }
         },
-        "/push-settings/validate-device": {
-            "post": {
-                "description": "Validate if a device ID is available for registration",
+        "/permissions/users/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all permissions for a user (through assigned roles)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1132,36 +1541,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
+                    "RBAC Permission Management"
                 ],
-                "summary": "Validate Device ID",
+                "summary": "Get User Permissions",
                 "parameters": [
                     {
-                        "description": "Device validation data",
-                        "name": "device",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ValidateDeviceRequest"
-                        }
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Device ID is available",
+                        "description": "List of user permissions",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": true
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/handler.PermissionResponse"
+                                }
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters or validation failed",
+                        "description": "Invalid user ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "409": {
-                        "description": "Device ID already exists",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1175,14 +1587,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/push-settings/{id}": {
+        "/permissions/{id}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get a specific push notification setting by ID",
+                "description": "Get permission information by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1190,13 +1602,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
+                    "RBAC Permission Management"
                 ],
-                "summary": "Get Push Setting",
+                "summary": "Get Permission",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Push setting ID",
+                        "description": "Permission ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1204,13 +1616,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Push setting retrieved successfully",
+                        "description": "Permission retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserPushSettingResponse"
+                            "$ref": "#/definitions/handler.PermissionResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid setting ID",
+                        "description": "Invalid permission ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1222,7 +1634,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Push setting not found",
+                        "description": "Permission not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1241,7 +1653,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Update a push notification setting",
+                "description": "Update permission information",
                 "consumes": [
                     "application/json"
                 ],
@@ -1249,36 +1661,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
+                    "RBAC Permission Management"
                 ],
-                "summary": "Update Push Setting",
+                "summary": "Update Permission",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Push setting ID",
+                        "description": "Permission ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Push setting update data",
-                        "name": "setting",
+                        "description": "Permission update data",
+                        "name": "permission",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserPushSettingRequest"
+                            "$ref": "#/definitions/handler.UpdatePermissionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Push setting updated successfully",
+                        "description": "Permission updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserPushSettingResponse"
+                            "$ref": "#/definitions/handler.PermissionResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters or validation failed",
+                        "description": "Invalid request parameters",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1290,7 +1702,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Push setting not found",
+                        "description": "Permission not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1309,7 +1721,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a push notification setting",
+                "description": "Delete a permission from the system",
                 "consumes": [
                     "application/json"
                 ],
@@ -1317,30 +1729,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
+                    "RBAC Permission Management"
                 ],
-                "summary": "Delete Push Setting",
+                "summary": "Delete Permission",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Push setting ID",
+                        "description": "Permission ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Push setting deleted successfully",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
+                    "204": {
+                        "description": "Permission deleted successfully"
                     },
                     "400": {
-                        "description": "Invalid setting ID",
+                        "description": "Invalid permission ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1351,8 +1757,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Cannot delete system permission",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "404": {
-                        "description": "Push setting not found",
+                        "description": "Permission not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1366,14 +1778,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/push-settings/{id}/disable": {
+        "/permissions/{id}/assign": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Disable a push notification setting",
+                "description": "Assign a permission to a role",
                 "consumes": [
                     "application/json"
                 ],
@@ -1381,21 +1793,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
+                    "RBAC Permission Management"
                 ],
-                "summary": "Disable Push Setting",
+                "summary": "Assign Permission to Role",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Push setting ID",
+                        "description": "Permission ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Role assignment data",
+                        "name": "assignment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AssignPermissionToRoleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Push setting disabled successfully",
+                        "description": "Permission assigned successfully",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1404,7 +1825,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid setting ID",
+                        "description": "Invalid request parameters",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1416,7 +1837,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Push setting not found",
+                        "description": "Permission or role not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Permission already assigned",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1430,14 +1857,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/push-settings/{id}/enable": {
-            "post": {
+        "/permissions/{id}/roles/{roleId}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Enable a push notification setting",
+                "description": "Remove a permission from a role",
                 "consumes": [
                     "application/json"
                 ],
@@ -1445,21 +1872,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Settings"
+                    "RBAC Permission Management"
                 ],
-                "summary": "Enable Push Setting",
+                "summary": "Remove Permission from Role",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Push setting ID",
+                        "description": "Permission ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "roleId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Push setting enabled successfully",
+                        "description": "Permission removed successfully",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1468,7 +1902,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid setting ID",
+                        "description": "Invalid request parameters",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1480,7 +1914,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Push setting not found",
+                        "description": "Permission, role or role permission not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1494,14 +1928,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/push/my-devices": {
-            "post": {
+        "/push-settings": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Send push notification to current user's all enabled devices",
+                "description": "Get current user's push notification settings with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -1509,31 +1943,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Notifications"
+                    "Push Settings"
                 ],
-                "summary": "Send Push to My Devices",
+                "summary": "Get Push Settings",
                 "parameters": [
                     {
-                        "description": "Push notification data",
-                        "name": "notification",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UserPushRequest"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page, values above server.pagination.max_limit are clamped",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "bark",
+                            "email"
+                        ],
+                        "type": "string",
+                        "description": "Filter by provider",
+                        "name": "provider",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the full device ID instead of the masked one, only honored for the owner",
+                        "name": "reveal_device_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Push notification sent successfully",
-                        "schema": {
-                            "$ref": "#/definitions/dto.UserPushResult"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request parameters or validation failed",
+                        "description": "List of user's push settings",
                         "schema": {
-                            "$ref": "#/definitions/errors.APIError"
+                            "$ref": "#/definitions/dto.ListResponse-dto_UserPushSettingResponse"
                         }
                     },
                     "401": {
@@ -1549,16 +1998,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/push/my-devices/{provider}": {
+            },
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Send push notification to current user's devices for specific provider",
+                "description": "Create a new push notification setting for current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1566,36 +2013,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Push Notifications"
+                    "Push Settings"
                 ],
-                "summary": "Send Push to My Devices by Provider",
+                "summary": "Create Push Setting",
                 "parameters": [
                     {
-                        "enum": [
-                            "bark"
-                        ],
-                        "type": "string",
-                        "example": "bark",
-                        "description": "Push provider name",
-                        "name": "provider",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Push notification data",
-                        "name": "notification",
+                        "description": "Push setting creation data",
+                        "name": "setting",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UserPushRequest"
+                            "$ref": "#/definitions/dto.CreateUserPushSettingRequest"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the full device ID instead of the masked one, only honored for the owner",
+                        "name": "reveal_device_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Push notification sent successfully",
+                    "201": {
+                        "description": "Push setting created successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserPushResult"
+                            "$ref": "#/definitions/dto.UserPushSettingResponse"
                         }
                     },
                     "400": {
@@ -1610,6 +2052,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "409": {
+                        "description": "Device already exists or device limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1619,29 +2067,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/push/test": {
+        "/push-settings/disable-all": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Send a test push notification to current user's all enabled devices",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Disable all of current user's push notification settings at once, e.g. to pause notifications",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Push Notifications"
+                    "Push Settings"
                 ],
-                "summary": "Test Push Settings",
+                "summary": "Disable All Push Settings",
                 "responses": {
                     "200": {
-                        "description": "Test notification sent successfully",
+                        "description": "Number of settings that were disabled",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserPushResult"
+                            "$ref": "#/definitions/dto.SetAllEnabledResponse"
                         }
                     },
                     "401": {
@@ -1659,45 +2104,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles": {
-            "get": {
+        "/push-settings/enable-all": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get list of roles with pagination",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Enable all of current user's push notification settings at once",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Role Management"
-                ],
-                "summary": "List Roles",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "Push Settings"
                 ],
+                "summary": "Enable All Push Settings",
                 "responses": {
                     "200": {
-                        "description": "List of roles",
+                        "description": "Number of settings that were enabled",
                         "schema": {
-                            "$ref": "#/definitions/handler.ListRolesResponse"
+                            "$ref": "#/definitions/dto.SetAllEnabledResponse"
                         }
                     },
                     "401": {
@@ -1706,6 +2132,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "409": {
+                        "description": "Enabling all devices would exceed the device limit",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1713,14 +2145,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Create a new role in the system",
+            }
+        },
+        "/push-settings/providers": {
+            "get": {
+                "description": "Get list of all supported push notification providers",
                 "consumes": [
                     "application/json"
                 ],
@@ -1728,62 +2157,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Role Management"
+                    "Push Settings"
                 ],
-                "summary": "Create Role",
-                "parameters": [
-                    {
-                        "description": "Role creation data",
-                        "name": "role",
-                        "in": "body",
-                        "required": true,
+                "summary": "Get Supported Push Providers",
+                "responses": {
+                    "200": {
+                        "description": "List of supported providers with configuration options",
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateRoleRequest"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     }
+                }
+            }
+        },
+        "/push-settings/providers/health": {
+            "get": {
+                "description": "Check whether the upstream of each enabled push provider is reachable (results are cached briefly)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "Push Settings"
+                ],
+                "summary": "Get Push Providers Health",
                 "responses": {
-                    "201": {
-                        "description": "Role created successfully",
-                        "schema": {
-                            "$ref": "#/definitions/handler.RoleResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request parameters",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "409": {
-                        "description": "Role already exists",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                    "200": {
+                        "description": "Reachability status of each provider",
                         "schema": {
-                            "$ref": "#/definitions/errors.APIError"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     }
                 }
             }
         },
-        "/roles/users/{userId}": {
+        "/push-settings/providers/{provider}/schema": {
             "get": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Get all roles assigned to a user",
+                "description": "Get the settings fields, types and validation rules of a push provider for building configuration forms",
                 "consumes": [
                     "application/json"
                 ],
@@ -1791,45 +2205,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Role Management"
+                    "Push Settings"
                 ],
-                "summary": "Get User Roles",
+                "summary": "Get Push Provider Settings Schema",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
+                        "type": "string",
+                        "description": "Provider name",
+                        "name": "provider",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of user roles",
+                        "description": "Settings schema of the provider",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/handler.RoleResponse"
-                                }
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid user ID",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
+                            "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Provider not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
-                    },
-                    "404": {
-                        "description": "User not found",
+                    }
+                }
+            }
+        },
+        "/push-settings/validate-device": {
+            "post": {
+                "description": "Validate if a device ID is available for registration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Push Settings"
+                ],
+                "summary": "Validate Device ID",
+                "parameters": [
+                    {
+                        "description": "Device validation data",
+                        "name": "device",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidateDeviceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Device ID is available",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Device ID already exists",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1843,14 +2287,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles/{id}": {
+        "/push-settings/{id}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get role information by ID",
+                "description": "Get a specific push notification setting by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1858,27 +2302,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Role Management"
+                    "Push Settings"
                 ],
-                "summary": "Get Role",
+                "summary": "Get Push Setting",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Role ID",
+                        "description": "Push setting ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the full device ID instead of the masked one, only honored for the owner",
+                        "name": "reveal_device_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Role retrieved successfully",
+                        "description": "Push setting retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/handler.RoleResponse"
+                            "$ref": "#/definitions/dto.UserPushSettingResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid role ID",
+                        "description": "Invalid setting ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1890,7 +2340,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Role not found",
+                        "description": "Push setting not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1909,7 +2359,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Update role information",
+                "description": "Update a push notification setting",
                 "consumes": [
                     "application/json"
                 ],
@@ -1917,36 +2367,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Role Management"
+                    "Push Settings"
                 ],
-                "summary": "Update Role",
+                "summary": "Update Push Setting",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Role ID",
+                        "description": "Push setting ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Role update data",
-                        "name": "role",
+                        "description": "Push setting update data",
+                        "name": "setting",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.UpdateRoleRequest"
+                            "$ref": "#/definitions/dto.UpdateUserPushSettingRequest"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return the full device ID instead of the masked one, only honored for the owner",
+                        "name": "reveal_device_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Role updated successfully",
+                        "description": "Push setting updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/handler.RoleResponse"
+                            "$ref": "#/definitions/dto.UserPushSettingResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid request parameters or validation failed",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1958,7 +2414,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Role not found",
+                        "description": "Push setting not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Device limit reached when enabling",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -1977,7 +2439,7 @@ const docTemplate = `{
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a role from the system",
+                "description": "Delete a push notification setting",
                 "consumes": [
                     "application/json"
                 ],
@@ -1985,24 +2447,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Role Management"
+                    "Push Settings"
                 ],
-                "summary": "Delete Role",
+                "summary": "Delete Push Setting",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Role ID",
+                        "description": "Push setting ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Role deleted successfully"
+                    "200": {
+                        "description": "Push setting deleted successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Invalid role ID",
+                        "description": "Invalid setting ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2013,14 +2481,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "403": {
-                        "description": "Cannot delete system role",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "404": {
-                        "description": "Role not found",
+                        "description": "Push setting not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2034,14 +2496,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles/{id}/assign": {
+        "/push-settings/{id}/disable": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Assign a role to a user",
+                "description": "Disable a push notification setting",
                 "consumes": [
                     "application/json"
                 ],
@@ -2049,30 +2511,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Role Management"
+                    "Push Settings"
                 ],
-                "summary": "Assign Role to User",
+                "summary": "Disable Push Setting",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Role ID",
+                        "description": "Push setting ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "User assignment data",
-                        "name": "assignment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.AssignRoleRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Role assigned successfully",
+                        "description": "Push setting disabled successfully",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2081,7 +2534,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid setting ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2093,13 +2546,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Role or user not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
-                    "409": {
-                        "description": "Role already assigned",
+                        "description": "Push setting not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2113,14 +2560,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles/{id}/users/{userId}": {
-            "delete": {
+        "/push-settings/{id}/enable": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Remove a role from a user",
+                "description": "Enable a push notification setting",
                 "consumes": [
                     "application/json"
                 ],
@@ -2128,28 +2575,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC Role Management"
+                    "Push Settings"
                 ],
-                "summary": "Remove Role from User",
+                "summary": "Enable Push Setting",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Role ID",
+                        "description": "Push setting ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Role removed successfully",
+                        "description": "Push setting enabled successfully",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2158,7 +2598,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid setting ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2170,7 +2610,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Role, user or user role not found",
+                        "description": "Push setting not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Device limit reached",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2184,14 +2630,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
-            "get": {
+        "/push-settings/{id}/transfer": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get list of users with pagination",
+                "description": "Move a push device from one user to another, e.g. when its owner switches accounts. The provider and device ID stay the same; an enabled device counts against the target user's device limit (requires user:manage permission)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2199,30 +2645,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Push Settings"
                 ],
-                "summary": "List Users",
+                "summary": "Transfer Push Setting",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Push setting ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Current and target owner",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferUserPushSettingRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of users",
+                        "description": "Push setting transferred",
                         "schema": {
-                            "$ref": "#/definitions/handler.ListUsersResponse"
+                            "$ref": "#/definitions/dto.UserPushSettingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid setting ID or request",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
                         }
                     },
                     "401": {
@@ -2231,6 +2685,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Push setting or target user not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Device limit of the target user reached",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2238,14 +2710,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/push/my-devices": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new user in the system",
+                "description": "Send push notification to current user's all enabled devices",
                 "consumes": [
                     "application/json"
                 ],
@@ -2253,29 +2727,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Push Notifications"
                 ],
-                "summary": "Create User",
+                "summary": "Send Push to My Devices",
                 "parameters": [
                     {
-                        "description": "User creation data",
-                        "name": "user",
+                        "description": "Push notification data",
+                        "name": "notification",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateUserRequest"
+                            "$ref": "#/definitions/dto.UserPushRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "User created successfully",
+                    "200": {
+                        "description": "Push notification sent successfully",
                         "schema": {
-                            "$ref": "#/definitions/handler.UserResponse"
+                            "$ref": "#/definitions/dto.UserPushResult"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid request parameters or validation failed",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2287,7 +2761,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "User already exists",
+                        "description": "Same notification sent recently",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2301,14 +2775,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
-            "get": {
+        "/push/my-devices/async": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get user information by ID",
+                "description": "Submit a push notification to current user's all enabled devices as a background job and return immediately. Poll GET /jobs/{id} for the result, which holds total_devices, success_count and failed_count, or duplicate when the same notification was sent recently",
                 "consumes": [
                     "application/json"
                 ],
@@ -2316,27 +2790,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Push Notifications"
                 ],
-                "summary": "Get User",
+                "summary": "Send Push to My Devices Asynchronously",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Push notification data",
+                        "name": "notification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPushRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User retrieved successfully",
+                    "202": {
+                        "description": "Push job submitted",
                         "schema": {
-                            "$ref": "#/definitions/handler.UserResponse"
+                            "$ref": "#/definitions/dto.JobResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid request parameters or validation failed",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2347,12 +2823,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2360,14 +2830,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/push/my-devices/{provider}": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update user information",
+                "description": "Send push notification to current user's devices for specific provider",
                 "consumes": [
                     "application/json"
                 ],
@@ -2375,39 +2847,93 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Push Notifications"
                 ],
-                "summary": "Update User",
+                "summary": "Send Push to My Devices by Provider",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
+                        "enum": [
+                            "bark",
+                            "email"
+                        ],
+                        "type": "string",
+                        "example": "bark",
+                        "description": "Push provider name",
+                        "name": "provider",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "User update data",
-                        "name": "user",
+                        "description": "Push notification data",
+                        "name": "notification",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.UpdateUserRequest"
+                            "$ref": "#/definitions/dto.UserPushRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User updated successfully",
+                        "description": "Push notification sent successfully",
                         "schema": {
-                            "$ref": "#/definitions/handler.UserResponse"
+                            "$ref": "#/definitions/dto.UserPushResult"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameters",
+                        "description": "Invalid request parameters, validation failed or unsupported provider",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Same notification sent recently",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "503": {
+                        "description": "Push provider is disabled",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
+                    }
+                }
+            }
+        },
+        "/push/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get current user's default push group, sound and level. Precedence when sending: explicit message field \u003e device setting \u003e user default",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Push Notifications"
+                ],
+                "summary": "Get My Push Preferences",
+                "responses": {
+                    "200": {
+                        "description": "Push preferences",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPushPreferencesResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -2429,13 +2955,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a user from the system",
+                "description": "Replace current user's default push group, sound and level; empty values clear the default",
                 "consumes": [
                     "application/json"
                 ],
@@ -2443,24 +2969,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Push Notifications"
                 ],
-                "summary": "Delete User",
+                "summary": "Update My Push Preferences",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Push preferences",
+                        "name": "preferences",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPushPreferencesRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "User deleted successfully"
+                    "200": {
+                        "description": "Push preferences updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPushPreferencesResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid request parameters or validation failed",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2486,14 +3017,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}/activate": {
+        "/push/preview": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Activate a user account",
+                "description": "Resolve the notification for each of current user's enabled devices as it would be sent, applying device settings, user defaults, provider length limits and field support, without sending it",
                 "consumes": [
                     "application/json"
                 ],
@@ -2501,30 +3032,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Push Notifications"
                 ],
-                "summary": "Activate User",
+                "summary": "Preview Push on My Devices",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Push notification data",
+                        "name": "notification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPushRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User activated successfully",
+                        "description": "Resolved message per device",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.PushPreviewResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid request parameters or validation failed",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2535,12 +3065,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
-                        "schema": {
-                            "$ref": "#/definitions/errors.APIError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2550,14 +3074,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}/ban": {
-            "post": {
+        "/push/recurring": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Ban a user account",
+                "description": "Get current user's recurring push notifications with pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -2565,42 +3089,89 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Recurring Push"
                 ],
-                "summary": "Ban User",
+                "summary": "Get Recurring Pushes",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page, values above server.pagination.max_limit are clamped",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User banned successfully",
+                        "description": "List of user's recurring pushes",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.ListResponse-dto_RecurringPushResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid user ID",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a push notification sent to current user's devices on a cron schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurring Push"
+                ],
+                "summary": "Create Recurring Push",
+                "parameters": [
+                    {
+                        "description": "Recurring push data",
+                        "name": "recurringPush",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringPushRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Recurring push created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringPushResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters, validation failed or invalid cron expression",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2614,14 +3185,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}/deactivate": {
-            "post": {
+        "/push/recurring/{id}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deactivate a user account",
+                "description": "Get a specific recurring push notification by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2629,13 +3200,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Management"
+                    "Recurring Push"
                 ],
-                "summary": "Deactivate User",
+                "summary": "Get Recurring Push",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
+                        "description": "Recurring push ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2643,16 +3214,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "User deactivated successfully",
+                        "description": "Recurring push retrieved successfully",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.RecurringPushResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid recurring push ID",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2664,7 +3232,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Recurring push not found",
                         "schema": {
                             "$ref": "#/definitions/errors.APIError"
                         }
@@ -2676,74 +3244,2689 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "dto.CreateUserPushSettingRequest": {
-            "type": "object",
-            "required": [
-                "device_id",
-                "device_name",
-                "provider"
-            ],
-            "properties": {
-                "device_id": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
-                },
-                "device_name": {
-                    "type": "string",
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update a recurring push notification, recomputing its next run time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurring Push"
+                ],
+                "summary": "Update Recurring Push",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recurring push ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Recurring push data",
+                        "name": "recurringPush",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringPushRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recurring push updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecurringPushResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters, validation failed or invalid cron expression",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Recurring push not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a recurring push notification",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Recurring Push"
+                ],
+                "summary": "Delete Recurring Push",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recurring push ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recurring push deleted successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid recurring push ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Recurring push not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/push/scheduled": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get current user's scheduled push notifications with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scheduled Push"
+                ],
+                "summary": "Get Scheduled Pushes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page, values above server.pagination.max_limit are clamped",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of user's scheduled pushes",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ListResponse-dto_ScheduledPushResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Schedule a push notification to be sent to current user's devices at a later time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scheduled Push"
+                ],
+                "summary": "Create Scheduled Push",
+                "parameters": [
+                    {
+                        "description": "Scheduled push data",
+                        "name": "scheduledPush",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduledPushRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Scheduled push created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduledPushResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/push/scheduled/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a specific scheduled push notification by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scheduled Push"
+                ],
+                "summary": "Get Scheduled Push",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scheduled push ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled push retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduledPushResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid scheduled push ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheduled push not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update a scheduled push notification that has not been sent yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scheduled Push"
+                ],
+                "summary": "Update Scheduled Push",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scheduled push ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Scheduled push data",
+                        "name": "scheduledPush",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduledPushRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled push updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduledPushResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheduled push not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Scheduled push is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a scheduled push notification",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scheduled Push"
+                ],
+                "summary": "Delete Scheduled Push",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scheduled push ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled push deleted successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid scheduled push ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheduled push not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Scheduled push is being sent",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/push/scheduled/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cancel a scheduled push notification before it is sent",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scheduled Push"
+                ],
+                "summary": "Cancel Scheduled Push",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scheduled push ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Scheduled push cancelled successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid scheduled push ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Scheduled push not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Scheduled push is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/push/test": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Send a test push notification to current user's all enabled devices",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Push Notifications"
+                ],
+                "summary": "Test Push Settings",
+                "responses": {
+                    "200": {
+                        "description": "Test notification sent successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserPushResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Same notification sent recently",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get list of roles with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "List Roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page, values above server.pagination.max_limit are clamped",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of roles",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ListRolesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new role in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "Create Role",
+                "parameters": [
+                    {
+                        "description": "Role creation data",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Role created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Role already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/users/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all roles assigned to a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "Get User Roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of user roles",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/handler.RoleResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get role information by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "Get Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid role ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update role information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "Update Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role update data",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a role from the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "Delete Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Role deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid role ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Cannot delete system role",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update only the provided role fields; an explicit empty description clears it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "Partially Update Role",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.PatchRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RoleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{id}/assign": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Assign a role to a user, optionally until expires_at after which the assignment no longer grants the role",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "Assign Role to User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User assignment data",
+                        "name": "assignment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AssignRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role assigned successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Role or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Role already assigned",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{id}/users/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove a role from a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC Role Management"
+                ],
+                "summary": "Remove Role from User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role removed successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Role, user or user role not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get list of users with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "List Users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page, values above server.pagination.max_limit are clamped",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of users",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ListUsersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new user in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Create User",
+                "parameters": [
+                    {
+                        "description": "User creation data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get user information by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Get User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update user information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Update User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User update data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a user from the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Delete User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "User deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update only the provided user fields; an explicit empty string clears the field",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Partially Update User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.PatchUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/activate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Activate a user account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Activate User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional reason for the status change",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UserStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User activated successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Status transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/ban": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Ban a user account; a reason is required. With expires_at the ban is temporary and is lifted on the user's next login after it expires; banning an already banned user replaces the reason and expiry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Ban User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason and optional expiry of the ban",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BanUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User banned successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID, missing reason or expiry in the past",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Status transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/deactivate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deactivate a user account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Deactivate User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional reason for the status change",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UserStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User deactivated successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "Status transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/devices": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List all push devices registered by a user for support staff debugging notifications. Device IDs are masked and provider settings are omitted (requires user:read or user:manage permission)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Push Settings"
+                ],
+                "summary": "Get User Devices",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Push devices of the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserDevicesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/impersonate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Issue a short-lived access token for the target user carrying an impersonated_by claim. The token cannot be refreshed, and requests made with it return the X-Impersonated-By header. Impersonating another administrator requires the super_admin role; impersonated sessions cannot impersonate again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Impersonate User",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Impersonation token issued",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ImpersonateUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or impersonating yourself",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "403": {
+                        "description": "Target is an administrator or the session is already impersonated",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/roles": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove several roles from a user in one transaction; an empty list removes all roles",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Management"
+                ],
+                "summary": "Remove User Roles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role names to remove (empty to remove all)",
+                        "name": "roles",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RemoveUserRolesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Roles removed successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "User or role not found",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "409": {
+                        "description": "User must keep at least one role",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/errors.APIError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.CreateLiveSubscriptionRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "room_id"
+            ],
+            "properties": {
+                "platform": {
+                    "description": "平台名称或别名",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "bilibili"
+                },
+                "room_id": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "5440"
+                }
+            }
+        },
+        "dto.CreateUserPushSettingRequest": {
+            "type": "object",
+            "required": [
+                "device_id",
+                "device_name",
+                "provider"
+            ],
+            "properties": {
+                "device_id": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "device_name": {
+                    "type": "string",
                     "maxLength": 100,
                     "minLength": 1
                 },
+                "enabled": {
+                    "description": "不传时使用服务端配置的默认值",
+                    "type": "boolean"
+                },
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "bark",
+                        "email"
+                    ]
+                },
+                "settings": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "dto.JobResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "result": {
+                    "type": "object"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "completed",
+                        "failed"
+                    ]
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ListResponse-dto_LiveSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LiveSubscriptionResponse"
+                    }
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ListResponse-dto_RecurringPushResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RecurringPushResponse"
+                    }
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ListResponse-dto_ScheduledPushResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ScheduledPushResponse"
+                    }
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ListResponse-dto_UserPushSettingResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserPushSettingResponse"
+                    }
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ListResponse-dto_WebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WebhookDeliveryResponse"
+                    }
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.LiveSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "platform": {
+                    "description": "规范的平台名称",
+                    "type": "string",
+                    "example": "bilibili"
+                },
+                "room_id": {
+                    "type": "string",
+                    "example": "5440"
+                }
+            }
+        },
+        "dto.LiveSubscriptionStatusResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "第五人格"
+                },
+                "checked_at": {
+                    "description": "获取直播状态的时间，状态会被缓存一小段时间",
+                    "type": "string"
+                },
+                "cover": {
+                    "type": "string",
+                    "example": "https://example.com/cover.jpg"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "获取直播状态失败的原因",
+                    "type": "string",
+                    "example": "Failed to fetch live room status"
+                },
+                "id": {
+                    "description": "订阅ID",
+                    "type": "integer"
+                },
+                "live_duration": {
+                    "description": "秒",
+                    "type": "integer",
+                    "example": 3600
+                },
+                "live_start_time": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
+                },
+                "owner_avatar": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "owner_name": {
+                    "type": "string",
+                    "example": "丨马老六丨"
+                },
+                "platform": {
+                    "type": "string",
+                    "example": "bilibili"
+                },
+                "room_id": {
+                    "type": "string",
+                    "example": "5440"
+                },
+                "status": {
+                    "description": "获取失败时为unknown",
+                    "type": "string",
+                    "enum": [
+                        "online",
+                        "offline",
+                        "unknown"
+                    ],
+                    "example": "online"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "【六神】游戏室"
+                },
+                "viewer_count": {
+                    "type": "integer",
+                    "example": 1234
+                }
+            }
+        },
+        "dto.LiveSubscriptionStatusesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LiveSubscriptionStatusResponse"
+                    }
+                },
+                "online": {
+                    "description": "正在直播的数量",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "返回的订阅数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PushDevicePreview": {
+            "type": "object",
+            "properties": {
+                "auto_copy": {
+                    "type": "boolean"
+                },
+                "badge": {
+                    "type": "integer"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "call": {
+                    "type": "boolean"
+                },
+                "copy": {
+                    "type": "string"
+                },
+                "device_name": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "description": "推送服务器地址",
+                    "type": "string"
+                },
+                "error": {
+                    "description": "该设备不会收到消息的原因，如超出长度限制",
+                    "type": "string"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "level": {
+                    "$ref": "#/definitions/push.PushLevel"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "setting_id": {
+                    "type": "integer"
+                },
+                "sound": {
+                    "type": "string"
+                },
+                "subtitle": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PushPreviewResponse": {
+            "type": "object",
+            "properties": {
+                "devices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PushDevicePreview"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PushResponse": {
+            "type": "object",
+            "properties": {
+                "cancelled": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "message_id": {
+                    "type": "string"
+                },
                 "provider": {
+                    "type": "string"
+                },
+                "raw_response": {
+                    "description": "RawResponse 推送服务返回的原始响应，需启用 push.capture_raw_response，仅对拥有 system:manage 权限的用户返回",
+                    "type": "string"
+                },
+                "rejected": {
+                    "description": "超出提供商长度限制等原因未发送",
+                    "type": "boolean"
+                },
+                "status_code": {
+                    "description": "StatusCode 推送服务返回的状态码（Bark为HTTP状态码，邮件为SMTP回复码），仅对拥有 system:manage 权限的用户返回",
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "test_mode": {
+                    "description": "测试模式下未真正发送",
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.RecurringPushRequest": {
+            "type": "object",
+            "required": [
+                "body",
+                "cron_expr",
+                "title"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1
+                },
+                "cron_expr": {
                     "type": "string",
+                    "maxLength": 100,
+                    "example": "0 9 * * *"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "level": {
                     "enum": [
-                        "bark"
+                        "passive",
+                        "active",
+                        "timeSensitive",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/push.PushLevel"
+                        }
                     ]
                 },
-                "settings": {
-                    "type": "object",
-                    "additionalProperties": true
+                "provider": {
+                    "type": "string"
+                },
+                "sound": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RecurringPushResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "cron_expr": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_run_at": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "sound": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.ListResponse-dto_UserPushSettingResponse": {
+        "dto.ScheduledPushRequest": {
             "type": "object",
+            "required": [
+                "body",
+                "send_at",
+                "title"
+            ],
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.UserPushSettingResponse"
-                    }
+                "body": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1
                 },
-                "limit": {
-                    "type": "integer"
+                "group": {
+                    "type": "string"
                 },
-                "page": {
-                    "type": "integer"
+                "icon": {
+                    "type": "string"
                 },
-                "total": {
-                    "type": "integer"
+                "level": {
+                    "enum": [
+                        "passive",
+                        "active",
+                        "timeSensitive",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/push.PushLevel"
+                        }
+                    ]
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "send_at": {
+                    "type": "string"
+                },
+                "sound": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "dto.PushResponse": {
+        "dto.ScheduledPushResponse": {
             "type": "object",
             "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
                 "error": {
                     "type": "string"
                 },
-                "message_id": {
+                "group": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
                     "type": "string"
                 },
                 "provider": {
                     "type": "string"
                 },
-                "success": {
+                "send_at": {
+                    "type": "string"
+                },
+                "sent_at": {
+                    "type": "string"
+                },
+                "sound": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SetAllEnabledResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
                     "type": "boolean"
+                },
+                "updated": {
+                    "description": "状态实际发生变化的设置数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.TransferUserPushSettingRequest": {
+            "type": "object",
+            "required": [
+                "from_user_id",
+                "to_user_id"
+            ],
+            "properties": {
+                "from_user_id": {
+                    "description": "设备当前所属的用户ID",
+                    "type": "integer"
+                },
+                "to_user_id": {
+                    "description": "设备转移到的用户ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.UnsubscribeAllResponse": {
+            "type": "object",
+            "properties": {
+                "removed": {
+                    "description": "取消的订阅数量",
+                    "type": "integer"
                 }
             }
         },
@@ -2762,6 +5945,89 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UserDeviceResponse": {
+            "type": "object",
+            "properties": {
+                "consecutive_failures": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "device_id": {
+                    "type": "string",
+                    "example": "abcd****wxyz"
+                },
+                "device_name": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_failure_at": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UserDevicesResponse": {
+            "type": "object",
+            "properties": {
+                "devices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserDeviceResponse"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.UserPushPreferencesRequest": {
+            "type": "object",
+            "properties": {
+                "default_group": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "default_level": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "critical",
+                        "timeSensitive",
+                        "passive"
+                    ]
+                },
+                "default_sound": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.UserPushPreferencesResponse": {
+            "type": "object",
+            "properties": {
+                "default_group": {
+                    "type": "string"
+                },
+                "default_level": {
+                    "type": "string"
+                },
+                "default_sound": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.UserPushRequest": {
             "type": "object",
             "required": [
@@ -2772,6 +6038,10 @@ const docTemplate = `{
                 "auto_copy": {
                     "type": "boolean"
                 },
+                "badge": {
+                    "type": "integer",
+                    "minimum": 0
+                },
                 "body": {
                     "type": "string",
                     "maxLength": 1000,
@@ -2780,18 +6050,45 @@ const docTemplate = `{
                 "call": {
                     "type": "boolean"
                 },
+                "copy": {
+                    "description": "复制通知时得到的内容，auto_copy为true时自动复制",
+                    "type": "string",
+                    "maxLength": 1000
+                },
                 "group": {
                     "type": "string"
                 },
                 "icon": {
                     "type": "string"
                 },
+                "image": {
+                    "description": "通知中展示的图片，必须是https地址，仅Bark支持",
+                    "type": "string"
+                },
                 "level": {
+                    "enum": [
+                        "passive",
+                        "active",
+                        "timeSensitive",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/push.PushLevel"
+                        }
+                    ]
+                },
+                "server_url": {
+                    "description": "ServerURL 本次推送使用的服务器，优先于设备设置和默认配置",
                     "type": "string"
                 },
                 "sound": {
                     "type": "string"
                 },
+                "subtitle": {
+                    "type": "string",
+                    "maxLength": 200
+                },
                 "title": {
                     "type": "string",
                     "maxLength": 200,
@@ -2834,11 +6131,16 @@ const docTemplate = `{
         "dto.UserPushSettingResponse": {
             "type": "object",
             "properties": {
+                "consecutive_failures": {
+                    "type": "integer"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "device_id": {
-                    "type": "string"
+                    "description": "默认脱敏，所有者使用reveal_device_id=true查询参数时返回完整值",
+                    "type": "string",
+                    "example": "abcd****wxyz"
                 },
                 "device_name": {
                     "type": "string"
@@ -2849,6 +6151,9 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
+                "last_failure_at": {
+                    "type": "string"
+                },
                 "provider": {
                     "type": "string"
                 },
@@ -2879,8 +6184,52 @@ const docTemplate = `{
                 "provider": {
                     "type": "string",
                     "enum": [
-                        "bark"
+                        "bark",
+                        "email"
+                    ]
+                }
+            }
+        },
+        "dto.WebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivery_id": {
+                    "type": "string"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string",
+                    "enum": [
+                        "user.created",
+                        "user.banned",
+                        "user.deleted"
                     ]
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
@@ -2890,6 +6239,10 @@ const docTemplate = `{
                 "code": {
                     "type": "integer"
                 },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
                 "error": {
                     "type": "string"
                 },
@@ -2916,6 +6269,10 @@ const docTemplate = `{
                 "user_id"
             ],
             "properties": {
+                "expires_at": {
+                    "description": "可选，分配在该时间后失效，需晚于当前时间",
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "integer",
                     "minimum": 1
@@ -2945,6 +6302,42 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.BanUserRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "可选，临时禁用的到期时间，需晚于当前时间，省略时永久禁用",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "handler.BatchPermissionResult": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "permission": {
+                    "description": "仅在创建成功时返回",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.PermissionResponse"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "created 或 exists",
+                    "type": "string"
+                }
+            }
+        },
         "handler.CreatePermissionRequest": {
             "type": "object",
             "required": [
@@ -2957,7 +6350,17 @@ const docTemplate = `{
                 "action": {
                     "type": "string",
                     "maxLength": 50,
-                    "minLength": 2
+                    "minLength": 1
+                },
+                "category": {
+                    "description": "为空时使用other",
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "rbac",
+                        "system",
+                        "other"
+                    ]
                 },
                 "description": {
                     "type": "string",
@@ -2976,7 +6379,40 @@ const docTemplate = `{
                 "resource": {
                     "type": "string",
                     "maxLength": 50,
-                    "minLength": 2
+                    "minLength": 1
+                }
+            }
+        },
+        "handler.CreatePermissionsBatchRequest": {
+            "type": "object",
+            "required": [
+                "permissions"
+            ],
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/handler.CreatePermissionRequest"
+                    }
+                }
+            }
+        },
+        "handler.CreatePermissionsBatchResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BatchPermissionResult"
+                    }
+                },
+                "skipped": {
+                    "type": "integer"
                 }
             }
         },
@@ -3031,9 +6467,52 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.GroupedPermissionsResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/handler.PermissionResponse"
+                        }
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ImpersonateUserResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "integer"
+                },
+                "impersonated_by": {
+                    "type": "integer"
+                },
+                "token_type": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/handler.UserResponse"
+                }
+            }
+        },
         "handler.ListPermissionsResponse": {
             "type": "object",
             "properties": {
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
                 "limit": {
                     "type": "integer"
                 },
@@ -3048,12 +6527,21 @@ const docTemplate = `{
                 },
                 "total": {
                     "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
                 }
             }
         },
         "handler.ListRolesResponse": {
             "type": "object",
             "properties": {
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
                 "limit": {
                     "type": "integer"
                 },
@@ -3066,6 +6554,37 @@ const docTemplate = `{
                         "$ref": "#/definitions/handler.RoleResponse"
                     }
                 },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ListRoutesResponse": {
+            "type": "object",
+            "properties": {
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.RouteResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ListSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.SessionResponse"
+                    }
+                },
                 "total": {
                     "type": "integer"
                 }
@@ -3074,6 +6593,12 @@ const docTemplate = `{
         "handler.ListUsersResponse": {
             "type": "object",
             "properties": {
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
                 "limit": {
                     "type": "integer"
                 },
@@ -3083,6 +6608,9 @@ const docTemplate = `{
                 "total": {
                     "type": "integer"
                 },
+                "total_pages": {
+                    "type": "integer"
+                },
                 "users": {
                     "type": "array",
                     "items": {
@@ -3098,6 +6626,10 @@ const docTemplate = `{
                 "username"
             ],
             "properties": {
+                "captcha_token": {
+                    "description": "CaptchaToken 人机验证令牌，启用 captcha 时必填",
+                    "type": "string"
+                },
                 "password": {
                     "type": "string",
                     "maxLength": 100,
@@ -3110,15 +6642,49 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.PatchRoleRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                }
+            }
+        },
+        "handler.PatchUserRequest": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "nickname": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
         "handler.PermissionResponse": {
             "type": "object",
             "properties": {
                 "action": {
                     "type": "string"
                 },
+                "category": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
+                "created_by": {
+                    "description": "创建者的用户ID，系统初始化时为null",
+                    "type": "integer"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -3139,6 +6705,30 @@ const docTemplate = `{
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "updated_by": {
+                    "description": "最后修改者的用户ID，未修改过时为null",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.PermissionUsersResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "resource": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.UserResponse"
+                    }
                 }
             }
         },
@@ -3161,6 +6751,10 @@ const docTemplate = `{
                 "username"
             ],
             "properties": {
+                "captcha_token": {
+                    "description": "CaptchaToken 人机验证令牌，启用 captcha 时必填",
+                    "type": "string"
+                },
                 "email": {
                     "type": "string",
                     "maxLength": 100
@@ -3181,12 +6775,27 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.RemoveUserRolesRequest": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "handler.RoleResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
+                "created_by": {
+                    "description": "创建者的用户ID，系统初始化时为null",
+                    "type": "integer"
+                },
                 "description": {
                     "type": "string"
                 },
@@ -3204,6 +6813,10 @@ const docTemplate = `{
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "updated_by": {
+                    "description": "最后修改者的用户ID，未修改过时为null",
+                    "type": "integer"
                 }
             }
         },
@@ -3226,9 +6839,13 @@ const docTemplate = `{
                     "type": "string",
                     "example": "https://example.com/keyframe.jpg"
                 },
-                "live_start_time": {
+                "live_duration": {
                     "type": "integer",
-                    "example": 1609459200
+                    "example": 3600
+                },
+                "live_start_time": {
+                    "type": "string",
+                    "example": "2021-01-01T00:00:00Z"
                 },
                 "owner_avatar": {
                     "type": "string",
@@ -3254,6 +6871,12 @@ const docTemplate = `{
                     "type": "string",
                     "example": "online"
                 },
+                "streams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/livestream.StreamURL"
+                    }
+                },
                 "title": {
                     "type": "string",
                     "example": "【六神】游戏室"
@@ -3264,6 +6887,71 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.RoomResolveResponse": {
+            "type": "object",
+            "properties": {
+                "owner_id": {
+                    "type": "string",
+                    "example": "9617619"
+                },
+                "platform": {
+                    "type": "string",
+                    "example": "bilibili"
+                },
+                "room_id": {
+                    "type": "string",
+                    "example": "5440"
+                },
+                "short_id": {
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "handler.RouteResponse": {
+            "type": "object",
+            "properties": {
+                "handler": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "description": "是否为发起请求的会话",
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
         "handler.StreamStatusResponse": {
             "type": "object",
             "properties": {
@@ -3349,6 +7037,10 @@ const docTemplate = `{
                 "avatar": {
                     "type": "string"
                 },
+                "ban_expires_at": {
+                    "description": "临时禁用的到期时间，永久禁用时为空",
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -3364,6 +7056,13 @@ const docTemplate = `{
                 "status": {
                     "type": "string"
                 },
+                "status_changed_at": {
+                    "type": "string"
+                },
+                "status_reason": {
+                    "description": "以下状态变更信息仅在用户管理接口中返回",
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -3371,6 +7070,44 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "handler.UserStatusRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "livestream.StreamURL": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "type": "string"
+                },
+                "quality": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "push.PushLevel": {
+            "type": "string",
+            "enum": [
+                "critical",
+                "active",
+                "timeSensitive",
+                "passive"
+            ],
+            "x-enum-varnames": [
+                "PushLevelCritical",
+                "PushLevelActive",
+                "PushLevelTimeSensitive",
+                "PushLevelPassive"
+            ]
         }
     },
     "securityDefinitions": {
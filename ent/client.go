@@ -11,12 +11,18 @@ import (
 
 	"nebula-live/ent/migrate"
 
+	"nebula-live/ent/job"
+	"nebula-live/ent/livesubscription"
 	"nebula-live/ent/permission"
+	"nebula-live/ent/recurringpush"
 	"nebula-live/ent/role"
 	"nebula-live/ent/rolepermission"
+	"nebula-live/ent/scheduledpush"
 	"nebula-live/ent/user"
 	"nebula-live/ent/userpushsetting"
 	"nebula-live/ent/userrole"
+	"nebula-live/ent/usersession"
+	"nebula-live/ent/webhookdelivery"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -29,18 +35,30 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// LiveSubscription is the client for interacting with the LiveSubscription builders.
+	LiveSubscription *LiveSubscriptionClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
+	// RecurringPush is the client for interacting with the RecurringPush builders.
+	RecurringPush *RecurringPushClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// ScheduledPush is the client for interacting with the ScheduledPush builders.
+	ScheduledPush *ScheduledPushClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserPushSetting is the client for interacting with the UserPushSetting builders.
 	UserPushSetting *UserPushSettingClient
 	// UserRole is the client for interacting with the UserRole builders.
 	UserRole *UserRoleClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -52,12 +70,18 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Job = NewJobClient(c.config)
+	c.LiveSubscription = NewLiveSubscriptionClient(c.config)
 	c.Permission = NewPermissionClient(c.config)
+	c.RecurringPush = NewRecurringPushClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.RolePermission = NewRolePermissionClient(c.config)
+	c.ScheduledPush = NewScheduledPushClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserPushSetting = NewUserPushSettingClient(c.config)
 	c.UserRole = NewUserRoleClient(c.config)
+	c.UserSession = NewUserSessionClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -148,14 +172,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		Permission:      NewPermissionClient(cfg),
-		Role:            NewRoleClient(cfg),
-		RolePermission:  NewRolePermissionClient(cfg),
-		User:            NewUserClient(cfg),
-		UserPushSetting: NewUserPushSettingClient(cfg),
-		UserRole:        NewUserRoleClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Job:              NewJobClient(cfg),
+		LiveSubscription: NewLiveSubscriptionClient(cfg),
+		Permission:       NewPermissionClient(cfg),
+		RecurringPush:    NewRecurringPushClient(cfg),
+		Role:             NewRoleClient(cfg),
+		RolePermission:   NewRolePermissionClient(cfg),
+		ScheduledPush:    NewScheduledPushClient(cfg),
+		User:             NewUserClient(cfg),
+		UserPushSetting:  NewUserPushSettingClient(cfg),
+		UserRole:         NewUserRoleClient(cfg),
+		UserSession:      NewUserSessionClient(cfg),
+		WebhookDelivery:  NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -173,21 +203,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		Permission:      NewPermissionClient(cfg),
-		Role:            NewRoleClient(cfg),
-		RolePermission:  NewRolePermissionClient(cfg),
-		User:            NewUserClient(cfg),
-		UserPushSetting: NewUserPushSettingClient(cfg),
-		UserRole:        NewUserRoleClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Job:              NewJobClient(cfg),
+		LiveSubscription: NewLiveSubscriptionClient(cfg),
+		Permission:       NewPermissionClient(cfg),
+		RecurringPush:    NewRecurringPushClient(cfg),
+		Role:             NewRoleClient(cfg),
+		RolePermission:   NewRolePermissionClient(cfg),
+		ScheduledPush:    NewScheduledPushClient(cfg),
+		User:             NewUserClient(cfg),
+		UserPushSetting:  NewUserPushSettingClient(cfg),
+		UserRole:         NewUserRoleClient(cfg),
+		UserSession:      NewUserSessionClient(cfg),
+		WebhookDelivery:  NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Permission.
+//		Job.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -210,7 +246,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Permission, c.Role, c.RolePermission, c.User, c.UserPushSetting, c.UserRole,
+		c.Job, c.LiveSubscription, c.Permission, c.RecurringPush, c.Role,
+		c.RolePermission, c.ScheduledPush, c.User, c.UserPushSetting, c.UserRole,
+		c.UserSession, c.WebhookDelivery,
 	} {
 		n.Use(hooks...)
 	}
@@ -220,7 +258,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Permission, c.Role, c.RolePermission, c.User, c.UserPushSetting, c.UserRole,
+		c.Job, c.LiveSubscription, c.Permission, c.RecurringPush, c.Role,
+		c.RolePermission, c.ScheduledPush, c.User, c.UserPushSetting, c.UserRole,
+		c.UserSession, c.WebhookDelivery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -229,23 +269,317 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
+	case *LiveSubscriptionMutation:
+		return c.LiveSubscription.mutate(ctx, m)
 	case *PermissionMutation:
 		return c.Permission.mutate(ctx, m)
+	case *RecurringPushMutation:
+		return c.RecurringPush.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *RolePermissionMutation:
 		return c.RolePermission.mutate(ctx, m)
+	case *ScheduledPushMutation:
+		return c.ScheduledPush.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserPushSettingMutation:
 		return c.UserPushSetting.mutate(ctx, m)
 	case *UserRoleMutation:
 		return c.UserRole.mutate(ctx, m)
+	case *UserSessionMutation:
+		return c.UserSession.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(_m *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(_m))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id uint) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(_m *Job) *JobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id uint) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id uint) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id uint) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// LiveSubscriptionClient is a client for the LiveSubscription schema.
+type LiveSubscriptionClient struct {
+	config
+}
+
+// NewLiveSubscriptionClient returns a client for the LiveSubscription from the given config.
+func NewLiveSubscriptionClient(c config) *LiveSubscriptionClient {
+	return &LiveSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `livesubscription.Hooks(f(g(h())))`.
+func (c *LiveSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.LiveSubscription = append(c.hooks.LiveSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `livesubscription.Intercept(f(g(h())))`.
+func (c *LiveSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LiveSubscription = append(c.inters.LiveSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a LiveSubscription entity.
+func (c *LiveSubscriptionClient) Create() *LiveSubscriptionCreate {
+	mutation := newLiveSubscriptionMutation(c.config, OpCreate)
+	return &LiveSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LiveSubscription entities.
+func (c *LiveSubscriptionClient) CreateBulk(builders ...*LiveSubscriptionCreate) *LiveSubscriptionCreateBulk {
+	return &LiveSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LiveSubscriptionClient) MapCreateBulk(slice any, setFunc func(*LiveSubscriptionCreate, int)) *LiveSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LiveSubscriptionCreateBulk{err: fmt.Errorf("calling to LiveSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LiveSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LiveSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LiveSubscription.
+func (c *LiveSubscriptionClient) Update() *LiveSubscriptionUpdate {
+	mutation := newLiveSubscriptionMutation(c.config, OpUpdate)
+	return &LiveSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LiveSubscriptionClient) UpdateOne(_m *LiveSubscription) *LiveSubscriptionUpdateOne {
+	mutation := newLiveSubscriptionMutation(c.config, OpUpdateOne, withLiveSubscription(_m))
+	return &LiveSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LiveSubscriptionClient) UpdateOneID(id uint) *LiveSubscriptionUpdateOne {
+	mutation := newLiveSubscriptionMutation(c.config, OpUpdateOne, withLiveSubscriptionID(id))
+	return &LiveSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LiveSubscription.
+func (c *LiveSubscriptionClient) Delete() *LiveSubscriptionDelete {
+	mutation := newLiveSubscriptionMutation(c.config, OpDelete)
+	return &LiveSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LiveSubscriptionClient) DeleteOne(_m *LiveSubscription) *LiveSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LiveSubscriptionClient) DeleteOneID(id uint) *LiveSubscriptionDeleteOne {
+	builder := c.Delete().Where(livesubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LiveSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for LiveSubscription.
+func (c *LiveSubscriptionClient) Query() *LiveSubscriptionQuery {
+	return &LiveSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLiveSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LiveSubscription entity by its id.
+func (c *LiveSubscriptionClient) Get(ctx context.Context, id uint) (*LiveSubscription, error) {
+	return c.Query().Where(livesubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LiveSubscriptionClient) GetX(ctx context.Context, id uint) *LiveSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LiveSubscription.
+func (c *LiveSubscriptionClient) QueryUser(_m *LiveSubscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(livesubscription.Table, livesubscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, livesubscription.UserTable, livesubscription.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LiveSubscriptionClient) Hooks() []Hook {
+	return c.hooks.LiveSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *LiveSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.LiveSubscription
+}
+
+func (c *LiveSubscriptionClient) mutate(ctx context.Context, m *LiveSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LiveSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LiveSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LiveSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LiveSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LiveSubscription mutation op: %q", m.Op())
+	}
+}
+
 // PermissionClient is a client for the Permission schema.
 type PermissionClient struct {
 	config
@@ -395,6 +729,155 @@ func (c *PermissionClient) mutate(ctx context.Context, m *PermissionMutation) (V
 	}
 }
 
+// RecurringPushClient is a client for the RecurringPush schema.
+type RecurringPushClient struct {
+	config
+}
+
+// NewRecurringPushClient returns a client for the RecurringPush from the given config.
+func NewRecurringPushClient(c config) *RecurringPushClient {
+	return &RecurringPushClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recurringpush.Hooks(f(g(h())))`.
+func (c *RecurringPushClient) Use(hooks ...Hook) {
+	c.hooks.RecurringPush = append(c.hooks.RecurringPush, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recurringpush.Intercept(f(g(h())))`.
+func (c *RecurringPushClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecurringPush = append(c.inters.RecurringPush, interceptors...)
+}
+
+// Create returns a builder for creating a RecurringPush entity.
+func (c *RecurringPushClient) Create() *RecurringPushCreate {
+	mutation := newRecurringPushMutation(c.config, OpCreate)
+	return &RecurringPushCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecurringPush entities.
+func (c *RecurringPushClient) CreateBulk(builders ...*RecurringPushCreate) *RecurringPushCreateBulk {
+	return &RecurringPushCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecurringPushClient) MapCreateBulk(slice any, setFunc func(*RecurringPushCreate, int)) *RecurringPushCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecurringPushCreateBulk{err: fmt.Errorf("calling to RecurringPushClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecurringPushCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecurringPushCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecurringPush.
+func (c *RecurringPushClient) Update() *RecurringPushUpdate {
+	mutation := newRecurringPushMutation(c.config, OpUpdate)
+	return &RecurringPushUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecurringPushClient) UpdateOne(_m *RecurringPush) *RecurringPushUpdateOne {
+	mutation := newRecurringPushMutation(c.config, OpUpdateOne, withRecurringPush(_m))
+	return &RecurringPushUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecurringPushClient) UpdateOneID(id uint) *RecurringPushUpdateOne {
+	mutation := newRecurringPushMutation(c.config, OpUpdateOne, withRecurringPushID(id))
+	return &RecurringPushUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecurringPush.
+func (c *RecurringPushClient) Delete() *RecurringPushDelete {
+	mutation := newRecurringPushMutation(c.config, OpDelete)
+	return &RecurringPushDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecurringPushClient) DeleteOne(_m *RecurringPush) *RecurringPushDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecurringPushClient) DeleteOneID(id uint) *RecurringPushDeleteOne {
+	builder := c.Delete().Where(recurringpush.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecurringPushDeleteOne{builder}
+}
+
+// Query returns a query builder for RecurringPush.
+func (c *RecurringPushClient) Query() *RecurringPushQuery {
+	return &RecurringPushQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecurringPush},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecurringPush entity by its id.
+func (c *RecurringPushClient) Get(ctx context.Context, id uint) (*RecurringPush, error) {
+	return c.Query().Where(recurringpush.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecurringPushClient) GetX(ctx context.Context, id uint) *RecurringPush {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RecurringPush.
+func (c *RecurringPushClient) QueryUser(_m *RecurringPush) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurringpush.Table, recurringpush.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, recurringpush.UserTable, recurringpush.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecurringPushClient) Hooks() []Hook {
+	return c.hooks.RecurringPush
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecurringPushClient) Interceptors() []Interceptor {
+	return c.inters.RecurringPush
+}
+
+func (c *RecurringPushClient) mutate(ctx context.Context, m *RecurringPushMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecurringPushCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecurringPushUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecurringPushUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecurringPushDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecurringPush mutation op: %q", m.Op())
+	}
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -654,13 +1137,194 @@ func (c *RolePermissionClient) Query() *RolePermissionQuery {
 	}
 }
 
-// Get returns a RolePermission entity by its id.
-func (c *RolePermissionClient) Get(ctx context.Context, id uint) (*RolePermission, error) {
-	return c.Query().Where(rolepermission.ID(id)).Only(ctx)
+// Get returns a RolePermission entity by its id.
+func (c *RolePermissionClient) Get(ctx context.Context, id uint) (*RolePermission, error) {
+	return c.Query().Where(rolepermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RolePermissionClient) GetX(ctx context.Context, id uint) *RolePermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a RolePermission.
+func (c *RolePermissionClient) QueryRole(_m *RolePermission) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.RoleTable, rolepermission.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermission queries the permission edge of a RolePermission.
+func (c *RolePermissionClient) QueryPermission(_m *RolePermission) *PermissionQuery {
+	query := (&PermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
+			sqlgraph.To(permission.Table, permission.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.PermissionTable, rolepermission.PermissionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssigner queries the assigner edge of a RolePermission.
+func (c *RolePermissionClient) QueryAssigner(_m *RolePermission) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.AssignerTable, rolepermission.AssignerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RolePermissionClient) Hooks() []Hook {
+	return c.hooks.RolePermission
+}
+
+// Interceptors returns the client interceptors.
+func (c *RolePermissionClient) Interceptors() []Interceptor {
+	return c.inters.RolePermission
+}
+
+func (c *RolePermissionClient) mutate(ctx context.Context, m *RolePermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RolePermission mutation op: %q", m.Op())
+	}
+}
+
+// ScheduledPushClient is a client for the ScheduledPush schema.
+type ScheduledPushClient struct {
+	config
+}
+
+// NewScheduledPushClient returns a client for the ScheduledPush from the given config.
+func NewScheduledPushClient(c config) *ScheduledPushClient {
+	return &ScheduledPushClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scheduledpush.Hooks(f(g(h())))`.
+func (c *ScheduledPushClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledPush = append(c.hooks.ScheduledPush, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scheduledpush.Intercept(f(g(h())))`.
+func (c *ScheduledPushClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledPush = append(c.inters.ScheduledPush, interceptors...)
+}
+
+// Create returns a builder for creating a ScheduledPush entity.
+func (c *ScheduledPushClient) Create() *ScheduledPushCreate {
+	mutation := newScheduledPushMutation(c.config, OpCreate)
+	return &ScheduledPushCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduledPush entities.
+func (c *ScheduledPushClient) CreateBulk(builders ...*ScheduledPushCreate) *ScheduledPushCreateBulk {
+	return &ScheduledPushCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduledPushClient) MapCreateBulk(slice any, setFunc func(*ScheduledPushCreate, int)) *ScheduledPushCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduledPushCreateBulk{err: fmt.Errorf("calling to ScheduledPushClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduledPushCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduledPushCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduledPush.
+func (c *ScheduledPushClient) Update() *ScheduledPushUpdate {
+	mutation := newScheduledPushMutation(c.config, OpUpdate)
+	return &ScheduledPushUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduledPushClient) UpdateOne(_m *ScheduledPush) *ScheduledPushUpdateOne {
+	mutation := newScheduledPushMutation(c.config, OpUpdateOne, withScheduledPush(_m))
+	return &ScheduledPushUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduledPushClient) UpdateOneID(id uint) *ScheduledPushUpdateOne {
+	mutation := newScheduledPushMutation(c.config, OpUpdateOne, withScheduledPushID(id))
+	return &ScheduledPushUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduledPush.
+func (c *ScheduledPushClient) Delete() *ScheduledPushDelete {
+	mutation := newScheduledPushMutation(c.config, OpDelete)
+	return &ScheduledPushDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduledPushClient) DeleteOne(_m *ScheduledPush) *ScheduledPushDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduledPushClient) DeleteOneID(id uint) *ScheduledPushDeleteOne {
+	builder := c.Delete().Where(scheduledpush.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduledPushDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduledPush.
+func (c *ScheduledPushClient) Query() *ScheduledPushQuery {
+	return &ScheduledPushQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScheduledPush},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScheduledPush entity by its id.
+func (c *ScheduledPushClient) Get(ctx context.Context, id uint) (*ScheduledPush, error) {
+	return c.Query().Where(scheduledpush.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RolePermissionClient) GetX(ctx context.Context, id uint) *RolePermission {
+func (c *ScheduledPushClient) GetX(ctx context.Context, id uint) *ScheduledPush {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -668,47 +1332,15 @@ func (c *RolePermissionClient) GetX(ctx context.Context, id uint) *RolePermissio
 	return obj
 }
 
-// QueryRole queries the role edge of a RolePermission.
-func (c *RolePermissionClient) QueryRole(_m *RolePermission) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.RoleTable, rolepermission.RoleColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPermission queries the permission edge of a RolePermission.
-func (c *RolePermissionClient) QueryPermission(_m *RolePermission) *PermissionQuery {
-	query := (&PermissionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
-			sqlgraph.To(permission.Table, permission.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.PermissionTable, rolepermission.PermissionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAssigner queries the assigner edge of a RolePermission.
-func (c *RolePermissionClient) QueryAssigner(_m *RolePermission) *UserQuery {
+// QueryUser queries the user edge of a ScheduledPush.
+func (c *ScheduledPushClient) QueryUser(_m *ScheduledPush) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(rolepermission.Table, rolepermission.FieldID, id),
+			sqlgraph.From(scheduledpush.Table, scheduledpush.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, rolepermission.AssignerTable, rolepermission.AssignerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledpush.UserTable, scheduledpush.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -717,27 +1349,27 @@ func (c *RolePermissionClient) QueryAssigner(_m *RolePermission) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *RolePermissionClient) Hooks() []Hook {
-	return c.hooks.RolePermission
+func (c *ScheduledPushClient) Hooks() []Hook {
+	return c.hooks.ScheduledPush
 }
 
 // Interceptors returns the client interceptors.
-func (c *RolePermissionClient) Interceptors() []Interceptor {
-	return c.inters.RolePermission
+func (c *ScheduledPushClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledPush
 }
 
-func (c *RolePermissionClient) mutate(ctx context.Context, m *RolePermissionMutation) (Value, error) {
+func (c *ScheduledPushClient) mutate(ctx context.Context, m *ScheduledPushMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RolePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledPushCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RolePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledPushUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RolePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ScheduledPushUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RolePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ScheduledPushDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RolePermission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ScheduledPush mutation op: %q", m.Op())
 	}
 }
 
@@ -913,6 +1545,70 @@ func (c *UserClient) QueryPushSettings(_m *User) *UserPushSettingQuery {
 	return query
 }
 
+// QueryScheduledPushes queries the scheduled_pushes edge of a User.
+func (c *UserClient) QueryScheduledPushes(_m *User) *ScheduledPushQuery {
+	query := (&ScheduledPushClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(scheduledpush.Table, scheduledpush.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ScheduledPushesTable, user.ScheduledPushesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecurringPushes queries the recurring_pushes edge of a User.
+func (c *UserClient) QueryRecurringPushes(_m *User) *RecurringPushQuery {
+	query := (&RecurringPushClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recurringpush.Table, recurringpush.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.RecurringPushesTable, user.RecurringPushesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(_m *User) *UserSessionQuery {
+	query := (&UserSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usersession.Table, usersession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLiveSubscriptions queries the live_subscriptions edge of a User.
+func (c *UserClient) QueryLiveSubscriptions(_m *User) *LiveSubscriptionQuery {
+	query := (&LiveSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(livesubscription.Table, livesubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.LiveSubscriptionsTable, user.LiveSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1268,13 +1964,298 @@ func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value
 	}
 }
 
+// UserSessionClient is a client for the UserSession schema.
+type UserSessionClient struct {
+	config
+}
+
+// NewUserSessionClient returns a client for the UserSession from the given config.
+func NewUserSessionClient(c config) *UserSessionClient {
+	return &UserSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usersession.Hooks(f(g(h())))`.
+func (c *UserSessionClient) Use(hooks ...Hook) {
+	c.hooks.UserSession = append(c.hooks.UserSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usersession.Intercept(f(g(h())))`.
+func (c *UserSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserSession = append(c.inters.UserSession, interceptors...)
+}
+
+// Create returns a builder for creating a UserSession entity.
+func (c *UserSessionClient) Create() *UserSessionCreate {
+	mutation := newUserSessionMutation(c.config, OpCreate)
+	return &UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserSession entities.
+func (c *UserSessionClient) CreateBulk(builders ...*UserSessionCreate) *UserSessionCreateBulk {
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserSessionClient) MapCreateBulk(slice any, setFunc func(*UserSessionCreate, int)) *UserSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserSessionCreateBulk{err: fmt.Errorf("calling to UserSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserSession.
+func (c *UserSessionClient) Update() *UserSessionUpdate {
+	mutation := newUserSessionMutation(c.config, OpUpdate)
+	return &UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserSessionClient) UpdateOne(_m *UserSession) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSession(_m))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserSessionClient) UpdateOneID(id uint) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSessionID(id))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserSession.
+func (c *UserSessionClient) Delete() *UserSessionDelete {
+	mutation := newUserSessionMutation(c.config, OpDelete)
+	return &UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserSessionClient) DeleteOne(_m *UserSession) *UserSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserSessionClient) DeleteOneID(id uint) *UserSessionDeleteOne {
+	builder := c.Delete().Where(usersession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for UserSession.
+func (c *UserSessionClient) Query() *UserSessionQuery {
+	return &UserSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserSession entity by its id.
+func (c *UserSessionClient) Get(ctx context.Context, id uint) (*UserSession, error) {
+	return c.Query().Where(usersession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserSessionClient) GetX(ctx context.Context, id uint) *UserSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserSession.
+func (c *UserSessionClient) QueryUser(_m *UserSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usersession.Table, usersession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, usersession.UserTable, usersession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserSessionClient) Hooks() []Hook {
+	return c.hooks.UserSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserSessionClient) Interceptors() []Interceptor {
+	return c.inters.UserSession
+}
+
+func (c *UserSessionClient) mutate(ctx context.Context, m *UserSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserSession mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uint) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uint) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uint) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uint) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Permission, Role, RolePermission, User, UserPushSetting, UserRole []ent.Hook
+		Job, LiveSubscription, Permission, RecurringPush, Role, RolePermission,
+		ScheduledPush, User, UserPushSetting, UserRole, UserSession,
+		WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		Permission, Role, RolePermission, User, UserPushSetting,
-		UserRole []ent.Interceptor
+		Job, LiveSubscription, Permission, RecurringPush, Role, RolePermission,
+		ScheduledPush, User, UserPushSetting, UserRole, UserSession,
+		WebhookDelivery []ent.Interceptor
 	}
 )
@@ -6,12 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nebula-live/ent/job"
+	"nebula-live/ent/livesubscription"
 	"nebula-live/ent/permission"
+	"nebula-live/ent/recurringpush"
 	"nebula-live/ent/role"
 	"nebula-live/ent/rolepermission"
+	"nebula-live/ent/scheduledpush"
 	"nebula-live/ent/user"
 	"nebula-live/ent/userpushsetting"
 	"nebula-live/ent/userrole"
+	"nebula-live/ent/usersession"
+	"nebula-live/ent/webhookdelivery"
 	"reflect"
 	"sync"
 
@@ -78,12 +84,18 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			permission.Table:      permission.ValidColumn,
-			role.Table:            role.ValidColumn,
-			rolepermission.Table:  rolepermission.ValidColumn,
-			user.Table:            user.ValidColumn,
-			userpushsetting.Table: userpushsetting.ValidColumn,
-			userrole.Table:        userrole.ValidColumn,
+			job.Table:              job.ValidColumn,
+			livesubscription.Table: livesubscription.ValidColumn,
+			permission.Table:       permission.ValidColumn,
+			recurringpush.Table:    recurringpush.ValidColumn,
+			role.Table:             role.ValidColumn,
+			rolepermission.Table:   rolepermission.ValidColumn,
+			scheduledpush.Table:    scheduledpush.ValidColumn,
+			user.Table:             user.ValidColumn,
+			userpushsetting.Table:  userpushsetting.ValidColumn,
+			userrole.Table:         userrole.ValidColumn,
+			usersession.Table:      usersession.ValidColumn,
+			webhookdelivery.Table:  webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
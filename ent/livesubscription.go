@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nebula-live/ent/livesubscription"
+	"nebula-live/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LiveSubscription is the model entity for the LiveSubscription schema.
+type LiveSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 订阅的用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 直播平台的规范名称（别名在保存前已解析）
+	Platform string `json:"platform,omitempty"`
+	// 直播间ID
+	RoomID string `json:"room_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LiveSubscriptionQuery when eager-loading is set.
+	Edges        LiveSubscriptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LiveSubscriptionEdges holds the relations/edges for other nodes in the graph.
+type LiveSubscriptionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LiveSubscriptionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LiveSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case livesubscription.FieldID, livesubscription.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case livesubscription.FieldPlatform, livesubscription.FieldRoomID:
+			values[i] = new(sql.NullString)
+		case livesubscription.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LiveSubscription fields.
+func (_m *LiveSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case livesubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case livesubscription.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint(value.Int64)
+			}
+		case livesubscription.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				_m.Platform = value.String
+			}
+		case livesubscription.FieldRoomID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room_id", values[i])
+			} else if value.Valid {
+				_m.RoomID = value.String
+			}
+		case livesubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LiveSubscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *LiveSubscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LiveSubscription entity.
+func (_m *LiveSubscription) QueryUser() *UserQuery {
+	return NewLiveSubscriptionClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this LiveSubscription.
+// Note that you need to call LiveSubscription.Unwrap() before calling this method if this LiveSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LiveSubscription) Update() *LiveSubscriptionUpdateOne {
+	return NewLiveSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LiveSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LiveSubscription) Unwrap() *LiveSubscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LiveSubscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LiveSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("LiveSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(_m.Platform)
+	builder.WriteString(", ")
+	builder.WriteString("room_id=")
+	builder.WriteString(_m.RoomID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LiveSubscriptions is a parsable slice of LiveSubscription.
+type LiveSubscriptions []*LiveSubscription
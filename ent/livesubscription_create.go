@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nebula-live/ent/livesubscription"
+	"nebula-live/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LiveSubscriptionCreate is the builder for creating a LiveSubscription entity.
+type LiveSubscriptionCreate struct {
+	config
+	mutation *LiveSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LiveSubscriptionCreate) SetUserID(v uint) *LiveSubscriptionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPlatform sets the "platform" field.
+func (_c *LiveSubscriptionCreate) SetPlatform(v string) *LiveSubscriptionCreate {
+	_c.mutation.SetPlatform(v)
+	return _c
+}
+
+// SetRoomID sets the "room_id" field.
+func (_c *LiveSubscriptionCreate) SetRoomID(v string) *LiveSubscriptionCreate {
+	_c.mutation.SetRoomID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LiveSubscriptionCreate) SetCreatedAt(v time.Time) *LiveSubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LiveSubscriptionCreate) SetNillableCreatedAt(v *time.Time) *LiveSubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LiveSubscriptionCreate) SetID(v uint) *LiveSubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LiveSubscriptionCreate) SetUser(v *User) *LiveSubscriptionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LiveSubscriptionMutation object of the builder.
+func (_c *LiveSubscriptionCreate) Mutation() *LiveSubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the LiveSubscription in the database.
+func (_c *LiveSubscriptionCreate) Save(ctx context.Context) (*LiveSubscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LiveSubscriptionCreate) SaveX(ctx context.Context) *LiveSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LiveSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LiveSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LiveSubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := livesubscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LiveSubscriptionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LiveSubscription.user_id"`)}
+	}
+	if _, ok := _c.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "LiveSubscription.platform"`)}
+	}
+	if v, ok := _c.mutation.Platform(); ok {
+		if err := livesubscription.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "LiveSubscription.platform": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room_id", err: errors.New(`ent: missing required field "LiveSubscription.room_id"`)}
+	}
+	if v, ok := _c.mutation.RoomID(); ok {
+		if err := livesubscription.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "LiveSubscription.room_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LiveSubscription.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "LiveSubscription.user"`)}
+	}
+	return nil
+}
+
+func (_c *LiveSubscriptionCreate) sqlSave(ctx context.Context) (*LiveSubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LiveSubscriptionCreate) createSpec() (*LiveSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LiveSubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(livesubscription.Table, sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Platform(); ok {
+		_spec.SetField(livesubscription.FieldPlatform, field.TypeString, value)
+		_node.Platform = value
+	}
+	if value, ok := _c.mutation.RoomID(); ok {
+		_spec.SetField(livesubscription.FieldRoomID, field.TypeString, value)
+		_node.RoomID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(livesubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   livesubscription.UserTable,
+			Columns: []string{livesubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LiveSubscriptionCreateBulk is the builder for creating many LiveSubscription entities in bulk.
+type LiveSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*LiveSubscriptionCreate
+}
+
+// Save creates the LiveSubscription entities in the database.
+func (_c *LiveSubscriptionCreateBulk) Save(ctx context.Context) ([]*LiveSubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LiveSubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LiveSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LiveSubscriptionCreateBulk) SaveX(ctx context.Context) []*LiveSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LiveSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LiveSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
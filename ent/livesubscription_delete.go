@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"nebula-live/ent/livesubscription"
+	"nebula-live/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LiveSubscriptionDelete is the builder for deleting a LiveSubscription entity.
+type LiveSubscriptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *LiveSubscriptionMutation
+}
+
+// Where appends a list predicates to the LiveSubscriptionDelete builder.
+func (_d *LiveSubscriptionDelete) Where(ps ...predicate.LiveSubscription) *LiveSubscriptionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *LiveSubscriptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LiveSubscriptionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *LiveSubscriptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(livesubscription.Table, sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// LiveSubscriptionDeleteOne is the builder for deleting a single LiveSubscription entity.
+type LiveSubscriptionDeleteOne struct {
+	_d *LiveSubscriptionDelete
+}
+
+// Where appends a list predicates to the LiveSubscriptionDelete builder.
+func (_d *LiveSubscriptionDeleteOne) Where(ps ...predicate.LiveSubscription) *LiveSubscriptionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *LiveSubscriptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{livesubscription.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LiveSubscriptionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nebula-live/ent/livesubscription"
+	"nebula-live/ent/predicate"
+	"nebula-live/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LiveSubscriptionUpdate is the builder for updating LiveSubscription entities.
+type LiveSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LiveSubscriptionMutation
+}
+
+// Where appends a list predicates to the LiveSubscriptionUpdate builder.
+func (_u *LiveSubscriptionUpdate) Where(ps ...predicate.LiveSubscription) *LiveSubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LiveSubscriptionUpdate) SetUserID(v uint) *LiveSubscriptionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LiveSubscriptionUpdate) SetNillableUserID(v *uint) *LiveSubscriptionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *LiveSubscriptionUpdate) SetPlatform(v string) *LiveSubscriptionUpdate {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *LiveSubscriptionUpdate) SetNillablePlatform(v *string) *LiveSubscriptionUpdate {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetRoomID sets the "room_id" field.
+func (_u *LiveSubscriptionUpdate) SetRoomID(v string) *LiveSubscriptionUpdate {
+	_u.mutation.SetRoomID(v)
+	return _u
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (_u *LiveSubscriptionUpdate) SetNillableRoomID(v *string) *LiveSubscriptionUpdate {
+	if v != nil {
+		_u.SetRoomID(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LiveSubscriptionUpdate) SetUser(v *User) *LiveSubscriptionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LiveSubscriptionMutation object of the builder.
+func (_u *LiveSubscriptionUpdate) Mutation() *LiveSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LiveSubscriptionUpdate) ClearUser() *LiveSubscriptionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LiveSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LiveSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LiveSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LiveSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LiveSubscriptionUpdate) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := livesubscription.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "LiveSubscription.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoomID(); ok {
+		if err := livesubscription.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "LiveSubscription.room_id": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LiveSubscription.user"`)
+	}
+	return nil
+}
+
+func (_u *LiveSubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(livesubscription.Table, livesubscription.Columns, sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(livesubscription.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoomID(); ok {
+		_spec.SetField(livesubscription.FieldRoomID, field.TypeString, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   livesubscription.UserTable,
+			Columns: []string{livesubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   livesubscription.UserTable,
+			Columns: []string{livesubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{livesubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LiveSubscriptionUpdateOne is the builder for updating a single LiveSubscription entity.
+type LiveSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LiveSubscriptionMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LiveSubscriptionUpdateOne) SetUserID(v uint) *LiveSubscriptionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LiveSubscriptionUpdateOne) SetNillableUserID(v *uint) *LiveSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetPlatform sets the "platform" field.
+func (_u *LiveSubscriptionUpdateOne) SetPlatform(v string) *LiveSubscriptionUpdateOne {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *LiveSubscriptionUpdateOne) SetNillablePlatform(v *string) *LiveSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
+// SetRoomID sets the "room_id" field.
+func (_u *LiveSubscriptionUpdateOne) SetRoomID(v string) *LiveSubscriptionUpdateOne {
+	_u.mutation.SetRoomID(v)
+	return _u
+}
+
+// SetNillableRoomID sets the "room_id" field if the given value is not nil.
+func (_u *LiveSubscriptionUpdateOne) SetNillableRoomID(v *string) *LiveSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetRoomID(*v)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LiveSubscriptionUpdateOne) SetUser(v *User) *LiveSubscriptionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LiveSubscriptionMutation object of the builder.
+func (_u *LiveSubscriptionUpdateOne) Mutation() *LiveSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LiveSubscriptionUpdateOne) ClearUser() *LiveSubscriptionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the LiveSubscriptionUpdate builder.
+func (_u *LiveSubscriptionUpdateOne) Where(ps ...predicate.LiveSubscription) *LiveSubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LiveSubscriptionUpdateOne) Select(field string, fields ...string) *LiveSubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LiveSubscription entity.
+func (_u *LiveSubscriptionUpdateOne) Save(ctx context.Context) (*LiveSubscription, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LiveSubscriptionUpdateOne) SaveX(ctx context.Context) *LiveSubscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LiveSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LiveSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LiveSubscriptionUpdateOne) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := livesubscription.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "LiveSubscription.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RoomID(); ok {
+		if err := livesubscription.RoomIDValidator(v); err != nil {
+			return &ValidationError{Name: "room_id", err: fmt.Errorf(`ent: validator failed for field "LiveSubscription.room_id": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LiveSubscription.user"`)
+	}
+	return nil
+}
+
+func (_u *LiveSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *LiveSubscription, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(livesubscription.Table, livesubscription.Columns, sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LiveSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, livesubscription.FieldID)
+		for _, f := range fields {
+			if !livesubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != livesubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(livesubscription.FieldPlatform, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RoomID(); ok {
+		_spec.SetField(livesubscription.FieldRoomID, field.TypeString, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   livesubscription.UserTable,
+			Columns: []string{livesubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   livesubscription.UserTable,
+			Columns: []string{livesubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LiveSubscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{livesubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,73 @@ import (
 )
 
 var (
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "type", Type: field.TypeString, Size: 64},
+		{Name: "payload", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}, Default: "pending"},
+		{Name: "result", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "created_by", Type: field.TypeUint, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_status_id",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[3], JobsColumns[0]},
+			},
+			{
+				Name:    "job_created_by",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[7]},
+			},
+		},
+	}
+	// LiveSubscriptionsColumns holds the columns for the "live_subscriptions" table.
+	LiveSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "platform", Type: field.TypeString, Size: 50},
+		{Name: "room_id", Type: field.TypeString, Size: 100},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint},
+	}
+	// LiveSubscriptionsTable holds the schema information for the "live_subscriptions" table.
+	LiveSubscriptionsTable = &schema.Table{
+		Name:       "live_subscriptions",
+		Columns:    LiveSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{LiveSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "live_subscriptions_users_user",
+				Columns:    []*schema.Column{LiveSubscriptionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "livesubscription_user_id_platform_room_id",
+				Unique:  true,
+				Columns: []*schema.Column{LiveSubscriptionsColumns[4], LiveSubscriptionsColumns[1], LiveSubscriptionsColumns[2]},
+			},
+			{
+				Name:    "livesubscription_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LiveSubscriptionsColumns[4], LiveSubscriptionsColumns[3]},
+			},
+		},
+	}
 	// PermissionsColumns holds the columns for the "permissions" table.
 	PermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -16,7 +83,10 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500},
 		{Name: "resource", Type: field.TypeString, Size: 50},
 		{Name: "action", Type: field.TypeString, Size: 50},
+		{Name: "category", Type: field.TypeString, Size: 50, Default: "other"},
 		{Name: "is_system", Type: field.TypeBool, Default: false},
+		{Name: "created_by", Type: field.TypeUint, Nullable: true},
+		{Name: "updated_by", Type: field.TypeUint, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -47,15 +117,65 @@ var (
 				Columns: []*schema.Column{PermissionsColumns[4], PermissionsColumns[5]},
 			},
 			{
-				Name:    "permission_is_system",
+				Name:    "permission_category",
 				Unique:  false,
 				Columns: []*schema.Column{PermissionsColumns[6]},
 			},
 			{
-				Name:    "permission_created_at",
+				Name:    "permission_is_system",
 				Unique:  false,
 				Columns: []*schema.Column{PermissionsColumns[7]},
 			},
+			{
+				Name:    "permission_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PermissionsColumns[10]},
+			},
+		},
+	}
+	// RecurringPushesColumns holds the columns for the "recurring_pushes" table.
+	RecurringPushesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "cron_expr", Type: field.TypeString, Size: 100},
+		{Name: "provider", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "body", Type: field.TypeString, Size: 1000},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "sound", Type: field.TypeString, Nullable: true},
+		{Name: "icon", Type: field.TypeString, Nullable: true},
+		{Name: "group", Type: field.TypeString, Nullable: true},
+		{Name: "level", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint},
+	}
+	// RecurringPushesTable holds the schema information for the "recurring_pushes" table.
+	RecurringPushesTable = &schema.Table{
+		Name:       "recurring_pushes",
+		Columns:    RecurringPushesColumns,
+		PrimaryKey: []*schema.Column{RecurringPushesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recurring_pushes_users_user",
+				Columns:    []*schema.Column{RecurringPushesColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recurringpush_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RecurringPushesColumns[15]},
+			},
+			{
+				Name:    "recurringpush_enabled_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{RecurringPushesColumns[10], RecurringPushesColumns[11]},
+			},
 		},
 	}
 	// RolesColumns holds the columns for the "roles" table.
@@ -65,6 +185,8 @@ var (
 		{Name: "display_name", Type: field.TypeString, Size: 100},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500},
 		{Name: "is_system", Type: field.TypeBool, Default: false},
+		{Name: "created_by", Type: field.TypeUint, Nullable: true},
+		{Name: "updated_by", Type: field.TypeUint, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -87,7 +209,7 @@ var (
 			{
 				Name:    "role_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RolesColumns[5]},
+				Columns: []*schema.Column{RolesColumns[7]},
 			},
 		},
 	}
@@ -152,6 +274,51 @@ var (
 			},
 		},
 	}
+	// ScheduledPushesColumns holds the columns for the "scheduled_pushes" table.
+	ScheduledPushesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "provider", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "body", Type: field.TypeString, Size: 1000},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "sound", Type: field.TypeString, Nullable: true},
+		{Name: "icon", Type: field.TypeString, Nullable: true},
+		{Name: "group", Type: field.TypeString, Nullable: true},
+		{Name: "level", Type: field.TypeString, Nullable: true},
+		{Name: "send_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sending", "sent", "failed", "cancelled"}, Default: "pending"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint},
+	}
+	// ScheduledPushesTable holds the schema information for the "scheduled_pushes" table.
+	ScheduledPushesTable = &schema.Table{
+		Name:       "scheduled_pushes",
+		Columns:    ScheduledPushesColumns,
+		PrimaryKey: []*schema.Column{ScheduledPushesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scheduled_pushes_users_user",
+				Columns:    []*schema.Column{ScheduledPushesColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledpush_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledPushesColumns[15]},
+			},
+			{
+				Name:    "scheduledpush_status_send_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledPushesColumns[10], ScheduledPushesColumns[9]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -160,7 +327,13 @@ var (
 		{Name: "password", Type: field.TypeString},
 		{Name: "nickname", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "avatar", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "push_default_group", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "push_default_sound", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "push_default_level", Type: field.TypeString, Nullable: true, Size: 20},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "banned"}, Default: "active"},
+		{Name: "status_reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "status_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ban_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -183,23 +356,25 @@ var (
 			{
 				Name:    "user_status",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[6]},
+				Columns: []*schema.Column{UsersColumns[9]},
 			},
 			{
 				Name:    "user_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[7]},
+				Columns: []*schema.Column{UsersColumns[13]},
 			},
 		},
 	}
 	// UserPushSettingsColumns holds the columns for the "user_push_settings" table.
 	UserPushSettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
-		{Name: "provider", Type: field.TypeEnum, Enums: []string{"bark"}},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"bark", "email"}},
 		{Name: "enabled", Type: field.TypeBool, Default: false},
 		{Name: "device_id", Type: field.TypeString},
 		{Name: "device_name", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_failure_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeUint},
@@ -212,7 +387,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_push_settings_users_user",
-				Columns:    []*schema.Column{UserPushSettingsColumns[8]},
+				Columns:    []*schema.Column{UserPushSettingsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -221,18 +396,28 @@ var (
 			{
 				Name:    "userpushsetting_user_id_provider",
 				Unique:  false,
-				Columns: []*schema.Column{UserPushSettingsColumns[8], UserPushSettingsColumns[1]},
+				Columns: []*schema.Column{UserPushSettingsColumns[10], UserPushSettingsColumns[1]},
 			},
 			{
 				Name:    "userpushsetting_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserPushSettingsColumns[8]},
+				Columns: []*schema.Column{UserPushSettingsColumns[10]},
+			},
+			{
+				Name:    "userpushsetting_user_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{UserPushSettingsColumns[10], UserPushSettingsColumns[2]},
 			},
 			{
 				Name:    "userpushsetting_provider",
 				Unique:  false,
 				Columns: []*schema.Column{UserPushSettingsColumns[1]},
 			},
+			{
+				Name:    "userpushsetting_device_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserPushSettingsColumns[3]},
+			},
 			{
 				Name:    "userpushsetting_enabled",
 				Unique:  false,
@@ -241,7 +426,7 @@ var (
 			{
 				Name:    "userpushsetting_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UserPushSettingsColumns[6]},
+				Columns: []*schema.Column{UserPushSettingsColumns[8]},
 			},
 			{
 				Name:    "userpushsetting_provider_device_id",
@@ -254,6 +439,7 @@ var (
 	UserRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
 		{Name: "assigned_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_id", Type: field.TypeUint},
 		{Name: "role_id", Type: field.TypeUint},
 		{Name: "assigned_by", Type: field.TypeUint, Nullable: true},
@@ -266,19 +452,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_roles_users_user",
-				Columns:    []*schema.Column{UserRolesColumns[2]},
+				Columns:    []*schema.Column{UserRolesColumns[3]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "user_roles_roles_role",
-				Columns:    []*schema.Column{UserRolesColumns[3]},
+				Columns:    []*schema.Column{UserRolesColumns[4]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "user_roles_users_assigner",
-				Columns:    []*schema.Column{UserRolesColumns[4]},
+				Columns:    []*schema.Column{UserRolesColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -287,47 +473,136 @@ var (
 			{
 				Name:    "userrole_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserRolesColumns[2]},
+				Columns: []*schema.Column{UserRolesColumns[3]},
 			},
 			{
 				Name:    "userrole_role_id",
 				Unique:  false,
-				Columns: []*schema.Column{UserRolesColumns[3]},
+				Columns: []*schema.Column{UserRolesColumns[4]},
 			},
 			{
 				Name:    "userrole_user_id_role_id",
 				Unique:  true,
-				Columns: []*schema.Column{UserRolesColumns[2], UserRolesColumns[3]},
+				Columns: []*schema.Column{UserRolesColumns[3], UserRolesColumns[4]},
 			},
 			{
 				Name:    "userrole_assigned_by",
 				Unique:  false,
-				Columns: []*schema.Column{UserRolesColumns[4]},
+				Columns: []*schema.Column{UserRolesColumns[5]},
 			},
 			{
 				Name:    "userrole_assigned_at",
 				Unique:  false,
 				Columns: []*schema.Column{UserRolesColumns[1]},
 			},
+			{
+				Name:    "userrole_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserRolesColumns[2]},
+			},
+		},
+	}
+	// UserSessionsColumns holds the columns for the "user_sessions" table.
+	UserSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "refresh_token_id", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUint},
+	}
+	// UserSessionsTable holds the schema information for the "user_sessions" table.
+	UserSessionsTable = &schema.Table{
+		Name:       "user_sessions",
+		Columns:    UserSessionsColumns,
+		PrimaryKey: []*schema.Column{UserSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_sessions_users_user",
+				Columns:    []*schema.Column{UserSessionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usersession_user_id_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserSessionsColumns[7], UserSessionsColumns[6]},
+			},
+			{
+				Name:    "usersession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserSessionsColumns[6]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "delivery_id", Type: field.TypeString, Size: 64},
+		{Name: "event_id", Type: field.TypeString, Size: 64},
+		{Name: "event", Type: field.TypeString, Size: 64},
+		{Name: "url", Type: field.TypeString, Size: 500},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "success", Type: field.TypeBool, Default: false},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "duration_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_delivery_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_event",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3]},
+			},
+			{
+				Name:    "webhookdelivery_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		JobsTable,
+		LiveSubscriptionsTable,
 		PermissionsTable,
+		RecurringPushesTable,
 		RolesTable,
 		RolePermissionsTable,
+		ScheduledPushesTable,
 		UsersTable,
 		UserPushSettingsTable,
 		UserRolesTable,
+		UserSessionsTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
+	LiveSubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
+	RecurringPushesTable.ForeignKeys[0].RefTable = UsersTable
 	RolePermissionsTable.ForeignKeys[0].RefTable = RolesTable
 	RolePermissionsTable.ForeignKeys[1].RefTable = PermissionsTable
 	RolePermissionsTable.ForeignKeys[2].RefTable = UsersTable
+	ScheduledPushesTable.ForeignKeys[0].RefTable = UsersTable
 	UserPushSettingsTable.ForeignKeys[0].RefTable = UsersTable
 	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
 	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
 	UserRolesTable.ForeignKeys[2].RefTable = UsersTable
+	UserSessionsTable.ForeignKeys[0].RefTable = UsersTable
 }
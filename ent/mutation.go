@@ -6,13 +6,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nebula-live/ent/job"
+	"nebula-live/ent/livesubscription"
 	"nebula-live/ent/permission"
 	"nebula-live/ent/predicate"
+	"nebula-live/ent/recurringpush"
 	"nebula-live/ent/role"
 	"nebula-live/ent/rolepermission"
+	"nebula-live/ent/scheduledpush"
 	"nebula-live/ent/user"
 	"nebula-live/ent/userpushsetting"
 	"nebula-live/ent/userrole"
+	"nebula-live/ent/usersession"
+	"nebula-live/ent/webhookdelivery"
 	"sync"
 	"time"
 
@@ -29,48 +35,56 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypePermission      = "Permission"
-	TypeRole            = "Role"
-	TypeRolePermission  = "RolePermission"
-	TypeUser            = "User"
-	TypeUserPushSetting = "UserPushSetting"
-	TypeUserRole        = "UserRole"
+	TypeJob              = "Job"
+	TypeLiveSubscription = "LiveSubscription"
+	TypePermission       = "Permission"
+	TypeRecurringPush    = "RecurringPush"
+	TypeRole             = "Role"
+	TypeRolePermission   = "RolePermission"
+	TypeScheduledPush    = "ScheduledPush"
+	TypeUser             = "User"
+	TypeUserPushSetting  = "UserPushSetting"
+	TypeUserRole         = "UserRole"
+	TypeUserSession      = "UserSession"
+	TypeWebhookDelivery  = "WebhookDelivery"
 )
 
-// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
-type PermissionMutation struct {
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uint
-	name                    *string
-	display_name            *string
-	description             *string
-	resource                *string
-	action                  *string
-	is_system               *bool
-	created_at              *time.Time
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	role_permissions        map[uint]struct{}
-	removedrole_permissions map[uint]struct{}
-	clearedrole_permissions bool
-	done                    bool
-	oldValue                func(context.Context) (*Permission, error)
-	predicates              []predicate.Permission
+	op            Op
+	typ           string
+	id            *uint
+	_type         *string
+	payload       *string
+	status        *job.Status
+	result        *string
+	error         *string
+	attempts      *int
+	addattempts   *int
+	created_by    *uint
+	addcreated_by *int
+	started_at    *time.Time
+	finished_at   *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Job, error)
+	predicates    []predicate.Job
 }
 
-var _ ent.Mutation = (*PermissionMutation)(nil)
+var _ ent.Mutation = (*JobMutation)(nil)
 
-// permissionOption allows management of the mutation configuration using functional options.
-type permissionOption func(*PermissionMutation)
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
 
-// newPermissionMutation creates new mutation for the Permission entity.
-func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
-	m := &PermissionMutation{
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePermission,
+		typ:           TypeJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -79,20 +93,20 @@ func newPermissionMutation(c config, op Op, opts ...permissionOption) *Permissio
 	return m
 }
 
-// withPermissionID sets the ID field of the mutation.
-func withPermissionID(id uint) permissionOption {
-	return func(m *PermissionMutation) {
+// withJobID sets the ID field of the mutation.
+func withJobID(id uint) jobOption {
+	return func(m *JobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Permission
+			value *Job
 		)
-		m.oldValue = func(ctx context.Context) (*Permission, error) {
+		m.oldValue = func(ctx context.Context) (*Job, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Permission.Get(ctx, id)
+					value, err = m.Client().Job.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -101,10 +115,10 @@ func withPermissionID(id uint) permissionOption {
 	}
 }
 
-// withPermission sets the old Permission of the mutation.
-func withPermission(node *Permission) permissionOption {
-	return func(m *PermissionMutation) {
-		m.oldValue = func(context.Context) (*Permission, error) {
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -113,7 +127,7 @@ func withPermission(node *Permission) permissionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PermissionMutation) Client() *Client {
+func (m JobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -121,7 +135,7 @@ func (m PermissionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PermissionMutation) Tx() (*Tx, error) {
+func (m JobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -131,14 +145,14 @@ func (m PermissionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Permission entities.
-func (m *PermissionMutation) SetID(id uint) {
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PermissionMutation) ID() (id uint, exists bool) {
+func (m *JobMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -149,7 +163,7 @@ func (m *PermissionMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PermissionMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *JobMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -158,376 +172,536 @@ func (m *PermissionMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PermissionMutation) SetName(s string) {
-	m.name = &s
+// SetType sets the "type" field.
+func (m *JobMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PermissionMutation) Name() (r string, exists bool) {
-	v := m.name
+// GetType returns the value of the "type" field in the mutation.
+func (m *JobMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Type, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PermissionMutation) ResetName() {
-	m.name = nil
+// ResetType resets all changes to the "type" field.
+func (m *JobMutation) ResetType() {
+	m._type = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *PermissionMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *PermissionMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.Payload, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *PermissionMutation) ResetDisplayName() {
-	m.display_name = nil
+// ClearPayload clears the value of the "payload" field.
+func (m *JobMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[job.FieldPayload] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *PermissionMutation) SetDescription(s string) {
-	m.description = &s
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *JobMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[job.FieldPayload]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PermissionMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, job.FieldPayload)
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *PermissionMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[permission.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PermissionMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[permission.FieldDescription]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PermissionMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, permission.FieldDescription)
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetResource sets the "resource" field.
-func (m *PermissionMutation) SetResource(s string) {
-	m.resource = &s
+// SetResult sets the "result" field.
+func (m *JobMutation) SetResult(s string) {
+	m.result = &s
 }
 
-// Resource returns the value of the "resource" field in the mutation.
-func (m *PermissionMutation) Resource() (r string, exists bool) {
-	v := m.resource
+// Result returns the value of the "result" field in the mutation.
+func (m *JobMutation) Result() (r string, exists bool) {
+	v := m.result
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResource returns the old "resource" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldResult returns the old "result" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldResource(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldResult(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResource is only allowed on UpdateOne operations")
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResource requires an ID field in the mutation")
+		return v, errors.New("OldResult requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResource: %w", err)
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
 	}
-	return oldValue.Resource, nil
+	return oldValue.Result, nil
 }
 
-// ResetResource resets all changes to the "resource" field.
-func (m *PermissionMutation) ResetResource() {
-	m.resource = nil
+// ClearResult clears the value of the "result" field.
+func (m *JobMutation) ClearResult() {
+	m.result = nil
+	m.clearedFields[job.FieldResult] = struct{}{}
 }
 
-// SetAction sets the "action" field.
-func (m *PermissionMutation) SetAction(s string) {
-	m.action = &s
+// ResultCleared returns if the "result" field was cleared in this mutation.
+func (m *JobMutation) ResultCleared() bool {
+	_, ok := m.clearedFields[job.FieldResult]
+	return ok
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *PermissionMutation) Action() (r string, exists bool) {
-	v := m.action
+// ResetResult resets all changes to the "result" field.
+func (m *JobMutation) ResetResult() {
+	m.result = nil
+	delete(m.clearedFields, job.FieldResult)
+}
+
+// SetError sets the "error" field.
+func (m *JobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAction returns the old "action" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldAction(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.Action, nil
+	return oldValue.Error, nil
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *PermissionMutation) ResetAction() {
-	m.action = nil
+// ClearError clears the value of the "error" field.
+func (m *JobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[job.FieldError] = struct{}{}
 }
 
-// SetIsSystem sets the "is_system" field.
-func (m *PermissionMutation) SetIsSystem(b bool) {
-	m.is_system = &b
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldError]
+	return ok
 }
 
-// IsSystem returns the value of the "is_system" field in the mutation.
-func (m *PermissionMutation) IsSystem() (r bool, exists bool) {
-	v := m.is_system
+// ResetError resets all changes to the "error" field.
+func (m *JobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, job.FieldError)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSystem returns the old "is_system" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.IsSystem, nil
+	return oldValue.Attempts, nil
 }
 
-// ResetIsSystem resets all changes to the "is_system" field.
-func (m *PermissionMutation) ResetIsSystem() {
-	m.is_system = nil
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PermissionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PermissionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *JobMutation) SetCreatedBy(u uint) {
+	m.created_by = &u
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *JobMutation) CreatedBy() (r uint, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobMutation) OldCreatedBy(ctx context.Context) (v *uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PermissionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddCreatedBy adds u to the "created_by" field.
+func (m *JobMutation) AddCreatedBy(u int) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
+	} else {
+		m.addcreated_by = &u
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PermissionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *JobMutation) AddedCreatedBy() (r int, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PermissionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *JobMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[job.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *JobMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[job.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *JobMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, job.FieldCreatedBy)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *JobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Permission entity.
-// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *JobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PermissionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *JobMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[job.FieldStartedAt] = struct{}{}
 }
 
-// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermission entity by ids.
-func (m *PermissionMutation) AddRolePermissionIDs(ids ...uint) {
-	if m.role_permissions == nil {
-		m.role_permissions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.role_permissions[ids[i]] = struct{}{}
-	}
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *JobMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldStartedAt]
+	return ok
 }
 
-// ClearRolePermissions clears the "role_permissions" edge to the RolePermission entity.
-func (m *PermissionMutation) ClearRolePermissions() {
-	m.clearedrole_permissions = true
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, job.FieldStartedAt)
 }
 
-// RolePermissionsCleared reports if the "role_permissions" edge to the RolePermission entity was cleared.
-func (m *PermissionMutation) RolePermissionsCleared() bool {
-	return m.clearedrole_permissions
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// RemoveRolePermissionIDs removes the "role_permissions" edge to the RolePermission entity by IDs.
-func (m *PermissionMutation) RemoveRolePermissionIDs(ids ...uint) {
-	if m.removedrole_permissions == nil {
-		m.removedrole_permissions = make(map[uint]struct{})
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.role_permissions, ids[i])
-		m.removedrole_permissions[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
+	return oldValue.FinishedAt, nil
 }
 
-// RemovedRolePermissions returns the removed IDs of the "role_permissions" edge to the RolePermission entity.
-func (m *PermissionMutation) RemovedRolePermissionsIDs() (ids []uint) {
-	for id := range m.removedrole_permissions {
-		ids = append(ids, id)
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[job.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, job.FieldFinishedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RolePermissionsIDs returns the "role_permissions" edge IDs in the mutation.
-func (m *PermissionMutation) RolePermissionsIDs() (ids []uint) {
-	for id := range m.role_permissions {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetRolePermissions resets all changes to the "role_permissions" edge.
-func (m *PermissionMutation) ResetRolePermissions() {
-	m.role_permissions = nil
-	m.clearedrole_permissions = false
-	m.removedrole_permissions = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the PermissionMutation builder.
-func (m *PermissionMutation) Where(ps ...predicate.Permission) {
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Permission, len(ps))
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -535,48 +709,57 @@ func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PermissionMutation) Op() Op {
+func (m *JobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PermissionMutation) SetOp(op Op) {
+func (m *JobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Permission).
-func (m *PermissionMutation) Type() string {
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PermissionMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, permission.FieldName)
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m._type != nil {
+		fields = append(fields, job.FieldType)
 	}
-	if m.display_name != nil {
-		fields = append(fields, permission.FieldDisplayName)
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
 	}
-	if m.description != nil {
-		fields = append(fields, permission.FieldDescription)
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
 	}
-	if m.resource != nil {
-		fields = append(fields, permission.FieldResource)
+	if m.result != nil {
+		fields = append(fields, job.FieldResult)
 	}
-	if m.action != nil {
-		fields = append(fields, permission.FieldAction)
+	if m.error != nil {
+		fields = append(fields, job.FieldError)
 	}
-	if m.is_system != nil {
-		fields = append(fields, permission.FieldIsSystem)
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.created_by != nil {
+		fields = append(fields, job.FieldCreatedBy)
+	}
+	if m.started_at != nil {
+		fields = append(fields, job.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, job.FieldFinishedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, permission.FieldCreatedAt)
+		fields = append(fields, job.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, permission.FieldUpdatedAt)
+		fields = append(fields, job.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -584,23 +767,29 @@ func (m *PermissionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case permission.FieldName:
-		return m.Name()
-	case permission.FieldDisplayName:
-		return m.DisplayName()
-	case permission.FieldDescription:
-		return m.Description()
-	case permission.FieldResource:
-		return m.Resource()
-	case permission.FieldAction:
-		return m.Action()
-	case permission.FieldIsSystem:
-		return m.IsSystem()
-	case permission.FieldCreatedAt:
+	case job.FieldType:
+		return m.GetType()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldResult:
+		return m.Result()
+	case job.FieldError:
+		return m.Error()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldCreatedBy:
+		return m.CreatedBy()
+	case job.FieldStartedAt:
+		return m.StartedAt()
+	case job.FieldFinishedAt:
+		return m.FinishedAt()
+	case job.FieldCreatedAt:
 		return m.CreatedAt()
-	case permission.FieldUpdatedAt:
+	case job.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -609,83 +798,110 @@ func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case permission.FieldName:
-		return m.OldName(ctx)
-	case permission.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case permission.FieldDescription:
-		return m.OldDescription(ctx)
-	case permission.FieldResource:
-		return m.OldResource(ctx)
-	case permission.FieldAction:
-		return m.OldAction(ctx)
-	case permission.FieldIsSystem:
-		return m.OldIsSystem(ctx)
-	case permission.FieldCreatedAt:
+	case job.FieldType:
+		return m.OldType(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldResult:
+		return m.OldResult(ctx)
+	case job.FieldError:
+		return m.OldError(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case job.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case job.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case job.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case permission.FieldUpdatedAt:
+	case job.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Permission field %s", name)
+	return nil, fmt.Errorf("unknown Job field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionMutation) SetField(name string, value ent.Value) error {
+func (m *JobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case permission.FieldName:
+	case job.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetType(v)
 		return nil
-	case permission.FieldDisplayName:
+	case job.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetPayload(v)
 		return nil
-	case permission.FieldDescription:
-		v, ok := value.(string)
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetStatus(v)
 		return nil
-	case permission.FieldResource:
+	case job.FieldResult:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResource(v)
+		m.SetResult(v)
 		return nil
-	case permission.FieldAction:
+	case job.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAction(v)
+		m.SetError(v)
 		return nil
-	case permission.FieldIsSystem:
-		v, ok := value.(bool)
+	case job.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsSystem(v)
+		m.SetAttempts(v)
 		return nil
-	case permission.FieldCreatedAt:
+	case job.FieldCreatedBy:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case job.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case job.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case job.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case permission.FieldUpdatedAt:
+	case job.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -693,210 +909,233 @@ func (m *PermissionMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return fmt.Errorf("unknown Job field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PermissionMutation) AddedFields() []string {
-	return nil
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.addcreated_by != nil {
+		fields = append(fields, job.FieldCreatedBy)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	case job.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionMutation) AddField(name string, value ent.Value) error {
+func (m *JobMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case job.FieldCreatedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Permission numeric field %s", name)
+	return fmt.Errorf("unknown Job numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PermissionMutation) ClearedFields() []string {
+func (m *JobMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(permission.FieldDescription) {
-		fields = append(fields, permission.FieldDescription)
+	if m.FieldCleared(job.FieldPayload) {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.FieldCleared(job.FieldResult) {
+		fields = append(fields, job.FieldResult)
+	}
+	if m.FieldCleared(job.FieldError) {
+		fields = append(fields, job.FieldError)
+	}
+	if m.FieldCleared(job.FieldCreatedBy) {
+		fields = append(fields, job.FieldCreatedBy)
+	}
+	if m.FieldCleared(job.FieldStartedAt) {
+		fields = append(fields, job.FieldStartedAt)
+	}
+	if m.FieldCleared(job.FieldFinishedAt) {
+		fields = append(fields, job.FieldFinishedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PermissionMutation) FieldCleared(name string) bool {
+func (m *JobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PermissionMutation) ClearField(name string) error {
+func (m *JobMutation) ClearField(name string) error {
 	switch name {
-	case permission.FieldDescription:
-		m.ClearDescription()
+	case job.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case job.FieldResult:
+		m.ClearResult()
+		return nil
+	case job.FieldError:
+		m.ClearError()
+		return nil
+	case job.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case job.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ClearFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Permission nullable field %s", name)
+	return fmt.Errorf("unknown Job nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PermissionMutation) ResetField(name string) error {
+func (m *JobMutation) ResetField(name string) error {
 	switch name {
-	case permission.FieldName:
-		m.ResetName()
+	case job.FieldType:
+		m.ResetType()
 		return nil
-	case permission.FieldDisplayName:
-		m.ResetDisplayName()
+	case job.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case permission.FieldDescription:
-		m.ResetDescription()
+	case job.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case permission.FieldResource:
-		m.ResetResource()
+	case job.FieldResult:
+		m.ResetResult()
 		return nil
-	case permission.FieldAction:
-		m.ResetAction()
+	case job.FieldError:
+		m.ResetError()
 		return nil
-	case permission.FieldIsSystem:
-		m.ResetIsSystem()
+	case job.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case permission.FieldCreatedAt:
+	case job.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case job.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case job.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case permission.FieldUpdatedAt:
+	case job.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Permission field %s", name)
+	return fmt.Errorf("unknown Job field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PermissionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.role_permissions != nil {
-		edges = append(edges, permission.EdgeRolePermissions)
-	}
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case permission.EdgeRolePermissions:
-		ids := make([]ent.Value, 0, len(m.role_permissions))
-		for id := range m.role_permissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PermissionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedrole_permissions != nil {
-		edges = append(edges, permission.EdgeRolePermissions)
-	}
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case permission.EdgeRolePermissions:
-		ids := make([]ent.Value, 0, len(m.removedrole_permissions))
-		for id := range m.removedrole_permissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PermissionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedrole_permissions {
-		edges = append(edges, permission.EdgeRolePermissions)
-	}
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PermissionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case permission.EdgeRolePermissions:
-		return m.clearedrole_permissions
-	}
+func (m *JobMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PermissionMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Permission unique edge %s", name)
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PermissionMutation) ResetEdge(name string) error {
-	switch name {
-	case permission.EdgeRolePermissions:
-		m.ResetRolePermissions()
-		return nil
-	}
-	return fmt.Errorf("unknown Permission edge %s", name)
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
 }
 
-// RoleMutation represents an operation that mutates the Role nodes in the graph.
-type RoleMutation struct {
+// LiveSubscriptionMutation represents an operation that mutates the LiveSubscription nodes in the graph.
+type LiveSubscriptionMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uint
-	name                    *string
-	display_name            *string
-	description             *string
-	is_system               *bool
-	created_at              *time.Time
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	user_roles              map[uint]struct{}
-	removeduser_roles       map[uint]struct{}
-	cleareduser_roles       bool
-	role_permissions        map[uint]struct{}
-	removedrole_permissions map[uint]struct{}
-	clearedrole_permissions bool
-	done                    bool
-	oldValue                func(context.Context) (*Role, error)
-	predicates              []predicate.Role
+	op            Op
+	typ           string
+	id            *uint
+	platform      *string
+	room_id       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uint
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*LiveSubscription, error)
+	predicates    []predicate.LiveSubscription
 }
 
-var _ ent.Mutation = (*RoleMutation)(nil)
+var _ ent.Mutation = (*LiveSubscriptionMutation)(nil)
 
-// roleOption allows management of the mutation configuration using functional options.
-type roleOption func(*RoleMutation)
+// livesubscriptionOption allows management of the mutation configuration using functional options.
+type livesubscriptionOption func(*LiveSubscriptionMutation)
 
-// newRoleMutation creates new mutation for the Role entity.
-func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
-	m := &RoleMutation{
+// newLiveSubscriptionMutation creates new mutation for the LiveSubscription entity.
+func newLiveSubscriptionMutation(c config, op Op, opts ...livesubscriptionOption) *LiveSubscriptionMutation {
+	m := &LiveSubscriptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRole,
+		typ:           TypeLiveSubscription,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -905,20 +1144,20 @@ func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
 	return m
 }
 
-// withRoleID sets the ID field of the mutation.
-func withRoleID(id uint) roleOption {
-	return func(m *RoleMutation) {
+// withLiveSubscriptionID sets the ID field of the mutation.
+func withLiveSubscriptionID(id uint) livesubscriptionOption {
+	return func(m *LiveSubscriptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Role
+			value *LiveSubscription
 		)
-		m.oldValue = func(ctx context.Context) (*Role, error) {
+		m.oldValue = func(ctx context.Context) (*LiveSubscription, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Role.Get(ctx, id)
+					value, err = m.Client().LiveSubscription.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -927,10 +1166,10 @@ func withRoleID(id uint) roleOption {
 	}
 }
 
-// withRole sets the old Role of the mutation.
-func withRole(node *Role) roleOption {
-	return func(m *RoleMutation) {
-		m.oldValue = func(context.Context) (*Role, error) {
+// withLiveSubscription sets the old LiveSubscription of the mutation.
+func withLiveSubscription(node *LiveSubscription) livesubscriptionOption {
+	return func(m *LiveSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*LiveSubscription, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -939,7 +1178,7 @@ func withRole(node *Role) roleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleMutation) Client() *Client {
+func (m LiveSubscriptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -947,7 +1186,7 @@ func (m RoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoleMutation) Tx() (*Tx, error) {
+func (m LiveSubscriptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -957,14 +1196,14 @@ func (m RoleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Role entities.
-func (m *RoleMutation) SetID(id uint) {
+// operation is only accepted on creation of LiveSubscription entities.
+func (m *LiveSubscriptionMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoleMutation) ID() (id uint, exists bool) {
+func (m *LiveSubscriptionMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -975,7 +1214,7 @@ func (m *RoleMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoleMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *LiveSubscriptionMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -984,176 +1223,127 @@ func (m *RoleMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().LiveSubscription.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *RoleMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *RoleMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *RoleMutation) ResetName() {
-	m.name = nil
-}
-
-// SetDisplayName sets the "display_name" field.
-func (m *RoleMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetUserID sets the "user_id" field.
+func (m *LiveSubscriptionMutation) SetUserID(u uint) {
+	m.user = &u
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *RoleMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LiveSubscriptionMutation) UserID() (r uint, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the LiveSubscription entity.
+// If the LiveSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *LiveSubscriptionMutation) OldUserID(ctx context.Context) (v uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.UserID, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *RoleMutation) ResetDisplayName() {
-	m.display_name = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LiveSubscriptionMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *RoleMutation) SetDescription(s string) {
-	m.description = &s
+// SetPlatform sets the "platform" field.
+func (m *LiveSubscriptionMutation) SetPlatform(s string) {
+	m.platform = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *RoleMutation) Description() (r string, exists bool) {
-	v := m.description
+// Platform returns the value of the "platform" field in the mutation.
+func (m *LiveSubscriptionMutation) Platform() (r string, exists bool) {
+	v := m.platform
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldPlatform returns the old "platform" field's value of the LiveSubscription entity.
+// If the LiveSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *LiveSubscriptionMutation) OldPlatform(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *RoleMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[role.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RoleMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[role.FieldDescription]
-	return ok
+	return oldValue.Platform, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *RoleMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, role.FieldDescription)
+// ResetPlatform resets all changes to the "platform" field.
+func (m *LiveSubscriptionMutation) ResetPlatform() {
+	m.platform = nil
 }
 
-// SetIsSystem sets the "is_system" field.
-func (m *RoleMutation) SetIsSystem(b bool) {
-	m.is_system = &b
+// SetRoomID sets the "room_id" field.
+func (m *LiveSubscriptionMutation) SetRoomID(s string) {
+	m.room_id = &s
 }
 
-// IsSystem returns the value of the "is_system" field in the mutation.
-func (m *RoleMutation) IsSystem() (r bool, exists bool) {
-	v := m.is_system
+// RoomID returns the value of the "room_id" field in the mutation.
+func (m *LiveSubscriptionMutation) RoomID() (r string, exists bool) {
+	v := m.room_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSystem returns the old "is_system" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldRoomID returns the old "room_id" field's value of the LiveSubscription entity.
+// If the LiveSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+func (m *LiveSubscriptionMutation) OldRoomID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoomID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+		return v, errors.New("OldRoomID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoomID: %w", err)
 	}
-	return oldValue.IsSystem, nil
+	return oldValue.RoomID, nil
 }
 
-// ResetIsSystem resets all changes to the "is_system" field.
-func (m *RoleMutation) ResetIsSystem() {
-	m.is_system = nil
+// ResetRoomID resets all changes to the "room_id" field.
+func (m *LiveSubscriptionMutation) ResetRoomID() {
+	m.room_id = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RoleMutation) SetCreatedAt(t time.Time) {
+func (m *LiveSubscriptionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *LiveSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1161,10 +1351,10 @@ func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the LiveSubscription entity.
+// If the LiveSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LiveSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1179,163 +1369,46 @@ func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoleMutation) ResetCreatedAt() {
+func (m *LiveSubscriptionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearUser clears the "user" edge to the User entity.
+func (m *LiveSubscriptionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[livesubscription.FieldUserID] = struct{}{}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LiveSubscriptionMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LiveSubscriptionMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *LiveSubscriptionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// AddUserRoleIDs adds the "user_roles" edge to the UserRole entity by ids.
-func (m *RoleMutation) AddUserRoleIDs(ids ...uint) {
-	if m.user_roles == nil {
-		m.user_roles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.user_roles[ids[i]] = struct{}{}
-	}
+// Where appends a list predicates to the LiveSubscriptionMutation builder.
+func (m *LiveSubscriptionMutation) Where(ps ...predicate.LiveSubscription) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearUserRoles clears the "user_roles" edge to the UserRole entity.
-func (m *RoleMutation) ClearUserRoles() {
-	m.cleareduser_roles = true
-}
-
-// UserRolesCleared reports if the "user_roles" edge to the UserRole entity was cleared.
-func (m *RoleMutation) UserRolesCleared() bool {
-	return m.cleareduser_roles
-}
-
-// RemoveUserRoleIDs removes the "user_roles" edge to the UserRole entity by IDs.
-func (m *RoleMutation) RemoveUserRoleIDs(ids ...uint) {
-	if m.removeduser_roles == nil {
-		m.removeduser_roles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.user_roles, ids[i])
-		m.removeduser_roles[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRole entity.
-func (m *RoleMutation) RemovedUserRolesIDs() (ids []uint) {
-	for id := range m.removeduser_roles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
-func (m *RoleMutation) UserRolesIDs() (ids []uint) {
-	for id := range m.user_roles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetUserRoles resets all changes to the "user_roles" edge.
-func (m *RoleMutation) ResetUserRoles() {
-	m.user_roles = nil
-	m.cleareduser_roles = false
-	m.removeduser_roles = nil
-}
-
-// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermission entity by ids.
-func (m *RoleMutation) AddRolePermissionIDs(ids ...uint) {
-	if m.role_permissions == nil {
-		m.role_permissions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.role_permissions[ids[i]] = struct{}{}
-	}
-}
-
-// ClearRolePermissions clears the "role_permissions" edge to the RolePermission entity.
-func (m *RoleMutation) ClearRolePermissions() {
-	m.clearedrole_permissions = true
-}
-
-// RolePermissionsCleared reports if the "role_permissions" edge to the RolePermission entity was cleared.
-func (m *RoleMutation) RolePermissionsCleared() bool {
-	return m.clearedrole_permissions
-}
-
-// RemoveRolePermissionIDs removes the "role_permissions" edge to the RolePermission entity by IDs.
-func (m *RoleMutation) RemoveRolePermissionIDs(ids ...uint) {
-	if m.removedrole_permissions == nil {
-		m.removedrole_permissions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.role_permissions, ids[i])
-		m.removedrole_permissions[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedRolePermissions returns the removed IDs of the "role_permissions" edge to the RolePermission entity.
-func (m *RoleMutation) RemovedRolePermissionsIDs() (ids []uint) {
-	for id := range m.removedrole_permissions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// RolePermissionsIDs returns the "role_permissions" edge IDs in the mutation.
-func (m *RoleMutation) RolePermissionsIDs() (ids []uint) {
-	for id := range m.role_permissions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetRolePermissions resets all changes to the "role_permissions" edge.
-func (m *RoleMutation) ResetRolePermissions() {
-	m.role_permissions = nil
-	m.clearedrole_permissions = false
-	m.removedrole_permissions = nil
-}
-
-// Where appends a list predicates to the RoleMutation builder.
-func (m *RoleMutation) Where(ps ...predicate.Role) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the LiveSubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Role, len(ps))
+func (m *LiveSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LiveSubscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1343,42 +1416,36 @@ func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleMutation) Op() Op {
+func (m *LiveSubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleMutation) SetOp(op Op) {
+func (m *LiveSubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Role).
-func (m *RoleMutation) Type() string {
+// Type returns the node type of this mutation (LiveSubscription).
+func (m *LiveSubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, role.FieldName)
-	}
-	if m.display_name != nil {
-		fields = append(fields, role.FieldDisplayName)
+func (m *LiveSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user != nil {
+		fields = append(fields, livesubscription.FieldUserID)
 	}
-	if m.description != nil {
-		fields = append(fields, role.FieldDescription)
+	if m.platform != nil {
+		fields = append(fields, livesubscription.FieldPlatform)
 	}
-	if m.is_system != nil {
-		fields = append(fields, role.FieldIsSystem)
+	if m.room_id != nil {
+		fields = append(fields, livesubscription.FieldRoomID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, role.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, role.FieldUpdatedAt)
+		fields = append(fields, livesubscription.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1386,20 +1453,16 @@ func (m *RoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+func (m *LiveSubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldName:
-		return m.Name()
-	case role.FieldDisplayName:
-		return m.DisplayName()
-	case role.FieldDescription:
-		return m.Description()
-	case role.FieldIsSystem:
-		return m.IsSystem()
-	case role.FieldCreatedAt:
+	case livesubscription.FieldUserID:
+		return m.UserID()
+	case livesubscription.FieldPlatform:
+		return m.Platform()
+	case livesubscription.FieldRoomID:
+		return m.RoomID()
+	case livesubscription.FieldCreatedAt:
 		return m.CreatedAt()
-	case role.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1407,291 +1470,234 @@ func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LiveSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case role.FieldName:
-		return m.OldName(ctx)
-	case role.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case role.FieldDescription:
-		return m.OldDescription(ctx)
-	case role.FieldIsSystem:
-		return m.OldIsSystem(ctx)
-	case role.FieldCreatedAt:
+	case livesubscription.FieldUserID:
+		return m.OldUserID(ctx)
+	case livesubscription.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case livesubscription.FieldRoomID:
+		return m.OldRoomID(ctx)
+	case livesubscription.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case role.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Role field %s", name)
+	return nil, fmt.Errorf("unknown LiveSubscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) SetField(name string, value ent.Value) error {
+func (m *LiveSubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldName:
-		v, ok := value.(string)
+	case livesubscription.FieldUserID:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetUserID(v)
 		return nil
-	case role.FieldDisplayName:
+	case livesubscription.FieldPlatform:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetPlatform(v)
 		return nil
-	case role.FieldDescription:
+	case livesubscription.FieldRoomID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case role.FieldIsSystem:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsSystem(v)
+		m.SetRoomID(v)
 		return nil
-	case role.FieldCreatedAt:
+	case livesubscription.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case role.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown LiveSubscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleMutation) AddedFields() []string {
-	return nil
+func (m *LiveSubscriptionMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *LiveSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) AddField(name string, value ent.Value) error {
+func (m *LiveSubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Role numeric field %s", name)
+	return fmt.Errorf("unknown LiveSubscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(role.FieldDescription) {
-		fields = append(fields, role.FieldDescription)
-	}
-	return fields
+func (m *LiveSubscriptionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleMutation) FieldCleared(name string) bool {
+func (m *LiveSubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleMutation) ClearField(name string) error {
-	switch name {
-	case role.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Role nullable field %s", name)
+func (m *LiveSubscriptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LiveSubscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleMutation) ResetField(name string) error {
+func (m *LiveSubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case role.FieldName:
-		m.ResetName()
-		return nil
-	case role.FieldDisplayName:
-		m.ResetDisplayName()
+	case livesubscription.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case role.FieldDescription:
-		m.ResetDescription()
+	case livesubscription.FieldPlatform:
+		m.ResetPlatform()
 		return nil
-	case role.FieldIsSystem:
-		m.ResetIsSystem()
+	case livesubscription.FieldRoomID:
+		m.ResetRoomID()
 		return nil
-	case role.FieldCreatedAt:
+	case livesubscription.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case role.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown LiveSubscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user_roles != nil {
-		edges = append(edges, role.EdgeUserRoles)
-	}
-	if m.role_permissions != nil {
-		edges = append(edges, role.EdgeRolePermissions)
+func (m *LiveSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, livesubscription.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+func (m *LiveSubscriptionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.user_roles))
-		for id := range m.user_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case role.EdgeRolePermissions:
-		ids := make([]ent.Value, 0, len(m.role_permissions))
-		for id := range m.role_permissions {
-			ids = append(ids, id)
+	case livesubscription.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeduser_roles != nil {
-		edges = append(edges, role.EdgeUserRoles)
-	}
-	if m.removedrole_permissions != nil {
-		edges = append(edges, role.EdgeRolePermissions)
-	}
+func (m *LiveSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case role.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.removeduser_roles))
-		for id := range m.removeduser_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case role.EdgeRolePermissions:
-		ids := make([]ent.Value, 0, len(m.removedrole_permissions))
-		for id := range m.removedrole_permissions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *LiveSubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser_roles {
-		edges = append(edges, role.EdgeUserRoles)
-	}
-	if m.clearedrole_permissions {
-		edges = append(edges, role.EdgeRolePermissions)
+func (m *LiveSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, livesubscription.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleMutation) EdgeCleared(name string) bool {
+func (m *LiveSubscriptionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case role.EdgeUserRoles:
-		return m.cleareduser_roles
-	case role.EdgeRolePermissions:
-		return m.clearedrole_permissions
+	case livesubscription.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleMutation) ClearEdge(name string) error {
+func (m *LiveSubscriptionMutation) ClearEdge(name string) error {
 	switch name {
+	case livesubscription.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown Role unique edge %s", name)
+	return fmt.Errorf("unknown LiveSubscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoleMutation) ResetEdge(name string) error {
+func (m *LiveSubscriptionMutation) ResetEdge(name string) error {
 	switch name {
-	case role.EdgeUserRoles:
-		m.ResetUserRoles()
-		return nil
-	case role.EdgeRolePermissions:
-		m.ResetRolePermissions()
+	case livesubscription.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Role edge %s", name)
+	return fmt.Errorf("unknown LiveSubscription edge %s", name)
 }
 
-// RolePermissionMutation represents an operation that mutates the RolePermission nodes in the graph.
-type RolePermissionMutation struct {
+// PermissionMutation represents an operation that mutates the Permission nodes in the graph.
+type PermissionMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uint
-	assigned_at       *time.Time
-	clearedFields     map[string]struct{}
-	role              *uint
-	clearedrole       bool
-	permission        *uint
-	clearedpermission bool
-	assigner          *uint
-	clearedassigner   bool
-	done              bool
-	oldValue          func(context.Context) (*RolePermission, error)
-	predicates        []predicate.RolePermission
+	op                      Op
+	typ                     string
+	id                      *uint
+	name                    *string
+	display_name            *string
+	description             *string
+	resource                *string
+	action                  *string
+	category                *string
+	is_system               *bool
+	created_by              *uint
+	addcreated_by           *int
+	updated_by              *uint
+	addupdated_by           *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	role_permissions        map[uint]struct{}
+	removedrole_permissions map[uint]struct{}
+	clearedrole_permissions bool
+	done                    bool
+	oldValue                func(context.Context) (*Permission, error)
+	predicates              []predicate.Permission
 }
 
-var _ ent.Mutation = (*RolePermissionMutation)(nil)
+var _ ent.Mutation = (*PermissionMutation)(nil)
 
-// rolepermissionOption allows management of the mutation configuration using functional options.
-type rolepermissionOption func(*RolePermissionMutation)
+// permissionOption allows management of the mutation configuration using functional options.
+type permissionOption func(*PermissionMutation)
 
-// newRolePermissionMutation creates new mutation for the RolePermission entity.
-func newRolePermissionMutation(c config, op Op, opts ...rolepermissionOption) *RolePermissionMutation {
-	m := &RolePermissionMutation{
+// newPermissionMutation creates new mutation for the Permission entity.
+func newPermissionMutation(c config, op Op, opts ...permissionOption) *PermissionMutation {
+	m := &PermissionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRolePermission,
+		typ:           TypePermission,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1700,20 +1706,20 @@ func newRolePermissionMutation(c config, op Op, opts ...rolepermissionOption) *R
 	return m
 }
 
-// withRolePermissionID sets the ID field of the mutation.
-func withRolePermissionID(id uint) rolepermissionOption {
-	return func(m *RolePermissionMutation) {
+// withPermissionID sets the ID field of the mutation.
+func withPermissionID(id uint) permissionOption {
+	return func(m *PermissionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RolePermission
+			value *Permission
 		)
-		m.oldValue = func(ctx context.Context) (*RolePermission, error) {
+		m.oldValue = func(ctx context.Context) (*Permission, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RolePermission.Get(ctx, id)
+					value, err = m.Client().Permission.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1722,10 +1728,10 @@ func withRolePermissionID(id uint) rolepermissionOption {
 	}
 }
 
-// withRolePermission sets the old RolePermission of the mutation.
-func withRolePermission(node *RolePermission) rolepermissionOption {
-	return func(m *RolePermissionMutation) {
-		m.oldValue = func(context.Context) (*RolePermission, error) {
+// withPermission sets the old Permission of the mutation.
+func withPermission(node *Permission) permissionOption {
+	return func(m *PermissionMutation) {
+		m.oldValue = func(context.Context) (*Permission, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1734,7 +1740,7 @@ func withRolePermission(node *RolePermission) rolepermissionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RolePermissionMutation) Client() *Client {
+func (m PermissionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1742,7 +1748,7 @@ func (m RolePermissionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RolePermissionMutation) Tx() (*Tx, error) {
+func (m PermissionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1752,14 +1758,14 @@ func (m RolePermissionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RolePermission entities.
-func (m *RolePermissionMutation) SetID(id uint) {
+// operation is only accepted on creation of Permission entities.
+func (m *PermissionMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RolePermissionMutation) ID() (id uint, exists bool) {
+func (m *PermissionMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1770,7 +1776,7 @@ func (m *RolePermissionMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RolePermissionMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *PermissionMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1779,1242 +1785,8516 @@ func (m *RolePermissionMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RolePermission.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Permission.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRoleID sets the "role_id" field.
-func (m *RolePermissionMutation) SetRoleID(u uint) {
-	m.role = &u
+// SetName sets the "name" field.
+func (m *PermissionMutation) SetName(s string) {
+	m.name = &s
 }
 
-// RoleID returns the value of the "role_id" field in the mutation.
-func (m *RolePermissionMutation) RoleID() (r uint, exists bool) {
-	v := m.role
+// Name returns the value of the "name" field in the mutation.
+func (m *PermissionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoleID returns the old "role_id" field's value of the RolePermission entity.
-// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolePermissionMutation) OldRoleID(ctx context.Context) (v uint, err error) {
+func (m *PermissionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoleID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.RoleID, nil
+	return oldValue.Name, nil
 }
 
-// ResetRoleID resets all changes to the "role_id" field.
-func (m *RolePermissionMutation) ResetRoleID() {
-	m.role = nil
+// ResetName resets all changes to the "name" field.
+func (m *PermissionMutation) ResetName() {
+	m.name = nil
 }
 
-// SetPermissionID sets the "permission_id" field.
-func (m *RolePermissionMutation) SetPermissionID(u uint) {
-	m.permission = &u
+// SetDisplayName sets the "display_name" field.
+func (m *PermissionMutation) SetDisplayName(s string) {
+	m.display_name = &s
 }
 
-// PermissionID returns the value of the "permission_id" field in the mutation.
-func (m *RolePermissionMutation) PermissionID() (r uint, exists bool) {
-	v := m.permission
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *PermissionMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPermissionID returns the old "permission_id" field's value of the RolePermission entity.
-// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// OldDisplayName returns the old "display_name" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolePermissionMutation) OldPermissionID(ctx context.Context) (v uint, err error) {
+func (m *PermissionMutation) OldDisplayName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPermissionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPermissionID requires an ID field in the mutation")
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPermissionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
 	}
-	return oldValue.PermissionID, nil
+	return oldValue.DisplayName, nil
 }
 
-// ResetPermissionID resets all changes to the "permission_id" field.
-func (m *RolePermissionMutation) ResetPermissionID() {
-	m.permission = nil
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *PermissionMutation) ResetDisplayName() {
+	m.display_name = nil
 }
 
-// SetAssignedBy sets the "assigned_by" field.
-func (m *RolePermissionMutation) SetAssignedBy(u uint) {
-	m.assigner = &u
+// SetDescription sets the "description" field.
+func (m *PermissionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// AssignedBy returns the value of the "assigned_by" field in the mutation.
-func (m *RolePermissionMutation) AssignedBy() (r uint, exists bool) {
-	v := m.assigner
+// Description returns the value of the "description" field in the mutation.
+func (m *PermissionMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssignedBy returns the old "assigned_by" field's value of the RolePermission entity.
-// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolePermissionMutation) OldAssignedBy(ctx context.Context) (v uint, err error) {
+func (m *PermissionMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.AssignedBy, nil
+	return oldValue.Description, nil
 }
 
-// ClearAssignedBy clears the value of the "assigned_by" field.
-func (m *RolePermissionMutation) ClearAssignedBy() {
-	m.assigner = nil
-	m.clearedFields[rolepermission.FieldAssignedBy] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *PermissionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[permission.FieldDescription] = struct{}{}
 }
 
-// AssignedByCleared returns if the "assigned_by" field was cleared in this mutation.
-func (m *RolePermissionMutation) AssignedByCleared() bool {
-	_, ok := m.clearedFields[rolepermission.FieldAssignedBy]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PermissionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[permission.FieldDescription]
 	return ok
 }
 
-// ResetAssignedBy resets all changes to the "assigned_by" field.
-func (m *RolePermissionMutation) ResetAssignedBy() {
-	m.assigner = nil
-	delete(m.clearedFields, rolepermission.FieldAssignedBy)
+// ResetDescription resets all changes to the "description" field.
+func (m *PermissionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, permission.FieldDescription)
 }
 
-// SetAssignedAt sets the "assigned_at" field.
-func (m *RolePermissionMutation) SetAssignedAt(t time.Time) {
-	m.assigned_at = &t
+// SetResource sets the "resource" field.
+func (m *PermissionMutation) SetResource(s string) {
+	m.resource = &s
 }
 
-// AssignedAt returns the value of the "assigned_at" field in the mutation.
-func (m *RolePermissionMutation) AssignedAt() (r time.Time, exists bool) {
-	v := m.assigned_at
+// Resource returns the value of the "resource" field in the mutation.
+func (m *PermissionMutation) Resource() (r string, exists bool) {
+	v := m.resource
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssignedAt returns the old "assigned_at" field's value of the RolePermission entity.
-// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// OldResource returns the old "resource" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolePermissionMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PermissionMutation) OldResource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldResource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+		return v, errors.New("OldResource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldResource: %w", err)
 	}
-	return oldValue.AssignedAt, nil
+	return oldValue.Resource, nil
 }
 
-// ResetAssignedAt resets all changes to the "assigned_at" field.
-func (m *RolePermissionMutation) ResetAssignedAt() {
-	m.assigned_at = nil
+// ResetResource resets all changes to the "resource" field.
+func (m *PermissionMutation) ResetResource() {
+	m.resource = nil
 }
 
-// ClearRole clears the "role" edge to the Role entity.
-func (m *RolePermissionMutation) ClearRole() {
-	m.clearedrole = true
-	m.clearedFields[rolepermission.FieldRoleID] = struct{}{}
+// SetAction sets the "action" field.
+func (m *PermissionMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// RoleCleared reports if the "role" edge to the Role entity was cleared.
-func (m *RolePermissionMutation) RoleCleared() bool {
-	return m.clearedrole
+// Action returns the value of the "action" field in the mutation.
+func (m *PermissionMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RoleIDs returns the "role" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoleID instead. It exists only for internal usage by the builders.
-func (m *RolePermissionMutation) RoleIDs() (ids []uint) {
-	if id := m.role; id != nil {
-		ids = append(ids, *id)
+// OldAction returns the old "action" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
 }
 
-// ResetRole resets all changes to the "role" edge.
-func (m *RolePermissionMutation) ResetRole() {
-	m.role = nil
-	m.clearedrole = false
+// ResetAction resets all changes to the "action" field.
+func (m *PermissionMutation) ResetAction() {
+	m.action = nil
 }
 
-// ClearPermission clears the "permission" edge to the Permission entity.
-func (m *RolePermissionMutation) ClearPermission() {
-	m.clearedpermission = true
-	m.clearedFields[rolepermission.FieldPermissionID] = struct{}{}
+// SetCategory sets the "category" field.
+func (m *PermissionMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// PermissionCleared reports if the "permission" edge to the Permission entity was cleared.
-func (m *RolePermissionMutation) PermissionCleared() bool {
-	return m.clearedpermission
+// Category returns the value of the "category" field in the mutation.
+func (m *PermissionMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PermissionIDs returns the "permission" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PermissionID instead. It exists only for internal usage by the builders.
-func (m *RolePermissionMutation) PermissionIDs() (ids []uint) {
-	if id := m.permission; id != nil {
-		ids = append(ids, *id)
+// OldCategory returns the old "category" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
 }
 
-// ResetPermission resets all changes to the "permission" edge.
-func (m *RolePermissionMutation) ResetPermission() {
-	m.permission = nil
-	m.clearedpermission = false
+// ResetCategory resets all changes to the "category" field.
+func (m *PermissionMutation) ResetCategory() {
+	m.category = nil
 }
 
-// SetAssignerID sets the "assigner" edge to the User entity by id.
-func (m *RolePermissionMutation) SetAssignerID(id uint) {
-	m.assigner = &id
+// SetIsSystem sets the "is_system" field.
+func (m *PermissionMutation) SetIsSystem(b bool) {
+	m.is_system = &b
 }
 
-// ClearAssigner clears the "assigner" edge to the User entity.
-func (m *RolePermissionMutation) ClearAssigner() {
-	m.clearedassigner = true
-	m.clearedFields[rolepermission.FieldAssignedBy] = struct{}{}
+// IsSystem returns the value of the "is_system" field in the mutation.
+func (m *PermissionMutation) IsSystem() (r bool, exists bool) {
+	v := m.is_system
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AssignerCleared reports if the "assigner" edge to the User entity was cleared.
-func (m *RolePermissionMutation) AssignerCleared() bool {
-	return m.AssignedByCleared() || m.clearedassigner
+// OldIsSystem returns the old "is_system" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+	}
+	return oldValue.IsSystem, nil
 }
 
-// AssignerID returns the "assigner" edge ID in the mutation.
-func (m *RolePermissionMutation) AssignerID() (id uint, exists bool) {
-	if m.assigner != nil {
-		return *m.assigner, true
-	}
-	return
+// ResetIsSystem resets all changes to the "is_system" field.
+func (m *PermissionMutation) ResetIsSystem() {
+	m.is_system = nil
 }
 
-// AssignerIDs returns the "assigner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AssignerID instead. It exists only for internal usage by the builders.
-func (m *RolePermissionMutation) AssignerIDs() (ids []uint) {
-	if id := m.assigner; id != nil {
-		ids = append(ids, *id)
+// SetCreatedBy sets the "created_by" field.
+func (m *PermissionMutation) SetCreatedBy(u uint) {
+	m.created_by = &u
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *PermissionMutation) CreatedBy() (r uint, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetAssigner resets all changes to the "assigner" edge.
-func (m *RolePermissionMutation) ResetAssigner() {
-	m.assigner = nil
-	m.clearedassigner = false
+// OldCreatedBy returns the old "created_by" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldCreatedBy(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-// Where appends a list predicates to the RolePermissionMutation builder.
-func (m *RolePermissionMutation) Where(ps ...predicate.RolePermission) {
-	m.predicates = append(m.predicates, ps...)
+// AddCreatedBy adds u to the "created_by" field.
+func (m *PermissionMutation) AddCreatedBy(u int) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
+	} else {
+		m.addcreated_by = &u
+	}
 }
 
-// WhereP appends storage-level predicates to the RolePermissionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RolePermissionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RolePermission, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *PermissionMutation) AddedCreatedBy() (r int, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *RolePermissionMutation) Op() Op {
-	return m.op
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *PermissionMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[permission.FieldCreatedBy] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RolePermissionMutation) SetOp(op Op) {
-	m.op = op
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *PermissionMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[permission.FieldCreatedBy]
+	return ok
 }
 
-// Type returns the node type of this mutation (RolePermission).
-func (m *RolePermissionMutation) Type() string {
-	return m.typ
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *PermissionMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, permission.FieldCreatedBy)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RolePermissionMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.role != nil {
-		fields = append(fields, rolepermission.FieldRoleID)
+// SetUpdatedBy sets the "updated_by" field.
+func (m *PermissionMutation) SetUpdatedBy(u uint) {
+	m.updated_by = &u
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *PermissionMutation) UpdatedBy() (r uint, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
 	}
-	if m.permission != nil {
-		fields = append(fields, rolepermission.FieldPermissionID)
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldUpdatedBy(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
 	}
-	if m.assigner != nil {
-		fields = append(fields, rolepermission.FieldAssignedBy)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
 	}
-	if m.assigned_at != nil {
-		fields = append(fields, rolepermission.FieldAssignedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
 	}
-	return fields
+	return oldValue.UpdatedBy, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RolePermissionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case rolepermission.FieldRoleID:
-		return m.RoleID()
-	case rolepermission.FieldPermissionID:
-		return m.PermissionID()
-	case rolepermission.FieldAssignedBy:
-		return m.AssignedBy()
-	case rolepermission.FieldAssignedAt:
-		return m.AssignedAt()
+// AddUpdatedBy adds u to the "updated_by" field.
+func (m *PermissionMutation) AddUpdatedBy(u int) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += u
+	} else {
+		m.addupdated_by = &u
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RolePermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case rolepermission.FieldRoleID:
-		return m.OldRoleID(ctx)
-	case rolepermission.FieldPermissionID:
-		return m.OldPermissionID(ctx)
-	case rolepermission.FieldAssignedBy:
-		return m.OldAssignedBy(ctx)
-	case rolepermission.FieldAssignedAt:
-		return m.OldAssignedAt(ctx)
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *PermissionMutation) AddedUpdatedBy() (r int, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown RolePermission field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RolePermissionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case rolepermission.FieldRoleID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRoleID(v)
-		return nil
-	case rolepermission.FieldPermissionID:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPermissionID(v)
-		return nil
-	case rolepermission.FieldAssignedBy:
-		v, ok := value.(uint)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAssignedBy(v)
-		return nil
-	case rolepermission.FieldAssignedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAssignedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown RolePermission field %s", name)
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *PermissionMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[permission.FieldUpdatedBy] = struct{}{}
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *RolePermissionMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *PermissionMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[permission.FieldUpdatedBy]
+	return ok
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *RolePermissionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
-	return nil, false
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *PermissionMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, permission.FieldUpdatedBy)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RolePermissionMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// SetCreatedAt sets the "created_at" field.
+func (m *PermissionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PermissionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown RolePermission numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *RolePermissionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(rolepermission.FieldAssignedBy) {
-		fields = append(fields, rolepermission.FieldAssignedBy)
+// OldCreatedAt returns the old "created_at" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *RolePermissionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PermissionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *RolePermissionMutation) ClearField(name string) error {
-	switch name {
-	case rolepermission.FieldAssignedBy:
-		m.ClearAssignedBy()
-		return nil
-	}
-	return fmt.Errorf("unknown RolePermission nullable field %s", name)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PermissionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *RolePermissionMutation) ResetField(name string) error {
-	switch name {
-	case rolepermission.FieldRoleID:
-		m.ResetRoleID()
-		return nil
-	case rolepermission.FieldPermissionID:
-		m.ResetPermissionID()
-		return nil
-	case rolepermission.FieldAssignedBy:
-		m.ResetAssignedBy()
-		return nil
-	case rolepermission.FieldAssignedAt:
-		m.ResetAssignedAt()
-		return nil
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PermissionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown RolePermission field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RolePermissionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.role != nil {
-		edges = append(edges, rolepermission.EdgeRole)
+// OldUpdatedAt returns the old "updated_at" field's value of the Permission entity.
+// If the Permission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.permission != nil {
-		edges = append(edges, rolepermission.EdgePermission)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.assigner != nil {
-		edges = append(edges, rolepermission.EdgeAssigner)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return edges
+	return oldValue.UpdatedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *RolePermissionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case rolepermission.EdgeRole:
-		if id := m.role; id != nil {
-			return []ent.Value{*id}
-		}
-	case rolepermission.EdgePermission:
-		if id := m.permission; id != nil {
-			return []ent.Value{*id}
-		}
-	case rolepermission.EdgeAssigner:
-		if id := m.assigner; id != nil {
-			return []ent.Value{*id}
-		}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PermissionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermission entity by ids.
+func (m *PermissionMutation) AddRolePermissionIDs(ids ...uint) {
+	if m.role_permissions == nil {
+		m.role_permissions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.role_permissions[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RolePermissionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	return edges
+// ClearRolePermissions clears the "role_permissions" edge to the RolePermission entity.
+func (m *PermissionMutation) ClearRolePermissions() {
+	m.clearedrole_permissions = true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RolePermissionMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// RolePermissionsCleared reports if the "role_permissions" edge to the RolePermission entity was cleared.
+func (m *PermissionMutation) RolePermissionsCleared() bool {
+	return m.clearedrole_permissions
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RolePermissionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedrole {
-		edges = append(edges, rolepermission.EdgeRole)
-	}
-	if m.clearedpermission {
-		edges = append(edges, rolepermission.EdgePermission)
+// RemoveRolePermissionIDs removes the "role_permissions" edge to the RolePermission entity by IDs.
+func (m *PermissionMutation) RemoveRolePermissionIDs(ids ...uint) {
+	if m.removedrole_permissions == nil {
+		m.removedrole_permissions = make(map[uint]struct{})
 	}
-	if m.clearedassigner {
-		edges = append(edges, rolepermission.EdgeAssigner)
+	for i := range ids {
+		delete(m.role_permissions, ids[i])
+		m.removedrole_permissions[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *RolePermissionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case rolepermission.EdgeRole:
-		return m.clearedrole
-	case rolepermission.EdgePermission:
-		return m.clearedpermission
-	case rolepermission.EdgeAssigner:
-		return m.clearedassigner
+// RemovedRolePermissions returns the removed IDs of the "role_permissions" edge to the RolePermission entity.
+func (m *PermissionMutation) RemovedRolePermissionsIDs() (ids []uint) {
+	for id := range m.removedrole_permissions {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RolePermissionMutation) ClearEdge(name string) error {
-	switch name {
-	case rolepermission.EdgeRole:
-		m.ClearRole()
-		return nil
-	case rolepermission.EdgePermission:
-		m.ClearPermission()
-		return nil
-	case rolepermission.EdgeAssigner:
-		m.ClearAssigner()
-		return nil
+// RolePermissionsIDs returns the "role_permissions" edge IDs in the mutation.
+func (m *PermissionMutation) RolePermissionsIDs() (ids []uint) {
+	for id := range m.role_permissions {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown RolePermission unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RolePermissionMutation) ResetEdge(name string) error {
-	switch name {
-	case rolepermission.EdgeRole:
-		m.ResetRole()
-		return nil
-	case rolepermission.EdgePermission:
-		m.ResetPermission()
-		return nil
-	case rolepermission.EdgeAssigner:
-		m.ResetAssigner()
-		return nil
+// ResetRolePermissions resets all changes to the "role_permissions" edge.
+func (m *PermissionMutation) ResetRolePermissions() {
+	m.role_permissions = nil
+	m.clearedrole_permissions = false
+	m.removedrole_permissions = nil
+}
+
+// Where appends a list predicates to the PermissionMutation builder.
+func (m *PermissionMutation) Where(ps ...predicate.Permission) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PermissionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PermissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Permission, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return fmt.Errorf("unknown RolePermission edge %s", name)
+	m.Where(p...)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
+// Op returns the operation name.
+func (m *PermissionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PermissionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Permission).
+func (m *PermissionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PermissionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.name != nil {
+		fields = append(fields, permission.FieldName)
+	}
+	if m.display_name != nil {
+		fields = append(fields, permission.FieldDisplayName)
+	}
+	if m.description != nil {
+		fields = append(fields, permission.FieldDescription)
+	}
+	if m.resource != nil {
+		fields = append(fields, permission.FieldResource)
+	}
+	if m.action != nil {
+		fields = append(fields, permission.FieldAction)
+	}
+	if m.category != nil {
+		fields = append(fields, permission.FieldCategory)
+	}
+	if m.is_system != nil {
+		fields = append(fields, permission.FieldIsSystem)
+	}
+	if m.created_by != nil {
+		fields = append(fields, permission.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, permission.FieldUpdatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, permission.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, permission.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PermissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case permission.FieldName:
+		return m.Name()
+	case permission.FieldDisplayName:
+		return m.DisplayName()
+	case permission.FieldDescription:
+		return m.Description()
+	case permission.FieldResource:
+		return m.Resource()
+	case permission.FieldAction:
+		return m.Action()
+	case permission.FieldCategory:
+		return m.Category()
+	case permission.FieldIsSystem:
+		return m.IsSystem()
+	case permission.FieldCreatedBy:
+		return m.CreatedBy()
+	case permission.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case permission.FieldCreatedAt:
+		return m.CreatedAt()
+	case permission.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case permission.FieldName:
+		return m.OldName(ctx)
+	case permission.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case permission.FieldDescription:
+		return m.OldDescription(ctx)
+	case permission.FieldResource:
+		return m.OldResource(ctx)
+	case permission.FieldAction:
+		return m.OldAction(ctx)
+	case permission.FieldCategory:
+		return m.OldCategory(ctx)
+	case permission.FieldIsSystem:
+		return m.OldIsSystem(ctx)
+	case permission.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case permission.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case permission.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case permission.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Permission field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case permission.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case permission.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case permission.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case permission.FieldResource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResource(v)
+		return nil
+	case permission.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case permission.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case permission.FieldIsSystem:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSystem(v)
+		return nil
+	case permission.FieldCreatedBy:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case permission.FieldUpdatedBy:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case permission.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case permission.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Permission field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PermissionMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_by != nil {
+		fields = append(fields, permission.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, permission.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PermissionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case permission.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case permission.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case permission.FieldCreatedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case permission.FieldUpdatedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Permission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PermissionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(permission.FieldDescription) {
+		fields = append(fields, permission.FieldDescription)
+	}
+	if m.FieldCleared(permission.FieldCreatedBy) {
+		fields = append(fields, permission.FieldCreatedBy)
+	}
+	if m.FieldCleared(permission.FieldUpdatedBy) {
+		fields = append(fields, permission.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PermissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PermissionMutation) ClearField(name string) error {
+	switch name {
+	case permission.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case permission.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case permission.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PermissionMutation) ResetField(name string) error {
+	switch name {
+	case permission.FieldName:
+		m.ResetName()
+		return nil
+	case permission.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case permission.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case permission.FieldResource:
+		m.ResetResource()
+		return nil
+	case permission.FieldAction:
+		m.ResetAction()
+		return nil
+	case permission.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case permission.FieldIsSystem:
+		m.ResetIsSystem()
+		return nil
+	case permission.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case permission.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case permission.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case permission.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PermissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.role_permissions != nil {
+		edges = append(edges, permission.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PermissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeRolePermissions:
+		ids := make([]ent.Value, 0, len(m.role_permissions))
+		for id := range m.role_permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PermissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedrole_permissions != nil {
+		edges = append(edges, permission.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PermissionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case permission.EdgeRolePermissions:
+		ids := make([]ent.Value, 0, len(m.removedrole_permissions))
+		for id := range m.removedrole_permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PermissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrole_permissions {
+		edges = append(edges, permission.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PermissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case permission.EdgeRolePermissions:
+		return m.clearedrole_permissions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PermissionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Permission unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PermissionMutation) ResetEdge(name string) error {
+	switch name {
+	case permission.EdgeRolePermissions:
+		m.ResetRolePermissions()
+		return nil
+	}
+	return fmt.Errorf("unknown Permission edge %s", name)
+}
+
+// RecurringPushMutation represents an operation that mutates the RecurringPush nodes in the graph.
+type RecurringPushMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	cron_expr     *string
+	provider      *string
+	title         *string
+	body          *string
+	url           *string
+	sound         *string
+	icon          *string
+	group         *string
+	level         *string
+	enabled       *bool
+	next_run_at   *time.Time
+	last_run_at   *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uint
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RecurringPush, error)
+	predicates    []predicate.RecurringPush
+}
+
+var _ ent.Mutation = (*RecurringPushMutation)(nil)
+
+// recurringpushOption allows management of the mutation configuration using functional options.
+type recurringpushOption func(*RecurringPushMutation)
+
+// newRecurringPushMutation creates new mutation for the RecurringPush entity.
+func newRecurringPushMutation(c config, op Op, opts ...recurringpushOption) *RecurringPushMutation {
+	m := &RecurringPushMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecurringPush,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecurringPushID sets the ID field of the mutation.
+func withRecurringPushID(id uint) recurringpushOption {
+	return func(m *RecurringPushMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecurringPush
+		)
+		m.oldValue = func(ctx context.Context) (*RecurringPush, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecurringPush.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecurringPush sets the old RecurringPush of the mutation.
+func withRecurringPush(node *RecurringPush) recurringpushOption {
+	return func(m *RecurringPushMutation) {
+		m.oldValue = func(context.Context) (*RecurringPush, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecurringPushMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecurringPushMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RecurringPush entities.
+func (m *RecurringPushMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecurringPushMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecurringPushMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecurringPush.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RecurringPushMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RecurringPushMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RecurringPushMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetCronExpr sets the "cron_expr" field.
+func (m *RecurringPushMutation) SetCronExpr(s string) {
+	m.cron_expr = &s
+}
+
+// CronExpr returns the value of the "cron_expr" field in the mutation.
+func (m *RecurringPushMutation) CronExpr() (r string, exists bool) {
+	v := m.cron_expr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCronExpr returns the old "cron_expr" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldCronExpr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCronExpr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCronExpr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCronExpr: %w", err)
+	}
+	return oldValue.CronExpr, nil
+}
+
+// ResetCronExpr resets all changes to the "cron_expr" field.
+func (m *RecurringPushMutation) ResetCronExpr() {
+	m.cron_expr = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *RecurringPushMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *RecurringPushMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (m *RecurringPushMutation) ClearProvider() {
+	m.provider = nil
+	m.clearedFields[recurringpush.FieldProvider] = struct{}{}
+}
+
+// ProviderCleared returns if the "provider" field was cleared in this mutation.
+func (m *RecurringPushMutation) ProviderCleared() bool {
+	_, ok := m.clearedFields[recurringpush.FieldProvider]
+	return ok
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *RecurringPushMutation) ResetProvider() {
+	m.provider = nil
+	delete(m.clearedFields, recurringpush.FieldProvider)
+}
+
+// SetTitle sets the "title" field.
+func (m *RecurringPushMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *RecurringPushMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *RecurringPushMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetBody sets the "body" field.
+func (m *RecurringPushMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *RecurringPushMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *RecurringPushMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetURL sets the "url" field.
+func (m *RecurringPushMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *RecurringPushMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *RecurringPushMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[recurringpush.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *RecurringPushMutation) URLCleared() bool {
+	_, ok := m.clearedFields[recurringpush.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *RecurringPushMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, recurringpush.FieldURL)
+}
+
+// SetSound sets the "sound" field.
+func (m *RecurringPushMutation) SetSound(s string) {
+	m.sound = &s
+}
+
+// Sound returns the value of the "sound" field in the mutation.
+func (m *RecurringPushMutation) Sound() (r string, exists bool) {
+	v := m.sound
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSound returns the old "sound" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldSound(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSound: %w", err)
+	}
+	return oldValue.Sound, nil
+}
+
+// ClearSound clears the value of the "sound" field.
+func (m *RecurringPushMutation) ClearSound() {
+	m.sound = nil
+	m.clearedFields[recurringpush.FieldSound] = struct{}{}
+}
+
+// SoundCleared returns if the "sound" field was cleared in this mutation.
+func (m *RecurringPushMutation) SoundCleared() bool {
+	_, ok := m.clearedFields[recurringpush.FieldSound]
+	return ok
+}
+
+// ResetSound resets all changes to the "sound" field.
+func (m *RecurringPushMutation) ResetSound() {
+	m.sound = nil
+	delete(m.clearedFields, recurringpush.FieldSound)
+}
+
+// SetIcon sets the "icon" field.
+func (m *RecurringPushMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *RecurringPushMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldIcon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (m *RecurringPushMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[recurringpush.FieldIcon] = struct{}{}
+}
+
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *RecurringPushMutation) IconCleared() bool {
+	_, ok := m.clearedFields[recurringpush.FieldIcon]
+	return ok
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *RecurringPushMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, recurringpush.FieldIcon)
+}
+
+// SetGroup sets the "group" field.
+func (m *RecurringPushMutation) SetGroup(s string) {
+	m.group = &s
+}
+
+// Group returns the value of the "group" field in the mutation.
+func (m *RecurringPushMutation) Group() (r string, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroup returns the old "group" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroup: %w", err)
+	}
+	return oldValue.Group, nil
+}
+
+// ClearGroup clears the value of the "group" field.
+func (m *RecurringPushMutation) ClearGroup() {
+	m.group = nil
+	m.clearedFields[recurringpush.FieldGroup] = struct{}{}
+}
+
+// GroupCleared returns if the "group" field was cleared in this mutation.
+func (m *RecurringPushMutation) GroupCleared() bool {
+	_, ok := m.clearedFields[recurringpush.FieldGroup]
+	return ok
+}
+
+// ResetGroup resets all changes to the "group" field.
+func (m *RecurringPushMutation) ResetGroup() {
+	m.group = nil
+	delete(m.clearedFields, recurringpush.FieldGroup)
+}
+
+// SetLevel sets the "level" field.
+func (m *RecurringPushMutation) SetLevel(s string) {
+	m.level = &s
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *RecurringPushMutation) Level() (r string, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldLevel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// ClearLevel clears the value of the "level" field.
+func (m *RecurringPushMutation) ClearLevel() {
+	m.level = nil
+	m.clearedFields[recurringpush.FieldLevel] = struct{}{}
+}
+
+// LevelCleared returns if the "level" field was cleared in this mutation.
+func (m *RecurringPushMutation) LevelCleared() bool {
+	_, ok := m.clearedFields[recurringpush.FieldLevel]
+	return ok
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *RecurringPushMutation) ResetLevel() {
+	m.level = nil
+	delete(m.clearedFields, recurringpush.FieldLevel)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *RecurringPushMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *RecurringPushMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *RecurringPushMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *RecurringPushMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *RecurringPushMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldNextRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *RecurringPushMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (m *RecurringPushMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
+}
+
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *RecurringPushMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunAt returns the old "last_run_at" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *RecurringPushMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[recurringpush.FieldLastRunAt] = struct{}{}
+}
+
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *RecurringPushMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[recurringpush.FieldLastRunAt]
+	return ok
+}
+
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *RecurringPushMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, recurringpush.FieldLastRunAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecurringPushMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecurringPushMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecurringPushMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RecurringPushMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RecurringPushMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RecurringPush entity.
+// If the RecurringPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringPushMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RecurringPushMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RecurringPushMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[recurringpush.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RecurringPushMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RecurringPushMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RecurringPushMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RecurringPushMutation builder.
+func (m *RecurringPushMutation) Where(ps ...predicate.RecurringPush) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecurringPushMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecurringPushMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecurringPush, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecurringPushMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecurringPushMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecurringPush).
+func (m *RecurringPushMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecurringPushMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.user != nil {
+		fields = append(fields, recurringpush.FieldUserID)
+	}
+	if m.cron_expr != nil {
+		fields = append(fields, recurringpush.FieldCronExpr)
+	}
+	if m.provider != nil {
+		fields = append(fields, recurringpush.FieldProvider)
+	}
+	if m.title != nil {
+		fields = append(fields, recurringpush.FieldTitle)
+	}
+	if m.body != nil {
+		fields = append(fields, recurringpush.FieldBody)
+	}
+	if m.url != nil {
+		fields = append(fields, recurringpush.FieldURL)
+	}
+	if m.sound != nil {
+		fields = append(fields, recurringpush.FieldSound)
+	}
+	if m.icon != nil {
+		fields = append(fields, recurringpush.FieldIcon)
+	}
+	if m.group != nil {
+		fields = append(fields, recurringpush.FieldGroup)
+	}
+	if m.level != nil {
+		fields = append(fields, recurringpush.FieldLevel)
+	}
+	if m.enabled != nil {
+		fields = append(fields, recurringpush.FieldEnabled)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, recurringpush.FieldNextRunAt)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, recurringpush.FieldLastRunAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recurringpush.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, recurringpush.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecurringPushMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recurringpush.FieldUserID:
+		return m.UserID()
+	case recurringpush.FieldCronExpr:
+		return m.CronExpr()
+	case recurringpush.FieldProvider:
+		return m.Provider()
+	case recurringpush.FieldTitle:
+		return m.Title()
+	case recurringpush.FieldBody:
+		return m.Body()
+	case recurringpush.FieldURL:
+		return m.URL()
+	case recurringpush.FieldSound:
+		return m.Sound()
+	case recurringpush.FieldIcon:
+		return m.Icon()
+	case recurringpush.FieldGroup:
+		return m.Group()
+	case recurringpush.FieldLevel:
+		return m.Level()
+	case recurringpush.FieldEnabled:
+		return m.Enabled()
+	case recurringpush.FieldNextRunAt:
+		return m.NextRunAt()
+	case recurringpush.FieldLastRunAt:
+		return m.LastRunAt()
+	case recurringpush.FieldCreatedAt:
+		return m.CreatedAt()
+	case recurringpush.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecurringPushMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recurringpush.FieldUserID:
+		return m.OldUserID(ctx)
+	case recurringpush.FieldCronExpr:
+		return m.OldCronExpr(ctx)
+	case recurringpush.FieldProvider:
+		return m.OldProvider(ctx)
+	case recurringpush.FieldTitle:
+		return m.OldTitle(ctx)
+	case recurringpush.FieldBody:
+		return m.OldBody(ctx)
+	case recurringpush.FieldURL:
+		return m.OldURL(ctx)
+	case recurringpush.FieldSound:
+		return m.OldSound(ctx)
+	case recurringpush.FieldIcon:
+		return m.OldIcon(ctx)
+	case recurringpush.FieldGroup:
+		return m.OldGroup(ctx)
+	case recurringpush.FieldLevel:
+		return m.OldLevel(ctx)
+	case recurringpush.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case recurringpush.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case recurringpush.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case recurringpush.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case recurringpush.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecurringPush field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringPushMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recurringpush.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case recurringpush.FieldCronExpr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCronExpr(v)
+		return nil
+	case recurringpush.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case recurringpush.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case recurringpush.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case recurringpush.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case recurringpush.FieldSound:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSound(v)
+		return nil
+	case recurringpush.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case recurringpush.FieldGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroup(v)
+		return nil
+	case recurringpush.FieldLevel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case recurringpush.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case recurringpush.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case recurringpush.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	case recurringpush.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case recurringpush.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringPush field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecurringPushMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecurringPushMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringPushMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RecurringPush numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecurringPushMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recurringpush.FieldProvider) {
+		fields = append(fields, recurringpush.FieldProvider)
+	}
+	if m.FieldCleared(recurringpush.FieldURL) {
+		fields = append(fields, recurringpush.FieldURL)
+	}
+	if m.FieldCleared(recurringpush.FieldSound) {
+		fields = append(fields, recurringpush.FieldSound)
+	}
+	if m.FieldCleared(recurringpush.FieldIcon) {
+		fields = append(fields, recurringpush.FieldIcon)
+	}
+	if m.FieldCleared(recurringpush.FieldGroup) {
+		fields = append(fields, recurringpush.FieldGroup)
+	}
+	if m.FieldCleared(recurringpush.FieldLevel) {
+		fields = append(fields, recurringpush.FieldLevel)
+	}
+	if m.FieldCleared(recurringpush.FieldLastRunAt) {
+		fields = append(fields, recurringpush.FieldLastRunAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecurringPushMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecurringPushMutation) ClearField(name string) error {
+	switch name {
+	case recurringpush.FieldProvider:
+		m.ClearProvider()
+		return nil
+	case recurringpush.FieldURL:
+		m.ClearURL()
+		return nil
+	case recurringpush.FieldSound:
+		m.ClearSound()
+		return nil
+	case recurringpush.FieldIcon:
+		m.ClearIcon()
+		return nil
+	case recurringpush.FieldGroup:
+		m.ClearGroup()
+		return nil
+	case recurringpush.FieldLevel:
+		m.ClearLevel()
+		return nil
+	case recurringpush.FieldLastRunAt:
+		m.ClearLastRunAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringPush nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecurringPushMutation) ResetField(name string) error {
+	switch name {
+	case recurringpush.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case recurringpush.FieldCronExpr:
+		m.ResetCronExpr()
+		return nil
+	case recurringpush.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case recurringpush.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case recurringpush.FieldBody:
+		m.ResetBody()
+		return nil
+	case recurringpush.FieldURL:
+		m.ResetURL()
+		return nil
+	case recurringpush.FieldSound:
+		m.ResetSound()
+		return nil
+	case recurringpush.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case recurringpush.FieldGroup:
+		m.ResetGroup()
+		return nil
+	case recurringpush.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case recurringpush.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case recurringpush.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case recurringpush.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case recurringpush.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case recurringpush.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringPush field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecurringPushMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, recurringpush.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecurringPushMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recurringpush.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecurringPushMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecurringPushMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecurringPushMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, recurringpush.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecurringPushMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recurringpush.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecurringPushMutation) ClearEdge(name string) error {
+	switch name {
+	case recurringpush.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringPush unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecurringPushMutation) ResetEdge(name string) error {
+	switch name {
+	case recurringpush.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringPush edge %s", name)
+}
+
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uint
+	name                    *string
+	display_name            *string
+	description             *string
+	is_system               *bool
+	created_by              *uint
+	addcreated_by           *int
+	updated_by              *uint
+	addupdated_by           *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	user_roles              map[uint]struct{}
+	removeduser_roles       map[uint]struct{}
+	cleareduser_roles       bool
+	role_permissions        map[uint]struct{}
+	removedrole_permissions map[uint]struct{}
+	clearedrole_permissions bool
+	done                    bool
+	oldValue                func(context.Context) (*Role, error)
+	predicates              []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id uint) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Role entities.
+func (m *RoleMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *RoleMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *RoleMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *RoleMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RoleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RoleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[role.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RoleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[role.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, role.FieldDescription)
+}
+
+// SetIsSystem sets the "is_system" field.
+func (m *RoleMutation) SetIsSystem(b bool) {
+	m.is_system = &b
+}
+
+// IsSystem returns the value of the "is_system" field in the mutation.
+func (m *RoleMutation) IsSystem() (r bool, exists bool) {
+	v := m.is_system
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSystem returns the old "is_system" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+	}
+	return oldValue.IsSystem, nil
+}
+
+// ResetIsSystem resets all changes to the "is_system" field.
+func (m *RoleMutation) ResetIsSystem() {
+	m.is_system = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *RoleMutation) SetCreatedBy(u uint) {
+	m.created_by = &u
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *RoleMutation) CreatedBy() (r uint, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCreatedBy(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// AddCreatedBy adds u to the "created_by" field.
+func (m *RoleMutation) AddCreatedBy(u int) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += u
+	} else {
+		m.addcreated_by = &u
+	}
+}
+
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *RoleMutation) AddedCreatedBy() (r int, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *RoleMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[role.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *RoleMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[role.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *RoleMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, role.FieldCreatedBy)
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (m *RoleMutation) SetUpdatedBy(u uint) {
+	m.updated_by = &u
+	m.addupdated_by = nil
+}
+
+// UpdatedBy returns the value of the "updated_by" field in the mutation.
+func (m *RoleMutation) UpdatedBy() (r uint, exists bool) {
+	v := m.updated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedBy returns the old "updated_by" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldUpdatedBy(ctx context.Context) (v *uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedBy: %w", err)
+	}
+	return oldValue.UpdatedBy, nil
+}
+
+// AddUpdatedBy adds u to the "updated_by" field.
+func (m *RoleMutation) AddUpdatedBy(u int) {
+	if m.addupdated_by != nil {
+		*m.addupdated_by += u
+	} else {
+		m.addupdated_by = &u
+	}
+}
+
+// AddedUpdatedBy returns the value that was added to the "updated_by" field in this mutation.
+func (m *RoleMutation) AddedUpdatedBy() (r int, exists bool) {
+	v := m.addupdated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (m *RoleMutation) ClearUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	m.clearedFields[role.FieldUpdatedBy] = struct{}{}
+}
+
+// UpdatedByCleared returns if the "updated_by" field was cleared in this mutation.
+func (m *RoleMutation) UpdatedByCleared() bool {
+	_, ok := m.clearedFields[role.FieldUpdatedBy]
+	return ok
+}
+
+// ResetUpdatedBy resets all changes to the "updated_by" field.
+func (m *RoleMutation) ResetUpdatedBy() {
+	m.updated_by = nil
+	m.addupdated_by = nil
+	delete(m.clearedFields, role.FieldUpdatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUserRoleIDs adds the "user_roles" edge to the UserRole entity by ids.
+func (m *RoleMutation) AddUserRoleIDs(ids ...uint) {
+	if m.user_roles == nil {
+		m.user_roles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.user_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserRoles clears the "user_roles" edge to the UserRole entity.
+func (m *RoleMutation) ClearUserRoles() {
+	m.cleareduser_roles = true
+}
+
+// UserRolesCleared reports if the "user_roles" edge to the UserRole entity was cleared.
+func (m *RoleMutation) UserRolesCleared() bool {
+	return m.cleareduser_roles
+}
+
+// RemoveUserRoleIDs removes the "user_roles" edge to the UserRole entity by IDs.
+func (m *RoleMutation) RemoveUserRoleIDs(ids ...uint) {
+	if m.removeduser_roles == nil {
+		m.removeduser_roles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.user_roles, ids[i])
+		m.removeduser_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRole entity.
+func (m *RoleMutation) RemovedUserRolesIDs() (ids []uint) {
+	for id := range m.removeduser_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
+func (m *RoleMutation) UserRolesIDs() (ids []uint) {
+	for id := range m.user_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserRoles resets all changes to the "user_roles" edge.
+func (m *RoleMutation) ResetUserRoles() {
+	m.user_roles = nil
+	m.cleareduser_roles = false
+	m.removeduser_roles = nil
+}
+
+// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermission entity by ids.
+func (m *RoleMutation) AddRolePermissionIDs(ids ...uint) {
+	if m.role_permissions == nil {
+		m.role_permissions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.role_permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRolePermissions clears the "role_permissions" edge to the RolePermission entity.
+func (m *RoleMutation) ClearRolePermissions() {
+	m.clearedrole_permissions = true
+}
+
+// RolePermissionsCleared reports if the "role_permissions" edge to the RolePermission entity was cleared.
+func (m *RoleMutation) RolePermissionsCleared() bool {
+	return m.clearedrole_permissions
+}
+
+// RemoveRolePermissionIDs removes the "role_permissions" edge to the RolePermission entity by IDs.
+func (m *RoleMutation) RemoveRolePermissionIDs(ids ...uint) {
+	if m.removedrole_permissions == nil {
+		m.removedrole_permissions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.role_permissions, ids[i])
+		m.removedrole_permissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRolePermissions returns the removed IDs of the "role_permissions" edge to the RolePermission entity.
+func (m *RoleMutation) RemovedRolePermissionsIDs() (ids []uint) {
+	for id := range m.removedrole_permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolePermissionsIDs returns the "role_permissions" edge IDs in the mutation.
+func (m *RoleMutation) RolePermissionsIDs() (ids []uint) {
+	for id := range m.role_permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRolePermissions resets all changes to the "role_permissions" edge.
+func (m *RoleMutation) ResetRolePermissions() {
+	m.role_permissions = nil
+	m.clearedrole_permissions = false
+	m.removedrole_permissions = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.display_name != nil {
+		fields = append(fields, role.FieldDisplayName)
+	}
+	if m.description != nil {
+		fields = append(fields, role.FieldDescription)
+	}
+	if m.is_system != nil {
+		fields = append(fields, role.FieldIsSystem)
+	}
+	if m.created_by != nil {
+		fields = append(fields, role.FieldCreatedBy)
+	}
+	if m.updated_by != nil {
+		fields = append(fields, role.FieldUpdatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, role.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, role.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldName:
+		return m.Name()
+	case role.FieldDisplayName:
+		return m.DisplayName()
+	case role.FieldDescription:
+		return m.Description()
+	case role.FieldIsSystem:
+		return m.IsSystem()
+	case role.FieldCreatedBy:
+		return m.CreatedBy()
+	case role.FieldUpdatedBy:
+		return m.UpdatedBy()
+	case role.FieldCreatedAt:
+		return m.CreatedAt()
+	case role.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case role.FieldDescription:
+		return m.OldDescription(ctx)
+	case role.FieldIsSystem:
+		return m.OldIsSystem(ctx)
+	case role.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case role.FieldUpdatedBy:
+		return m.OldUpdatedBy(ctx)
+	case role.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case role.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Role field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case role.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case role.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case role.FieldIsSystem:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSystem(v)
+		return nil
+	case role.FieldCreatedBy:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case role.FieldUpdatedBy:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedBy(v)
+		return nil
+	case role.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case role.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_by != nil {
+		fields = append(fields, role.FieldCreatedBy)
+	}
+	if m.addupdated_by != nil {
+		fields = append(fields, role.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldCreatedBy:
+		return m.AddedCreatedBy()
+	case role.FieldUpdatedBy:
+		return m.AddedUpdatedBy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldCreatedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedBy(v)
+		return nil
+	case role.FieldUpdatedBy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(role.FieldDescription) {
+		fields = append(fields, role.FieldDescription)
+	}
+	if m.FieldCleared(role.FieldCreatedBy) {
+		fields = append(fields, role.FieldCreatedBy)
+	}
+	if m.FieldCleared(role.FieldUpdatedBy) {
+		fields = append(fields, role.FieldUpdatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleMutation) ClearField(name string) error {
+	switch name {
+	case role.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case role.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case role.FieldUpdatedBy:
+		m.ClearUpdatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Role nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case role.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case role.FieldIsSystem:
+		m.ResetIsSystem()
+		return nil
+	case role.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case role.FieldUpdatedBy:
+		m.ResetUpdatedBy()
+		return nil
+	case role.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case role.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user_roles != nil {
+		edges = append(edges, role.EdgeUserRoles)
+	}
+	if m.role_permissions != nil {
+		edges = append(edges, role.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.user_roles))
+		for id := range m.user_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeRolePermissions:
+		ids := make([]ent.Value, 0, len(m.role_permissions))
+		for id := range m.role_permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeduser_roles != nil {
+		edges = append(edges, role.EdgeUserRoles)
+	}
+	if m.removedrole_permissions != nil {
+		edges = append(edges, role.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.removeduser_roles))
+		for id := range m.removeduser_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeRolePermissions:
+		ids := make([]ent.Value, 0, len(m.removedrole_permissions))
+		for id := range m.removedrole_permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser_roles {
+		edges = append(edges, role.EdgeUserRoles)
+	}
+	if m.clearedrole_permissions {
+		edges = append(edges, role.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case role.EdgeUserRoles:
+		return m.cleareduser_roles
+	case role.EdgeRolePermissions:
+		return m.clearedrole_permissions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleMutation) ResetEdge(name string) error {
+	switch name {
+	case role.EdgeUserRoles:
+		m.ResetUserRoles()
+		return nil
+	case role.EdgeRolePermissions:
+		m.ResetRolePermissions()
+		return nil
+	}
+	return fmt.Errorf("unknown Role edge %s", name)
+}
+
+// RolePermissionMutation represents an operation that mutates the RolePermission nodes in the graph.
+type RolePermissionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uint
+	assigned_at       *time.Time
+	clearedFields     map[string]struct{}
+	role              *uint
+	clearedrole       bool
+	permission        *uint
+	clearedpermission bool
+	assigner          *uint
+	clearedassigner   bool
+	done              bool
+	oldValue          func(context.Context) (*RolePermission, error)
+	predicates        []predicate.RolePermission
+}
+
+var _ ent.Mutation = (*RolePermissionMutation)(nil)
+
+// rolepermissionOption allows management of the mutation configuration using functional options.
+type rolepermissionOption func(*RolePermissionMutation)
+
+// newRolePermissionMutation creates new mutation for the RolePermission entity.
+func newRolePermissionMutation(c config, op Op, opts ...rolepermissionOption) *RolePermissionMutation {
+	m := &RolePermissionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRolePermission,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRolePermissionID sets the ID field of the mutation.
+func withRolePermissionID(id uint) rolepermissionOption {
+	return func(m *RolePermissionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RolePermission
+		)
+		m.oldValue = func(ctx context.Context) (*RolePermission, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RolePermission.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRolePermission sets the old RolePermission of the mutation.
+func withRolePermission(node *RolePermission) rolepermissionOption {
+	return func(m *RolePermissionMutation) {
+		m.oldValue = func(context.Context) (*RolePermission, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RolePermissionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RolePermissionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RolePermission entities.
+func (m *RolePermissionMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RolePermissionMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RolePermissionMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RolePermission.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *RolePermissionMutation) SetRoleID(u uint) {
+	m.role = &u
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *RolePermissionMutation) RoleID() (r uint, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the RolePermission entity.
+// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionMutation) OldRoleID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *RolePermissionMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (m *RolePermissionMutation) SetPermissionID(u uint) {
+	m.permission = &u
+}
+
+// PermissionID returns the value of the "permission_id" field in the mutation.
+func (m *RolePermissionMutation) PermissionID() (r uint, exists bool) {
+	v := m.permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionID returns the old "permission_id" field's value of the RolePermission entity.
+// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionMutation) OldPermissionID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionID: %w", err)
+	}
+	return oldValue.PermissionID, nil
+}
+
+// ResetPermissionID resets all changes to the "permission_id" field.
+func (m *RolePermissionMutation) ResetPermissionID() {
+	m.permission = nil
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (m *RolePermissionMutation) SetAssignedBy(u uint) {
+	m.assigner = &u
+}
+
+// AssignedBy returns the value of the "assigned_by" field in the mutation.
+func (m *RolePermissionMutation) AssignedBy() (r uint, exists bool) {
+	v := m.assigner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedBy returns the old "assigned_by" field's value of the RolePermission entity.
+// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionMutation) OldAssignedBy(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
+	}
+	return oldValue.AssignedBy, nil
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (m *RolePermissionMutation) ClearAssignedBy() {
+	m.assigner = nil
+	m.clearedFields[rolepermission.FieldAssignedBy] = struct{}{}
+}
+
+// AssignedByCleared returns if the "assigned_by" field was cleared in this mutation.
+func (m *RolePermissionMutation) AssignedByCleared() bool {
+	_, ok := m.clearedFields[rolepermission.FieldAssignedBy]
+	return ok
+}
+
+// ResetAssignedBy resets all changes to the "assigned_by" field.
+func (m *RolePermissionMutation) ResetAssignedBy() {
+	m.assigner = nil
+	delete(m.clearedFields, rolepermission.FieldAssignedBy)
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (m *RolePermissionMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *RolePermissionMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedAt returns the old "assigned_at" field's value of the RolePermission entity.
+// If the RolePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+	}
+	return oldValue.AssignedAt, nil
+}
+
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *RolePermissionMutation) ResetAssignedAt() {
+	m.assigned_at = nil
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (m *RolePermissionMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[rolepermission.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Role entity was cleared.
+func (m *RolePermissionMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *RolePermissionMutation) RoleIDs() (ids []uint) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *RolePermissionMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// ClearPermission clears the "permission" edge to the Permission entity.
+func (m *RolePermissionMutation) ClearPermission() {
+	m.clearedpermission = true
+	m.clearedFields[rolepermission.FieldPermissionID] = struct{}{}
+}
+
+// PermissionCleared reports if the "permission" edge to the Permission entity was cleared.
+func (m *RolePermissionMutation) PermissionCleared() bool {
+	return m.clearedpermission
+}
+
+// PermissionIDs returns the "permission" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PermissionID instead. It exists only for internal usage by the builders.
+func (m *RolePermissionMutation) PermissionIDs() (ids []uint) {
+	if id := m.permission; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPermission resets all changes to the "permission" edge.
+func (m *RolePermissionMutation) ResetPermission() {
+	m.permission = nil
+	m.clearedpermission = false
+}
+
+// SetAssignerID sets the "assigner" edge to the User entity by id.
+func (m *RolePermissionMutation) SetAssignerID(id uint) {
+	m.assigner = &id
+}
+
+// ClearAssigner clears the "assigner" edge to the User entity.
+func (m *RolePermissionMutation) ClearAssigner() {
+	m.clearedassigner = true
+	m.clearedFields[rolepermission.FieldAssignedBy] = struct{}{}
+}
+
+// AssignerCleared reports if the "assigner" edge to the User entity was cleared.
+func (m *RolePermissionMutation) AssignerCleared() bool {
+	return m.AssignedByCleared() || m.clearedassigner
+}
+
+// AssignerID returns the "assigner" edge ID in the mutation.
+func (m *RolePermissionMutation) AssignerID() (id uint, exists bool) {
+	if m.assigner != nil {
+		return *m.assigner, true
+	}
+	return
+}
+
+// AssignerIDs returns the "assigner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AssignerID instead. It exists only for internal usage by the builders.
+func (m *RolePermissionMutation) AssignerIDs() (ids []uint) {
+	if id := m.assigner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAssigner resets all changes to the "assigner" edge.
+func (m *RolePermissionMutation) ResetAssigner() {
+	m.assigner = nil
+	m.clearedassigner = false
+}
+
+// Where appends a list predicates to the RolePermissionMutation builder.
+func (m *RolePermissionMutation) Where(ps ...predicate.RolePermission) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RolePermissionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RolePermissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RolePermission, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RolePermissionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RolePermissionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RolePermission).
+func (m *RolePermissionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RolePermissionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.role != nil {
+		fields = append(fields, rolepermission.FieldRoleID)
+	}
+	if m.permission != nil {
+		fields = append(fields, rolepermission.FieldPermissionID)
+	}
+	if m.assigner != nil {
+		fields = append(fields, rolepermission.FieldAssignedBy)
+	}
+	if m.assigned_at != nil {
+		fields = append(fields, rolepermission.FieldAssignedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RolePermissionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rolepermission.FieldRoleID:
+		return m.RoleID()
+	case rolepermission.FieldPermissionID:
+		return m.PermissionID()
+	case rolepermission.FieldAssignedBy:
+		return m.AssignedBy()
+	case rolepermission.FieldAssignedAt:
+		return m.AssignedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RolePermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rolepermission.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case rolepermission.FieldPermissionID:
+		return m.OldPermissionID(ctx)
+	case rolepermission.FieldAssignedBy:
+		return m.OldAssignedBy(ctx)
+	case rolepermission.FieldAssignedAt:
+		return m.OldAssignedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RolePermission field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RolePermissionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rolepermission.FieldRoleID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case rolepermission.FieldPermissionID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionID(v)
+		return nil
+	case rolepermission.FieldAssignedBy:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedBy(v)
+		return nil
+	case rolepermission.FieldAssignedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermission field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RolePermissionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RolePermissionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RolePermissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RolePermission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RolePermissionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rolepermission.FieldAssignedBy) {
+		fields = append(fields, rolepermission.FieldAssignedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RolePermissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RolePermissionMutation) ClearField(name string) error {
+	switch name {
+	case rolepermission.FieldAssignedBy:
+		m.ClearAssignedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RolePermissionMutation) ResetField(name string) error {
+	switch name {
+	case rolepermission.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case rolepermission.FieldPermissionID:
+		m.ResetPermissionID()
+		return nil
+	case rolepermission.FieldAssignedBy:
+		m.ResetAssignedBy()
+		return nil
+	case rolepermission.FieldAssignedAt:
+		m.ResetAssignedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RolePermissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.role != nil {
+		edges = append(edges, rolepermission.EdgeRole)
+	}
+	if m.permission != nil {
+		edges = append(edges, rolepermission.EdgePermission)
+	}
+	if m.assigner != nil {
+		edges = append(edges, rolepermission.EdgeAssigner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RolePermissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rolepermission.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	case rolepermission.EdgePermission:
+		if id := m.permission; id != nil {
+			return []ent.Value{*id}
+		}
+	case rolepermission.EdgeAssigner:
+		if id := m.assigner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RolePermissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RolePermissionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RolePermissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedrole {
+		edges = append(edges, rolepermission.EdgeRole)
+	}
+	if m.clearedpermission {
+		edges = append(edges, rolepermission.EdgePermission)
+	}
+	if m.clearedassigner {
+		edges = append(edges, rolepermission.EdgeAssigner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RolePermissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rolepermission.EdgeRole:
+		return m.clearedrole
+	case rolepermission.EdgePermission:
+		return m.clearedpermission
+	case rolepermission.EdgeAssigner:
+		return m.clearedassigner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RolePermissionMutation) ClearEdge(name string) error {
+	switch name {
+	case rolepermission.EdgeRole:
+		m.ClearRole()
+		return nil
+	case rolepermission.EdgePermission:
+		m.ClearPermission()
+		return nil
+	case rolepermission.EdgeAssigner:
+		m.ClearAssigner()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermission unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RolePermissionMutation) ResetEdge(name string) error {
+	switch name {
+	case rolepermission.EdgeRole:
+		m.ResetRole()
+		return nil
+	case rolepermission.EdgePermission:
+		m.ResetPermission()
+		return nil
+	case rolepermission.EdgeAssigner:
+		m.ResetAssigner()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermission edge %s", name)
+}
+
+// ScheduledPushMutation represents an operation that mutates the ScheduledPush nodes in the graph.
+type ScheduledPushMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint
+	provider      *string
+	title         *string
+	body          *string
+	url           *string
+	sound         *string
+	icon          *string
+	group         *string
+	level         *string
+	send_at       *time.Time
+	status        *scheduledpush.Status
+	error         *string
+	sent_at       *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uint
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ScheduledPush, error)
+	predicates    []predicate.ScheduledPush
+}
+
+var _ ent.Mutation = (*ScheduledPushMutation)(nil)
+
+// scheduledpushOption allows management of the mutation configuration using functional options.
+type scheduledpushOption func(*ScheduledPushMutation)
+
+// newScheduledPushMutation creates new mutation for the ScheduledPush entity.
+func newScheduledPushMutation(c config, op Op, opts ...scheduledpushOption) *ScheduledPushMutation {
+	m := &ScheduledPushMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScheduledPush,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScheduledPushID sets the ID field of the mutation.
+func withScheduledPushID(id uint) scheduledpushOption {
+	return func(m *ScheduledPushMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScheduledPush
+		)
+		m.oldValue = func(ctx context.Context) (*ScheduledPush, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScheduledPush.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScheduledPush sets the old ScheduledPush of the mutation.
+func withScheduledPush(node *ScheduledPush) scheduledpushOption {
+	return func(m *ScheduledPushMutation) {
+		m.oldValue = func(context.Context) (*ScheduledPush, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduledPushMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduledPushMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScheduledPush entities.
+func (m *ScheduledPushMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduledPushMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduledPushMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScheduledPush.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ScheduledPushMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ScheduledPushMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ScheduledPushMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *ScheduledPushMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *ScheduledPushMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (m *ScheduledPushMutation) ClearProvider() {
+	m.provider = nil
+	m.clearedFields[scheduledpush.FieldProvider] = struct{}{}
+}
+
+// ProviderCleared returns if the "provider" field was cleared in this mutation.
+func (m *ScheduledPushMutation) ProviderCleared() bool {
+	_, ok := m.clearedFields[scheduledpush.FieldProvider]
+	return ok
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *ScheduledPushMutation) ResetProvider() {
+	m.provider = nil
+	delete(m.clearedFields, scheduledpush.FieldProvider)
+}
+
+// SetTitle sets the "title" field.
+func (m *ScheduledPushMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ScheduledPushMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ScheduledPushMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetBody sets the "body" field.
+func (m *ScheduledPushMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *ScheduledPushMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *ScheduledPushMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetURL sets the "url" field.
+func (m *ScheduledPushMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ScheduledPushMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *ScheduledPushMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[scheduledpush.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *ScheduledPushMutation) URLCleared() bool {
+	_, ok := m.clearedFields[scheduledpush.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ScheduledPushMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, scheduledpush.FieldURL)
+}
+
+// SetSound sets the "sound" field.
+func (m *ScheduledPushMutation) SetSound(s string) {
+	m.sound = &s
+}
+
+// Sound returns the value of the "sound" field in the mutation.
+func (m *ScheduledPushMutation) Sound() (r string, exists bool) {
+	v := m.sound
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSound returns the old "sound" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldSound(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSound: %w", err)
+	}
+	return oldValue.Sound, nil
+}
+
+// ClearSound clears the value of the "sound" field.
+func (m *ScheduledPushMutation) ClearSound() {
+	m.sound = nil
+	m.clearedFields[scheduledpush.FieldSound] = struct{}{}
+}
+
+// SoundCleared returns if the "sound" field was cleared in this mutation.
+func (m *ScheduledPushMutation) SoundCleared() bool {
+	_, ok := m.clearedFields[scheduledpush.FieldSound]
+	return ok
+}
+
+// ResetSound resets all changes to the "sound" field.
+func (m *ScheduledPushMutation) ResetSound() {
+	m.sound = nil
+	delete(m.clearedFields, scheduledpush.FieldSound)
+}
+
+// SetIcon sets the "icon" field.
+func (m *ScheduledPushMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *ScheduledPushMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldIcon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (m *ScheduledPushMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[scheduledpush.FieldIcon] = struct{}{}
+}
+
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *ScheduledPushMutation) IconCleared() bool {
+	_, ok := m.clearedFields[scheduledpush.FieldIcon]
+	return ok
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *ScheduledPushMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, scheduledpush.FieldIcon)
+}
+
+// SetGroup sets the "group" field.
+func (m *ScheduledPushMutation) SetGroup(s string) {
+	m.group = &s
+}
+
+// Group returns the value of the "group" field in the mutation.
+func (m *ScheduledPushMutation) Group() (r string, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroup returns the old "group" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroup: %w", err)
+	}
+	return oldValue.Group, nil
+}
+
+// ClearGroup clears the value of the "group" field.
+func (m *ScheduledPushMutation) ClearGroup() {
+	m.group = nil
+	m.clearedFields[scheduledpush.FieldGroup] = struct{}{}
+}
+
+// GroupCleared returns if the "group" field was cleared in this mutation.
+func (m *ScheduledPushMutation) GroupCleared() bool {
+	_, ok := m.clearedFields[scheduledpush.FieldGroup]
+	return ok
+}
+
+// ResetGroup resets all changes to the "group" field.
+func (m *ScheduledPushMutation) ResetGroup() {
+	m.group = nil
+	delete(m.clearedFields, scheduledpush.FieldGroup)
+}
+
+// SetLevel sets the "level" field.
+func (m *ScheduledPushMutation) SetLevel(s string) {
+	m.level = &s
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *ScheduledPushMutation) Level() (r string, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldLevel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// ClearLevel clears the value of the "level" field.
+func (m *ScheduledPushMutation) ClearLevel() {
+	m.level = nil
+	m.clearedFields[scheduledpush.FieldLevel] = struct{}{}
+}
+
+// LevelCleared returns if the "level" field was cleared in this mutation.
+func (m *ScheduledPushMutation) LevelCleared() bool {
+	_, ok := m.clearedFields[scheduledpush.FieldLevel]
+	return ok
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *ScheduledPushMutation) ResetLevel() {
+	m.level = nil
+	delete(m.clearedFields, scheduledpush.FieldLevel)
+}
+
+// SetSendAt sets the "send_at" field.
+func (m *ScheduledPushMutation) SetSendAt(t time.Time) {
+	m.send_at = &t
+}
+
+// SendAt returns the value of the "send_at" field in the mutation.
+func (m *ScheduledPushMutation) SendAt() (r time.Time, exists bool) {
+	v := m.send_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendAt returns the old "send_at" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldSendAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendAt: %w", err)
+	}
+	return oldValue.SendAt, nil
+}
+
+// ResetSendAt resets all changes to the "send_at" field.
+func (m *ScheduledPushMutation) ResetSendAt() {
+	m.send_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ScheduledPushMutation) SetStatus(s scheduledpush.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScheduledPushMutation) Status() (r scheduledpush.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldStatus(ctx context.Context) (v scheduledpush.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScheduledPushMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *ScheduledPushMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ScheduledPushMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ScheduledPushMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[scheduledpush.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ScheduledPushMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[scheduledpush.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ScheduledPushMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, scheduledpush.FieldError)
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *ScheduledPushMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *ScheduledPushMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (m *ScheduledPushMutation) ClearSentAt() {
+	m.sent_at = nil
+	m.clearedFields[scheduledpush.FieldSentAt] = struct{}{}
+}
+
+// SentAtCleared returns if the "sent_at" field was cleared in this mutation.
+func (m *ScheduledPushMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[scheduledpush.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *ScheduledPushMutation) ResetSentAt() {
+	m.sent_at = nil
+	delete(m.clearedFields, scheduledpush.FieldSentAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ScheduledPushMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScheduledPushMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScheduledPushMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ScheduledPushMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ScheduledPushMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ScheduledPush entity.
+// If the ScheduledPush object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledPushMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ScheduledPushMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ScheduledPushMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[scheduledpush.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ScheduledPushMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ScheduledPushMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ScheduledPushMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ScheduledPushMutation builder.
+func (m *ScheduledPushMutation) Where(ps ...predicate.ScheduledPush) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScheduledPushMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduledPushMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduledPush, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScheduledPushMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScheduledPushMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScheduledPush).
+func (m *ScheduledPushMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduledPushMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.user != nil {
+		fields = append(fields, scheduledpush.FieldUserID)
+	}
+	if m.provider != nil {
+		fields = append(fields, scheduledpush.FieldProvider)
+	}
+	if m.title != nil {
+		fields = append(fields, scheduledpush.FieldTitle)
+	}
+	if m.body != nil {
+		fields = append(fields, scheduledpush.FieldBody)
+	}
+	if m.url != nil {
+		fields = append(fields, scheduledpush.FieldURL)
+	}
+	if m.sound != nil {
+		fields = append(fields, scheduledpush.FieldSound)
+	}
+	if m.icon != nil {
+		fields = append(fields, scheduledpush.FieldIcon)
+	}
+	if m.group != nil {
+		fields = append(fields, scheduledpush.FieldGroup)
+	}
+	if m.level != nil {
+		fields = append(fields, scheduledpush.FieldLevel)
+	}
+	if m.send_at != nil {
+		fields = append(fields, scheduledpush.FieldSendAt)
+	}
+	if m.status != nil {
+		fields = append(fields, scheduledpush.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, scheduledpush.FieldError)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, scheduledpush.FieldSentAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, scheduledpush.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, scheduledpush.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduledPushMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scheduledpush.FieldUserID:
+		return m.UserID()
+	case scheduledpush.FieldProvider:
+		return m.Provider()
+	case scheduledpush.FieldTitle:
+		return m.Title()
+	case scheduledpush.FieldBody:
+		return m.Body()
+	case scheduledpush.FieldURL:
+		return m.URL()
+	case scheduledpush.FieldSound:
+		return m.Sound()
+	case scheduledpush.FieldIcon:
+		return m.Icon()
+	case scheduledpush.FieldGroup:
+		return m.Group()
+	case scheduledpush.FieldLevel:
+		return m.Level()
+	case scheduledpush.FieldSendAt:
+		return m.SendAt()
+	case scheduledpush.FieldStatus:
+		return m.Status()
+	case scheduledpush.FieldError:
+		return m.Error()
+	case scheduledpush.FieldSentAt:
+		return m.SentAt()
+	case scheduledpush.FieldCreatedAt:
+		return m.CreatedAt()
+	case scheduledpush.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduledPushMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scheduledpush.FieldUserID:
+		return m.OldUserID(ctx)
+	case scheduledpush.FieldProvider:
+		return m.OldProvider(ctx)
+	case scheduledpush.FieldTitle:
+		return m.OldTitle(ctx)
+	case scheduledpush.FieldBody:
+		return m.OldBody(ctx)
+	case scheduledpush.FieldURL:
+		return m.OldURL(ctx)
+	case scheduledpush.FieldSound:
+		return m.OldSound(ctx)
+	case scheduledpush.FieldIcon:
+		return m.OldIcon(ctx)
+	case scheduledpush.FieldGroup:
+		return m.OldGroup(ctx)
+	case scheduledpush.FieldLevel:
+		return m.OldLevel(ctx)
+	case scheduledpush.FieldSendAt:
+		return m.OldSendAt(ctx)
+	case scheduledpush.FieldStatus:
+		return m.OldStatus(ctx)
+	case scheduledpush.FieldError:
+		return m.OldError(ctx)
+	case scheduledpush.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case scheduledpush.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case scheduledpush.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScheduledPush field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduledPushMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scheduledpush.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case scheduledpush.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case scheduledpush.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case scheduledpush.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case scheduledpush.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case scheduledpush.FieldSound:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSound(v)
+		return nil
+	case scheduledpush.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case scheduledpush.FieldGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroup(v)
+		return nil
+	case scheduledpush.FieldLevel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case scheduledpush.FieldSendAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendAt(v)
+		return nil
+	case scheduledpush.FieldStatus:
+		v, ok := value.(scheduledpush.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case scheduledpush.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case scheduledpush.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case scheduledpush.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case scheduledpush.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledPush field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduledPushMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduledPushMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduledPushMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScheduledPush numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduledPushMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scheduledpush.FieldProvider) {
+		fields = append(fields, scheduledpush.FieldProvider)
+	}
+	if m.FieldCleared(scheduledpush.FieldURL) {
+		fields = append(fields, scheduledpush.FieldURL)
+	}
+	if m.FieldCleared(scheduledpush.FieldSound) {
+		fields = append(fields, scheduledpush.FieldSound)
+	}
+	if m.FieldCleared(scheduledpush.FieldIcon) {
+		fields = append(fields, scheduledpush.FieldIcon)
+	}
+	if m.FieldCleared(scheduledpush.FieldGroup) {
+		fields = append(fields, scheduledpush.FieldGroup)
+	}
+	if m.FieldCleared(scheduledpush.FieldLevel) {
+		fields = append(fields, scheduledpush.FieldLevel)
+	}
+	if m.FieldCleared(scheduledpush.FieldError) {
+		fields = append(fields, scheduledpush.FieldError)
+	}
+	if m.FieldCleared(scheduledpush.FieldSentAt) {
+		fields = append(fields, scheduledpush.FieldSentAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduledPushMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduledPushMutation) ClearField(name string) error {
+	switch name {
+	case scheduledpush.FieldProvider:
+		m.ClearProvider()
+		return nil
+	case scheduledpush.FieldURL:
+		m.ClearURL()
+		return nil
+	case scheduledpush.FieldSound:
+		m.ClearSound()
+		return nil
+	case scheduledpush.FieldIcon:
+		m.ClearIcon()
+		return nil
+	case scheduledpush.FieldGroup:
+		m.ClearGroup()
+		return nil
+	case scheduledpush.FieldLevel:
+		m.ClearLevel()
+		return nil
+	case scheduledpush.FieldError:
+		m.ClearError()
+		return nil
+	case scheduledpush.FieldSentAt:
+		m.ClearSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledPush nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduledPushMutation) ResetField(name string) error {
+	switch name {
+	case scheduledpush.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case scheduledpush.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case scheduledpush.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case scheduledpush.FieldBody:
+		m.ResetBody()
+		return nil
+	case scheduledpush.FieldURL:
+		m.ResetURL()
+		return nil
+	case scheduledpush.FieldSound:
+		m.ResetSound()
+		return nil
+	case scheduledpush.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case scheduledpush.FieldGroup:
+		m.ResetGroup()
+		return nil
+	case scheduledpush.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case scheduledpush.FieldSendAt:
+		m.ResetSendAt()
+		return nil
+	case scheduledpush.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scheduledpush.FieldError:
+		m.ResetError()
+		return nil
+	case scheduledpush.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case scheduledpush.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case scheduledpush.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledPush field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduledPushMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, scheduledpush.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduledPushMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scheduledpush.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduledPushMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduledPushMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduledPushMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, scheduledpush.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduledPushMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scheduledpush.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduledPushMutation) ClearEdge(name string) error {
+	switch name {
+	case scheduledpush.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledPush unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduledPushMutation) ResetEdge(name string) error {
+	switch name {
+	case scheduledpush.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledPush edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op                               Op
-	typ                              string
-	id                               *uint
-	username                         *string
-	email                            *string
-	password                         *string
-	nickname                         *string
-	avatar                           *string
-	status                           *user.Status
-	created_at                       *time.Time
-	updated_at                       *time.Time
-	clearedFields                    map[string]struct{}
-	user_roles                       map[uint]struct{}
-	removeduser_roles                map[uint]struct{}
-	cleareduser_roles                bool
-	assigned_user_roles              map[uint]struct{}
-	removedassigned_user_roles       map[uint]struct{}
-	clearedassigned_user_roles       bool
-	assigned_role_permissions        map[uint]struct{}
-	removedassigned_role_permissions map[uint]struct{}
-	clearedassigned_role_permissions bool
-	push_settings                    map[uint]struct{}
-	removedpush_settings             map[uint]struct{}
-	clearedpush_settings             bool
-	done                             bool
-	oldValue                         func(context.Context) (*User, error)
-	predicates                       []predicate.User
+	op                               Op
+	typ                              string
+	id                               *uint
+	username                         *string
+	email                            *string
+	password                         *string
+	nickname                         *string
+	avatar                           *string
+	push_default_group               *string
+	push_default_sound               *string
+	push_default_level               *string
+	status                           *user.Status
+	status_reason                    *string
+	status_changed_at                *time.Time
+	ban_expires_at                   *time.Time
+	created_at                       *time.Time
+	updated_at                       *time.Time
+	clearedFields                    map[string]struct{}
+	user_roles                       map[uint]struct{}
+	removeduser_roles                map[uint]struct{}
+	cleareduser_roles                bool
+	assigned_user_roles              map[uint]struct{}
+	removedassigned_user_roles       map[uint]struct{}
+	clearedassigned_user_roles       bool
+	assigned_role_permissions        map[uint]struct{}
+	removedassigned_role_permissions map[uint]struct{}
+	clearedassigned_role_permissions bool
+	push_settings                    map[uint]struct{}
+	removedpush_settings             map[uint]struct{}
+	clearedpush_settings             bool
+	scheduled_pushes                 map[uint]struct{}
+	removedscheduled_pushes          map[uint]struct{}
+	clearedscheduled_pushes          bool
+	recurring_pushes                 map[uint]struct{}
+	removedrecurring_pushes          map[uint]struct{}
+	clearedrecurring_pushes          bool
+	sessions                         map[uint]struct{}
+	removedsessions                  map[uint]struct{}
+	clearedsessions                  bool
+	live_subscriptions               map[uint]struct{}
+	removedlive_subscriptions        map[uint]struct{}
+	clearedlive_subscriptions        bool
+	done                             bool
+	oldValue                         func(context.Context) (*User, error)
+	predicates                       []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uint) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetNickname sets the "nickname" field.
+func (m *UserMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *UserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (m *UserMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[user.FieldNickname] = struct{}{}
+}
+
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *UserMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[user.FieldNickname]
+	return ok
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *UserMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, user.FieldNickname)
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetPushDefaultGroup sets the "push_default_group" field.
+func (m *UserMutation) SetPushDefaultGroup(s string) {
+	m.push_default_group = &s
+}
+
+// PushDefaultGroup returns the value of the "push_default_group" field in the mutation.
+func (m *UserMutation) PushDefaultGroup() (r string, exists bool) {
+	v := m.push_default_group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPushDefaultGroup returns the old "push_default_group" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPushDefaultGroup(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPushDefaultGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPushDefaultGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPushDefaultGroup: %w", err)
+	}
+	return oldValue.PushDefaultGroup, nil
+}
+
+// ClearPushDefaultGroup clears the value of the "push_default_group" field.
+func (m *UserMutation) ClearPushDefaultGroup() {
+	m.push_default_group = nil
+	m.clearedFields[user.FieldPushDefaultGroup] = struct{}{}
+}
+
+// PushDefaultGroupCleared returns if the "push_default_group" field was cleared in this mutation.
+func (m *UserMutation) PushDefaultGroupCleared() bool {
+	_, ok := m.clearedFields[user.FieldPushDefaultGroup]
+	return ok
+}
+
+// ResetPushDefaultGroup resets all changes to the "push_default_group" field.
+func (m *UserMutation) ResetPushDefaultGroup() {
+	m.push_default_group = nil
+	delete(m.clearedFields, user.FieldPushDefaultGroup)
+}
+
+// SetPushDefaultSound sets the "push_default_sound" field.
+func (m *UserMutation) SetPushDefaultSound(s string) {
+	m.push_default_sound = &s
+}
+
+// PushDefaultSound returns the value of the "push_default_sound" field in the mutation.
+func (m *UserMutation) PushDefaultSound() (r string, exists bool) {
+	v := m.push_default_sound
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPushDefaultSound returns the old "push_default_sound" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPushDefaultSound(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPushDefaultSound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPushDefaultSound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPushDefaultSound: %w", err)
+	}
+	return oldValue.PushDefaultSound, nil
+}
+
+// ClearPushDefaultSound clears the value of the "push_default_sound" field.
+func (m *UserMutation) ClearPushDefaultSound() {
+	m.push_default_sound = nil
+	m.clearedFields[user.FieldPushDefaultSound] = struct{}{}
+}
+
+// PushDefaultSoundCleared returns if the "push_default_sound" field was cleared in this mutation.
+func (m *UserMutation) PushDefaultSoundCleared() bool {
+	_, ok := m.clearedFields[user.FieldPushDefaultSound]
+	return ok
+}
+
+// ResetPushDefaultSound resets all changes to the "push_default_sound" field.
+func (m *UserMutation) ResetPushDefaultSound() {
+	m.push_default_sound = nil
+	delete(m.clearedFields, user.FieldPushDefaultSound)
+}
+
+// SetPushDefaultLevel sets the "push_default_level" field.
+func (m *UserMutation) SetPushDefaultLevel(s string) {
+	m.push_default_level = &s
+}
+
+// PushDefaultLevel returns the value of the "push_default_level" field in the mutation.
+func (m *UserMutation) PushDefaultLevel() (r string, exists bool) {
+	v := m.push_default_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPushDefaultLevel returns the old "push_default_level" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPushDefaultLevel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPushDefaultLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPushDefaultLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPushDefaultLevel: %w", err)
+	}
+	return oldValue.PushDefaultLevel, nil
+}
+
+// ClearPushDefaultLevel clears the value of the "push_default_level" field.
+func (m *UserMutation) ClearPushDefaultLevel() {
+	m.push_default_level = nil
+	m.clearedFields[user.FieldPushDefaultLevel] = struct{}{}
+}
+
+// PushDefaultLevelCleared returns if the "push_default_level" field was cleared in this mutation.
+func (m *UserMutation) PushDefaultLevelCleared() bool {
+	_, ok := m.clearedFields[user.FieldPushDefaultLevel]
+	return ok
+}
+
+// ResetPushDefaultLevel resets all changes to the "push_default_level" field.
+func (m *UserMutation) ResetPushDefaultLevel() {
+	m.push_default_level = nil
+	delete(m.clearedFields, user.FieldPushDefaultLevel)
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(u user.Status) {
+	m.status = &u
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r user.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatus(ctx context.Context) (v user.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (m *UserMutation) SetStatusReason(s string) {
+	m.status_reason = &s
+}
+
+// StatusReason returns the value of the "status_reason" field in the mutation.
+func (m *UserMutation) StatusReason() (r string, exists bool) {
+	v := m.status_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusReason returns the old "status_reason" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatusReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusReason: %w", err)
+	}
+	return oldValue.StatusReason, nil
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (m *UserMutation) ClearStatusReason() {
+	m.status_reason = nil
+	m.clearedFields[user.FieldStatusReason] = struct{}{}
+}
+
+// StatusReasonCleared returns if the "status_reason" field was cleared in this mutation.
+func (m *UserMutation) StatusReasonCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatusReason]
+	return ok
+}
+
+// ResetStatusReason resets all changes to the "status_reason" field.
+func (m *UserMutation) ResetStatusReason() {
+	m.status_reason = nil
+	delete(m.clearedFields, user.FieldStatusReason)
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (m *UserMutation) SetStatusChangedAt(t time.Time) {
+	m.status_changed_at = &t
+}
+
+// StatusChangedAt returns the value of the "status_changed_at" field in the mutation.
+func (m *UserMutation) StatusChangedAt() (r time.Time, exists bool) {
+	v := m.status_changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusChangedAt returns the old "status_changed_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStatusChangedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusChangedAt: %w", err)
+	}
+	return oldValue.StatusChangedAt, nil
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (m *UserMutation) ClearStatusChangedAt() {
+	m.status_changed_at = nil
+	m.clearedFields[user.FieldStatusChangedAt] = struct{}{}
+}
+
+// StatusChangedAtCleared returns if the "status_changed_at" field was cleared in this mutation.
+func (m *UserMutation) StatusChangedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldStatusChangedAt]
+	return ok
+}
+
+// ResetStatusChangedAt resets all changes to the "status_changed_at" field.
+func (m *UserMutation) ResetStatusChangedAt() {
+	m.status_changed_at = nil
+	delete(m.clearedFields, user.FieldStatusChangedAt)
+}
+
+// SetBanExpiresAt sets the "ban_expires_at" field.
+func (m *UserMutation) SetBanExpiresAt(t time.Time) {
+	m.ban_expires_at = &t
+}
+
+// BanExpiresAt returns the value of the "ban_expires_at" field in the mutation.
+func (m *UserMutation) BanExpiresAt() (r time.Time, exists bool) {
+	v := m.ban_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBanExpiresAt returns the old "ban_expires_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBanExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBanExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBanExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBanExpiresAt: %w", err)
+	}
+	return oldValue.BanExpiresAt, nil
+}
+
+// ClearBanExpiresAt clears the value of the "ban_expires_at" field.
+func (m *UserMutation) ClearBanExpiresAt() {
+	m.ban_expires_at = nil
+	m.clearedFields[user.FieldBanExpiresAt] = struct{}{}
+}
+
+// BanExpiresAtCleared returns if the "ban_expires_at" field was cleared in this mutation.
+func (m *UserMutation) BanExpiresAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldBanExpiresAt]
+	return ok
+}
+
+// ResetBanExpiresAt resets all changes to the "ban_expires_at" field.
+func (m *UserMutation) ResetBanExpiresAt() {
+	m.ban_expires_at = nil
+	delete(m.clearedFields, user.FieldBanExpiresAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUserRoleIDs adds the "user_roles" edge to the UserRole entity by ids.
+func (m *UserMutation) AddUserRoleIDs(ids ...uint) {
+	if m.user_roles == nil {
+		m.user_roles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.user_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserRoles clears the "user_roles" edge to the UserRole entity.
+func (m *UserMutation) ClearUserRoles() {
+	m.cleareduser_roles = true
+}
+
+// UserRolesCleared reports if the "user_roles" edge to the UserRole entity was cleared.
+func (m *UserMutation) UserRolesCleared() bool {
+	return m.cleareduser_roles
+}
+
+// RemoveUserRoleIDs removes the "user_roles" edge to the UserRole entity by IDs.
+func (m *UserMutation) RemoveUserRoleIDs(ids ...uint) {
+	if m.removeduser_roles == nil {
+		m.removeduser_roles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.user_roles, ids[i])
+		m.removeduser_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRole entity.
+func (m *UserMutation) RemovedUserRolesIDs() (ids []uint) {
+	for id := range m.removeduser_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
+func (m *UserMutation) UserRolesIDs() (ids []uint) {
+	for id := range m.user_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserRoles resets all changes to the "user_roles" edge.
+func (m *UserMutation) ResetUserRoles() {
+	m.user_roles = nil
+	m.cleareduser_roles = false
+	m.removeduser_roles = nil
+}
+
+// AddAssignedUserRoleIDs adds the "assigned_user_roles" edge to the UserRole entity by ids.
+func (m *UserMutation) AddAssignedUserRoleIDs(ids ...uint) {
+	if m.assigned_user_roles == nil {
+		m.assigned_user_roles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.assigned_user_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignedUserRoles clears the "assigned_user_roles" edge to the UserRole entity.
+func (m *UserMutation) ClearAssignedUserRoles() {
+	m.clearedassigned_user_roles = true
+}
+
+// AssignedUserRolesCleared reports if the "assigned_user_roles" edge to the UserRole entity was cleared.
+func (m *UserMutation) AssignedUserRolesCleared() bool {
+	return m.clearedassigned_user_roles
+}
+
+// RemoveAssignedUserRoleIDs removes the "assigned_user_roles" edge to the UserRole entity by IDs.
+func (m *UserMutation) RemoveAssignedUserRoleIDs(ids ...uint) {
+	if m.removedassigned_user_roles == nil {
+		m.removedassigned_user_roles = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.assigned_user_roles, ids[i])
+		m.removedassigned_user_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignedUserRoles returns the removed IDs of the "assigned_user_roles" edge to the UserRole entity.
+func (m *UserMutation) RemovedAssignedUserRolesIDs() (ids []uint) {
+	for id := range m.removedassigned_user_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignedUserRolesIDs returns the "assigned_user_roles" edge IDs in the mutation.
+func (m *UserMutation) AssignedUserRolesIDs() (ids []uint) {
+	for id := range m.assigned_user_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignedUserRoles resets all changes to the "assigned_user_roles" edge.
+func (m *UserMutation) ResetAssignedUserRoles() {
+	m.assigned_user_roles = nil
+	m.clearedassigned_user_roles = false
+	m.removedassigned_user_roles = nil
+}
+
+// AddAssignedRolePermissionIDs adds the "assigned_role_permissions" edge to the RolePermission entity by ids.
+func (m *UserMutation) AddAssignedRolePermissionIDs(ids ...uint) {
+	if m.assigned_role_permissions == nil {
+		m.assigned_role_permissions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.assigned_role_permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignedRolePermissions clears the "assigned_role_permissions" edge to the RolePermission entity.
+func (m *UserMutation) ClearAssignedRolePermissions() {
+	m.clearedassigned_role_permissions = true
+}
+
+// AssignedRolePermissionsCleared reports if the "assigned_role_permissions" edge to the RolePermission entity was cleared.
+func (m *UserMutation) AssignedRolePermissionsCleared() bool {
+	return m.clearedassigned_role_permissions
+}
+
+// RemoveAssignedRolePermissionIDs removes the "assigned_role_permissions" edge to the RolePermission entity by IDs.
+func (m *UserMutation) RemoveAssignedRolePermissionIDs(ids ...uint) {
+	if m.removedassigned_role_permissions == nil {
+		m.removedassigned_role_permissions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.assigned_role_permissions, ids[i])
+		m.removedassigned_role_permissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignedRolePermissions returns the removed IDs of the "assigned_role_permissions" edge to the RolePermission entity.
+func (m *UserMutation) RemovedAssignedRolePermissionsIDs() (ids []uint) {
+	for id := range m.removedassigned_role_permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignedRolePermissionsIDs returns the "assigned_role_permissions" edge IDs in the mutation.
+func (m *UserMutation) AssignedRolePermissionsIDs() (ids []uint) {
+	for id := range m.assigned_role_permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignedRolePermissions resets all changes to the "assigned_role_permissions" edge.
+func (m *UserMutation) ResetAssignedRolePermissions() {
+	m.assigned_role_permissions = nil
+	m.clearedassigned_role_permissions = false
+	m.removedassigned_role_permissions = nil
+}
+
+// AddPushSettingIDs adds the "push_settings" edge to the UserPushSetting entity by ids.
+func (m *UserMutation) AddPushSettingIDs(ids ...uint) {
+	if m.push_settings == nil {
+		m.push_settings = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.push_settings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPushSettings clears the "push_settings" edge to the UserPushSetting entity.
+func (m *UserMutation) ClearPushSettings() {
+	m.clearedpush_settings = true
+}
+
+// PushSettingsCleared reports if the "push_settings" edge to the UserPushSetting entity was cleared.
+func (m *UserMutation) PushSettingsCleared() bool {
+	return m.clearedpush_settings
+}
+
+// RemovePushSettingIDs removes the "push_settings" edge to the UserPushSetting entity by IDs.
+func (m *UserMutation) RemovePushSettingIDs(ids ...uint) {
+	if m.removedpush_settings == nil {
+		m.removedpush_settings = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.push_settings, ids[i])
+		m.removedpush_settings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPushSettings returns the removed IDs of the "push_settings" edge to the UserPushSetting entity.
+func (m *UserMutation) RemovedPushSettingsIDs() (ids []uint) {
+	for id := range m.removedpush_settings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PushSettingsIDs returns the "push_settings" edge IDs in the mutation.
+func (m *UserMutation) PushSettingsIDs() (ids []uint) {
+	for id := range m.push_settings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPushSettings resets all changes to the "push_settings" edge.
+func (m *UserMutation) ResetPushSettings() {
+	m.push_settings = nil
+	m.clearedpush_settings = false
+	m.removedpush_settings = nil
+}
+
+// AddScheduledPushIDs adds the "scheduled_pushes" edge to the ScheduledPush entity by ids.
+func (m *UserMutation) AddScheduledPushIDs(ids ...uint) {
+	if m.scheduled_pushes == nil {
+		m.scheduled_pushes = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.scheduled_pushes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearScheduledPushes clears the "scheduled_pushes" edge to the ScheduledPush entity.
+func (m *UserMutation) ClearScheduledPushes() {
+	m.clearedscheduled_pushes = true
+}
+
+// ScheduledPushesCleared reports if the "scheduled_pushes" edge to the ScheduledPush entity was cleared.
+func (m *UserMutation) ScheduledPushesCleared() bool {
+	return m.clearedscheduled_pushes
+}
+
+// RemoveScheduledPushIDs removes the "scheduled_pushes" edge to the ScheduledPush entity by IDs.
+func (m *UserMutation) RemoveScheduledPushIDs(ids ...uint) {
+	if m.removedscheduled_pushes == nil {
+		m.removedscheduled_pushes = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.scheduled_pushes, ids[i])
+		m.removedscheduled_pushes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedScheduledPushes returns the removed IDs of the "scheduled_pushes" edge to the ScheduledPush entity.
+func (m *UserMutation) RemovedScheduledPushesIDs() (ids []uint) {
+	for id := range m.removedscheduled_pushes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ScheduledPushesIDs returns the "scheduled_pushes" edge IDs in the mutation.
+func (m *UserMutation) ScheduledPushesIDs() (ids []uint) {
+	for id := range m.scheduled_pushes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetScheduledPushes resets all changes to the "scheduled_pushes" edge.
+func (m *UserMutation) ResetScheduledPushes() {
+	m.scheduled_pushes = nil
+	m.clearedscheduled_pushes = false
+	m.removedscheduled_pushes = nil
+}
+
+// AddRecurringPushIDs adds the "recurring_pushes" edge to the RecurringPush entity by ids.
+func (m *UserMutation) AddRecurringPushIDs(ids ...uint) {
+	if m.recurring_pushes == nil {
+		m.recurring_pushes = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.recurring_pushes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecurringPushes clears the "recurring_pushes" edge to the RecurringPush entity.
+func (m *UserMutation) ClearRecurringPushes() {
+	m.clearedrecurring_pushes = true
+}
+
+// RecurringPushesCleared reports if the "recurring_pushes" edge to the RecurringPush entity was cleared.
+func (m *UserMutation) RecurringPushesCleared() bool {
+	return m.clearedrecurring_pushes
+}
+
+// RemoveRecurringPushIDs removes the "recurring_pushes" edge to the RecurringPush entity by IDs.
+func (m *UserMutation) RemoveRecurringPushIDs(ids ...uint) {
+	if m.removedrecurring_pushes == nil {
+		m.removedrecurring_pushes = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.recurring_pushes, ids[i])
+		m.removedrecurring_pushes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurringPushes returns the removed IDs of the "recurring_pushes" edge to the RecurringPush entity.
+func (m *UserMutation) RemovedRecurringPushesIDs() (ids []uint) {
+	for id := range m.removedrecurring_pushes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecurringPushesIDs returns the "recurring_pushes" edge IDs in the mutation.
+func (m *UserMutation) RecurringPushesIDs() (ids []uint) {
+	for id := range m.recurring_pushes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecurringPushes resets all changes to the "recurring_pushes" edge.
+func (m *UserMutation) ResetRecurringPushes() {
+	m.recurring_pushes = nil
+	m.clearedrecurring_pushes = false
+	m.removedrecurring_pushes = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the UserSession entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...uint) {
+	if m.sessions == nil {
+		m.sessions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the UserSession entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the UserSession entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the UserSession entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...uint) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the UserSession entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []uint) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []uint) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddLiveSubscriptionIDs adds the "live_subscriptions" edge to the LiveSubscription entity by ids.
+func (m *UserMutation) AddLiveSubscriptionIDs(ids ...uint) {
+	if m.live_subscriptions == nil {
+		m.live_subscriptions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		m.live_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLiveSubscriptions clears the "live_subscriptions" edge to the LiveSubscription entity.
+func (m *UserMutation) ClearLiveSubscriptions() {
+	m.clearedlive_subscriptions = true
+}
+
+// LiveSubscriptionsCleared reports if the "live_subscriptions" edge to the LiveSubscription entity was cleared.
+func (m *UserMutation) LiveSubscriptionsCleared() bool {
+	return m.clearedlive_subscriptions
+}
+
+// RemoveLiveSubscriptionIDs removes the "live_subscriptions" edge to the LiveSubscription entity by IDs.
+func (m *UserMutation) RemoveLiveSubscriptionIDs(ids ...uint) {
+	if m.removedlive_subscriptions == nil {
+		m.removedlive_subscriptions = make(map[uint]struct{})
+	}
+	for i := range ids {
+		delete(m.live_subscriptions, ids[i])
+		m.removedlive_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLiveSubscriptions returns the removed IDs of the "live_subscriptions" edge to the LiveSubscription entity.
+func (m *UserMutation) RemovedLiveSubscriptionsIDs() (ids []uint) {
+	for id := range m.removedlive_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LiveSubscriptionsIDs returns the "live_subscriptions" edge IDs in the mutation.
+func (m *UserMutation) LiveSubscriptionsIDs() (ids []uint) {
+	for id := range m.live_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLiveSubscriptions resets all changes to the "live_subscriptions" edge.
+func (m *UserMutation) ResetLiveSubscriptions() {
+	m.live_subscriptions = nil
+	m.clearedlive_subscriptions = false
+	m.removedlive_subscriptions = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.nickname != nil {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.push_default_group != nil {
+		fields = append(fields, user.FieldPushDefaultGroup)
+	}
+	if m.push_default_sound != nil {
+		fields = append(fields, user.FieldPushDefaultSound)
+	}
+	if m.push_default_level != nil {
+		fields = append(fields, user.FieldPushDefaultLevel)
+	}
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.status_reason != nil {
+		fields = append(fields, user.FieldStatusReason)
+	}
+	if m.status_changed_at != nil {
+		fields = append(fields, user.FieldStatusChangedAt)
+	}
+	if m.ban_expires_at != nil {
+		fields = append(fields, user.FieldBanExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldNickname:
+		return m.Nickname()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldPushDefaultGroup:
+		return m.PushDefaultGroup()
+	case user.FieldPushDefaultSound:
+		return m.PushDefaultSound()
+	case user.FieldPushDefaultLevel:
+		return m.PushDefaultLevel()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldStatusReason:
+		return m.StatusReason()
+	case user.FieldStatusChangedAt:
+		return m.StatusChangedAt()
+	case user.FieldBanExpiresAt:
+		return m.BanExpiresAt()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldNickname:
+		return m.OldNickname(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldPushDefaultGroup:
+		return m.OldPushDefaultGroup(ctx)
+	case user.FieldPushDefaultSound:
+		return m.OldPushDefaultSound(ctx)
+	case user.FieldPushDefaultLevel:
+		return m.OldPushDefaultLevel(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldStatusReason:
+		return m.OldStatusReason(ctx)
+	case user.FieldStatusChangedAt:
+		return m.OldStatusChangedAt(ctx)
+	case user.FieldBanExpiresAt:
+		return m.OldBanExpiresAt(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldPushDefaultGroup:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPushDefaultGroup(v)
+		return nil
+	case user.FieldPushDefaultSound:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPushDefaultSound(v)
+		return nil
+	case user.FieldPushDefaultLevel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPushDefaultLevel(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(user.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case user.FieldStatusReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusReason(v)
+		return nil
+	case user.FieldStatusChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusChangedAt(v)
+		return nil
+	case user.FieldBanExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBanExpiresAt(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldNickname) {
+		fields = append(fields, user.FieldNickname)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldPushDefaultGroup) {
+		fields = append(fields, user.FieldPushDefaultGroup)
+	}
+	if m.FieldCleared(user.FieldPushDefaultSound) {
+		fields = append(fields, user.FieldPushDefaultSound)
+	}
+	if m.FieldCleared(user.FieldPushDefaultLevel) {
+		fields = append(fields, user.FieldPushDefaultLevel)
+	}
+	if m.FieldCleared(user.FieldStatusReason) {
+		fields = append(fields, user.FieldStatusReason)
+	}
+	if m.FieldCleared(user.FieldStatusChangedAt) {
+		fields = append(fields, user.FieldStatusChangedAt)
+	}
+	if m.FieldCleared(user.FieldBanExpiresAt) {
+		fields = append(fields, user.FieldBanExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldNickname:
+		m.ClearNickname()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldPushDefaultGroup:
+		m.ClearPushDefaultGroup()
+		return nil
+	case user.FieldPushDefaultSound:
+		m.ClearPushDefaultSound()
+		return nil
+	case user.FieldPushDefaultLevel:
+		m.ClearPushDefaultLevel()
+		return nil
+	case user.FieldStatusReason:
+		m.ClearStatusReason()
+		return nil
+	case user.FieldStatusChangedAt:
+		m.ClearStatusChangedAt()
+		return nil
+	case user.FieldBanExpiresAt:
+		m.ClearBanExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldPushDefaultGroup:
+		m.ResetPushDefaultGroup()
+		return nil
+	case user.FieldPushDefaultSound:
+		m.ResetPushDefaultSound()
+		return nil
+	case user.FieldPushDefaultLevel:
+		m.ResetPushDefaultLevel()
+		return nil
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case user.FieldStatusReason:
+		m.ResetStatusReason()
+		return nil
+	case user.FieldStatusChangedAt:
+		m.ResetStatusChangedAt()
+		return nil
+	case user.FieldBanExpiresAt:
+		m.ResetBanExpiresAt()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.user_roles != nil {
+		edges = append(edges, user.EdgeUserRoles)
+	}
+	if m.assigned_user_roles != nil {
+		edges = append(edges, user.EdgeAssignedUserRoles)
+	}
+	if m.assigned_role_permissions != nil {
+		edges = append(edges, user.EdgeAssignedRolePermissions)
+	}
+	if m.push_settings != nil {
+		edges = append(edges, user.EdgePushSettings)
+	}
+	if m.scheduled_pushes != nil {
+		edges = append(edges, user.EdgeScheduledPushes)
+	}
+	if m.recurring_pushes != nil {
+		edges = append(edges, user.EdgeRecurringPushes)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.live_subscriptions != nil {
+		edges = append(edges, user.EdgeLiveSubscriptions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.user_roles))
+		for id := range m.user_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAssignedUserRoles:
+		ids := make([]ent.Value, 0, len(m.assigned_user_roles))
+		for id := range m.assigned_user_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAssignedRolePermissions:
+		ids := make([]ent.Value, 0, len(m.assigned_role_permissions))
+		for id := range m.assigned_role_permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePushSettings:
+		ids := make([]ent.Value, 0, len(m.push_settings))
+		for id := range m.push_settings {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeScheduledPushes:
+		ids := make([]ent.Value, 0, len(m.scheduled_pushes))
+		for id := range m.scheduled_pushes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecurringPushes:
+		ids := make([]ent.Value, 0, len(m.recurring_pushes))
+		for id := range m.recurring_pushes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLiveSubscriptions:
+		ids := make([]ent.Value, 0, len(m.live_subscriptions))
+		for id := range m.live_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removeduser_roles != nil {
+		edges = append(edges, user.EdgeUserRoles)
+	}
+	if m.removedassigned_user_roles != nil {
+		edges = append(edges, user.EdgeAssignedUserRoles)
+	}
+	if m.removedassigned_role_permissions != nil {
+		edges = append(edges, user.EdgeAssignedRolePermissions)
+	}
+	if m.removedpush_settings != nil {
+		edges = append(edges, user.EdgePushSettings)
+	}
+	if m.removedscheduled_pushes != nil {
+		edges = append(edges, user.EdgeScheduledPushes)
+	}
+	if m.removedrecurring_pushes != nil {
+		edges = append(edges, user.EdgeRecurringPushes)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedlive_subscriptions != nil {
+		edges = append(edges, user.EdgeLiveSubscriptions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.removeduser_roles))
+		for id := range m.removeduser_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAssignedUserRoles:
+		ids := make([]ent.Value, 0, len(m.removedassigned_user_roles))
+		for id := range m.removedassigned_user_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAssignedRolePermissions:
+		ids := make([]ent.Value, 0, len(m.removedassigned_role_permissions))
+		for id := range m.removedassigned_role_permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePushSettings:
+		ids := make([]ent.Value, 0, len(m.removedpush_settings))
+		for id := range m.removedpush_settings {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeScheduledPushes:
+		ids := make([]ent.Value, 0, len(m.removedscheduled_pushes))
+		for id := range m.removedscheduled_pushes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecurringPushes:
+		ids := make([]ent.Value, 0, len(m.removedrecurring_pushes))
+		for id := range m.removedrecurring_pushes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLiveSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedlive_subscriptions))
+		for id := range m.removedlive_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.cleareduser_roles {
+		edges = append(edges, user.EdgeUserRoles)
+	}
+	if m.clearedassigned_user_roles {
+		edges = append(edges, user.EdgeAssignedUserRoles)
+	}
+	if m.clearedassigned_role_permissions {
+		edges = append(edges, user.EdgeAssignedRolePermissions)
+	}
+	if m.clearedpush_settings {
+		edges = append(edges, user.EdgePushSettings)
+	}
+	if m.clearedscheduled_pushes {
+		edges = append(edges, user.EdgeScheduledPushes)
+	}
+	if m.clearedrecurring_pushes {
+		edges = append(edges, user.EdgeRecurringPushes)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedlive_subscriptions {
+		edges = append(edges, user.EdgeLiveSubscriptions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeUserRoles:
+		return m.cleareduser_roles
+	case user.EdgeAssignedUserRoles:
+		return m.clearedassigned_user_roles
+	case user.EdgeAssignedRolePermissions:
+		return m.clearedassigned_role_permissions
+	case user.EdgePushSettings:
+		return m.clearedpush_settings
+	case user.EdgeScheduledPushes:
+		return m.clearedscheduled_pushes
+	case user.EdgeRecurringPushes:
+		return m.clearedrecurring_pushes
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeLiveSubscriptions:
+		return m.clearedlive_subscriptions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeUserRoles:
+		m.ResetUserRoles()
+		return nil
+	case user.EdgeAssignedUserRoles:
+		m.ResetAssignedUserRoles()
+		return nil
+	case user.EdgeAssignedRolePermissions:
+		m.ResetAssignedRolePermissions()
+		return nil
+	case user.EdgePushSettings:
+		m.ResetPushSettings()
+		return nil
+	case user.EdgeScheduledPushes:
+		m.ResetScheduledPushes()
+		return nil
+	case user.EdgeRecurringPushes:
+		m.ResetRecurringPushes()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgeLiveSubscriptions:
+		m.ResetLiveSubscriptions()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// UserPushSettingMutation represents an operation that mutates the UserPushSetting nodes in the graph.
+type UserPushSettingMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uint
+	provider                *userpushsetting.Provider
+	enabled                 *bool
+	device_id               *string
+	device_name             *string
+	settings                *map[string]interface{}
+	consecutive_failures    *int
+	addconsecutive_failures *int
+	last_failure_at         *time.Time
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	user                    *uint
+	cleareduser             bool
+	done                    bool
+	oldValue                func(context.Context) (*UserPushSetting, error)
+	predicates              []predicate.UserPushSetting
+}
+
+var _ ent.Mutation = (*UserPushSettingMutation)(nil)
+
+// userpushsettingOption allows management of the mutation configuration using functional options.
+type userpushsettingOption func(*UserPushSettingMutation)
+
+// newUserPushSettingMutation creates new mutation for the UserPushSetting entity.
+func newUserPushSettingMutation(c config, op Op, opts ...userpushsettingOption) *UserPushSettingMutation {
+	m := &UserPushSettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserPushSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserPushSettingID sets the ID field of the mutation.
+func withUserPushSettingID(id uint) userpushsettingOption {
+	return func(m *UserPushSettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserPushSetting
+		)
+		m.oldValue = func(ctx context.Context) (*UserPushSetting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserPushSetting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserPushSetting sets the old UserPushSetting of the mutation.
+func withUserPushSetting(node *UserPushSetting) userpushsettingOption {
+	return func(m *UserPushSettingMutation) {
+		m.oldValue = func(context.Context) (*UserPushSetting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserPushSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserPushSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserPushSetting entities.
+func (m *UserPushSettingMutation) SetID(id uint) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserPushSettingMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserPushSettingMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserPushSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UserPushSettingMutation) SetUserID(u uint) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserPushSettingMutation) UserID() (r uint, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldUserID(ctx context.Context) (v uint, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserPushSettingMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *UserPushSettingMutation) SetProvider(u userpushsetting.Provider) {
+	m.provider = &u
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *UserPushSettingMutation) Provider() (r userpushsetting.Provider, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldProvider(ctx context.Context) (v userpushsetting.Provider, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *UserPushSettingMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *UserPushSettingMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *UserPushSettingMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *UserPushSettingMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *UserPushSettingMutation) SetDeviceID(s string) {
+	m.device_id = &s
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *UserPushSettingMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *UserPushSettingMutation) ResetDeviceID() {
+	m.device_id = nil
+}
+
+// SetDeviceName sets the "device_name" field.
+func (m *UserPushSettingMutation) SetDeviceName(s string) {
+	m.device_name = &s
+}
+
+// DeviceName returns the value of the "device_name" field in the mutation.
+func (m *UserPushSettingMutation) DeviceName() (r string, exists bool) {
+	v := m.device_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceName returns the old "device_name" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldDeviceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
+	}
+	return oldValue.DeviceName, nil
+}
+
+// ClearDeviceName clears the value of the "device_name" field.
+func (m *UserPushSettingMutation) ClearDeviceName() {
+	m.device_name = nil
+	m.clearedFields[userpushsetting.FieldDeviceName] = struct{}{}
+}
+
+// DeviceNameCleared returns if the "device_name" field was cleared in this mutation.
+func (m *UserPushSettingMutation) DeviceNameCleared() bool {
+	_, ok := m.clearedFields[userpushsetting.FieldDeviceName]
+	return ok
+}
+
+// ResetDeviceName resets all changes to the "device_name" field.
+func (m *UserPushSettingMutation) ResetDeviceName() {
+	m.device_name = nil
+	delete(m.clearedFields, userpushsetting.FieldDeviceName)
+}
+
+// SetSettings sets the "settings" field.
+func (m *UserPushSettingMutation) SetSettings(value map[string]interface{}) {
+	m.settings = &value
+}
+
+// Settings returns the value of the "settings" field in the mutation.
+func (m *UserPushSettingMutation) Settings() (r map[string]interface{}, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettings returns the old "settings" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldSettings(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (m *UserPushSettingMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[userpushsetting.FieldSettings] = struct{}{}
+}
+
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *UserPushSettingMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[userpushsetting.FieldSettings]
+	return ok
+}
+
+// ResetSettings resets all changes to the "settings" field.
+func (m *UserPushSettingMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, userpushsetting.FieldSettings)
+}
+
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *UserPushSettingMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
+}
+
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *UserPushSettingMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *UserPushSettingMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
+}
+
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *UserPushSettingMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *UserPushSettingMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (m *UserPushSettingMutation) SetLastFailureAt(t time.Time) {
+	m.last_failure_at = &t
+}
+
+// LastFailureAt returns the value of the "last_failure_at" field in the mutation.
+func (m *UserPushSettingMutation) LastFailureAt() (r time.Time, exists bool) {
+	v := m.last_failure_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFailureAt returns the old "last_failure_at" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldLastFailureAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFailureAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFailureAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFailureAt: %w", err)
+	}
+	return oldValue.LastFailureAt, nil
+}
+
+// ClearLastFailureAt clears the value of the "last_failure_at" field.
+func (m *UserPushSettingMutation) ClearLastFailureAt() {
+	m.last_failure_at = nil
+	m.clearedFields[userpushsetting.FieldLastFailureAt] = struct{}{}
+}
+
+// LastFailureAtCleared returns if the "last_failure_at" field was cleared in this mutation.
+func (m *UserPushSettingMutation) LastFailureAtCleared() bool {
+	_, ok := m.clearedFields[userpushsetting.FieldLastFailureAt]
+	return ok
+}
+
+// ResetLastFailureAt resets all changes to the "last_failure_at" field.
+func (m *UserPushSettingMutation) ResetLastFailureAt() {
+	m.last_failure_at = nil
+	delete(m.clearedFields, userpushsetting.FieldLastFailureAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserPushSettingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserPushSettingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserPushSettingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserPushSettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserPushSettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the UserPushSetting entity.
+// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPushSettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserPushSettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserPushSettingMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userpushsetting.FieldUserID] = struct{}{}
+}
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserPushSettingMutation) UserCleared() bool {
+	return m.cleareduser
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserPushSettingMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	for _, opt := range opts {
-		opt(m)
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserPushSettingMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the UserPushSettingMutation builder.
+func (m *UserPushSettingMutation) Where(ps ...predicate.UserPushSetting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserPushSettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserPushSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserPushSetting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return m
+	m.Where(p...)
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uint) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// Op returns the operation name.
+func (m *UserPushSettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserPushSettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserPushSetting).
+func (m *UserPushSettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserPushSettingMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user != nil {
+		fields = append(fields, userpushsetting.FieldUserID)
+	}
+	if m.provider != nil {
+		fields = append(fields, userpushsetting.FieldProvider)
+	}
+	if m.enabled != nil {
+		fields = append(fields, userpushsetting.FieldEnabled)
 	}
+	if m.device_id != nil {
+		fields = append(fields, userpushsetting.FieldDeviceID)
+	}
+	if m.device_name != nil {
+		fields = append(fields, userpushsetting.FieldDeviceName)
+	}
+	if m.settings != nil {
+		fields = append(fields, userpushsetting.FieldSettings)
+	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, userpushsetting.FieldConsecutiveFailures)
+	}
+	if m.last_failure_at != nil {
+		fields = append(fields, userpushsetting.FieldLastFailureAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, userpushsetting.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, userpushsetting.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserPushSettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userpushsetting.FieldUserID:
+		return m.UserID()
+	case userpushsetting.FieldProvider:
+		return m.Provider()
+	case userpushsetting.FieldEnabled:
+		return m.Enabled()
+	case userpushsetting.FieldDeviceID:
+		return m.DeviceID()
+	case userpushsetting.FieldDeviceName:
+		return m.DeviceName()
+	case userpushsetting.FieldSettings:
+		return m.Settings()
+	case userpushsetting.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case userpushsetting.FieldLastFailureAt:
+		return m.LastFailureAt()
+	case userpushsetting.FieldCreatedAt:
+		return m.CreatedAt()
+	case userpushsetting.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserPushSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userpushsetting.FieldUserID:
+		return m.OldUserID(ctx)
+	case userpushsetting.FieldProvider:
+		return m.OldProvider(ctx)
+	case userpushsetting.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case userpushsetting.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case userpushsetting.FieldDeviceName:
+		return m.OldDeviceName(ctx)
+	case userpushsetting.FieldSettings:
+		return m.OldSettings(ctx)
+	case userpushsetting.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case userpushsetting.FieldLastFailureAt:
+		return m.OldLastFailureAt(ctx)
+	case userpushsetting.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case userpushsetting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserPushSetting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserPushSettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userpushsetting.FieldUserID:
+		v, ok := value.(uint)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.id = &node.ID
+		m.SetUserID(v)
+		return nil
+	case userpushsetting.FieldProvider:
+		v, ok := value.(userpushsetting.Provider)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case userpushsetting.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case userpushsetting.FieldDeviceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case userpushsetting.FieldDeviceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceName(v)
+		return nil
+	case userpushsetting.FieldSettings:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
+	case userpushsetting.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case userpushsetting.FieldLastFailureAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFailureAt(v)
+		return nil
+	case userpushsetting.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case userpushsetting.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserPushSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserPushSettingMutation) AddedFields() []string {
+	var fields []string
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, userpushsetting.FieldConsecutiveFailures)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserPushSettingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case userpushsetting.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
 	}
+	return nil, false
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserPushSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case userpushsetting.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserPushSetting numeric field %s", name)
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserPushSettingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(userpushsetting.FieldDeviceName) {
+		fields = append(fields, userpushsetting.FieldDeviceName)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.FieldCleared(userpushsetting.FieldSettings) {
+		fields = append(fields, userpushsetting.FieldSettings)
+	}
+	if m.FieldCleared(userpushsetting.FieldLastFailureAt) {
+		fields = append(fields, userpushsetting.FieldLastFailureAt)
+	}
+	return fields
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uint) {
-	m.id = &id
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserPushSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uint, exists bool) {
-	if m.id == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserPushSettingMutation) ClearField(name string) error {
+	switch name {
+	case userpushsetting.FieldDeviceName:
+		m.ClearDeviceName()
+		return nil
+	case userpushsetting.FieldSettings:
+		m.ClearSettings()
+		return nil
+	case userpushsetting.FieldLastFailureAt:
+		m.ClearLastFailureAt()
+		return nil
 	}
-	return *m.id, true
+	return fmt.Errorf("unknown UserPushSetting nullable field %s", name)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uint, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserPushSettingMutation) ResetField(name string) error {
+	switch name {
+	case userpushsetting.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case userpushsetting.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case userpushsetting.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case userpushsetting.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case userpushsetting.FieldDeviceName:
+		m.ResetDeviceName()
+		return nil
+	case userpushsetting.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case userpushsetting.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case userpushsetting.FieldLastFailureAt:
+		m.ResetLastFailureAt()
+		return nil
+	case userpushsetting.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case userpushsetting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown UserPushSetting field %s", name)
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
-}
-
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserPushSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, userpushsetting.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserPushSettingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case userpushsetting.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Username, nil
+	return nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserPushSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserPushSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserPushSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, userpushsetting.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserPushSettingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case userpushsetting.EdgeUser:
+		return m.cleareduser
 	}
-	return oldValue.Email, nil
+	return false
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserPushSettingMutation) ClearEdge(name string) error {
+	switch name {
+	case userpushsetting.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPushSetting unique edge %s", name)
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserPushSettingMutation) ResetEdge(name string) error {
+	switch name {
+	case userpushsetting.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPushSetting edge %s", name)
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserRoleMutation represents an operation that mutates the UserRole nodes in the graph.
+type UserRoleMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uint
+	assigned_at     *time.Time
+	expires_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uint
+	cleareduser     bool
+	role            *uint
+	clearedrole     bool
+	assigner        *uint
+	clearedassigner bool
+	done            bool
+	oldValue        func(context.Context) (*UserRole, error)
+	predicates      []predicate.UserRole
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+var _ ent.Mutation = (*UserRoleMutation)(nil)
+
+// userroleOption allows management of the mutation configuration using functional options.
+type userroleOption func(*UserRoleMutation)
+
+// newUserRoleMutation creates new mutation for the UserRole entity.
+func newUserRoleMutation(c config, op Op, opts ...userroleOption) *UserRoleMutation {
+	m := &UserRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserRole,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.Password, nil
+	return m
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// withUserRoleID sets the ID field of the mutation.
+func withUserRoleID(id uint) userroleOption {
+	return func(m *UserRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserRole
+		)
+		m.oldValue = func(ctx context.Context) (*UserRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetNickname sets the "nickname" field.
-func (m *UserMutation) SetNickname(s string) {
-	m.nickname = &s
+// withUserRole sets the old UserRole of the mutation.
+func withUserRole(node *UserRole) userroleOption {
+	return func(m *UserRoleMutation) {
+		m.oldValue = func(context.Context) (*UserRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *UserMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldNickname returns the old "nickname" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Nickname, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearNickname clears the value of the "nickname" field.
-func (m *UserMutation) ClearNickname() {
-	m.nickname = nil
-	m.clearedFields[user.FieldNickname] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserRole entities.
+func (m *UserRoleMutation) SetID(id uint) {
+	m.id = &id
 }
 
-// NicknameCleared returns if the "nickname" field was cleared in this mutation.
-func (m *UserMutation) NicknameCleared() bool {
-	_, ok := m.clearedFields[user.FieldNickname]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserRoleMutation) ID() (id uint, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *UserMutation) ResetNickname() {
-	m.nickname = nil
-	delete(m.clearedFields, user.FieldNickname)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserRoleMutation) IDs(ctx context.Context) ([]uint, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetUserID sets the "user_id" field.
+func (m *UserRoleMutation) SetUserID(u uint) {
+	m.user = &u
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserRoleMutation) UserID() (r uint, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserRole entity.
+// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+func (m *UserRoleMutation) OldUserID(ctx context.Context) (v uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Avatar, nil
-}
-
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
-}
-
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserRoleMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *UserMutation) SetStatus(u user.Status) {
-	m.status = &u
+// SetRoleID sets the "role_id" field.
+func (m *UserRoleMutation) SetRoleID(u uint) {
+	m.role = &u
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *UserMutation) Status() (r user.Status, exists bool) {
-	v := m.status
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *UserRoleMutation) RoleID() (r uint, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldRoleID returns the old "role_id" field's value of the UserRole entity.
+// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStatus(ctx context.Context) (v user.Status, err error) {
+func (m *UserRoleMutation) OldRoleID(ctx context.Context) (v uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.RoleID, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *UserMutation) ResetStatus() {
-	m.status = nil
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *UserRoleMutation) ResetRoleID() {
+	m.role = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAssignedBy sets the "assigned_by" field.
+func (m *UserRoleMutation) SetAssignedBy(u uint) {
+	m.assigner = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AssignedBy returns the value of the "assigned_by" field in the mutation.
+func (m *UserRoleMutation) AssignedBy() (r uint, exists bool) {
+	v := m.assigner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAssignedBy returns the old "assigned_by" field's value of the UserRole entity.
+// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserRoleMutation) OldAssignedBy(ctx context.Context) (v uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AssignedBy, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (m *UserRoleMutation) ClearAssignedBy() {
+	m.assigner = nil
+	m.clearedFields[userrole.FieldAssignedBy] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AssignedByCleared returns if the "assigned_by" field was cleared in this mutation.
+func (m *UserRoleMutation) AssignedByCleared() bool {
+	_, ok := m.clearedFields[userrole.FieldAssignedBy]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetAssignedBy resets all changes to the "assigned_by" field.
+func (m *UserRoleMutation) ResetAssignedBy() {
+	m.assigner = nil
+	delete(m.clearedFields, userrole.FieldAssignedBy)
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (m *UserRoleMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *UserRoleMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAssignedAt returns the old "assigned_at" field's value of the UserRole entity.
+// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserRoleMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddUserRoleIDs adds the "user_roles" edge to the UserRole entity by ids.
-func (m *UserMutation) AddUserRoleIDs(ids ...uint) {
-	if m.user_roles == nil {
-		m.user_roles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.user_roles[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
 	}
+	return oldValue.AssignedAt, nil
 }
 
-// ClearUserRoles clears the "user_roles" edge to the UserRole entity.
-func (m *UserMutation) ClearUserRoles() {
-	m.cleareduser_roles = true
-}
-
-// UserRolesCleared reports if the "user_roles" edge to the UserRole entity was cleared.
-func (m *UserMutation) UserRolesCleared() bool {
-	return m.cleareduser_roles
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *UserRoleMutation) ResetAssignedAt() {
+	m.assigned_at = nil
 }
 
-// RemoveUserRoleIDs removes the "user_roles" edge to the UserRole entity by IDs.
-func (m *UserMutation) RemoveUserRoleIDs(ids ...uint) {
-	if m.removeduser_roles == nil {
-		m.removeduser_roles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.user_roles, ids[i])
-		m.removeduser_roles[ids[i]] = struct{}{}
-	}
+// SetExpiresAt sets the "expires_at" field.
+func (m *UserRoleMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRole entity.
-func (m *UserMutation) RemovedUserRolesIDs() (ids []uint) {
-	for id := range m.removeduser_roles {
-		ids = append(ids, id)
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *UserRoleMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
-func (m *UserMutation) UserRolesIDs() (ids []uint) {
-	for id := range m.user_roles {
-		ids = append(ids, id)
+// OldExpiresAt returns the old "expires_at" field's value of the UserRole entity.
+// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserRoleMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetUserRoles resets all changes to the "user_roles" edge.
-func (m *UserMutation) ResetUserRoles() {
-	m.user_roles = nil
-	m.cleareduser_roles = false
-	m.removeduser_roles = nil
-}
-
-// AddAssignedUserRoleIDs adds the "assigned_user_roles" edge to the UserRole entity by ids.
-func (m *UserMutation) AddAssignedUserRoleIDs(ids ...uint) {
-	if m.assigned_user_roles == nil {
-		m.assigned_user_roles = make(map[uint]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.assigned_user_roles[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearAssignedUserRoles clears the "assigned_user_roles" edge to the UserRole entity.
-func (m *UserMutation) ClearAssignedUserRoles() {
-	m.clearedassigned_user_roles = true
-}
-
-// AssignedUserRolesCleared reports if the "assigned_user_roles" edge to the UserRole entity was cleared.
-func (m *UserMutation) AssignedUserRolesCleared() bool {
-	return m.clearedassigned_user_roles
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *UserRoleMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[userrole.FieldExpiresAt] = struct{}{}
 }
 
-// RemoveAssignedUserRoleIDs removes the "assigned_user_roles" edge to the UserRole entity by IDs.
-func (m *UserMutation) RemoveAssignedUserRoleIDs(ids ...uint) {
-	if m.removedassigned_user_roles == nil {
-		m.removedassigned_user_roles = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.assigned_user_roles, ids[i])
-		m.removedassigned_user_roles[ids[i]] = struct{}{}
-	}
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *UserRoleMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[userrole.FieldExpiresAt]
+	return ok
 }
 
-// RemovedAssignedUserRoles returns the removed IDs of the "assigned_user_roles" edge to the UserRole entity.
-func (m *UserMutation) RemovedAssignedUserRolesIDs() (ids []uint) {
-	for id := range m.removedassigned_user_roles {
-		ids = append(ids, id)
-	}
-	return
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *UserRoleMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, userrole.FieldExpiresAt)
 }
 
-// AssignedUserRolesIDs returns the "assigned_user_roles" edge IDs in the mutation.
-func (m *UserMutation) AssignedUserRolesIDs() (ids []uint) {
-	for id := range m.assigned_user_roles {
-		ids = append(ids, id)
-	}
-	return
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserRoleMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userrole.FieldUserID] = struct{}{}
 }
 
-// ResetAssignedUserRoles resets all changes to the "assigned_user_roles" edge.
-func (m *UserMutation) ResetAssignedUserRoles() {
-	m.assigned_user_roles = nil
-	m.clearedassigned_user_roles = false
-	m.removedassigned_user_roles = nil
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserRoleMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// AddAssignedRolePermissionIDs adds the "assigned_role_permissions" edge to the RolePermission entity by ids.
-func (m *UserMutation) AddAssignedRolePermissionIDs(ids ...uint) {
-	if m.assigned_role_permissions == nil {
-		m.assigned_role_permissions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.assigned_role_permissions[ids[i]] = struct{}{}
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserRoleMutation) UserIDs() (ids []uint) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearAssignedRolePermissions clears the "assigned_role_permissions" edge to the RolePermission entity.
-func (m *UserMutation) ClearAssignedRolePermissions() {
-	m.clearedassigned_role_permissions = true
-}
-
-// AssignedRolePermissionsCleared reports if the "assigned_role_permissions" edge to the RolePermission entity was cleared.
-func (m *UserMutation) AssignedRolePermissionsCleared() bool {
-	return m.clearedassigned_role_permissions
+// ResetUser resets all changes to the "user" edge.
+func (m *UserRoleMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// RemoveAssignedRolePermissionIDs removes the "assigned_role_permissions" edge to the RolePermission entity by IDs.
-func (m *UserMutation) RemoveAssignedRolePermissionIDs(ids ...uint) {
-	if m.removedassigned_role_permissions == nil {
-		m.removedassigned_role_permissions = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.assigned_role_permissions, ids[i])
-		m.removedassigned_role_permissions[ids[i]] = struct{}{}
-	}
+// ClearRole clears the "role" edge to the Role entity.
+func (m *UserRoleMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[userrole.FieldRoleID] = struct{}{}
 }
 
-// RemovedAssignedRolePermissions returns the removed IDs of the "assigned_role_permissions" edge to the RolePermission entity.
-func (m *UserMutation) RemovedAssignedRolePermissionsIDs() (ids []uint) {
-	for id := range m.removedassigned_role_permissions {
-		ids = append(ids, id)
-	}
-	return
+// RoleCleared reports if the "role" edge to the Role entity was cleared.
+func (m *UserRoleMutation) RoleCleared() bool {
+	return m.clearedrole
 }
 
-// AssignedRolePermissionsIDs returns the "assigned_role_permissions" edge IDs in the mutation.
-func (m *UserMutation) AssignedRolePermissionsIDs() (ids []uint) {
-	for id := range m.assigned_role_permissions {
-		ids = append(ids, id)
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *UserRoleMutation) RoleIDs() (ids []uint) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAssignedRolePermissions resets all changes to the "assigned_role_permissions" edge.
-func (m *UserMutation) ResetAssignedRolePermissions() {
-	m.assigned_role_permissions = nil
-	m.clearedassigned_role_permissions = false
-	m.removedassigned_role_permissions = nil
-}
-
-// AddPushSettingIDs adds the "push_settings" edge to the UserPushSetting entity by ids.
-func (m *UserMutation) AddPushSettingIDs(ids ...uint) {
-	if m.push_settings == nil {
-		m.push_settings = make(map[uint]struct{})
-	}
-	for i := range ids {
-		m.push_settings[ids[i]] = struct{}{}
-	}
+// ResetRole resets all changes to the "role" edge.
+func (m *UserRoleMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
 }
 
-// ClearPushSettings clears the "push_settings" edge to the UserPushSetting entity.
-func (m *UserMutation) ClearPushSettings() {
-	m.clearedpush_settings = true
+// SetAssignerID sets the "assigner" edge to the User entity by id.
+func (m *UserRoleMutation) SetAssignerID(id uint) {
+	m.assigner = &id
 }
 
-// PushSettingsCleared reports if the "push_settings" edge to the UserPushSetting entity was cleared.
-func (m *UserMutation) PushSettingsCleared() bool {
-	return m.clearedpush_settings
+// ClearAssigner clears the "assigner" edge to the User entity.
+func (m *UserRoleMutation) ClearAssigner() {
+	m.clearedassigner = true
+	m.clearedFields[userrole.FieldAssignedBy] = struct{}{}
 }
 
-// RemovePushSettingIDs removes the "push_settings" edge to the UserPushSetting entity by IDs.
-func (m *UserMutation) RemovePushSettingIDs(ids ...uint) {
-	if m.removedpush_settings == nil {
-		m.removedpush_settings = make(map[uint]struct{})
-	}
-	for i := range ids {
-		delete(m.push_settings, ids[i])
-		m.removedpush_settings[ids[i]] = struct{}{}
-	}
+// AssignerCleared reports if the "assigner" edge to the User entity was cleared.
+func (m *UserRoleMutation) AssignerCleared() bool {
+	return m.AssignedByCleared() || m.clearedassigner
 }
 
-// RemovedPushSettings returns the removed IDs of the "push_settings" edge to the UserPushSetting entity.
-func (m *UserMutation) RemovedPushSettingsIDs() (ids []uint) {
-	for id := range m.removedpush_settings {
-		ids = append(ids, id)
+// AssignerID returns the "assigner" edge ID in the mutation.
+func (m *UserRoleMutation) AssignerID() (id uint, exists bool) {
+	if m.assigner != nil {
+		return *m.assigner, true
 	}
 	return
 }
 
-// PushSettingsIDs returns the "push_settings" edge IDs in the mutation.
-func (m *UserMutation) PushSettingsIDs() (ids []uint) {
-	for id := range m.push_settings {
-		ids = append(ids, id)
+// AssignerIDs returns the "assigner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AssignerID instead. It exists only for internal usage by the builders.
+func (m *UserRoleMutation) AssignerIDs() (ids []uint) {
+	if id := m.assigner; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
-
-// ResetPushSettings resets all changes to the "push_settings" edge.
-func (m *UserMutation) ResetPushSettings() {
-	m.push_settings = nil
-	m.clearedpush_settings = false
-	m.removedpush_settings = nil
+
+// ResetAssigner resets all changes to the "assigner" edge.
+func (m *UserRoleMutation) ResetAssigner() {
+	m.assigner = nil
+	m.clearedassigner = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserRoleMutation builder.
+func (m *UserRoleMutation) Where(ps ...predicate.UserRole) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserRoleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserRole, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3022,48 +10302,39 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserRoleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserRoleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserRole).
+func (m *UserRoleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
-	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
-	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
-	}
-	if m.nickname != nil {
-		fields = append(fields, user.FieldNickname)
+func (m *UserRoleMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user != nil {
+		fields = append(fields, userrole.FieldUserID)
 	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
+	if m.role != nil {
+		fields = append(fields, userrole.FieldRoleID)
 	}
-	if m.status != nil {
-		fields = append(fields, user.FieldStatus)
+	if m.assigner != nil {
+		fields = append(fields, userrole.FieldAssignedBy)
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	if m.assigned_at != nil {
+		fields = append(fields, userrole.FieldAssignedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+	if m.expires_at != nil {
+		fields = append(fields, userrole.FieldExpiresAt)
 	}
 	return fields
 }
@@ -3071,24 +10342,18 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserRoleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldNickname:
-		return m.Nickname()
-	case user.FieldAvatar:
-		return m.Avatar()
-	case user.FieldStatus:
-		return m.Status()
-	case user.FieldCreatedAt:
-		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case userrole.FieldUserID:
+		return m.UserID()
+	case userrole.FieldRoleID:
+		return m.RoleID()
+	case userrole.FieldAssignedBy:
+		return m.AssignedBy()
+	case userrole.FieldAssignedAt:
+		return m.AssignedAt()
+	case userrole.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -3096,375 +10361,289 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldNickname:
-		return m.OldNickname(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case user.FieldStatus:
-		return m.OldStatus(ctx)
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case userrole.FieldUserID:
+		return m.OldUserID(ctx)
+	case userrole.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case userrole.FieldAssignedBy:
+		return m.OldAssignedBy(ctx)
+	case userrole.FieldAssignedAt:
+		return m.OldAssignedAt(ctx)
+	case userrole.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserRole field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserRoleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsername(v)
-		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case user.FieldNickname:
-		v, ok := value.(string)
+	case userrole.FieldUserID:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNickname(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldAvatar:
-		v, ok := value.(string)
+	case userrole.FieldRoleID:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetRoleID(v)
 		return nil
-	case user.FieldStatus:
-		v, ok := value.(user.Status)
+	case userrole.FieldAssignedBy:
+		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetAssignedBy(v)
 		return nil
-	case user.FieldCreatedAt:
+	case userrole.FieldAssignedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetAssignedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case userrole.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserRole field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *UserRoleMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserRoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserRoleMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserRole numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *UserRoleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldNickname) {
-		fields = append(fields, user.FieldNickname)
+	if m.FieldCleared(userrole.FieldAssignedBy) {
+		fields = append(fields, userrole.FieldAssignedBy)
 	}
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
+	if m.FieldCleared(userrole.FieldExpiresAt) {
+		fields = append(fields, userrole.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserRoleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *UserRoleMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldNickname:
-		m.ClearNickname()
+	case userrole.FieldAssignedBy:
+		m.ClearAssignedBy()
 		return nil
-	case user.FieldAvatar:
-		m.ClearAvatar()
+	case userrole.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown UserRole nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserRoleMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case user.FieldNickname:
-		m.ResetNickname()
+	case userrole.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
+	case userrole.FieldRoleID:
+		m.ResetRoleID()
 		return nil
-	case user.FieldStatus:
-		m.ResetStatus()
+	case userrole.FieldAssignedBy:
+		m.ResetAssignedBy()
 		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case userrole.FieldAssignedAt:
+		m.ResetAssignedAt()
 		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case userrole.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserRole field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.user_roles != nil {
-		edges = append(edges, user.EdgeUserRoles)
-	}
-	if m.assigned_user_roles != nil {
-		edges = append(edges, user.EdgeAssignedUserRoles)
+func (m *UserRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, userrole.EdgeUser)
 	}
-	if m.assigned_role_permissions != nil {
-		edges = append(edges, user.EdgeAssignedRolePermissions)
+	if m.role != nil {
+		edges = append(edges, userrole.EdgeRole)
 	}
-	if m.push_settings != nil {
-		edges = append(edges, user.EdgePushSettings)
+	if m.assigner != nil {
+		edges = append(edges, userrole.EdgeAssigner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserRoleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.user_roles))
-		for id := range m.user_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAssignedUserRoles:
-		ids := make([]ent.Value, 0, len(m.assigned_user_roles))
-		for id := range m.assigned_user_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAssignedRolePermissions:
-		ids := make([]ent.Value, 0, len(m.assigned_role_permissions))
-		for id := range m.assigned_role_permissions {
-			ids = append(ids, id)
+	case userrole.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgePushSettings:
-		ids := make([]ent.Value, 0, len(m.push_settings))
-		for id := range m.push_settings {
-			ids = append(ids, id)
+	case userrole.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	case userrole.EdgeAssigner:
+		if id := m.assigner; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removeduser_roles != nil {
-		edges = append(edges, user.EdgeUserRoles)
-	}
-	if m.removedassigned_user_roles != nil {
-		edges = append(edges, user.EdgeAssignedUserRoles)
-	}
-	if m.removedassigned_role_permissions != nil {
-		edges = append(edges, user.EdgeAssignedRolePermissions)
-	}
-	if m.removedpush_settings != nil {
-		edges = append(edges, user.EdgePushSettings)
-	}
+func (m *UserRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.removeduser_roles))
-		for id := range m.removeduser_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAssignedUserRoles:
-		ids := make([]ent.Value, 0, len(m.removedassigned_user_roles))
-		for id := range m.removedassigned_user_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAssignedRolePermissions:
-		ids := make([]ent.Value, 0, len(m.removedassigned_role_permissions))
-		for id := range m.removedassigned_role_permissions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgePushSettings:
-		ids := make([]ent.Value, 0, len(m.removedpush_settings))
-		for id := range m.removedpush_settings {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserRoleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.cleareduser_roles {
-		edges = append(edges, user.EdgeUserRoles)
-	}
-	if m.clearedassigned_user_roles {
-		edges = append(edges, user.EdgeAssignedUserRoles)
+func (m *UserRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, userrole.EdgeUser)
 	}
-	if m.clearedassigned_role_permissions {
-		edges = append(edges, user.EdgeAssignedRolePermissions)
+	if m.clearedrole {
+		edges = append(edges, userrole.EdgeRole)
 	}
-	if m.clearedpush_settings {
-		edges = append(edges, user.EdgePushSettings)
+	if m.clearedassigner {
+		edges = append(edges, userrole.EdgeAssigner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserRoleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeUserRoles:
-		return m.cleareduser_roles
-	case user.EdgeAssignedUserRoles:
-		return m.clearedassigned_user_roles
-	case user.EdgeAssignedRolePermissions:
-		return m.clearedassigned_role_permissions
-	case user.EdgePushSettings:
-		return m.clearedpush_settings
+	case userrole.EdgeUser:
+		return m.cleareduser
+	case userrole.EdgeRole:
+		return m.clearedrole
+	case userrole.EdgeAssigner:
+		return m.clearedassigner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserRoleMutation) ClearEdge(name string) error {
 	switch name {
+	case userrole.EdgeUser:
+		m.ClearUser()
+		return nil
+	case userrole.EdgeRole:
+		m.ClearRole()
+		return nil
+	case userrole.EdgeAssigner:
+		m.ClearAssigner()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserRole unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserRoleMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeUserRoles:
-		m.ResetUserRoles()
-		return nil
-	case user.EdgeAssignedUserRoles:
-		m.ResetAssignedUserRoles()
+	case userrole.EdgeUser:
+		m.ResetUser()
 		return nil
-	case user.EdgeAssignedRolePermissions:
-		m.ResetAssignedRolePermissions()
+	case userrole.EdgeRole:
+		m.ResetRole()
 		return nil
-	case user.EdgePushSettings:
-		m.ResetPushSettings()
+	case userrole.EdgeAssigner:
+		m.ResetAssigner()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserRole edge %s", name)
 }
 
-// UserPushSettingMutation represents an operation that mutates the UserPushSetting nodes in the graph.
-type UserPushSettingMutation struct {
+// UserSessionMutation represents an operation that mutates the UserSession nodes in the graph.
+type UserSessionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uint
-	provider      *userpushsetting.Provider
-	enabled       *bool
-	device_id     *string
-	device_name   *string
-	settings      *map[string]interface{}
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uint
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*UserPushSetting, error)
-	predicates    []predicate.UserPushSetting
-}
-
-var _ ent.Mutation = (*UserPushSettingMutation)(nil)
-
-// userpushsettingOption allows management of the mutation configuration using functional options.
-type userpushsettingOption func(*UserPushSettingMutation)
-
-// newUserPushSettingMutation creates new mutation for the UserPushSetting entity.
-func newUserPushSettingMutation(c config, op Op, opts ...userpushsettingOption) *UserPushSettingMutation {
-	m := &UserPushSettingMutation{
+	op               Op
+	typ              string
+	id               *uint
+	refresh_token_id *string
+	user_agent       *string
+	ip               *string
+	created_at       *time.Time
+	last_used_at     *time.Time
+	expires_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uint
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*UserSession, error)
+	predicates       []predicate.UserSession
+}
+
+var _ ent.Mutation = (*UserSessionMutation)(nil)
+
+// usersessionOption allows management of the mutation configuration using functional options.
+type usersessionOption func(*UserSessionMutation)
+
+// newUserSessionMutation creates new mutation for the UserSession entity.
+func newUserSessionMutation(c config, op Op, opts ...usersessionOption) *UserSessionMutation {
+	m := &UserSessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserPushSetting,
+		typ:           TypeUserSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3473,20 +10652,20 @@ func newUserPushSettingMutation(c config, op Op, opts ...userpushsettingOption)
 	return m
 }
 
-// withUserPushSettingID sets the ID field of the mutation.
-func withUserPushSettingID(id uint) userpushsettingOption {
-	return func(m *UserPushSettingMutation) {
+// withUserSessionID sets the ID field of the mutation.
+func withUserSessionID(id uint) usersessionOption {
+	return func(m *UserSessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserPushSetting
+			value *UserSession
 		)
-		m.oldValue = func(ctx context.Context) (*UserPushSetting, error) {
+		m.oldValue = func(ctx context.Context) (*UserSession, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserPushSetting.Get(ctx, id)
+					value, err = m.Client().UserSession.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3495,10 +10674,10 @@ func withUserPushSettingID(id uint) userpushsettingOption {
 	}
 }
 
-// withUserPushSetting sets the old UserPushSetting of the mutation.
-func withUserPushSetting(node *UserPushSetting) userpushsettingOption {
-	return func(m *UserPushSettingMutation) {
-		m.oldValue = func(context.Context) (*UserPushSetting, error) {
+// withUserSession sets the old UserSession of the mutation.
+func withUserSession(node *UserSession) usersessionOption {
+	return func(m *UserSessionMutation) {
+		m.oldValue = func(context.Context) (*UserSession, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3507,7 +10686,7 @@ func withUserPushSetting(node *UserPushSetting) userpushsettingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserPushSettingMutation) Client() *Client {
+func (m UserSessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3515,7 +10694,7 @@ func (m UserPushSettingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserPushSettingMutation) Tx() (*Tx, error) {
+func (m UserSessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3525,14 +10704,14 @@ func (m UserPushSettingMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of UserPushSetting entities.
-func (m *UserPushSettingMutation) SetID(id uint) {
+// operation is only accepted on creation of UserSession entities.
+func (m *UserSessionMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserPushSettingMutation) ID() (id uint, exists bool) {
+func (m *UserSessionMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3543,7 +10722,7 @@ func (m *UserPushSettingMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserPushSettingMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *UserSessionMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3552,19 +10731,19 @@ func (m *UserPushSettingMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserPushSetting.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserSession.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *UserPushSettingMutation) SetUserID(u uint) {
+func (m *UserSessionMutation) SetUserID(u uint) {
 	m.user = &u
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *UserPushSettingMutation) UserID() (r uint, exists bool) {
+func (m *UserSessionMutation) UserID() (r uint, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -3572,10 +10751,10 @@ func (m *UserPushSettingMutation) UserID() (r uint, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserPushSetting entity.
-// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPushSettingMutation) OldUserID(ctx context.Context) (v uint, err error) {
+func (m *UserSessionMutation) OldUserID(ctx context.Context) (v uint, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -3590,223 +10769,151 @@ func (m *UserPushSettingMutation) OldUserID(ctx context.Context) (v uint, err er
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *UserPushSettingMutation) ResetUserID() {
+func (m *UserSessionMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetProvider sets the "provider" field.
-func (m *UserPushSettingMutation) SetProvider(u userpushsetting.Provider) {
-	m.provider = &u
-}
-
-// Provider returns the value of the "provider" field in the mutation.
-func (m *UserPushSettingMutation) Provider() (r userpushsetting.Provider, exists bool) {
-	v := m.provider
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProvider returns the old "provider" field's value of the UserPushSetting entity.
-// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPushSettingMutation) OldProvider(ctx context.Context) (v userpushsetting.Provider, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
-	}
-	return oldValue.Provider, nil
-}
-
-// ResetProvider resets all changes to the "provider" field.
-func (m *UserPushSettingMutation) ResetProvider() {
-	m.provider = nil
-}
-
-// SetEnabled sets the "enabled" field.
-func (m *UserPushSettingMutation) SetEnabled(b bool) {
-	m.enabled = &b
-}
-
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *UserPushSettingMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEnabled returns the old "enabled" field's value of the UserPushSetting entity.
-// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPushSettingMutation) OldEnabled(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
-	}
-	return oldValue.Enabled, nil
-}
-
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *UserPushSettingMutation) ResetEnabled() {
-	m.enabled = nil
-}
-
-// SetDeviceID sets the "device_id" field.
-func (m *UserPushSettingMutation) SetDeviceID(s string) {
-	m.device_id = &s
+// SetRefreshTokenID sets the "refresh_token_id" field.
+func (m *UserSessionMutation) SetRefreshTokenID(s string) {
+	m.refresh_token_id = &s
 }
 
-// DeviceID returns the value of the "device_id" field in the mutation.
-func (m *UserPushSettingMutation) DeviceID() (r string, exists bool) {
-	v := m.device_id
+// RefreshTokenID returns the value of the "refresh_token_id" field in the mutation.
+func (m *UserSessionMutation) RefreshTokenID() (r string, exists bool) {
+	v := m.refresh_token_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceID returns the old "device_id" field's value of the UserPushSetting entity.
-// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldRefreshTokenID returns the old "refresh_token_id" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPushSettingMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldRefreshTokenID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRefreshTokenID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+		return v, errors.New("OldRefreshTokenID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRefreshTokenID: %w", err)
 	}
-	return oldValue.DeviceID, nil
+	return oldValue.RefreshTokenID, nil
 }
 
-// ResetDeviceID resets all changes to the "device_id" field.
-func (m *UserPushSettingMutation) ResetDeviceID() {
-	m.device_id = nil
+// ResetRefreshTokenID resets all changes to the "refresh_token_id" field.
+func (m *UserSessionMutation) ResetRefreshTokenID() {
+	m.refresh_token_id = nil
 }
 
-// SetDeviceName sets the "device_name" field.
-func (m *UserPushSettingMutation) SetDeviceName(s string) {
-	m.device_name = &s
+// SetUserAgent sets the "user_agent" field.
+func (m *UserSessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// DeviceName returns the value of the "device_name" field in the mutation.
-func (m *UserPushSettingMutation) DeviceName() (r string, exists bool) {
-	v := m.device_name
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *UserSessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceName returns the old "device_name" field's value of the UserPushSetting entity.
-// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPushSettingMutation) OldDeviceName(ctx context.Context) (v string, err error) {
+func (m *UserSessionMutation) OldUserAgent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceName requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.DeviceName, nil
+	return oldValue.UserAgent, nil
 }
 
-// ClearDeviceName clears the value of the "device_name" field.
-func (m *UserPushSettingMutation) ClearDeviceName() {
-	m.device_name = nil
-	m.clearedFields[userpushsetting.FieldDeviceName] = struct{}{}
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *UserSessionMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[usersession.FieldUserAgent] = struct{}{}
 }
 
-// DeviceNameCleared returns if the "device_name" field was cleared in this mutation.
-func (m *UserPushSettingMutation) DeviceNameCleared() bool {
-	_, ok := m.clearedFields[userpushsetting.FieldDeviceName]
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *UserSessionMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[usersession.FieldUserAgent]
 	return ok
 }
 
-// ResetDeviceName resets all changes to the "device_name" field.
-func (m *UserPushSettingMutation) ResetDeviceName() {
-	m.device_name = nil
-	delete(m.clearedFields, userpushsetting.FieldDeviceName)
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *UserSessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, usersession.FieldUserAgent)
 }
 
-// SetSettings sets the "settings" field.
-func (m *UserPushSettingMutation) SetSettings(value map[string]interface{}) {
-	m.settings = &value
+// SetIP sets the "ip" field.
+func (m *UserSessionMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// Settings returns the value of the "settings" field in the mutation.
-func (m *UserPushSettingMutation) Settings() (r map[string]interface{}, exists bool) {
-	v := m.settings
+// IP returns the value of the "ip" field in the mutation.
+func (m *UserSessionMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSettings returns the old "settings" field's value of the UserPushSetting entity.
-// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPushSettingMutation) OldSettings(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *UserSessionMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettings requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.Settings, nil
+	return oldValue.IP, nil
 }
 
-// ClearSettings clears the value of the "settings" field.
-func (m *UserPushSettingMutation) ClearSettings() {
-	m.settings = nil
-	m.clearedFields[userpushsetting.FieldSettings] = struct{}{}
+// ClearIP clears the value of the "ip" field.
+func (m *UserSessionMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[usersession.FieldIP] = struct{}{}
 }
 
-// SettingsCleared returns if the "settings" field was cleared in this mutation.
-func (m *UserPushSettingMutation) SettingsCleared() bool {
-	_, ok := m.clearedFields[userpushsetting.FieldSettings]
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *UserSessionMutation) IPCleared() bool {
+	_, ok := m.clearedFields[usersession.FieldIP]
 	return ok
 }
 
-// ResetSettings resets all changes to the "settings" field.
-func (m *UserPushSettingMutation) ResetSettings() {
-	m.settings = nil
-	delete(m.clearedFields, userpushsetting.FieldSettings)
+// ResetIP resets all changes to the "ip" field.
+func (m *UserSessionMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, usersession.FieldIP)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserPushSettingMutation) SetCreatedAt(t time.Time) {
+func (m *UserSessionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserPushSettingMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserSessionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3814,10 +10921,10 @@ func (m *UserPushSettingMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the UserPushSetting entity.
-// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPushSettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3832,61 +10939,97 @@ func (m *UserPushSettingMutation) OldCreatedAt(ctx context.Context) (v time.Time
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserPushSettingMutation) ResetCreatedAt() {
+func (m *UserSessionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserPushSettingMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *UserSessionMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserPushSettingMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *UserSessionMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the UserPushSetting entity.
-// If the UserPushSetting object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPushSettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserSessionMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserPushSettingMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *UserSessionMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *UserSessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *UserSessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the UserSession entity.
+// If the UserSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserSessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *UserSessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *UserPushSettingMutation) ClearUser() {
+func (m *UserSessionMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[userpushsetting.FieldUserID] = struct{}{}
+	m.clearedFields[usersession.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserPushSettingMutation) UserCleared() bool {
+func (m *UserSessionMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *UserPushSettingMutation) UserIDs() (ids []uint) {
+func (m *UserSessionMutation) UserIDs() (ids []uint) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3894,20 +11037,20 @@ func (m *UserPushSettingMutation) UserIDs() (ids []uint) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *UserPushSettingMutation) ResetUser() {
+func (m *UserSessionMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the UserPushSettingMutation builder.
-func (m *UserPushSettingMutation) Where(ps ...predicate.UserPushSetting) {
+// Where appends a list predicates to the UserSessionMutation builder.
+func (m *UserSessionMutation) Where(ps ...predicate.UserSession) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserPushSettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserSessionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserPushSettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserPushSetting, len(ps))
+func (m *UserSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserSession, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3915,48 +11058,45 @@ func (m *UserPushSettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserPushSettingMutation) Op() Op {
+func (m *UserSessionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserPushSettingMutation) SetOp(op Op) {
+func (m *UserSessionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserPushSetting).
-func (m *UserPushSettingMutation) Type() string {
+// Type returns the node type of this mutation (UserSession).
+func (m *UserSessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserPushSettingMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *UserSessionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.user != nil {
-		fields = append(fields, userpushsetting.FieldUserID)
-	}
-	if m.provider != nil {
-		fields = append(fields, userpushsetting.FieldProvider)
-	}
-	if m.enabled != nil {
-		fields = append(fields, userpushsetting.FieldEnabled)
+		fields = append(fields, usersession.FieldUserID)
 	}
-	if m.device_id != nil {
-		fields = append(fields, userpushsetting.FieldDeviceID)
+	if m.refresh_token_id != nil {
+		fields = append(fields, usersession.FieldRefreshTokenID)
 	}
-	if m.device_name != nil {
-		fields = append(fields, userpushsetting.FieldDeviceName)
+	if m.user_agent != nil {
+		fields = append(fields, usersession.FieldUserAgent)
 	}
-	if m.settings != nil {
-		fields = append(fields, userpushsetting.FieldSettings)
+	if m.ip != nil {
+		fields = append(fields, usersession.FieldIP)
 	}
 	if m.created_at != nil {
-		fields = append(fields, userpushsetting.FieldCreatedAt)
+		fields = append(fields, usersession.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, userpushsetting.FieldUpdatedAt)
+	if m.last_used_at != nil {
+		fields = append(fields, usersession.FieldLastUsedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, usersession.FieldExpiresAt)
 	}
 	return fields
 }
@@ -3964,24 +11104,22 @@ func (m *UserPushSettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserPushSettingMutation) Field(name string) (ent.Value, bool) {
+func (m *UserSessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userpushsetting.FieldUserID:
+	case usersession.FieldUserID:
 		return m.UserID()
-	case userpushsetting.FieldProvider:
-		return m.Provider()
-	case userpushsetting.FieldEnabled:
-		return m.Enabled()
-	case userpushsetting.FieldDeviceID:
-		return m.DeviceID()
-	case userpushsetting.FieldDeviceName:
-		return m.DeviceName()
-	case userpushsetting.FieldSettings:
-		return m.Settings()
-	case userpushsetting.FieldCreatedAt:
+	case usersession.FieldRefreshTokenID:
+		return m.RefreshTokenID()
+	case usersession.FieldUserAgent:
+		return m.UserAgent()
+	case usersession.FieldIP:
+		return m.IP()
+	case usersession.FieldCreatedAt:
 		return m.CreatedAt()
-	case userpushsetting.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case usersession.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case usersession.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -3989,96 +11127,87 @@ func (m *UserPushSettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserPushSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userpushsetting.FieldUserID:
+	case usersession.FieldUserID:
 		return m.OldUserID(ctx)
-	case userpushsetting.FieldProvider:
-		return m.OldProvider(ctx)
-	case userpushsetting.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case userpushsetting.FieldDeviceID:
-		return m.OldDeviceID(ctx)
-	case userpushsetting.FieldDeviceName:
-		return m.OldDeviceName(ctx)
-	case userpushsetting.FieldSettings:
-		return m.OldSettings(ctx)
-	case userpushsetting.FieldCreatedAt:
+	case usersession.FieldRefreshTokenID:
+		return m.OldRefreshTokenID(ctx)
+	case usersession.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case usersession.FieldIP:
+		return m.OldIP(ctx)
+	case usersession.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case userpushsetting.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case usersession.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case usersession.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserPushSetting field %s", name)
+	return nil, fmt.Errorf("unknown UserSession field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserPushSettingMutation) SetField(name string, value ent.Value) error {
+func (m *UserSessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userpushsetting.FieldUserID:
+	case usersession.FieldUserID:
 		v, ok := value.(uint)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case userpushsetting.FieldProvider:
-		v, ok := value.(userpushsetting.Provider)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProvider(v)
-		return nil
-	case userpushsetting.FieldEnabled:
-		v, ok := value.(bool)
+	case usersession.FieldRefreshTokenID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetRefreshTokenID(v)
 		return nil
-	case userpushsetting.FieldDeviceID:
+	case usersession.FieldUserAgent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceID(v)
+		m.SetUserAgent(v)
 		return nil
-	case userpushsetting.FieldDeviceName:
+	case usersession.FieldIP:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceName(v)
+		m.SetIP(v)
 		return nil
-	case userpushsetting.FieldSettings:
-		v, ok := value.(map[string]interface{})
+	case usersession.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSettings(v)
+		m.SetCreatedAt(v)
 		return nil
-	case userpushsetting.FieldCreatedAt:
+	case usersession.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case userpushsetting.FieldUpdatedAt:
+	case usersession.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserPushSetting field %s", name)
+	return fmt.Errorf("unknown UserSession field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserPushSettingMutation) AddedFields() []string {
+func (m *UserSessionMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -4086,7 +11215,7 @@ func (m *UserPushSettingMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserPushSettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserSessionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -4095,92 +11224,89 @@ func (m *UserPushSettingMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserPushSettingMutation) AddField(name string, value ent.Value) error {
+func (m *UserSessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown UserPushSetting numeric field %s", name)
+	return fmt.Errorf("unknown UserSession numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserPushSettingMutation) ClearedFields() []string {
+func (m *UserSessionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(userpushsetting.FieldDeviceName) {
-		fields = append(fields, userpushsetting.FieldDeviceName)
+	if m.FieldCleared(usersession.FieldUserAgent) {
+		fields = append(fields, usersession.FieldUserAgent)
 	}
-	if m.FieldCleared(userpushsetting.FieldSettings) {
-		fields = append(fields, userpushsetting.FieldSettings)
+	if m.FieldCleared(usersession.FieldIP) {
+		fields = append(fields, usersession.FieldIP)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserPushSettingMutation) FieldCleared(name string) bool {
+func (m *UserSessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserPushSettingMutation) ClearField(name string) error {
+func (m *UserSessionMutation) ClearField(name string) error {
 	switch name {
-	case userpushsetting.FieldDeviceName:
-		m.ClearDeviceName()
+	case usersession.FieldUserAgent:
+		m.ClearUserAgent()
 		return nil
-	case userpushsetting.FieldSettings:
-		m.ClearSettings()
+	case usersession.FieldIP:
+		m.ClearIP()
 		return nil
 	}
-	return fmt.Errorf("unknown UserPushSetting nullable field %s", name)
+	return fmt.Errorf("unknown UserSession nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserPushSettingMutation) ResetField(name string) error {
+func (m *UserSessionMutation) ResetField(name string) error {
 	switch name {
-	case userpushsetting.FieldUserID:
+	case usersession.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case userpushsetting.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case userpushsetting.FieldEnabled:
-		m.ResetEnabled()
-		return nil
-	case userpushsetting.FieldDeviceID:
-		m.ResetDeviceID()
+	case usersession.FieldRefreshTokenID:
+		m.ResetRefreshTokenID()
 		return nil
-	case userpushsetting.FieldDeviceName:
-		m.ResetDeviceName()
+	case usersession.FieldUserAgent:
+		m.ResetUserAgent()
 		return nil
-	case userpushsetting.FieldSettings:
-		m.ResetSettings()
+	case usersession.FieldIP:
+		m.ResetIP()
 		return nil
-	case userpushsetting.FieldCreatedAt:
+	case usersession.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case userpushsetting.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case usersession.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case usersession.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown UserPushSetting field %s", name)
+	return fmt.Errorf("unknown UserSession field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserPushSettingMutation) AddedEdges() []string {
+func (m *UserSessionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, userpushsetting.EdgeUser)
+		edges = append(edges, usersession.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserPushSettingMutation) AddedIDs(name string) []ent.Value {
+func (m *UserSessionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case userpushsetting.EdgeUser:
+	case usersession.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -4189,31 +11315,31 @@ func (m *UserPushSettingMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserPushSettingMutation) RemovedEdges() []string {
+func (m *UserSessionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserPushSettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserSessionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserPushSettingMutation) ClearedEdges() []string {
+func (m *UserSessionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, userpushsetting.EdgeUser)
+		edges = append(edges, usersession.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserPushSettingMutation) EdgeCleared(name string) bool {
+func (m *UserSessionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case userpushsetting.EdgeUser:
+	case usersession.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -4221,56 +11347,62 @@ func (m *UserPushSettingMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserPushSettingMutation) ClearEdge(name string) error {
+func (m *UserSessionMutation) ClearEdge(name string) error {
 	switch name {
-	case userpushsetting.EdgeUser:
+	case usersession.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown UserPushSetting unique edge %s", name)
+	return fmt.Errorf("unknown UserSession unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserPushSettingMutation) ResetEdge(name string) error {
+func (m *UserSessionMutation) ResetEdge(name string) error {
 	switch name {
-	case userpushsetting.EdgeUser:
+	case usersession.EdgeUser:
 		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown UserPushSetting edge %s", name)
-}
-
-// UserRoleMutation represents an operation that mutates the UserRole nodes in the graph.
-type UserRoleMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *uint
-	assigned_at     *time.Time
-	clearedFields   map[string]struct{}
-	user            *uint
-	cleareduser     bool
-	role            *uint
-	clearedrole     bool
-	assigner        *uint
-	clearedassigner bool
-	done            bool
-	oldValue        func(context.Context) (*UserRole, error)
-	predicates      []predicate.UserRole
+		return nil
+	}
+	return fmt.Errorf("unknown UserSession edge %s", name)
 }
 
-var _ ent.Mutation = (*UserRoleMutation)(nil)
-
-// userroleOption allows management of the mutation configuration using functional options.
-type userroleOption func(*UserRoleMutation)
-
-// newUserRoleMutation creates new mutation for the UserRole entity.
-func newUserRoleMutation(c config, op Op, opts ...userroleOption) *UserRoleMutation {
-	m := &UserRoleMutation{
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uint
+	delivery_id    *string
+	event_id       *string
+	event          *string
+	url            *string
+	attempt        *int
+	addattempt     *int
+	status_code    *int
+	addstatus_code *int
+	success        *bool
+	error          *string
+	duration_ms    *int64
+	addduration_ms *int64
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*WebhookDelivery, error)
+	predicates     []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserRole,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4279,20 +11411,20 @@ func newUserRoleMutation(c config, op Op, opts ...userroleOption) *UserRoleMutat
 	return m
 }
 
-// withUserRoleID sets the ID field of the mutation.
-func withUserRoleID(id uint) userroleOption {
-	return func(m *UserRoleMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uint) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserRole
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*UserRole, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserRole.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4301,10 +11433,10 @@ func withUserRoleID(id uint) userroleOption {
 	}
 }
 
-// withUserRole sets the old UserRole of the mutation.
-func withUserRole(node *UserRole) userroleOption {
-	return func(m *UserRoleMutation) {
-		m.oldValue = func(context.Context) (*UserRole, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4313,7 +11445,7 @@ func withUserRole(node *UserRole) userroleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserRoleMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4321,7 +11453,7 @@ func (m UserRoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserRoleMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4331,14 +11463,14 @@ func (m UserRoleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of UserRole entities.
-func (m *UserRoleMutation) SetID(id uint) {
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uint) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserRoleMutation) ID() (id uint, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uint, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4349,7 +11481,7 @@ func (m *UserRoleMutation) ID() (id uint, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserRoleMutation) IDs(ctx context.Context) ([]uint, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uint, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4358,272 +11490,482 @@ func (m *UserRoleMutation) IDs(ctx context.Context) ([]uint, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserRole.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UserRoleMutation) SetUserID(u uint) {
-	m.user = &u
+// SetDeliveryID sets the "delivery_id" field.
+func (m *WebhookDeliveryMutation) SetDeliveryID(s string) {
+	m.delivery_id = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UserRoleMutation) UserID() (r uint, exists bool) {
-	v := m.user
+// DeliveryID returns the value of the "delivery_id" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveryID() (r string, exists bool) {
+	v := m.delivery_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserRole entity.
-// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
+// OldDeliveryID returns the old "delivery_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserRoleMutation) OldUserID(ctx context.Context) (v uint, err error) {
+func (m *WebhookDeliveryMutation) OldDeliveryID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeliveryID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldDeliveryID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveryID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.DeliveryID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UserRoleMutation) ResetUserID() {
-	m.user = nil
+// ResetDeliveryID resets all changes to the "delivery_id" field.
+func (m *WebhookDeliveryMutation) ResetDeliveryID() {
+	m.delivery_id = nil
 }
 
-// SetRoleID sets the "role_id" field.
-func (m *UserRoleMutation) SetRoleID(u uint) {
-	m.role = &u
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(s string) {
+	m.event_id = &s
 }
 
-// RoleID returns the value of the "role_id" field in the mutation.
-func (m *UserRoleMutation) RoleID() (r uint, exists bool) {
-	v := m.role
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r string, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoleID returns the old "role_id" field's value of the UserRole entity.
-// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserRoleMutation) OldRoleID(ctx context.Context) (v uint, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoleID requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.RoleID, nil
+	return oldValue.EventID, nil
 }
 
-// ResetRoleID resets all changes to the "role_id" field.
-func (m *UserRoleMutation) ResetRoleID() {
-	m.role = nil
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// SetAssignedBy sets the "assigned_by" field.
-func (m *UserRoleMutation) SetAssignedBy(u uint) {
-	m.assigner = &u
+// SetEvent sets the "event" field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
 }
 
-// AssignedBy returns the value of the "assigned_by" field in the mutation.
-func (m *UserRoleMutation) AssignedBy() (r uint, exists bool) {
-	v := m.assigner
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssignedBy returns the old "assigned_by" field's value of the UserRole entity.
-// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
+// OldEvent returns the old "event" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserRoleMutation) OldAssignedBy(ctx context.Context) (v uint, err error) {
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
+		return v, errors.New("OldEvent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
 	}
-	return oldValue.AssignedBy, nil
+	return oldValue.Event, nil
 }
 
-// ClearAssignedBy clears the value of the "assigned_by" field.
-func (m *UserRoleMutation) ClearAssignedBy() {
-	m.assigner = nil
-	m.clearedFields[userrole.FieldAssignedBy] = struct{}{}
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
 }
 
-// AssignedByCleared returns if the "assigned_by" field was cleared in this mutation.
-func (m *UserRoleMutation) AssignedByCleared() bool {
-	_, ok := m.clearedFields[userrole.FieldAssignedBy]
+// SetURL sets the "url" field.
+func (m *WebhookDeliveryMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookDeliveryMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookDeliveryMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetAttempt sets the "attempt" field.
+func (m *WebhookDeliveryMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
+}
+
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempt returns the old "attempt" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (m *WebhookDeliveryMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
+}
+
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *WebhookDeliveryMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
+}
+
+// SetStatusCode sets the "status_code" field.
+func (m *WebhookDeliveryMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *WebhookDeliveryMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusCode returns the old "status_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatusCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
+	}
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *WebhookDeliveryMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
+	}
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (m *WebhookDeliveryMutation) ClearStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	m.clearedFields[webhookdelivery.FieldStatusCode] = struct{}{}
+}
+
+// StatusCodeCleared returns if the "status_code" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) StatusCodeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldStatusCode]
 	return ok
 }
 
-// ResetAssignedBy resets all changes to the "assigned_by" field.
-func (m *UserRoleMutation) ResetAssignedBy() {
-	m.assigner = nil
-	delete(m.clearedFields, userrole.FieldAssignedBy)
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *WebhookDeliveryMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	delete(m.clearedFields, webhookdelivery.FieldStatusCode)
 }
 
-// SetAssignedAt sets the "assigned_at" field.
-func (m *UserRoleMutation) SetAssignedAt(t time.Time) {
-	m.assigned_at = &t
+// SetSuccess sets the "success" field.
+func (m *WebhookDeliveryMutation) SetSuccess(b bool) {
+	m.success = &b
 }
 
-// AssignedAt returns the value of the "assigned_at" field in the mutation.
-func (m *UserRoleMutation) AssignedAt() (r time.Time, exists bool) {
-	v := m.assigned_at
+// Success returns the value of the "success" field in the mutation.
+func (m *WebhookDeliveryMutation) Success() (r bool, exists bool) {
+	v := m.success
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssignedAt returns the old "assigned_at" field's value of the UserRole entity.
-// If the UserRole object wasn't provided to the builder, the object is fetched from the database.
+// OldSuccess returns the old "success" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserRoleMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldSuccess(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
 	}
-	return oldValue.AssignedAt, nil
+	return oldValue.Success, nil
 }
 
-// ResetAssignedAt resets all changes to the "assigned_at" field.
-func (m *UserRoleMutation) ResetAssignedAt() {
-	m.assigned_at = nil
+// ResetSuccess resets all changes to the "success" field.
+func (m *WebhookDeliveryMutation) ResetSuccess() {
+	m.success = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserRoleMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[userrole.FieldUserID] = struct{}{}
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserRoleMutation) UserCleared() bool {
-	return m.cleareduser
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *UserRoleMutation) UserIDs() (ids []uint) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *UserRoleMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
 }
 
-// ClearRole clears the "role" edge to the Role entity.
-func (m *UserRoleMutation) ClearRole() {
-	m.clearedrole = true
-	m.clearedFields[userrole.FieldRoleID] = struct{}{}
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
 }
 
-// RoleCleared reports if the "role" edge to the Role entity was cleared.
-func (m *UserRoleMutation) RoleCleared() bool {
-	return m.clearedrole
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
 }
 
-// RoleIDs returns the "role" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoleID instead. It exists only for internal usage by the builders.
-func (m *UserRoleMutation) RoleIDs() (ids []uint) {
-	if id := m.role; id != nil {
-		ids = append(ids, *id)
+// SetDurationMs sets the "duration_ms" field.
+func (m *WebhookDeliveryMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *WebhookDeliveryMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetRole resets all changes to the "role" edge.
-func (m *UserRoleMutation) ResetRole() {
-	m.role = nil
-	m.clearedrole = false
+// OldDurationMs returns the old "duration_ms" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
 }
 
-// SetAssignerID sets the "assigner" edge to the User entity by id.
-func (m *UserRoleMutation) SetAssignerID(id uint) {
-	m.assigner = &id
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
 }
 
-// ClearAssigner clears the "assigner" edge to the User entity.
-func (m *UserRoleMutation) ClearAssigner() {
-	m.clearedassigner = true
-	m.clearedFields[userrole.FieldAssignedBy] = struct{}{}
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (m *WebhookDeliveryMutation) ClearDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	m.clearedFields[webhookdelivery.FieldDurationMs] = struct{}{}
+}
+
+// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DurationMsCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDurationMs]
+	return ok
 }
 
-// AssignerCleared reports if the "assigner" edge to the User entity was cleared.
-func (m *UserRoleMutation) AssignerCleared() bool {
-	return m.AssignedByCleared() || m.clearedassigner
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	delete(m.clearedFields, webhookdelivery.FieldDurationMs)
 }
 
-// AssignerID returns the "assigner" edge ID in the mutation.
-func (m *UserRoleMutation) AssignerID() (id uint, exists bool) {
-	if m.assigner != nil {
-		return *m.assigner, true
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AssignerIDs returns the "assigner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AssignerID instead. It exists only for internal usage by the builders.
-func (m *UserRoleMutation) AssignerIDs() (ids []uint) {
-	if id := m.assigner; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAssigner resets all changes to the "assigner" edge.
-func (m *UserRoleMutation) ResetAssigner() {
-	m.assigner = nil
-	m.clearedassigner = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the UserRoleMutation builder.
-func (m *UserRoleMutation) Where(ps ...predicate.UserRole) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserRoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserRoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserRole, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4631,36 +11973,54 @@ func (m *UserRoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserRoleMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserRoleMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserRole).
-func (m *UserRoleMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserRoleMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.user != nil {
-		fields = append(fields, userrole.FieldUserID)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.delivery_id != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveryID)
 	}
-	if m.role != nil {
-		fields = append(fields, userrole.FieldRoleID)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
 	}
-	if m.assigner != nil {
-		fields = append(fields, userrole.FieldAssignedBy)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
 	}
-	if m.assigned_at != nil {
-		fields = append(fields, userrole.FieldAssignedAt)
+	if m.url != nil {
+		fields = append(fields, webhookdelivery.FieldURL)
+	}
+	if m.attempt != nil {
+		fields = append(fields, webhookdelivery.FieldAttempt)
+	}
+	if m.status_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	if m.success != nil {
+		fields = append(fields, webhookdelivery.FieldSuccess)
+	}
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4668,16 +12028,28 @@ func (m *UserRoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserRoleMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userrole.FieldUserID:
-		return m.UserID()
-	case userrole.FieldRoleID:
-		return m.RoleID()
-	case userrole.FieldAssignedBy:
-		return m.AssignedBy()
-	case userrole.FieldAssignedAt:
-		return m.AssignedAt()
+	case webhookdelivery.FieldDeliveryID:
+		return m.DeliveryID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldURL:
+		return m.URL()
+	case webhookdelivery.FieldAttempt:
+		return m.Attempt()
+	case webhookdelivery.FieldStatusCode:
+		return m.StatusCode()
+	case webhookdelivery.FieldSuccess:
+		return m.Success()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldDurationMs:
+		return m.DurationMs()
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -4685,69 +12057,138 @@ func (m *UserRoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userrole.FieldUserID:
-		return m.OldUserID(ctx)
-	case userrole.FieldRoleID:
-		return m.OldRoleID(ctx)
-	case userrole.FieldAssignedBy:
-		return m.OldAssignedBy(ctx)
-	case userrole.FieldAssignedAt:
-		return m.OldAssignedAt(ctx)
+	case webhookdelivery.FieldDeliveryID:
+		return m.OldDeliveryID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldURL:
+		return m.OldURL(ctx)
+	case webhookdelivery.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case webhookdelivery.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case webhookdelivery.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserRole field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserRoleMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userrole.FieldUserID:
-		v, ok := value.(uint)
+	case webhookdelivery.FieldDeliveryID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetDeliveryID(v)
 		return nil
-	case userrole.FieldRoleID:
-		v, ok := value.(uint)
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoleID(v)
+		m.SetEventID(v)
 		return nil
-	case userrole.FieldAssignedBy:
-		v, ok := value.(uint)
+	case webhookdelivery.FieldEvent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAssignedBy(v)
+		m.SetEvent(v)
 		return nil
-	case userrole.FieldAssignedAt:
+	case webhookdelivery.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhookdelivery.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case webhookdelivery.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAssignedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserRole field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserRoleMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
+	if m.addattempt != nil {
+		fields = append(fields, webhookdelivery.FieldAttempt)
+	}
+	if m.addstatus_code != nil {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserRoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case webhookdelivery.FieldAttempt:
+		return m.AddedAttempt()
+	case webhookdelivery.FieldStatusCode:
+		return m.AddedStatusCode()
+	case webhookdelivery.FieldDurationMs:
+		return m.AddedDurationMs()
 	}
 	return nil, false
 }
@@ -4755,166 +12196,155 @@ func (m *UserRoleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserRoleMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
 	}
-	return fmt.Errorf("unknown UserRole numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserRoleMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(userrole.FieldAssignedBy) {
-		fields = append(fields, userrole.FieldAssignedBy)
+	if m.FieldCleared(webhookdelivery.FieldStatusCode) {
+		fields = append(fields, webhookdelivery.FieldStatusCode)
+	}
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDurationMs) {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserRoleMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserRoleMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case userrole.FieldAssignedBy:
-		m.ClearAssignedBy()
+	case webhookdelivery.FieldStatusCode:
+		m.ClearStatusCode()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ClearError()
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		m.ClearDurationMs()
 		return nil
 	}
-	return fmt.Errorf("unknown UserRole nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserRoleMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case userrole.FieldUserID:
-		m.ResetUserID()
+	case webhookdelivery.FieldDeliveryID:
+		m.ResetDeliveryID()
 		return nil
-	case userrole.FieldRoleID:
-		m.ResetRoleID()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case userrole.FieldAssignedBy:
-		m.ResetAssignedBy()
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case userrole.FieldAssignedAt:
-		m.ResetAssignedAt()
+	case webhookdelivery.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhookdelivery.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case webhookdelivery.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case webhookdelivery.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown UserRole field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserRoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, userrole.EdgeUser)
-	}
-	if m.role != nil {
-		edges = append(edges, userrole.EdgeRole)
-	}
-	if m.assigner != nil {
-		edges = append(edges, userrole.EdgeAssigner)
-	}
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserRoleMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case userrole.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case userrole.EdgeRole:
-		if id := m.role; id != nil {
-			return []ent.Value{*id}
-		}
-	case userrole.EdgeAssigner:
-		if id := m.assigner; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserRoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserRoleMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserRoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, userrole.EdgeUser)
-	}
-	if m.clearedrole {
-		edges = append(edges, userrole.EdgeRole)
-	}
-	if m.clearedassigner {
-		edges = append(edges, userrole.EdgeAssigner)
-	}
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserRoleMutation) EdgeCleared(name string) bool {
-	switch name {
-	case userrole.EdgeUser:
-		return m.cleareduser
-	case userrole.EdgeRole:
-		return m.clearedrole
-	case userrole.EdgeAssigner:
-		return m.clearedassigner
-	}
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserRoleMutation) ClearEdge(name string) error {
-	switch name {
-	case userrole.EdgeUser:
-		m.ClearUser()
-		return nil
-	case userrole.EdgeRole:
-		m.ClearRole()
-		return nil
-	case userrole.EdgeAssigner:
-		m.ClearAssigner()
-		return nil
-	}
-	return fmt.Errorf("unknown UserRole unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserRoleMutation) ResetEdge(name string) error {
-	switch name {
-	case userrole.EdgeUser:
-		m.ResetUser()
-		return nil
-	case userrole.EdgeRole:
-		m.ResetRole()
-		return nil
-	case userrole.EdgeAssigner:
-		m.ResetAssigner()
-		return nil
-	}
-	return fmt.Errorf("unknown UserRole edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
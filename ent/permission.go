@@ -27,8 +27,14 @@ type Permission struct {
 	Resource string `json:"resource,omitempty"`
 	// 操作名称，如：read, write, delete, manage
 	Action string `json:"action,omitempty"`
+	// 权限分类，用于界面分组展示，如：user, rbac, system
+	Category string `json:"category,omitempty"`
 	// 是否为系统权限（系统权限不可删除）
 	IsSystem bool `json:"is_system,omitempty"`
+	// 创建权限的用户ID，系统初始化时为空
+	CreatedBy *uint `json:"created_by,omitempty"`
+	// 最后修改权限的用户ID，未修改过时为空
+	UpdatedBy *uint `json:"updated_by,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -64,9 +70,9 @@ func (*Permission) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case permission.FieldIsSystem:
 			values[i] = new(sql.NullBool)
-		case permission.FieldID:
+		case permission.FieldID, permission.FieldCreatedBy, permission.FieldUpdatedBy:
 			values[i] = new(sql.NullInt64)
-		case permission.FieldName, permission.FieldDisplayName, permission.FieldDescription, permission.FieldResource, permission.FieldAction:
+		case permission.FieldName, permission.FieldDisplayName, permission.FieldDescription, permission.FieldResource, permission.FieldAction, permission.FieldCategory:
 			values[i] = new(sql.NullString)
 		case permission.FieldCreatedAt, permission.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -121,12 +127,32 @@ func (_m *Permission) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Action = value.String
 			}
+		case permission.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
 		case permission.FieldIsSystem:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_system", values[i])
 			} else if value.Valid {
 				_m.IsSystem = value.Bool
 			}
+		case permission.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint)
+				*_m.CreatedBy = uint(value.Int64)
+			}
+		case permission.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint)
+				*_m.UpdatedBy = uint(value.Int64)
+			}
 		case permission.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -195,9 +221,22 @@ func (_m *Permission) String() string {
 	builder.WriteString("action=")
 	builder.WriteString(_m.Action)
 	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
 	builder.WriteString("is_system=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsSystem))
 	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -59,6 +59,20 @@ func (_c *PermissionCreate) SetAction(v string) *PermissionCreate {
 	return _c
 }
 
+// SetCategory sets the "category" field.
+func (_c *PermissionCreate) SetCategory(v string) *PermissionCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *PermissionCreate) SetNillableCategory(v *string) *PermissionCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
 // SetIsSystem sets the "is_system" field.
 func (_c *PermissionCreate) SetIsSystem(v bool) *PermissionCreate {
 	_c.mutation.SetIsSystem(v)
@@ -73,6 +87,34 @@ func (_c *PermissionCreate) SetNillableIsSystem(v *bool) *PermissionCreate {
 	return _c
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (_c *PermissionCreate) SetCreatedBy(v uint) *PermissionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PermissionCreate) SetNillableCreatedBy(v *uint) *PermissionCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *PermissionCreate) SetUpdatedBy(v uint) *PermissionCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *PermissionCreate) SetNillableUpdatedBy(v *uint) *PermissionCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PermissionCreate) SetCreatedAt(v time.Time) *PermissionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -157,6 +199,10 @@ func (_c *PermissionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PermissionCreate) defaults() {
+	if _, ok := _c.mutation.Category(); !ok {
+		v := permission.DefaultCategory
+		_c.mutation.SetCategory(v)
+	}
 	if _, ok := _c.mutation.IsSystem(); !ok {
 		v := permission.DefaultIsSystem
 		_c.mutation.SetIsSystem(v)
@@ -210,6 +256,14 @@ func (_c *PermissionCreate) check() error {
 			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Permission.action": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "Permission.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := permission.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Permission.category": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.IsSystem(); !ok {
 		return &ValidationError{Name: "is_system", err: errors.New(`ent: missing required field "Permission.is_system"`)}
 	}
@@ -271,10 +325,22 @@ func (_c *PermissionCreate) createSpec() (*Permission, *sqlgraph.CreateSpec) {
 		_spec.SetField(permission.FieldAction, field.TypeString, value)
 		_node.Action = value
 	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(permission.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
 	if value, ok := _c.mutation.IsSystem(); ok {
 		_spec.SetField(permission.FieldIsSystem, field.TypeBool, value)
 		_node.IsSystem = value
 	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(permission.FieldCreatedBy, field.TypeUint, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(permission.FieldUpdatedBy, field.TypeUint, value)
+		_node.UpdatedBy = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(permission.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
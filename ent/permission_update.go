@@ -105,6 +105,20 @@ func (_u *PermissionUpdate) SetNillableAction(v *string) *PermissionUpdate {
 	return _u
 }
 
+// SetCategory sets the "category" field.
+func (_u *PermissionUpdate) SetCategory(v string) *PermissionUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *PermissionUpdate) SetNillableCategory(v *string) *PermissionUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
 // SetIsSystem sets the "is_system" field.
 func (_u *PermissionUpdate) SetIsSystem(v bool) *PermissionUpdate {
 	_u.mutation.SetIsSystem(v)
@@ -119,6 +133,33 @@ func (_u *PermissionUpdate) SetNillableIsSystem(v *bool) *PermissionUpdate {
 	return _u
 }
 
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PermissionUpdate) SetUpdatedBy(v uint) *PermissionUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PermissionUpdate) SetNillableUpdatedBy(v *uint) *PermissionUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *PermissionUpdate) AddUpdatedBy(v int) *PermissionUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PermissionUpdate) ClearUpdatedBy() *PermissionUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PermissionUpdate) SetUpdatedAt(v time.Time) *PermissionUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -229,6 +270,11 @@ func (_u *PermissionUpdate) check() error {
 			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Permission.action": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := permission.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Permission.category": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -262,9 +308,24 @@ func (_u *PermissionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Action(); ok {
 		_spec.SetField(permission.FieldAction, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(permission.FieldCategory, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.IsSystem(); ok {
 		_spec.SetField(permission.FieldIsSystem, field.TypeBool, value)
 	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(permission.FieldCreatedBy, field.TypeUint)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(permission.FieldUpdatedBy, field.TypeUint, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(permission.FieldUpdatedBy, field.TypeUint, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(permission.FieldUpdatedBy, field.TypeUint)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(permission.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -409,6 +470,20 @@ func (_u *PermissionUpdateOne) SetNillableAction(v *string) *PermissionUpdateOne
 	return _u
 }
 
+// SetCategory sets the "category" field.
+func (_u *PermissionUpdateOne) SetCategory(v string) *PermissionUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *PermissionUpdateOne) SetNillableCategory(v *string) *PermissionUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
 // SetIsSystem sets the "is_system" field.
 func (_u *PermissionUpdateOne) SetIsSystem(v bool) *PermissionUpdateOne {
 	_u.mutation.SetIsSystem(v)
@@ -423,6 +498,33 @@ func (_u *PermissionUpdateOne) SetNillableIsSystem(v *bool) *PermissionUpdateOne
 	return _u
 }
 
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *PermissionUpdateOne) SetUpdatedBy(v uint) *PermissionUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *PermissionUpdateOne) SetNillableUpdatedBy(v *uint) *PermissionUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *PermissionUpdateOne) AddUpdatedBy(v int) *PermissionUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *PermissionUpdateOne) ClearUpdatedBy() *PermissionUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PermissionUpdateOne) SetUpdatedAt(v time.Time) *PermissionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -546,6 +648,11 @@ func (_u *PermissionUpdateOne) check() error {
 			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Permission.action": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := permission.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Permission.category": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -596,9 +703,24 @@ func (_u *PermissionUpdateOne) sqlSave(ctx context.Context) (_node *Permission,
 	if value, ok := _u.mutation.Action(); ok {
 		_spec.SetField(permission.FieldAction, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(permission.FieldCategory, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.IsSystem(); ok {
 		_spec.SetField(permission.FieldIsSystem, field.TypeBool, value)
 	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(permission.FieldCreatedBy, field.TypeUint)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(permission.FieldUpdatedBy, field.TypeUint, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(permission.FieldUpdatedBy, field.TypeUint, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(permission.FieldUpdatedBy, field.TypeUint)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(permission.FieldUpdatedAt, field.TypeTime, value)
 	}
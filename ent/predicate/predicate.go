@@ -6,15 +6,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// LiveSubscription is the predicate function for livesubscription builders.
+type LiveSubscription func(*sql.Selector)
+
 // Permission is the predicate function for permission builders.
 type Permission func(*sql.Selector)
 
+// RecurringPush is the predicate function for recurringpush builders.
+type RecurringPush func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
 // RolePermission is the predicate function for rolepermission builders.
 type RolePermission func(*sql.Selector)
 
+// ScheduledPush is the predicate function for scheduledpush builders.
+type ScheduledPush func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
@@ -23,3 +35,9 @@ type UserPushSetting func(*sql.Selector)
 
 // UserRole is the predicate function for userrole builders.
 type UserRole func(*sql.Selector)
+
+// UserSession is the predicate function for usersession builders.
+type UserSession func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
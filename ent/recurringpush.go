@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nebula-live/ent/recurringpush"
+	"nebula-live/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RecurringPush is the model entity for the RecurringPush schema.
+type RecurringPush struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 关联的用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 标准cron表达式（分 时 日 月 周）
+	CronExpr string `json:"cron_expr,omitempty"`
+	// 指定推送提供商，为空时发送到用户所有启用的设备
+	Provider string `json:"provider,omitempty"`
+	// 推送标题
+	Title string `json:"title,omitempty"`
+	// 推送内容
+	Body string `json:"body,omitempty"`
+	// 点击推送后跳转的URL
+	URL string `json:"url,omitempty"`
+	// 推送铃声
+	Sound string `json:"sound,omitempty"`
+	// 推送图标
+	Icon string `json:"icon,omitempty"`
+	// 推送分组
+	Group string `json:"group,omitempty"`
+	// 推送级别
+	Level string `json:"level,omitempty"`
+	// 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// 下次运行时间
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	// 上次运行时间
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RecurringPushQuery when eager-loading is set.
+	Edges        RecurringPushEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RecurringPushEdges holds the relations/edges for other nodes in the graph.
+type RecurringPushEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurringPushEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RecurringPush) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recurringpush.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case recurringpush.FieldID, recurringpush.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case recurringpush.FieldCronExpr, recurringpush.FieldProvider, recurringpush.FieldTitle, recurringpush.FieldBody, recurringpush.FieldURL, recurringpush.FieldSound, recurringpush.FieldIcon, recurringpush.FieldGroup, recurringpush.FieldLevel:
+			values[i] = new(sql.NullString)
+		case recurringpush.FieldNextRunAt, recurringpush.FieldLastRunAt, recurringpush.FieldCreatedAt, recurringpush.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RecurringPush fields.
+func (_m *RecurringPush) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recurringpush.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case recurringpush.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint(value.Int64)
+			}
+		case recurringpush.FieldCronExpr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_expr", values[i])
+			} else if value.Valid {
+				_m.CronExpr = value.String
+			}
+		case recurringpush.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case recurringpush.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case recurringpush.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case recurringpush.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case recurringpush.FieldSound:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sound", values[i])
+			} else if value.Valid {
+				_m.Sound = value.String
+			}
+		case recurringpush.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				_m.Icon = value.String
+			}
+		case recurringpush.FieldGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group", values[i])
+			} else if value.Valid {
+				_m.Group = value.String
+			}
+		case recurringpush.FieldLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = value.String
+			}
+		case recurringpush.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case recurringpush.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				_m.NextRunAt = value.Time
+			}
+		case recurringpush.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = new(time.Time)
+				*_m.LastRunAt = value.Time
+			}
+		case recurringpush.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case recurringpush.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RecurringPush.
+// This includes values selected through modifiers, order, etc.
+func (_m *RecurringPush) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RecurringPush entity.
+func (_m *RecurringPush) QueryUser() *UserQuery {
+	return NewRecurringPushClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this RecurringPush.
+// Note that you need to call RecurringPush.Unwrap() before calling this method if this RecurringPush
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RecurringPush) Update() *RecurringPushUpdateOne {
+	return NewRecurringPushClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RecurringPush entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RecurringPush) Unwrap() *RecurringPush {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RecurringPush is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RecurringPush) String() string {
+	var builder strings.Builder
+	builder.WriteString("RecurringPush(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("cron_expr=")
+	builder.WriteString(_m.CronExpr)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("sound=")
+	builder.WriteString(_m.Sound)
+	builder.WriteString(", ")
+	builder.WriteString("icon=")
+	builder.WriteString(_m.Icon)
+	builder.WriteString(", ")
+	builder.WriteString("group=")
+	builder.WriteString(_m.Group)
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(_m.Level)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(_m.NextRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RecurringPushes is a parsable slice of RecurringPush.
+type RecurringPushes []*RecurringPush
@@ -0,0 +1,1014 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recurringpush
+
+import (
+	"nebula-live/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldUserID, v))
+}
+
+// CronExpr applies equality check predicate on the "cron_expr" field. It's identical to CronExprEQ.
+func CronExpr(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldCronExpr, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldProvider, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldTitle, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldBody, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldURL, v))
+}
+
+// Sound applies equality check predicate on the "sound" field. It's identical to SoundEQ.
+func Sound(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldSound, v))
+}
+
+// Icon applies equality check predicate on the "icon" field. It's identical to IconEQ.
+func Icon(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldIcon, v))
+}
+
+// Group applies equality check predicate on the "group" field. It's identical to GroupEQ.
+func Group(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldGroup, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldLevel, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// CronExprEQ applies the EQ predicate on the "cron_expr" field.
+func CronExprEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldCronExpr, v))
+}
+
+// CronExprNEQ applies the NEQ predicate on the "cron_expr" field.
+func CronExprNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldCronExpr, v))
+}
+
+// CronExprIn applies the In predicate on the "cron_expr" field.
+func CronExprIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldCronExpr, vs...))
+}
+
+// CronExprNotIn applies the NotIn predicate on the "cron_expr" field.
+func CronExprNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldCronExpr, vs...))
+}
+
+// CronExprGT applies the GT predicate on the "cron_expr" field.
+func CronExprGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldCronExpr, v))
+}
+
+// CronExprGTE applies the GTE predicate on the "cron_expr" field.
+func CronExprGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldCronExpr, v))
+}
+
+// CronExprLT applies the LT predicate on the "cron_expr" field.
+func CronExprLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldCronExpr, v))
+}
+
+// CronExprLTE applies the LTE predicate on the "cron_expr" field.
+func CronExprLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldCronExpr, v))
+}
+
+// CronExprContains applies the Contains predicate on the "cron_expr" field.
+func CronExprContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldCronExpr, v))
+}
+
+// CronExprHasPrefix applies the HasPrefix predicate on the "cron_expr" field.
+func CronExprHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldCronExpr, v))
+}
+
+// CronExprHasSuffix applies the HasSuffix predicate on the "cron_expr" field.
+func CronExprHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldCronExpr, v))
+}
+
+// CronExprEqualFold applies the EqualFold predicate on the "cron_expr" field.
+func CronExprEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldCronExpr, v))
+}
+
+// CronExprContainsFold applies the ContainsFold predicate on the "cron_expr" field.
+func CronExprContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldCronExpr, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderIsNil applies the IsNil predicate on the "provider" field.
+func ProviderIsNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIsNull(FieldProvider))
+}
+
+// ProviderNotNil applies the NotNil predicate on the "provider" field.
+func ProviderNotNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotNull(FieldProvider))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldBody, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLIsNil applies the IsNil predicate on the "url" field.
+func URLIsNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIsNull(FieldURL))
+}
+
+// URLNotNil applies the NotNil predicate on the "url" field.
+func URLNotNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotNull(FieldURL))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldURL, v))
+}
+
+// SoundEQ applies the EQ predicate on the "sound" field.
+func SoundEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldSound, v))
+}
+
+// SoundNEQ applies the NEQ predicate on the "sound" field.
+func SoundNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldSound, v))
+}
+
+// SoundIn applies the In predicate on the "sound" field.
+func SoundIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldSound, vs...))
+}
+
+// SoundNotIn applies the NotIn predicate on the "sound" field.
+func SoundNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldSound, vs...))
+}
+
+// SoundGT applies the GT predicate on the "sound" field.
+func SoundGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldSound, v))
+}
+
+// SoundGTE applies the GTE predicate on the "sound" field.
+func SoundGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldSound, v))
+}
+
+// SoundLT applies the LT predicate on the "sound" field.
+func SoundLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldSound, v))
+}
+
+// SoundLTE applies the LTE predicate on the "sound" field.
+func SoundLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldSound, v))
+}
+
+// SoundContains applies the Contains predicate on the "sound" field.
+func SoundContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldSound, v))
+}
+
+// SoundHasPrefix applies the HasPrefix predicate on the "sound" field.
+func SoundHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldSound, v))
+}
+
+// SoundHasSuffix applies the HasSuffix predicate on the "sound" field.
+func SoundHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldSound, v))
+}
+
+// SoundIsNil applies the IsNil predicate on the "sound" field.
+func SoundIsNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIsNull(FieldSound))
+}
+
+// SoundNotNil applies the NotNil predicate on the "sound" field.
+func SoundNotNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotNull(FieldSound))
+}
+
+// SoundEqualFold applies the EqualFold predicate on the "sound" field.
+func SoundEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldSound, v))
+}
+
+// SoundContainsFold applies the ContainsFold predicate on the "sound" field.
+func SoundContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldSound, v))
+}
+
+// IconEQ applies the EQ predicate on the "icon" field.
+func IconEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldIcon, v))
+}
+
+// IconNEQ applies the NEQ predicate on the "icon" field.
+func IconNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldIcon, v))
+}
+
+// IconIn applies the In predicate on the "icon" field.
+func IconIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldIcon, vs...))
+}
+
+// IconNotIn applies the NotIn predicate on the "icon" field.
+func IconNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldIcon, vs...))
+}
+
+// IconGT applies the GT predicate on the "icon" field.
+func IconGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldIcon, v))
+}
+
+// IconGTE applies the GTE predicate on the "icon" field.
+func IconGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldIcon, v))
+}
+
+// IconLT applies the LT predicate on the "icon" field.
+func IconLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldIcon, v))
+}
+
+// IconLTE applies the LTE predicate on the "icon" field.
+func IconLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldIcon, v))
+}
+
+// IconContains applies the Contains predicate on the "icon" field.
+func IconContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldIcon, v))
+}
+
+// IconHasPrefix applies the HasPrefix predicate on the "icon" field.
+func IconHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldIcon, v))
+}
+
+// IconHasSuffix applies the HasSuffix predicate on the "icon" field.
+func IconHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldIcon, v))
+}
+
+// IconIsNil applies the IsNil predicate on the "icon" field.
+func IconIsNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIsNull(FieldIcon))
+}
+
+// IconNotNil applies the NotNil predicate on the "icon" field.
+func IconNotNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotNull(FieldIcon))
+}
+
+// IconEqualFold applies the EqualFold predicate on the "icon" field.
+func IconEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldIcon, v))
+}
+
+// IconContainsFold applies the ContainsFold predicate on the "icon" field.
+func IconContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldIcon, v))
+}
+
+// GroupEQ applies the EQ predicate on the "group" field.
+func GroupEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldGroup, v))
+}
+
+// GroupNEQ applies the NEQ predicate on the "group" field.
+func GroupNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldGroup, v))
+}
+
+// GroupIn applies the In predicate on the "group" field.
+func GroupIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldGroup, vs...))
+}
+
+// GroupNotIn applies the NotIn predicate on the "group" field.
+func GroupNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldGroup, vs...))
+}
+
+// GroupGT applies the GT predicate on the "group" field.
+func GroupGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldGroup, v))
+}
+
+// GroupGTE applies the GTE predicate on the "group" field.
+func GroupGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldGroup, v))
+}
+
+// GroupLT applies the LT predicate on the "group" field.
+func GroupLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldGroup, v))
+}
+
+// GroupLTE applies the LTE predicate on the "group" field.
+func GroupLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldGroup, v))
+}
+
+// GroupContains applies the Contains predicate on the "group" field.
+func GroupContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldGroup, v))
+}
+
+// GroupHasPrefix applies the HasPrefix predicate on the "group" field.
+func GroupHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldGroup, v))
+}
+
+// GroupHasSuffix applies the HasSuffix predicate on the "group" field.
+func GroupHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldGroup, v))
+}
+
+// GroupIsNil applies the IsNil predicate on the "group" field.
+func GroupIsNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIsNull(FieldGroup))
+}
+
+// GroupNotNil applies the NotNil predicate on the "group" field.
+func GroupNotNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotNull(FieldGroup))
+}
+
+// GroupEqualFold applies the EqualFold predicate on the "group" field.
+func GroupEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldGroup, v))
+}
+
+// GroupContainsFold applies the ContainsFold predicate on the "group" field.
+func GroupContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldGroup, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldLevel, v))
+}
+
+// LevelContains applies the Contains predicate on the "level" field.
+func LevelContains(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContains(FieldLevel, v))
+}
+
+// LevelHasPrefix applies the HasPrefix predicate on the "level" field.
+func LevelHasPrefix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasPrefix(FieldLevel, v))
+}
+
+// LevelHasSuffix applies the HasSuffix predicate on the "level" field.
+func LevelHasSuffix(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldHasSuffix(FieldLevel, v))
+}
+
+// LevelIsNil applies the IsNil predicate on the "level" field.
+func LevelIsNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIsNull(FieldLevel))
+}
+
+// LevelNotNil applies the NotNil predicate on the "level" field.
+func LevelNotNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotNull(FieldLevel))
+}
+
+// LevelEqualFold applies the EqualFold predicate on the "level" field.
+func LevelEqualFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEqualFold(FieldLevel, v))
+}
+
+// LevelContainsFold applies the ContainsFold predicate on the "level" field.
+func LevelContainsFold(v string) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldContainsFold(FieldLevel, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.RecurringPush {
+	return predicate.RecurringPush(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.RecurringPush {
+	return predicate.RecurringPush(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecurringPush) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecurringPush) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecurringPush) predicate.RecurringPush {
+	return predicate.RecurringPush(sql.NotPredicates(p))
+}
@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nebula-live/ent/recurringpush"
+	"nebula-live/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurringPushCreate is the builder for creating a RecurringPush entity.
+type RecurringPushCreate struct {
+	config
+	mutation *RecurringPushMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RecurringPushCreate) SetUserID(v uint) *RecurringPushCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCronExpr sets the "cron_expr" field.
+func (_c *RecurringPushCreate) SetCronExpr(v string) *RecurringPushCreate {
+	_c.mutation.SetCronExpr(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *RecurringPushCreate) SetProvider(v string) *RecurringPushCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableProvider(v *string) *RecurringPushCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *RecurringPushCreate) SetTitle(v string) *RecurringPushCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *RecurringPushCreate) SetBody(v string) *RecurringPushCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *RecurringPushCreate) SetURL(v string) *RecurringPushCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableURL(v *string) *RecurringPushCreate {
+	if v != nil {
+		_c.SetURL(*v)
+	}
+	return _c
+}
+
+// SetSound sets the "sound" field.
+func (_c *RecurringPushCreate) SetSound(v string) *RecurringPushCreate {
+	_c.mutation.SetSound(v)
+	return _c
+}
+
+// SetNillableSound sets the "sound" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableSound(v *string) *RecurringPushCreate {
+	if v != nil {
+		_c.SetSound(*v)
+	}
+	return _c
+}
+
+// SetIcon sets the "icon" field.
+func (_c *RecurringPushCreate) SetIcon(v string) *RecurringPushCreate {
+	_c.mutation.SetIcon(v)
+	return _c
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableIcon(v *string) *RecurringPushCreate {
+	if v != nil {
+		_c.SetIcon(*v)
+	}
+	return _c
+}
+
+// SetGroup sets the "group" field.
+func (_c *RecurringPushCreate) SetGroup(v string) *RecurringPushCreate {
+	_c.mutation.SetGroup(v)
+	return _c
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableGroup(v *string) *RecurringPushCreate {
+	if v != nil {
+		_c.SetGroup(*v)
+	}
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *RecurringPushCreate) SetLevel(v string) *RecurringPushCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableLevel(v *string) *RecurringPushCreate {
+	if v != nil {
+		_c.SetLevel(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RecurringPushCreate) SetEnabled(v bool) *RecurringPushCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableEnabled(v *bool) *RecurringPushCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *RecurringPushCreate) SetNextRunAt(v time.Time) *RecurringPushCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *RecurringPushCreate) SetLastRunAt(v time.Time) *RecurringPushCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableLastRunAt(v *time.Time) *RecurringPushCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RecurringPushCreate) SetCreatedAt(v time.Time) *RecurringPushCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableCreatedAt(v *time.Time) *RecurringPushCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RecurringPushCreate) SetUpdatedAt(v time.Time) *RecurringPushCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RecurringPushCreate) SetNillableUpdatedAt(v *time.Time) *RecurringPushCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RecurringPushCreate) SetID(v uint) *RecurringPushCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *RecurringPushCreate) SetUser(v *User) *RecurringPushCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the RecurringPushMutation object of the builder.
+func (_c *RecurringPushCreate) Mutation() *RecurringPushMutation {
+	return _c.mutation
+}
+
+// Save creates the RecurringPush in the database.
+func (_c *RecurringPushCreate) Save(ctx context.Context) (*RecurringPush, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecurringPushCreate) SaveX(ctx context.Context) *RecurringPush {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurringPushCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurringPushCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecurringPushCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := recurringpush.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := recurringpush.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := recurringpush.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecurringPushCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RecurringPush.user_id"`)}
+	}
+	if _, ok := _c.mutation.CronExpr(); !ok {
+		return &ValidationError{Name: "cron_expr", err: errors.New(`ent: missing required field "RecurringPush.cron_expr"`)}
+	}
+	if v, ok := _c.mutation.CronExpr(); ok {
+		if err := recurringpush.CronExprValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expr", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.cron_expr": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "RecurringPush.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := recurringpush.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "RecurringPush.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := recurringpush.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.body": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RecurringPush.enabled"`)}
+	}
+	if _, ok := _c.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`ent: missing required field "RecurringPush.next_run_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecurringPush.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RecurringPush.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RecurringPush.user"`)}
+	}
+	return nil
+}
+
+func (_c *RecurringPushCreate) sqlSave(ctx context.Context) (*RecurringPush, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecurringPushCreate) createSpec() (*RecurringPush, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecurringPush{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recurringpush.Table, sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CronExpr(); ok {
+		_spec.SetField(recurringpush.FieldCronExpr, field.TypeString, value)
+		_node.CronExpr = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(recurringpush.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(recurringpush.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(recurringpush.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(recurringpush.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Sound(); ok {
+		_spec.SetField(recurringpush.FieldSound, field.TypeString, value)
+		_node.Sound = value
+	}
+	if value, ok := _c.mutation.Icon(); ok {
+		_spec.SetField(recurringpush.FieldIcon, field.TypeString, value)
+		_node.Icon = value
+	}
+	if value, ok := _c.mutation.Group(); ok {
+		_spec.SetField(recurringpush.FieldGroup, field.TypeString, value)
+		_node.Group = value
+	}
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(recurringpush.FieldLevel, field.TypeString, value)
+		_node.Level = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(recurringpush.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(recurringpush.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(recurringpush.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(recurringpush.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringpush.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringpush.UserTable,
+			Columns: []string{recurringpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecurringPushCreateBulk is the builder for creating many RecurringPush entities in bulk.
+type RecurringPushCreateBulk struct {
+	config
+	err      error
+	builders []*RecurringPushCreate
+}
+
+// Save creates the RecurringPush entities in the database.
+func (_c *RecurringPushCreateBulk) Save(ctx context.Context) ([]*RecurringPush, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecurringPush, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecurringPushMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecurringPushCreateBulk) SaveX(ctx context.Context) []*RecurringPush {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurringPushCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurringPushCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
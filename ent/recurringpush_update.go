@@ -0,0 +1,907 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nebula-live/ent/predicate"
+	"nebula-live/ent/recurringpush"
+	"nebula-live/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurringPushUpdate is the builder for updating RecurringPush entities.
+type RecurringPushUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecurringPushMutation
+}
+
+// Where appends a list predicates to the RecurringPushUpdate builder.
+func (_u *RecurringPushUpdate) Where(ps ...predicate.RecurringPush) *RecurringPushUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RecurringPushUpdate) SetUserID(v uint) *RecurringPushUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableUserID(v *uint) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetCronExpr sets the "cron_expr" field.
+func (_u *RecurringPushUpdate) SetCronExpr(v string) *RecurringPushUpdate {
+	_u.mutation.SetCronExpr(v)
+	return _u
+}
+
+// SetNillableCronExpr sets the "cron_expr" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableCronExpr(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetCronExpr(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *RecurringPushUpdate) SetProvider(v string) *RecurringPushUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableProvider(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (_u *RecurringPushUpdate) ClearProvider() *RecurringPushUpdate {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *RecurringPushUpdate) SetTitle(v string) *RecurringPushUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableTitle(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *RecurringPushUpdate) SetBody(v string) *RecurringPushUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableBody(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *RecurringPushUpdate) SetURL(v string) *RecurringPushUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableURL(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// ClearURL clears the value of the "url" field.
+func (_u *RecurringPushUpdate) ClearURL() *RecurringPushUpdate {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// SetSound sets the "sound" field.
+func (_u *RecurringPushUpdate) SetSound(v string) *RecurringPushUpdate {
+	_u.mutation.SetSound(v)
+	return _u
+}
+
+// SetNillableSound sets the "sound" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableSound(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetSound(*v)
+	}
+	return _u
+}
+
+// ClearSound clears the value of the "sound" field.
+func (_u *RecurringPushUpdate) ClearSound() *RecurringPushUpdate {
+	_u.mutation.ClearSound()
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *RecurringPushUpdate) SetIcon(v string) *RecurringPushUpdate {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableIcon(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (_u *RecurringPushUpdate) ClearIcon() *RecurringPushUpdate {
+	_u.mutation.ClearIcon()
+	return _u
+}
+
+// SetGroup sets the "group" field.
+func (_u *RecurringPushUpdate) SetGroup(v string) *RecurringPushUpdate {
+	_u.mutation.SetGroup(v)
+	return _u
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableGroup(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetGroup(*v)
+	}
+	return _u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (_u *RecurringPushUpdate) ClearGroup() *RecurringPushUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *RecurringPushUpdate) SetLevel(v string) *RecurringPushUpdate {
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableLevel(v *string) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// ClearLevel clears the value of the "level" field.
+func (_u *RecurringPushUpdate) ClearLevel() *RecurringPushUpdate {
+	_u.mutation.ClearLevel()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RecurringPushUpdate) SetEnabled(v bool) *RecurringPushUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableEnabled(v *bool) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *RecurringPushUpdate) SetNextRunAt(v time.Time) *RecurringPushUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableNextRunAt(v *time.Time) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *RecurringPushUpdate) SetLastRunAt(v time.Time) *RecurringPushUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *RecurringPushUpdate) SetNillableLastRunAt(v *time.Time) *RecurringPushUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *RecurringPushUpdate) ClearLastRunAt() *RecurringPushUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecurringPushUpdate) SetUpdatedAt(v time.Time) *RecurringPushUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *RecurringPushUpdate) SetUser(v *User) *RecurringPushUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the RecurringPushMutation object of the builder.
+func (_u *RecurringPushUpdate) Mutation() *RecurringPushMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *RecurringPushUpdate) ClearUser() *RecurringPushUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecurringPushUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurringPushUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecurringPushUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurringPushUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RecurringPushUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := recurringpush.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurringPushUpdate) check() error {
+	if v, ok := _u.mutation.CronExpr(); ok {
+		if err := recurringpush.CronExprValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expr", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.cron_expr": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := recurringpush.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := recurringpush.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.body": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurringPush.user"`)
+	}
+	return nil
+}
+
+func (_u *RecurringPushUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurringpush.Table, recurringpush.Columns, sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CronExpr(); ok {
+		_spec.SetField(recurringpush.FieldCronExpr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(recurringpush.FieldProvider, field.TypeString, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(recurringpush.FieldProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(recurringpush.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(recurringpush.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(recurringpush.FieldURL, field.TypeString, value)
+	}
+	if _u.mutation.URLCleared() {
+		_spec.ClearField(recurringpush.FieldURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sound(); ok {
+		_spec.SetField(recurringpush.FieldSound, field.TypeString, value)
+	}
+	if _u.mutation.SoundCleared() {
+		_spec.ClearField(recurringpush.FieldSound, field.TypeString)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(recurringpush.FieldIcon, field.TypeString, value)
+	}
+	if _u.mutation.IconCleared() {
+		_spec.ClearField(recurringpush.FieldIcon, field.TypeString)
+	}
+	if value, ok := _u.mutation.Group(); ok {
+		_spec.SetField(recurringpush.FieldGroup, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		_spec.ClearField(recurringpush.FieldGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(recurringpush.FieldLevel, field.TypeString, value)
+	}
+	if _u.mutation.LevelCleared() {
+		_spec.ClearField(recurringpush.FieldLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(recurringpush.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(recurringpush.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(recurringpush.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(recurringpush.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringpush.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringpush.UserTable,
+			Columns: []string{recurringpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringpush.UserTable,
+			Columns: []string{recurringpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurringpush.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecurringPushUpdateOne is the builder for updating a single RecurringPush entity.
+type RecurringPushUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecurringPushMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RecurringPushUpdateOne) SetUserID(v uint) *RecurringPushUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableUserID(v *uint) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetCronExpr sets the "cron_expr" field.
+func (_u *RecurringPushUpdateOne) SetCronExpr(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetCronExpr(v)
+	return _u
+}
+
+// SetNillableCronExpr sets the "cron_expr" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableCronExpr(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetCronExpr(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *RecurringPushUpdateOne) SetProvider(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableProvider(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (_u *RecurringPushUpdateOne) ClearProvider() *RecurringPushUpdateOne {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *RecurringPushUpdateOne) SetTitle(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableTitle(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *RecurringPushUpdateOne) SetBody(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableBody(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *RecurringPushUpdateOne) SetURL(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableURL(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// ClearURL clears the value of the "url" field.
+func (_u *RecurringPushUpdateOne) ClearURL() *RecurringPushUpdateOne {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// SetSound sets the "sound" field.
+func (_u *RecurringPushUpdateOne) SetSound(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetSound(v)
+	return _u
+}
+
+// SetNillableSound sets the "sound" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableSound(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetSound(*v)
+	}
+	return _u
+}
+
+// ClearSound clears the value of the "sound" field.
+func (_u *RecurringPushUpdateOne) ClearSound() *RecurringPushUpdateOne {
+	_u.mutation.ClearSound()
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *RecurringPushUpdateOne) SetIcon(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableIcon(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (_u *RecurringPushUpdateOne) ClearIcon() *RecurringPushUpdateOne {
+	_u.mutation.ClearIcon()
+	return _u
+}
+
+// SetGroup sets the "group" field.
+func (_u *RecurringPushUpdateOne) SetGroup(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetGroup(v)
+	return _u
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableGroup(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetGroup(*v)
+	}
+	return _u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (_u *RecurringPushUpdateOne) ClearGroup() *RecurringPushUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *RecurringPushUpdateOne) SetLevel(v string) *RecurringPushUpdateOne {
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableLevel(v *string) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// ClearLevel clears the value of the "level" field.
+func (_u *RecurringPushUpdateOne) ClearLevel() *RecurringPushUpdateOne {
+	_u.mutation.ClearLevel()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RecurringPushUpdateOne) SetEnabled(v bool) *RecurringPushUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableEnabled(v *bool) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *RecurringPushUpdateOne) SetNextRunAt(v time.Time) *RecurringPushUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableNextRunAt(v *time.Time) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *RecurringPushUpdateOne) SetLastRunAt(v time.Time) *RecurringPushUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *RecurringPushUpdateOne) SetNillableLastRunAt(v *time.Time) *RecurringPushUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *RecurringPushUpdateOne) ClearLastRunAt() *RecurringPushUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RecurringPushUpdateOne) SetUpdatedAt(v time.Time) *RecurringPushUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *RecurringPushUpdateOne) SetUser(v *User) *RecurringPushUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the RecurringPushMutation object of the builder.
+func (_u *RecurringPushUpdateOne) Mutation() *RecurringPushMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *RecurringPushUpdateOne) ClearUser() *RecurringPushUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the RecurringPushUpdate builder.
+func (_u *RecurringPushUpdateOne) Where(ps ...predicate.RecurringPush) *RecurringPushUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecurringPushUpdateOne) Select(field string, fields ...string) *RecurringPushUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RecurringPush entity.
+func (_u *RecurringPushUpdateOne) Save(ctx context.Context) (*RecurringPush, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurringPushUpdateOne) SaveX(ctx context.Context) *RecurringPush {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecurringPushUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurringPushUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RecurringPushUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := recurringpush.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurringPushUpdateOne) check() error {
+	if v, ok := _u.mutation.CronExpr(); ok {
+		if err := recurringpush.CronExprValidator(v); err != nil {
+			return &ValidationError{Name: "cron_expr", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.cron_expr": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := recurringpush.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := recurringpush.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "RecurringPush.body": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurringPush.user"`)
+	}
+	return nil
+}
+
+func (_u *RecurringPushUpdateOne) sqlSave(ctx context.Context) (_node *RecurringPush, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurringpush.Table, recurringpush.Columns, sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecurringPush.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurringpush.FieldID)
+		for _, f := range fields {
+			if !recurringpush.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recurringpush.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CronExpr(); ok {
+		_spec.SetField(recurringpush.FieldCronExpr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(recurringpush.FieldProvider, field.TypeString, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(recurringpush.FieldProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(recurringpush.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(recurringpush.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(recurringpush.FieldURL, field.TypeString, value)
+	}
+	if _u.mutation.URLCleared() {
+		_spec.ClearField(recurringpush.FieldURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sound(); ok {
+		_spec.SetField(recurringpush.FieldSound, field.TypeString, value)
+	}
+	if _u.mutation.SoundCleared() {
+		_spec.ClearField(recurringpush.FieldSound, field.TypeString)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(recurringpush.FieldIcon, field.TypeString, value)
+	}
+	if _u.mutation.IconCleared() {
+		_spec.ClearField(recurringpush.FieldIcon, field.TypeString)
+	}
+	if value, ok := _u.mutation.Group(); ok {
+		_spec.SetField(recurringpush.FieldGroup, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		_spec.ClearField(recurringpush.FieldGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(recurringpush.FieldLevel, field.TypeString, value)
+	}
+	if _u.mutation.LevelCleared() {
+		_spec.ClearField(recurringpush.FieldLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(recurringpush.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(recurringpush.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(recurringpush.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(recurringpush.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringpush.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringpush.UserTable,
+			Columns: []string{recurringpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   recurringpush.UserTable,
+			Columns: []string{recurringpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RecurringPush{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurringpush.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -25,6 +25,10 @@ type Role struct {
 	Description string `json:"description,omitempty"`
 	// 是否为系统角色（系统角色不可删除）
 	IsSystem bool `json:"is_system,omitempty"`
+	// 创建角色的用户ID，系统初始化时为空
+	CreatedBy *uint `json:"created_by,omitempty"`
+	// 最后修改角色的用户ID，未修改过时为空
+	UpdatedBy *uint `json:"updated_by,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -71,7 +75,7 @@ func (*Role) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case role.FieldIsSystem:
 			values[i] = new(sql.NullBool)
-		case role.FieldID:
+		case role.FieldID, role.FieldCreatedBy, role.FieldUpdatedBy:
 			values[i] = new(sql.NullInt64)
 		case role.FieldName, role.FieldDisplayName, role.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -122,6 +126,20 @@ func (_m *Role) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsSystem = value.Bool
 			}
+		case role.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uint)
+				*_m.CreatedBy = uint(value.Int64)
+			}
+		case role.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(uint)
+				*_m.UpdatedBy = uint(value.Int64)
+			}
 		case role.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -192,6 +210,16 @@ func (_m *Role) String() string {
 	builder.WriteString("is_system=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsSystem))
 	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -62,6 +62,34 @@ func (_c *RoleCreate) SetNillableIsSystem(v *bool) *RoleCreate {
 	return _c
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (_c *RoleCreate) SetCreatedBy(v uint) *RoleCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableCreatedBy(v *uint) *RoleCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *RoleCreate) SetUpdatedBy(v uint) *RoleCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *RoleCreate) SetNillableUpdatedBy(v *uint) *RoleCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *RoleCreate) SetCreatedAt(v time.Time) *RoleCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -255,6 +283,14 @@ func (_c *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldIsSystem, field.TypeBool, value)
 		_node.IsSystem = value
 	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(role.FieldCreatedBy, field.TypeUint, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(role.FieldUpdatedBy, field.TypeUint, value)
+		_node.UpdatedBy = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(role.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -3,13 +3,19 @@
 package ent
 
 import (
+	"nebula-live/ent/job"
+	"nebula-live/ent/livesubscription"
 	"nebula-live/ent/permission"
+	"nebula-live/ent/recurringpush"
 	"nebula-live/ent/role"
 	"nebula-live/ent/rolepermission"
+	"nebula-live/ent/scheduledpush"
 	"nebula-live/ent/schema"
 	"nebula-live/ent/user"
 	"nebula-live/ent/userpushsetting"
 	"nebula-live/ent/userrole"
+	"nebula-live/ent/usersession"
+	"nebula-live/ent/webhookdelivery"
 	"time"
 )
 
@@ -17,6 +23,86 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescType is the schema descriptor for type field.
+	jobDescType := jobFields[1].Descriptor()
+	// job.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	job.TypeValidator = func() func(string) error {
+		validators := jobDescType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(_type string) error {
+			for _, fn := range fns {
+				if err := fn(_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// jobDescError is the schema descriptor for error field.
+	jobDescError := jobFields[5].Descriptor()
+	// job.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	job.ErrorValidator = jobDescError.Validators[0].(func(string) error)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[6].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[10].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[11].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	livesubscriptionFields := schema.LiveSubscription{}.Fields()
+	_ = livesubscriptionFields
+	// livesubscriptionDescPlatform is the schema descriptor for platform field.
+	livesubscriptionDescPlatform := livesubscriptionFields[2].Descriptor()
+	// livesubscription.PlatformValidator is a validator for the "platform" field. It is called by the builders before save.
+	livesubscription.PlatformValidator = func() func(string) error {
+		validators := livesubscriptionDescPlatform.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(platform string) error {
+			for _, fn := range fns {
+				if err := fn(platform); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// livesubscriptionDescRoomID is the schema descriptor for room_id field.
+	livesubscriptionDescRoomID := livesubscriptionFields[3].Descriptor()
+	// livesubscription.RoomIDValidator is a validator for the "room_id" field. It is called by the builders before save.
+	livesubscription.RoomIDValidator = func() func(string) error {
+		validators := livesubscriptionDescRoomID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(room_id string) error {
+			for _, fn := range fns {
+				if err := fn(room_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// livesubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	livesubscriptionDescCreatedAt := livesubscriptionFields[4].Descriptor()
+	// livesubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	livesubscription.DefaultCreatedAt = livesubscriptionDescCreatedAt.Default.(func() time.Time)
 	permissionFields := schema.Permission{}.Fields()
 	_ = permissionFields
 	// permissionDescName is the schema descriptor for name field.
@@ -95,20 +181,96 @@ func init() {
 			return nil
 		}
 	}()
+	// permissionDescCategory is the schema descriptor for category field.
+	permissionDescCategory := permissionFields[6].Descriptor()
+	// permission.DefaultCategory holds the default value on creation for the category field.
+	permission.DefaultCategory = permissionDescCategory.Default.(string)
+	// permission.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	permission.CategoryValidator = permissionDescCategory.Validators[0].(func(string) error)
 	// permissionDescIsSystem is the schema descriptor for is_system field.
-	permissionDescIsSystem := permissionFields[6].Descriptor()
+	permissionDescIsSystem := permissionFields[7].Descriptor()
 	// permission.DefaultIsSystem holds the default value on creation for the is_system field.
 	permission.DefaultIsSystem = permissionDescIsSystem.Default.(bool)
 	// permissionDescCreatedAt is the schema descriptor for created_at field.
-	permissionDescCreatedAt := permissionFields[7].Descriptor()
+	permissionDescCreatedAt := permissionFields[10].Descriptor()
 	// permission.DefaultCreatedAt holds the default value on creation for the created_at field.
 	permission.DefaultCreatedAt = permissionDescCreatedAt.Default.(func() time.Time)
 	// permissionDescUpdatedAt is the schema descriptor for updated_at field.
-	permissionDescUpdatedAt := permissionFields[8].Descriptor()
+	permissionDescUpdatedAt := permissionFields[11].Descriptor()
 	// permission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	permission.DefaultUpdatedAt = permissionDescUpdatedAt.Default.(func() time.Time)
 	// permission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	permission.UpdateDefaultUpdatedAt = permissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	recurringpushFields := schema.RecurringPush{}.Fields()
+	_ = recurringpushFields
+	// recurringpushDescCronExpr is the schema descriptor for cron_expr field.
+	recurringpushDescCronExpr := recurringpushFields[2].Descriptor()
+	// recurringpush.CronExprValidator is a validator for the "cron_expr" field. It is called by the builders before save.
+	recurringpush.CronExprValidator = func() func(string) error {
+		validators := recurringpushDescCronExpr.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(cron_expr string) error {
+			for _, fn := range fns {
+				if err := fn(cron_expr); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recurringpushDescTitle is the schema descriptor for title field.
+	recurringpushDescTitle := recurringpushFields[4].Descriptor()
+	// recurringpush.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	recurringpush.TitleValidator = func() func(string) error {
+		validators := recurringpushDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recurringpushDescBody is the schema descriptor for body field.
+	recurringpushDescBody := recurringpushFields[5].Descriptor()
+	// recurringpush.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	recurringpush.BodyValidator = func() func(string) error {
+		validators := recurringpushDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recurringpushDescEnabled is the schema descriptor for enabled field.
+	recurringpushDescEnabled := recurringpushFields[11].Descriptor()
+	// recurringpush.DefaultEnabled holds the default value on creation for the enabled field.
+	recurringpush.DefaultEnabled = recurringpushDescEnabled.Default.(bool)
+	// recurringpushDescCreatedAt is the schema descriptor for created_at field.
+	recurringpushDescCreatedAt := recurringpushFields[14].Descriptor()
+	// recurringpush.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recurringpush.DefaultCreatedAt = recurringpushDescCreatedAt.Default.(func() time.Time)
+	// recurringpushDescUpdatedAt is the schema descriptor for updated_at field.
+	recurringpushDescUpdatedAt := recurringpushFields[15].Descriptor()
+	// recurringpush.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recurringpush.DefaultUpdatedAt = recurringpushDescUpdatedAt.Default.(func() time.Time)
+	// recurringpush.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recurringpush.UpdateDefaultUpdatedAt = recurringpushDescUpdatedAt.UpdateDefault.(func() time.Time)
 	roleFields := schema.Role{}.Fields()
 	_ = roleFields
 	// roleDescName is the schema descriptor for name field.
@@ -156,11 +318,11 @@ func init() {
 	// role.DefaultIsSystem holds the default value on creation for the is_system field.
 	role.DefaultIsSystem = roleDescIsSystem.Default.(bool)
 	// roleDescCreatedAt is the schema descriptor for created_at field.
-	roleDescCreatedAt := roleFields[5].Descriptor()
+	roleDescCreatedAt := roleFields[7].Descriptor()
 	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
 	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
 	// roleDescUpdatedAt is the schema descriptor for updated_at field.
-	roleDescUpdatedAt := roleFields[6].Descriptor()
+	roleDescUpdatedAt := roleFields[8].Descriptor()
 	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
 	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -171,6 +333,54 @@ func init() {
 	rolepermissionDescAssignedAt := rolepermissionFields[4].Descriptor()
 	// rolepermission.DefaultAssignedAt holds the default value on creation for the assigned_at field.
 	rolepermission.DefaultAssignedAt = rolepermissionDescAssignedAt.Default.(func() time.Time)
+	scheduledpushFields := schema.ScheduledPush{}.Fields()
+	_ = scheduledpushFields
+	// scheduledpushDescTitle is the schema descriptor for title field.
+	scheduledpushDescTitle := scheduledpushFields[3].Descriptor()
+	// scheduledpush.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	scheduledpush.TitleValidator = func() func(string) error {
+		validators := scheduledpushDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduledpushDescBody is the schema descriptor for body field.
+	scheduledpushDescBody := scheduledpushFields[4].Descriptor()
+	// scheduledpush.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	scheduledpush.BodyValidator = func() func(string) error {
+		validators := scheduledpushDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduledpushDescCreatedAt is the schema descriptor for created_at field.
+	scheduledpushDescCreatedAt := scheduledpushFields[14].Descriptor()
+	// scheduledpush.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledpush.DefaultCreatedAt = scheduledpushDescCreatedAt.Default.(func() time.Time)
+	// scheduledpushDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledpushDescUpdatedAt := scheduledpushFields[15].Descriptor()
+	// scheduledpush.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledpush.DefaultUpdatedAt = scheduledpushDescUpdatedAt.Default.(func() time.Time)
+	// scheduledpush.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledpush.UpdateDefaultUpdatedAt = scheduledpushDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -221,12 +431,28 @@ func init() {
 	userDescAvatar := userFields[5].Descriptor()
 	// user.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
 	user.AvatarValidator = userDescAvatar.Validators[0].(func(string) error)
+	// userDescPushDefaultGroup is the schema descriptor for push_default_group field.
+	userDescPushDefaultGroup := userFields[6].Descriptor()
+	// user.PushDefaultGroupValidator is a validator for the "push_default_group" field. It is called by the builders before save.
+	user.PushDefaultGroupValidator = userDescPushDefaultGroup.Validators[0].(func(string) error)
+	// userDescPushDefaultSound is the schema descriptor for push_default_sound field.
+	userDescPushDefaultSound := userFields[7].Descriptor()
+	// user.PushDefaultSoundValidator is a validator for the "push_default_sound" field. It is called by the builders before save.
+	user.PushDefaultSoundValidator = userDescPushDefaultSound.Validators[0].(func(string) error)
+	// userDescPushDefaultLevel is the schema descriptor for push_default_level field.
+	userDescPushDefaultLevel := userFields[8].Descriptor()
+	// user.PushDefaultLevelValidator is a validator for the "push_default_level" field. It is called by the builders before save.
+	user.PushDefaultLevelValidator = userDescPushDefaultLevel.Validators[0].(func(string) error)
+	// userDescStatusReason is the schema descriptor for status_reason field.
+	userDescStatusReason := userFields[10].Descriptor()
+	// user.StatusReasonValidator is a validator for the "status_reason" field. It is called by the builders before save.
+	user.StatusReasonValidator = userDescStatusReason.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[7].Descriptor()
+	userDescCreatedAt := userFields[13].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[8].Descriptor()
+	userDescUpdatedAt := userFields[14].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -245,12 +471,18 @@ func init() {
 	userpushsettingDescDeviceName := userpushsettingFields[5].Descriptor()
 	// userpushsetting.DeviceNameValidator is a validator for the "device_name" field. It is called by the builders before save.
 	userpushsetting.DeviceNameValidator = userpushsettingDescDeviceName.Validators[0].(func(string) error)
+	// userpushsettingDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	userpushsettingDescConsecutiveFailures := userpushsettingFields[7].Descriptor()
+	// userpushsetting.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	userpushsetting.DefaultConsecutiveFailures = userpushsettingDescConsecutiveFailures.Default.(int)
+	// userpushsetting.ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	userpushsetting.ConsecutiveFailuresValidator = userpushsettingDescConsecutiveFailures.Validators[0].(func(int) error)
 	// userpushsettingDescCreatedAt is the schema descriptor for created_at field.
-	userpushsettingDescCreatedAt := userpushsettingFields[7].Descriptor()
+	userpushsettingDescCreatedAt := userpushsettingFields[9].Descriptor()
 	// userpushsetting.DefaultCreatedAt holds the default value on creation for the created_at field.
 	userpushsetting.DefaultCreatedAt = userpushsettingDescCreatedAt.Default.(func() time.Time)
 	// userpushsettingDescUpdatedAt is the schema descriptor for updated_at field.
-	userpushsettingDescUpdatedAt := userpushsettingFields[8].Descriptor()
+	userpushsettingDescUpdatedAt := userpushsettingFields[10].Descriptor()
 	// userpushsetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	userpushsetting.DefaultUpdatedAt = userpushsettingDescUpdatedAt.Default.(func() time.Time)
 	// userpushsetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -261,4 +493,130 @@ func init() {
 	userroleDescAssignedAt := userroleFields[4].Descriptor()
 	// userrole.DefaultAssignedAt holds the default value on creation for the assigned_at field.
 	userrole.DefaultAssignedAt = userroleDescAssignedAt.Default.(func() time.Time)
+	usersessionFields := schema.UserSession{}.Fields()
+	_ = usersessionFields
+	// usersessionDescRefreshTokenID is the schema descriptor for refresh_token_id field.
+	usersessionDescRefreshTokenID := usersessionFields[2].Descriptor()
+	// usersession.RefreshTokenIDValidator is a validator for the "refresh_token_id" field. It is called by the builders before save.
+	usersession.RefreshTokenIDValidator = func() func(string) error {
+		validators := usersessionDescRefreshTokenID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(refresh_token_id string) error {
+			for _, fn := range fns {
+				if err := fn(refresh_token_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// usersessionDescUserAgent is the schema descriptor for user_agent field.
+	usersessionDescUserAgent := usersessionFields[3].Descriptor()
+	// usersession.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	usersession.UserAgentValidator = usersessionDescUserAgent.Validators[0].(func(string) error)
+	// usersessionDescIP is the schema descriptor for ip field.
+	usersessionDescIP := usersessionFields[4].Descriptor()
+	// usersession.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	usersession.IPValidator = usersessionDescIP.Validators[0].(func(string) error)
+	// usersessionDescCreatedAt is the schema descriptor for created_at field.
+	usersessionDescCreatedAt := usersessionFields[5].Descriptor()
+	// usersession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usersession.DefaultCreatedAt = usersessionDescCreatedAt.Default.(func() time.Time)
+	// usersessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	usersessionDescLastUsedAt := usersessionFields[6].Descriptor()
+	// usersession.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	usersession.DefaultLastUsedAt = usersessionDescLastUsedAt.Default.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescDeliveryID is the schema descriptor for delivery_id field.
+	webhookdeliveryDescDeliveryID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.DeliveryIDValidator is a validator for the "delivery_id" field. It is called by the builders before save.
+	webhookdelivery.DeliveryIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescDeliveryID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(delivery_id string) error {
+			for _, fn := range fns {
+				if err := fn(delivery_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_id string) error {
+			for _, fn := range fns {
+				if err := fn(event_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = func() func(string) error {
+		validators := webhookdeliveryDescEvent.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event string) error {
+			for _, fn := range fns {
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescURL is the schema descriptor for url field.
+	webhookdeliveryDescURL := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookdelivery.URLValidator = func() func(string) error {
+		validators := webhookdeliveryDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookdeliveryDescAttempt is the schema descriptor for attempt field.
+	webhookdeliveryDescAttempt := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	webhookdelivery.AttemptValidator = webhookdeliveryDescAttempt.Validators[0].(func(int) error)
+	// webhookdeliveryDescSuccess is the schema descriptor for success field.
+	webhookdeliveryDescSuccess := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultSuccess holds the default value on creation for the success field.
+	webhookdelivery.DefaultSuccess = webhookdeliveryDescSuccess.Default.(bool)
+	// webhookdeliveryDescError is the schema descriptor for error field.
+	webhookdeliveryDescError := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	webhookdelivery.ErrorValidator = webhookdeliveryDescError.Validators[0].(func(string) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"nebula-live/ent/scheduledpush"
+	"nebula-live/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ScheduledPush is the model entity for the ScheduledPush schema.
+type ScheduledPush struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 关联的用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 指定推送提供商，为空时发送到用户所有启用的设备
+	Provider string `json:"provider,omitempty"`
+	// 推送标题
+	Title string `json:"title,omitempty"`
+	// 推送内容
+	Body string `json:"body,omitempty"`
+	// 点击推送后跳转的URL
+	URL string `json:"url,omitempty"`
+	// 推送铃声
+	Sound string `json:"sound,omitempty"`
+	// 推送图标
+	Icon string `json:"icon,omitempty"`
+	// 推送分组
+	Group string `json:"group,omitempty"`
+	// 推送级别
+	Level string `json:"level,omitempty"`
+	// 计划发送时间
+	SendAt time.Time `json:"send_at,omitempty"`
+	// 推送状态
+	Status scheduledpush.Status `json:"status,omitempty"`
+	// 发送失败时的错误信息
+	Error string `json:"error,omitempty"`
+	// 实际发送时间
+	SentAt *time.Time `json:"sent_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduledPushQuery when eager-loading is set.
+	Edges        ScheduledPushEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduledPushEdges holds the relations/edges for other nodes in the graph.
+type ScheduledPushEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledPushEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledPush) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledpush.FieldID, scheduledpush.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case scheduledpush.FieldProvider, scheduledpush.FieldTitle, scheduledpush.FieldBody, scheduledpush.FieldURL, scheduledpush.FieldSound, scheduledpush.FieldIcon, scheduledpush.FieldGroup, scheduledpush.FieldLevel, scheduledpush.FieldStatus, scheduledpush.FieldError:
+			values[i] = new(sql.NullString)
+		case scheduledpush.FieldSendAt, scheduledpush.FieldSentAt, scheduledpush.FieldCreatedAt, scheduledpush.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledPush fields.
+func (_m *ScheduledPush) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledpush.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint(value.Int64)
+		case scheduledpush.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint(value.Int64)
+			}
+		case scheduledpush.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case scheduledpush.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case scheduledpush.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case scheduledpush.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case scheduledpush.FieldSound:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sound", values[i])
+			} else if value.Valid {
+				_m.Sound = value.String
+			}
+		case scheduledpush.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				_m.Icon = value.String
+			}
+		case scheduledpush.FieldGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group", values[i])
+			} else if value.Valid {
+				_m.Group = value.String
+			}
+		case scheduledpush.FieldLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = value.String
+			}
+		case scheduledpush.FieldSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field send_at", values[i])
+			} else if value.Valid {
+				_m.SendAt = value.Time
+			}
+		case scheduledpush.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = scheduledpush.Status(value.String)
+			}
+		case scheduledpush.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case scheduledpush.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = new(time.Time)
+				*_m.SentAt = value.Time
+			}
+		case scheduledpush.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case scheduledpush.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledPush.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScheduledPush) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ScheduledPush entity.
+func (_m *ScheduledPush) QueryUser() *UserQuery {
+	return NewScheduledPushClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ScheduledPush.
+// Note that you need to call ScheduledPush.Unwrap() before calling this method if this ScheduledPush
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScheduledPush) Update() *ScheduledPushUpdateOne {
+	return NewScheduledPushClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScheduledPush entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScheduledPush) Unwrap() *ScheduledPush {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledPush is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScheduledPush) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledPush(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("sound=")
+	builder.WriteString(_m.Sound)
+	builder.WriteString(", ")
+	builder.WriteString("icon=")
+	builder.WriteString(_m.Icon)
+	builder.WriteString(", ")
+	builder.WriteString("group=")
+	builder.WriteString(_m.Group)
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(_m.Level)
+	builder.WriteString(", ")
+	builder.WriteString("send_at=")
+	builder.WriteString(_m.SendAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	if v := _m.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledPushes is a parsable slice of ScheduledPush.
+type ScheduledPushes []*ScheduledPush
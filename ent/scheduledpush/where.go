@@ -0,0 +1,1029 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledpush
+
+import (
+	"nebula-live/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldUserID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldProvider, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldTitle, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldBody, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldURL, v))
+}
+
+// Sound applies equality check predicate on the "sound" field. It's identical to SoundEQ.
+func Sound(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldSound, v))
+}
+
+// Icon applies equality check predicate on the "icon" field. It's identical to IconEQ.
+func Icon(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldIcon, v))
+}
+
+// Group applies equality check predicate on the "group" field. It's identical to GroupEQ.
+func Group(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldGroup, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldLevel, v))
+}
+
+// SendAt applies equality check predicate on the "send_at" field. It's identical to SendAtEQ.
+func SendAt(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldSendAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldError, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldSentAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderIsNil applies the IsNil predicate on the "provider" field.
+func ProviderIsNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIsNull(FieldProvider))
+}
+
+// ProviderNotNil applies the NotNil predicate on the "provider" field.
+func ProviderNotNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotNull(FieldProvider))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldBody, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLIsNil applies the IsNil predicate on the "url" field.
+func URLIsNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIsNull(FieldURL))
+}
+
+// URLNotNil applies the NotNil predicate on the "url" field.
+func URLNotNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotNull(FieldURL))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldURL, v))
+}
+
+// SoundEQ applies the EQ predicate on the "sound" field.
+func SoundEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldSound, v))
+}
+
+// SoundNEQ applies the NEQ predicate on the "sound" field.
+func SoundNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldSound, v))
+}
+
+// SoundIn applies the In predicate on the "sound" field.
+func SoundIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldSound, vs...))
+}
+
+// SoundNotIn applies the NotIn predicate on the "sound" field.
+func SoundNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldSound, vs...))
+}
+
+// SoundGT applies the GT predicate on the "sound" field.
+func SoundGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldSound, v))
+}
+
+// SoundGTE applies the GTE predicate on the "sound" field.
+func SoundGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldSound, v))
+}
+
+// SoundLT applies the LT predicate on the "sound" field.
+func SoundLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldSound, v))
+}
+
+// SoundLTE applies the LTE predicate on the "sound" field.
+func SoundLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldSound, v))
+}
+
+// SoundContains applies the Contains predicate on the "sound" field.
+func SoundContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldSound, v))
+}
+
+// SoundHasPrefix applies the HasPrefix predicate on the "sound" field.
+func SoundHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldSound, v))
+}
+
+// SoundHasSuffix applies the HasSuffix predicate on the "sound" field.
+func SoundHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldSound, v))
+}
+
+// SoundIsNil applies the IsNil predicate on the "sound" field.
+func SoundIsNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIsNull(FieldSound))
+}
+
+// SoundNotNil applies the NotNil predicate on the "sound" field.
+func SoundNotNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotNull(FieldSound))
+}
+
+// SoundEqualFold applies the EqualFold predicate on the "sound" field.
+func SoundEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldSound, v))
+}
+
+// SoundContainsFold applies the ContainsFold predicate on the "sound" field.
+func SoundContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldSound, v))
+}
+
+// IconEQ applies the EQ predicate on the "icon" field.
+func IconEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldIcon, v))
+}
+
+// IconNEQ applies the NEQ predicate on the "icon" field.
+func IconNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldIcon, v))
+}
+
+// IconIn applies the In predicate on the "icon" field.
+func IconIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldIcon, vs...))
+}
+
+// IconNotIn applies the NotIn predicate on the "icon" field.
+func IconNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldIcon, vs...))
+}
+
+// IconGT applies the GT predicate on the "icon" field.
+func IconGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldIcon, v))
+}
+
+// IconGTE applies the GTE predicate on the "icon" field.
+func IconGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldIcon, v))
+}
+
+// IconLT applies the LT predicate on the "icon" field.
+func IconLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldIcon, v))
+}
+
+// IconLTE applies the LTE predicate on the "icon" field.
+func IconLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldIcon, v))
+}
+
+// IconContains applies the Contains predicate on the "icon" field.
+func IconContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldIcon, v))
+}
+
+// IconHasPrefix applies the HasPrefix predicate on the "icon" field.
+func IconHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldIcon, v))
+}
+
+// IconHasSuffix applies the HasSuffix predicate on the "icon" field.
+func IconHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldIcon, v))
+}
+
+// IconIsNil applies the IsNil predicate on the "icon" field.
+func IconIsNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIsNull(FieldIcon))
+}
+
+// IconNotNil applies the NotNil predicate on the "icon" field.
+func IconNotNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotNull(FieldIcon))
+}
+
+// IconEqualFold applies the EqualFold predicate on the "icon" field.
+func IconEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldIcon, v))
+}
+
+// IconContainsFold applies the ContainsFold predicate on the "icon" field.
+func IconContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldIcon, v))
+}
+
+// GroupEQ applies the EQ predicate on the "group" field.
+func GroupEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldGroup, v))
+}
+
+// GroupNEQ applies the NEQ predicate on the "group" field.
+func GroupNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldGroup, v))
+}
+
+// GroupIn applies the In predicate on the "group" field.
+func GroupIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldGroup, vs...))
+}
+
+// GroupNotIn applies the NotIn predicate on the "group" field.
+func GroupNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldGroup, vs...))
+}
+
+// GroupGT applies the GT predicate on the "group" field.
+func GroupGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldGroup, v))
+}
+
+// GroupGTE applies the GTE predicate on the "group" field.
+func GroupGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldGroup, v))
+}
+
+// GroupLT applies the LT predicate on the "group" field.
+func GroupLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldGroup, v))
+}
+
+// GroupLTE applies the LTE predicate on the "group" field.
+func GroupLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldGroup, v))
+}
+
+// GroupContains applies the Contains predicate on the "group" field.
+func GroupContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldGroup, v))
+}
+
+// GroupHasPrefix applies the HasPrefix predicate on the "group" field.
+func GroupHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldGroup, v))
+}
+
+// GroupHasSuffix applies the HasSuffix predicate on the "group" field.
+func GroupHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldGroup, v))
+}
+
+// GroupIsNil applies the IsNil predicate on the "group" field.
+func GroupIsNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIsNull(FieldGroup))
+}
+
+// GroupNotNil applies the NotNil predicate on the "group" field.
+func GroupNotNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotNull(FieldGroup))
+}
+
+// GroupEqualFold applies the EqualFold predicate on the "group" field.
+func GroupEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldGroup, v))
+}
+
+// GroupContainsFold applies the ContainsFold predicate on the "group" field.
+func GroupContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldGroup, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldLevel, v))
+}
+
+// LevelContains applies the Contains predicate on the "level" field.
+func LevelContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldLevel, v))
+}
+
+// LevelHasPrefix applies the HasPrefix predicate on the "level" field.
+func LevelHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldLevel, v))
+}
+
+// LevelHasSuffix applies the HasSuffix predicate on the "level" field.
+func LevelHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldLevel, v))
+}
+
+// LevelIsNil applies the IsNil predicate on the "level" field.
+func LevelIsNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIsNull(FieldLevel))
+}
+
+// LevelNotNil applies the NotNil predicate on the "level" field.
+func LevelNotNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotNull(FieldLevel))
+}
+
+// LevelEqualFold applies the EqualFold predicate on the "level" field.
+func LevelEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldLevel, v))
+}
+
+// LevelContainsFold applies the ContainsFold predicate on the "level" field.
+func LevelContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldLevel, v))
+}
+
+// SendAtEQ applies the EQ predicate on the "send_at" field.
+func SendAtEQ(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SendAtNEQ applies the NEQ predicate on the "send_at" field.
+func SendAtNEQ(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldSendAt, v))
+}
+
+// SendAtIn applies the In predicate on the "send_at" field.
+func SendAtIn(vs ...time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldSendAt, vs...))
+}
+
+// SendAtNotIn applies the NotIn predicate on the "send_at" field.
+func SendAtNotIn(vs ...time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldSendAt, vs...))
+}
+
+// SendAtGT applies the GT predicate on the "send_at" field.
+func SendAtGT(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldSendAt, v))
+}
+
+// SendAtGTE applies the GTE predicate on the "send_at" field.
+func SendAtGTE(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldSendAt, v))
+}
+
+// SendAtLT applies the LT predicate on the "send_at" field.
+func SendAtLT(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldSendAt, v))
+}
+
+// SendAtLTE applies the LTE predicate on the "send_at" field.
+func SendAtLTE(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldSendAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldContainsFold(FieldError, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotNull(FieldSentAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ScheduledPush {
+	return predicate.ScheduledPush(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ScheduledPush {
+	return predicate.ScheduledPush(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledPush) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledPush) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledPush) predicate.ScheduledPush {
+	return predicate.ScheduledPush(sql.NotPredicates(p))
+}
@@ -0,0 +1,497 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nebula-live/ent/scheduledpush"
+	"nebula-live/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledPushCreate is the builder for creating a ScheduledPush entity.
+type ScheduledPushCreate struct {
+	config
+	mutation *ScheduledPushMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ScheduledPushCreate) SetUserID(v uint) *ScheduledPushCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *ScheduledPushCreate) SetProvider(v string) *ScheduledPushCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableProvider(v *string) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ScheduledPushCreate) SetTitle(v string) *ScheduledPushCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *ScheduledPushCreate) SetBody(v string) *ScheduledPushCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *ScheduledPushCreate) SetURL(v string) *ScheduledPushCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableURL(v *string) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetURL(*v)
+	}
+	return _c
+}
+
+// SetSound sets the "sound" field.
+func (_c *ScheduledPushCreate) SetSound(v string) *ScheduledPushCreate {
+	_c.mutation.SetSound(v)
+	return _c
+}
+
+// SetNillableSound sets the "sound" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableSound(v *string) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetSound(*v)
+	}
+	return _c
+}
+
+// SetIcon sets the "icon" field.
+func (_c *ScheduledPushCreate) SetIcon(v string) *ScheduledPushCreate {
+	_c.mutation.SetIcon(v)
+	return _c
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableIcon(v *string) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetIcon(*v)
+	}
+	return _c
+}
+
+// SetGroup sets the "group" field.
+func (_c *ScheduledPushCreate) SetGroup(v string) *ScheduledPushCreate {
+	_c.mutation.SetGroup(v)
+	return _c
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableGroup(v *string) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetGroup(*v)
+	}
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *ScheduledPushCreate) SetLevel(v string) *ScheduledPushCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableLevel(v *string) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetLevel(*v)
+	}
+	return _c
+}
+
+// SetSendAt sets the "send_at" field.
+func (_c *ScheduledPushCreate) SetSendAt(v time.Time) *ScheduledPushCreate {
+	_c.mutation.SetSendAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScheduledPushCreate) SetStatus(v scheduledpush.Status) *ScheduledPushCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableStatus(v *scheduledpush.Status) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ScheduledPushCreate) SetError(v string) *ScheduledPushCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableError(v *string) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *ScheduledPushCreate) SetSentAt(v time.Time) *ScheduledPushCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableSentAt(v *time.Time) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScheduledPushCreate) SetCreatedAt(v time.Time) *ScheduledPushCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableCreatedAt(v *time.Time) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScheduledPushCreate) SetUpdatedAt(v time.Time) *ScheduledPushCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScheduledPushCreate) SetNillableUpdatedAt(v *time.Time) *ScheduledPushCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScheduledPushCreate) SetID(v uint) *ScheduledPushCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ScheduledPushCreate) SetUser(v *User) *ScheduledPushCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ScheduledPushMutation object of the builder.
+func (_c *ScheduledPushCreate) Mutation() *ScheduledPushMutation {
+	return _c.mutation
+}
+
+// Save creates the ScheduledPush in the database.
+func (_c *ScheduledPushCreate) Save(ctx context.Context) (*ScheduledPush, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduledPushCreate) SaveX(ctx context.Context) *ScheduledPush {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledPushCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledPushCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduledPushCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scheduledpush.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scheduledpush.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := scheduledpush.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduledPushCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ScheduledPush.user_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "ScheduledPush.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := scheduledpush.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "ScheduledPush.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := scheduledpush.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.body": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SendAt(); !ok {
+		return &ValidationError{Name: "send_at", err: errors.New(`ent: missing required field "ScheduledPush.send_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduledPush.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scheduledpush.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledPush.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledPush.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ScheduledPush.user"`)}
+	}
+	return nil
+}
+
+func (_c *ScheduledPushCreate) sqlSave(ctx context.Context) (*ScheduledPush, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduledPushCreate) createSpec() (*ScheduledPush, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledPush{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledpush.Table, sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(scheduledpush.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(scheduledpush.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(scheduledpush.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(scheduledpush.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Sound(); ok {
+		_spec.SetField(scheduledpush.FieldSound, field.TypeString, value)
+		_node.Sound = value
+	}
+	if value, ok := _c.mutation.Icon(); ok {
+		_spec.SetField(scheduledpush.FieldIcon, field.TypeString, value)
+		_node.Icon = value
+	}
+	if value, ok := _c.mutation.Group(); ok {
+		_spec.SetField(scheduledpush.FieldGroup, field.TypeString, value)
+		_node.Group = value
+	}
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(scheduledpush.FieldLevel, field.TypeString, value)
+		_node.Level = value
+	}
+	if value, ok := _c.mutation.SendAt(); ok {
+		_spec.SetField(scheduledpush.FieldSendAt, field.TypeTime, value)
+		_node.SendAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scheduledpush.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(scheduledpush.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(scheduledpush.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledpush.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledpush.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledpush.UserTable,
+			Columns: []string{scheduledpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScheduledPushCreateBulk is the builder for creating many ScheduledPush entities in bulk.
+type ScheduledPushCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledPushCreate
+}
+
+// Save creates the ScheduledPush entities in the database.
+func (_c *ScheduledPushCreateBulk) Save(ctx context.Context) ([]*ScheduledPush, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScheduledPush, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledPushMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduledPushCreateBulk) SaveX(ctx context.Context) []*ScheduledPush {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledPushCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledPushCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"nebula-live/ent/predicate"
+	"nebula-live/ent/scheduledpush"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledPushDelete is the builder for deleting a ScheduledPush entity.
+type ScheduledPushDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledPushMutation
+}
+
+// Where appends a list predicates to the ScheduledPushDelete builder.
+func (_d *ScheduledPushDelete) Where(ps ...predicate.ScheduledPush) *ScheduledPushDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ScheduledPushDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ScheduledPushDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ScheduledPushDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(scheduledpush.Table, sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ScheduledPushDeleteOne is the builder for deleting a single ScheduledPush entity.
+type ScheduledPushDeleteOne struct {
+	_d *ScheduledPushDelete
+}
+
+// Where appends a list predicates to the ScheduledPushDelete builder.
+func (_d *ScheduledPushDeleteOne) Where(ps ...predicate.ScheduledPush) *ScheduledPushDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ScheduledPushDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{scheduledpush.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ScheduledPushDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nebula-live/ent/predicate"
+	"nebula-live/ent/scheduledpush"
+	"nebula-live/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduledPushUpdate is the builder for updating ScheduledPush entities.
+type ScheduledPushUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledPushMutation
+}
+
+// Where appends a list predicates to the ScheduledPushUpdate builder.
+func (_u *ScheduledPushUpdate) Where(ps ...predicate.ScheduledPush) *ScheduledPushUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ScheduledPushUpdate) SetUserID(v uint) *ScheduledPushUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableUserID(v *uint) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ScheduledPushUpdate) SetProvider(v string) *ScheduledPushUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableProvider(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (_u *ScheduledPushUpdate) ClearProvider() *ScheduledPushUpdate {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ScheduledPushUpdate) SetTitle(v string) *ScheduledPushUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableTitle(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *ScheduledPushUpdate) SetBody(v string) *ScheduledPushUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableBody(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ScheduledPushUpdate) SetURL(v string) *ScheduledPushUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableURL(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// ClearURL clears the value of the "url" field.
+func (_u *ScheduledPushUpdate) ClearURL() *ScheduledPushUpdate {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// SetSound sets the "sound" field.
+func (_u *ScheduledPushUpdate) SetSound(v string) *ScheduledPushUpdate {
+	_u.mutation.SetSound(v)
+	return _u
+}
+
+// SetNillableSound sets the "sound" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableSound(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetSound(*v)
+	}
+	return _u
+}
+
+// ClearSound clears the value of the "sound" field.
+func (_u *ScheduledPushUpdate) ClearSound() *ScheduledPushUpdate {
+	_u.mutation.ClearSound()
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *ScheduledPushUpdate) SetIcon(v string) *ScheduledPushUpdate {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableIcon(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (_u *ScheduledPushUpdate) ClearIcon() *ScheduledPushUpdate {
+	_u.mutation.ClearIcon()
+	return _u
+}
+
+// SetGroup sets the "group" field.
+func (_u *ScheduledPushUpdate) SetGroup(v string) *ScheduledPushUpdate {
+	_u.mutation.SetGroup(v)
+	return _u
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableGroup(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetGroup(*v)
+	}
+	return _u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (_u *ScheduledPushUpdate) ClearGroup() *ScheduledPushUpdate {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *ScheduledPushUpdate) SetLevel(v string) *ScheduledPushUpdate {
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableLevel(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// ClearLevel clears the value of the "level" field.
+func (_u *ScheduledPushUpdate) ClearLevel() *ScheduledPushUpdate {
+	_u.mutation.ClearLevel()
+	return _u
+}
+
+// SetSendAt sets the "send_at" field.
+func (_u *ScheduledPushUpdate) SetSendAt(v time.Time) *ScheduledPushUpdate {
+	_u.mutation.SetSendAt(v)
+	return _u
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableSendAt(v *time.Time) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetSendAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScheduledPushUpdate) SetStatus(v scheduledpush.Status) *ScheduledPushUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableStatus(v *scheduledpush.Status) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ScheduledPushUpdate) SetError(v string) *ScheduledPushUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableError(v *string) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ScheduledPushUpdate) ClearError() *ScheduledPushUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *ScheduledPushUpdate) SetSentAt(v time.Time) *ScheduledPushUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *ScheduledPushUpdate) SetNillableSentAt(v *time.Time) *ScheduledPushUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *ScheduledPushUpdate) ClearSentAt() *ScheduledPushUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledPushUpdate) SetUpdatedAt(v time.Time) *ScheduledPushUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ScheduledPushUpdate) SetUser(v *User) *ScheduledPushUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ScheduledPushMutation object of the builder.
+func (_u *ScheduledPushUpdate) Mutation() *ScheduledPushMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ScheduledPushUpdate) ClearUser() *ScheduledPushUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduledPushUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledPushUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduledPushUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledPushUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledPushUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledpush.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledPushUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := scheduledpush.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := scheduledpush.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.body": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scheduledpush.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledPush.user"`)
+	}
+	return nil
+}
+
+func (_u *ScheduledPushUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledpush.Table, scheduledpush.Columns, sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(scheduledpush.FieldProvider, field.TypeString, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(scheduledpush.FieldProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(scheduledpush.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(scheduledpush.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(scheduledpush.FieldURL, field.TypeString, value)
+	}
+	if _u.mutation.URLCleared() {
+		_spec.ClearField(scheduledpush.FieldURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sound(); ok {
+		_spec.SetField(scheduledpush.FieldSound, field.TypeString, value)
+	}
+	if _u.mutation.SoundCleared() {
+		_spec.ClearField(scheduledpush.FieldSound, field.TypeString)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(scheduledpush.FieldIcon, field.TypeString, value)
+	}
+	if _u.mutation.IconCleared() {
+		_spec.ClearField(scheduledpush.FieldIcon, field.TypeString)
+	}
+	if value, ok := _u.mutation.Group(); ok {
+		_spec.SetField(scheduledpush.FieldGroup, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		_spec.ClearField(scheduledpush.FieldGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(scheduledpush.FieldLevel, field.TypeString, value)
+	}
+	if _u.mutation.LevelCleared() {
+		_spec.ClearField(scheduledpush.FieldLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.SendAt(); ok {
+		_spec.SetField(scheduledpush.FieldSendAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scheduledpush.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(scheduledpush.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(scheduledpush.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(scheduledpush.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(scheduledpush.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledpush.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledpush.UserTable,
+			Columns: []string{scheduledpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledpush.UserTable,
+			Columns: []string{scheduledpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledpush.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduledPushUpdateOne is the builder for updating a single ScheduledPush entity.
+type ScheduledPushUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledPushMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ScheduledPushUpdateOne) SetUserID(v uint) *ScheduledPushUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableUserID(v *uint) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ScheduledPushUpdateOne) SetProvider(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableProvider(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (_u *ScheduledPushUpdateOne) ClearProvider() *ScheduledPushUpdateOne {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ScheduledPushUpdateOne) SetTitle(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableTitle(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *ScheduledPushUpdateOne) SetBody(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableBody(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *ScheduledPushUpdateOne) SetURL(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableURL(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// ClearURL clears the value of the "url" field.
+func (_u *ScheduledPushUpdateOne) ClearURL() *ScheduledPushUpdateOne {
+	_u.mutation.ClearURL()
+	return _u
+}
+
+// SetSound sets the "sound" field.
+func (_u *ScheduledPushUpdateOne) SetSound(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetSound(v)
+	return _u
+}
+
+// SetNillableSound sets the "sound" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableSound(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetSound(*v)
+	}
+	return _u
+}
+
+// ClearSound clears the value of the "sound" field.
+func (_u *ScheduledPushUpdateOne) ClearSound() *ScheduledPushUpdateOne {
+	_u.mutation.ClearSound()
+	return _u
+}
+
+// SetIcon sets the "icon" field.
+func (_u *ScheduledPushUpdateOne) SetIcon(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetIcon(v)
+	return _u
+}
+
+// SetNillableIcon sets the "icon" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableIcon(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetIcon(*v)
+	}
+	return _u
+}
+
+// ClearIcon clears the value of the "icon" field.
+func (_u *ScheduledPushUpdateOne) ClearIcon() *ScheduledPushUpdateOne {
+	_u.mutation.ClearIcon()
+	return _u
+}
+
+// SetGroup sets the "group" field.
+func (_u *ScheduledPushUpdateOne) SetGroup(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetGroup(v)
+	return _u
+}
+
+// SetNillableGroup sets the "group" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableGroup(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetGroup(*v)
+	}
+	return _u
+}
+
+// ClearGroup clears the value of the "group" field.
+func (_u *ScheduledPushUpdateOne) ClearGroup() *ScheduledPushUpdateOne {
+	_u.mutation.ClearGroup()
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *ScheduledPushUpdateOne) SetLevel(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableLevel(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// ClearLevel clears the value of the "level" field.
+func (_u *ScheduledPushUpdateOne) ClearLevel() *ScheduledPushUpdateOne {
+	_u.mutation.ClearLevel()
+	return _u
+}
+
+// SetSendAt sets the "send_at" field.
+func (_u *ScheduledPushUpdateOne) SetSendAt(v time.Time) *ScheduledPushUpdateOne {
+	_u.mutation.SetSendAt(v)
+	return _u
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableSendAt(v *time.Time) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetSendAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScheduledPushUpdateOne) SetStatus(v scheduledpush.Status) *ScheduledPushUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableStatus(v *scheduledpush.Status) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ScheduledPushUpdateOne) SetError(v string) *ScheduledPushUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableError(v *string) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ScheduledPushUpdateOne) ClearError() *ScheduledPushUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *ScheduledPushUpdateOne) SetSentAt(v time.Time) *ScheduledPushUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *ScheduledPushUpdateOne) SetNillableSentAt(v *time.Time) *ScheduledPushUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *ScheduledPushUpdateOne) ClearSentAt() *ScheduledPushUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledPushUpdateOne) SetUpdatedAt(v time.Time) *ScheduledPushUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ScheduledPushUpdateOne) SetUser(v *User) *ScheduledPushUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ScheduledPushMutation object of the builder.
+func (_u *ScheduledPushUpdateOne) Mutation() *ScheduledPushMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ScheduledPushUpdateOne) ClearUser() *ScheduledPushUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ScheduledPushUpdate builder.
+func (_u *ScheduledPushUpdateOne) Where(ps ...predicate.ScheduledPush) *ScheduledPushUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduledPushUpdateOne) Select(field string, fields ...string) *ScheduledPushUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScheduledPush entity.
+func (_u *ScheduledPushUpdateOne) Save(ctx context.Context) (*ScheduledPush, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledPushUpdateOne) SaveX(ctx context.Context) *ScheduledPush {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduledPushUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledPushUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledPushUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledpush.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledPushUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := scheduledpush.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := scheduledpush.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.body": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scheduledpush.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledPush.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledPush.user"`)
+	}
+	return nil
+}
+
+func (_u *ScheduledPushUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledPush, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledpush.Table, scheduledpush.Columns, sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledPush.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledpush.FieldID)
+		for _, f := range fields {
+			if !scheduledpush.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledpush.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(scheduledpush.FieldProvider, field.TypeString, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(scheduledpush.FieldProvider, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(scheduledpush.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(scheduledpush.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(scheduledpush.FieldURL, field.TypeString, value)
+	}
+	if _u.mutation.URLCleared() {
+		_spec.ClearField(scheduledpush.FieldURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sound(); ok {
+		_spec.SetField(scheduledpush.FieldSound, field.TypeString, value)
+	}
+	if _u.mutation.SoundCleared() {
+		_spec.ClearField(scheduledpush.FieldSound, field.TypeString)
+	}
+	if value, ok := _u.mutation.Icon(); ok {
+		_spec.SetField(scheduledpush.FieldIcon, field.TypeString, value)
+	}
+	if _u.mutation.IconCleared() {
+		_spec.ClearField(scheduledpush.FieldIcon, field.TypeString)
+	}
+	if value, ok := _u.mutation.Group(); ok {
+		_spec.SetField(scheduledpush.FieldGroup, field.TypeString, value)
+	}
+	if _u.mutation.GroupCleared() {
+		_spec.ClearField(scheduledpush.FieldGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(scheduledpush.FieldLevel, field.TypeString, value)
+	}
+	if _u.mutation.LevelCleared() {
+		_spec.ClearField(scheduledpush.FieldLevel, field.TypeString)
+	}
+	if value, ok := _u.mutation.SendAt(); ok {
+		_spec.SetField(scheduledpush.FieldSendAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scheduledpush.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(scheduledpush.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(scheduledpush.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(scheduledpush.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(scheduledpush.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledpush.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledpush.UserTable,
+			Columns: []string{scheduledpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledpush.UserTable,
+			Columns: []string{scheduledpush.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScheduledPush{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledpush.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
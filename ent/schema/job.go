@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Job holds the schema definition for the Job entity.
+type Job struct {
+	ent.Schema
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").
+			Unique().
+			Immutable(),
+		field.String("type").
+			NotEmpty().
+			MaxLen(64).
+			Comment("任务类型，决定由哪个处理函数执行"),
+		field.Text("payload").
+			Optional().
+			Comment("任务参数，JSON格式"),
+		field.Enum("status").
+			Values("pending", "running", "completed", "failed").
+			Default("pending").
+			Comment("任务状态"),
+		field.Text("result").
+			Optional().
+			Comment("任务完成时的结果，JSON格式"),
+		field.String("error").
+			Optional().
+			MaxLen(1000).
+			Comment("任务失败时的错误信息"),
+		field.Int("attempts").
+			Default(0).
+			Comment("已开始执行的次数，中断后重新执行时递增"),
+		field.Uint("created_by").
+			Optional().
+			Nillable().
+			Comment("提交任务的用户ID，系统提交时为空"),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("最近一次开始执行的时间"),
+		field.Time("finished_at").
+			Optional().
+			Nillable().
+			Comment("完成或失败的时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		// 工作器按状态和提交顺序领取任务
+		index.Fields("status", "id"),
+		index.Fields("created_by"),
+	}
+}
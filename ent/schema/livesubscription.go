@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LiveSubscription holds the schema definition for the LiveSubscription entity.
+type LiveSubscription struct {
+	ent.Schema
+}
+
+// Fields of the LiveSubscription.
+func (LiveSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").
+			Unique().
+			Immutable(),
+		field.Uint("user_id").
+			Comment("订阅的用户ID"),
+		field.String("platform").
+			NotEmpty().
+			MaxLen(50).
+			Comment("直播平台的规范名称（别名在保存前已解析）"),
+		field.String("room_id").
+			NotEmpty().
+			MaxLen(100).
+			Comment("直播间ID"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the LiveSubscription.
+func (LiveSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关联到用户
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the LiveSubscription.
+func (LiveSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一用户不能重复订阅同一直播间
+		index.Fields("user_id", "platform", "room_id").Unique(),
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -41,9 +41,22 @@ func (Permission) Fields() []ent.Field {
 			NotEmpty().
 			MaxLen(50).
 			Comment("操作名称，如：read, write, delete, manage"),
+		field.String("category").
+			Default("other").
+			MaxLen(50).
+			Comment("权限分类，用于界面分组展示，如：user, rbac, system"),
 		field.Bool("is_system").
 			Default(false).
 			Comment("是否为系统权限（系统权限不可删除）"),
+		field.Uint("created_by").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("创建权限的用户ID，系统初始化时为空"),
+		field.Uint("updated_by").
+			Optional().
+			Nillable().
+			Comment("最后修改权限的用户ID，未修改过时为空"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -69,6 +82,7 @@ func (Permission) Indexes() []ent.Index {
 		index.Fields("resource"),
 		index.Fields("action"),
 		index.Fields("resource", "action").Unique(),
+		index.Fields("category"),
 		index.Fields("is_system"),
 		index.Fields("created_at"),
 	}
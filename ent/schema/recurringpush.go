@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecurringPush holds the schema definition for the RecurringPush entity.
+type RecurringPush struct {
+	ent.Schema
+}
+
+// Fields of the RecurringPush.
+func (RecurringPush) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").
+			Unique().
+			Immutable(),
+		field.Uint("user_id").
+			Comment("关联的用户ID"),
+		field.String("cron_expr").
+			NotEmpty().
+			MaxLen(100).
+			Comment("标准cron表达式（分 时 日 月 周）"),
+		field.String("provider").
+			Optional().
+			Comment("指定推送提供商，为空时发送到用户所有启用的设备"),
+		field.String("title").
+			NotEmpty().
+			MaxLen(200).
+			Comment("推送标题"),
+		field.String("body").
+			NotEmpty().
+			MaxLen(1000).
+			Comment("推送内容"),
+		field.String("url").
+			Optional().
+			Comment("点击推送后跳转的URL"),
+		field.String("sound").
+			Optional().
+			Comment("推送铃声"),
+		field.String("icon").
+			Optional().
+			Comment("推送图标"),
+		field.String("group").
+			Optional().
+			Comment("推送分组"),
+		field.String("level").
+			Optional().
+			Comment("推送级别"),
+		field.Bool("enabled").
+			Default(true).
+			Comment("是否启用"),
+		field.Time("next_run_at").
+			Comment("下次运行时间"),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("上次运行时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RecurringPush.
+func (RecurringPush) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关联到用户
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the RecurringPush.
+func (RecurringPush) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		// 调度器按启用状态和下次运行时间查询到期任务
+		index.Fields("enabled", "next_run_at"),
+	}
+}
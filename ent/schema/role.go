@@ -36,6 +36,15 @@ func (Role) Fields() []ent.Field {
 		field.Bool("is_system").
 			Default(false).
 			Comment("是否为系统角色（系统角色不可删除）"),
+		field.Uint("created_by").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("创建角色的用户ID，系统初始化时为空"),
+		field.Uint("updated_by").
+			Optional().
+			Nillable().
+			Comment("最后修改角色的用户ID，未修改过时为空"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScheduledPush holds the schema definition for the ScheduledPush entity.
+type ScheduledPush struct {
+	ent.Schema
+}
+
+// Fields of the ScheduledPush.
+func (ScheduledPush) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").
+			Unique().
+			Immutable(),
+		field.Uint("user_id").
+			Comment("关联的用户ID"),
+		field.String("provider").
+			Optional().
+			Comment("指定推送提供商，为空时发送到用户所有启用的设备"),
+		field.String("title").
+			NotEmpty().
+			MaxLen(200).
+			Comment("推送标题"),
+		field.String("body").
+			NotEmpty().
+			MaxLen(1000).
+			Comment("推送内容"),
+		field.String("url").
+			Optional().
+			Comment("点击推送后跳转的URL"),
+		field.String("sound").
+			Optional().
+			Comment("推送铃声"),
+		field.String("icon").
+			Optional().
+			Comment("推送图标"),
+		field.String("group").
+			Optional().
+			Comment("推送分组"),
+		field.String("level").
+			Optional().
+			Comment("推送级别"),
+		field.Time("send_at").
+			Comment("计划发送时间"),
+		field.Enum("status").
+			Values("pending", "sending", "sent", "failed", "cancelled").
+			Default("pending").
+			Comment("推送状态"),
+		field.String("error").
+			Optional().
+			Comment("发送失败时的错误信息"),
+		field.Time("sent_at").
+			Optional().
+			Nillable().
+			Comment("实际发送时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ScheduledPush.
+func (ScheduledPush) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关联到用户
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the ScheduledPush.
+func (ScheduledPush) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		// 调度器按状态和发送时间查询到期任务
+		index.Fields("status", "send_at"),
+	}
+}
@@ -37,9 +37,33 @@ func (User) Fields() []ent.Field {
 		field.String("avatar").
 			Optional().
 			MaxLen(500),
+		field.String("push_default_group").
+			Optional().
+			MaxLen(100).
+			Comment("用户默认推送分组，优先级低于设备设置"),
+		field.String("push_default_sound").
+			Optional().
+			MaxLen(100).
+			Comment("用户默认推送铃声，优先级低于设备设置"),
+		field.String("push_default_level").
+			Optional().
+			MaxLen(20).
+			Comment("用户默认推送通知级别，优先级低于设备设置"),
 		field.Enum("status").
 			Values("active", "inactive", "banned").
 			Default("active"),
+		field.String("status_reason").
+			Optional().
+			MaxLen(500).
+			Comment("最近一次状态变更的原因，禁用时必填"),
+		field.Time("status_changed_at").
+			Optional().
+			Nillable().
+			Comment("最近一次状态变更的时间，从未变更时为空"),
+		field.Time("ban_expires_at").
+			Optional().
+			Nillable().
+			Comment("临时禁用的到期时间，到期后登录时自动恢复为活跃状态，永久禁用时为空"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -64,6 +88,18 @@ func (User) Edges() []ent.Edge {
 		// 用户的推送设置
 		edge.From("push_settings", UserPushSetting.Type).
 			Ref("user"),
+		// 用户的定时推送
+		edge.From("scheduled_pushes", ScheduledPush.Type).
+			Ref("user"),
+		// 用户的周期推送
+		edge.From("recurring_pushes", RecurringPush.Type).
+			Ref("user"),
+		// 用户的登录会话
+		edge.From("sessions", UserSession.Type).
+			Ref("user"),
+		// 用户的直播间订阅
+		edge.From("live_subscriptions", LiveSubscription.Type).
+			Ref("user"),
 	}
 }
 
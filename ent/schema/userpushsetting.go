@@ -23,14 +23,14 @@ func (UserPushSetting) Fields() []ent.Field {
 		field.Uint("user_id").
 			Comment("关联的用户ID"),
 		field.Enum("provider").
-			Values("bark").
+			Values("bark", "email").
 			Comment("推送服务提供商"),
 		field.Bool("enabled").
 			Default(false).
 			Comment("是否启用此推送设置"),
 		field.String("device_id").
 			NotEmpty().
-			Comment("设备ID或推送标识符"),
+			Comment("设备ID或推送标识符，邮件提供商为收件人邮箱地址"),
 		field.String("device_name").
 			Optional().
 			MaxLen(100).
@@ -38,6 +38,14 @@ func (UserPushSetting) Fields() []ent.Field {
 		field.JSON("settings", map[string]interface{}{}).
 			Optional().
 			Comment("提供商特定的设置，JSON格式存储"),
+		field.Int("consecutive_failures").
+			Default(0).
+			NonNegative().
+			Comment("连续推送失败次数，推送成功后清零"),
+		field.Time("last_failure_at").
+			Optional().
+			Nillable().
+			Comment("最近一次推送失败时间"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -64,7 +72,10 @@ func (UserPushSetting) Indexes() []ent.Index {
 		// 用户和提供商的组合索引，支持一个用户多个同类型设备
 		index.Fields("user_id", "provider"),
 		index.Fields("user_id"),
+		// 查询用户已启用设备时使用
+		index.Fields("user_id", "enabled"),
 		index.Fields("provider"),
+		index.Fields("device_id"),
 		index.Fields("enabled"),
 		index.Fields("created_at"),
 		// 设备ID的唯一性索引，防止重复添加同一设备
@@ -30,6 +30,10 @@ func (UserRole) Fields() []ent.Field {
 		field.Time("assigned_at").
 			Default(time.Now).
 			Comment("分配时间"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空表示永不过期"),
 	}
 }
 
@@ -58,5 +62,6 @@ func (UserRole) Indexes() []ent.Index {
 		index.Fields("user_id", "role_id").Unique(), // 确保用户角色组合唯一
 		index.Fields("assigned_by"),
 		index.Fields("assigned_at"),
+		index.Fields("expires_at"),
 	}
 }
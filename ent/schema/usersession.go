@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserSession holds the schema definition for the UserSession entity.
+type UserSession struct {
+	ent.Schema
+}
+
+// Fields of the UserSession.
+func (UserSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").
+			Unique().
+			Immutable(),
+		field.Uint("user_id").
+			Comment("关联的用户ID"),
+		field.String("refresh_token_id").
+			Unique().
+			NotEmpty().
+			MaxLen(64).
+			Comment("当前有效的刷新令牌ID（jti），每次刷新时轮换"),
+		field.String("user_agent").
+			Optional().
+			MaxLen(500).
+			Comment("登录设备的User-Agent"),
+		field.String("ip").
+			Optional().
+			MaxLen(64).
+			Comment("最近一次使用的IP地址"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Default(time.Now).
+			Comment("最近一次登录或刷新令牌的时间"),
+		field.Time("expires_at").
+			Comment("会话过期时间，与刷新令牌的过期时间一致"),
+	}
+}
+
+// Edges of the UserSession.
+func (UserSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关联到用户
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the UserSession.
+func (UserSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "expires_at"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id").
+			Unique().
+			Immutable(),
+		field.String("delivery_id").
+			NotEmpty().
+			MaxLen(64).
+			Comment("投递ID，同一事件发往同一端点的所有重试共用"),
+		field.String("event_id").
+			NotEmpty().
+			MaxLen(64).
+			Comment("事件ID"),
+		field.String("event").
+			NotEmpty().
+			MaxLen(64).
+			Comment("事件类型，如 user.created"),
+		field.String("url").
+			NotEmpty().
+			MaxLen(500).
+			Comment("接收端点地址"),
+		field.Int("attempt").
+			Positive().
+			Comment("第几次尝试，从1开始"),
+		field.Int("status_code").
+			Optional().
+			Comment("端点返回的HTTP状态码，请求未完成时为0"),
+		field.Bool("success").
+			Default(false),
+		field.String("error").
+			Optional().
+			MaxLen(1000).
+			Comment("失败原因"),
+		field.Int64("duration_ms").
+			Optional().
+			Comment("请求耗时（毫秒）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("delivery_id"),
+		index.Fields("event"),
+		index.Fields("created_at"),
+	}
+}
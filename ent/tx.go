@@ -12,18 +12,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// LiveSubscription is the client for interacting with the LiveSubscription builders.
+	LiveSubscription *LiveSubscriptionClient
 	// Permission is the client for interacting with the Permission builders.
 	Permission *PermissionClient
+	// RecurringPush is the client for interacting with the RecurringPush builders.
+	RecurringPush *RecurringPushClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// RolePermission is the client for interacting with the RolePermission builders.
 	RolePermission *RolePermissionClient
+	// ScheduledPush is the client for interacting with the ScheduledPush builders.
+	ScheduledPush *ScheduledPushClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserPushSetting is the client for interacting with the UserPushSetting builders.
 	UserPushSetting *UserPushSettingClient
 	// UserRole is the client for interacting with the UserRole builders.
 	UserRole *UserRoleClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,12 +167,18 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Job = NewJobClient(tx.config)
+	tx.LiveSubscription = NewLiveSubscriptionClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
+	tx.RecurringPush = NewRecurringPushClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.RolePermission = NewRolePermissionClient(tx.config)
+	tx.ScheduledPush = NewScheduledPushClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserPushSetting = NewUserPushSettingClient(tx.config)
 	tx.UserRole = NewUserRoleClient(tx.config)
+	tx.UserSession = NewUserSessionClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -170,7 +188,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Permission.QueryXXX(), the query will be executed
+// applies a query, for example: Job.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -27,8 +27,20 @@ type User struct {
 	Nickname string `json:"nickname,omitempty"`
 	// Avatar holds the value of the "avatar" field.
 	Avatar string `json:"avatar,omitempty"`
+	// 用户默认推送分组，优先级低于设备设置
+	PushDefaultGroup string `json:"push_default_group,omitempty"`
+	// 用户默认推送铃声，优先级低于设备设置
+	PushDefaultSound string `json:"push_default_sound,omitempty"`
+	// 用户默认推送通知级别，优先级低于设备设置
+	PushDefaultLevel string `json:"push_default_level,omitempty"`
 	// Status holds the value of the "status" field.
 	Status user.Status `json:"status,omitempty"`
+	// 最近一次状态变更的原因，禁用时必填
+	StatusReason string `json:"status_reason,omitempty"`
+	// 最近一次状态变更的时间，从未变更时为空
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+	// 临时禁用的到期时间，到期后登录时自动恢复为活跃状态，永久禁用时为空
+	BanExpiresAt *time.Time `json:"ban_expires_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -49,9 +61,17 @@ type UserEdges struct {
 	AssignedRolePermissions []*RolePermission `json:"assigned_role_permissions,omitempty"`
 	// PushSettings holds the value of the push_settings edge.
 	PushSettings []*UserPushSetting `json:"push_settings,omitempty"`
+	// ScheduledPushes holds the value of the scheduled_pushes edge.
+	ScheduledPushes []*ScheduledPush `json:"scheduled_pushes,omitempty"`
+	// RecurringPushes holds the value of the recurring_pushes edge.
+	RecurringPushes []*RecurringPush `json:"recurring_pushes,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*UserSession `json:"sessions,omitempty"`
+	// LiveSubscriptions holds the value of the live_subscriptions edge.
+	LiveSubscriptions []*LiveSubscription `json:"live_subscriptions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [8]bool
 }
 
 // UserRolesOrErr returns the UserRoles value or an error if the edge
@@ -90,6 +110,42 @@ func (e UserEdges) PushSettingsOrErr() ([]*UserPushSetting, error) {
 	return nil, &NotLoadedError{edge: "push_settings"}
 }
 
+// ScheduledPushesOrErr returns the ScheduledPushes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ScheduledPushesOrErr() ([]*ScheduledPush, error) {
+	if e.loadedTypes[4] {
+		return e.ScheduledPushes, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_pushes"}
+}
+
+// RecurringPushesOrErr returns the RecurringPushes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecurringPushesOrErr() ([]*RecurringPush, error) {
+	if e.loadedTypes[5] {
+		return e.RecurringPushes, nil
+	}
+	return nil, &NotLoadedError{edge: "recurring_pushes"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*UserSession, error) {
+	if e.loadedTypes[6] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// LiveSubscriptionsOrErr returns the LiveSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LiveSubscriptionsOrErr() ([]*LiveSubscription, error) {
+	if e.loadedTypes[7] {
+		return e.LiveSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "live_subscriptions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -97,9 +153,9 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldNickname, user.FieldAvatar, user.FieldStatus:
+		case user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldNickname, user.FieldAvatar, user.FieldPushDefaultGroup, user.FieldPushDefaultSound, user.FieldPushDefaultLevel, user.FieldStatus, user.FieldStatusReason:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldStatusChangedAt, user.FieldBanExpiresAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -152,12 +208,50 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Avatar = value.String
 			}
+		case user.FieldPushDefaultGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_default_group", values[i])
+			} else if value.Valid {
+				_m.PushDefaultGroup = value.String
+			}
+		case user.FieldPushDefaultSound:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_default_sound", values[i])
+			} else if value.Valid {
+				_m.PushDefaultSound = value.String
+			}
+		case user.FieldPushDefaultLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_default_level", values[i])
+			} else if value.Valid {
+				_m.PushDefaultLevel = value.String
+			}
 		case user.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				_m.Status = user.Status(value.String)
 			}
+		case user.FieldStatusReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_reason", values[i])
+			} else if value.Valid {
+				_m.StatusReason = value.String
+			}
+		case user.FieldStatusChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field status_changed_at", values[i])
+			} else if value.Valid {
+				_m.StatusChangedAt = new(time.Time)
+				*_m.StatusChangedAt = value.Time
+			}
+		case user.FieldBanExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ban_expires_at", values[i])
+			} else if value.Valid {
+				_m.BanExpiresAt = new(time.Time)
+				*_m.BanExpiresAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -203,6 +297,26 @@ func (_m *User) QueryPushSettings() *UserPushSettingQuery {
 	return NewUserClient(_m.config).QueryPushSettings(_m)
 }
 
+// QueryScheduledPushes queries the "scheduled_pushes" edge of the User entity.
+func (_m *User) QueryScheduledPushes() *ScheduledPushQuery {
+	return NewUserClient(_m.config).QueryScheduledPushes(_m)
+}
+
+// QueryRecurringPushes queries the "recurring_pushes" edge of the User entity.
+func (_m *User) QueryRecurringPushes() *RecurringPushQuery {
+	return NewUserClient(_m.config).QueryRecurringPushes(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (_m *User) QuerySessions() *UserSessionQuery {
+	return NewUserClient(_m.config).QuerySessions(_m)
+}
+
+// QueryLiveSubscriptions queries the "live_subscriptions" edge of the User entity.
+func (_m *User) QueryLiveSubscriptions() *LiveSubscriptionQuery {
+	return NewUserClient(_m.config).QueryLiveSubscriptions(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -240,9 +354,31 @@ func (_m *User) String() string {
 	builder.WriteString("avatar=")
 	builder.WriteString(_m.Avatar)
 	builder.WriteString(", ")
+	builder.WriteString("push_default_group=")
+	builder.WriteString(_m.PushDefaultGroup)
+	builder.WriteString(", ")
+	builder.WriteString("push_default_sound=")
+	builder.WriteString(_m.PushDefaultSound)
+	builder.WriteString(", ")
+	builder.WriteString("push_default_level=")
+	builder.WriteString(_m.PushDefaultLevel)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("status_reason=")
+	builder.WriteString(_m.StatusReason)
+	builder.WriteString(", ")
+	if v := _m.StatusChangedAt; v != nil {
+		builder.WriteString("status_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BanExpiresAt; v != nil {
+		builder.WriteString("ban_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
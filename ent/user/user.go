@@ -25,8 +25,20 @@ const (
 	FieldNickname = "nickname"
 	// FieldAvatar holds the string denoting the avatar field in the database.
 	FieldAvatar = "avatar"
+	// FieldPushDefaultGroup holds the string denoting the push_default_group field in the database.
+	FieldPushDefaultGroup = "push_default_group"
+	// FieldPushDefaultSound holds the string denoting the push_default_sound field in the database.
+	FieldPushDefaultSound = "push_default_sound"
+	// FieldPushDefaultLevel holds the string denoting the push_default_level field in the database.
+	FieldPushDefaultLevel = "push_default_level"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldStatusReason holds the string denoting the status_reason field in the database.
+	FieldStatusReason = "status_reason"
+	// FieldStatusChangedAt holds the string denoting the status_changed_at field in the database.
+	FieldStatusChangedAt = "status_changed_at"
+	// FieldBanExpiresAt holds the string denoting the ban_expires_at field in the database.
+	FieldBanExpiresAt = "ban_expires_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -39,6 +51,14 @@ const (
 	EdgeAssignedRolePermissions = "assigned_role_permissions"
 	// EdgePushSettings holds the string denoting the push_settings edge name in mutations.
 	EdgePushSettings = "push_settings"
+	// EdgeScheduledPushes holds the string denoting the scheduled_pushes edge name in mutations.
+	EdgeScheduledPushes = "scheduled_pushes"
+	// EdgeRecurringPushes holds the string denoting the recurring_pushes edge name in mutations.
+	EdgeRecurringPushes = "recurring_pushes"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeLiveSubscriptions holds the string denoting the live_subscriptions edge name in mutations.
+	EdgeLiveSubscriptions = "live_subscriptions"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// UserRolesTable is the table that holds the user_roles relation/edge.
@@ -69,6 +89,34 @@ const (
 	PushSettingsInverseTable = "user_push_settings"
 	// PushSettingsColumn is the table column denoting the push_settings relation/edge.
 	PushSettingsColumn = "user_id"
+	// ScheduledPushesTable is the table that holds the scheduled_pushes relation/edge.
+	ScheduledPushesTable = "scheduled_pushes"
+	// ScheduledPushesInverseTable is the table name for the ScheduledPush entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledpush" package.
+	ScheduledPushesInverseTable = "scheduled_pushes"
+	// ScheduledPushesColumn is the table column denoting the scheduled_pushes relation/edge.
+	ScheduledPushesColumn = "user_id"
+	// RecurringPushesTable is the table that holds the recurring_pushes relation/edge.
+	RecurringPushesTable = "recurring_pushes"
+	// RecurringPushesInverseTable is the table name for the RecurringPush entity.
+	// It exists in this package in order to avoid circular dependency with the "recurringpush" package.
+	RecurringPushesInverseTable = "recurring_pushes"
+	// RecurringPushesColumn is the table column denoting the recurring_pushes relation/edge.
+	RecurringPushesColumn = "user_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "user_sessions"
+	// SessionsInverseTable is the table name for the UserSession entity.
+	// It exists in this package in order to avoid circular dependency with the "usersession" package.
+	SessionsInverseTable = "user_sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_id"
+	// LiveSubscriptionsTable is the table that holds the live_subscriptions relation/edge.
+	LiveSubscriptionsTable = "live_subscriptions"
+	// LiveSubscriptionsInverseTable is the table name for the LiveSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "livesubscription" package.
+	LiveSubscriptionsInverseTable = "live_subscriptions"
+	// LiveSubscriptionsColumn is the table column denoting the live_subscriptions relation/edge.
+	LiveSubscriptionsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -79,7 +127,13 @@ var Columns = []string{
 	FieldPassword,
 	FieldNickname,
 	FieldAvatar,
+	FieldPushDefaultGroup,
+	FieldPushDefaultSound,
+	FieldPushDefaultLevel,
 	FieldStatus,
+	FieldStatusReason,
+	FieldStatusChangedAt,
+	FieldBanExpiresAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -105,6 +159,14 @@ var (
 	NicknameValidator func(string) error
 	// AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
 	AvatarValidator func(string) error
+	// PushDefaultGroupValidator is a validator for the "push_default_group" field. It is called by the builders before save.
+	PushDefaultGroupValidator func(string) error
+	// PushDefaultSoundValidator is a validator for the "push_default_sound" field. It is called by the builders before save.
+	PushDefaultSoundValidator func(string) error
+	// PushDefaultLevelValidator is a validator for the "push_default_level" field. It is called by the builders before save.
+	PushDefaultLevelValidator func(string) error
+	// StatusReasonValidator is a validator for the "status_reason" field. It is called by the builders before save.
+	StatusReasonValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -173,11 +235,41 @@ func ByAvatar(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatar, opts...).ToFunc()
 }
 
+// ByPushDefaultGroup orders the results by the push_default_group field.
+func ByPushDefaultGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPushDefaultGroup, opts...).ToFunc()
+}
+
+// ByPushDefaultSound orders the results by the push_default_sound field.
+func ByPushDefaultSound(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPushDefaultSound, opts...).ToFunc()
+}
+
+// ByPushDefaultLevel orders the results by the push_default_level field.
+func ByPushDefaultLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPushDefaultLevel, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByStatusReason orders the results by the status_reason field.
+func ByStatusReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusReason, opts...).ToFunc()
+}
+
+// ByStatusChangedAt orders the results by the status_changed_at field.
+func ByStatusChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusChangedAt, opts...).ToFunc()
+}
+
+// ByBanExpiresAt orders the results by the ban_expires_at field.
+func ByBanExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBanExpiresAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -243,6 +335,62 @@ func ByPushSettings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPushSettingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByScheduledPushesCount orders the results by scheduled_pushes count.
+func ByScheduledPushesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledPushesStep(), opts...)
+	}
+}
+
+// ByScheduledPushes orders the results by scheduled_pushes terms.
+func ByScheduledPushes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledPushesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecurringPushesCount orders the results by recurring_pushes count.
+func ByRecurringPushesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecurringPushesStep(), opts...)
+	}
+}
+
+// ByRecurringPushes orders the results by recurring_pushes terms.
+func ByRecurringPushes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurringPushesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLiveSubscriptionsCount orders the results by live_subscriptions count.
+func ByLiveSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLiveSubscriptionsStep(), opts...)
+	}
+}
+
+// ByLiveSubscriptions orders the results by live_subscriptions terms.
+func ByLiveSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLiveSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -271,3 +419,31 @@ func newPushSettingsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, PushSettingsTable, PushSettingsColumn),
 	)
 }
+func newScheduledPushesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledPushesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ScheduledPushesTable, ScheduledPushesColumn),
+	)
+}
+func newRecurringPushesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurringPushesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, RecurringPushesTable, RecurringPushesColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SessionsTable, SessionsColumn),
+	)
+}
+func newLiveSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LiveSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, LiveSubscriptionsTable, LiveSubscriptionsColumn),
+	)
+}
@@ -80,6 +80,36 @@ func Avatar(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAvatar, v))
 }
 
+// PushDefaultGroup applies equality check predicate on the "push_default_group" field. It's identical to PushDefaultGroupEQ.
+func PushDefaultGroup(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultSound applies equality check predicate on the "push_default_sound" field. It's identical to PushDefaultSoundEQ.
+func PushDefaultSound(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPushDefaultSound, v))
+}
+
+// PushDefaultLevel applies equality check predicate on the "push_default_level" field. It's identical to PushDefaultLevelEQ.
+func PushDefaultLevel(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPushDefaultLevel, v))
+}
+
+// StatusReason applies equality check predicate on the "status_reason" field. It's identical to StatusReasonEQ.
+func StatusReason(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusReason, v))
+}
+
+// StatusChangedAt applies equality check predicate on the "status_changed_at" field. It's identical to StatusChangedAtEQ.
+func StatusChangedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusChangedAt, v))
+}
+
+// BanExpiresAt applies equality check predicate on the "ban_expires_at" field. It's identical to BanExpiresAtEQ.
+func BanExpiresAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBanExpiresAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -435,6 +465,231 @@ func AvatarContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAvatar, v))
 }
 
+// PushDefaultGroupEQ applies the EQ predicate on the "push_default_group" field.
+func PushDefaultGroupEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupNEQ applies the NEQ predicate on the "push_default_group" field.
+func PushDefaultGroupNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupIn applies the In predicate on the "push_default_group" field.
+func PushDefaultGroupIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPushDefaultGroup, vs...))
+}
+
+// PushDefaultGroupNotIn applies the NotIn predicate on the "push_default_group" field.
+func PushDefaultGroupNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPushDefaultGroup, vs...))
+}
+
+// PushDefaultGroupGT applies the GT predicate on the "push_default_group" field.
+func PushDefaultGroupGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupGTE applies the GTE predicate on the "push_default_group" field.
+func PushDefaultGroupGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupLT applies the LT predicate on the "push_default_group" field.
+func PushDefaultGroupLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupLTE applies the LTE predicate on the "push_default_group" field.
+func PushDefaultGroupLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupContains applies the Contains predicate on the "push_default_group" field.
+func PushDefaultGroupContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupHasPrefix applies the HasPrefix predicate on the "push_default_group" field.
+func PushDefaultGroupHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupHasSuffix applies the HasSuffix predicate on the "push_default_group" field.
+func PushDefaultGroupHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupIsNil applies the IsNil predicate on the "push_default_group" field.
+func PushDefaultGroupIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPushDefaultGroup))
+}
+
+// PushDefaultGroupNotNil applies the NotNil predicate on the "push_default_group" field.
+func PushDefaultGroupNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPushDefaultGroup))
+}
+
+// PushDefaultGroupEqualFold applies the EqualFold predicate on the "push_default_group" field.
+func PushDefaultGroupEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultGroupContainsFold applies the ContainsFold predicate on the "push_default_group" field.
+func PushDefaultGroupContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPushDefaultGroup, v))
+}
+
+// PushDefaultSoundEQ applies the EQ predicate on the "push_default_sound" field.
+func PushDefaultSoundEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundNEQ applies the NEQ predicate on the "push_default_sound" field.
+func PushDefaultSoundNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundIn applies the In predicate on the "push_default_sound" field.
+func PushDefaultSoundIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPushDefaultSound, vs...))
+}
+
+// PushDefaultSoundNotIn applies the NotIn predicate on the "push_default_sound" field.
+func PushDefaultSoundNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPushDefaultSound, vs...))
+}
+
+// PushDefaultSoundGT applies the GT predicate on the "push_default_sound" field.
+func PushDefaultSoundGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundGTE applies the GTE predicate on the "push_default_sound" field.
+func PushDefaultSoundGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundLT applies the LT predicate on the "push_default_sound" field.
+func PushDefaultSoundLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundLTE applies the LTE predicate on the "push_default_sound" field.
+func PushDefaultSoundLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundContains applies the Contains predicate on the "push_default_sound" field.
+func PushDefaultSoundContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundHasPrefix applies the HasPrefix predicate on the "push_default_sound" field.
+func PushDefaultSoundHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundHasSuffix applies the HasSuffix predicate on the "push_default_sound" field.
+func PushDefaultSoundHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundIsNil applies the IsNil predicate on the "push_default_sound" field.
+func PushDefaultSoundIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPushDefaultSound))
+}
+
+// PushDefaultSoundNotNil applies the NotNil predicate on the "push_default_sound" field.
+func PushDefaultSoundNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPushDefaultSound))
+}
+
+// PushDefaultSoundEqualFold applies the EqualFold predicate on the "push_default_sound" field.
+func PushDefaultSoundEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPushDefaultSound, v))
+}
+
+// PushDefaultSoundContainsFold applies the ContainsFold predicate on the "push_default_sound" field.
+func PushDefaultSoundContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPushDefaultSound, v))
+}
+
+// PushDefaultLevelEQ applies the EQ predicate on the "push_default_level" field.
+func PushDefaultLevelEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelNEQ applies the NEQ predicate on the "push_default_level" field.
+func PushDefaultLevelNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelIn applies the In predicate on the "push_default_level" field.
+func PushDefaultLevelIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPushDefaultLevel, vs...))
+}
+
+// PushDefaultLevelNotIn applies the NotIn predicate on the "push_default_level" field.
+func PushDefaultLevelNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPushDefaultLevel, vs...))
+}
+
+// PushDefaultLevelGT applies the GT predicate on the "push_default_level" field.
+func PushDefaultLevelGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelGTE applies the GTE predicate on the "push_default_level" field.
+func PushDefaultLevelGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelLT applies the LT predicate on the "push_default_level" field.
+func PushDefaultLevelLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelLTE applies the LTE predicate on the "push_default_level" field.
+func PushDefaultLevelLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelContains applies the Contains predicate on the "push_default_level" field.
+func PushDefaultLevelContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelHasPrefix applies the HasPrefix predicate on the "push_default_level" field.
+func PushDefaultLevelHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelHasSuffix applies the HasSuffix predicate on the "push_default_level" field.
+func PushDefaultLevelHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelIsNil applies the IsNil predicate on the "push_default_level" field.
+func PushDefaultLevelIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPushDefaultLevel))
+}
+
+// PushDefaultLevelNotNil applies the NotNil predicate on the "push_default_level" field.
+func PushDefaultLevelNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPushDefaultLevel))
+}
+
+// PushDefaultLevelEqualFold applies the EqualFold predicate on the "push_default_level" field.
+func PushDefaultLevelEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPushDefaultLevel, v))
+}
+
+// PushDefaultLevelContainsFold applies the ContainsFold predicate on the "push_default_level" field.
+func PushDefaultLevelContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPushDefaultLevel, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldStatus, v))
@@ -455,6 +710,181 @@ func StatusNotIn(vs ...Status) predicate.User {
 	return predicate.User(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// StatusReasonEQ applies the EQ predicate on the "status_reason" field.
+func StatusReasonEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusReason, v))
+}
+
+// StatusReasonNEQ applies the NEQ predicate on the "status_reason" field.
+func StatusReasonNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStatusReason, v))
+}
+
+// StatusReasonIn applies the In predicate on the "status_reason" field.
+func StatusReasonIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStatusReason, vs...))
+}
+
+// StatusReasonNotIn applies the NotIn predicate on the "status_reason" field.
+func StatusReasonNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStatusReason, vs...))
+}
+
+// StatusReasonGT applies the GT predicate on the "status_reason" field.
+func StatusReasonGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStatusReason, v))
+}
+
+// StatusReasonGTE applies the GTE predicate on the "status_reason" field.
+func StatusReasonGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStatusReason, v))
+}
+
+// StatusReasonLT applies the LT predicate on the "status_reason" field.
+func StatusReasonLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStatusReason, v))
+}
+
+// StatusReasonLTE applies the LTE predicate on the "status_reason" field.
+func StatusReasonLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStatusReason, v))
+}
+
+// StatusReasonContains applies the Contains predicate on the "status_reason" field.
+func StatusReasonContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldStatusReason, v))
+}
+
+// StatusReasonHasPrefix applies the HasPrefix predicate on the "status_reason" field.
+func StatusReasonHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldStatusReason, v))
+}
+
+// StatusReasonHasSuffix applies the HasSuffix predicate on the "status_reason" field.
+func StatusReasonHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldStatusReason, v))
+}
+
+// StatusReasonIsNil applies the IsNil predicate on the "status_reason" field.
+func StatusReasonIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldStatusReason))
+}
+
+// StatusReasonNotNil applies the NotNil predicate on the "status_reason" field.
+func StatusReasonNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldStatusReason))
+}
+
+// StatusReasonEqualFold applies the EqualFold predicate on the "status_reason" field.
+func StatusReasonEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldStatusReason, v))
+}
+
+// StatusReasonContainsFold applies the ContainsFold predicate on the "status_reason" field.
+func StatusReasonContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldStatusReason, v))
+}
+
+// StatusChangedAtEQ applies the EQ predicate on the "status_changed_at" field.
+func StatusChangedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtNEQ applies the NEQ predicate on the "status_changed_at" field.
+func StatusChangedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtIn applies the In predicate on the "status_changed_at" field.
+func StatusChangedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStatusChangedAt, vs...))
+}
+
+// StatusChangedAtNotIn applies the NotIn predicate on the "status_changed_at" field.
+func StatusChangedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStatusChangedAt, vs...))
+}
+
+// StatusChangedAtGT applies the GT predicate on the "status_changed_at" field.
+func StatusChangedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtGTE applies the GTE predicate on the "status_changed_at" field.
+func StatusChangedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtLT applies the LT predicate on the "status_changed_at" field.
+func StatusChangedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtLTE applies the LTE predicate on the "status_changed_at" field.
+func StatusChangedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStatusChangedAt, v))
+}
+
+// StatusChangedAtIsNil applies the IsNil predicate on the "status_changed_at" field.
+func StatusChangedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldStatusChangedAt))
+}
+
+// StatusChangedAtNotNil applies the NotNil predicate on the "status_changed_at" field.
+func StatusChangedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldStatusChangedAt))
+}
+
+// BanExpiresAtEQ applies the EQ predicate on the "ban_expires_at" field.
+func BanExpiresAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtNEQ applies the NEQ predicate on the "ban_expires_at" field.
+func BanExpiresAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtIn applies the In predicate on the "ban_expires_at" field.
+func BanExpiresAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldBanExpiresAt, vs...))
+}
+
+// BanExpiresAtNotIn applies the NotIn predicate on the "ban_expires_at" field.
+func BanExpiresAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldBanExpiresAt, vs...))
+}
+
+// BanExpiresAtGT applies the GT predicate on the "ban_expires_at" field.
+func BanExpiresAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtGTE applies the GTE predicate on the "ban_expires_at" field.
+func BanExpiresAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtLT applies the LT predicate on the "ban_expires_at" field.
+func BanExpiresAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtLTE applies the LTE predicate on the "ban_expires_at" field.
+func BanExpiresAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldBanExpiresAt, v))
+}
+
+// BanExpiresAtIsNil applies the IsNil predicate on the "ban_expires_at" field.
+func BanExpiresAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldBanExpiresAt))
+}
+
+// BanExpiresAtNotNil applies the NotNil predicate on the "ban_expires_at" field.
+func BanExpiresAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldBanExpiresAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -627,6 +1057,98 @@ func HasPushSettingsWith(preds ...predicate.UserPushSetting) predicate.User {
 	})
 }
 
+// HasScheduledPushes applies the HasEdge predicate on the "scheduled_pushes" edge.
+func HasScheduledPushes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ScheduledPushesTable, ScheduledPushesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledPushesWith applies the HasEdge predicate on the "scheduled_pushes" edge with a given conditions (other predicates).
+func HasScheduledPushesWith(preds ...predicate.ScheduledPush) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newScheduledPushesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecurringPushes applies the HasEdge predicate on the "recurring_pushes" edge.
+func HasRecurringPushes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, RecurringPushesTable, RecurringPushesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecurringPushesWith applies the HasEdge predicate on the "recurring_pushes" edge with a given conditions (other predicates).
+func HasRecurringPushesWith(preds ...predicate.RecurringPush) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRecurringPushesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.UserSession) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLiveSubscriptions applies the HasEdge predicate on the "live_subscriptions" edge.
+func HasLiveSubscriptions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, LiveSubscriptionsTable, LiveSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLiveSubscriptionsWith applies the HasEdge predicate on the "live_subscriptions" edge with a given conditions (other predicates).
+func HasLiveSubscriptionsWith(preds ...predicate.LiveSubscription) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLiveSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -6,10 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nebula-live/ent/livesubscription"
+	"nebula-live/ent/recurringpush"
 	"nebula-live/ent/rolepermission"
+	"nebula-live/ent/scheduledpush"
 	"nebula-live/ent/user"
 	"nebula-live/ent/userpushsetting"
 	"nebula-live/ent/userrole"
+	"nebula-live/ent/usersession"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -69,6 +73,48 @@ func (_c *UserCreate) SetNillableAvatar(v *string) *UserCreate {
 	return _c
 }
 
+// SetPushDefaultGroup sets the "push_default_group" field.
+func (_c *UserCreate) SetPushDefaultGroup(v string) *UserCreate {
+	_c.mutation.SetPushDefaultGroup(v)
+	return _c
+}
+
+// SetNillablePushDefaultGroup sets the "push_default_group" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePushDefaultGroup(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPushDefaultGroup(*v)
+	}
+	return _c
+}
+
+// SetPushDefaultSound sets the "push_default_sound" field.
+func (_c *UserCreate) SetPushDefaultSound(v string) *UserCreate {
+	_c.mutation.SetPushDefaultSound(v)
+	return _c
+}
+
+// SetNillablePushDefaultSound sets the "push_default_sound" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePushDefaultSound(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPushDefaultSound(*v)
+	}
+	return _c
+}
+
+// SetPushDefaultLevel sets the "push_default_level" field.
+func (_c *UserCreate) SetPushDefaultLevel(v string) *UserCreate {
+	_c.mutation.SetPushDefaultLevel(v)
+	return _c
+}
+
+// SetNillablePushDefaultLevel sets the "push_default_level" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePushDefaultLevel(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPushDefaultLevel(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *UserCreate) SetStatus(v user.Status) *UserCreate {
 	_c.mutation.SetStatus(v)
@@ -83,6 +129,48 @@ func (_c *UserCreate) SetNillableStatus(v *user.Status) *UserCreate {
 	return _c
 }
 
+// SetStatusReason sets the "status_reason" field.
+func (_c *UserCreate) SetStatusReason(v string) *UserCreate {
+	_c.mutation.SetStatusReason(v)
+	return _c
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatusReason(v *string) *UserCreate {
+	if v != nil {
+		_c.SetStatusReason(*v)
+	}
+	return _c
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (_c *UserCreate) SetStatusChangedAt(v time.Time) *UserCreate {
+	_c.mutation.SetStatusChangedAt(v)
+	return _c
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStatusChangedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetStatusChangedAt(*v)
+	}
+	return _c
+}
+
+// SetBanExpiresAt sets the "ban_expires_at" field.
+func (_c *UserCreate) SetBanExpiresAt(v time.Time) *UserCreate {
+	_c.mutation.SetBanExpiresAt(v)
+	return _c
+}
+
+// SetNillableBanExpiresAt sets the "ban_expires_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableBanExpiresAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetBanExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -177,6 +265,66 @@ func (_c *UserCreate) AddPushSettings(v ...*UserPushSetting) *UserCreate {
 	return _c.AddPushSettingIDs(ids...)
 }
 
+// AddScheduledPushIDs adds the "scheduled_pushes" edge to the ScheduledPush entity by IDs.
+func (_c *UserCreate) AddScheduledPushIDs(ids ...uint) *UserCreate {
+	_c.mutation.AddScheduledPushIDs(ids...)
+	return _c
+}
+
+// AddScheduledPushes adds the "scheduled_pushes" edges to the ScheduledPush entity.
+func (_c *UserCreate) AddScheduledPushes(v ...*ScheduledPush) *UserCreate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScheduledPushIDs(ids...)
+}
+
+// AddRecurringPushIDs adds the "recurring_pushes" edge to the RecurringPush entity by IDs.
+func (_c *UserCreate) AddRecurringPushIDs(ids ...uint) *UserCreate {
+	_c.mutation.AddRecurringPushIDs(ids...)
+	return _c
+}
+
+// AddRecurringPushes adds the "recurring_pushes" edges to the RecurringPush entity.
+func (_c *UserCreate) AddRecurringPushes(v ...*RecurringPush) *UserCreate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecurringPushIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the UserSession entity by IDs.
+func (_c *UserCreate) AddSessionIDs(ids ...uint) *UserCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the UserSession entity.
+func (_c *UserCreate) AddSessions(v ...*UserSession) *UserCreate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// AddLiveSubscriptionIDs adds the "live_subscriptions" edge to the LiveSubscription entity by IDs.
+func (_c *UserCreate) AddLiveSubscriptionIDs(ids ...uint) *UserCreate {
+	_c.mutation.AddLiveSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddLiveSubscriptions adds the "live_subscriptions" edges to the LiveSubscription entity.
+func (_c *UserCreate) AddLiveSubscriptions(v ...*LiveSubscription) *UserCreate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLiveSubscriptionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -262,6 +410,21 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "User.avatar": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.PushDefaultGroup(); ok {
+		if err := user.PushDefaultGroupValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_group", err: fmt.Errorf(`ent: validator failed for field "User.push_default_group": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PushDefaultSound(); ok {
+		if err := user.PushDefaultSoundValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_sound", err: fmt.Errorf(`ent: validator failed for field "User.push_default_sound": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PushDefaultLevel(); ok {
+		if err := user.PushDefaultLevelValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_level", err: fmt.Errorf(`ent: validator failed for field "User.push_default_level": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "User.status"`)}
 	}
@@ -270,6 +433,11 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.StatusReason(); ok {
+		if err := user.StatusReasonValidator(v); err != nil {
+			return &ValidationError{Name: "status_reason", err: fmt.Errorf(`ent: validator failed for field "User.status_reason": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -328,10 +496,34 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAvatar, field.TypeString, value)
 		_node.Avatar = value
 	}
+	if value, ok := _c.mutation.PushDefaultGroup(); ok {
+		_spec.SetField(user.FieldPushDefaultGroup, field.TypeString, value)
+		_node.PushDefaultGroup = value
+	}
+	if value, ok := _c.mutation.PushDefaultSound(); ok {
+		_spec.SetField(user.FieldPushDefaultSound, field.TypeString, value)
+		_node.PushDefaultSound = value
+	}
+	if value, ok := _c.mutation.PushDefaultLevel(); ok {
+		_spec.SetField(user.FieldPushDefaultLevel, field.TypeString, value)
+		_node.PushDefaultLevel = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+		_node.StatusReason = value
+	}
+	if value, ok := _c.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+		_node.StatusChangedAt = &value
+	}
+	if value, ok := _c.mutation.BanExpiresAt(); ok {
+		_spec.SetField(user.FieldBanExpiresAt, field.TypeTime, value)
+		_node.BanExpiresAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -404,6 +596,70 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ScheduledPushesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledPushesTable,
+			Columns: []string{user.ScheduledPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecurringPushesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecurringPushesTable,
+			Columns: []string{user.RecurringPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LiveSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LiveSubscriptionsTable,
+			Columns: []string{user.LiveSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
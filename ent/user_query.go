@@ -7,11 +7,15 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"nebula-live/ent/livesubscription"
 	"nebula-live/ent/predicate"
+	"nebula-live/ent/recurringpush"
 	"nebula-live/ent/rolepermission"
+	"nebula-live/ent/scheduledpush"
 	"nebula-live/ent/user"
 	"nebula-live/ent/userpushsetting"
 	"nebula-live/ent/userrole"
+	"nebula-live/ent/usersession"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -30,6 +34,10 @@ type UserQuery struct {
 	withAssignedUserRoles       *UserRoleQuery
 	withAssignedRolePermissions *RolePermissionQuery
 	withPushSettings            *UserPushSettingQuery
+	withScheduledPushes         *ScheduledPushQuery
+	withRecurringPushes         *RecurringPushQuery
+	withSessions                *UserSessionQuery
+	withLiveSubscriptions       *LiveSubscriptionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -154,6 +162,94 @@ func (_q *UserQuery) QueryPushSettings() *UserPushSettingQuery {
 	return query
 }
 
+// QueryScheduledPushes chains the current query on the "scheduled_pushes" edge.
+func (_q *UserQuery) QueryScheduledPushes() *ScheduledPushQuery {
+	query := (&ScheduledPushClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(scheduledpush.Table, scheduledpush.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ScheduledPushesTable, user.ScheduledPushesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecurringPushes chains the current query on the "recurring_pushes" edge.
+func (_q *UserQuery) QueryRecurringPushes() *RecurringPushQuery {
+	query := (&RecurringPushClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(recurringpush.Table, recurringpush.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.RecurringPushesTable, user.RecurringPushesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySessions chains the current query on the "sessions" edge.
+func (_q *UserQuery) QuerySessions() *UserSessionQuery {
+	query := (&UserSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(usersession.Table, usersession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.SessionsTable, user.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLiveSubscriptions chains the current query on the "live_subscriptions" edge.
+func (_q *UserQuery) QueryLiveSubscriptions() *LiveSubscriptionQuery {
+	query := (&LiveSubscriptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(livesubscription.Table, livesubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.LiveSubscriptionsTable, user.LiveSubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -350,6 +446,10 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withAssignedUserRoles:       _q.withAssignedUserRoles.Clone(),
 		withAssignedRolePermissions: _q.withAssignedRolePermissions.Clone(),
 		withPushSettings:            _q.withPushSettings.Clone(),
+		withScheduledPushes:         _q.withScheduledPushes.Clone(),
+		withRecurringPushes:         _q.withRecurringPushes.Clone(),
+		withSessions:                _q.withSessions.Clone(),
+		withLiveSubscriptions:       _q.withLiveSubscriptions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -400,6 +500,50 @@ func (_q *UserQuery) WithPushSettings(opts ...func(*UserPushSettingQuery)) *User
 	return _q
 }
 
+// WithScheduledPushes tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_pushes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithScheduledPushes(opts ...func(*ScheduledPushQuery)) *UserQuery {
+	query := (&ScheduledPushClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduledPushes = query
+	return _q
+}
+
+// WithRecurringPushes tells the query-builder to eager-load the nodes that are connected to
+// the "recurring_pushes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithRecurringPushes(opts ...func(*RecurringPushQuery)) *UserQuery {
+	query := (&RecurringPushClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecurringPushes = query
+	return _q
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSessions(opts ...func(*UserSessionQuery)) *UserQuery {
+	query := (&UserSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSessions = query
+	return _q
+}
+
+// WithLiveSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "live_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLiveSubscriptions(opts ...func(*LiveSubscriptionQuery)) *UserQuery {
+	query := (&LiveSubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLiveSubscriptions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -478,11 +622,15 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [8]bool{
 			_q.withUserRoles != nil,
 			_q.withAssignedUserRoles != nil,
 			_q.withAssignedRolePermissions != nil,
 			_q.withPushSettings != nil,
+			_q.withScheduledPushes != nil,
+			_q.withRecurringPushes != nil,
+			_q.withSessions != nil,
+			_q.withLiveSubscriptions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -533,6 +681,34 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withScheduledPushes; query != nil {
+		if err := _q.loadScheduledPushes(ctx, query, nodes,
+			func(n *User) { n.Edges.ScheduledPushes = []*ScheduledPush{} },
+			func(n *User, e *ScheduledPush) { n.Edges.ScheduledPushes = append(n.Edges.ScheduledPushes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecurringPushes; query != nil {
+		if err := _q.loadRecurringPushes(ctx, query, nodes,
+			func(n *User) { n.Edges.RecurringPushes = []*RecurringPush{} },
+			func(n *User, e *RecurringPush) { n.Edges.RecurringPushes = append(n.Edges.RecurringPushes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSessions; query != nil {
+		if err := _q.loadSessions(ctx, query, nodes,
+			func(n *User) { n.Edges.Sessions = []*UserSession{} },
+			func(n *User, e *UserSession) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLiveSubscriptions; query != nil {
+		if err := _q.loadLiveSubscriptions(ctx, query, nodes,
+			func(n *User) { n.Edges.LiveSubscriptions = []*LiveSubscription{} },
+			func(n *User, e *LiveSubscription) { n.Edges.LiveSubscriptions = append(n.Edges.LiveSubscriptions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -656,6 +832,126 @@ func (_q *UserQuery) loadPushSettings(ctx context.Context, query *UserPushSettin
 	}
 	return nil
 }
+func (_q *UserQuery) loadScheduledPushes(ctx context.Context, query *ScheduledPushQuery, nodes []*User, init func(*User), assign func(*User, *ScheduledPush)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduledpush.FieldUserID)
+	}
+	query.Where(predicate.ScheduledPush(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ScheduledPushesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadRecurringPushes(ctx context.Context, query *RecurringPushQuery, nodes []*User, init func(*User), assign func(*User, *RecurringPush)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recurringpush.FieldUserID)
+	}
+	query.Where(predicate.RecurringPush(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RecurringPushesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadSessions(ctx context.Context, query *UserSessionQuery, nodes []*User, init func(*User), assign func(*User, *UserSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(usersession.FieldUserID)
+	}
+	query.Where(predicate.UserSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLiveSubscriptions(ctx context.Context, query *LiveSubscriptionQuery, nodes []*User, init func(*User), assign func(*User, *LiveSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(livesubscription.FieldUserID)
+	}
+	query.Where(predicate.LiveSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LiveSubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,11 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"nebula-live/ent/livesubscription"
 	"nebula-live/ent/predicate"
+	"nebula-live/ent/recurringpush"
 	"nebula-live/ent/rolepermission"
+	"nebula-live/ent/scheduledpush"
 	"nebula-live/ent/user"
 	"nebula-live/ent/userpushsetting"
 	"nebula-live/ent/userrole"
+	"nebula-live/ent/usersession"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -113,6 +117,66 @@ func (_u *UserUpdate) ClearAvatar() *UserUpdate {
 	return _u
 }
 
+// SetPushDefaultGroup sets the "push_default_group" field.
+func (_u *UserUpdate) SetPushDefaultGroup(v string) *UserUpdate {
+	_u.mutation.SetPushDefaultGroup(v)
+	return _u
+}
+
+// SetNillablePushDefaultGroup sets the "push_default_group" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePushDefaultGroup(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPushDefaultGroup(*v)
+	}
+	return _u
+}
+
+// ClearPushDefaultGroup clears the value of the "push_default_group" field.
+func (_u *UserUpdate) ClearPushDefaultGroup() *UserUpdate {
+	_u.mutation.ClearPushDefaultGroup()
+	return _u
+}
+
+// SetPushDefaultSound sets the "push_default_sound" field.
+func (_u *UserUpdate) SetPushDefaultSound(v string) *UserUpdate {
+	_u.mutation.SetPushDefaultSound(v)
+	return _u
+}
+
+// SetNillablePushDefaultSound sets the "push_default_sound" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePushDefaultSound(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPushDefaultSound(*v)
+	}
+	return _u
+}
+
+// ClearPushDefaultSound clears the value of the "push_default_sound" field.
+func (_u *UserUpdate) ClearPushDefaultSound() *UserUpdate {
+	_u.mutation.ClearPushDefaultSound()
+	return _u
+}
+
+// SetPushDefaultLevel sets the "push_default_level" field.
+func (_u *UserUpdate) SetPushDefaultLevel(v string) *UserUpdate {
+	_u.mutation.SetPushDefaultLevel(v)
+	return _u
+}
+
+// SetNillablePushDefaultLevel sets the "push_default_level" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePushDefaultLevel(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPushDefaultLevel(*v)
+	}
+	return _u
+}
+
+// ClearPushDefaultLevel clears the value of the "push_default_level" field.
+func (_u *UserUpdate) ClearPushDefaultLevel() *UserUpdate {
+	_u.mutation.ClearPushDefaultLevel()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *UserUpdate) SetStatus(v user.Status) *UserUpdate {
 	_u.mutation.SetStatus(v)
@@ -127,6 +191,66 @@ func (_u *UserUpdate) SetNillableStatus(v *user.Status) *UserUpdate {
 	return _u
 }
 
+// SetStatusReason sets the "status_reason" field.
+func (_u *UserUpdate) SetStatusReason(v string) *UserUpdate {
+	_u.mutation.SetStatusReason(v)
+	return _u
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusReason(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetStatusReason(*v)
+	}
+	return _u
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (_u *UserUpdate) ClearStatusReason() *UserUpdate {
+	_u.mutation.ClearStatusReason()
+	return _u
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (_u *UserUpdate) SetStatusChangedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetStatusChangedAt(v)
+	return _u
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusChangedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetStatusChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (_u *UserUpdate) ClearStatusChangedAt() *UserUpdate {
+	_u.mutation.ClearStatusChangedAt()
+	return _u
+}
+
+// SetBanExpiresAt sets the "ban_expires_at" field.
+func (_u *UserUpdate) SetBanExpiresAt(v time.Time) *UserUpdate {
+	_u.mutation.SetBanExpiresAt(v)
+	return _u
+}
+
+// SetNillableBanExpiresAt sets the "ban_expires_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableBanExpiresAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetBanExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearBanExpiresAt clears the value of the "ban_expires_at" field.
+func (_u *UserUpdate) ClearBanExpiresAt() *UserUpdate {
+	_u.mutation.ClearBanExpiresAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -193,6 +317,66 @@ func (_u *UserUpdate) AddPushSettings(v ...*UserPushSetting) *UserUpdate {
 	return _u.AddPushSettingIDs(ids...)
 }
 
+// AddScheduledPushIDs adds the "scheduled_pushes" edge to the ScheduledPush entity by IDs.
+func (_u *UserUpdate) AddScheduledPushIDs(ids ...uint) *UserUpdate {
+	_u.mutation.AddScheduledPushIDs(ids...)
+	return _u
+}
+
+// AddScheduledPushes adds the "scheduled_pushes" edges to the ScheduledPush entity.
+func (_u *UserUpdate) AddScheduledPushes(v ...*ScheduledPush) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledPushIDs(ids...)
+}
+
+// AddRecurringPushIDs adds the "recurring_pushes" edge to the RecurringPush entity by IDs.
+func (_u *UserUpdate) AddRecurringPushIDs(ids ...uint) *UserUpdate {
+	_u.mutation.AddRecurringPushIDs(ids...)
+	return _u
+}
+
+// AddRecurringPushes adds the "recurring_pushes" edges to the RecurringPush entity.
+func (_u *UserUpdate) AddRecurringPushes(v ...*RecurringPush) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecurringPushIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the UserSession entity by IDs.
+func (_u *UserUpdate) AddSessionIDs(ids ...uint) *UserUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the UserSession entity.
+func (_u *UserUpdate) AddSessions(v ...*UserSession) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddLiveSubscriptionIDs adds the "live_subscriptions" edge to the LiveSubscription entity by IDs.
+func (_u *UserUpdate) AddLiveSubscriptionIDs(ids ...uint) *UserUpdate {
+	_u.mutation.AddLiveSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddLiveSubscriptions adds the "live_subscriptions" edges to the LiveSubscription entity.
+func (_u *UserUpdate) AddLiveSubscriptions(v ...*LiveSubscription) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLiveSubscriptionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -282,6 +466,90 @@ func (_u *UserUpdate) RemovePushSettings(v ...*UserPushSetting) *UserUpdate {
 	return _u.RemovePushSettingIDs(ids...)
 }
 
+// ClearScheduledPushes clears all "scheduled_pushes" edges to the ScheduledPush entity.
+func (_u *UserUpdate) ClearScheduledPushes() *UserUpdate {
+	_u.mutation.ClearScheduledPushes()
+	return _u
+}
+
+// RemoveScheduledPushIDs removes the "scheduled_pushes" edge to ScheduledPush entities by IDs.
+func (_u *UserUpdate) RemoveScheduledPushIDs(ids ...uint) *UserUpdate {
+	_u.mutation.RemoveScheduledPushIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledPushes removes "scheduled_pushes" edges to ScheduledPush entities.
+func (_u *UserUpdate) RemoveScheduledPushes(v ...*ScheduledPush) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledPushIDs(ids...)
+}
+
+// ClearRecurringPushes clears all "recurring_pushes" edges to the RecurringPush entity.
+func (_u *UserUpdate) ClearRecurringPushes() *UserUpdate {
+	_u.mutation.ClearRecurringPushes()
+	return _u
+}
+
+// RemoveRecurringPushIDs removes the "recurring_pushes" edge to RecurringPush entities by IDs.
+func (_u *UserUpdate) RemoveRecurringPushIDs(ids ...uint) *UserUpdate {
+	_u.mutation.RemoveRecurringPushIDs(ids...)
+	return _u
+}
+
+// RemoveRecurringPushes removes "recurring_pushes" edges to RecurringPush entities.
+func (_u *UserUpdate) RemoveRecurringPushes(v ...*RecurringPush) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurringPushIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the UserSession entity.
+func (_u *UserUpdate) ClearSessions() *UserUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to UserSession entities by IDs.
+func (_u *UserUpdate) RemoveSessionIDs(ids ...uint) *UserUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to UserSession entities.
+func (_u *UserUpdate) RemoveSessions(v ...*UserSession) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearLiveSubscriptions clears all "live_subscriptions" edges to the LiveSubscription entity.
+func (_u *UserUpdate) ClearLiveSubscriptions() *UserUpdate {
+	_u.mutation.ClearLiveSubscriptions()
+	return _u
+}
+
+// RemoveLiveSubscriptionIDs removes the "live_subscriptions" edge to LiveSubscription entities by IDs.
+func (_u *UserUpdate) RemoveLiveSubscriptionIDs(ids ...uint) *UserUpdate {
+	_u.mutation.RemoveLiveSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveLiveSubscriptions removes "live_subscriptions" edges to LiveSubscription entities.
+func (_u *UserUpdate) RemoveLiveSubscriptions(v ...*LiveSubscription) *UserUpdate {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLiveSubscriptionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -345,11 +613,31 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "User.avatar": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PushDefaultGroup(); ok {
+		if err := user.PushDefaultGroupValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_group", err: fmt.Errorf(`ent: validator failed for field "User.push_default_group": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PushDefaultSound(); ok {
+		if err := user.PushDefaultSoundValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_sound", err: fmt.Errorf(`ent: validator failed for field "User.push_default_sound": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PushDefaultLevel(); ok {
+		if err := user.PushDefaultLevelValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_level", err: fmt.Errorf(`ent: validator failed for field "User.push_default_level": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := user.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StatusReason(); ok {
+		if err := user.StatusReasonValidator(v); err != nil {
+			return &ValidationError{Name: "status_reason", err: fmt.Errorf(`ent: validator failed for field "User.status_reason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -386,9 +674,45 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AvatarCleared() {
 		_spec.ClearField(user.FieldAvatar, field.TypeString)
 	}
+	if value, ok := _u.mutation.PushDefaultGroup(); ok {
+		_spec.SetField(user.FieldPushDefaultGroup, field.TypeString, value)
+	}
+	if _u.mutation.PushDefaultGroupCleared() {
+		_spec.ClearField(user.FieldPushDefaultGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.PushDefaultSound(); ok {
+		_spec.SetField(user.FieldPushDefaultSound, field.TypeString, value)
+	}
+	if _u.mutation.PushDefaultSoundCleared() {
+		_spec.ClearField(user.FieldPushDefaultSound, field.TypeString)
+	}
+	if value, ok := _u.mutation.PushDefaultLevel(); ok {
+		_spec.SetField(user.FieldPushDefaultLevel, field.TypeString, value)
+	}
+	if _u.mutation.PushDefaultLevelCleared() {
+		_spec.ClearField(user.FieldPushDefaultLevel, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+	}
+	if _u.mutation.StatusReasonCleared() {
+		_spec.ClearField(user.FieldStatusReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StatusChangedAtCleared() {
+		_spec.ClearField(user.FieldStatusChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BanExpiresAt(); ok {
+		_spec.SetField(user.FieldBanExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.BanExpiresAtCleared() {
+		_spec.ClearField(user.FieldBanExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -572,6 +896,186 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScheduledPushesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledPushesTable,
+			Columns: []string{user.ScheduledPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduledPushesIDs(); len(nodes) > 0 && !_u.mutation.ScheduledPushesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledPushesTable,
+			Columns: []string{user.ScheduledPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledPushesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledPushesTable,
+			Columns: []string{user.ScheduledPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurringPushesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecurringPushesTable,
+			Columns: []string{user.RecurringPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecurringPushesIDs(); len(nodes) > 0 && !_u.mutation.RecurringPushesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecurringPushesTable,
+			Columns: []string{user.RecurringPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurringPushesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecurringPushesTable,
+			Columns: []string{user.RecurringPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LiveSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LiveSubscriptionsTable,
+			Columns: []string{user.LiveSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLiveSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.LiveSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LiveSubscriptionsTable,
+			Columns: []string{user.LiveSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiveSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LiveSubscriptionsTable,
+			Columns: []string{user.LiveSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -654,40 +1158,160 @@ func (_u *UserUpdateOne) ClearNickname() *UserUpdateOne {
 	return _u
 }
 
-// SetAvatar sets the "avatar" field.
-func (_u *UserUpdateOne) SetAvatar(v string) *UserUpdateOne {
-	_u.mutation.SetAvatar(v)
+// SetAvatar sets the "avatar" field.
+func (_u *UserUpdateOne) SetAvatar(v string) *UserUpdateOne {
+	_u.mutation.SetAvatar(v)
+	return _u
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAvatar(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetAvatar(*v)
+	}
+	return _u
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (_u *UserUpdateOne) ClearAvatar() *UserUpdateOne {
+	_u.mutation.ClearAvatar()
+	return _u
+}
+
+// SetPushDefaultGroup sets the "push_default_group" field.
+func (_u *UserUpdateOne) SetPushDefaultGroup(v string) *UserUpdateOne {
+	_u.mutation.SetPushDefaultGroup(v)
+	return _u
+}
+
+// SetNillablePushDefaultGroup sets the "push_default_group" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePushDefaultGroup(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPushDefaultGroup(*v)
+	}
+	return _u
+}
+
+// ClearPushDefaultGroup clears the value of the "push_default_group" field.
+func (_u *UserUpdateOne) ClearPushDefaultGroup() *UserUpdateOne {
+	_u.mutation.ClearPushDefaultGroup()
+	return _u
+}
+
+// SetPushDefaultSound sets the "push_default_sound" field.
+func (_u *UserUpdateOne) SetPushDefaultSound(v string) *UserUpdateOne {
+	_u.mutation.SetPushDefaultSound(v)
+	return _u
+}
+
+// SetNillablePushDefaultSound sets the "push_default_sound" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePushDefaultSound(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPushDefaultSound(*v)
+	}
+	return _u
+}
+
+// ClearPushDefaultSound clears the value of the "push_default_sound" field.
+func (_u *UserUpdateOne) ClearPushDefaultSound() *UserUpdateOne {
+	_u.mutation.ClearPushDefaultSound()
+	return _u
+}
+
+// SetPushDefaultLevel sets the "push_default_level" field.
+func (_u *UserUpdateOne) SetPushDefaultLevel(v string) *UserUpdateOne {
+	_u.mutation.SetPushDefaultLevel(v)
+	return _u
+}
+
+// SetNillablePushDefaultLevel sets the "push_default_level" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePushDefaultLevel(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPushDefaultLevel(*v)
+	}
+	return _u
+}
+
+// ClearPushDefaultLevel clears the value of the "push_default_level" field.
+func (_u *UserUpdateOne) ClearPushDefaultLevel() *UserUpdateOne {
+	_u.mutation.ClearPushDefaultLevel()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserUpdateOne) SetStatus(v user.Status) *UserUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatus(v *user.Status) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_u *UserUpdateOne) SetStatusReason(v string) *UserUpdateOne {
+	_u.mutation.SetStatusReason(v)
+	return _u
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusReason(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusReason(*v)
+	}
+	return _u
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (_u *UserUpdateOne) ClearStatusReason() *UserUpdateOne {
+	_u.mutation.ClearStatusReason()
+	return _u
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (_u *UserUpdateOne) SetStatusChangedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetStatusChangedAt(v)
 	return _u
 }
 
-// SetNillableAvatar sets the "avatar" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableAvatar(v *string) *UserUpdateOne {
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusChangedAt(v *time.Time) *UserUpdateOne {
 	if v != nil {
-		_u.SetAvatar(*v)
+		_u.SetStatusChangedAt(*v)
 	}
 	return _u
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (_u *UserUpdateOne) ClearAvatar() *UserUpdateOne {
-	_u.mutation.ClearAvatar()
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (_u *UserUpdateOne) ClearStatusChangedAt() *UserUpdateOne {
+	_u.mutation.ClearStatusChangedAt()
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *UserUpdateOne) SetStatus(v user.Status) *UserUpdateOne {
-	_u.mutation.SetStatus(v)
+// SetBanExpiresAt sets the "ban_expires_at" field.
+func (_u *UserUpdateOne) SetBanExpiresAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetBanExpiresAt(v)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableStatus(v *user.Status) *UserUpdateOne {
+// SetNillableBanExpiresAt sets the "ban_expires_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableBanExpiresAt(v *time.Time) *UserUpdateOne {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetBanExpiresAt(*v)
 	}
 	return _u
 }
 
+// ClearBanExpiresAt clears the value of the "ban_expires_at" field.
+func (_u *UserUpdateOne) ClearBanExpiresAt() *UserUpdateOne {
+	_u.mutation.ClearBanExpiresAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -754,6 +1378,66 @@ func (_u *UserUpdateOne) AddPushSettings(v ...*UserPushSetting) *UserUpdateOne {
 	return _u.AddPushSettingIDs(ids...)
 }
 
+// AddScheduledPushIDs adds the "scheduled_pushes" edge to the ScheduledPush entity by IDs.
+func (_u *UserUpdateOne) AddScheduledPushIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.AddScheduledPushIDs(ids...)
+	return _u
+}
+
+// AddScheduledPushes adds the "scheduled_pushes" edges to the ScheduledPush entity.
+func (_u *UserUpdateOne) AddScheduledPushes(v ...*ScheduledPush) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledPushIDs(ids...)
+}
+
+// AddRecurringPushIDs adds the "recurring_pushes" edge to the RecurringPush entity by IDs.
+func (_u *UserUpdateOne) AddRecurringPushIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.AddRecurringPushIDs(ids...)
+	return _u
+}
+
+// AddRecurringPushes adds the "recurring_pushes" edges to the RecurringPush entity.
+func (_u *UserUpdateOne) AddRecurringPushes(v ...*RecurringPush) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecurringPushIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the UserSession entity by IDs.
+func (_u *UserUpdateOne) AddSessionIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the UserSession entity.
+func (_u *UserUpdateOne) AddSessions(v ...*UserSession) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddLiveSubscriptionIDs adds the "live_subscriptions" edge to the LiveSubscription entity by IDs.
+func (_u *UserUpdateOne) AddLiveSubscriptionIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.AddLiveSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddLiveSubscriptions adds the "live_subscriptions" edges to the LiveSubscription entity.
+func (_u *UserUpdateOne) AddLiveSubscriptions(v ...*LiveSubscription) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLiveSubscriptionIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -843,6 +1527,90 @@ func (_u *UserUpdateOne) RemovePushSettings(v ...*UserPushSetting) *UserUpdateOn
 	return _u.RemovePushSettingIDs(ids...)
 }
 
+// ClearScheduledPushes clears all "scheduled_pushes" edges to the ScheduledPush entity.
+func (_u *UserUpdateOne) ClearScheduledPushes() *UserUpdateOne {
+	_u.mutation.ClearScheduledPushes()
+	return _u
+}
+
+// RemoveScheduledPushIDs removes the "scheduled_pushes" edge to ScheduledPush entities by IDs.
+func (_u *UserUpdateOne) RemoveScheduledPushIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.RemoveScheduledPushIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledPushes removes "scheduled_pushes" edges to ScheduledPush entities.
+func (_u *UserUpdateOne) RemoveScheduledPushes(v ...*ScheduledPush) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledPushIDs(ids...)
+}
+
+// ClearRecurringPushes clears all "recurring_pushes" edges to the RecurringPush entity.
+func (_u *UserUpdateOne) ClearRecurringPushes() *UserUpdateOne {
+	_u.mutation.ClearRecurringPushes()
+	return _u
+}
+
+// RemoveRecurringPushIDs removes the "recurring_pushes" edge to RecurringPush entities by IDs.
+func (_u *UserUpdateOne) RemoveRecurringPushIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.RemoveRecurringPushIDs(ids...)
+	return _u
+}
+
+// RemoveRecurringPushes removes "recurring_pushes" edges to RecurringPush entities.
+func (_u *UserUpdateOne) RemoveRecurringPushes(v ...*RecurringPush) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurringPushIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the UserSession entity.
+func (_u *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to UserSession entities by IDs.
+func (_u *UserUpdateOne) RemoveSessionIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to UserSession entities.
+func (_u *UserUpdateOne) RemoveSessions(v ...*UserSession) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearLiveSubscriptions clears all "live_subscriptions" edges to the LiveSubscription entity.
+func (_u *UserUpdateOne) ClearLiveSubscriptions() *UserUpdateOne {
+	_u.mutation.ClearLiveSubscriptions()
+	return _u
+}
+
+// RemoveLiveSubscriptionIDs removes the "live_subscriptions" edge to LiveSubscription entities by IDs.
+func (_u *UserUpdateOne) RemoveLiveSubscriptionIDs(ids ...uint) *UserUpdateOne {
+	_u.mutation.RemoveLiveSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveLiveSubscriptions removes "live_subscriptions" edges to LiveSubscription entities.
+func (_u *UserUpdateOne) RemoveLiveSubscriptions(v ...*LiveSubscription) *UserUpdateOne {
+	ids := make([]uint, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLiveSubscriptionIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -919,11 +1687,31 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "User.avatar": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PushDefaultGroup(); ok {
+		if err := user.PushDefaultGroupValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_group", err: fmt.Errorf(`ent: validator failed for field "User.push_default_group": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PushDefaultSound(); ok {
+		if err := user.PushDefaultSoundValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_sound", err: fmt.Errorf(`ent: validator failed for field "User.push_default_sound": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PushDefaultLevel(); ok {
+		if err := user.PushDefaultLevelValidator(v); err != nil {
+			return &ValidationError{Name: "push_default_level", err: fmt.Errorf(`ent: validator failed for field "User.push_default_level": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := user.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StatusReason(); ok {
+		if err := user.StatusReasonValidator(v); err != nil {
+			return &ValidationError{Name: "status_reason", err: fmt.Errorf(`ent: validator failed for field "User.status_reason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -977,9 +1765,45 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.AvatarCleared() {
 		_spec.ClearField(user.FieldAvatar, field.TypeString)
 	}
+	if value, ok := _u.mutation.PushDefaultGroup(); ok {
+		_spec.SetField(user.FieldPushDefaultGroup, field.TypeString, value)
+	}
+	if _u.mutation.PushDefaultGroupCleared() {
+		_spec.ClearField(user.FieldPushDefaultGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.PushDefaultSound(); ok {
+		_spec.SetField(user.FieldPushDefaultSound, field.TypeString, value)
+	}
+	if _u.mutation.PushDefaultSoundCleared() {
+		_spec.ClearField(user.FieldPushDefaultSound, field.TypeString)
+	}
+	if value, ok := _u.mutation.PushDefaultLevel(); ok {
+		_spec.SetField(user.FieldPushDefaultLevel, field.TypeString, value)
+	}
+	if _u.mutation.PushDefaultLevelCleared() {
+		_spec.ClearField(user.FieldPushDefaultLevel, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+	}
+	if _u.mutation.StatusReasonCleared() {
+		_spec.ClearField(user.FieldStatusReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StatusChangedAtCleared() {
+		_spec.ClearField(user.FieldStatusChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BanExpiresAt(); ok {
+		_spec.SetField(user.FieldBanExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.BanExpiresAtCleared() {
+		_spec.ClearField(user.FieldBanExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1163,6 +1987,186 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScheduledPushesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledPushesTable,
+			Columns: []string{user.ScheduledPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduledPushesIDs(); len(nodes) > 0 && !_u.mutation.ScheduledPushesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledPushesTable,
+			Columns: []string{user.ScheduledPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledPushesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ScheduledPushesTable,
+			Columns: []string{user.ScheduledPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurringPushesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecurringPushesTable,
+			Columns: []string{user.RecurringPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecurringPushesIDs(); len(nodes) > 0 && !_u.mutation.RecurringPushesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecurringPushesTable,
+			Columns: []string{user.RecurringPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurringPushesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecurringPushesTable,
+			Columns: []string{user.RecurringPushesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringpush.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LiveSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LiveSubscriptionsTable,
+			Columns: []string{user.LiveSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLiveSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.LiveSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LiveSubscriptionsTable,
+			Columns: []string{user.LiveSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiveSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LiveSubscriptionsTable,
+			Columns: []string{user.LiveSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livesubscription.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
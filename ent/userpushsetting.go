@@ -25,12 +25,16 @@ type UserPushSetting struct {
 	Provider userpushsetting.Provider `json:"provider,omitempty"`
 	// 是否启用此推送设置
 	Enabled bool `json:"enabled,omitempty"`
-	// 设备ID或推送标识符
+	// 设备ID或推送标识符，邮件提供商为收件人邮箱地址
 	DeviceID string `json:"device_id,omitempty"`
 	// 设备名称，用于用户识别
 	DeviceName string `json:"device_name,omitempty"`
 	// 提供商特定的设置，JSON格式存储
 	Settings map[string]interface{} `json:"settings,omitempty"`
+	// 连续推送失败次数，推送成功后清零
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// 最近一次推送失败时间
+	LastFailureAt *time.Time `json:"last_failure_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -70,11 +74,11 @@ func (*UserPushSetting) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case userpushsetting.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case userpushsetting.FieldID, userpushsetting.FieldUserID:
+		case userpushsetting.FieldID, userpushsetting.FieldUserID, userpushsetting.FieldConsecutiveFailures:
 			values[i] = new(sql.NullInt64)
 		case userpushsetting.FieldProvider, userpushsetting.FieldDeviceID, userpushsetting.FieldDeviceName:
 			values[i] = new(sql.NullString)
-		case userpushsetting.FieldCreatedAt, userpushsetting.FieldUpdatedAt:
+		case userpushsetting.FieldLastFailureAt, userpushsetting.FieldCreatedAt, userpushsetting.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -135,6 +139,19 @@ func (_m *UserPushSetting) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field settings: %w", err)
 				}
 			}
+		case userpushsetting.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				_m.ConsecutiveFailures = int(value.Int64)
+			}
+		case userpushsetting.FieldLastFailureAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failure_at", values[i])
+			} else if value.Valid {
+				_m.LastFailureAt = new(time.Time)
+				*_m.LastFailureAt = value.Time
+			}
 		case userpushsetting.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -206,6 +223,14 @@ func (_m *UserPushSetting) String() string {
 	builder.WriteString("settings=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Settings))
 	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	if v := _m.LastFailureAt; v != nil {
+		builder.WriteString("last_failure_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
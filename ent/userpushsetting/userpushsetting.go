@@ -27,6 +27,10 @@ const (
 	FieldDeviceName = "device_name"
 	// FieldSettings holds the string denoting the settings field in the database.
 	FieldSettings = "settings"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldLastFailureAt holds the string denoting the last_failure_at field in the database.
+	FieldLastFailureAt = "last_failure_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -53,6 +57,8 @@ var Columns = []string{
 	FieldDeviceID,
 	FieldDeviceName,
 	FieldSettings,
+	FieldConsecutiveFailures,
+	FieldLastFailureAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -74,6 +80,10 @@ var (
 	DeviceIDValidator func(string) error
 	// DeviceNameValidator is a validator for the "device_name" field. It is called by the builders before save.
 	DeviceNameValidator func(string) error
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int
+	// ConsecutiveFailuresValidator is a validator for the "consecutive_failures" field. It is called by the builders before save.
+	ConsecutiveFailuresValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -87,7 +97,8 @@ type Provider string
 
 // Provider values.
 const (
-	ProviderBark Provider = "bark"
+	ProviderBark  Provider = "bark"
+	ProviderEmail Provider = "email"
 )
 
 func (pr Provider) String() string {
@@ -97,7 +108,7 @@ func (pr Provider) String() string {
 // ProviderValidator is a validator for the "provider" field enum values. It is called by the builders before save.
 func ProviderValidator(pr Provider) error {
 	switch pr {
-	case ProviderBark:
+	case ProviderBark, ProviderEmail:
 		return nil
 	default:
 		return fmt.Errorf("userpushsetting: invalid enum value for provider field: %q", pr)
@@ -137,6 +148,16 @@ func ByDeviceName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeviceName, opts...).ToFunc()
 }
 
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByLastFailureAt orders the results by the last_failure_at field.
+func ByLastFailureAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFailureAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -75,6 +75,16 @@ func DeviceName(v string) predicate.UserPushSetting {
 	return predicate.UserPushSetting(sql.FieldEQ(FieldDeviceName, v))
 }
 
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// LastFailureAt applies equality check predicate on the "last_failure_at" field. It's identical to LastFailureAtEQ.
+func LastFailureAt(v time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldEQ(FieldLastFailureAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.UserPushSetting {
 	return predicate.UserPushSetting(sql.FieldEQ(FieldCreatedAt, v))
@@ -285,6 +295,96 @@ func SettingsNotNil() predicate.UserPushSetting {
 	return predicate.UserPushSetting(sql.FieldNotNull(FieldSettings))
 }
 
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// LastFailureAtEQ applies the EQ predicate on the "last_failure_at" field.
+func LastFailureAtEQ(v time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldEQ(FieldLastFailureAt, v))
+}
+
+// LastFailureAtNEQ applies the NEQ predicate on the "last_failure_at" field.
+func LastFailureAtNEQ(v time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldNEQ(FieldLastFailureAt, v))
+}
+
+// LastFailureAtIn applies the In predicate on the "last_failure_at" field.
+func LastFailureAtIn(vs ...time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldIn(FieldLastFailureAt, vs...))
+}
+
+// LastFailureAtNotIn applies the NotIn predicate on the "last_failure_at" field.
+func LastFailureAtNotIn(vs ...time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldNotIn(FieldLastFailureAt, vs...))
+}
+
+// LastFailureAtGT applies the GT predicate on the "last_failure_at" field.
+func LastFailureAtGT(v time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldGT(FieldLastFailureAt, v))
+}
+
+// LastFailureAtGTE applies the GTE predicate on the "last_failure_at" field.
+func LastFailureAtGTE(v time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldGTE(FieldLastFailureAt, v))
+}
+
+// LastFailureAtLT applies the LT predicate on the "last_failure_at" field.
+func LastFailureAtLT(v time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldLT(FieldLastFailureAt, v))
+}
+
+// LastFailureAtLTE applies the LTE predicate on the "last_failure_at" field.
+func LastFailureAtLTE(v time.Time) predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldLTE(FieldLastFailureAt, v))
+}
+
+// LastFailureAtIsNil applies the IsNil predicate on the "last_failure_at" field.
+func LastFailureAtIsNil() predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldIsNull(FieldLastFailureAt))
+}
+
+// LastFailureAtNotNil applies the NotNil predicate on the "last_failure_at" field.
+func LastFailureAtNotNil() predicate.UserPushSetting {
+	return predicate.UserPushSetting(sql.FieldNotNull(FieldLastFailureAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserPushSetting {
 	return predicate.UserPushSetting(sql.FieldEQ(FieldCreatedAt, v))
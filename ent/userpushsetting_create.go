@@ -73,6 +73,34 @@ func (_c *UserPushSettingCreate) SetSettings(v map[string]interface{}) *UserPush
 	return _c
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_c *UserPushSettingCreate) SetConsecutiveFailures(v int) *UserPushSettingCreate {
+	_c.mutation.SetConsecutiveFailures(v)
+	return _c
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_c *UserPushSettingCreate) SetNillableConsecutiveFailures(v *int) *UserPushSettingCreate {
+	if v != nil {
+		_c.SetConsecutiveFailures(*v)
+	}
+	return _c
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (_c *UserPushSettingCreate) SetLastFailureAt(v time.Time) *UserPushSettingCreate {
+	_c.mutation.SetLastFailureAt(v)
+	return _c
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (_c *UserPushSettingCreate) SetNillableLastFailureAt(v *time.Time) *UserPushSettingCreate {
+	if v != nil {
+		_c.SetLastFailureAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserPushSettingCreate) SetCreatedAt(v time.Time) *UserPushSettingCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -151,6 +179,10 @@ func (_c *UserPushSettingCreate) defaults() {
 		v := userpushsetting.DefaultEnabled
 		_c.mutation.SetEnabled(v)
 	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		v := userpushsetting.DefaultConsecutiveFailures
+		_c.mutation.SetConsecutiveFailures(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := userpushsetting.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -190,6 +222,14 @@ func (_c *UserPushSettingCreate) check() error {
 			return &ValidationError{Name: "device_name", err: fmt.Errorf(`ent: validator failed for field "UserPushSetting.device_name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "UserPushSetting.consecutive_failures"`)}
+	}
+	if v, ok := _c.mutation.ConsecutiveFailures(); ok {
+		if err := userpushsetting.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "UserPushSetting.consecutive_failures": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserPushSetting.created_at"`)}
 	}
@@ -251,6 +291,14 @@ func (_c *UserPushSettingCreate) createSpec() (*UserPushSetting, *sqlgraph.Creat
 		_spec.SetField(userpushsetting.FieldSettings, field.TypeJSON, value)
 		_node.Settings = value
 	}
+	if value, ok := _c.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(userpushsetting.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := _c.mutation.LastFailureAt(); ok {
+		_spec.SetField(userpushsetting.FieldLastFailureAt, field.TypeTime, value)
+		_node.LastFailureAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(userpushsetting.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
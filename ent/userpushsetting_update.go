@@ -117,6 +117,47 @@ func (_u *UserPushSettingUpdate) ClearSettings() *UserPushSettingUpdate {
 	return _u
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *UserPushSettingUpdate) SetConsecutiveFailures(v int) *UserPushSettingUpdate {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *UserPushSettingUpdate) SetNillableConsecutiveFailures(v *int) *UserPushSettingUpdate {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *UserPushSettingUpdate) AddConsecutiveFailures(v int) *UserPushSettingUpdate {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (_u *UserPushSettingUpdate) SetLastFailureAt(v time.Time) *UserPushSettingUpdate {
+	_u.mutation.SetLastFailureAt(v)
+	return _u
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (_u *UserPushSettingUpdate) SetNillableLastFailureAt(v *time.Time) *UserPushSettingUpdate {
+	if v != nil {
+		_u.SetLastFailureAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFailureAt clears the value of the "last_failure_at" field.
+func (_u *UserPushSettingUpdate) ClearLastFailureAt() *UserPushSettingUpdate {
+	_u.mutation.ClearLastFailureAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserPushSettingUpdate) SetUpdatedAt(v time.Time) *UserPushSettingUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -192,6 +233,11 @@ func (_u *UserPushSettingUpdate) check() error {
 			return &ValidationError{Name: "device_name", err: fmt.Errorf(`ent: validator failed for field "UserPushSetting.device_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := userpushsetting.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "UserPushSetting.consecutive_failures": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "UserPushSetting.user"`)
 	}
@@ -231,6 +277,18 @@ func (_u *UserPushSettingUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.SettingsCleared() {
 		_spec.ClearField(userpushsetting.FieldSettings, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(userpushsetting.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(userpushsetting.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFailureAt(); ok {
+		_spec.SetField(userpushsetting.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFailureAtCleared() {
+		_spec.ClearField(userpushsetting.FieldLastFailureAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(userpushsetting.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -371,6 +429,47 @@ func (_u *UserPushSettingUpdateOne) ClearSettings() *UserPushSettingUpdateOne {
 	return _u
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (_u *UserPushSettingUpdateOne) SetConsecutiveFailures(v int) *UserPushSettingUpdateOne {
+	_u.mutation.ResetConsecutiveFailures()
+	_u.mutation.SetConsecutiveFailures(v)
+	return _u
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (_u *UserPushSettingUpdateOne) SetNillableConsecutiveFailures(v *int) *UserPushSettingUpdateOne {
+	if v != nil {
+		_u.SetConsecutiveFailures(*v)
+	}
+	return _u
+}
+
+// AddConsecutiveFailures adds value to the "consecutive_failures" field.
+func (_u *UserPushSettingUpdateOne) AddConsecutiveFailures(v int) *UserPushSettingUpdateOne {
+	_u.mutation.AddConsecutiveFailures(v)
+	return _u
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (_u *UserPushSettingUpdateOne) SetLastFailureAt(v time.Time) *UserPushSettingUpdateOne {
+	_u.mutation.SetLastFailureAt(v)
+	return _u
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (_u *UserPushSettingUpdateOne) SetNillableLastFailureAt(v *time.Time) *UserPushSettingUpdateOne {
+	if v != nil {
+		_u.SetLastFailureAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFailureAt clears the value of the "last_failure_at" field.
+func (_u *UserPushSettingUpdateOne) ClearLastFailureAt() *UserPushSettingUpdateOne {
+	_u.mutation.ClearLastFailureAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserPushSettingUpdateOne) SetUpdatedAt(v time.Time) *UserPushSettingUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -459,6 +558,11 @@ func (_u *UserPushSettingUpdateOne) check() error {
 			return &ValidationError{Name: "device_name", err: fmt.Errorf(`ent: validator failed for field "UserPushSetting.device_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ConsecutiveFailures(); ok {
+		if err := userpushsetting.ConsecutiveFailuresValidator(v); err != nil {
+			return &ValidationError{Name: "consecutive_failures", err: fmt.Errorf(`ent: validator failed for field "UserPushSetting.consecutive_failures": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "UserPushSetting.user"`)
 	}
@@ -515,6 +619,18 @@ func (_u *UserPushSettingUpdateOne) sqlSave(ctx context.Context) (_node *UserPus
 	if _u.mutation.SettingsCleared() {
 		_spec.ClearField(userpushsetting.FieldSettings, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(userpushsetting.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(userpushsetting.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFailureAt(); ok {
+		_spec.SetField(userpushsetting.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFailureAtCleared() {
+		_spec.ClearField(userpushsetting.FieldLastFailureAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(userpushsetting.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -27,6 +27,8 @@ type UserRole struct {
 	AssignedBy uint `json:"assigned_by,omitempty"`
 	// 分配时间
 	AssignedAt time.Time `json:"assigned_at,omitempty"`
+	// 过期时间，为空表示永不过期
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserRoleQuery when eager-loading is set.
 	Edges        UserRoleEdges `json:"edges"`
@@ -86,7 +88,7 @@ func (*UserRole) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case userrole.FieldID, userrole.FieldUserID, userrole.FieldRoleID, userrole.FieldAssignedBy:
 			values[i] = new(sql.NullInt64)
-		case userrole.FieldAssignedAt:
+		case userrole.FieldAssignedAt, userrole.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -133,6 +135,13 @@ func (_m *UserRole) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AssignedAt = value.Time
 			}
+		case userrole.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -195,6 +204,11 @@ func (_m *UserRole) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("assigned_at=")
 	builder.WriteString(_m.AssignedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nebula-live/ent/predicate"
+	"nebula-live/ent/webhookdelivery"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (_u *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeliveryID sets the "delivery_id" field.
+func (_u *WebhookDeliveryUpdate) SetDeliveryID(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetDeliveryID(v)
+	return _u
+}
+
+// SetNillableDeliveryID sets the "delivery_id" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableDeliveryID(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetDeliveryID(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *WebhookDeliveryUpdate) SetEventID(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableEventID(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *WebhookDeliveryUpdate) SetEvent(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableEvent(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *WebhookDeliveryUpdate) SetURL(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableURL(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *WebhookDeliveryUpdate) SetAttempt(v int) *WebhookDeliveryUpdate {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableAttempt(v *int) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetAttempt(*v)
+	}
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *WebhookDeliveryUpdate) AddAttempt(v int) *WebhookDeliveryUpdate {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *WebhookDeliveryUpdate) SetStatusCode(v int) *WebhookDeliveryUpdate {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableStatusCode(v *int) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *WebhookDeliveryUpdate) AddStatusCode(v int) *WebhookDeliveryUpdate {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (_u *WebhookDeliveryUpdate) ClearStatusCode() *WebhookDeliveryUpdate {
+	_u.mutation.ClearStatusCode()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *WebhookDeliveryUpdate) SetSuccess(v bool) *WebhookDeliveryUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableSuccess(v *bool) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *WebhookDeliveryUpdate) SetError(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableError(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *WebhookDeliveryUpdate) ClearError() *WebhookDeliveryUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *WebhookDeliveryUpdate) SetDurationMs(v int64) *WebhookDeliveryUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableDurationMs(v *int64) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *WebhookDeliveryUpdate) AddDurationMs(v int64) *WebhookDeliveryUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *WebhookDeliveryUpdate) ClearDurationMs() *WebhookDeliveryUpdate {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (_u *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.DeliveryID(); ok {
+		if err := webhookdelivery.DeliveryIDValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.delivery_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := webhookdelivery.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := webhookdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := webhookdelivery.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempt(); ok {
+		if err := webhookdelivery.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.attempt": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := webhookdelivery.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUint))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeliveryID(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveryID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(webhookdelivery.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(webhookdelivery.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(webhookdelivery.FieldDurationMs, field.TypeInt64)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetDeliveryID sets the "delivery_id" field.
+func (_u *WebhookDeliveryUpdateOne) SetDeliveryID(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetDeliveryID(v)
+	return _u
+}
+
+// SetNillableDeliveryID sets the "delivery_id" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableDeliveryID(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDeliveryID(*v)
+	}
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *WebhookDeliveryUpdateOne) SetEventID(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableEventID(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *WebhookDeliveryUpdateOne) SetEvent(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableEvent(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *WebhookDeliveryUpdateOne) SetURL(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableURL(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *WebhookDeliveryUpdateOne) SetAttempt(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableAttempt(v *int) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempt(*v)
+	}
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *WebhookDeliveryUpdateOne) AddAttempt(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *WebhookDeliveryUpdateOne) SetStatusCode(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableStatusCode(v *int) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *WebhookDeliveryUpdateOne) AddStatusCode(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (_u *WebhookDeliveryUpdateOne) ClearStatusCode() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearStatusCode()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *WebhookDeliveryUpdateOne) SetSuccess(v bool) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableSuccess(v *bool) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *WebhookDeliveryUpdateOne) SetError(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableError(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *WebhookDeliveryUpdateOne) ClearError() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *WebhookDeliveryUpdateOne) SetDurationMs(v int64) *WebhookDeliveryUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableDurationMs(v *int64) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *WebhookDeliveryUpdateOne) AddDurationMs(v int64) *WebhookDeliveryUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *WebhookDeliveryUpdateOne) ClearDurationMs() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (_u *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (_u *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (_u *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.DeliveryID(); ok {
+		if err := webhookdelivery.DeliveryIDValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.delivery_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := webhookdelivery.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := webhookdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := webhookdelivery.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempt(); ok {
+		if err := webhookdelivery.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.attempt": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := webhookdelivery.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUint))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeliveryID(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveryID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(webhookdelivery.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(webhookdelivery.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(webhookdelivery.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(webhookdelivery.FieldDurationMs, field.TypeInt64)
+	}
+	_node = &WebhookDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
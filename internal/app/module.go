@@ -6,5 +6,6 @@ import "go.uber.org/fx"
 var AppModule = fx.Options(
 	fx.Provide(
 		NewFiberApp,
+		NewReadiness,
 	),
 )
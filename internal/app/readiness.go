@@ -0,0 +1,49 @@
+package app
+
+import (
+	"sync/atomic"
+
+	"nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Readiness 服务就绪状态，数据库迁移和系统数据初始化完成后标记为就绪
+type Readiness struct {
+	ready atomic.Bool
+}
+
+// NewReadiness 创建未就绪的就绪状态
+func NewReadiness() *Readiness {
+	return &Readiness{}
+}
+
+// MarkReady 标记服务已就绪
+func (r *Readiness) MarkReady() {
+	r.ready.Store(true)
+}
+
+// IsReady 服务是否已就绪
+func (r *Readiness) IsReady() bool {
+	return r.ready.Load()
+}
+
+// Handler /readyz 处理器，就绪时返回200，否则返回503
+func (r *Readiness) Handler(c *fiber.Ctx) error {
+	if !r.IsReady() {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"status": "not_ready"})
+	}
+	return c.JSON(fiber.Map{"status": "ready"})
+}
+
+// Gate 业务接口的就绪检查中间件，未就绪时返回503，避免请求访问尚未迁移的数据库
+func (r *Readiness) Gate() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !r.IsReady() {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(
+				errors.NewAPIError(fiber.StatusServiceUnavailable, "Service unavailable", "Service is starting"),
+			)
+		}
+		return c.Next()
+	}
+}
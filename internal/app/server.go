@@ -3,19 +3,17 @@ package app
 import (
 	"fmt"
 
+	"nebula-live/docs"
 	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web"
 	"nebula-live/internal/infrastructure/web/middleware"
 	"nebula-live/internal/infrastructure/web/router"
-	"nebula-live/pkg/errors"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
 	fiberSwagger "github.com/swaggo/fiber-swagger"
 	"go.uber.org/zap"
-
-	_ "nebula-live/docs" // swagger docs
 )
 
 type Server struct {
@@ -24,26 +22,14 @@ type Server struct {
 	logger *zap.Logger
 }
 
-func NewFiberApp(cfg *config.Config, log *zap.Logger, routerRegistry *router.RouterRegistry) *Server {
+func NewFiberApp(cfg *config.Config, log *zap.Logger, routerRegistry *router.RouterRegistry, readiness *Readiness) (*Server, error) {
+	corsHandler, err := middleware.NewCORS(cfg.CORS, routerRegistry.BasePath())
+	if err != nil {
+		return nil, err
+	}
+
 	app := fiber.New(fiber.Config{
-		ErrorHandler: func(c *fiber.Ctx, err error) error {
-			code := fiber.StatusInternalServerError
-			message := "Internal server error"
-
-			if e, ok := err.(*fiber.Error); ok {
-				code = e.Code
-				message = e.Message
-			}
-
-			log.Error("Request failed",
-				zap.String("method", c.Method()),
-				zap.String("path", c.Path()),
-				zap.Int("status", code),
-				zap.Error(err),
-			)
-
-			return c.Status(code).JSON(errors.NewAPIError(code, "Request failed", message))
-		},
+		ErrorHandler: web.NewErrorHandler(log),
 	})
 
 	// 全局中间件
@@ -51,15 +37,17 @@ func NewFiberApp(cfg *config.Config, log *zap.Logger, routerRegistry *router.Rou
 	app.Use(requestid.New())
 	app.Use(middleware.ZapLogger(log))
 
-	// CORS 配置
-	app.Use(cors.New(cors.Config{
-		AllowOrigins:     joinStrings(cfg.CORS.AllowedOrigins),
-		AllowMethods:     joinStrings(cfg.CORS.AllowedMethods),
-		AllowHeaders:     joinStrings(cfg.CORS.AllowedHeaders),
-		ExposeHeaders:    joinStrings(cfg.CORS.ExposedHeaders),
-		AllowCredentials: cfg.CORS.AllowCredentials,
-		MaxAge:           cfg.CORS.MaxAge,
-	}))
+	// CORS 配置（支持按路径前缀覆盖）
+	app.Use(corsHandler)
+
+	// 响应压缩
+	app.Use(middleware.NewCompression(cfg.Server.Compression))
+
+	// 响应信封，需要在超时中间件外层以包装超时响应
+	app.Use(middleware.NewEnvelope(cfg.Server))
+
+	// 请求超时，超时的请求取消下游调用并返回504
+	app.Use(middleware.NewTimeout(cfg.Server))
 
 	// 健康检查
 	app.Get("/health", func(c *fiber.Ctx) error {
@@ -70,7 +58,14 @@ func NewFiberApp(cfg *config.Config, log *zap.Logger, routerRegistry *router.Rou
 		})
 	})
 
-	// Swagger API 文档
+	// 就绪检查，数据库迁移和系统数据初始化完成前返回503
+	app.Get("/readyz", readiness.Handler)
+
+	// Swagger API 文档，基础路径与配置的API前缀保持一致
+	docs.SwaggerInfo.BasePath = routerRegistry.BasePath()
+	if docs.SwaggerInfo.BasePath == "" {
+		docs.SwaggerInfo.BasePath = "/"
+	}
 	app.Get("/swagger", func(c *fiber.Ctx) error {
 		return c.Redirect("/swagger/index.html", fiber.StatusMovedPermanently)
 	})
@@ -79,6 +74,13 @@ func NewFiberApp(cfg *config.Config, log *zap.Logger, routerRegistry *router.Rou
 	})
 	app.Get("/swagger/*", fiberSwagger.WrapHandler)
 
+	// 未就绪时业务接口返回503，只有先监听端口再初始化时才会出现
+	if base := routerRegistry.BasePath(); base != "" {
+		app.Use(base, readiness.Gate())
+	} else {
+		app.Use(readiness.Gate())
+	}
+
 	// 设置路由
 	routerRegistry.RegisterAllRoutes(app)
 
@@ -86,7 +88,7 @@ func NewFiberApp(cfg *config.Config, log *zap.Logger, routerRegistry *router.Rou
 		app:    app,
 		config: cfg,
 		logger: log,
-	}
+	}, nil
 }
 
 func (s *Server) Start() error {
@@ -99,14 +101,3 @@ func (s *Server) Stop() error {
 	s.logger.Info("Server stopping")
 	return s.app.Shutdown()
 }
-
-func joinStrings(slice []string) string {
-	if len(slice) == 0 {
-		return ""
-	}
-	result := slice[0]
-	for i := 1; i < len(slice); i++ {
-		result += "," + slice[i]
-	}
-	return result
-}
@@ -0,0 +1,107 @@
+package app_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"nebula-live/internal/app"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/infrastructure/web/router"
+	"nebula-live/internal/testutil"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// pingRouter 注册一个返回200的业务接口
+type pingRouter struct{}
+
+func (pingRouter) RegisterRoutes(r fiber.Router) {
+	r.Get("/ping", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+}
+
+func (pingRouter) GetPrefix() string {
+	return ""
+}
+
+// freePort 返回一个当前未被占用的本地端口
+func freePort(t *testing.T) int {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// 先监听端口再迁移时，迁移完成前/readyz和业务接口返回503，完成后返回200
+func TestServer_ReadyzWaitsForMigrations(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Server.Host = "127.0.0.1"
+	cfg.Server.Port = freePort(t)
+	cfg.Server.API = config.APIConfig{Prefix: "/api", Version: "v1"}
+	registry := router.NewRouterRegistry(router.RouterRegistryParams{Config: cfg, Routers: []router.Router{pingRouter{}}})
+
+	readiness := app.NewReadiness()
+	server, err := app.NewFiberApp(cfg, zap.NewNop(), registry, readiness)
+	if err != nil {
+		t.Fatalf("NewFiberApp() error = %v", err)
+	}
+	go server.Start()
+	t.Cleanup(func() { server.Stop() })
+
+	baseURL := fmt.Sprintf("http://127.0.0.1:%d", cfg.Server.Port)
+	status := func(path string) int {
+		t.Helper()
+		resp, err := http.Get(baseURL + path)
+		if err != nil {
+			return 0
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for status("/health") != http.StatusOK {
+		if time.Now().After(deadline) {
+			t.Fatal("server did not start listening")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// 模拟耗时的迁移：放行之前服务一直处于未就绪状态
+	client := testutil.NewEntClient(t)
+	release := make(chan struct{})
+	migrated := make(chan error, 1)
+	go func() {
+		<-release
+		err := persistence.RunMigrations(context.Background(), client, zap.NewNop())
+		if err == nil {
+			readiness.MarkReady()
+		}
+		migrated <- err
+	}()
+
+	for _, path := range []string{"/readyz", "/api/v1/ping"} {
+		if got := status(path); got != http.StatusServiceUnavailable {
+			t.Errorf("GET %s during migration status = %d, want %d", path, got, http.StatusServiceUnavailable)
+		}
+	}
+
+	close(release)
+	if err := <-migrated; err != nil {
+		t.Fatalf("RunMigrations() error = %v", err)
+	}
+	for _, path := range []string{"/readyz", "/api/v1/ping"} {
+		if got := status(path); got != http.StatusOK {
+			t.Errorf("GET %s after migration status = %d, want %d", path, got, http.StatusOK)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JobStatus 异步任务状态
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"   // 等待执行
+	JobStatusRunning   JobStatus = "running"   // 已被工作器领取，正在执行
+	JobStatusCompleted JobStatus = "completed" // 执行成功
+	JobStatusFailed    JobStatus = "failed"    // 执行失败
+)
+
+// Job 持久化的异步任务，由后台工作器按提交顺序执行
+type Job struct {
+	ID         uint            `json:"id"`
+	Type       string          `json:"type"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+	Status     JobStatus       `json:"status"`
+	Result     json.RawMessage `json:"result,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	Attempts   int             `json:"attempts"`   // 已开始执行的次数
+	CreatedBy  *uint           `json:"created_by"` // 系统提交时为空
+	StartedAt  *time.Time      `json:"started_at"`
+	FinishedAt *time.Time      `json:"finished_at"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// IsFinished 检查任务是否已结束（成功或失败）
+func (j *Job) IsFinished() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed
+}
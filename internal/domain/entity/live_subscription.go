@@ -0,0 +1,14 @@
+package entity
+
+import (
+	"time"
+)
+
+// LiveSubscription 用户对直播间的订阅，同一用户对同一平台的同一直播间只有一条订阅
+type LiveSubscription struct {
+	ID        uint      `json:"id"`
+	UserID    uint      `json:"user_id"`
+	Platform  string    `json:"platform"` // 直播平台的规范名称
+	RoomID    string    `json:"room_id"`  // 直播间ID
+	CreatedAt time.Time `json:"created_at"`
+}
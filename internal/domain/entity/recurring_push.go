@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+)
+
+// RecurringPush 周期推送实体，按cron表达式周期性地生成定时推送
+type RecurringPush struct {
+	ID        uint       `json:"id"`
+	UserID    uint       `json:"user_id"`
+	CronExpr  string     `json:"cron_expr"`
+	Provider  string     `json:"provider"` // 为空时发送到用户所有启用的设备
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	URL       string     `json:"url"`
+	Sound     string     `json:"sound"`
+	Icon      string     `json:"icon"`
+	Group     string     `json:"group"`
+	Level     string     `json:"level"`
+	Enabled   bool       `json:"enabled"`
+	NextRunAt time.Time  `json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// ToScheduledPush 根据周期推送生成一次在指定时间发送的定时推送
+func (rp *RecurringPush) ToScheduledPush(sendAt time.Time) *ScheduledPush {
+	return &ScheduledPush{
+		UserID:   rp.UserID,
+		Provider: rp.Provider,
+		Title:    rp.Title,
+		Body:     rp.Body,
+		URL:      rp.URL,
+		Sound:    rp.Sound,
+		Icon:     rp.Icon,
+		Group:    rp.Group,
+		Level:    rp.Level,
+		SendAt:   sendAt,
+		Status:   ScheduledPushStatusPending,
+	}
+}
@@ -11,6 +11,8 @@ type Role struct {
 	DisplayName string    `json:"display_name"` // 显示名称，如：管理员, 普通用户
 	Description string    `json:"description"`  // 角色描述
 	IsSystem    bool      `json:"is_system"`    // 是否为系统角色（系统角色不可删除）
+	CreatedBy   *uint     `json:"created_by"`   // 创建者的用户ID，系统初始化时为空
+	UpdatedBy   *uint     `json:"updated_by"`   // 最后修改者的用户ID，未修改过时为空
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -23,18 +25,27 @@ type Permission struct {
 	Description string    `json:"description"`  // 权限描述
 	Resource    string    `json:"resource"`     // 资源名称，如：user, post, system
 	Action      string    `json:"action"`       // 操作名称，如：read, write, delete, manage
+	Category    string    `json:"category"`     // 权限分类，用于界面分组展示
 	IsSystem    bool      `json:"is_system"`    // 是否为系统权限（系统权限不可删除）
+	CreatedBy   *uint     `json:"created_by"`   // 创建者的用户ID，系统初始化时为空
+	UpdatedBy   *uint     `json:"updated_by"`   // 最后修改者的用户ID，未修改过时为空
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 // UserRole 用户角色关联实体
 type UserRole struct {
-	ID         uint      `json:"id"`
-	UserID     uint      `json:"user_id"`
-	RoleID     uint      `json:"role_id"`
-	AssignedBy uint      `json:"assigned_by"` // 分配者的用户ID
-	AssignedAt time.Time `json:"assigned_at"`
+	ID         uint       `json:"id"`
+	UserID     uint       `json:"user_id"`
+	RoleID     uint       `json:"role_id"`
+	AssignedBy uint       `json:"assigned_by"` // 分配者的用户ID
+	AssignedAt time.Time  `json:"assigned_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"` // 过期时间，为空表示永不过期
+}
+
+// IsExpired 检查角色分配在指定时间是否已过期
+func (ur *UserRole) IsExpired(now time.Time) bool {
+	return ur.ExpiresAt != nil && !ur.ExpiresAt.After(now)
 }
 
 // RolePermission 角色权限关联实体
@@ -48,8 +59,9 @@ type RolePermission struct {
 
 // 系统预定义角色常量
 const (
-	RoleNameAdmin = "admin" // 管理员
-	RoleNameUser  = "user"  // 普通用户
+	RoleNameAdmin      = "admin"       // 管理员
+	RoleNameUser       = "user"        // 普通用户
+	RoleNameSuperAdmin = "super_admin" // 超级管理员，与管理员角色同时分配，允许模拟其他管理员
 )
 
 // 系统预定义权限常量
@@ -74,8 +86,40 @@ const (
 
 	// 系统管理权限
 	PermissionSystemManage = "system:manage"
+
+	// 全部权限，资源和操作均为通配符
+	PermissionAll = "*:*"
 )
 
+// PermissionWildcard 权限资源或操作的通配符，匹配任意资源或操作
+const PermissionWildcard = "*"
+
+// 权限分类
+const (
+	PermissionCategoryUser   = "user"   // 用户管理
+	PermissionCategoryRBAC   = "rbac"   // 角色与权限管理
+	PermissionCategorySystem = "system" // 系统管理
+	PermissionCategoryOther  = "other"  // 其他，未指定分类时使用
+)
+
+// PermissionCategories 所有有效的权限分类
+var PermissionCategories = []string{
+	PermissionCategoryUser,
+	PermissionCategoryRBAC,
+	PermissionCategorySystem,
+	PermissionCategoryOther,
+}
+
+// IsValidPermissionCategory 检查权限分类是否有效
+func IsValidPermissionCategory(category string) bool {
+	for _, c := range PermissionCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
 // IsSystemRole 检查是否为系统角色
 func (r *Role) IsSystemRole() bool {
 	return r.IsSystem
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+)
+
+// ScheduledPushStatus 定时推送状态
+type ScheduledPushStatus string
+
+const (
+	ScheduledPushStatusPending   ScheduledPushStatus = "pending"   // 等待发送
+	ScheduledPushStatusSending   ScheduledPushStatus = "sending"   // 已被调度器领取，正在发送
+	ScheduledPushStatusSent      ScheduledPushStatus = "sent"      // 发送成功
+	ScheduledPushStatusFailed    ScheduledPushStatus = "failed"    // 发送失败
+	ScheduledPushStatusCancelled ScheduledPushStatus = "cancelled" // 已取消
+)
+
+// ScheduledPush 定时推送实体
+type ScheduledPush struct {
+	ID        uint                `json:"id"`
+	UserID    uint                `json:"user_id"`
+	Provider  string              `json:"provider"` // 为空时发送到用户所有启用的设备
+	Title     string              `json:"title"`
+	Body      string              `json:"body"`
+	URL       string              `json:"url"`
+	Sound     string              `json:"sound"`
+	Icon      string              `json:"icon"`
+	Group     string              `json:"group"`
+	Level     string              `json:"level"`
+	SendAt    time.Time           `json:"send_at"`
+	Status    ScheduledPushStatus `json:"status"`
+	Error     string              `json:"error"`
+	SentAt    *time.Time          `json:"sent_at"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// IsPending 检查定时推送是否仍在等待发送
+func (sp *ScheduledPush) IsPending() bool {
+	return sp.Status == ScheduledPushStatusPending
+}
+
+// IsDue 检查定时推送是否已到发送时间
+func (sp *ScheduledPush) IsDue(now time.Time) bool {
+	return sp.IsPending() && !sp.SendAt.After(now)
+}
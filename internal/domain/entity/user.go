@@ -1,20 +1,52 @@
 package entity
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
+// NormalizeUsername 去除用户名首尾空白，foldCase为true时转换为小写
+func NormalizeUsername(username string, foldCase bool) string {
+	username = strings.TrimSpace(username)
+	if foldCase {
+		username = strings.ToLower(username)
+	}
+	return username
+}
+
+// NormalizeEmail 去除邮箱首尾空白并转换为小写
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
 // User 用户实体
 type User struct {
-	ID        uint       `json:"id"`
-	Username  string     `json:"username"`
-	Email     string     `json:"email"`
-	Password  string     `json:"-"` // 密码不在JSON中显示
-	Nickname  string     `json:"nickname"`
-	Avatar    string     `json:"avatar"`
-	Status    UserStatus `json:"status"`
-	CreatedAt time.Time  `json:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at"`
+	ID       uint       `json:"id"`
+	Username string     `json:"username"`
+	Email    string     `json:"email"`
+	Password string     `json:"-"` // 密码不在JSON中显示
+	Nickname string     `json:"nickname"`
+	Avatar   string     `json:"avatar"`
+	Status   UserStatus `json:"status"`
+	// StatusReason 最近一次状态变更的原因
+	StatusReason string `json:"status_reason,omitempty"`
+	// StatusChangedAt 最近一次状态变更的时间，从未变更时为nil
+	StatusChangedAt *time.Time `json:"status_changed_at,omitempty"`
+	// BanExpiresAt 临时禁用的到期时间，永久禁用或未禁用时为nil
+	BanExpiresAt *time.Time `json:"ban_expires_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+
+	PushPreferences UserPushPreferences `json:"push_preferences"`
+}
+
+// UserPushPreferences 用户级推送偏好，作为所有设备的默认值
+// 优先级：消息显式指定 > 设备设置 > 用户偏好
+type UserPushPreferences struct {
+	DefaultGroup string `json:"default_group"` // 默认分组
+	DefaultSound string `json:"default_sound"` // 默认铃声
+	DefaultLevel string `json:"default_level"` // 默认通知级别
 }
 
 // UserStatus 用户状态枚举
@@ -40,6 +72,62 @@ func (s UserStatus) String() string {
 	}
 }
 
+// ParseUserStatus 将字符串解析为用户状态
+func ParseUserStatus(status string) (UserStatus, bool) {
+	switch status {
+	case "active":
+		return UserStatusActive, true
+	case "inactive":
+		return UserStatusInactive, true
+	case "banned":
+		return UserStatusBanned, true
+	default:
+		return 0, false
+	}
+}
+
+// UserStatusTransitions 用户状态转换规则，键为当前状态，值为允许转换到的状态
+type UserStatusTransitions map[UserStatus][]UserStatus
+
+// DefaultUserStatusTransitions 默认状态转换规则：被禁用的用户需先恢复为停用状态才能重新激活
+var DefaultUserStatusTransitions = UserStatusTransitions{
+	UserStatusActive:   {UserStatusInactive, UserStatusBanned},
+	UserStatusInactive: {UserStatusActive, UserStatusBanned},
+	UserStatusBanned:   {UserStatusInactive},
+}
+
+// ParseUserStatusTransitions 解析配置中以状态名表示的转换规则，如 {"banned": ["inactive"]}
+func ParseUserStatusTransitions(rules map[string][]string) (UserStatusTransitions, error) {
+	transitions := make(UserStatusTransitions, len(rules))
+	for fromName, toNames := range rules {
+		from, ok := ParseUserStatus(fromName)
+		if !ok {
+			return nil, fmt.Errorf("invalid user status %q", fromName)
+		}
+		for _, toName := range toNames {
+			to, ok := ParseUserStatus(toName)
+			if !ok {
+				return nil, fmt.Errorf("invalid user status %q in transitions of %q", toName, fromName)
+			}
+			transitions[from] = append(transitions[from], to)
+		}
+	}
+	return transitions, nil
+}
+
+// Allows 检查是否允许从from转换到to，状态不变时始终允许
+func (t UserStatusTransitions) Allows(from, to UserStatus) bool {
+	if from == to {
+		return true
+	}
+	for _, allowed := range t[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
 // IsActive 检查用户是否处于活跃状态
 func (u *User) IsActive() bool {
 	return u.Status == UserStatusActive
@@ -50,20 +138,22 @@ func (u *User) IsBanned() bool {
 	return u.Status == UserStatusBanned
 }
 
-// Activate 激活用户
-func (u *User) Activate() {
-	u.Status = UserStatusActive
-	u.UpdatedAt = time.Now()
+// ChangeStatus 在now时变更用户状态并记录原因和变更时间，同时清除禁用到期时间，是否允许转换由调用方检查
+func (u *User) ChangeStatus(status UserStatus, reason string, now time.Time) {
+	u.Status = status
+	u.StatusReason = reason
+	u.StatusChangedAt = &now
+	u.BanExpiresAt = nil
+	u.UpdatedAt = now
 }
 
-// Deactivate 停用用户
-func (u *User) Deactivate() {
-	u.Status = UserStatusInactive
-	u.UpdatedAt = time.Now()
+// Ban 禁用用户，expiresAt为nil时永久禁用
+func (u *User) Ban(reason string, expiresAt *time.Time, now time.Time) {
+	u.ChangeStatus(UserStatusBanned, reason, now)
+	u.BanExpiresAt = expiresAt
 }
 
-// Ban 禁用用户
-func (u *User) Ban() {
-	u.Status = UserStatusBanned
-	u.UpdatedAt = time.Now()
+// IsBanExpired 检查临时禁用在指定时间是否已到期，永久禁用始终返回false
+func (u *User) IsBanExpired(now time.Time) bool {
+	return u.IsBanned() && u.BanExpiresAt != nil && !u.BanExpiresAt.After(now)
 }
@@ -9,13 +9,16 @@ import (
 type UserPushSetting struct {
 	ID         uint                   `json:"id"`
 	UserID     uint                   `json:"user_id"`
-	Provider   string                 `json:"provider"`        // 推送服务提供商（如：bark）
+	Provider   string                 `json:"provider"`        // 推送服务提供商（如：bark、email）
 	Enabled    bool                   `json:"enabled"`         // 是否启用
 	DeviceID   string                 `json:"device_id"`       // 设备ID
 	DeviceName string                 `json:"device_name"`     // 设备名称
 	Settings   map[string]interface{} `json:"settings"`        // 提供商特定设置
 	CreatedAt  time.Time              `json:"created_at"`
 	UpdatedAt  time.Time              `json:"updated_at"`
+
+	ConsecutiveFailures int        `json:"consecutive_failures"`    // 连续推送失败次数
+	LastFailureAt       *time.Time `json:"last_failure_at,omitempty"` // 最近一次推送失败时间
 }
 
 // BarkSettings Bark推送服务的特定设置
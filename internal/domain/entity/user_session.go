@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"time"
+)
+
+// UserSession 用户登录会话，每次登录创建一个会话，刷新令牌时轮换其刷新令牌ID
+type UserSession struct {
+	ID             uint      `json:"id"`
+	UserID         uint      `json:"user_id"`
+	RefreshTokenID string    `json:"-"` // 当前有效的刷新令牌ID（jti）
+	UserAgent      string    `json:"user_agent"`
+	IP             string    `json:"ip"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastUsedAt     time.Time `json:"last_used_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// IsExpired 检查会话在指定时间是否已过期
+func (s *UserSession) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"time"
+)
+
+// Webhook事件类型
+const (
+	WebhookEventUserCreated = "user.created"
+	WebhookEventUserBanned  = "user.banned"
+	WebhookEventUserDeleted = "user.deleted"
+)
+
+// WebhookEvents 所有支持订阅的Webhook事件类型
+var WebhookEvents = []string{
+	WebhookEventUserCreated,
+	WebhookEventUserBanned,
+	WebhookEventUserDeleted,
+}
+
+// IsValidWebhookEvent 检查Webhook事件类型是否有效
+func IsValidWebhookEvent(event string) bool {
+	for _, e := range WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery Webhook投递记录，每次尝试一条
+type WebhookDelivery struct {
+	ID         uint      `json:"id"`
+	DeliveryID string    `json:"delivery_id"` // 同一事件发往同一端点的所有重试共用
+	EventID    string    `json:"event_id"`
+	Event      string    `json:"event"`
+	URL        string    `json:"url"`
+	Attempt    int       `json:"attempt"`     // 第几次尝试，从1开始
+	StatusCode int       `json:"status_code"` // 请求未完成时为0
+	Success    bool      `json:"success"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,72 @@
+// Package event 定义服务通过事件总线发布的领域事件
+package event
+
+import (
+	"time"
+
+	"nebula-live/internal/domain/entity"
+)
+
+// 领域事件名称，订阅者按名称订阅
+const (
+	NameUserCreated = "user.created"
+	NameUserBanned  = "user.banned"
+	NameUserDeleted = "user.deleted"
+	NamePushSent    = "push.sent"
+
+	NamePermissionsChanged = "rbac.permissions_changed"
+)
+
+// UserCreated 用户已创建
+type UserCreated struct {
+	// User 发布时的用户快照，订阅者异步处理，不应引用可能被修改的实体
+	User       entity.User
+	OccurredAt time.Time
+}
+
+// EventName 返回事件名称
+func (UserCreated) EventName() string { return NameUserCreated }
+
+// UserBanned 用户已被封禁，User.StatusReason和User.BanExpiresAt为封禁原因和到期时间
+type UserBanned struct {
+	User       entity.User
+	OccurredAt time.Time
+}
+
+// EventName 返回事件名称
+func (UserBanned) EventName() string { return NameUserBanned }
+
+// UserDeleted 用户已删除，User为删除前的用户信息
+type UserDeleted struct {
+	User       entity.User
+	OccurredAt time.Time
+}
+
+// EventName 返回事件名称
+func (UserDeleted) EventName() string { return NameUserDeleted }
+
+// PushSent 向用户的一个设备发送了推送，包含失败的发送，测试模式和取消的发送不发布
+type PushSent struct {
+	UserID    uint
+	SettingID uint
+	Provider  string
+	Success   bool
+	MessageID string
+	Error     string
+
+	OccurredAt time.Time
+}
+
+// EventName 返回事件名称
+func (PushSent) EventName() string { return NamePushSent }
+
+// PermissionsChanged 角色分配或角色权限发生变化，用户的权限可能随之改变
+type PermissionsChanged struct {
+	// UserID 权限可能变化的用户，为0时所有用户的权限都可能变化（如角色权限变更、删除角色）
+	UserID uint
+
+	OccurredAt time.Time
+}
+
+// EventName 返回事件名称
+func (PermissionsChanged) EventName() string { return NamePermissionsChanged }
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+)
+
+// JobRepository 异步任务仓储接口
+type JobRepository interface {
+	// Create 创建待执行的任务
+	Create(ctx context.Context, job *entity.Job) (*entity.Job, error)
+
+	// GetByID 根据ID获取任务，不存在时返回nil
+	GetByID(ctx context.Context, id uint) (*entity.Job, error)
+
+	// ClaimPending 按提交顺序领取待执行的任务，将其状态从pending原子地更新为running并递增执行次数
+	ClaimPending(ctx context.Context, now time.Time, limit int) ([]*entity.Job, error)
+
+	// MarkCompleted 标记任务执行成功并保存结果
+	MarkCompleted(ctx context.Context, id uint, result []byte, finishedAt time.Time) error
+
+	// MarkFailed 标记任务执行失败
+	MarkFailed(ctx context.Context, id uint, reason string, finishedAt time.Time) error
+
+	// FailInterrupted 将遗留在running状态、类型属于types的任务标记为失败，返回标记的数量
+	FailInterrupted(ctx context.Context, types []string, reason string, now time.Time) (int, error)
+
+	// RecoverInterrupted 处理遗留在running状态的任务：执行次数未达到maxAttempts的重新置为pending，
+	// 其余标记为失败，返回重新排队和标记失败的数量
+	RecoverInterrupted(ctx context.Context, maxAttempts int, reason string, now time.Time) (requeued, failed int, err error)
+}
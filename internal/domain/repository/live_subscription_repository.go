@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"nebula-live/internal/domain/entity"
+)
+
+// LiveSubscriptionRepository 直播间订阅仓储接口
+type LiveSubscriptionRepository interface {
+	// Create 创建订阅，用户已订阅同一直播间时返回 service.ErrLiveSubscriptionExists
+	Create(ctx context.Context, subscription *entity.LiveSubscription) (*entity.LiveSubscription, error)
+
+	// List 获取用户的订阅列表（带分页），按订阅时间倒序
+	List(ctx context.Context, userID uint, offset, limit int) ([]*entity.LiveSubscription, error)
+
+	// Count 获取用户的订阅总数
+	Count(ctx context.Context, userID uint) (int64, error)
+
+	// Delete 删除用户的指定订阅，返回是否删除了订阅
+	Delete(ctx context.Context, userID, id uint) (bool, error)
+
+	// DeleteByUserID 删除用户的所有订阅，返回删除的数量
+	DeleteByUserID(ctx context.Context, userID uint) (int, error)
+}
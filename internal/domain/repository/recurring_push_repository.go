@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+)
+
+// RecurringPushRepository 周期推送仓储接口
+type RecurringPushRepository interface {
+	// Create 创建周期推送
+	Create(ctx context.Context, recurringPush *entity.RecurringPush) (*entity.RecurringPush, error)
+
+	// GetByID 根据ID获取周期推送
+	GetByID(ctx context.Context, id uint) (*entity.RecurringPush, error)
+
+	// Update 更新周期推送
+	Update(ctx context.Context, recurringPush *entity.RecurringPush) (*entity.RecurringPush, error)
+
+	// Delete 删除周期推送
+	Delete(ctx context.Context, id uint) error
+
+	// List 获取用户周期推送列表（带分页）
+	List(ctx context.Context, userID uint, offset, limit int) ([]*entity.RecurringPush, error)
+
+	// Count 获取用户周期推送总数
+	Count(ctx context.Context, userID uint) (int64, error)
+
+	// GetDue 获取已到运行时间的启用周期推送
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.RecurringPush, error)
+
+	// Advance 将已到期的周期推送的下次运行时间推进到next，仅当其在now时仍处于到期状态时生效，返回是否推进成功
+	Advance(ctx context.Context, id uint, now, next time.Time) (bool, error)
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"nebula-live/internal/domain/entity"
+	"time"
 )
 
 // RoleRepository 角色仓储接口
@@ -19,6 +20,9 @@ type RoleRepository interface {
 	// List 获取角色列表
 	List(ctx context.Context, offset, limit int) ([]*entity.Role, error)
 
+	// Count 获取角色总数
+	Count(ctx context.Context) (int64, error)
+
 	// Update 更新角色
 	Update(ctx context.Context, role *entity.Role) (*entity.Role, error)
 
@@ -46,6 +50,9 @@ type PermissionRepository interface {
 	// List 获取权限列表
 	List(ctx context.Context, offset, limit int) ([]*entity.Permission, error)
 
+	// Count 获取权限总数
+	Count(ctx context.Context) (int64, error)
+
 	// Update 更新权限
 	Update(ctx context.Context, permission *entity.Permission) (*entity.Permission, error)
 
@@ -60,6 +67,13 @@ type PermissionRepository interface {
 
 	// GetByResource 根据资源获取权限列表
 	GetByResource(ctx context.Context, resource string) ([]*entity.Permission, error)
+
+	// ListAll 获取所有权限，按分类和名称排序
+	ListAll(ctx context.Context) ([]*entity.Permission, error)
+
+	// CreateMissing 在事务中创建名称尚不存在的权限，返回与permissions一一对应的结果，
+	// 名称已存在或与前面的项重复的项跳过，对应位置为nil
+	CreateMissing(ctx context.Context, permissions []*entity.Permission) ([]*entity.Permission, error)
 }
 
 // UserRoleRepository 用户角色关联仓储接口
@@ -70,20 +84,27 @@ type UserRoleRepository interface {
 	// RemoveRole 移除用户的角色
 	RemoveRole(ctx context.Context, userID, roleID uint) error
 
-	// GetUserRoles 获取用户的所有角色
+	// RemoveRoles 在事务中移除用户的多个角色，roleIDs为空时移除全部角色，返回移除的数量。
+	// minRemaining大于0且移除后剩余角色数不足时回滚
+	RemoveRoles(ctx context.Context, userID uint, roleIDs []uint, minRemaining int) (int, error)
+
+	// GetUserRoles 获取用户的所有未过期角色
 	GetUserRoles(ctx context.Context, userID uint) ([]*entity.Role, error)
 
 	// GetRoleUsers 获取角色的所有用户
 	GetRoleUsers(ctx context.Context, roleID uint) ([]*entity.User, error)
 
-	// HasRole 检查用户是否有指定角色
+	// HasRole 检查用户是否有指定的未过期角色
 	HasRole(ctx context.Context, userID, roleID uint) (bool, error)
 
-	// HasRoleByName 检查用户是否有指定名称的角色
+	// HasRoleByName 检查用户是否有指定名称的未过期角色
 	HasRoleByName(ctx context.Context, userID uint, roleName string) (bool, error)
 
-	// GetUserRoleAssignments 获取用户角色分配记录
+	// GetUserRoleAssignments 获取用户角色分配记录，包含已过期但尚未清理的记录
 	GetUserRoleAssignments(ctx context.Context, userID uint) ([]*entity.UserRole, error)
+
+	// DeleteExpired 删除在指定时间已过期的角色分配，返回删除的数量
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
 }
 
 // RolePermissionRepository 角色权限关联仓储接口
@@ -111,4 +132,7 @@ type RolePermissionRepository interface {
 
 	// CheckUserPermission 检查用户是否有指定权限
 	CheckUserPermission(ctx context.Context, userID uint, resource, action string) (bool, error)
+
+	// GetPermissionUsers 获取通过有效的角色分配拥有指定权限（含通配权限）的用户，每个用户只出现一次，按ID升序
+	GetPermissionUsers(ctx context.Context, resource, action string) ([]*entity.User, error)
 }
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+)
+
+// ScheduledPushRepository 定时推送仓储接口
+type ScheduledPushRepository interface {
+	// Create 创建定时推送
+	Create(ctx context.Context, scheduledPush *entity.ScheduledPush) (*entity.ScheduledPush, error)
+
+	// GetByID 根据ID获取定时推送
+	GetByID(ctx context.Context, id uint) (*entity.ScheduledPush, error)
+
+	// Update 更新待发送的定时推送，仅当状态仍为pending时生效
+	Update(ctx context.Context, scheduledPush *entity.ScheduledPush) (*entity.ScheduledPush, error)
+
+	// Delete 删除定时推送
+	Delete(ctx context.Context, id uint) error
+
+	// List 获取用户定时推送列表（带分页）
+	List(ctx context.Context, userID uint, offset, limit int) ([]*entity.ScheduledPush, error)
+
+	// Count 获取用户定时推送总数
+	Count(ctx context.Context, userID uint) (int64, error)
+
+	// Cancel 取消待发送的定时推送，返回是否取消成功
+	Cancel(ctx context.Context, id uint) (bool, error)
+
+	// ClaimDue 领取到期的定时推送，将其状态从pending原子地更新为sending
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*entity.ScheduledPush, error)
+
+	// MarkSent 标记定时推送发送成功
+	MarkSent(ctx context.Context, id uint, sentAt time.Time) error
+
+	// MarkFailed 标记定时推送发送失败
+	MarkFailed(ctx context.Context, id uint, reason string) error
+
+	// FailInterrupted 将遗留在sending状态的定时推送标记为失败，返回受影响的数量
+	FailInterrupted(ctx context.Context, reason string) (int, error)
+}
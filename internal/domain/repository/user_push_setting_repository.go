@@ -7,8 +7,9 @@ import (
 
 // UserPushSettingRepository 用户推送设置仓储接口
 type UserPushSettingRepository interface {
-	// Create 创建用户推送设置
-	Create(ctx context.Context, setting *entity.UserPushSetting) (*entity.UserPushSetting, error)
+	// Create 创建用户推送设置，同一提供商的设备ID已存在时返回 service.ErrDeviceAlreadyExists。
+	// 设置启用且enabledLimit大于0时，在同一事务中锁定用户并检查启用的设备数，达到上限返回 service.ErrDeviceLimitReached
+	Create(ctx context.Context, setting *entity.UserPushSetting, enabledLimit int) (*entity.UserPushSetting, error)
 	
 	// GetByID 根据ID获取用户推送设置
 	GetByID(ctx context.Context, id uint) (*entity.UserPushSetting, error)
@@ -28,6 +29,10 @@ type UserPushSettingRepository interface {
 	// Update 更新用户推送设置
 	Update(ctx context.Context, setting *entity.UserPushSetting) (*entity.UserPushSetting, error)
 	
+	// Enable 启用推送设置并清零连续失败次数，设备上限的检查方式与 Create 相同，已启用时不做修改。
+	// 设置不存在时返回 service.ErrUserPushSettingNotFound
+	Enable(ctx context.Context, id uint, enabledLimit int) error
+
 	// Delete 删除用户推送设置
 	Delete(ctx context.Context, id uint) error
 	
@@ -42,4 +47,28 @@ type UserPushSettingRepository interface {
 	
 	// Count 获取用户推送设置总数
 	Count(ctx context.Context, userID uint) (int64, error)
+
+	// CountEnabled 获取用户启用的推送设置总数
+	CountEnabled(ctx context.Context, userID uint) (int64, error)
+
+	// ListByProvider 获取用户在指定提供商的推送设置列表（带分页）
+	ListByProvider(ctx context.Context, userID uint, provider string, offset, limit int) ([]*entity.UserPushSetting, error)
+
+	// CountByProvider 获取用户在指定提供商的推送设置总数
+	CountByProvider(ctx context.Context, userID uint, provider string) (int64, error)
+
+	// SetEnabledByUserID 将用户所有状态不同的推送设置改为指定启用状态，返回变更的数量。
+	// 启用时同时清零连续推送失败次数，enabledLimit大于0且用户的设置总数超过该值时不做修改，返回 service.ErrDeviceLimitReached
+	SetEnabledByUserID(ctx context.Context, userID uint, enabled bool, enabledLimit int) (int, error)
+
+	// TransferOwner 将属于fromUserID的推送设置转移给toUserID，设置不存在或不属于fromUserID时返回false。
+	// 启用的设置按 Create 的方式检查toUserID的设备上限
+	TransferOwner(ctx context.Context, id, fromUserID, toUserID uint, enabledLimit int) (bool, error)
+
+	// ResetFailures 清零连续推送失败次数
+	ResetFailures(ctx context.Context, id uint) error
+
+	// RecordFailure 累加连续推送失败次数，达到阈值（大于0时）且设置仍启用时将其禁用。
+	// 返回累加后的失败次数以及本次调用是否禁用了该设置
+	RecordFailure(ctx context.Context, id uint, threshold int) (int, bool, error)
 }
\ No newline at end of file
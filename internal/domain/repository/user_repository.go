@@ -14,15 +14,27 @@ type UserRepository interface {
 	// GetByID 根据ID获取用户
 	GetByID(ctx context.Context, id uint) (*entity.User, error)
 
+	// GetByIDs 根据ID批量获取用户，重复和不存在的ID被跳过，结果按ID升序
+	GetByIDs(ctx context.Context, ids []uint) ([]*entity.User, error)
+
 	// GetByUsername 根据用户名获取用户
 	GetByUsername(ctx context.Context, username string) (*entity.User, error)
 
-	// GetByEmail 根据邮箱获取用户
+	// GetByUsernameFold 根据用户名获取用户，不区分大小写，存在多个匹配时优先完全匹配
+	GetByUsernameFold(ctx context.Context, username string) (*entity.User, error)
+
+	// GetByEmail 根据邮箱获取用户，不区分大小写，存在多个匹配时优先完全匹配
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
 
-	// Update 更新用户信息
+	// Update 更新用户资料，不写入状态相关字段和推送偏好，避免用过期数据覆盖并发的状态变更和偏好更新
 	Update(ctx context.Context, user *entity.User) error
 
+	// UpdatePushPreferences 只更新用户的推送偏好，用户不存在时返回 service.ErrUserNotFound
+	UpdatePushPreferences(ctx context.Context, id uint, preferences entity.UserPushPreferences) error
+
+	// UpdateStatus 只更新用户的状态、原因、变更时间和禁用到期时间，不覆盖其他字段
+	UpdateStatus(ctx context.Context, user *entity.User) error
+
 	// Delete 删除用户
 	Delete(ctx context.Context, id uint) error
 
@@ -35,6 +47,9 @@ type UserRepository interface {
 	// ExistsByUsername 检查用户名是否已存在
 	ExistsByUsername(ctx context.Context, username string) (bool, error)
 
-	// ExistsByEmail 检查邮箱是否已存在
+	// ExistsByUsernameFold 检查用户名是否已存在，不区分大小写
+	ExistsByUsernameFold(ctx context.Context, username string) (bool, error)
+
+	// ExistsByEmail 检查邮箱是否已存在，不区分大小写
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 }
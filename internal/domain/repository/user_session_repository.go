@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+)
+
+// UserSessionRepository 用户会话仓储接口
+type UserSessionRepository interface {
+	// Create 创建会话
+	Create(ctx context.Context, session *entity.UserSession) (*entity.UserSession, error)
+
+	// GetByID 根据ID获取会话，不存在时返回nil
+	GetByID(ctx context.Context, id uint) (*entity.UserSession, error)
+
+	// ListActive 获取用户在now时仍未过期的会话，按最近使用时间倒序
+	ListActive(ctx context.Context, userID uint, now time.Time) ([]*entity.UserSession, error)
+
+	// Rotate 将会话的刷新令牌ID从oldTokenID轮换为session中的新值并更新使用信息
+	// 仅当会话当前的刷新令牌ID仍为oldTokenID时生效，返回是否轮换成功
+	Rotate(ctx context.Context, session *entity.UserSession, oldTokenID string) (bool, error)
+
+	// Delete 删除用户的指定会话，返回是否删除了会话
+	Delete(ctx context.Context, userID, id uint) (bool, error)
+
+	// DeleteByUserExcept 删除用户除exceptID以外的所有会话，返回删除的数量
+	DeleteByUserExcept(ctx context.Context, userID, exceptID uint) (int, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"nebula-live/internal/domain/entity"
+)
+
+// WebhookDeliveryRepository Webhook投递记录仓储接口
+type WebhookDeliveryRepository interface {
+	// Create 记录一次投递尝试
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error)
+
+	// List 分页获取投递记录，按时间倒序，event不为空时只返回该事件类型
+	List(ctx context.Context, event string, offset, limit int) ([]*entity.WebhookDelivery, error)
+
+	// Count 获取投递记录数量，event不为空时只统计该事件类型
+	Count(ctx context.Context, event string) (int, error)
+}
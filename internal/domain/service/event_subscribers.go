@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/event"
+	"nebula-live/internal/pkg/eventbus"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// RegisterEventSubscribers 注册领域事件的订阅者，订阅者在事件总线的独立协程中处理事件，
+// 权限缓存同步失效，保证发布事件的请求返回后变更立即生效
+func RegisterEventSubscribers(bus *eventbus.Bus, webhookService WebhookService, permissionCache *PermissionCache) error {
+	if err := bus.SubscribeSync("permission-cache", permissionCache.HandleEvent, event.NamePermissionsChanged); err != nil {
+		return fmt.Errorf("failed to subscribe permission cache: %w", err)
+	}
+
+	if err := bus.Subscribe("audit", auditEventHandler); err != nil {
+		return fmt.Errorf("failed to subscribe audit log: %w", err)
+	}
+
+	if err := bus.Subscribe("webhook", webhookEventHandler(webhookService),
+		event.NameUserCreated, event.NameUserBanned, event.NameUserDeleted); err != nil {
+		return fmt.Errorf("failed to subscribe webhooks: %w", err)
+	}
+
+	return nil
+}
+
+// auditEventHandler 将领域事件写入审计日志
+func auditEventHandler(_ context.Context, e eventbus.Event) error {
+	fields := []zap.Field{zap.String("audit_action", e.EventName())}
+
+	switch e := e.(type) {
+	case event.UserCreated:
+		fields = append(fields, zap.Uint("user_id", e.User.ID), zap.String("username", e.User.Username))
+	case event.UserBanned:
+		fields = append(fields,
+			zap.Uint("user_id", e.User.ID),
+			zap.String("reason", e.User.StatusReason))
+		if e.User.BanExpiresAt != nil {
+			fields = append(fields, zap.Time("ban_expires_at", *e.User.BanExpiresAt))
+		}
+	case event.UserDeleted:
+		fields = append(fields, zap.Uint("user_id", e.User.ID), zap.String("username", e.User.Username))
+	case event.PushSent:
+		fields = append(fields,
+			zap.Uint("user_id", e.UserID),
+			zap.Uint("setting_id", e.SettingID),
+			zap.String("provider", e.Provider),
+			zap.Bool("success", e.Success))
+	case event.PermissionsChanged:
+		fields = append(fields, zap.Uint("user_id", e.UserID))
+	}
+
+	logger.Info("Audit: domain event", fields...)
+	return nil
+}
+
+// webhookEventHandler 将用户生命周期事件投递到订阅的Webhook端点
+func webhookEventHandler(webhookService WebhookService) eventbus.Handler {
+	return func(_ context.Context, e eventbus.Event) error {
+		switch e := e.(type) {
+		case event.UserCreated:
+			webhookService.Publish(entity.WebhookEventUserCreated, NewWebhookUserData(&e.User))
+		case event.UserBanned:
+			webhookService.Publish(entity.WebhookEventUserBanned, NewWebhookUserData(&e.User))
+		case event.UserDeleted:
+			webhookService.Publish(entity.WebhookEventUserDeleted, NewWebhookUserData(&e.User))
+		default:
+			return fmt.Errorf("unexpected event %q", e.EventName())
+		}
+		return nil
+	}
+}
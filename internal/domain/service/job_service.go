@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/clock"
+	apperrors "nebula-live/pkg/errors"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// 异步任务服务相关错误
+var (
+	ErrJobNotFound    = apperrors.NewDomainError(apperrors.KindNotFound, "job not found")
+	ErrUnknownJobType = apperrors.NewDomainError(apperrors.KindInvalid, "unknown job type")
+)
+
+const (
+	// defaultJobMaxAttempts 任务被中断后最多执行的次数，包含首次执行
+	defaultJobMaxAttempts = 3
+	// jobErrorMaxLength 任务错误信息的最大长度，与表字段长度一致
+	jobErrorMaxLength = 1000
+)
+
+// 任务被中断（服务重启）后标记为失败时记录的原因
+const (
+	// interruptedJobReason 任务多次执行都被中断
+	interruptedJobReason = "interrupted too many times before completing"
+	// interruptedOnceJobReason 不重新执行的任务被中断，可能已经部分完成
+	interruptedOnceJobReason = "interrupted before completing and not retried"
+)
+
+// JobHandlerFunc 执行一种类型的任务，payload为提交时的参数，返回值序列化为任务结果
+//
+// 服务在执行中途重启时任务会重新执行，处理函数需要能安全地重复执行。
+type JobHandlerFunc func(ctx context.Context, payload json.RawMessage) (interface{}, error)
+
+// JobService 异步任务服务接口，耗时的操作提交为任务后立即返回任务ID，由后台工作器执行
+type JobService interface {
+	// RegisterHandler 注册任务类型的处理函数，需要在工作器启动前完成，任务被中断后重新排队
+	RegisterHandler(jobType string, handler JobHandlerFunc)
+
+	// RegisterOnceHandler 注册不能安全重复执行的任务类型，任务被中断后标记为失败而不重新排队
+	RegisterOnceHandler(jobType string, handler JobHandlerFunc)
+
+	// Enqueue 提交任务，payload序列化为JSON保存，createdBy为0表示系统提交
+	Enqueue(ctx context.Context, jobType string, payload interface{}, createdBy uint) (*entity.Job, error)
+
+	// GetJob 获取任务，userID不为0时只能获取该用户提交的任务
+	GetJob(ctx context.Context, userID, id uint) (*entity.Job, error)
+
+	// ProcessPending 领取并并发执行最多limit个待执行的任务，全部结束后返回处理的数量
+	ProcessPending(ctx context.Context, limit int) (int, error)
+
+	// RecoverInterrupted 处理上次运行中断时遗留的任务，返回重新排队的数量
+	RecoverInterrupted(ctx context.Context) (int, error)
+
+	// Notify 返回提交新任务时的通知，工作器据此立即处理而不必等到下次轮询
+	Notify() <-chan struct{}
+}
+
+// JobServiceConfig 异步任务服务配置
+type JobServiceConfig struct {
+	// MaxAttempts 任务被中断后最多执行的次数，包含首次执行
+	MaxAttempts int
+	// Clock 领取任务和记录完成时间使用的时间来源，为nil时使用系统时间
+	Clock clock.Clock
+}
+
+// jobService 实现异步任务服务
+type jobService struct {
+	jobRepo repository.JobRepository
+	config  JobServiceConfig
+	clock   clock.Clock
+
+	handlersMu sync.RWMutex
+	handlers   map[string]JobHandlerFunc
+	// onceTypes 中断后不重新执行的任务类型
+	onceTypes map[string]bool
+
+	notify chan struct{}
+}
+
+// NewJobService 创建异步任务服务
+func NewJobService(jobRepo repository.JobRepository, config JobServiceConfig) JobService {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = defaultJobMaxAttempts
+	}
+
+	return &jobService{
+		jobRepo:   jobRepo,
+		config:    config,
+		clock:     clock.OrReal(config.Clock),
+		handlers:  make(map[string]JobHandlerFunc),
+		onceTypes: make(map[string]bool),
+		notify:    make(chan struct{}, 1),
+	}
+}
+
+// RegisterHandler 注册任务类型的处理函数，重复注册时覆盖
+func (s *jobService) RegisterHandler(jobType string, handler JobHandlerFunc) {
+	s.handlersMu.Lock()
+	defer s.handlersMu.Unlock()
+	s.handlers[jobType] = handler
+	delete(s.onceTypes, jobType)
+}
+
+// RegisterOnceHandler 注册中断后不重新执行的任务类型，重复注册时覆盖
+func (s *jobService) RegisterOnceHandler(jobType string, handler JobHandlerFunc) {
+	s.handlersMu.Lock()
+	defer s.handlersMu.Unlock()
+	s.handlers[jobType] = handler
+	s.onceTypes[jobType] = true
+}
+
+// onceJobTypes 返回中断后不重新执行的任务类型
+func (s *jobService) onceJobTypes() []string {
+	s.handlersMu.RLock()
+	defer s.handlersMu.RUnlock()
+	types := make([]string, 0, len(s.onceTypes))
+	for jobType := range s.onceTypes {
+		types = append(types, jobType)
+	}
+	return types
+}
+
+// handler 获取任务类型的处理函数
+func (s *jobService) handler(jobType string) (JobHandlerFunc, bool) {
+	s.handlersMu.RLock()
+	defer s.handlersMu.RUnlock()
+	handler, ok := s.handlers[jobType]
+	return handler, ok
+}
+
+// Enqueue 提交任务
+func (s *jobService) Enqueue(ctx context.Context, jobType string, payload interface{}, createdBy uint) (*entity.Job, error) {
+	if _, ok := s.handler(jobType); !ok {
+		return nil, ErrUnknownJobType
+	}
+
+	job := &entity.Job{Type: jobType}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("marshal job payload: %w", err)
+		}
+		job.Payload = data
+	}
+	if createdBy != 0 {
+		job.CreatedBy = &createdBy
+	}
+
+	created, err := s.jobRepo.Create(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Job enqueued",
+		zap.Uint("id", created.ID),
+		zap.String("type", created.Type))
+
+	// 通知已有未处理的信号时无需重复发送
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+
+	return created, nil
+}
+
+// GetJob 获取任务
+func (s *jobService) GetJob(ctx context.Context, userID, id uint) (*entity.Job, error) {
+	job, err := s.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrJobNotFound
+	}
+
+	// 不暴露其他用户的任务是否存在
+	if userID != 0 && (job.CreatedBy == nil || *job.CreatedBy != userID) {
+		return nil, ErrJobNotFound
+	}
+
+	return job, nil
+}
+
+// ProcessPending 领取并并发执行待执行的任务
+func (s *jobService) ProcessPending(ctx context.Context, limit int) (int, error) {
+	jobs, err := s.jobRepo.ClaimPending(ctx, s.clock.Now(), limit)
+
+	// 已领取的任务必须执行完，否则会一直停留在running状态直到下次恢复
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job *entity.Job) {
+			defer wg.Done()
+			s.execute(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+
+	return len(jobs), err
+}
+
+// RecoverInterrupted 处理上次运行中断时遗留的任务
+//
+// 通过RegisterOnceHandler注册的任务可能已经部分完成，直接标记为失败。其余任务可以安全地重复执行，
+// 因此重新排队，执行次数达到上限的标记为失败，避免每次启动都会中断的任务无限重试。
+func (s *jobService) RecoverInterrupted(ctx context.Context) (int, error) {
+	now := s.clock.Now()
+	onceFailed, err := s.jobRepo.FailInterrupted(ctx, s.onceJobTypes(), interruptedOnceJobReason, now)
+	if err != nil {
+		return 0, err
+	}
+
+	requeued, failed, err := s.jobRepo.RecoverInterrupted(ctx, s.config.MaxAttempts, interruptedJobReason, now)
+	if err != nil {
+		return 0, err
+	}
+	failed += onceFailed
+
+	if requeued > 0 || failed > 0 {
+		logger.Warn("Recovered interrupted jobs",
+			zap.Int("requeued", requeued),
+			zap.Int("failed", failed))
+	}
+
+	return requeued, nil
+}
+
+// Notify 返回提交新任务时的通知
+func (s *jobService) Notify() <-chan struct{} {
+	return s.notify
+}
+
+// execute 执行单个任务并记录结果
+func (s *jobService) execute(ctx context.Context, job *entity.Job) {
+	start := s.clock.Now()
+	result, err := s.run(ctx, job)
+
+	// 工作器停止时任务被中断，保持running状态，下次恢复时重新排队
+	if ctx.Err() != nil {
+		logger.Warn("Job interrupted by shutdown",
+			zap.Uint("id", job.ID),
+			zap.String("type", job.Type))
+		return
+	}
+
+	// 结果写入不受执行超时影响
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	var data []byte
+	if err == nil && result != nil {
+		if data, err = json.Marshal(result); err != nil {
+			err = fmt.Errorf("marshal job result: %w", err)
+		}
+	}
+
+	if err != nil {
+		logger.Warn("Job failed",
+			zap.Uint("id", job.ID),
+			zap.String("type", job.Type),
+			zap.Int("attempt", job.Attempts),
+			zap.Error(err))
+
+		reason := err.Error()
+		if len(reason) > jobErrorMaxLength {
+			reason = reason[:jobErrorMaxLength]
+		}
+		if err := s.jobRepo.MarkFailed(recordCtx, job.ID, reason, s.clock.Now()); err != nil {
+			logger.Error("Failed to record job failure",
+				zap.Uint("id", job.ID),
+				zap.Error(err))
+		}
+		return
+	}
+
+	if err := s.jobRepo.MarkCompleted(recordCtx, job.ID, data, s.clock.Now()); err != nil {
+		logger.Error("Failed to record job completion",
+			zap.Uint("id", job.ID),
+			zap.Error(err))
+		return
+	}
+
+	logger.Info("Job completed",
+		zap.Uint("id", job.ID),
+		zap.String("type", job.Type),
+		zap.Duration("duration", s.clock.Now().Sub(start)))
+}
+
+// run 调用任务类型的处理函数，处理函数panic时视为失败
+func (s *jobService) run(ctx context.Context, job *entity.Job) (result interface{}, err error) {
+	handler, ok := s.handler(job.Type)
+	if !ok {
+		return nil, fmt.Errorf("no handler registered for job type %q", job.Type)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job handler panicked: %v", r)
+		}
+	}()
+
+	return handler(ctx, job.Payload)
+}
@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/pkg/push"
+)
+
+// newPushJobService 创建注册了推送任务的异步任务服务
+func newPushJobService(t *testing.T, f *pushServiceFixture) service.JobService {
+	t.Helper()
+	jobService := service.NewJobService(persistence.NewJobRepository(f.client), service.JobServiceConfig{})
+	service.RegisterJobHandlers(jobService, f.pushService)
+	return jobService
+}
+
+// processJob 执行所有待执行的任务并返回指定任务的最新状态
+func processJob(t *testing.T, jobService service.JobService, userID, id uint) *entity.Job {
+	t.Helper()
+	ctx := context.Background()
+
+	processed, err := jobService.ProcessPending(ctx, 10)
+	if err != nil || processed != 1 {
+		t.Fatalf("ProcessPending() = %d, %v; want 1 job processed", processed, err)
+	}
+
+	job, err := jobService.GetJob(ctx, userID, id)
+	if err != nil {
+		t.Fatalf("GetJob() error = %v", err)
+	}
+	return job
+}
+
+func TestJobService_SendToUserDevicesCompletes(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	server := fakeBarkServer(t, &status)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: server.URL})
+	f.addBarkDevice(t, "iphone-device-key", "iPhone")
+	f.addBarkDevice(t, "ipad-device-key", "iPad")
+	jobService := newPushJobService(t, f)
+
+	job, err := jobService.Enqueue(context.Background(), service.JobTypeSendToUserDevices, service.SendToUserDevicesPayload{
+		UserID:  f.user.ID,
+		Message: push.PushMessage{Title: "标题", Body: "内容"},
+	}, f.user.ID)
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	if job.Status != entity.JobStatusPending {
+		t.Fatalf("status after enqueue = %q, want pending", job.Status)
+	}
+
+	job = processJob(t, jobService, f.user.ID, job.ID)
+	if job.Status != entity.JobStatusCompleted || job.Error != "" {
+		t.Fatalf("job = %q (%s), want completed", job.Status, job.Error)
+	}
+
+	var result service.SendToUserDevicesResult
+	if err := json.Unmarshal(job.Result, &result); err != nil {
+		t.Fatalf("decode result %s: %v", job.Result, err)
+	}
+	want := service.SendToUserDevicesResult{TotalDevices: 2, SuccessCount: 2}
+	if result != want {
+		t.Errorf("result = %+v, want %+v", result, want)
+	}
+}
+
+func TestJobService_SendToUserDevicesResubmitIsNotSentAgain(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	server := fakeBarkServer(t, &status)
+
+	// 去重窗口内重复提交相同内容
+	f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: server.URL, DedupWindow: time.Minute})
+	f.addBarkDevice(t, "iphone-device-key", "iPhone")
+	jobService := newPushJobService(t, f)
+
+	payload := service.SendToUserDevicesPayload{UserID: f.user.ID, Message: push.PushMessage{Body: "内容"}}
+	for i, wantDuplicate := range []bool{false, true} {
+		job, err := jobService.Enqueue(context.Background(), service.JobTypeSendToUserDevices, payload, f.user.ID)
+		if err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+
+		job = processJob(t, jobService, f.user.ID, job.ID)
+		if job.Status != entity.JobStatusCompleted {
+			t.Fatalf("run %d: status = %q (%s), want completed", i+1, job.Status, job.Error)
+		}
+
+		var result service.SendToUserDevicesResult
+		if err := json.Unmarshal(job.Result, &result); err != nil {
+			t.Fatalf("decode result %s: %v", job.Result, err)
+		}
+		if result.Duplicate != wantDuplicate {
+			t.Errorf("run %d: duplicate = %v, want %v", i+1, result.Duplicate, wantDuplicate)
+		}
+	}
+}
+
+func TestJobService_SendToUserDevicesFails(t *testing.T) {
+	f := newPushServiceFixture(t, service.PushServiceConfig{})
+	jobService := newPushJobService(t, f)
+
+	job, err := jobService.Enqueue(context.Background(), service.JobTypeSendToUserDevices, service.SendToUserDevicesPayload{
+		UserID:  f.user.ID,
+		Message: push.PushMessage{Body: "内容", URL: "javascript:alert(1)"},
+	}, f.user.ID)
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	job = processJob(t, jobService, f.user.ID, job.ID)
+	if job.Status != entity.JobStatusFailed || job.Error == "" {
+		t.Errorf("job = %q (%q), want failed with an error", job.Status, job.Error)
+	}
+}
+
+func TestJobService_InterruptedPushJobIsNotRerun(t *testing.T) {
+	ctx := context.Background()
+	f := newPushServiceFixture(t, service.PushServiceConfig{})
+	jobRepo := persistence.NewJobRepository(f.client)
+	jobService := service.NewJobService(jobRepo, service.JobServiceConfig{})
+	service.RegisterJobHandlers(jobService, f.pushService)
+	jobService.RegisterHandler("test.idempotent", func(ctx context.Context, payload json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+
+	pushJob, err := jobService.Enqueue(ctx, service.JobTypeSendToUserDevices, service.SendToUserDevicesPayload{
+		UserID:  f.user.ID,
+		Message: push.PushMessage{Body: "内容"},
+	}, f.user.ID)
+	if err != nil {
+		t.Fatalf("Enqueue(push) error = %v", err)
+	}
+	idempotentJob, err := jobService.Enqueue(ctx, "test.idempotent", nil, f.user.ID)
+	if err != nil {
+		t.Fatalf("Enqueue(idempotent) error = %v", err)
+	}
+
+	// 领取后不执行，模拟执行中途服务重启
+	if claimed, err := jobRepo.ClaimPending(ctx, time.Now(), 10); err != nil || len(claimed) != 2 {
+		t.Fatalf("ClaimPending() = %d jobs, %v; want 2", len(claimed), err)
+	}
+
+	requeued, err := jobService.RecoverInterrupted(ctx)
+	if err != nil {
+		t.Fatalf("RecoverInterrupted() error = %v", err)
+	}
+	if requeued != 1 {
+		t.Errorf("RecoverInterrupted() = %d, want 1 requeued", requeued)
+	}
+
+	got, err := jobService.GetJob(ctx, f.user.ID, pushJob.ID)
+	if err != nil {
+		t.Fatalf("GetJob(push) error = %v", err)
+	}
+	if got.Status != entity.JobStatusFailed || got.Error == "" {
+		t.Errorf("push job = %q (%q), want failed", got.Status, got.Error)
+	}
+	got, err = jobService.GetJob(ctx, f.user.ID, idempotentJob.ID)
+	if err != nil {
+		t.Fatalf("GetJob(idempotent) error = %v", err)
+	}
+	if got.Status != entity.JobStatusPending {
+		t.Errorf("idempotent job = %q, want pending", got.Status)
+	}
+
+	// 失败的推送任务不会再被领取
+	if processed, err := jobService.ProcessPending(ctx, 10); err != nil || processed != 1 {
+		t.Errorf("ProcessPending() = %d, %v; want only the idempotent job", processed, err)
+	}
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/pkg/livestream"
+	"nebula-live/pkg/clock"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// 直播间订阅服务相关错误
+var (
+	ErrLiveSubscriptionNotFound = errors.New("live subscription not found")
+	ErrLiveSubscriptionExists   = errors.New("live room already subscribed")
+)
+
+// LiveSubscriptionService 直播间订阅服务接口，所有操作都限定在指定用户的订阅内
+type LiveSubscriptionService interface {
+	// Subscribe 订阅直播间，平台名称可以是别名，保存时解析为规范名称。
+	// 平台不支持时返回 livestream.ErrPlatformNotFound，已订阅时返回 ErrLiveSubscriptionExists
+	Subscribe(ctx context.Context, userID uint, platform, roomID string) (*entity.LiveSubscription, error)
+
+	// ListSubscriptions 获取用户的订阅列表（带分页）
+	ListSubscriptions(ctx context.Context, userID uint, page, limit int) ([]*entity.LiveSubscription, int64, error)
+
+	// Unsubscribe 取消用户的指定订阅
+	Unsubscribe(ctx context.Context, userID, subscriptionID uint) error
+
+	// UnsubscribeAll 取消用户的所有订阅，返回取消的数量
+	UnsubscribeAll(ctx context.Context, userID uint) (int, error)
+
+	// ListSubscriptionStatuses 获取用户订阅的直播间及其当前状态，开播的排在前面，获取失败的排在最后。
+	// 单个直播间获取失败不影响其他直播间，失败原因记录在对应项的Err中
+	ListSubscriptionStatuses(ctx context.Context, userID uint) ([]*LiveSubscriptionStatus, error)
+}
+
+const (
+	// maxSubscriptionStatuses 汇总状态时最多查询的订阅数量，超出时只查询最近订阅的直播间
+	maxSubscriptionStatuses = 200
+	// subscriptionStatusConcurrency 同时查询直播间状态的最大数量
+	subscriptionStatusConcurrency = 8
+	// defaultRoomStatusCacheTTL 直播间状态的默认缓存时间
+	defaultRoomStatusCacheTTL = 30 * time.Second
+	// roomStatusCacheMaxRooms 缓存的直播间数量上限，超出时清空重建
+	roomStatusCacheMaxRooms = 10000
+)
+
+// LiveSubscriptionServiceConfig 直播间订阅服务配置
+type LiveSubscriptionServiceConfig struct {
+	// StatusCacheTTL 直播间状态的缓存时间，0使用默认值30秒，负数表示不缓存
+	StatusCacheTTL time.Duration
+	// Clock 缓存有效期和检查时间使用的时间来源，为nil时使用系统时间
+	Clock clock.Clock
+}
+
+// LiveSubscriptionStatus 订阅的直播间及其当前状态
+type LiveSubscriptionStatus struct {
+	Subscription *entity.LiveSubscription
+	// Room 直播间状态和基本信息，获取失败时为nil
+	Room *livestream.RoomInfo
+	// CheckedAt 获取直播间状态的时间，命中缓存时为缓存写入的时间
+	CheckedAt time.Time
+	// Err 获取直播间状态失败的原因
+	Err error
+}
+
+// cachedRoomStatus 缓存的直播间状态
+type cachedRoomStatus struct {
+	room      livestream.RoomInfo
+	checkedAt time.Time
+}
+
+// liveSubscriptionService 实现直播间订阅服务
+type liveSubscriptionService struct {
+	subscriptionRepo  repository.LiveSubscriptionRepository
+	liveStreamService LiveStreamService
+	cacheTTL          time.Duration
+	clock             clock.Clock
+
+	// roomCache 按平台和房间号缓存直播间状态，多个用户订阅同一直播间时共用，获取失败的结果不缓存
+	cacheMu   sync.Mutex
+	roomCache map[string]cachedRoomStatus
+}
+
+// NewLiveSubscriptionService 创建直播间订阅服务
+func NewLiveSubscriptionService(subscriptionRepo repository.LiveSubscriptionRepository, liveStreamService LiveStreamService, config LiveSubscriptionServiceConfig) LiveSubscriptionService {
+	cacheTTL := config.StatusCacheTTL
+	if cacheTTL == 0 {
+		cacheTTL = defaultRoomStatusCacheTTL
+	}
+
+	return &liveSubscriptionService{
+		subscriptionRepo:  subscriptionRepo,
+		liveStreamService: liveStreamService,
+		cacheTTL:          cacheTTL,
+		clock:             clock.OrReal(config.Clock),
+		roomCache:         make(map[string]cachedRoomStatus),
+	}
+}
+
+// Subscribe 订阅直播间
+func (s *liveSubscriptionService) Subscribe(ctx context.Context, userID uint, platform, roomID string) (*entity.LiveSubscription, error) {
+	// 别名统一解析为规范名称，避免 bili 和 bilibili 被当作不同的订阅
+	platform = livestream.ResolvePlatform(platform)
+	if !slices.Contains(s.liveStreamService.GetSupportedPlatforms(), platform) {
+		return nil, livestream.ErrPlatformNotFound
+	}
+
+	roomID = strings.TrimSpace(roomID)
+	if roomID == "" {
+		return nil, livestream.ErrInvalidRoomID
+	}
+
+	subscription, err := s.subscriptionRepo.Create(ctx, &entity.LiveSubscription{
+		UserID:   userID,
+		Platform: platform,
+		RoomID:   roomID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Live room subscribed",
+		zap.Uint("user_id", userID),
+		zap.Uint("subscription_id", subscription.ID),
+		zap.String("platform", platform),
+		zap.String("room_id", roomID))
+
+	return subscription, nil
+}
+
+// ListSubscriptions 获取用户的订阅列表（带分页）
+func (s *liveSubscriptionService) ListSubscriptions(ctx context.Context, userID uint, page, limit int) ([]*entity.LiveSubscription, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	// 每页数量上限由调用方（处理器层的分页配置）控制
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	subscriptions, err := s.subscriptionRepo.List(ctx, userID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.subscriptionRepo.Count(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return subscriptions, total, nil
+}
+
+// Unsubscribe 取消用户的指定订阅，订阅不存在或属于其他用户时返回 ErrLiveSubscriptionNotFound
+func (s *liveSubscriptionService) Unsubscribe(ctx context.Context, userID, subscriptionID uint) error {
+	deleted, err := s.subscriptionRepo.Delete(ctx, userID, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrLiveSubscriptionNotFound
+	}
+
+	logger.Info("Live room unsubscribed",
+		zap.Uint("user_id", userID),
+		zap.Uint("subscription_id", subscriptionID))
+
+	return nil
+}
+
+// UnsubscribeAll 取消用户的所有订阅
+func (s *liveSubscriptionService) UnsubscribeAll(ctx context.Context, userID uint) (int, error) {
+	removed, err := s.subscriptionRepo.DeleteByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Info("All live rooms unsubscribed",
+		zap.Uint("user_id", userID),
+		zap.Int("count", removed))
+
+	return removed, nil
+}
+
+// ListSubscriptionStatuses 获取用户订阅的直播间及其当前状态
+func (s *liveSubscriptionService) ListSubscriptionStatuses(ctx context.Context, userID uint) ([]*LiveSubscriptionStatus, error) {
+	subscriptions, err := s.subscriptionRepo.List(ctx, userID, 0, maxSubscriptionStatuses)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*LiveSubscriptionStatus, len(subscriptions))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, subscriptionStatusConcurrency)
+	for i, subscription := range subscriptions {
+		statuses[i] = &LiveSubscriptionStatus{Subscription: subscription}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(status *LiveSubscriptionStatus) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			status.Room, status.CheckedAt, status.Err = s.getRoomStatus(ctx, status.Subscription.Platform, status.Subscription.RoomID)
+		}(statuses[i])
+	}
+	wg.Wait()
+
+	// 开播的在前、获取失败的在最后，同组内保持订阅时间倒序
+	slices.SortStableFunc(statuses, func(a, b *LiveSubscriptionStatus) int {
+		return subscriptionStatusRank(a) - subscriptionStatusRank(b)
+	})
+
+	return statuses, nil
+}
+
+// subscriptionStatusRank 汇总状态的排序分组：开播、未开播、获取失败
+func subscriptionStatusRank(status *LiveSubscriptionStatus) int {
+	switch {
+	case status.Err != nil:
+		return 2
+	case status.Room.Status == livestream.StreamStatusOnline:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// getRoomStatus 获取直播间状态，优先使用未过期的缓存
+func (s *liveSubscriptionService) getRoomStatus(ctx context.Context, platform, roomID string) (*livestream.RoomInfo, time.Time, error) {
+	key := platform + ":" + roomID
+	now := s.clock.Now()
+
+	if s.cacheTTL > 0 {
+		s.cacheMu.Lock()
+		cached, ok := s.roomCache[key]
+		s.cacheMu.Unlock()
+		if ok && now.Sub(cached.checkedAt) < s.cacheTTL {
+			room := cached.room
+			return &room, cached.checkedAt, nil
+		}
+	}
+
+	room, err := s.liveStreamService.GetRoomInfo(ctx, platform, roomID, false, "")
+	if err != nil {
+		logger.Warn("Failed to get subscribed live room status",
+			zap.String("platform", platform),
+			zap.String("room_id", roomID),
+			zap.Error(err))
+		return nil, now, err
+	}
+
+	if s.cacheTTL > 0 {
+		s.cacheMu.Lock()
+		if len(s.roomCache) >= roomStatusCacheMaxRooms {
+			s.roomCache = make(map[string]cachedRoomStatus)
+		}
+		s.roomCache[key] = cachedRoomStatus{room: *room, checkedAt: now}
+		s.cacheMu.Unlock()
+	}
+
+	return room, now, nil
+}
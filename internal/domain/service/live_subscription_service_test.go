@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/pkg/livestream"
+	"nebula-live/internal/testutil"
+)
+
+func TestLiveSubscriptionService_ListSubscriptionStatuses(t *testing.T) {
+	ctx := context.Background()
+
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	user, err := testutil.NewUserService(t, client, rbacService).CreateUser(ctx, "dave", "dave@example.com", "Password123!", "Dave")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	provider := testutil.NewFakeLiveStreamProvider("douyu")
+	provider.SetRoom(&livestream.RoomInfo{RoomID: "100", Status: livestream.StreamStatusOffline, Title: "未开播"})
+	provider.SetRoom(&livestream.RoomInfo{RoomID: "200", Status: livestream.StreamStatusOnline, Title: "直播中"})
+	provider.SetError("300", errors.New("upstream unavailable"))
+
+	liveClient := livestream.NewClient(livestream.ClientConfig{})
+	liveClient.RegisterProvider(provider)
+
+	subscriptionService := service.NewLiveSubscriptionService(
+		persistence.NewLiveSubscriptionRepository(client),
+		service.NewLiveStreamServiceWithClient(liveClient),
+		service.LiveSubscriptionServiceConfig{},
+	)
+
+	// 订阅顺序与期望的结果顺序不同，获取失败的房间最先订阅
+	for _, roomID := range []string{"300", "100", "200"} {
+		if _, err := subscriptionService.Subscribe(ctx, user.ID, "douyu", roomID); err != nil {
+			t.Fatalf("Subscribe(%s) error = %v", roomID, err)
+		}
+	}
+
+	statuses, err := subscriptionService.ListSubscriptionStatuses(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("ListSubscriptionStatuses() error = %v", err)
+	}
+	if len(statuses) != 3 {
+		t.Fatalf("got %d statuses, want 3", len(statuses))
+	}
+
+	wantRooms := []string{"200", "100", "300"}
+	for i, status := range statuses {
+		if status.Subscription.RoomID != wantRooms[i] {
+			t.Errorf("statuses[%d] room = %s, want %s", i, status.Subscription.RoomID, wantRooms[i])
+		}
+	}
+	if statuses[0].Err != nil || statuses[0].Room.Status != livestream.StreamStatusOnline || statuses[0].Room.Title != "直播中" {
+		t.Errorf("online room = %+v, err = %v", statuses[0].Room, statuses[0].Err)
+	}
+	if statuses[1].Err != nil || statuses[1].Room.Status != livestream.StreamStatusOffline {
+		t.Errorf("offline room = %+v, err = %v", statuses[1].Room, statuses[1].Err)
+	}
+	if statuses[2].Err == nil || statuses[2].Room != nil {
+		t.Errorf("failed room = %+v, err = %v; want error and no room info", statuses[2].Room, statuses[2].Err)
+	}
+
+	// 再次获取时成功的房间走缓存，失败的房间重新请求
+	calls := provider.RoomCalls()
+	if _, err := subscriptionService.ListSubscriptionStatuses(ctx, user.ID); err != nil {
+		t.Fatalf("ListSubscriptionStatuses() error = %v", err)
+	}
+	if got := provider.RoomCalls() - calls; got != 1 {
+		t.Errorf("second call made %d room requests, want 1 (only the failed room)", got)
+	}
+}
+
+func TestLiveSubscriptionService_SubscribeListAndClear(t *testing.T) {
+	ctx := context.Background()
+
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+	alice, err := userService.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	bob, err := userService.CreateUser(ctx, "bob", "bob@example.com", "Password123!", "Bob")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	liveClient := livestream.NewClient(livestream.ClientConfig{})
+	liveClient.RegisterProvider(testutil.NewFakeLiveStreamProvider("bilibili"))
+	subscriptionService := service.NewLiveSubscriptionService(
+		persistence.NewLiveSubscriptionRepository(client),
+		service.NewLiveStreamServiceWithClient(liveClient),
+		service.LiveSubscriptionServiceConfig{},
+	)
+
+	for _, roomID := range []string{"1", "2", "3"} {
+		if _, err := subscriptionService.Subscribe(ctx, alice.ID, "bilibili", roomID); err != nil {
+			t.Fatalf("Subscribe(%s) error = %v", roomID, err)
+		}
+	}
+
+	// 同一直播间只能订阅一次，别名和空白不会绕过检查
+	for _, platform := range []string{"bilibili", "bili", " BiliBili "} {
+		if _, err := subscriptionService.Subscribe(ctx, alice.ID, platform, " 1 "); !errors.Is(err, service.ErrLiveSubscriptionExists) {
+			t.Errorf("Subscribe(%q, 1) again error = %v, want ErrLiveSubscriptionExists", platform, err)
+		}
+	}
+	if _, err := subscriptionService.Subscribe(ctx, alice.ID, "unknown", "1"); !errors.Is(err, livestream.ErrPlatformNotFound) {
+		t.Errorf("Subscribe(unknown) error = %v, want ErrPlatformNotFound", err)
+	}
+	if _, err := subscriptionService.Subscribe(ctx, alice.ID, "bilibili", " "); !errors.Is(err, livestream.ErrInvalidRoomID) {
+		t.Errorf("Subscribe(empty room) error = %v, want ErrInvalidRoomID", err)
+	}
+
+	// 其他用户可以订阅同一直播间
+	bobSubscription, err := subscriptionService.Subscribe(ctx, bob.ID, "bili", "1")
+	if err != nil {
+		t.Fatalf("Subscribe() for another user error = %v", err)
+	}
+
+	// 分页列出，只包含当前用户的订阅
+	seen := map[string]bool{}
+	for page := 1; page <= 2; page++ {
+		subscriptions, total, err := subscriptionService.ListSubscriptions(ctx, alice.ID, page, 2)
+		if err != nil {
+			t.Fatalf("ListSubscriptions(page %d) error = %v", page, err)
+		}
+		if total != 3 {
+			t.Errorf("ListSubscriptions(page %d) total = %d, want 3", page, total)
+		}
+		if want := 3 - (page-1)*2; len(subscriptions) != min(want, 2) {
+			t.Errorf("ListSubscriptions(page %d) returned %d, want %d", page, len(subscriptions), min(want, 2))
+		}
+		for _, subscription := range subscriptions {
+			if subscription.UserID != alice.ID || subscription.Platform != "bilibili" {
+				t.Errorf("subscription = %+v, want alice's bilibili subscription", subscription)
+			}
+			seen[subscription.RoomID] = true
+		}
+	}
+	if len(seen) != 3 {
+		t.Errorf("listed rooms = %v, want 1, 2 and 3 once each", seen)
+	}
+
+	// 不能取消其他用户的订阅
+	if err := subscriptionService.Unsubscribe(ctx, alice.ID, bobSubscription.ID); !errors.Is(err, service.ErrLiveSubscriptionNotFound) {
+		t.Errorf("Unsubscribe() of another user's subscription error = %v, want ErrLiveSubscriptionNotFound", err)
+	}
+
+	removed, err := subscriptionService.UnsubscribeAll(ctx, alice.ID)
+	if err != nil || removed != 3 {
+		t.Fatalf("UnsubscribeAll() = %d, %v; want 3", removed, err)
+	}
+	if _, total, err := subscriptionService.ListSubscriptions(ctx, alice.ID, 1, 10); err != nil || total != 0 {
+		t.Errorf("after UnsubscribeAll() total = %d, %v; want 0", total, err)
+	}
+	if _, total, err := subscriptionService.ListSubscriptions(ctx, bob.ID, 1, 10); err != nil || total != 1 {
+		t.Errorf("bob's subscriptions after alice cleared hers = %d, %v; want 1", total, err)
+	}
+
+	// 清空后可以重新订阅
+	if _, err := subscriptionService.Subscribe(ctx, alice.ID, "bilibili", "1"); err != nil {
+		t.Errorf("Subscribe() after UnsubscribeAll() error = %v", err)
+	}
+	if removed, err := subscriptionService.UnsubscribeAll(ctx, bob.ID); err != nil || removed != 1 {
+		t.Errorf("UnsubscribeAll(bob) = %d, %v; want 1", removed, err)
+	}
+}
@@ -2,24 +2,37 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"nebula-live/internal/pkg/livestream"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
 )
 
 // LiveStreamService manages multiple live streaming platforms
 type LiveStreamService interface {
 	GetStreamStatus(ctx context.Context, platformName string, roomID string) (*livestream.StreamInfo, error)
-	GetRoomInfo(ctx context.Context, platformName string, roomID string) (*livestream.RoomInfo, error)
+	// GetRoomInfo gets room info, optionally with stream URLs in the given quality (empty for the best)
+	// when the room is online and the platform supports it
+	GetRoomInfo(ctx context.Context, platformName string, roomID string, includeStreams bool, quality string) (*livestream.RoomInfo, error)
 	GetSupportedPlatforms() []string
+	// ResolveRoomID resolves a short room ID to the real room ID on platforms that have short IDs
+	ResolveRoomID(ctx context.Context, platformName string, roomID string) (*livestream.ResolvedRoom, error)
 }
 
 type liveStreamService struct {
 	client *livestream.Client
 }
 
-func NewLiveStreamService() LiveStreamService {
+func NewLiveStreamService(config livestream.ClientConfig) LiveStreamService {
+	return NewLiveStreamServiceWithClient(livestream.NewClient(config))
+}
+
+// NewLiveStreamServiceWithClient creates the service on an existing client, e.g. one with fake providers registered in tests
+func NewLiveStreamServiceWithClient(client *livestream.Client) LiveStreamService {
 	return &liveStreamService{
-		client: livestream.NewClient(),
+		client: client,
 	}
 }
 
@@ -27,10 +40,39 @@ func (s *liveStreamService) GetStreamStatus(ctx context.Context, platformName st
 	return s.client.GetStreamStatus(ctx, platformName, roomID)
 }
 
-func (s *liveStreamService) GetRoomInfo(ctx context.Context, platformName string, roomID string) (*livestream.RoomInfo, error) {
-	return s.client.GetRoomInfo(ctx, platformName, roomID)
+func (s *liveStreamService) GetRoomInfo(ctx context.Context, platformName string, roomID string, includeStreams bool, quality string) (*livestream.RoomInfo, error) {
+	roomInfo, err := s.client.GetRoomInfo(ctx, platformName, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !includeStreams || roomInfo.Status != livestream.StreamStatusOnline {
+		return roomInfo, nil
+	}
+
+	// 获取播放地址失败不影响房间信息的返回，请求的清晰度无效时除外
+	streams, err := s.client.GetStreamURLs(ctx, platformName, roomID, quality)
+	if errors.Is(err, livestream.ErrInvalidQuality) {
+		return nil, err
+	}
+	if err != nil {
+		if !errors.Is(err, livestream.ErrNotSupported) {
+			logger.Warn("Failed to get stream URLs",
+				zap.String("platform", platformName),
+				zap.String("room_id", roomID),
+				zap.Error(err))
+		}
+		return roomInfo, nil
+	}
+
+	roomInfo.Streams = streams
+	return roomInfo, nil
 }
 
 func (s *liveStreamService) GetSupportedPlatforms() []string {
 	return s.client.GetSupportedPlatforms()
 }
+
+func (s *liveStreamService) ResolveRoomID(ctx context.Context, platformName string, roomID string) (*livestream.ResolvedRoom, error) {
+	return s.client.ResolveRoomID(ctx, platformName, roomID)
+}
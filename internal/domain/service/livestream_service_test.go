@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/pkg/livestream"
+	"nebula-live/internal/testutil"
+)
+
+// streamURLProvider 支持获取播放地址的假直播平台，记录请求的清晰度
+type streamURLProvider struct {
+	*testutil.FakeLiveStreamProvider
+	qualities []string
+}
+
+func (p *streamURLProvider) GetStreamURLs(ctx context.Context, roomID, quality string) ([]livestream.StreamURL, error) {
+	p.qualities = append(p.qualities, quality)
+	if quality == "bad" {
+		return nil, livestream.ErrInvalidQuality
+	}
+	return []livestream.StreamURL{{Quality: "原画", Format: "flv", URL: "https://example.com/" + roomID + ".flv"}}, nil
+}
+
+func TestLiveStreamService_GetRoomInfoIncludeStreams(t *testing.T) {
+	ctx := context.Background()
+	testutil.InitLogger()
+
+	bilibili := &streamURLProvider{FakeLiveStreamProvider: testutil.NewFakeLiveStreamProvider("bilibili")}
+	bilibili.SetRoom(&livestream.RoomInfo{RoomID: "5440", Status: livestream.StreamStatusOnline})
+	bilibili.SetRoom(&livestream.RoomInfo{RoomID: "100", Status: livestream.StreamStatusOffline})
+	douyu := testutil.NewFakeLiveStreamProvider("douyu")
+	douyu.SetRoom(&livestream.RoomInfo{RoomID: "9999", Status: livestream.StreamStatusOnline})
+
+	liveClient := livestream.NewClient(livestream.ClientConfig{})
+	liveClient.RegisterProvider(bilibili)
+	liveClient.RegisterProvider(douyu)
+	liveStreamService := service.NewLiveStreamServiceWithClient(liveClient)
+
+	room, err := liveStreamService.GetRoomInfo(ctx, "bilibili", "5440", true, "400")
+	if err != nil {
+		t.Fatalf("GetRoomInfo(include_streams) error = %v", err)
+	}
+	if len(room.Streams) != 1 || room.Streams[0].URL != "https://example.com/5440.flv" {
+		t.Errorf("streams = %+v, want the stream of room 5440", room.Streams)
+	}
+	if len(bilibili.qualities) != 1 || bilibili.qualities[0] != "400" {
+		t.Errorf("requested qualities = %v, want [400]", bilibili.qualities)
+	}
+
+	// 未请求播放地址或未开播时不获取播放地址
+	for _, roomID := range []string{"5440", "100"} {
+		includeStreams := roomID == "100"
+		room, err := liveStreamService.GetRoomInfo(ctx, "bilibili", roomID, includeStreams, "")
+		if err != nil || room.Streams != nil {
+			t.Errorf("GetRoomInfo(%s, include_streams=%v) streams = %+v, err = %v; want none", roomID, includeStreams, room.Streams, err)
+		}
+	}
+	if len(bilibili.qualities) != 1 {
+		t.Errorf("stream URL requests = %d, want 1", len(bilibili.qualities))
+	}
+
+	// 不支持获取播放地址的平台仍返回房间信息
+	room, err = liveStreamService.GetRoomInfo(ctx, "douyu", "9999", true, "")
+	if err != nil {
+		t.Fatalf("GetRoomInfo(douyu, include_streams) error = %v", err)
+	}
+	if room.Status != livestream.StreamStatusOnline || room.Streams != nil {
+		t.Errorf("douyu room = %+v, want online without streams", room)
+	}
+
+	if _, err := liveStreamService.GetRoomInfo(ctx, "bilibili", "5440", true, "bad"); !errors.Is(err, livestream.ErrInvalidQuality) {
+		t.Errorf("GetRoomInfo(invalid quality) error = %v, want ErrInvalidQuality", err)
+	}
+}
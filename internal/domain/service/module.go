@@ -7,8 +7,17 @@ var ServiceModule = fx.Options(
 	fx.Provide(
 		NewUserService,
 		NewRBACService,
+		NewPermissionCache,
 		NewLiveStreamService,
+		NewLiveSubscriptionService,
 		NewUserPushSettingService,
 		NewPushService,
+		NewScheduledPushService,
+		NewRecurringPushService,
+		NewSessionService,
+		NewWebhookService,
+		NewJobService,
 	),
+	fx.Invoke(RegisterEventSubscribers),
+	fx.Invoke(RegisterJobHandlers),
 )
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"nebula-live/internal/domain/event"
+	"nebula-live/internal/pkg/eventbus"
+	"nebula-live/pkg/clock"
+)
+
+// permissionCacheMaxUsers 缓存的用户数上限，超出时清空重建，避免长时间运行后无限增长
+const permissionCacheMaxUsers = 10000
+
+// PermissionCacheConfig 权限缓存配置
+type PermissionCacheConfig struct {
+	// TTL 缓存的权限检查结果有效期，为0时不缓存
+	TTL time.Duration
+	// Clock 缓存有效期使用的时间来源，为nil时使用系统时间
+	Clock clock.Clock
+}
+
+// permissionCacheEntry 单个用户已检查过的权限结果
+type permissionCacheEntry struct {
+	expiresAt time.Time
+	decisions map[string]bool
+}
+
+// PermissionCache 缓存用户的权限检查结果，供中间件在每个请求中使用
+//
+// 角色或权限分配变更时RBAC服务发布 PermissionsChanged 事件，缓存同步订阅该事件并立即失效，
+// 因此本实例的变更在下一个请求即生效。其他实例的变更和角色到期在TTL内生效。
+type PermissionCache struct {
+	rbacService RBACService
+	ttl         time.Duration
+	clock       clock.Clock
+
+	mu      sync.Mutex
+	entries map[uint]*permissionCacheEntry
+	// generation 每次失效时递增，查询期间发生失效时不写入缓存，避免写回过期的结果
+	generation uint64
+}
+
+// NewPermissionCache 创建权限缓存
+func NewPermissionCache(rbacService RBACService, config PermissionCacheConfig) *PermissionCache {
+	return &PermissionCache{
+		rbacService: rbacService,
+		ttl:         config.TTL,
+		clock:       clock.OrReal(config.Clock),
+		entries:     make(map[uint]*permissionCacheEntry),
+	}
+}
+
+// HasPermission 检查用户是否拥有指定权限，优先使用缓存的结果
+func (c *PermissionCache) HasPermission(ctx context.Context, userID uint, resource, action string) (bool, error) {
+	if c.ttl <= 0 {
+		return c.rbacService.HasPermission(ctx, userID, resource, action)
+	}
+
+	key := resource + ":" + action
+	now := c.clock.Now()
+
+	c.mu.Lock()
+	if entry, ok := c.entries[userID]; ok && now.Before(entry.expiresAt) {
+		if allowed, ok := entry.decisions[key]; ok {
+			c.mu.Unlock()
+			return allowed, nil
+		}
+	}
+	generation := c.generation
+	c.mu.Unlock()
+
+	allowed, err := c.rbacService.HasPermission(ctx, userID, resource, action)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return allowed, nil
+	}
+
+	entry, ok := c.entries[userID]
+	if !ok || !now.Before(entry.expiresAt) {
+		if !ok && len(c.entries) >= permissionCacheMaxUsers {
+			c.entries = make(map[uint]*permissionCacheEntry)
+		}
+		entry = &permissionCacheEntry{
+			expiresAt: now.Add(c.ttl),
+			decisions: make(map[string]bool),
+		}
+		c.entries[userID] = entry
+	}
+	entry.decisions[key] = allowed
+
+	return allowed, nil
+}
+
+// InvalidateUser 清除指定用户缓存的权限检查结果
+func (c *PermissionCache) InvalidateUser(userID uint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, userID)
+	c.generation++
+}
+
+// InvalidateAll 清除所有用户缓存的权限检查结果
+func (c *PermissionCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[uint]*permissionCacheEntry)
+	c.generation++
+}
+
+// HandleEvent 处理权限变更事件，UserID为0时清除所有用户的缓存
+func (c *PermissionCache) HandleEvent(_ context.Context, e eventbus.Event) error {
+	changed, ok := e.(event.PermissionsChanged)
+	if !ok {
+		return nil
+	}
+
+	if changed.UserID == 0 {
+		c.InvalidateAll()
+	} else {
+		c.InvalidateUser(changed.UserID)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"nebula-live/internal/pkg/push"
+)
+
+// JobTypeSendToUserDevices 发送推送到用户所有启用设备的异步任务类型
+const JobTypeSendToUserDevices = "push.send_to_user_devices"
+
+// SendToUserDevicesPayload 发送到用户所有设备任务的参数
+type SendToUserDevicesPayload struct {
+	UserID  uint             `json:"user_id"`
+	Message push.PushMessage `json:"message"`
+}
+
+// SendToUserDevicesResult 发送到用户所有设备任务的结果
+type SendToUserDevicesResult struct {
+	TotalDevices int `json:"total_devices"`
+	SuccessCount int `json:"success_count"`
+	FailedCount  int `json:"failed_count"`
+	// Duplicate 相同内容刚在去重窗口内发送过（例如重复提交），本次未发送
+	Duplicate bool `json:"duplicate,omitempty"`
+}
+
+// RegisterJobHandlers 注册各服务的异步任务处理函数，需要在任务工作器启动前完成
+func RegisterJobHandlers(jobService JobService, pushService PushService) {
+	// 推送无法判断中断前已经送达了哪些设备，中断后不重新执行，避免重复送达
+	jobService.RegisterOnceHandler(JobTypeSendToUserDevices, sendToUserDevicesJobHandler(pushService))
+}
+
+// sendToUserDevicesJobHandler 执行发送到用户所有设备的任务
+//
+// 任务被重启中断后标记为失败，不会重新执行。去重窗口内提交的相同内容不会再次发送，结果标记为Duplicate。
+func sendToUserDevicesJobHandler(pushService PushService) JobHandlerFunc {
+	return func(ctx context.Context, payload json.RawMessage) (interface{}, error) {
+		var params SendToUserDevicesPayload
+		if err := json.Unmarshal(payload, &params); err != nil {
+			return nil, fmt.Errorf("decode payload: %w", err)
+		}
+
+		responses, err := pushService.SendToUserDevices(ctx, params.UserID, &params.Message)
+		if errors.Is(err, ErrDuplicatePush) {
+			return SendToUserDevicesResult{Duplicate: true}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result := SendToUserDevicesResult{TotalDevices: len(responses)}
+		for _, response := range responses {
+			if response.Success {
+				result.SuccessCount++
+			}
+		}
+		result.FailedCount = result.TotalDevices - result.SuccessCount
+
+		return result, nil
+	}
+}
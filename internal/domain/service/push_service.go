@@ -2,10 +2,21 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/event"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/pkg/eventbus"
+	"nebula-live/internal/pkg/httpproxy"
+	"nebula-live/internal/pkg/lock"
 	"nebula-live/internal/pkg/push"
+	"nebula-live/pkg/clock"
 	"nebula-live/pkg/logger"
 
 	"go.uber.org/zap"
@@ -15,6 +26,16 @@ import (
 var (
 	ErrPushServiceUnavailable = errors.New("push service is unavailable")
 	ErrInvalidPushProvider    = errors.New("invalid push provider")
+	ErrDuplicatePush          = errors.New("duplicate push notification within dedup window")
+)
+
+const (
+	// providerHealthTimeout 单个推送提供商可达性检查的超时时间
+	providerHealthTimeout = 5 * time.Second
+	// providerHealthCacheTTL 可达性检查结果的缓存时间，避免频繁请求上游
+	providerHealthCacheTTL = 30 * time.Second
+	// maxCachedPushClients 缓存的推送客户端数量上限，请求可以指定任意服务器，需要限制缓存大小
+	maxCachedPushClients = 64
 )
 
 // PushService defines the interface for push notification service
@@ -24,18 +45,167 @@ type PushService interface {
 	
 	// SendToUserDevicesByProvider sends push notifications to user devices of specific provider
 	SendToUserDevicesByProvider(ctx context.Context, userID uint, provider string, message *push.PushMessage) ([]*push.PushResponse, error)
+
+	// GetProviderCapabilities returns the capabilities of all supported push providers
+	GetProviderCapabilities() []push.Capabilities
+
+	// GetProviderCapability returns the capabilities of a single push provider
+	GetProviderCapability(provider string) (push.Capabilities, error)
+
+	// CheckProviderHealth returns the reachability of all enabled push providers, cached briefly
+	CheckProviderHealth(ctx context.Context) []push.ProviderHealth
+
+	// PreviewUserDevices resolves the message for every enabled device of a user as it would be sent, without sending
+	PreviewUserDevices(ctx context.Context, userID uint, message *push.PushMessage) ([]*PushPreview, error)
+
+	// ValidateURL checks that a message URL uses an allowed scheme, returning push.ErrURLSchemeNotAllowed otherwise
+	ValidateURL(rawURL string) error
+}
+
+// PushPreview is the message a device would receive after its settings, the user defaults,
+// the provider's length limits and field support are applied
+type PushPreview struct {
+	SettingID  uint
+	DeviceName string
+	Provider   string
+	// Endpoint is the provider server the message would be posted to
+	Endpoint string
+	Message  push.PushMessage
+	// Error explains why the device would not receive the message, e.g. a length limit under the reject policy
+	Error string
+}
+
+// PushServiceConfig holds the options of the push service
+type PushServiceConfig struct {
+	// FailureThreshold disables a device after this many consecutive failed pushes, 0 keeps it enabled
+	FailureThreshold int
+	// BarkBaseURL is the default Bark server for devices without a custom server, empty means DefaultBarkBaseURL
+	BarkBaseURL string
+	// BarkDisabled turns off delivery through Bark, sends fail with push.ErrProviderNotEnabled
+	BarkDisabled bool
+	// Email is the SMTP server used by the email provider, which is disabled unless configured
+	Email push.SMTPConfig
+	// Proxy routes requests to push providers through an upstream proxy when set
+	Proxy httpproxy.Config
+	// DedupWindow suppresses a send whose title and body match one delivered to the same user within this window, 0 disables it.
+	// Unlike client-supplied idempotency keys this is content based and also catches repeated triggers from monitors.
+	DedupWindow time.Duration
+	// DedupLocker stores the dedup window in Redis so it is shared by all replicas and survives restarts,
+	// nil keeps it in process memory, which is only suitable for a single replica
+	DedupLocker *lock.Locker
+	// TestMode logs composed messages and returns synthetic successes instead of calling providers
+	TestMode bool
+	// CaptureRawResponse keeps the upstream response body in push.PushResponse.RawResponse for debugging
+	CaptureRawResponse bool
+	// LengthLimits caps title and body length per provider name, applied to each device at send time
+	LengthLimits map[string]push.LengthLimits
+	// AllowedURLSchemes lists the schemes a message URL may use, empty means push.DefaultAllowedURLSchemes
+	AllowedURLSchemes []string
+	// Clock is the time source for the dedup window, the health cache and event timestamps, nil means the system clock
+	Clock clock.Clock
 }
 
 // pushService implements PushService
 type pushService struct {
 	userPushSettingService UserPushSettingService
+	userPushSettingRepo    repository.UserPushSettingRepository
+	userRepo               repository.UserRepository
+	config                 PushServiceConfig
+	registry               *push.Client
+	eventBus               *eventbus.Bus
+	clock                  clock.Clock
+
+	healthMu        sync.Mutex
+	healthCache     []push.ProviderHealth
+	healthCheckedAt time.Time
+
+	// recentSent tracks the dedup window in memory when no DedupLocker is configured
+	dedupMu    sync.Mutex
+	recentSent map[string]time.Time
+
+	// clients caches push clients by provider and server
+	clientsMu sync.Mutex
+	clients   map[string]*push.Client
 }
 
 // NewPushService creates a new push service
-func NewPushService(userPushSettingService UserPushSettingService) PushService {
+func NewPushService(
+	userPushSettingService UserPushSettingService,
+	userPushSettingRepo repository.UserPushSettingRepository,
+	userRepo repository.UserRepository,
+	eventBus *eventbus.Bus,
+	config PushServiceConfig,
+) PushService {
 	return &pushService{
 		userPushSettingService: userPushSettingService,
+		userPushSettingRepo:    userPushSettingRepo,
+		userRepo:               userRepo,
+		config:                 config,
+		eventBus:               eventBus,
+		clock:                  clock.OrReal(config.Clock),
+		recentSent:             make(map[string]time.Time),
+		clients:                make(map[string]*push.Client),
+		// registry holds one instance of every provider with its default upstream,
+		// used to list capabilities and check reachability
+		registry: push.NewClient(push.ClientConfig{
+			Bark:  push.BarkConfig{Enabled: !config.BarkDisabled, BaseURL: config.BarkBaseURL, CaptureRawResponse: config.CaptureRawResponse},
+			Email: config.Email,
+			Proxy: config.Proxy,
+		}),
+	}
+}
+
+// ValidateURL checks that a message URL uses an allowed scheme, an empty URL is always valid
+func (s *pushService) ValidateURL(rawURL string) error {
+	if rawURL == "" {
+		return nil
+	}
+	return push.ValidateURLScheme(rawURL, s.config.AllowedURLSchemes)
+}
+
+// GetProviderCapabilities returns the capabilities of all supported push providers
+func (s *pushService) GetProviderCapabilities() []push.Capabilities {
+	return s.registry.GetProviderCapabilities()
+}
+
+// GetProviderCapability returns the capabilities of a single push provider
+func (s *pushService) GetProviderCapability(provider string) (push.Capabilities, error) {
+	capability, ok := s.registry.GetProviderCapability(provider)
+	if !ok {
+		return push.Capabilities{}, ErrInvalidPushProvider
+	}
+	return capability, nil
+}
+
+// CheckProviderHealth returns the reachability of all enabled push providers, cached briefly
+func (s *pushService) CheckProviderHealth(ctx context.Context) []push.ProviderHealth {
+	// 持有锁期间检查，并发请求会等待同一次检查结果而不是重复请求上游
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+
+	if s.healthCache != nil && s.clock.Now().Sub(s.healthCheckedAt) < providerHealthCacheTTL {
+		return s.healthCache
+	}
+
+	results := s.registry.CheckHealth(ctx, providerHealthTimeout)
+
+	// 调用方已取消或超时时，不可达只反映本次请求，不写入缓存以免影响其他请求
+	if ctx.Err() != nil {
+		return results
+	}
+
+	for _, result := range results {
+		if !result.Reachable {
+			logger.Warn("Push provider is unreachable",
+				zap.String("provider", result.Provider),
+				zap.String("error", result.Error))
+		}
 	}
+
+	s.healthCache = results
+	s.healthCheckedAt = s.clock.Now()
+
+	return results
 }
 
 
@@ -50,6 +220,10 @@ func (s *pushService) SendToUserDevices(ctx context.Context, userID uint, messag
 		return nil, ErrPushServiceUnavailable
 	}
 
+	if err := s.ValidateURL(message.URL); err != nil {
+		return nil, err
+	}
+
 	// 获取用户的所有启用推送设置
 	userSettings, err := s.userPushSettingService.GetEnabledUserSettings(ctx, userID)
 	if err != nil {
@@ -65,52 +239,17 @@ func (s *pushService) SendToUserDevices(ctx context.Context, userID uint, messag
 		return []*push.PushResponse{}, nil
 	}
 
-	var responses []*push.PushResponse
-	
-	for _, setting := range userSettings {
-		// 创建消息副本并应用用户设置
-		userMessage := *message
-		userMessage.DeviceID = setting.DeviceID
-		
-		// 应用用户特定设置
-		if err := s.applyUserSettings(setting, &userMessage); err != nil {
-			logger.Error("Failed to apply user settings",
-				zap.Uint("user_id", userID),
-				zap.Uint("setting_id", setting.ID),
-				zap.Error(err))
-			continue
-		}
+	releaseDedup, ok := s.claimDedup(ctx, userID, "", message)
+	if !ok {
+		logger.Info("Duplicate push notification suppressed",
+			zap.Uint("user_id", userID),
+			zap.String("title", message.Title))
+		return nil, ErrDuplicatePush
+	}
 
-		// 基于用户设置创建推送客户端
-		pushClient, err := s.createPushClientForSetting(setting)
-		if err != nil {
-			logger.Error("Failed to create push client for setting",
-				zap.Uint("user_id", userID),
-				zap.Uint("setting_id", setting.ID),
-				zap.Error(err))
-			continue
-		}
+	responses := s.fanOut(ctx, userID, userSettings, message)
 
-		// 发送推送通知
-		response, err := pushClient.SendMessage(ctx, setting.Provider, &userMessage)
-		if err != nil {
-			logger.Error("Failed to send push notification to user device",
-				zap.Uint("user_id", userID),
-				zap.String("provider", setting.Provider),
-				zap.String("device_id", setting.DeviceID),
-				zap.Error(err))
-			// 创建错误响应
-			response = &push.PushResponse{
-				Success:  false,
-				Error:    err.Error(),
-				Provider: setting.Provider,
-			}
-		}
-		
-		if response != nil {
-			responses = append(responses, response)
-		}
-	}
+	releaseDedupUnlessDelivered(releaseDedup, responses)
 
 	logger.Info("User push notification batch completed",
 		zap.Uint("user_id", userID),
@@ -132,6 +271,18 @@ func (s *pushService) SendToUserDevicesByProvider(ctx context.Context, userID ui
 		return nil, ErrPushServiceUnavailable
 	}
 
+	if err := s.ValidateURL(message.URL); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkProvider(provider); err != nil {
+		logger.Warn("Push provider is not available",
+			zap.Uint("user_id", userID),
+			zap.String("provider", provider),
+			zap.Error(err))
+		return nil, err
+	}
+
 	// 获取用户指定提供商的启用推送设置
 	userSettings, err := s.userPushSettingService.GetEnabledUserSettingsByProvider(ctx, userID, provider)
 	if err != nil {
@@ -149,39 +300,81 @@ func (s *pushService) SendToUserDevicesByProvider(ctx context.Context, userID ui
 		return []*push.PushResponse{}, nil
 	}
 
+	releaseDedup, ok := s.claimDedup(ctx, userID, provider, message)
+	if !ok {
+		logger.Info("Duplicate push notification suppressed",
+			zap.Uint("user_id", userID),
+			zap.String("title", message.Title))
+		return nil, ErrDuplicatePush
+	}
+
+	responses := s.fanOut(ctx, userID, userSettings, message)
+
+	releaseDedupUnlessDelivered(releaseDedup, responses)
+
+	logger.Info("User push notification batch by provider completed",
+		zap.Uint("user_id", userID),
+		zap.String("provider", provider),
+		zap.Int("total_devices", len(userSettings)),
+		zap.Int("responses", len(responses)))
+
+	return responses, nil
+}
+
+// fanOut sends the message to each device with its settings and the user defaults applied.
+// Once ctx is done no further device is sent to, the remaining ones get cancelled responses.
+// Every attempted delivery is recorded and published.
+func (s *pushService) fanOut(ctx context.Context, userID uint, settings []*entity.UserPushSetting, message *push.PushMessage) []*push.PushResponse {
+	preferences := s.getUserPushPreferences(ctx, userID)
+
 	var responses []*push.PushResponse
 
-	for _, setting := range userSettings {
+	for i, setting := range settings {
+		// 请求已取消时不再发送，剩余设备标记为已取消
+		if ctx.Err() != nil {
+			logger.Warn("Push fan-out cancelled",
+				zap.Uint("user_id", userID),
+				zap.Int("skipped_devices", len(settings)-i),
+				zap.Error(ctx.Err()))
+			responses = append(responses, cancelledResponses(settings[i:], ctx.Err())...)
+			break
+		}
+
 		// 创建消息副本并应用用户设置
 		userMessage := *message
 		userMessage.DeviceID = setting.DeviceID
-		
+
 		// 应用用户特定设置
 		if err := s.applyUserSettings(setting, &userMessage); err != nil {
 			logger.Error("Failed to apply user settings",
 				zap.Uint("user_id", userID),
 				zap.Uint("setting_id", setting.ID),
 				zap.Error(err))
+			responses = append(responses, setupFailedResponse(setting, err))
 			continue
 		}
+		s.applyUserPreferences(preferences, &userMessage)
 
 		// 基于用户设置创建推送客户端
-		pushClient, err := s.createPushClientForSetting(setting)
+		pushClient, err := s.createPushClientForSetting(setting, message.ServerURL)
 		if err != nil {
 			logger.Error("Failed to create push client for setting",
 				zap.Uint("user_id", userID),
 				zap.Uint("setting_id", setting.ID),
+				zap.String("provider", setting.Provider),
 				zap.Error(err))
+			// 不支持的提供商等配置问题计为失败，避免调用方误以为没有可发送的设备
+			responses = append(responses, setupFailedResponse(setting, err))
 			continue
 		}
 
 		// 发送推送通知
-		response, err := pushClient.SendMessage(ctx, setting.Provider, &userMessage)
+		response, err := s.send(ctx, pushClient, setting.Provider, &userMessage)
 		if err != nil {
 			logger.Error("Failed to send push notification to user device",
 				zap.Uint("user_id", userID),
 				zap.String("provider", setting.Provider),
-				zap.String("device_id", setting.DeviceID),
+				zap.String("device_id", push.MaskDeviceID(setting.DeviceID)),
 				zap.Error(err))
 			// 创建错误响应
 			response = &push.PushResponse{
@@ -190,49 +383,312 @@ func (s *pushService) SendToUserDevicesByProvider(ctx context.Context, userID ui
 				Provider: setting.Provider,
 			}
 		}
-		
+		// 发送过程中请求被取消导致的失败不是设备的问题
+		if response != nil && !response.Success && ctx.Err() != nil {
+			response.Cancelled = true
+		}
+		// 已发出的推送结果即使请求随后取消也需要记录
+		s.recordDeliveryResult(context.WithoutCancel(ctx), setting, response)
+		s.publishPushSent(userID, setting, response)
+
 		if response != nil {
 			responses = append(responses, response)
 		}
 	}
 
-	logger.Info("User push notification batch by provider completed",
-		zap.Uint("user_id", userID),
-		zap.String("provider", provider),
-		zap.Int("total_devices", len(userSettings)),
-		zap.Int("responses", len(responses)))
+	return responses
+}
 
-	return responses, nil
+// cancelledResponses builds the responses of devices skipped because the context was cancelled
+func cancelledResponses(settings []*entity.UserPushSetting, err error) []*push.PushResponse {
+	responses := make([]*push.PushResponse, len(settings))
+	for i, setting := range settings {
+		responses[i] = &push.PushResponse{
+			Success:   false,
+			Error:     err.Error(),
+			Provider:  setting.Provider,
+			Cancelled: true,
+		}
+	}
+	return responses
+}
+
+// setupFailedResponse builds the response of a device that could not be sent to
+// because its setting is unusable, e.g. an unsupported provider or invalid settings
+func setupFailedResponse(setting *entity.UserPushSetting, err error) *push.PushResponse {
+	return &push.PushResponse{
+		Success:  false,
+		Error:    err.Error(),
+		Provider: setting.Provider,
+	}
+}
+
+// PreviewUserDevices resolves the message per enabled device the same way SendToUserDevices does,
+// skipping deduplication and the actual send
+func (s *pushService) PreviewUserDevices(ctx context.Context, userID uint, message *push.PushMessage) ([]*PushPreview, error) {
+	if s.userPushSettingService == nil {
+		return nil, ErrPushServiceUnavailable
+	}
+
+	if err := s.ValidateURL(message.URL); err != nil {
+		return nil, err
+	}
+
+	userSettings, err := s.userPushSettingService.GetEnabledUserSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := s.getUserPushPreferences(ctx, userID)
+
+	previews := make([]*PushPreview, 0, len(userSettings))
+	for _, setting := range userSettings {
+		preview := &PushPreview{
+			SettingID:  setting.ID,
+			DeviceName: setting.DeviceName,
+			Provider:   setting.Provider,
+		}
+		previews = append(previews, preview)
+
+		userMessage := *message
+		userMessage.DeviceID = setting.DeviceID
+		if err := s.applyUserSettings(setting, &userMessage); err != nil {
+			preview.Error = err.Error()
+			continue
+		}
+		s.applyUserPreferences(preferences, &userMessage)
+
+		endpoint, err := s.resolveEndpoint(setting, message.ServerURL)
+		if err != nil {
+			preview.Error = err.Error()
+			continue
+		}
+		preview.Endpoint = endpoint
+
+		if limits, ok := s.config.LengthLimits[setting.Provider]; ok {
+			if err := limits.Apply(&userMessage); err != nil {
+				preview.Error = err.Error()
+			}
+		}
+		if capability, ok := s.registry.GetProviderCapability(setting.Provider); ok {
+			userMessage = *push.AdaptMessage(capability, &userMessage)
+		}
+		// ServerURL is reported as Endpoint
+		userMessage.ServerURL = ""
+		preview.Message = userMessage
+	}
+
+	return previews, nil
 }
 
-// createPushClientForSetting creates a push client based on user setting
-func (s *pushService) createPushClientForSetting(setting *entity.UserPushSetting) (*push.Client, error) {
+// resolveEndpoint returns the provider server a message to the device is posted to
+func (s *pushService) resolveEndpoint(setting *entity.UserPushSetting, serverURL string) (string, error) {
 	switch setting.Provider {
 	case "bark":
 		barkSettings, err := setting.GetBarkSettings()
 		if err != nil {
-			return nil, err
+			return "", err
 		}
-		
-		// 创建Bark配置
-		barkConfig := push.BarkConfig{
-			BaseURL: "https://api.day.app", // 默认服务器
-			Enabled: true,
+
+		var deviceURL string
+		if barkSettings != nil {
+			deviceURL = barkSettings.BaseURL
 		}
-		
-		// 如果用户设置了自定义服务器
-		if barkSettings != nil && barkSettings.BaseURL != "" {
-			barkConfig.BaseURL = barkSettings.BaseURL
+		return resolveBarkBaseURL(serverURL, deviceURL, s.config.BarkBaseURL), nil
+	case "email":
+		return s.emailEndpoint(), nil
+	default:
+		return "", fmt.Errorf("%w: %s", push.ErrProviderNotFound, setting.Provider)
+	}
+}
+
+// send delivers the message through the provider. In test mode the message is adapted
+// and logged as it would be sent, and a synthetic success is returned without calling the provider.
+// The provider's length limits are applied to a copy first, so other devices of a fan-out are unaffected;
+// a message rejected by them gets a failed response marked Rejected.
+func (s *pushService) send(ctx context.Context, pushClient *push.Client, provider string, message *push.PushMessage) (*push.PushResponse, error) {
+	if limits, ok := s.config.LengthLimits[provider]; ok {
+		limited := *message
+		if err := limits.Apply(&limited); err != nil {
+			return &push.PushResponse{
+				Success:  false,
+				Error:    err.Error(),
+				Provider: provider,
+				Rejected: true,
+			}, nil
+		}
+		message = &limited
+	}
+
+	if !s.config.TestMode {
+		return pushClient.SendMessage(ctx, provider, message)
+	}
+
+	if capability, ok := s.registry.GetProviderCapability(provider); ok {
+		message = push.AdaptMessage(capability, message)
+	}
+	logged := *message
+	logged.DeviceID = push.MaskDeviceID(logged.DeviceID)
+	logger.Info("Push test mode, message not sent",
+		zap.String("provider", provider),
+		zap.Any("message", logged))
+
+	return &push.PushResponse{
+		Success:   true,
+		MessageID: "test-mode",
+		Provider:  provider,
+		TestMode:  true,
+	}, nil
+}
+
+// resolveBarkBaseURL picks the Bark server by precedence:
+// request override > device setting > configured default > DefaultBarkBaseURL
+func resolveBarkBaseURL(requestURL, deviceURL, defaultURL string) string {
+	for _, baseURL := range []string{requestURL, deviceURL, defaultURL} {
+		if baseURL != "" {
+			return baseURL
+		}
+	}
+	return push.DefaultBarkBaseURL
+}
+
+// emailEndpoint returns the SMTP server of the email provider in URL form
+func (s *pushService) emailEndpoint() string {
+	if s.config.Email.Port == 0 {
+		return "smtp://" + s.config.Email.Host
+	}
+	return fmt.Sprintf("smtp://%s:%d", s.config.Email.Host, s.config.Email.Port)
+}
+
+// createPushClientForSetting returns the push client for a user setting,
+// serverURL overrides the server of the setting when not empty
+func (s *pushService) createPushClientForSetting(setting *entity.UserPushSetting, serverURL string) (*push.Client, error) {
+	switch setting.Provider {
+	case "bark":
+		// 服务器按请求 > 设备设置 > 配置默认值 > day.app 的优先级选择
+		baseURL, err := s.resolveEndpoint(setting, serverURL)
+		if err != nil {
+			return nil, err
 		}
 		
 		clientConfig := push.ClientConfig{
-			Bark: barkConfig,
+			Bark: push.BarkConfig{
+				BaseURL:            baseURL,
+				Enabled:            !s.config.BarkDisabled,
+				CaptureRawResponse: s.config.CaptureRawResponse,
+			},
+			Proxy: s.config.Proxy,
 		}
 		
-		return push.NewClient(clientConfig), nil
+		return s.cachedPushClient(setting.Provider, baseURL, clientConfig), nil
+	case "email":
+		// 邮件通过配置的SMTP服务器发送，不支持按请求或设备指定服务器
+		clientConfig := push.ClientConfig{
+			Email: s.config.Email,
+			Proxy: s.config.Proxy,
+		}
+
+		return s.cachedPushClient(setting.Provider, s.emailEndpoint(), clientConfig), nil
 	default:
-		return nil, errors.New("unsupported push provider: " + setting.Provider)
+		return nil, fmt.Errorf("%w: %s", push.ErrProviderNotFound, setting.Provider)
+	}
+}
+
+// cachedPushClient returns the client for provider and baseURL, creating it on first use.
+// Every client holds its own HTTP client, so reusing them avoids a new connection pool per send.
+// Once maxCachedPushClients is reached new servers get an uncached client.
+func (s *pushService) cachedPushClient(provider, baseURL string, config push.ClientConfig) *push.Client {
+	key := provider + "|" + baseURL
+
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+
+	if client, ok := s.clients[key]; ok {
+		return client
+	}
+
+	client := push.NewClient(config)
+	if len(s.clients) < maxCachedPushClients {
+		s.clients[key] = client
+	}
+	return client
+}
+
+// claimDedup reserves the content of the message for the user and provider during the dedup window,
+// ok is false when the same title and body were already sent within it. An empty provider means all of the user's devices.
+// release forgets the claim. When Redis cannot be reached the message is sent rather than dropped.
+func (s *pushService) claimDedup(ctx context.Context, userID uint, provider string, message *push.PushMessage) (release func(), ok bool) {
+	if s.config.DedupWindow <= 0 {
+		return func() {}, true
+	}
+
+	if provider == "" {
+		provider = "*"
+	}
+	sum := sha256.Sum256([]byte(message.Title + "\x00" + message.Body))
+	key := fmt.Sprintf("push-dedup:%d:%s:%s", userID, provider, hex.EncodeToString(sum[:]))
+
+	if s.config.DedupLocker != nil {
+		// SET NX with the window as TTL, the key expires on its own when the window ends
+		claim, err := s.config.DedupLocker.TryAcquire(ctx, key, s.config.DedupWindow)
+		if errors.Is(err, lock.ErrNotAcquired) {
+			return nil, false
+		}
+		if err != nil {
+			logger.Warn("Push dedup check failed, sending without dedup",
+				zap.Uint("user_id", userID),
+				zap.Error(err))
+			return func() {}, true
+		}
+		return func() {
+			if err := claim.Release(context.WithoutCancel(ctx)); err != nil && !errors.Is(err, lock.ErrLockLost) {
+				logger.Warn("Failed to release push dedup claim", zap.String("key", claim.Key()), zap.Error(err))
+			}
+		}, true
+	}
+
+	now := s.clock.Now()
+
+	s.dedupMu.Lock()
+	defer s.dedupMu.Unlock()
+
+	for k, sentAt := range s.recentSent {
+		if now.Sub(sentAt) >= s.config.DedupWindow {
+			delete(s.recentSent, k)
+		}
+	}
+
+	if _, exists := s.recentSent[key]; exists {
+		return nil, false
+	}
+	s.recentSent[key] = now
+	return func() {
+		s.dedupMu.Lock()
+		delete(s.recentSent, key)
+		s.dedupMu.Unlock()
+	}, true
+}
+
+// releaseDedupUnlessDelivered forgets the claimed content when no device received it, so an immediate retry is not suppressed
+func releaseDedupUnlessDelivered(release func(), responses []*push.PushResponse) {
+	for _, response := range responses {
+		if response.Success {
+			return
+		}
 	}
+	release()
+}
+
+// checkProvider reports whether the provider can deliver messages,
+// returning push.ErrProviderNotFound for unknown providers and push.ErrProviderNotEnabled for disabled ones
+func (s *pushService) checkProvider(provider string) error {
+	if _, ok := s.registry.GetProviderCapability(provider); !ok {
+		return fmt.Errorf("%w: %s", push.ErrProviderNotFound, provider)
+	}
+	if !s.registry.IsProviderEnabled(provider) {
+		return fmt.Errorf("%w: %s", push.ErrProviderNotEnabled, provider)
+	}
+	return nil
 }
 
 // applyUserSettings applies user-specific settings to the push message
@@ -267,3 +723,138 @@ func (s *pushService) applyUserSettings(setting *entity.UserPushSetting, message
 	}
 	return nil
 }
+
+// getUserPushPreferences loads the user-level push defaults, a lookup failure only skips the defaults
+func (s *pushService) getUserPushPreferences(ctx context.Context, userID uint) *entity.UserPushPreferences {
+	if s.userRepo == nil {
+		return nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		logger.Warn("Failed to load user push preferences",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return nil
+	}
+
+	return &user.PushPreferences
+}
+
+// applyUserPreferences fills fields still empty after the device settings with the user defaults,
+// giving the precedence: explicit message field > device setting > user default
+func (s *pushService) applyUserPreferences(preferences *entity.UserPushPreferences, message *push.PushMessage) {
+	if preferences == nil {
+		return
+	}
+	if message.Group == "" {
+		message.Group = preferences.DefaultGroup
+	}
+	if message.Sound == "" {
+		message.Sound = preferences.DefaultSound
+	}
+	if message.Level == "" {
+		message.Level = push.PushLevel(preferences.DefaultLevel)
+	}
+}
+
+// publishPushSent publishes the result of a send, cancelled and test mode sends are not published
+func (s *pushService) publishPushSent(userID uint, setting *entity.UserPushSetting, response *push.PushResponse) {
+	if s.eventBus == nil || response == nil || response.Cancelled || response.TestMode {
+		return
+	}
+
+	s.eventBus.Publish(event.PushSent{
+		UserID:     userID,
+		SettingID:  setting.ID,
+		Provider:   setting.Provider,
+		Success:    response.Success,
+		MessageID:  response.MessageID,
+		Error:      response.Error,
+		OccurredAt: s.clock.Now(),
+	})
+}
+
+// recordDeliveryResult tracks consecutive failures of a device, a success resets the counter.
+// Only failures where the upstream rejected the device count, see push.PushResponse.DeviceRejected.
+// Once the configured threshold is reached the device is disabled and the user is notified.
+func (s *pushService) recordDeliveryResult(ctx context.Context, setting *entity.UserPushSetting, response *push.PushResponse) {
+	// 取消和拒绝发送的推送不是设备的问题，测试模式下没有真正发送，都不影响失败次数
+	if s.userPushSettingRepo == nil || response == nil || response.Cancelled || response.TestMode || response.Rejected {
+		return
+	}
+
+	if response.Success {
+		if setting.ConsecutiveFailures > 0 {
+			if err := s.userPushSettingRepo.ResetFailures(ctx, setting.ID); err != nil {
+				logger.Warn("Failed to reset push setting failures",
+					zap.Uint("setting_id", setting.ID),
+					zap.Error(err))
+			}
+		}
+		return
+	}
+
+	// 只有上游拒绝设备本身（如设备未注册）才计入失败；网络错误、超时、429和5xx是上游故障，
+	// 计入会在服务中断时停用所有用户的设备
+	if !response.DeviceRejected {
+		return
+	}
+
+	failures, disabled, err := s.userPushSettingRepo.RecordFailure(ctx, setting.ID, s.config.FailureThreshold)
+	if err != nil {
+		logger.Warn("Failed to record push setting failure",
+			zap.Uint("setting_id", setting.ID),
+			zap.Error(err))
+		return
+	}
+
+	if disabled {
+		logger.Warn("Push setting disabled after consecutive failures",
+			zap.Uint("user_id", setting.UserID),
+			zap.Uint("setting_id", setting.ID),
+			zap.String("provider", setting.Provider),
+			zap.Int("consecutive_failures", failures))
+		s.notifyDeviceDisabled(ctx, setting, failures)
+	}
+}
+
+// notifyDeviceDisabled tells the user about an auto-disabled device through their other enabled devices.
+// Results are not tracked so a failing notification cannot disable further devices.
+func (s *pushService) notifyDeviceDisabled(ctx context.Context, disabled *entity.UserPushSetting, failures int) {
+	settings, err := s.userPushSettingService.GetEnabledUserSettings(ctx, disabled.UserID)
+	if err != nil {
+		logger.Warn("Failed to load push settings for disabled device notification",
+			zap.Uint("user_id", disabled.UserID),
+			zap.Error(err))
+		return
+	}
+
+	deviceName := disabled.DeviceName
+	if deviceName == "" {
+		deviceName = push.MaskDeviceID(disabled.DeviceID)
+	}
+
+	for _, setting := range settings {
+		if setting.ID == disabled.ID {
+			continue
+		}
+
+		pushClient, err := s.createPushClientForSetting(setting, "")
+		if err != nil {
+			continue
+		}
+
+		message := &push.PushMessage{
+			Title:    "推送设备已停用",
+			Body:     fmt.Sprintf("设备「%s」连续%d次推送失败，已自动停用，请检查后重新启用。", deviceName, failures),
+			DeviceID: setting.DeviceID,
+		}
+		if _, err := s.send(ctx, pushClient, setting.Provider, message); err != nil {
+			logger.Warn("Failed to send disabled device notification",
+				zap.Uint("user_id", disabled.UserID),
+				zap.Uint("setting_id", setting.ID),
+				zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,802 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"nebula-live/ent"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/pkg/lock"
+	"nebula-live/internal/pkg/push"
+	"nebula-live/internal/testutil"
+)
+
+// pushServiceFixture 基于测试数据库的推送服务和一个拥有设备的用户
+type pushServiceFixture struct {
+	client      *ent.Client
+	settings    service.UserPushSettingService
+	pushService service.PushService
+	user        *entity.User
+}
+
+func newPushServiceFixture(t *testing.T, config service.PushServiceConfig) *pushServiceFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	user, err := userService.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	settingRepo := persistence.NewUserPushSettingRepository(client)
+	userRepo := persistence.NewUserRepository(client)
+	settings := service.NewUserPushSettingService(settingRepo, userRepo, rbacService, service.UserPushSettingServiceConfig{})
+
+	return &pushServiceFixture{
+		client:      client,
+		settings:    settings,
+		pushService: service.NewPushService(settings, settingRepo, userRepo, testutil.NewEventBus(t), config),
+		user:        user,
+	}
+}
+
+// addBarkDevice 为用户添加一个启用的Bark设备
+func (f *pushServiceFixture) addBarkDevice(t *testing.T, deviceID, deviceName string) *entity.UserPushSetting {
+	t.Helper()
+	enabled := true
+	setting, err := f.settings.CreateSetting(context.Background(), f.user.ID, "bark", deviceID, deviceName, nil, &enabled)
+	if err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+	return setting
+}
+
+// reload 重新读取设备设置
+func (f *pushServiceFixture) reload(t *testing.T, id uint) *entity.UserPushSetting {
+	t.Helper()
+	setting, err := persistence.NewUserPushSettingRepository(f.client).GetByID(context.Background(), id)
+	if err != nil || setting == nil {
+		t.Fatalf("GetByID() = %v, %v", setting, err)
+	}
+	return setting
+}
+
+// fakeBarkServer 按 status 返回Bark响应的服务器，status为200时推送成功
+func fakeBarkServer(t *testing.T, status *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code := int(status.Load())
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		switch code {
+		case http.StatusOK:
+			w.Write([]byte(`{"code":200,"message":"success","timestamp":1700000000}`))
+		case http.StatusBadRequest:
+			w.Write([]byte(`{"code":400,"message":"failed to get device token: device not registered"}`))
+		default:
+			w.Write([]byte(`{"code":` + strconv.Itoa(code) + `,"message":"upstream unavailable"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func send(t *testing.T, f *pushServiceFixture) {
+	t.Helper()
+	// 发送结果由设备的失败计数体现，全部失败时返回的错误不影响断言
+	f.pushService.SendToUserDevices(context.Background(), f.user.ID, &push.PushMessage{Title: "标题", Body: "内容"})
+}
+
+func TestPushService_RejectedDeviceIsDisabledAfterThreshold(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusBadRequest)
+	server := fakeBarkServer(t, &status)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{FailureThreshold: 3, BarkBaseURL: server.URL})
+	device := f.addBarkDevice(t, "unregistered-device-key", "iPhone")
+
+	for i := 1; i < 3; i++ {
+		send(t, f)
+		got := f.reload(t, device.ID)
+		if got.ConsecutiveFailures != i || !got.Enabled {
+			t.Fatalf("after %d failures: failures = %d, enabled = %v; want %d, enabled", i, got.ConsecutiveFailures, got.Enabled, i)
+		}
+	}
+
+	send(t, f)
+	got := f.reload(t, device.ID)
+	if got.ConsecutiveFailures != 3 || got.Enabled {
+		t.Errorf("after 3 failures: failures = %d, enabled = %v; want 3, disabled", got.ConsecutiveFailures, got.Enabled)
+	}
+}
+
+func TestPushService_SuccessResetsFailures(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusBadRequest)
+	server := fakeBarkServer(t, &status)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{FailureThreshold: 3, BarkBaseURL: server.URL})
+	device := f.addBarkDevice(t, "flaky-device-key", "iPad")
+
+	send(t, f)
+	send(t, f)
+	if got := f.reload(t, device.ID).ConsecutiveFailures; got != 2 {
+		t.Fatalf("failures = %d, want 2", got)
+	}
+
+	status.Store(http.StatusOK)
+	send(t, f)
+	if got := f.reload(t, device.ID); got.ConsecutiveFailures != 0 || !got.Enabled {
+		t.Errorf("after success: failures = %d, enabled = %v; want 0, enabled", got.ConsecutiveFailures, got.Enabled)
+	}
+
+	// 计数清零后需要重新累计到阈值才会停用
+	status.Store(http.StatusBadRequest)
+	send(t, f)
+	send(t, f)
+	if got := f.reload(t, device.ID); got.ConsecutiveFailures != 2 || !got.Enabled {
+		t.Errorf("failures = %d, enabled = %v; want 2, enabled", got.ConsecutiveFailures, got.Enabled)
+	}
+}
+
+func TestPushService_UpstreamOutageDoesNotCountAsDeviceFailure(t *testing.T) {
+	// 501不会被HTTP客户端重试，代表上游的5xx故障
+	var status atomic.Int32
+	status.Store(http.StatusNotImplemented)
+	server := fakeBarkServer(t, &status)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{FailureThreshold: 2, BarkBaseURL: server.URL})
+	device := f.addBarkDevice(t, "healthy-device-key", "iPhone")
+
+	for i := 0; i < 3; i++ {
+		send(t, f)
+	}
+	if got := f.reload(t, device.ID); got.ConsecutiveFailures != 0 || !got.Enabled {
+		t.Errorf("after 5xx: failures = %d, enabled = %v; want 0, enabled", got.ConsecutiveFailures, got.Enabled)
+	}
+
+	// 无法连接的服务器同样不计入
+	server.Close()
+	send(t, f)
+	if got := f.reload(t, device.ID); got.ConsecutiveFailures != 0 || !got.Enabled {
+		t.Errorf("after network error: failures = %d, enabled = %v; want 0, enabled", got.ConsecutiveFailures, got.Enabled)
+	}
+}
+
+func TestPushService_DisabledDeviceNotificationMasksDeviceID(t *testing.T) {
+	const deviceKey = "secretdevicekey1234"
+
+	var (
+		mu     sync.Mutex
+		bodies []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, deviceKey) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":400,"message":"failed to get device token"}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+		w.Write([]byte(`{"code":200,"message":"success","timestamp":1700000000}`))
+	}))
+	t.Cleanup(server.Close)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{FailureThreshold: 1, BarkBaseURL: server.URL})
+	f.addBarkDevice(t, deviceKey, "")
+	f.addBarkDevice(t, "otherdevicekey5678", "iPad")
+
+	send(t, f)
+
+	mu.Lock()
+	defer mu.Unlock()
+	var notification string
+	for _, body := range bodies {
+		if strings.Contains(body, "已自动停用") {
+			notification = body
+		}
+	}
+	if notification == "" {
+		t.Fatalf("no disabled device notification in %q", bodies)
+	}
+	if strings.Contains(notification, deviceKey) {
+		t.Errorf("notification %q contains the raw device key", notification)
+	}
+	if !strings.Contains(notification, push.MaskDeviceID(deviceKey)) {
+		t.Errorf("notification %q does not name the masked device ID", notification)
+	}
+}
+
+func TestPushService_CheckProviderHealthSkipsCacheForCancelledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: server.URL})
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, health := range f.pushService.CheckProviderHealth(cancelled) {
+		if health.Provider == "bark" && health.Reachable {
+			t.Fatalf("bark reachable under a cancelled context")
+		}
+	}
+
+	var bark *push.ProviderHealth
+	results := f.pushService.CheckProviderHealth(context.Background())
+	for i := range results {
+		if results[i].Provider == "bark" {
+			bark = &results[i]
+		}
+	}
+	if bark == nil || !bark.Reachable {
+		t.Errorf("bark health = %+v, want reachable after the cancelled check", bark)
+	}
+}
+
+func TestPushService_DedupWindowIsSharedThroughRedis(t *testing.T) {
+	ctx := context.Background()
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	server := fakeBarkServer(t, &status)
+	redisServer, redisClient := testutil.NewRedis(t)
+
+	config := service.PushServiceConfig{
+		BarkBaseURL: server.URL,
+		DedupWindow: 10 * time.Second,
+		DedupLocker: lock.NewLocker(redisClient, "test:"),
+	}
+	f := newPushServiceFixture(t, config)
+	f.addBarkDevice(t, "iphone-device-key", "iPhone")
+	// 另一个副本上的推送服务，与f共用数据库和Redis
+	settingRepo := persistence.NewUserPushSettingRepository(f.client)
+	replica := service.NewPushService(f.settings, settingRepo, persistence.NewUserRepository(f.client), testutil.NewEventBus(t), config)
+
+	message := &push.PushMessage{Title: "标题", Body: "内容"}
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, message); err != nil {
+		t.Fatalf("first send error = %v", err)
+	}
+	if _, err := replica.SendToUserDevices(ctx, f.user.ID, message); !errors.Is(err, service.ErrDuplicatePush) {
+		t.Errorf("send on replica error = %v, want ErrDuplicatePush", err)
+	}
+	if len(redisServer.Keys()) != 1 {
+		t.Errorf("redis keys = %v, want one dedup key", redisServer.Keys())
+	}
+
+	// 键中包含提供商，发送到指定提供商不受发送到全部设备的影响
+	if _, err := replica.SendToUserDevicesByProvider(ctx, f.user.ID, "bark", message); err != nil {
+		t.Errorf("send to bark error = %v, want sent", err)
+	}
+	if _, err := f.pushService.SendToUserDevicesByProvider(ctx, f.user.ID, "bark", message); !errors.Is(err, service.ErrDuplicatePush) {
+		t.Errorf("second send to bark error = %v, want ErrDuplicatePush", err)
+	}
+
+	// 窗口结束后键过期，相同内容可以再次发送
+	redisServer.FastForward(10 * time.Second)
+	if _, err := replica.SendToUserDevices(ctx, f.user.ID, message); err != nil {
+		t.Errorf("send after window error = %v, want sent", err)
+	}
+}
+
+func TestPushService_UndeliveredPushReleasesDedupWindow(t *testing.T) {
+	ctx := context.Background()
+	var status atomic.Int32
+	status.Store(http.StatusServiceUnavailable)
+	server := fakeBarkServer(t, &status)
+	redisServer, redisClient := testutil.NewRedis(t)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{
+		BarkBaseURL: server.URL,
+		DedupWindow: time.Minute,
+		DedupLocker: lock.NewLocker(redisClient, "test:"),
+	})
+	f.addBarkDevice(t, "iphone-device-key", "iPhone")
+
+	message := &push.PushMessage{Title: "标题", Body: "内容"}
+	f.pushService.SendToUserDevices(ctx, f.user.ID, message)
+	if keys := redisServer.Keys(); len(keys) != 0 {
+		t.Fatalf("redis keys after failed send = %v, want released", keys)
+	}
+
+	// 没有设备收到时立即重试不会被当作重复
+	status.Store(http.StatusOK)
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, message); err != nil {
+		t.Errorf("retry error = %v, want sent", err)
+	}
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, message); !errors.Is(err, service.ErrDuplicatePush) {
+		t.Errorf("send after delivery error = %v, want ErrDuplicatePush", err)
+	}
+}
+
+func TestPushService_DedupWindowInMemory(t *testing.T) {
+	ctx := context.Background()
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	server := fakeBarkServer(t, &status)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: server.URL, DedupWindow: 50 * time.Millisecond})
+	f.addBarkDevice(t, "iphone-device-key", "iPhone")
+
+	message := &push.PushMessage{Title: "标题", Body: "内容"}
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, message); err != nil {
+		t.Fatalf("first send error = %v", err)
+	}
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, message); !errors.Is(err, service.ErrDuplicatePush) {
+		t.Errorf("second send error = %v, want ErrDuplicatePush", err)
+	}
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, &push.PushMessage{Title: "标题", Body: "其他内容"}); err != nil {
+		t.Errorf("send with other body error = %v, want sent", err)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, message); err != nil {
+		t.Errorf("send after window error = %v, want sent", err)
+	}
+}
+
+func TestPushService_CancelledFanOutSkipsRemainingDevices(t *testing.T) {
+	sends := map[string]func(s service.PushService, ctx context.Context, userID uint, message *push.PushMessage) ([]*push.PushResponse, error){
+		"all devices": func(s service.PushService, ctx context.Context, userID uint, message *push.PushMessage) ([]*push.PushResponse, error) {
+			return s.SendToUserDevices(ctx, userID, message)
+		},
+		"by provider": func(s service.PushService, ctx context.Context, userID uint, message *push.PushMessage) ([]*push.PushResponse, error) {
+			return s.SendToUserDevicesByProvider(ctx, userID, "bark", message)
+		},
+	}
+	for name, sendTo := range sends {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// 第一个设备发送时请求被取消
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				cancel()
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"code":200,"message":"success","timestamp":1700000000}`))
+			}))
+			t.Cleanup(server.Close)
+
+			f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: server.URL})
+			for _, key := range []string{"first-device-key", "second-device-key", "third-device-key"} {
+				f.addBarkDevice(t, key, key)
+			}
+
+			responses, err := sendTo(f.pushService, ctx, f.user.ID, &push.PushMessage{Title: "标题", Body: "内容"})
+			if err != nil {
+				t.Fatalf("send error = %v", err)
+			}
+			if got := requests.Load(); got != 1 {
+				t.Errorf("provider received %d requests, want 1", got)
+			}
+			if len(responses) != 3 {
+				t.Fatalf("got %d responses, want 3", len(responses))
+			}
+			for i, response := range responses[1:] {
+				if response.Success || !response.Cancelled || response.Provider != "bark" {
+					t.Errorf("response %d = %+v, want cancelled bark response", i+1, response)
+				}
+			}
+		})
+	}
+}
+
+// barkRecorder 记录请求路径和请求体并总是返回成功的假Bark服务器
+type barkRecorder struct {
+	server   *httptest.Server
+	mu       sync.Mutex
+	paths    []string
+	payloads []map[string]any
+}
+
+func newBarkRecorder(t *testing.T) *barkRecorder {
+	t.Helper()
+	r := &barkRecorder{}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var payload map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		r.mu.Lock()
+		r.paths = append(r.paths, req.URL.Path)
+		r.payloads = append(r.payloads, payload)
+		r.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":200,"message":"success","timestamp":1700000000}`))
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *barkRecorder) received() []map[string]any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]map[string]any(nil), r.payloads...)
+}
+
+// devices 返回按接收顺序推送到的设备路径
+func (r *barkRecorder) devices() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.paths...)
+}
+
+// newMixedDeviceFixture 用户同时拥有Bark设备和邮件设备，分别发往假Bark服务器和假SMTP服务器
+func newMixedDeviceFixture(t *testing.T, bark *barkRecorder, smtp *testutil.FakeSMTPServer, config service.PushServiceConfig) *pushServiceFixture {
+	t.Helper()
+	testutil.InitLogger()
+	config.BarkBaseURL = bark.server.URL
+	config.Email = push.SMTPConfig{
+		Enabled: true,
+		Host:    smtp.Host(),
+		Port:    smtp.Port(),
+		From:    "noreply@example.com",
+		TLS:     push.SMTPTLSNone,
+	}
+	f := newPushServiceFixture(t, config)
+	f.addBarkDevice(t, "bark-device-key", "iPhone")
+	enabled := true
+	if _, err := f.settings.CreateSetting(context.Background(), f.user.ID, "email", "alice@example.com", "Mail", nil, &enabled); err != nil {
+		t.Fatalf("CreateSetting(email) error = %v", err)
+	}
+	return f
+}
+
+// emailSubject 解码邮件的主题
+func emailSubject(t *testing.T, message testutil.SMTPMessage) string {
+	t.Helper()
+	msg, err := mail.ReadMessage(bytes.NewReader(message.Data))
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("DecodeHeader() error = %v", err)
+	}
+	return subject
+}
+
+// 长度限制只作用于配置了限制的提供商，同一次推送的其他设备收到完整内容
+func TestPushService_LengthLimitsApplyPerProvider(t *testing.T) {
+	ctx := context.Background()
+	title := strings.Repeat("标", 8)
+	body := strings.Repeat("内", 30)
+
+	t.Run("truncate", func(t *testing.T) {
+		bark, smtp := newBarkRecorder(t), testutil.NewFakeSMTPServer(t)
+		f := newMixedDeviceFixture(t, bark, smtp, service.PushServiceConfig{
+			LengthLimits: map[string]push.LengthLimits{"bark": {MaxTitle: 4, MaxBody: 10, Policy: push.LengthPolicyTruncate}},
+		})
+
+		responses, err := f.pushService.SendToUserDevices(ctx, f.user.ID, &push.PushMessage{Title: title, Body: body})
+		if err != nil {
+			t.Fatalf("SendToUserDevices() error = %v", err)
+		}
+		for _, resp := range responses {
+			if !resp.Success {
+				t.Errorf("%s response = %+v, want success", resp.Provider, resp)
+			}
+		}
+
+		payloads := bark.received()
+		if len(payloads) != 1 {
+			t.Fatalf("bark received %d requests, want 1", len(payloads))
+		}
+		if payloads[0]["title"] != "标标标…" || payloads[0]["body"] != strings.Repeat("内", 9)+"…" {
+			t.Errorf("bark payload = {title: %v, body: %v}, want both truncated with an ellipsis", payloads[0]["title"], payloads[0]["body"])
+		}
+
+		messages := smtp.Messages()
+		if len(messages) != 1 {
+			t.Fatalf("smtp received %d messages, want 1", len(messages))
+		}
+		if got := emailSubject(t, messages[0]); got != title {
+			t.Errorf("email subject = %q, want the full title %q", got, title)
+		}
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		bark, smtp := newBarkRecorder(t), testutil.NewFakeSMTPServer(t)
+		f := newMixedDeviceFixture(t, bark, smtp, service.PushServiceConfig{
+			LengthLimits: map[string]push.LengthLimits{"bark": {MaxBody: 10, Policy: push.LengthPolicyReject}},
+		})
+
+		responses, _ := f.pushService.SendToUserDevices(ctx, f.user.ID, &push.PushMessage{Title: title, Body: body})
+		byProvider := map[string]*push.PushResponse{}
+		for _, resp := range responses {
+			byProvider[resp.Provider] = resp
+		}
+		if resp := byProvider["bark"]; resp == nil || resp.Success || !resp.Rejected || !strings.Contains(resp.Error, "body longer than 10") {
+			t.Errorf("bark response = %+v, want rejected for the body length", resp)
+		}
+		if resp := byProvider["email"]; resp == nil || !resp.Success {
+			t.Errorf("email response = %+v, want success", resp)
+		}
+		if got := len(bark.received()); got != 0 {
+			t.Errorf("bark received %d requests, want none", got)
+		}
+		if got := len(smtp.Messages()); got != 1 {
+			t.Errorf("smtp received %d messages, want 1", got)
+		}
+	})
+}
+
+func TestPushService_TestModeSkipsProviders(t *testing.T) {
+	ctx := context.Background()
+	bark, smtp := newBarkRecorder(t), testutil.NewFakeSMTPServer(t)
+	f := newMixedDeviceFixture(t, bark, smtp, service.PushServiceConfig{TestMode: true})
+
+	responses, err := f.pushService.SendToUserDevices(ctx, f.user.ID, &push.PushMessage{Title: "标题", Body: "内容"})
+	if err != nil {
+		t.Fatalf("SendToUserDevices() error = %v", err)
+	}
+	if len(responses) != 2 {
+		t.Fatalf("got %d responses, want one per device", len(responses))
+	}
+	for _, resp := range responses {
+		if !resp.Success || !resp.TestMode || resp.MessageID != "test-mode" {
+			t.Errorf("%s response = %+v, want a synthetic test mode success", resp.Provider, resp)
+		}
+	}
+
+	if got := len(bark.received()); got != 0 {
+		t.Errorf("bark received %d requests in test mode, want 0", got)
+	}
+	if got := len(smtp.Messages()); got != 0 {
+		t.Errorf("smtp received %d messages in test mode, want 0", got)
+	}
+}
+
+// Bark服务器按请求 > 设备设置 > 配置默认值的优先级选择
+func TestPushService_BarkServerPrecedence(t *testing.T) {
+	ctx := context.Background()
+	testutil.InitLogger()
+	configured, device, requested := newBarkRecorder(t), newBarkRecorder(t), newBarkRecorder(t)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: configured.server.URL})
+	f.addBarkDevice(t, "default-device", "iPhone")
+	enabled := true
+	if _, err := f.settings.CreateSetting(ctx, f.user.ID, "bark", "custom-device", "iPad",
+		map[string]interface{}{"base_url": device.server.URL}, &enabled); err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, &push.PushMessage{Title: "标题", Body: "内容"}); err != nil {
+		t.Fatalf("SendToUserDevices() error = %v", err)
+	}
+	if got := configured.devices(); len(got) != 1 || got[0] != "/default-device" {
+		t.Errorf("configured server received %v, want only /default-device", got)
+	}
+	if got := device.devices(); len(got) != 1 || got[0] != "/custom-device" {
+		t.Errorf("device server received %v, want only /custom-device", got)
+	}
+
+	// 请求指定的服务器覆盖设备设置和默认配置
+	message := &push.PushMessage{Title: "标题", Body: "内容", ServerURL: requested.server.URL}
+	if _, err := f.pushService.SendToUserDevices(ctx, f.user.ID, message); err != nil {
+		t.Fatalf("SendToUserDevices(server_url) error = %v", err)
+	}
+	if got := requested.devices(); len(got) != 2 {
+		t.Errorf("requested server received %v, want both devices", got)
+	}
+	if len(configured.devices()) != 1 || len(device.devices()) != 1 {
+		t.Error("the request override still reached the device or configured server")
+	}
+}
+
+// 按提供商推送时未知提供商和已停用提供商返回不同的错误
+func TestPushService_ProviderErrors(t *testing.T) {
+	ctx := context.Background()
+	testutil.InitLogger()
+	bark := newBarkRecorder(t)
+	message := &push.PushMessage{Title: "标题", Body: "内容"}
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: bark.server.URL})
+	f.addBarkDevice(t, "bark-device-key", "iPhone")
+	if _, err := f.pushService.SendToUserDevicesByProvider(ctx, f.user.ID, "sms", message); !errors.Is(err, push.ErrProviderNotFound) {
+		t.Errorf("SendToUserDevicesByProvider(sms) error = %v, want ErrProviderNotFound", err)
+	}
+	if _, err := f.pushService.SendToUserDevicesByProvider(ctx, f.user.ID, "bark", message); err != nil {
+		t.Fatalf("SendToUserDevicesByProvider(bark) error = %v", err)
+	}
+	if got := bark.devices(); len(got) != 1 {
+		t.Fatalf("Bark server received %v, want one push", got)
+	}
+
+	disabled := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: bark.server.URL, BarkDisabled: true})
+	disabled.addBarkDevice(t, "bark-device-key", "iPhone")
+	_, err := disabled.pushService.SendToUserDevicesByProvider(ctx, disabled.user.ID, "bark", message)
+	if !errors.Is(err, push.ErrProviderNotEnabled) || errors.Is(err, push.ErrProviderNotFound) {
+		t.Errorf("SendToUserDevicesByProvider(disabled bark) error = %v, want only ErrProviderNotEnabled", err)
+	}
+	if got := bark.devices(); len(got) != 1 {
+		t.Errorf("Bark server received %v after the provider was disabled, want no new push", got)
+	}
+}
+
+// countingBarkServer 返回成功的假Bark服务器，记录新建的连接数
+func countingBarkServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var conns atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":200,"message":"success","timestamp":1700000000}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+	return server, &conns
+}
+
+// 同一服务器的推送复用同一个客户端，连接得以保持；不同服务器各自使用自己的客户端
+func TestPushService_ReusesClientPerServer(t *testing.T) {
+	ctx := context.Background()
+	testutil.InitLogger()
+	defaultServer, defaultConns := countingBarkServer(t)
+	customServer, customConns := countingBarkServer(t)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: defaultServer.URL})
+	f.addBarkDevice(t, "default-device", "iPhone")
+	enabled := true
+	if _, err := f.settings.CreateSetting(ctx, f.user.ID, "bark", "custom-device", "iPad",
+		map[string]interface{}{"base_url": customServer.URL}, &enabled); err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		responses, err := f.pushService.SendToUserDevicesByProvider(ctx, f.user.ID, "bark",
+			&push.PushMessage{Title: "标题" + strconv.Itoa(i), Body: "内容"})
+		if err != nil {
+			t.Fatalf("send %d error = %v", i, err)
+		}
+		for _, resp := range responses {
+			if !resp.Success {
+				t.Fatalf("send %d response = %+v, want success", i, resp)
+			}
+		}
+	}
+
+	if got := defaultConns.Load(); got != 1 {
+		t.Errorf("default server accepted %d connections over 5 sends, want 1", got)
+	}
+	if got := customConns.Load(); got != 1 {
+		t.Errorf("custom server accepted %d connections over 5 sends, want 1", got)
+	}
+}
+
+// 预览按设备应用铃声、分组等设置，但不实际发送
+func TestPushService_PreviewAppliesDeviceSettings(t *testing.T) {
+	ctx := context.Background()
+	testutil.InitLogger()
+	bark := newBarkRecorder(t)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{BarkBaseURL: bark.server.URL})
+	plain := f.addBarkDevice(t, "plain-device", "iPhone")
+	enabled := true
+	custom, err := f.settings.CreateSetting(ctx, f.user.ID, "bark", "custom-device", "iPad", map[string]interface{}{
+		"base_url": "https://bark.example.com",
+		"sound":    "bell",
+		"group":    "live",
+		"level":    "timeSensitive",
+	}, &enabled)
+	if err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+
+	previews, err := f.pushService.PreviewUserDevices(ctx, f.user.ID, &push.PushMessage{Title: "开播提醒", Body: "主播开播了"})
+	if err != nil {
+		t.Fatalf("PreviewUserDevices() error = %v", err)
+	}
+	if len(previews) != 2 {
+		t.Fatalf("got %d previews, want 2", len(previews))
+	}
+	bySetting := map[uint]*service.PushPreview{}
+	for _, preview := range previews {
+		if preview.Error != "" {
+			t.Errorf("preview of %s error = %q", preview.DeviceName, preview.Error)
+		}
+		bySetting[preview.SettingID] = preview
+	}
+
+	got := bySetting[custom.ID]
+	if got == nil || got.Endpoint != "https://bark.example.com" {
+		t.Fatalf("custom device preview = %+v, want endpoint https://bark.example.com", got)
+	}
+	if got.Message.Sound != "bell" || got.Message.Group != "live" || got.Message.Level != push.PushLevelTimeSensitive ||
+		got.Message.DeviceID != "custom-device" || got.Message.Title != "开播提醒" {
+		t.Errorf("custom device message = %+v, want the device sound, group and level", got.Message)
+	}
+	got = bySetting[plain.ID]
+	if got == nil || got.Endpoint != bark.server.URL || got.Message.Sound != "" || got.Message.Group != "" {
+		t.Errorf("plain device preview = %+v, want the default server and no overrides", got)
+	}
+
+	// 请求中指定的值优先于设备设置
+	previews, err = f.pushService.PreviewUserDevices(ctx, f.user.ID, &push.PushMessage{Title: "开播提醒", Body: "主播开播了", Sound: "alarm"})
+	if err != nil {
+		t.Fatalf("PreviewUserDevices(sound) error = %v", err)
+	}
+	for _, preview := range previews {
+		if preview.Message.Sound != "alarm" {
+			t.Errorf("%s sound = %q, want alarm", preview.DeviceName, preview.Message.Sound)
+		}
+	}
+
+	if got := bark.received(); len(got) != 0 {
+		t.Errorf("Bark server received %d pushes during preview, want 0", len(got))
+	}
+}
+
+// unsupportedDeviceSettings 在用户的启用设备中追加一个未知提供商的设备，模拟提供商下线后遗留的设置
+type unsupportedDeviceSettings struct {
+	service.UserPushSettingService
+}
+
+func (s unsupportedDeviceSettings) GetEnabledUserSettings(ctx context.Context, userID uint) ([]*entity.UserPushSetting, error) {
+	settings, err := s.UserPushSettingService.GetEnabledUserSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return append(settings, &entity.UserPushSetting{ID: 9999, UserID: userID, Provider: "sms", DeviceID: "13800000000", Enabled: true}), nil
+}
+
+// 不支持的提供商的设备记为失败响应，而不是被静默跳过
+func TestPushService_UnsupportedProviderSettingCountsAsFailure(t *testing.T) {
+	ctx := context.Background()
+	testutil.InitLogger()
+	bark := newBarkRecorder(t)
+
+	f := newPushServiceFixture(t, service.PushServiceConfig{})
+	f.addBarkDevice(t, "bark-device-key", "iPhone")
+	settingRepo := persistence.NewUserPushSettingRepository(f.client)
+	pushService := service.NewPushService(unsupportedDeviceSettings{f.settings}, settingRepo,
+		persistence.NewUserRepository(f.client), testutil.NewEventBus(t), service.PushServiceConfig{BarkBaseURL: bark.server.URL})
+
+	responses, err := pushService.SendToUserDevices(ctx, f.user.ID, &push.PushMessage{Title: "标题", Body: "内容"})
+	if err != nil {
+		t.Fatalf("SendToUserDevices() error = %v", err)
+	}
+	if len(responses) != 2 {
+		t.Fatalf("got %d responses, want 2 (one per device)", len(responses))
+	}
+	var failed []*push.PushResponse
+	for _, resp := range responses {
+		if !resp.Success {
+			failed = append(failed, resp)
+		}
+	}
+	if len(failed) != 1 || failed[0].Provider != "sms" || !strings.Contains(failed[0].Error, push.ErrProviderNotFound.Error()) {
+		t.Errorf("failed responses = %+v, want one sms response with the provider-not-found error", failed)
+	}
+	if got := bark.devices(); len(got) != 1 {
+		t.Errorf("Bark server received %v, want the supported device to still be sent", got)
+	}
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/clock"
+	"nebula-live/pkg/logger"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"go.uber.org/zap"
+)
+
+// RBAC权限检查引擎
+const (
+	RBACEngineBuiltin = "builtin"
+	RBACEngineCasbin  = "casbin"
+)
+
+// casbinRolePageSize 加载策略时分页读取角色的大小
+const casbinRolePageSize = 100
+
+// defaultCasbinModel 默认Casbin模型，与内置实现的语义一致：
+// 用户通过角色获得权限，资源或操作为*的策略匹配任意值
+const defaultCasbinModel = `
+[request_definition]
+r = sub, obj, act
+
+[policy_definition]
+p = sub, obj, act
+
+[role_definition]
+g = _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub) && (r.obj == p.obj || p.obj == "*") && (r.act == p.act || p.act == "*")
+`
+
+// RBACServiceConfig RBAC服务配置
+type RBACServiceConfig struct {
+	// Engine 权限检查引擎，builtin（默认）或casbin
+	Engine string
+	// CasbinModelPath 自定义Casbin模型文件路径，为空时使用默认模型
+	CasbinModelPath string
+	// PolicyRefreshInterval Casbin策略的定期重新加载间隔，用于同步其他实例的变更，0表示仅在本实例变更后重新加载
+	PolicyRefreshInterval time.Duration
+	// AdminWildcard 初始化系统数据时为管理员分配 *:* 通配权限而不是逐个分配系统权限
+	AdminWildcard bool
+	// Clock 角色分配期限检查和时间戳使用的时间来源，为nil时使用系统时间
+	Clock clock.Clock
+}
+
+// casbinRBACService 基于Casbin的RBAC服务
+//
+// 角色与权限的管理仍由内置实现完成，HasPermission和HasRole改由Casbin根据数据库中的
+// 角色分配和角色权限生成的策略判断。策略主体为 user:<id> 和 role:<name>。
+// 本实例修改角色或权限后标记策略过期，下一次检查时重新加载。
+type casbinRBACService struct {
+	RBACService
+
+	roleRepo           repository.RoleRepository
+	userRoleRepo       repository.UserRoleRepository
+	rolePermissionRepo repository.RolePermissionRepository
+
+	model           model.Model
+	refreshInterval time.Duration
+
+	mu       sync.RWMutex
+	enforcer *casbin.SyncedEnforcer
+	loadedAt time.Time
+	stale    bool
+}
+
+// newCasbinRBACService 创建基于Casbin的RBAC服务，包装内置实现
+func newCasbinRBACService(
+	base RBACService,
+	roleRepo repository.RoleRepository,
+	userRoleRepo repository.UserRoleRepository,
+	rolePermissionRepo repository.RolePermissionRepository,
+	config RBACServiceConfig,
+) (RBACService, error) {
+	modelText := defaultCasbinModel
+	if config.CasbinModelPath != "" {
+		data, err := os.ReadFile(config.CasbinModelPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read casbin model: %w", err)
+		}
+		modelText = string(data)
+	}
+
+	m, err := model.NewModelFromString(modelText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid casbin model: %w", err)
+	}
+
+	return &casbinRBACService{
+		RBACService:        base,
+		roleRepo:           roleRepo,
+		userRoleRepo:       userRoleRepo,
+		rolePermissionRepo: rolePermissionRepo,
+		model:              m,
+		refreshInterval:    config.PolicyRefreshInterval,
+		stale:              true,
+	}, nil
+}
+
+// casbinUserSubject 用户在Casbin策略中的主体
+func casbinUserSubject(userID uint) string {
+	return "user:" + strconv.FormatUint(uint64(userID), 10)
+}
+
+// casbinRoleSubject 角色在Casbin策略中的主体
+func casbinRoleSubject(roleName string) string {
+	return "role:" + roleName
+}
+
+// HasPermission 使用Casbin检查用户是否拥有指定权限
+func (s *casbinRBACService) HasPermission(ctx context.Context, userID uint, resource, action string) (bool, error) {
+	enforcer, err := s.getEnforcer(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return enforcer.Enforce(casbinUserSubject(userID), resource, action)
+}
+
+// HasRole 使用Casbin检查用户是否拥有指定角色
+func (s *casbinRBACService) HasRole(ctx context.Context, userID uint, roleName string) (bool, error) {
+	enforcer, err := s.getEnforcer(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return enforcer.HasRoleForUser(casbinUserSubject(userID), casbinRoleSubject(roleName))
+}
+
+// getEnforcer 返回当前的Enforcer，策略过期时从数据库重新加载
+func (s *casbinRBACService) getEnforcer(ctx context.Context) (*casbin.SyncedEnforcer, error) {
+	s.mu.RLock()
+	enforcer := s.enforcer
+	fresh := !s.stale && (s.refreshInterval <= 0 || time.Since(s.loadedAt) < s.refreshInterval)
+	s.mu.RUnlock()
+
+	if enforcer != nil && fresh {
+		return enforcer, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 其他请求可能已经完成加载
+	if s.enforcer != nil && !s.stale && (s.refreshInterval <= 0 || time.Since(s.loadedAt) < s.refreshInterval) {
+		return s.enforcer, nil
+	}
+
+	enforcer, err := s.loadEnforcer(ctx)
+	if err != nil {
+		// 保留旧策略继续服务，避免数据库短暂不可用导致全部拒绝
+		if s.enforcer != nil {
+			logger.Warn("Failed to reload casbin policy, using previous policy", zap.Error(err))
+			return s.enforcer, nil
+		}
+		return nil, err
+	}
+
+	s.enforcer = enforcer
+	s.loadedAt = time.Now()
+	s.stale = false
+
+	return enforcer, nil
+}
+
+// loadEnforcer 根据数据库中的角色权限和用户角色构建新的Enforcer
+func (s *casbinRBACService) loadEnforcer(ctx context.Context) (*casbin.SyncedEnforcer, error) {
+	var policies, groupings [][]string
+
+	for offset := 0; ; offset += casbinRolePageSize {
+		roles, err := s.roleRepo.List(ctx, offset, casbinRolePageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load roles: %w", err)
+		}
+
+		for _, role := range roles {
+			subject := casbinRoleSubject(role.Name)
+
+			permissions, err := s.rolePermissionRepo.GetRolePermissions(ctx, role.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load permissions of role %s: %w", role.Name, err)
+			}
+			for _, permission := range permissions {
+				policies = append(policies, []string{subject, permission.Resource, permission.Action})
+			}
+
+			users, err := s.userRoleRepo.GetRoleUsers(ctx, role.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load users of role %s: %w", role.Name, err)
+			}
+			for _, user := range users {
+				groupings = append(groupings, []string{casbinUserSubject(user.ID), subject})
+			}
+		}
+
+		if len(roles) < casbinRolePageSize {
+			break
+		}
+	}
+
+	enforcer, err := casbin.NewSyncedEnforcer(s.model.Copy())
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) > 0 {
+		if _, err := enforcer.AddPolicies(policies); err != nil {
+			return nil, err
+		}
+	}
+	if len(groupings) > 0 {
+		if _, err := enforcer.AddGroupingPolicies(groupings); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Debug("Loaded casbin policy",
+		zap.Int("policies", len(policies)),
+		zap.Int("groupings", len(groupings)))
+
+	return enforcer, nil
+}
+
+// invalidate 标记策略过期
+func (s *casbinRBACService) invalidate() {
+	s.mu.Lock()
+	s.stale = true
+	s.mu.Unlock()
+}
+
+// 以下方法修改角色或权限，完成后使策略过期
+
+func (s *casbinRBACService) DeleteRole(ctx context.Context, id uint) error {
+	defer s.invalidate()
+	return s.RBACService.DeleteRole(ctx, id)
+}
+
+func (s *casbinRBACService) DeletePermission(ctx context.Context, id uint) error {
+	defer s.invalidate()
+	return s.RBACService.DeletePermission(ctx, id)
+}
+
+func (s *casbinRBACService) AssignRoleToUser(ctx context.Context, userID, roleID, assignerID uint, expiresAt *time.Time) error {
+	defer s.invalidate()
+	return s.RBACService.AssignRoleToUser(ctx, userID, roleID, assignerID, expiresAt)
+}
+
+func (s *casbinRBACService) CleanupExpiredRoles(ctx context.Context) (int, error) {
+	defer s.invalidate()
+	return s.RBACService.CleanupExpiredRoles(ctx)
+}
+
+func (s *casbinRBACService) RemoveRoleFromUser(ctx context.Context, userID, roleID uint) error {
+	defer s.invalidate()
+	return s.RBACService.RemoveRoleFromUser(ctx, userID, roleID)
+}
+
+func (s *casbinRBACService) RemoveRolesFromUser(ctx context.Context, userID uint, roleIDs []uint, minRemaining int) (int, error) {
+	defer s.invalidate()
+	return s.RBACService.RemoveRolesFromUser(ctx, userID, roleIDs, minRemaining)
+}
+
+func (s *casbinRBACService) AssignPermissionToRole(ctx context.Context, roleID, permissionID, assignerID uint) error {
+	defer s.invalidate()
+	return s.RBACService.AssignPermissionToRole(ctx, roleID, permissionID, assignerID)
+}
+
+func (s *casbinRBACService) RemovePermissionFromRole(ctx context.Context, roleID, permissionID uint) error {
+	defer s.invalidate()
+	return s.RBACService.RemovePermissionFromRole(ctx, roleID, permissionID)
+}
+
+func (s *casbinRBACService) InitializeSystemData(ctx context.Context) error {
+	defer s.invalidate()
+	return s.RBACService.InitializeSystemData(ctx)
+}
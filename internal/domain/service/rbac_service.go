@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/event"
 	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/pkg/eventbus"
+	"nebula-live/pkg/clock"
+	apperrors "nebula-live/pkg/errors"
 	"nebula-live/pkg/logger"
 	"time"
 
@@ -13,41 +17,51 @@ import (
 
 var (
 	// RBAC相关错误
-	ErrRoleAlreadyExists            = errors.New("role already exists")
-	ErrRoleNotFound                 = errors.New("role not found")
-	ErrSystemRoleCannotDelete       = errors.New("system role cannot be deleted")
-	ErrPermissionAlreadyExists      = errors.New("permission already exists")
-	ErrPermissionNotFound           = errors.New("permission not found")
-	ErrSystemPermissionCannotDelete = errors.New("system permission cannot be deleted")
-	ErrUserRoleAlreadyExists        = errors.New("user role already exists")
-	ErrUserRoleNotFound             = errors.New("user role not found")
-	ErrRolePermissionAlreadyExists  = errors.New("role permission already exists")
-	ErrRolePermissionNotFound       = errors.New("role permission not found")
+	ErrRoleAlreadyExists            = apperrors.NewDomainError(apperrors.KindConflict, "role already exists")
+	ErrRoleNotFound                 = apperrors.NewDomainError(apperrors.KindNotFound, "role not found")
+	ErrSystemRoleCannotDelete       = apperrors.NewDomainError(apperrors.KindForbidden, "system role cannot be deleted")
+	ErrPermissionAlreadyExists      = apperrors.NewDomainError(apperrors.KindConflict, "permission already exists")
+	ErrPermissionNotFound           = apperrors.NewDomainError(apperrors.KindNotFound, "permission not found")
+	ErrSystemPermissionCannotDelete = apperrors.NewDomainError(apperrors.KindForbidden, "system permission cannot be deleted")
+	ErrUserRoleAlreadyExists        = apperrors.NewDomainError(apperrors.KindConflict, "user role already exists")
+	ErrUserRoleNotFound             = apperrors.NewDomainError(apperrors.KindNotFound, "user role not found")
+	ErrRolePermissionAlreadyExists  = apperrors.NewDomainError(apperrors.KindConflict, "role permission already exists")
+	ErrRolePermissionNotFound       = apperrors.NewDomainError(apperrors.KindNotFound, "role permission not found")
+	ErrInvalidRoleExpiry            = apperrors.NewDomainError(apperrors.KindInvalid, "role expiry must be in the future")
+	ErrInvalidPermissionCategory    = apperrors.NewDomainError(apperrors.KindInvalid, "invalid permission category")
 )
 
 // RBACService RBAC服务接口
 type RBACService interface {
-	// 角色管理
-	CreateRole(ctx context.Context, name, displayName, description string, isSystem bool) (*entity.Role, error)
+	// 角色管理，createdBy和updatedBy为操作者的用户ID，0表示系统操作
+	CreateRole(ctx context.Context, name, displayName, description string, isSystem bool, createdBy uint) (*entity.Role, error)
 	GetRoleByID(ctx context.Context, id uint) (*entity.Role, error)
 	GetRoleByName(ctx context.Context, name string) (*entity.Role, error)
 	ListRoles(ctx context.Context, offset, limit int) ([]*entity.Role, error)
-	UpdateRole(ctx context.Context, id uint, displayName, description string) (*entity.Role, error)
+	CountRoles(ctx context.Context) (int64, error)
+	UpdateRole(ctx context.Context, id uint, displayName, description string, updatedBy uint) (*entity.Role, error)
 	DeleteRole(ctx context.Context, id uint) error
 
-	// 权限管理
-	CreatePermission(ctx context.Context, name, displayName, description, resource, action string, isSystem bool) (*entity.Permission, error)
+	// 权限管理，createdBy和updatedBy为操作者的用户ID，0表示系统操作
+	CreatePermission(ctx context.Context, name, displayName, description, resource, action, category string, isSystem bool, createdBy uint) (*entity.Permission, error)
 	GetPermissionByID(ctx context.Context, id uint) (*entity.Permission, error)
 	GetPermissionByName(ctx context.Context, name string) (*entity.Permission, error)
 	ListPermissions(ctx context.Context, offset, limit int) ([]*entity.Permission, error)
-	UpdatePermission(ctx context.Context, id uint, displayName, description string) (*entity.Permission, error)
+	CountPermissions(ctx context.Context) (int64, error)
+	ListPermissionsGrouped(ctx context.Context) (map[string][]*entity.Permission, error)
+	// CreatePermissions 在事务中批量创建权限，返回与inputs一一对应的结果，名称已存在的项跳过，对应位置为nil
+	CreatePermissions(ctx context.Context, inputs []PermissionInput, createdBy uint) ([]*entity.Permission, error)
+	UpdatePermission(ctx context.Context, id uint, displayName, description string, updatedBy uint) (*entity.Permission, error)
 	DeletePermission(ctx context.Context, id uint) error
 
 	// 用户角色管理
-	AssignRoleToUser(ctx context.Context, userID, roleID, assignerID uint) error
+	AssignRoleToUser(ctx context.Context, userID, roleID, assignerID uint, expiresAt *time.Time) error
 	RemoveRoleFromUser(ctx context.Context, userID, roleID uint) error
+	RemoveRolesFromUser(ctx context.Context, userID uint, roleIDs []uint, minRemaining int) (int, error)
 	GetUserRoles(ctx context.Context, userID uint) ([]*entity.Role, error)
+	GetRoleUsers(ctx context.Context, roleID uint) ([]*entity.User, error)
 	HasRole(ctx context.Context, userID uint, roleName string) (bool, error)
+	CleanupExpiredRoles(ctx context.Context) (int, error)
 
 	// 角色权限管理
 	AssignPermissionToRole(ctx context.Context, roleID, permissionID, assignerID uint) error
@@ -57,35 +71,65 @@ type RBACService interface {
 	// 权限验证
 	HasPermission(ctx context.Context, userID uint, resource, action string) (bool, error)
 	GetUserPermissions(ctx context.Context, userID uint) ([]*entity.Permission, error)
+	// GetUsersWithPermission 获取拥有指定权限的用户（经由角色，含通配权限），多个角色授予时不重复
+	GetUsersWithPermission(ctx context.Context, resource, action string) ([]*entity.User, error)
 
 	// 初始化系统数据
 	InitializeSystemData(ctx context.Context) error
 }
 
+// PermissionInput 批量创建权限时的单项参数，Category为空时使用other
+type PermissionInput struct {
+	Name        string
+	DisplayName string
+	Description string
+	Resource    string
+	Action      string
+	Category    string
+}
+
 type rbacService struct {
 	roleRepo           repository.RoleRepository
 	permissionRepo     repository.PermissionRepository
 	userRoleRepo       repository.UserRoleRepository
 	rolePermissionRepo repository.RolePermissionRepository
+	eventBus           *eventbus.Bus
+	config             RBACServiceConfig
+	clock              clock.Clock
 }
 
 // NewRBACService 创建RBAC服务实例
+// 配置为casbin引擎时，权限与角色检查由Casbin完成，其余功能仍使用内置实现
 func NewRBACService(
 	roleRepo repository.RoleRepository,
 	permissionRepo repository.PermissionRepository,
 	userRoleRepo repository.UserRoleRepository,
 	rolePermissionRepo repository.RolePermissionRepository,
-) RBACService {
-	return &rbacService{
+	eventBus *eventbus.Bus,
+	config RBACServiceConfig,
+) (RBACService, error) {
+	base := &rbacService{
 		roleRepo:           roleRepo,
 		permissionRepo:     permissionRepo,
 		userRoleRepo:       userRoleRepo,
 		rolePermissionRepo: rolePermissionRepo,
+		eventBus:           eventBus,
+		config:             config,
+		clock:              clock.OrReal(config.Clock),
+	}
+
+	switch config.Engine {
+	case "", RBACEngineBuiltin:
+		return base, nil
+	case RBACEngineCasbin:
+		return newCasbinRBACService(base, roleRepo, userRoleRepo, rolePermissionRepo, config)
+	default:
+		return nil, fmt.Errorf("unsupported rbac engine: %s", config.Engine)
 	}
 }
 
 // 角色管理
-func (s *rbacService) CreateRole(ctx context.Context, name, displayName, description string, isSystem bool) (*entity.Role, error) {
+func (s *rbacService) CreateRole(ctx context.Context, name, displayName, description string, isSystem bool, createdBy uint) (*entity.Role, error) {
 	// 检查角色名称是否已存在
 	exists, err := s.roleRepo.ExistsByName(ctx, name)
 	if err != nil {
@@ -100,8 +144,9 @@ func (s *rbacService) CreateRole(ctx context.Context, name, displayName, descrip
 		DisplayName: displayName,
 		Description: description,
 		IsSystem:    isSystem,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		CreatedBy:   operatorID(createdBy),
+		CreatedAt:   s.clock.Now(),
+		UpdatedAt:   s.clock.Now(),
 	}
 
 	return s.roleRepo.Create(ctx, role)
@@ -133,7 +178,11 @@ func (s *rbacService) ListRoles(ctx context.Context, offset, limit int) ([]*enti
 	return s.roleRepo.List(ctx, offset, limit)
 }
 
-func (s *rbacService) UpdateRole(ctx context.Context, id uint, displayName, description string) (*entity.Role, error) {
+func (s *rbacService) CountRoles(ctx context.Context) (int64, error) {
+	return s.roleRepo.Count(ctx)
+}
+
+func (s *rbacService) UpdateRole(ctx context.Context, id uint, displayName, description string, updatedBy uint) (*entity.Role, error) {
 	role, err := s.GetRoleByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -141,7 +190,8 @@ func (s *rbacService) UpdateRole(ctx context.Context, id uint, displayName, desc
 
 	role.DisplayName = displayName
 	role.Description = description
-	role.UpdatedAt = time.Now()
+	role.UpdatedBy = operatorID(updatedBy)
+	role.UpdatedAt = s.clock.Now()
 
 	return s.roleRepo.Update(ctx, role)
 }
@@ -156,11 +206,23 @@ func (s *rbacService) DeleteRole(ctx context.Context, id uint) error {
 		return ErrSystemRoleCannotDelete
 	}
 
-	return s.roleRepo.Delete(ctx, id)
+	if err := s.roleRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.publishPermissionsChanged(0)
+	return nil
 }
 
 // 权限管理
-func (s *rbacService) CreatePermission(ctx context.Context, name, displayName, description, resource, action string, isSystem bool) (*entity.Permission, error) {
+func (s *rbacService) CreatePermission(ctx context.Context, name, displayName, description, resource, action, category string, isSystem bool, createdBy uint) (*entity.Permission, error) {
+	if category == "" {
+		category = entity.PermissionCategoryOther
+	}
+	if !entity.IsValidPermissionCategory(category) {
+		return nil, ErrInvalidPermissionCategory
+	}
+
 	// 检查权限名称是否已存在
 	exists, err := s.permissionRepo.ExistsByName(ctx, name)
 	if err != nil {
@@ -176,14 +238,45 @@ func (s *rbacService) CreatePermission(ctx context.Context, name, displayName, d
 		Description: description,
 		Resource:    resource,
 		Action:      action,
+		Category:    category,
 		IsSystem:    isSystem,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		CreatedBy:   operatorID(createdBy),
+		CreatedAt:   s.clock.Now(),
+		UpdatedAt:   s.clock.Now(),
 	}
 
 	return s.permissionRepo.Create(ctx, permission)
 }
 
+// CreatePermissions 批量创建权限，任一项分类无效时不创建任何权限
+func (s *rbacService) CreatePermissions(ctx context.Context, inputs []PermissionInput, createdBy uint) ([]*entity.Permission, error) {
+	now := s.clock.Now()
+	permissions := make([]*entity.Permission, len(inputs))
+	for i, input := range inputs {
+		category := input.Category
+		if category == "" {
+			category = entity.PermissionCategoryOther
+		}
+		if !entity.IsValidPermissionCategory(category) {
+			return nil, ErrInvalidPermissionCategory
+		}
+
+		permissions[i] = &entity.Permission{
+			Name:        input.Name,
+			DisplayName: input.DisplayName,
+			Description: input.Description,
+			Resource:    input.Resource,
+			Action:      input.Action,
+			Category:    category,
+			CreatedBy:   operatorID(createdBy),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+	}
+
+	return s.permissionRepo.CreateMissing(ctx, permissions)
+}
+
 func (s *rbacService) GetPermissionByID(ctx context.Context, id uint) (*entity.Permission, error) {
 	permission, err := s.permissionRepo.GetByID(ctx, id)
 	if err != nil {
@@ -210,7 +303,26 @@ func (s *rbacService) ListPermissions(ctx context.Context, offset, limit int) ([
 	return s.permissionRepo.List(ctx, offset, limit)
 }
 
-func (s *rbacService) UpdatePermission(ctx context.Context, id uint, displayName, description string) (*entity.Permission, error) {
+func (s *rbacService) CountPermissions(ctx context.Context) (int64, error) {
+	return s.permissionRepo.Count(ctx)
+}
+
+// ListPermissionsGrouped 获取所有权限并按分类分组
+func (s *rbacService) ListPermissionsGrouped(ctx context.Context) (map[string][]*entity.Permission, error) {
+	permissions, err := s.permissionRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := make(map[string][]*entity.Permission)
+	for _, permission := range permissions {
+		grouped[permission.Category] = append(grouped[permission.Category], permission)
+	}
+
+	return grouped, nil
+}
+
+func (s *rbacService) UpdatePermission(ctx context.Context, id uint, displayName, description string, updatedBy uint) (*entity.Permission, error) {
 	permission, err := s.GetPermissionByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -218,7 +330,8 @@ func (s *rbacService) UpdatePermission(ctx context.Context, id uint, displayName
 
 	permission.DisplayName = displayName
 	permission.Description = description
-	permission.UpdatedAt = time.Now()
+	permission.UpdatedBy = operatorID(updatedBy)
+	permission.UpdatedAt = s.clock.Now()
 
 	return s.permissionRepo.Update(ctx, permission)
 }
@@ -233,11 +346,21 @@ func (s *rbacService) DeletePermission(ctx context.Context, id uint) error {
 		return ErrSystemPermissionCannotDelete
 	}
 
-	return s.permissionRepo.Delete(ctx, id)
+	if err := s.permissionRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.publishPermissionsChanged(0)
+	return nil
 }
 
 // 用户角色管理
-func (s *rbacService) AssignRoleToUser(ctx context.Context, userID, roleID, assignerID uint) error {
+// AssignRoleToUser 为用户分配角色，expiresAt不为空时分配在该时间后失效
+func (s *rbacService) AssignRoleToUser(ctx context.Context, userID, roleID, assignerID uint, expiresAt *time.Time) error {
+	if expiresAt != nil && !expiresAt.After(s.clock.Now()) {
+		return ErrInvalidRoleExpiry
+	}
+
 	// 检查是否已经分配
 	exists, err := s.userRoleRepo.HasRole(ctx, userID, roleID)
 	if err != nil {
@@ -247,29 +370,76 @@ func (s *rbacService) AssignRoleToUser(ctx context.Context, userID, roleID, assi
 		return ErrUserRoleAlreadyExists
 	}
 
+	// 清理可能残留的已过期分配，避免唯一索引冲突
+	if err := s.userRoleRepo.RemoveRole(ctx, userID, roleID); err != nil {
+		return err
+	}
+
 	userRole := &entity.UserRole{
 		UserID:     userID,
 		RoleID:     roleID,
 		AssignedBy: assignerID,
-		AssignedAt: time.Now(),
+		AssignedAt: s.clock.Now(),
+		ExpiresAt:  expiresAt,
+	}
+
+	if _, err := s.userRoleRepo.AssignRole(ctx, userRole); err != nil {
+		return err
 	}
 
-	_, err = s.userRoleRepo.AssignRole(ctx, userRole)
-	return err
+	s.publishPermissionsChanged(userID)
+	return nil
 }
 
 func (s *rbacService) RemoveRoleFromUser(ctx context.Context, userID, roleID uint) error {
-	return s.userRoleRepo.RemoveRole(ctx, userID, roleID)
+	if err := s.userRoleRepo.RemoveRole(ctx, userID, roleID); err != nil {
+		return err
+	}
+
+	s.publishPermissionsChanged(userID)
+	return nil
+}
+
+// RemoveRolesFromUser 在事务中批量移除用户角色，roleIDs为空时移除全部角色
+func (s *rbacService) RemoveRolesFromUser(ctx context.Context, userID uint, roleIDs []uint, minRemaining int) (int, error) {
+	removed, err := s.userRoleRepo.RemoveRoles(ctx, userID, roleIDs, minRemaining)
+	if err != nil {
+		return 0, err
+	}
+
+	if removed > 0 {
+		s.publishPermissionsChanged(userID)
+	}
+	return removed, nil
 }
 
 func (s *rbacService) GetUserRoles(ctx context.Context, userID uint) ([]*entity.Role, error) {
 	return s.userRoleRepo.GetUserRoles(ctx, userID)
 }
 
+func (s *rbacService) GetRoleUsers(ctx context.Context, roleID uint) ([]*entity.User, error) {
+	return s.userRoleRepo.GetRoleUsers(ctx, roleID)
+}
+
 func (s *rbacService) HasRole(ctx context.Context, userID uint, roleName string) (bool, error) {
 	return s.userRoleRepo.HasRoleByName(ctx, userID, roleName)
 }
 
+// CleanupExpiredRoles 删除已过期的角色分配，返回删除的数量
+func (s *rbacService) CleanupExpiredRoles(ctx context.Context) (int, error) {
+	deleted, err := s.userRoleRepo.DeleteExpired(ctx, s.clock.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if deleted > 0 {
+		logger.Info("Cleaned up expired user roles", zap.Int("count", deleted))
+		s.publishPermissionsChanged(0)
+	}
+
+	return deleted, nil
+}
+
 // 角色权限管理
 func (s *rbacService) AssignPermissionToRole(ctx context.Context, roleID, permissionID, assignerID uint) error {
 	// 检查是否已经分配
@@ -285,15 +455,37 @@ func (s *rbacService) AssignPermissionToRole(ctx context.Context, roleID, permis
 		RoleID:       roleID,
 		PermissionID: permissionID,
 		AssignedBy:   assignerID,
-		AssignedAt:   time.Now(),
+		AssignedAt:   s.clock.Now(),
+	}
+
+	if _, err := s.rolePermissionRepo.AssignPermission(ctx, rolePermission); err != nil {
+		return err
 	}
 
-	_, err = s.rolePermissionRepo.AssignPermission(ctx, rolePermission)
-	return err
+	s.publishPermissionsChanged(0)
+	return nil
 }
 
 func (s *rbacService) RemovePermissionFromRole(ctx context.Context, roleID, permissionID uint) error {
-	return s.rolePermissionRepo.RemovePermission(ctx, roleID, permissionID)
+	if err := s.rolePermissionRepo.RemovePermission(ctx, roleID, permissionID); err != nil {
+		return err
+	}
+
+	s.publishPermissionsChanged(0)
+	return nil
+}
+
+// operatorID 将操作者的用户ID转换为可空字段，0表示系统操作
+func operatorID(userID uint) *uint {
+	if userID == 0 {
+		return nil
+	}
+	return &userID
+}
+
+// publishPermissionsChanged 通知用户的权限可能已变化，userID为0表示所有用户，权限缓存据此失效
+func (s *rbacService) publishPermissionsChanged(userID uint) {
+	s.eventBus.Publish(event.PermissionsChanged{UserID: userID, OccurredAt: s.clock.Now()})
 }
 
 func (s *rbacService) GetRolePermissions(ctx context.Context, roleID uint) ([]*entity.Permission, error) {
@@ -309,6 +501,10 @@ func (s *rbacService) GetUserPermissions(ctx context.Context, userID uint) ([]*e
 	return s.rolePermissionRepo.GetUserPermissions(ctx, userID)
 }
 
+func (s *rbacService) GetUsersWithPermission(ctx context.Context, resource, action string) ([]*entity.User, error) {
+	return s.rolePermissionRepo.GetPermissionUsers(ctx, resource, action)
+}
+
 // 初始化系统数据
 func (s *rbacService) InitializeSystemData(ctx context.Context) error {
 	logger.Info("Initializing RBAC system data...")
@@ -331,6 +527,7 @@ func (s *rbacService) InitializeSystemData(ctx context.Context) error {
 		return err
 	}
 
+	s.publishPermissionsChanged(0)
 	logger.Info("RBAC system data initialized successfully")
 	return nil
 }
@@ -344,6 +541,7 @@ func (s *rbacService) createSystemRoles(ctx context.Context) error {
 	}{
 		{entity.RoleNameAdmin, "管理员", "拥有系统管理权限的管理员"},
 		{entity.RoleNameUser, "普通用户", "普通用户角色"},
+		{entity.RoleNameSuperAdmin, "超级管理员", "可以模拟其他管理员登录，需同时拥有管理员角色"},
 	}
 
 	for _, roleData := range systemRoles {
@@ -352,7 +550,7 @@ func (s *rbacService) createSystemRoles(ctx context.Context) error {
 			return err
 		}
 		if !exists {
-			_, err := s.CreateRole(ctx, roleData.name, roleData.displayName, roleData.description, true)
+			_, err := s.CreateRole(ctx, roleData.name, roleData.displayName, roleData.description, true, 0)
 			if err != nil {
 				return err
 			}
@@ -365,46 +563,69 @@ func (s *rbacService) createSystemRoles(ctx context.Context) error {
 
 // createSystemPermissions 创建系统权限
 func (s *rbacService) createSystemPermissions(ctx context.Context) error {
-	systemPermissions := []struct {
+	type permissionData struct {
 		name        string
 		displayName string
 		description string
 		resource    string
 		action      string
-	}{
+		category    string
+	}
+
+	systemPermissions := []permissionData{
 		// 用户管理权限
-		{entity.PermissionUserRead, "查看用户", "查看用户信息的权限", "user", "read"},
-		{entity.PermissionUserWrite, "修改用户", "修改用户信息的权限", "user", "write"},
-		{entity.PermissionUserDelete, "删除用户", "删除用户的权限", "user", "delete"},
-		{entity.PermissionUserManage, "管理用户", "完全管理用户的权限", "user", "manage"},
+		{entity.PermissionUserRead, "查看用户", "查看用户信息的权限", "user", "read", entity.PermissionCategoryUser},
+		{entity.PermissionUserWrite, "修改用户", "修改用户信息的权限", "user", "write", entity.PermissionCategoryUser},
+		{entity.PermissionUserDelete, "删除用户", "删除用户的权限", "user", "delete", entity.PermissionCategoryUser},
+		{entity.PermissionUserManage, "管理用户", "完全管理用户的权限", "user", "manage", entity.PermissionCategoryUser},
 
 		// 角色管理权限
-		{entity.PermissionRoleRead, "查看角色", "查看角色信息的权限", "role", "read"},
-		{entity.PermissionRoleWrite, "修改角色", "修改角色信息的权限", "role", "write"},
-		{entity.PermissionRoleDelete, "删除角色", "删除角色的权限", "role", "delete"},
-		{entity.PermissionRoleManage, "管理角色", "完全管理角色的权限", "role", "manage"},
+		{entity.PermissionRoleRead, "查看角色", "查看角色信息的权限", "role", "read", entity.PermissionCategoryRBAC},
+		{entity.PermissionRoleWrite, "修改角色", "修改角色信息的权限", "role", "write", entity.PermissionCategoryRBAC},
+		{entity.PermissionRoleDelete, "删除角色", "删除角色的权限", "role", "delete", entity.PermissionCategoryRBAC},
+		{entity.PermissionRoleManage, "管理角色", "完全管理角色的权限", "role", "manage", entity.PermissionCategoryRBAC},
 
 		// 权限管理权限
-		{entity.PermissionPermissionRead, "查看权限", "查看权限信息的权限", "permission", "read"},
-		{entity.PermissionPermissionWrite, "修改权限", "修改权限信息的权限", "permission", "write"},
-		{entity.PermissionPermissionDelete, "删除权限", "删除权限的权限", "permission", "delete"},
-		{entity.PermissionPermissionManage, "管理权限", "完全管理权限的权限", "permission", "manage"},
+		{entity.PermissionPermissionRead, "查看权限", "查看权限信息的权限", "permission", "read", entity.PermissionCategoryRBAC},
+		{entity.PermissionPermissionWrite, "修改权限", "修改权限信息的权限", "permission", "write", entity.PermissionCategoryRBAC},
+		{entity.PermissionPermissionDelete, "删除权限", "删除权限的权限", "permission", "delete", entity.PermissionCategoryRBAC},
+		{entity.PermissionPermissionManage, "管理权限", "完全管理权限的权限", "permission", "manage", entity.PermissionCategoryRBAC},
 
 		// 系统管理权限
-		{entity.PermissionSystemManage, "系统管理", "系统管理权限", "system", "manage"},
+		{entity.PermissionSystemManage, "系统管理", "系统管理权限", "system", "manage", entity.PermissionCategorySystem},
+	}
+
+	// 启用通配权限时额外创建 *:* 权限，分配给管理员
+	if s.config.AdminWildcard {
+		systemPermissions = append(systemPermissions, permissionData{
+			entity.PermissionAll, "全部权限", "所有资源的所有操作权限", entity.PermissionWildcard, entity.PermissionWildcard, entity.PermissionCategorySystem,
+		})
 	}
 
 	for _, permData := range systemPermissions {
-		exists, err := s.permissionRepo.ExistsByName(ctx, permData.name)
+		existing, err := s.permissionRepo.GetByName(ctx, permData.name)
 		if err != nil {
 			return err
 		}
-		if !exists {
-			_, err := s.CreatePermission(ctx, permData.name, permData.displayName, permData.description, permData.resource, permData.action, true)
+		if existing == nil {
+			_, err := s.CreatePermission(ctx, permData.name, permData.displayName, permData.description, permData.resource, permData.action, permData.category, true, 0)
 			if err != nil {
 				return err
 			}
 			logger.Info("Created system permission", zap.String("name", permData.name))
+			continue
+		}
+
+		// 为添加分类之前创建的系统权限补充分类
+		if existing.Category != permData.category {
+			existing.Category = permData.category
+			existing.UpdatedAt = s.clock.Now()
+			if _, err := s.permissionRepo.Update(ctx, existing); err != nil {
+				return err
+			}
+			logger.Info("Updated system permission category",
+				zap.String("name", permData.name),
+				zap.String("category", permData.category))
 		}
 	}
 
@@ -419,13 +640,22 @@ func (s *rbacService) assignPermissionsToRoles(ctx context.Context) error {
 		return err
 	}
 
-	// 给管理员分配所有系统权限
-	systemPermissions, err := s.permissionRepo.GetSystemPermissions(ctx)
-	if err != nil {
-		return err
+	// 给管理员分配所有系统权限，启用通配权限时仅分配 *:*
+	var adminPermissions []*entity.Permission
+	if s.config.AdminWildcard {
+		permission, err := s.GetPermissionByName(ctx, entity.PermissionAll)
+		if err != nil {
+			return err
+		}
+		adminPermissions = []*entity.Permission{permission}
+	} else {
+		adminPermissions, err = s.permissionRepo.GetSystemPermissions(ctx)
+		if err != nil {
+			return err
+		}
 	}
 
-	for _, permission := range systemPermissions {
+	for _, permission := range adminPermissions {
 		exists, err := s.rolePermissionRepo.HasPermission(ctx, adminRole.ID, permission.ID)
 		if err != nil {
 			return err
@@ -435,7 +665,7 @@ func (s *rbacService) assignPermissionsToRoles(ctx context.Context) error {
 			rolePermission := &entity.RolePermission{
 				RoleID:       adminRole.ID,
 				PermissionID: permission.ID,
-				AssignedAt:   time.Now(),
+				AssignedAt:   s.clock.Now(),
 			}
 			_, err = s.rolePermissionRepo.AssignPermission(ctx, rolePermission)
 			if err != nil && err != ErrRolePermissionAlreadyExists {
@@ -469,7 +699,7 @@ func (s *rbacService) assignPermissionsToRoles(ctx context.Context) error {
 			rolePermission := &entity.RolePermission{
 				RoleID:       userRole.ID,
 				PermissionID: permission.ID,
-				AssignedAt:   time.Now(),
+				AssignedAt:   s.clock.Now(),
 			}
 			_, err = s.rolePermissionRepo.AssignPermission(ctx, rolePermission)
 			if err != nil && err != ErrRolePermissionAlreadyExists {
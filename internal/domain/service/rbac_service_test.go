@@ -0,0 +1,365 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nebula-live/ent"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/testutil"
+)
+
+// rbacFixture 初始化了系统数据的RBAC服务和一个普通用户
+type rbacFixture struct {
+	client *ent.Client
+	rbac   service.RBACService
+	user   *entity.User
+}
+
+func newRBACFixture(t *testing.T) *rbacFixture {
+	t.Helper()
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	user, err := userService.CreateUser(context.Background(), "bob", "bob@example.com", "Password123!", "Bob")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	return &rbacFixture{client: client, rbac: rbacService, user: user}
+}
+
+// grant 创建一个拥有指定权限的角色并分配给用户
+func (f *rbacFixture) grant(t *testing.T, roleName, resource, action string) *entity.Permission {
+	t.Helper()
+	ctx := context.Background()
+
+	role, err := f.rbac.CreateRole(ctx, roleName, roleName, "", false, 0)
+	if err != nil {
+		t.Fatalf("CreateRole() error = %v", err)
+	}
+	permission, err := f.rbac.GetPermissionByName(ctx, resource+":"+action)
+	if err != nil {
+		permission, err = f.rbac.CreatePermission(ctx, resource+":"+action, resource+":"+action, "", resource, action, entity.PermissionCategorySystem, false, 0)
+		if err != nil {
+			t.Fatalf("CreatePermission() error = %v", err)
+		}
+	}
+	if err := f.rbac.AssignPermissionToRole(ctx, role.ID, permission.ID, 0); err != nil {
+		t.Fatalf("AssignPermissionToRole() error = %v", err)
+	}
+	if err := f.rbac.AssignRoleToUser(ctx, f.user.ID, role.ID, 0, nil); err != nil {
+		t.Fatalf("AssignRoleToUser() error = %v", err)
+	}
+	return permission
+}
+
+func (f *rbacFixture) hasPermission(t *testing.T, resource, action string) bool {
+	t.Helper()
+	ok, err := f.rbac.HasPermission(context.Background(), f.user.ID, resource, action)
+	if err != nil {
+		t.Fatalf("HasPermission(%s:%s) error = %v", resource, action, err)
+	}
+	return ok
+}
+
+func TestRBACService_ResourceWildcardMatchesAnyAction(t *testing.T) {
+	f := newRBACFixture(t)
+	if f.hasPermission(t, "report", "delete") {
+		t.Fatal("user has report:delete before the grant")
+	}
+
+	f.grant(t, "reporter", "report", entity.PermissionWildcard)
+
+	if !f.hasPermission(t, "report", "delete") {
+		t.Error("report:* does not satisfy report:delete")
+	}
+	if f.hasPermission(t, "role", "delete") {
+		t.Error("report:* satisfies role:delete")
+	}
+}
+
+func TestRBACService_AllWildcardMatchesEverything(t *testing.T) {
+	f := newRBACFixture(t)
+	f.grant(t, "superuser", entity.PermissionWildcard, entity.PermissionWildcard)
+
+	for _, pair := range [][2]string{{"user", "delete"}, {"role", "write"}, {"report", "read"}} {
+		if !f.hasPermission(t, pair[0], pair[1]) {
+			t.Errorf("*:* does not satisfy %s:%s", pair[0], pair[1])
+		}
+	}
+}
+
+// newRBACServiceWithClock 创建角色分配期限使用假时钟的RBAC服务
+func newRBACServiceWithClock(t *testing.T, client *ent.Client, engine string, clk *testutil.FakeClock) service.RBACService {
+	t.Helper()
+	rbacService, err := service.NewRBACService(
+		persistence.NewRoleRepository(client),
+		persistence.NewPermissionRepository(client),
+		persistence.NewUserRoleRepository(client, clk),
+		persistence.NewRolePermissionRepository(client, clk),
+		testutil.NewEventBus(t),
+		service.RBACServiceConfig{Engine: engine, Clock: clk},
+	)
+	if err != nil {
+		t.Fatalf("NewRBACService() error = %v", err)
+	}
+	if err := rbacService.InitializeSystemData(context.Background()); err != nil {
+		t.Fatalf("InitializeSystemData() error = %v", err)
+	}
+	return rbacService
+}
+
+func TestRBACService_ExpiredRoleAssignmentStopsGranting(t *testing.T) {
+	ctx := context.Background()
+	clk := testutil.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	client := testutil.NewEntClient(t)
+	rbacService := newRBACServiceWithClock(t, client, service.RBACEngineBuiltin, clk)
+
+	user, err := testutil.NewUserService(t, client, rbacService).CreateUser(ctx, "carol", "carol@example.com", "Password123!", "Carol")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	f := &rbacFixture{client: client, rbac: rbacService, user: user}
+
+	role, err := rbacService.CreateRole(ctx, "on-call", "On call", "", false, 0)
+	if err != nil {
+		t.Fatalf("CreateRole() error = %v", err)
+	}
+	permission, err := rbacService.CreatePermission(ctx, "incident:resolve", "incident:resolve", "", "incident", "resolve", entity.PermissionCategorySystem, false, 0)
+	if err != nil {
+		t.Fatalf("CreatePermission() error = %v", err)
+	}
+	if err := rbacService.AssignPermissionToRole(ctx, role.ID, permission.ID, 0); err != nil {
+		t.Fatalf("AssignPermissionToRole() error = %v", err)
+	}
+
+	expiresAt := clk.Now().Add(time.Hour)
+	if err := rbacService.AssignRoleToUser(ctx, user.ID, role.ID, 0, &expiresAt); err != nil {
+		t.Fatalf("AssignRoleToUser() error = %v", err)
+	}
+	if !f.hasPermission(t, "incident", "resolve") {
+		t.Fatal("user lacks incident:resolve before the assignment expires")
+	}
+
+	clk.Advance(time.Hour)
+	if f.hasPermission(t, "incident", "resolve") {
+		t.Error("expired assignment still grants incident:resolve")
+	}
+	if ok, err := rbacService.HasRole(ctx, user.ID, "on-call"); err != nil || ok {
+		t.Errorf("HasRole() after expiry = %v, %v; want false", ok, err)
+	}
+	roles, err := rbacService.GetUserRoles(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetUserRoles() error = %v", err)
+	}
+	for _, r := range roles {
+		if r.ID == role.ID {
+			t.Error("GetUserRoles() still lists the expired role")
+		}
+	}
+
+	// 已过期的分配不妨碍重新分配
+	if err := rbacService.AssignRoleToUser(ctx, user.ID, role.ID, 0, nil); err != nil {
+		t.Fatalf("AssignRoleToUser() after expiry error = %v", err)
+	}
+	if !f.hasPermission(t, "incident", "resolve") {
+		t.Error("reassigned role does not grant incident:resolve")
+	}
+}
+
+// permissionMatrix 按引擎构建相同的角色和用户，返回每个用户对每项权限的检查结果
+func permissionMatrix(t *testing.T, engine string, permissions [][2]string) map[string]map[string]bool {
+	t.Helper()
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	rbacService := newRBACServiceWithClock(t, client, engine, testutil.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	// 自定义角色的权限，资源或操作为通配符时按需创建
+	customRoles := map[string][][2]string{
+		"reporter": {{"report", entity.PermissionWildcard}},
+		"root":     {{entity.PermissionWildcard, entity.PermissionWildcard}},
+		"reader":   {{"user", "read"}},
+	}
+	for name, grants := range customRoles {
+		role, err := rbacService.CreateRole(ctx, name, name, "", false, 0)
+		if err != nil {
+			t.Fatalf("CreateRole(%s) error = %v", name, err)
+		}
+		for _, grant := range grants {
+			permissionName := grant[0] + ":" + grant[1]
+			permission, err := rbacService.GetPermissionByName(ctx, permissionName)
+			if err != nil {
+				permission, err = rbacService.CreatePermission(ctx, permissionName, permissionName, "", grant[0], grant[1], entity.PermissionCategorySystem, false, 0)
+				if err != nil {
+					t.Fatalf("CreatePermission(%s) error = %v", permissionName, err)
+				}
+			}
+			if err := rbacService.AssignPermissionToRole(ctx, role.ID, permission.ID, 0); err != nil {
+				t.Fatalf("AssignPermissionToRole() error = %v", err)
+			}
+		}
+	}
+
+	userRoles := map[string][]string{
+		"dave":  nil,
+		"admin": {entity.RoleNameAdmin},
+		"erin":  {"reporter"},
+		"frank": {"root"},
+		"gina":  {"reader", "reporter"},
+	}
+	results := make(map[string]map[string]bool, len(userRoles))
+	for _, name := range []string{"dave", "admin", "erin", "frank", "gina"} {
+		user, err := userService.CreateUser(ctx, name, name+"@example.com", "Password123!", name)
+		if err != nil {
+			t.Fatalf("CreateUser(%s) error = %v", name, err)
+		}
+		for _, roleName := range userRoles[name] {
+			if err := userService.AssignRole(ctx, user.ID, roleName, 0, nil); err != nil {
+				t.Fatalf("AssignRole(%s, %s) error = %v", name, roleName, err)
+			}
+		}
+
+		results[name] = make(map[string]bool, len(permissions))
+		for _, pair := range permissions {
+			ok, err := rbacService.HasPermission(ctx, user.ID, pair[0], pair[1])
+			if err != nil {
+				t.Fatalf("%s HasPermission(%s:%s) error = %v", engine, pair[0], pair[1], err)
+			}
+			results[name][pair[0]+":"+pair[1]] = ok
+		}
+	}
+	return results
+}
+
+func TestRBACService_CasbinMatchesBuiltin(t *testing.T) {
+	permissions := [][2]string{
+		{"user", "read"}, {"user", "write"}, {"user", "manage"},
+		{"role", "delete"}, {"system", "manage"},
+		{"report", "read"}, {"report", "delete"}, {"billing", "export"},
+	}
+
+	builtin := permissionMatrix(t, service.RBACEngineBuiltin, permissions)
+	casbin := permissionMatrix(t, service.RBACEngineCasbin, permissions)
+
+	for user, checks := range builtin {
+		for permission, want := range checks {
+			if got := casbin[user][permission]; got != want {
+				t.Errorf("%s %s: casbin = %v, builtin = %v", user, permission, got, want)
+			}
+		}
+	}
+
+	// 抽查矩阵本身，避免两个引擎一致地全部拒绝
+	expected := []struct {
+		user, permission string
+		want             bool
+	}{
+		{"dave", "user:manage", false},
+		{"admin", "user:manage", true},
+		{"erin", "report:delete", true},
+		{"erin", "role:delete", false},
+		{"frank", "billing:export", true},
+		{"gina", "user:read", true},
+		{"gina", "user:write", false},
+	}
+	for _, tt := range expected {
+		if got := builtin[tt.user][tt.permission]; got != tt.want {
+			t.Errorf("builtin %s %s = %v, want %v", tt.user, tt.permission, got, tt.want)
+		}
+	}
+}
+
+func TestRBACService_SystemPermissionCategories(t *testing.T) {
+	ctx := context.Background()
+	f := newRBACFixture(t)
+
+	grouped, err := f.rbac.ListPermissionsGrouped(ctx)
+	if err != nil {
+		t.Fatalf("ListPermissionsGrouped() error = %v", err)
+	}
+	categories := map[string]string{}
+	for category, permissions := range grouped {
+		for _, permission := range permissions {
+			if permission.Category != category {
+				t.Errorf("%s grouped under %q, category = %q", permission.Name, category, permission.Category)
+			}
+			categories[permission.Name] = category
+		}
+	}
+
+	want := map[string]string{
+		entity.PermissionUserRead:         entity.PermissionCategoryUser,
+		entity.PermissionUserManage:       entity.PermissionCategoryUser,
+		entity.PermissionRoleWrite:        entity.PermissionCategoryRBAC,
+		entity.PermissionPermissionDelete: entity.PermissionCategoryRBAC,
+		entity.PermissionSystemManage:     entity.PermissionCategorySystem,
+	}
+	for name, category := range want {
+		if categories[name] != category {
+			t.Errorf("%s category = %q, want %q", name, categories[name], category)
+		}
+	}
+
+	// 未指定分类时归入other，未知分类被拒绝
+	permission, err := f.rbac.CreatePermission(ctx, "report:read", "查看报表", "", "report", "read", "", false, 0)
+	if err != nil {
+		t.Fatalf("CreatePermission() error = %v", err)
+	}
+	if permission.Category != entity.PermissionCategoryOther {
+		t.Errorf("category = %q, want %q", permission.Category, entity.PermissionCategoryOther)
+	}
+	if _, err := f.rbac.CreatePermission(ctx, "report:write", "修改报表", "", "report", "write", "reports", false, 0); !errors.Is(err, service.ErrInvalidPermissionCategory) {
+		t.Errorf("CreatePermission(unknown category) error = %v, want ErrInvalidPermissionCategory", err)
+	}
+}
+
+// 两个角色都授予同一权限时，拥有该权限的用户只返回一次
+func TestRBACService_GetUsersWithPermissionDedupes(t *testing.T) {
+	ctx := context.Background()
+	f := newRBACFixture(t)
+	userService := testutil.NewUserService(t, f.client, f.rbac)
+	carol, err := userService.CreateUser(ctx, "carol", "carol@example.com", "Password123!", "Carol")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	f.grant(t, "report-admin", "report", "delete")
+	f.grant(t, "report-owner", "report", entity.PermissionWildcard)
+	owner, err := f.rbac.GetRoleByName(ctx, "report-owner")
+	if err != nil {
+		t.Fatalf("GetRoleByName() error = %v", err)
+	}
+	if err := f.rbac.AssignRoleToUser(ctx, carol.ID, owner.ID, 0, nil); err != nil {
+		t.Fatalf("AssignRoleToUser() error = %v", err)
+	}
+
+	users, err := f.rbac.GetUsersWithPermission(ctx, "report", "delete")
+	if err != nil {
+		t.Fatalf("GetUsersWithPermission() error = %v", err)
+	}
+	if len(users) != 2 || users[0].ID != f.user.ID || users[1].ID != carol.ID {
+		t.Errorf("GetUsersWithPermission(report:delete) = %v, want bob and carol once each", users)
+	}
+
+	// 只有report:*授予report:read
+	users, err = f.rbac.GetUsersWithPermission(ctx, "report", "read")
+	if err != nil {
+		t.Fatalf("GetUsersWithPermission() error = %v", err)
+	}
+	if len(users) != 2 {
+		t.Errorf("GetUsersWithPermission(report:read) = %v, want bob and carol through report:*", users)
+	}
+	users, err = f.rbac.GetUsersWithPermission(ctx, "invoice", "delete")
+	if err != nil {
+		t.Fatalf("GetUsersWithPermission() error = %v", err)
+	}
+	if len(users) != 0 {
+		t.Errorf("GetUsersWithPermission(invoice:delete) = %v, want nobody", users)
+	}
+}
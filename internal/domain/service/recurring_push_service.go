@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/clock"
+	"nebula-live/pkg/logger"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+// 周期推送服务相关错误
+var (
+	ErrRecurringPushNotFound = errors.New("recurring push not found")
+	ErrInvalidRecurringPush  = errors.New("invalid recurring push")
+	ErrInvalidCronExpression = errors.New("invalid cron expression")
+)
+
+// RecurringPushService 周期推送服务接口
+type RecurringPushService interface {
+	// CreateRecurringPush 创建周期推送
+	CreateRecurringPush(ctx context.Context, userID uint, recurringPush *entity.RecurringPush) (*entity.RecurringPush, error)
+
+	// GetRecurringPush 获取用户的周期推送
+	GetRecurringPush(ctx context.Context, userID, id uint) (*entity.RecurringPush, error)
+
+	// ListRecurringPushes 获取用户周期推送列表（带分页）
+	ListRecurringPushes(ctx context.Context, userID uint, page, limit int) ([]*entity.RecurringPush, int64, error)
+
+	// UpdateRecurringPush 更新周期推送
+	UpdateRecurringPush(ctx context.Context, userID uint, recurringPush *entity.RecurringPush) (*entity.RecurringPush, error)
+
+	// DeleteRecurringPush 删除周期推送
+	DeleteRecurringPush(ctx context.Context, userID, id uint) error
+
+	// EnqueueDue 为到期的周期推送生成定时推送并推进下次运行时间，返回生成的数量
+	EnqueueDue(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+// recurringPushService 实现周期推送服务
+type recurringPushService struct {
+	recurringPushRepo repository.RecurringPushRepository
+	scheduledPushRepo repository.ScheduledPushRepository
+	pushService       PushService
+	clock             clock.Clock
+}
+
+// NewRecurringPushService 创建周期推送服务，clk用于计算创建和更新时的下次运行时间
+func NewRecurringPushService(
+	recurringPushRepo repository.RecurringPushRepository,
+	scheduledPushRepo repository.ScheduledPushRepository,
+	pushService PushService,
+	clk clock.Clock,
+) RecurringPushService {
+	return &recurringPushService{
+		recurringPushRepo: recurringPushRepo,
+		scheduledPushRepo: scheduledPushRepo,
+		pushService:       pushService,
+		clock:             clock.OrReal(clk),
+	}
+}
+
+// NextRunTime 解析标准cron表达式（分 时 日 月 周，支持@hourly等描述符）并计算from之后的下次运行时间
+func NextRunTime(cronExpr string, from time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(cronExpr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidCronExpression, err)
+	}
+
+	next := schedule.Next(from)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("%w: expression never fires", ErrInvalidCronExpression)
+	}
+
+	return next, nil
+}
+
+// CreateRecurringPush 创建周期推送
+func (s *recurringPushService) CreateRecurringPush(ctx context.Context, userID uint, recurringPush *entity.RecurringPush) (*entity.RecurringPush, error) {
+	if recurringPush.Title == "" || recurringPush.Body == "" {
+		return nil, ErrInvalidRecurringPush
+	}
+	if err := s.pushService.ValidateURL(recurringPush.URL); err != nil {
+		return nil, err
+	}
+
+	nextRunAt, err := NextRunTime(recurringPush.CronExpr, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	recurringPush.UserID = userID
+	recurringPush.NextRunAt = nextRunAt
+
+	created, err := s.recurringPushRepo.Create(ctx, recurringPush)
+	if err != nil {
+		logger.Error("Failed to create recurring push",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// GetRecurringPush 获取用户的周期推送
+func (s *recurringPushService) GetRecurringPush(ctx context.Context, userID, id uint) (*entity.RecurringPush, error) {
+	recurringPush, err := s.recurringPushRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if recurringPush == nil {
+		return nil, ErrRecurringPushNotFound
+	}
+
+	// 检查周期推送是否属于该用户
+	if recurringPush.UserID != userID {
+		return nil, ErrRecurringPushNotFound
+	}
+
+	return recurringPush, nil
+}
+
+// ListRecurringPushes 获取用户周期推送列表（带分页）
+func (s *recurringPushService) ListRecurringPushes(ctx context.Context, userID uint, page, limit int) ([]*entity.RecurringPush, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	// 每页数量上限由调用方（处理器层的分页配置）控制
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	recurringPushes, err := s.recurringPushRepo.List(ctx, userID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.recurringPushRepo.Count(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return recurringPushes, total, nil
+}
+
+// UpdateRecurringPush 更新周期推送
+func (s *recurringPushService) UpdateRecurringPush(ctx context.Context, userID uint, recurringPush *entity.RecurringPush) (*entity.RecurringPush, error) {
+	if _, err := s.GetRecurringPush(ctx, userID, recurringPush.ID); err != nil {
+		return nil, err
+	}
+
+	if recurringPush.Title == "" || recurringPush.Body == "" {
+		return nil, ErrInvalidRecurringPush
+	}
+	if err := s.pushService.ValidateURL(recurringPush.URL); err != nil {
+		return nil, err
+	}
+
+	// 表达式或启用状态可能已变化，从当前时间重新计算下次运行时间
+	nextRunAt, err := NextRunTime(recurringPush.CronExpr, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	recurringPush.UserID = userID
+	recurringPush.NextRunAt = nextRunAt
+
+	return s.recurringPushRepo.Update(ctx, recurringPush)
+}
+
+// DeleteRecurringPush 删除周期推送
+func (s *recurringPushService) DeleteRecurringPush(ctx context.Context, userID, id uint) error {
+	if _, err := s.GetRecurringPush(ctx, userID, id); err != nil {
+		return err
+	}
+
+	return s.recurringPushRepo.Delete(ctx, id)
+}
+
+// EnqueueDue 为到期的周期推送生成定时推送并推进下次运行时间，返回生成的数量
+//
+// 先推进下次运行时间再生成定时推送：推进成功的实例才会生成推送，
+// 因此多实例并发或重启后都不会重复生成同一次运行的推送。错过的多次运行只补发一次。
+func (s *recurringPushService) EnqueueDue(ctx context.Context, now time.Time, limit int) (int, error) {
+	duePushes, err := s.recurringPushRepo.GetDue(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	enqueued := 0
+	for _, recurringPush := range duePushes {
+		nextRunAt, err := NextRunTime(recurringPush.CronExpr, now)
+		if err != nil {
+			logger.Error("Invalid cron expression on recurring push",
+				zap.Uint("id", recurringPush.ID),
+				zap.String("cron_expr", recurringPush.CronExpr),
+				zap.Error(err))
+			continue
+		}
+
+		advanced, err := s.recurringPushRepo.Advance(ctx, recurringPush.ID, now, nextRunAt)
+		if err != nil {
+			return enqueued, err
+		}
+		// 已被其他实例处理
+		if !advanced {
+			continue
+		}
+
+		if _, err := s.scheduledPushRepo.Create(ctx, recurringPush.ToScheduledPush(now)); err != nil {
+			logger.Error("Failed to enqueue recurring push",
+				zap.Uint("id", recurringPush.ID),
+				zap.Error(err))
+			continue
+		}
+
+		enqueued++
+	}
+
+	if enqueued > 0 {
+		logger.Info("Enqueued due recurring pushes",
+			zap.Int("count", enqueued))
+	}
+
+	return enqueued, nil
+}
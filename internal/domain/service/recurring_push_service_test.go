@@ -0,0 +1,52 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/service"
+)
+
+func TestNextRunTime(t *testing.T) {
+	// 2026-01-01是星期四
+	from := time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    time.Time
+		wantErr bool
+	}{
+		{"every minute", "* * * * *", time.Date(2026, 1, 1, 12, 31, 0, 0, time.UTC), false},
+		{"later today", "0 18 * * *", time.Date(2026, 1, 1, 18, 0, 0, 0, time.UTC), false},
+		{"already passed today", "0 9 * * *", time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC), false},
+		{"exactly now is skipped", "30 12 * * *", time.Date(2026, 1, 2, 12, 30, 0, 0, time.UTC), false},
+		{"weekday", "0 9 * * MON", time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC), false},
+		{"step", "*/15 * * * *", time.Date(2026, 1, 1, 12, 45, 0, 0, time.UTC), false},
+		{"descriptor", "@hourly", time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC), false},
+		{"empty", "", time.Time{}, true},
+		{"too few fields", "0 9 * *", time.Time{}, true},
+		{"seconds field not supported", "0 0 9 * * *", time.Time{}, true},
+		{"out of range", "0 25 * * *", time.Time{}, true},
+		{"garbage", "every day", time.Time{}, true},
+		{"never fires", "0 0 30 2 *", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := service.NextRunTime(tt.expr, from)
+			if tt.wantErr {
+				if !errors.Is(err, service.ErrInvalidCronExpression) {
+					t.Errorf("NextRunTime(%q) error = %v, want ErrInvalidCronExpression", tt.expr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NextRunTime(%q) error = %v", tt.expr, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("NextRunTime(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/pkg/push"
+	"nebula-live/pkg/clock"
+	apperrors "nebula-live/pkg/errors"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// 定时推送服务相关错误
+var (
+	ErrScheduledPushNotFound   = apperrors.NewDomainError(apperrors.KindNotFound, "scheduled push not found")
+	ErrScheduledPushNotPending = apperrors.NewDomainError(apperrors.KindConflict, "scheduled push is no longer pending")
+	ErrInvalidScheduledPush    = apperrors.NewDomainError(apperrors.KindInvalid, "invalid scheduled push")
+	ErrInvalidSendTime         = apperrors.NewDomainError(apperrors.KindInvalid, "send time must be in the future")
+)
+
+// interruptedScheduledPushReason 服务重启时遗留在sending状态的推送的失败原因
+const interruptedScheduledPushReason = "interrupted before delivery was confirmed"
+
+// ScheduledPushService 定时推送服务接口
+type ScheduledPushService interface {
+	// CreateScheduledPush 创建定时推送
+	CreateScheduledPush(ctx context.Context, userID uint, scheduledPush *entity.ScheduledPush) (*entity.ScheduledPush, error)
+
+	// GetScheduledPush 获取用户的定时推送
+	GetScheduledPush(ctx context.Context, userID, id uint) (*entity.ScheduledPush, error)
+
+	// ListScheduledPushes 获取用户定时推送列表（带分页）
+	ListScheduledPushes(ctx context.Context, userID uint, page, limit int) ([]*entity.ScheduledPush, int64, error)
+
+	// UpdateScheduledPush 更新待发送的定时推送
+	UpdateScheduledPush(ctx context.Context, userID uint, scheduledPush *entity.ScheduledPush) (*entity.ScheduledPush, error)
+
+	// CancelScheduledPush 取消待发送的定时推送
+	CancelScheduledPush(ctx context.Context, userID, id uint) error
+
+	// DeleteScheduledPush 删除定时推送
+	DeleteScheduledPush(ctx context.Context, userID, id uint) error
+
+	// ProcessDuePushes 领取并发送到期的定时推送，返回处理的数量
+	ProcessDuePushes(ctx context.Context, now time.Time, limit int) (int, error)
+
+	// RecoverInterrupted 处理上次运行中断时遗留的推送，返回处理的数量
+	RecoverInterrupted(ctx context.Context) (int, error)
+}
+
+// scheduledPushService 实现定时推送服务
+type scheduledPushService struct {
+	scheduledPushRepo repository.ScheduledPushRepository
+	pushService       PushService
+	clock             clock.Clock
+}
+
+// NewScheduledPushService 创建定时推送服务，clk用于检查发送时间和记录发送时间
+func NewScheduledPushService(
+	scheduledPushRepo repository.ScheduledPushRepository,
+	pushService PushService,
+	clk clock.Clock,
+) ScheduledPushService {
+	return &scheduledPushService{
+		scheduledPushRepo: scheduledPushRepo,
+		pushService:       pushService,
+		clock:             clock.OrReal(clk),
+	}
+}
+
+// CreateScheduledPush 创建定时推送
+func (s *scheduledPushService) CreateScheduledPush(ctx context.Context, userID uint, scheduledPush *entity.ScheduledPush) (*entity.ScheduledPush, error) {
+	if scheduledPush.Title == "" || scheduledPush.Body == "" {
+		return nil, ErrInvalidScheduledPush
+	}
+	if err := s.pushService.ValidateURL(scheduledPush.URL); err != nil {
+		return nil, err
+	}
+	if !scheduledPush.SendAt.After(s.clock.Now()) {
+		return nil, ErrInvalidSendTime
+	}
+
+	scheduledPush.UserID = userID
+	scheduledPush.Status = entity.ScheduledPushStatusPending
+
+	created, err := s.scheduledPushRepo.Create(ctx, scheduledPush)
+	if err != nil {
+		logger.Error("Failed to create scheduled push",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// GetScheduledPush 获取用户的定时推送
+func (s *scheduledPushService) GetScheduledPush(ctx context.Context, userID, id uint) (*entity.ScheduledPush, error) {
+	scheduledPush, err := s.scheduledPushRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if scheduledPush == nil {
+		return nil, ErrScheduledPushNotFound
+	}
+
+	// 检查定时推送是否属于该用户
+	if scheduledPush.UserID != userID {
+		return nil, ErrScheduledPushNotFound
+	}
+
+	return scheduledPush, nil
+}
+
+// ListScheduledPushes 获取用户定时推送列表（带分页）
+func (s *scheduledPushService) ListScheduledPushes(ctx context.Context, userID uint, page, limit int) ([]*entity.ScheduledPush, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	// 每页数量上限由调用方（处理器层的分页配置）控制
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	scheduledPushes, err := s.scheduledPushRepo.List(ctx, userID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.scheduledPushRepo.Count(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return scheduledPushes, total, nil
+}
+
+// UpdateScheduledPush 更新待发送的定时推送
+func (s *scheduledPushService) UpdateScheduledPush(ctx context.Context, userID uint, scheduledPush *entity.ScheduledPush) (*entity.ScheduledPush, error) {
+	existing, err := s.GetScheduledPush(ctx, userID, scheduledPush.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.IsPending() {
+		return nil, ErrScheduledPushNotPending
+	}
+
+	if scheduledPush.Title == "" || scheduledPush.Body == "" {
+		return nil, ErrInvalidScheduledPush
+	}
+	if err := s.pushService.ValidateURL(scheduledPush.URL); err != nil {
+		return nil, err
+	}
+	if !scheduledPush.SendAt.After(s.clock.Now()) {
+		return nil, ErrInvalidSendTime
+	}
+
+	updated, err := s.scheduledPushRepo.Update(ctx, scheduledPush)
+	if err != nil {
+		return nil, err
+	}
+	// 在读取和更新之间已被调度器领取或被取消
+	if updated == nil {
+		return nil, ErrScheduledPushNotPending
+	}
+
+	return updated, nil
+}
+
+// CancelScheduledPush 取消待发送的定时推送
+func (s *scheduledPushService) CancelScheduledPush(ctx context.Context, userID, id uint) error {
+	if _, err := s.GetScheduledPush(ctx, userID, id); err != nil {
+		return err
+	}
+
+	cancelled, err := s.scheduledPushRepo.Cancel(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return ErrScheduledPushNotPending
+	}
+
+	logger.Info("Scheduled push cancelled",
+		zap.Uint("id", id),
+		zap.Uint("user_id", userID))
+
+	return nil
+}
+
+// DeleteScheduledPush 删除定时推送
+func (s *scheduledPushService) DeleteScheduledPush(ctx context.Context, userID, id uint) error {
+	scheduledPush, err := s.GetScheduledPush(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	// 正在发送的推送不允许删除，避免发送结果无处记录
+	if scheduledPush.Status == entity.ScheduledPushStatusSending {
+		return ErrScheduledPushNotPending
+	}
+
+	return s.scheduledPushRepo.Delete(ctx, id)
+}
+
+// ProcessDuePushes 领取并发送到期的定时推送，返回处理的数量
+func (s *scheduledPushService) ProcessDuePushes(ctx context.Context, now time.Time, limit int) (int, error) {
+	duePushes, err := s.scheduledPushRepo.ClaimDue(ctx, now, limit)
+
+	// 已领取的推送必须处理完，否则会一直停留在sending状态
+	for _, scheduledPush := range duePushes {
+		s.deliver(ctx, scheduledPush)
+	}
+
+	if err != nil {
+		return len(duePushes), err
+	}
+
+	if len(duePushes) > 0 {
+		logger.Info("Processed due scheduled pushes",
+			zap.Int("count", len(duePushes)))
+	}
+
+	return len(duePushes), nil
+}
+
+// RecoverInterrupted 处理上次运行中断时遗留的推送，返回处理的数量
+//
+// 遗留在sending状态的推送可能已经送达，为避免重复发送，统一标记为失败而不是重新发送。
+func (s *scheduledPushService) RecoverInterrupted(ctx context.Context) (int, error) {
+	count, err := s.scheduledPushRepo.FailInterrupted(ctx, interruptedScheduledPushReason)
+	if err != nil {
+		return 0, err
+	}
+
+	if count > 0 {
+		logger.Warn("Marked interrupted scheduled pushes as failed",
+			zap.Int("count", count))
+	}
+
+	return count, nil
+}
+
+// deliver 发送单条定时推送并记录结果
+func (s *scheduledPushService) deliver(ctx context.Context, scheduledPush *entity.ScheduledPush) {
+	message := &push.PushMessage{
+		Title: scheduledPush.Title,
+		Body:  scheduledPush.Body,
+		URL:   scheduledPush.URL,
+		Sound: scheduledPush.Sound,
+		Icon:  scheduledPush.Icon,
+		Group: scheduledPush.Group,
+		Level: push.PushLevel(scheduledPush.Level),
+	}
+
+	var responses []*push.PushResponse
+	var err error
+	if scheduledPush.Provider != "" {
+		responses, err = s.pushService.SendToUserDevicesByProvider(ctx, scheduledPush.UserID, scheduledPush.Provider, message)
+	} else {
+		responses, err = s.pushService.SendToUserDevices(ctx, scheduledPush.UserID, message)
+	}
+
+	if reason := deliveryFailureReason(responses, err); reason != "" {
+		logger.Warn("Scheduled push delivery failed",
+			zap.Uint("id", scheduledPush.ID),
+			zap.Uint("user_id", scheduledPush.UserID),
+			zap.String("reason", reason))
+		if err := s.scheduledPushRepo.MarkFailed(ctx, scheduledPush.ID, reason); err != nil {
+			logger.Error("Failed to record scheduled push failure",
+				zap.Uint("id", scheduledPush.ID),
+				zap.Error(err))
+		}
+		return
+	}
+
+	if err := s.scheduledPushRepo.MarkSent(ctx, scheduledPush.ID, s.clock.Now()); err != nil {
+		logger.Error("Failed to record scheduled push delivery",
+			zap.Uint("id", scheduledPush.ID),
+			zap.Error(err))
+	}
+}
+
+// deliveryFailureReason 根据推送结果判断是否失败，成功时返回空字符串
+//
+// 只要有一台设备推送成功即视为发送成功；相同内容刚在去重窗口内发送过时也视为成功。
+func deliveryFailureReason(responses []*push.PushResponse, err error) string {
+	if errors.Is(err, ErrDuplicatePush) {
+		return ""
+	}
+	if err != nil {
+		return err.Error()
+	}
+	if len(responses) == 0 {
+		return "no enabled push devices"
+	}
+
+	for _, resp := range responses {
+		if resp.Success {
+			return ""
+		}
+	}
+
+	if responses[0].Error != "" {
+		return responses[0].Error
+	}
+	return "all devices failed"
+}
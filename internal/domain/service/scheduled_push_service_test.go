@@ -0,0 +1,79 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/testutil"
+	apperrors "nebula-live/pkg/errors"
+)
+
+func TestScheduledPushService_ErrorsCarryKinds(t *testing.T) {
+	ctx := context.Background()
+	clk := testutil.NewFakeClock(time.Now())
+	f := newPushServiceFixture(t, service.PushServiceConfig{})
+	scheduled := service.NewScheduledPushService(persistence.NewScheduledPushRepository(f.client), f.pushService, clk)
+
+	_, err := scheduled.CreateScheduledPush(ctx, f.user.ID, &entity.ScheduledPush{Title: "标题", Body: "内容", SendAt: clk.Now()})
+	if !errors.Is(err, service.ErrInvalidSendTime) || apperrors.KindOf(err).HTTPStatus() != http.StatusBadRequest {
+		t.Errorf("CreateScheduledPush(now) error = %v, want ErrInvalidSendTime mapped to 400", err)
+	}
+	if _, err := scheduled.GetScheduledPush(ctx, f.user.ID, 999); apperrors.KindOf(err).HTTPStatus() != http.StatusNotFound {
+		t.Errorf("GetScheduledPush(missing) error = %v, want 404 kind", err)
+	}
+
+	created, err := scheduled.CreateScheduledPush(ctx, f.user.ID, &entity.ScheduledPush{Title: "标题", Body: "内容", SendAt: clk.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("CreateScheduledPush() error = %v", err)
+	}
+	if err := scheduled.CancelScheduledPush(ctx, f.user.ID, created.ID); err != nil {
+		t.Fatalf("CancelScheduledPush() error = %v", err)
+	}
+	err = scheduled.CancelScheduledPush(ctx, f.user.ID, created.ID)
+	if !errors.Is(err, service.ErrScheduledPushNotPending) || apperrors.KindOf(err).HTTPStatus() != http.StatusConflict {
+		t.Errorf("second CancelScheduledPush() error = %v, want ErrScheduledPushNotPending mapped to 409", err)
+	}
+}
+
+func TestScheduledPushService_RecoverInterruptedFailsClaimedPushes(t *testing.T) {
+	ctx := context.Background()
+	clk := testutil.NewFakeClock(time.Now())
+	f := newPushServiceFixture(t, service.PushServiceConfig{})
+	repo := persistence.NewScheduledPushRepository(f.client)
+	scheduled := service.NewScheduledPushService(repo, f.pushService, clk)
+
+	interrupted, err := scheduled.CreateScheduledPush(ctx, f.user.ID, &entity.ScheduledPush{Title: "标题", Body: "内容", SendAt: clk.Now().Add(time.Minute)})
+	if err != nil {
+		t.Fatalf("CreateScheduledPush() error = %v", err)
+	}
+	pending, err := scheduled.CreateScheduledPush(ctx, f.user.ID, &entity.ScheduledPush{Title: "标题", Body: "内容", SendAt: clk.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("CreateScheduledPush() error = %v", err)
+	}
+
+	// 调度器领取后进程退出，推送遗留在sending状态
+	clk.Advance(time.Minute)
+	if claimed, err := repo.ClaimDue(ctx, clk.Now(), 10); err != nil || len(claimed) != 1 {
+		t.Fatalf("ClaimDue() = %d pushes, error = %v; want 1", len(claimed), err)
+	}
+
+	count, err := scheduled.RecoverInterrupted(ctx)
+	if err != nil {
+		t.Fatalf("RecoverInterrupted() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("RecoverInterrupted() = %d, want 1", count)
+	}
+	if got, _ := repo.GetByID(ctx, interrupted.ID); got.Status != entity.ScheduledPushStatusFailed || got.Error == "" {
+		t.Errorf("interrupted push status = %s, error = %q; want failed with a reason", got.Status, got.Error)
+	}
+	if got, _ := repo.GetByID(ctx, pending.ID); got.Status != entity.ScheduledPushStatusPending {
+		t.Errorf("pending push status = %s, want pending", got.Status)
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/clock"
+	"nebula-live/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// 会话服务相关错误
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// SessionService 用户会话服务接口
+type SessionService interface {
+	// CreateSession 登录时创建会话，返回的会话包含首个刷新令牌ID
+	CreateSession(ctx context.Context, userID uint, userAgent, ip string) (*entity.UserSession, error)
+
+	// RotateSession 使用刷新令牌时轮换会话的刷新令牌ID，返回包含新刷新令牌ID的会话
+	// 用户已被禁用或停用时返回ErrUserBanned或ErrUserInactive，会话保留，解除后仍可刷新
+	RotateSession(ctx context.Context, userID, sessionID uint, refreshTokenID, ip string) (*entity.UserSession, error)
+
+	// ListSessions 获取用户的活跃会话
+	ListSessions(ctx context.Context, userID uint) ([]*entity.UserSession, error)
+
+	// RevokeSession 撤销用户的指定会话，其刷新令牌随即失效
+	RevokeSession(ctx context.Context, userID, sessionID uint) error
+
+	// RevokeOtherSessions 撤销用户除currentSessionID以外的所有会话，返回撤销的数量
+	RevokeOtherSessions(ctx context.Context, userID, currentSessionID uint) (int, error)
+}
+
+// SessionServiceConfig 会话服务配置
+type SessionServiceConfig struct {
+	// TTL 会话有效期，与刷新令牌有效期一致，每次刷新后顺延
+	TTL time.Duration
+	// Clock 会话有效期计算使用的时间来源，为nil时使用系统时间
+	Clock clock.Clock
+}
+
+// sessionService 实现用户会话服务
+type sessionService struct {
+	sessionRepo repository.UserSessionRepository
+	userRepo    repository.UserRepository
+	config      SessionServiceConfig
+	clock       clock.Clock
+}
+
+// NewSessionService 创建用户会话服务
+func NewSessionService(sessionRepo repository.UserSessionRepository, userRepo repository.UserRepository, config SessionServiceConfig) SessionService {
+	return &sessionService{
+		sessionRepo: sessionRepo,
+		userRepo:    userRepo,
+		config:      config,
+		clock:       clock.OrReal(config.Clock),
+	}
+}
+
+// CreateSession 登录时创建会话
+func (s *sessionService) CreateSession(ctx context.Context, userID uint, userAgent, ip string) (*entity.UserSession, error) {
+	now := s.clock.Now()
+	session := &entity.UserSession{
+		UserID:         userID,
+		RefreshTokenID: uuid.NewString(),
+		UserAgent:      truncate(userAgent, 500),
+		IP:             ip,
+		LastUsedAt:     now,
+		ExpiresAt:      now.Add(s.config.TTL),
+	}
+
+	return s.sessionRepo.Create(ctx, session)
+}
+
+// RotateSession 轮换会话的刷新令牌ID
+//
+// 刷新令牌只能使用一次。已轮换过的旧令牌再次出现说明令牌可能泄露，此时直接撤销整个会话。
+func (s *sessionService) RotateSession(ctx context.Context, userID, sessionID uint, refreshTokenID, ip string) (*entity.UserSession, error) {
+	if sessionID == 0 || refreshTokenID == "" {
+		return nil, ErrSessionNotFound
+	}
+
+	session, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	if session == nil || session.UserID != userID || session.IsExpired(now) {
+		return nil, ErrSessionNotFound
+	}
+
+	oldTokenID := session.RefreshTokenID
+	if oldTokenID != refreshTokenID {
+		s.revokeReusedSession(ctx, session)
+		return nil, ErrSessionNotFound
+	}
+
+	// 访问令牌有效期很短，在刷新时检查用户状态，禁用后最多在访问令牌过期时失去访问权限
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+	if err := checkSignInStatus(ctx, s.userRepo, user, now); err != nil {
+		return nil, err
+	}
+
+	session.RefreshTokenID = uuid.NewString()
+	session.IP = ip
+	session.LastUsedAt = now
+	session.ExpiresAt = now.Add(s.config.TTL)
+
+	rotated, err := s.sessionRepo.Rotate(ctx, session, oldTokenID)
+	if err != nil {
+		return nil, err
+	}
+	// 同一刷新令牌被并发使用，另一个请求已完成轮换
+	if !rotated {
+		s.revokeReusedSession(ctx, session)
+		return nil, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+// revokeReusedSession 撤销刷新令牌被重复使用的会话
+func (s *sessionService) revokeReusedSession(ctx context.Context, session *entity.UserSession) {
+	logger.Warn("Refresh token reuse detected, revoking session",
+		zap.Uint("session_id", session.ID),
+		zap.Uint("user_id", session.UserID))
+
+	if _, err := s.sessionRepo.Delete(ctx, session.UserID, session.ID); err != nil {
+		logger.Error("Failed to revoke session after refresh token reuse",
+			zap.Uint("session_id", session.ID),
+			zap.Error(err))
+	}
+}
+
+// ListSessions 获取用户的活跃会话
+func (s *sessionService) ListSessions(ctx context.Context, userID uint) ([]*entity.UserSession, error) {
+	return s.sessionRepo.ListActive(ctx, userID, s.clock.Now())
+}
+
+// RevokeSession 撤销用户的指定会话
+func (s *sessionService) RevokeSession(ctx context.Context, userID, sessionID uint) error {
+	deleted, err := s.sessionRepo.Delete(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrSessionNotFound
+	}
+
+	logger.Info("Session revoked",
+		zap.Uint("user_id", userID),
+		zap.Uint("session_id", sessionID))
+
+	return nil
+}
+
+// RevokeOtherSessions 撤销用户除当前会话以外的所有会话
+func (s *sessionService) RevokeOtherSessions(ctx context.Context, userID, currentSessionID uint) (int, error) {
+	revoked, err := s.sessionRepo.DeleteByUserExcept(ctx, userID, currentSessionID)
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Info("Other sessions revoked",
+		zap.Uint("user_id", userID),
+		zap.Uint("current_session_id", currentSessionID),
+		zap.Int("count", revoked))
+
+	return revoked, nil
+}
+
+// truncate 按字节截断字符串，避免超过字段长度限制
+func truncate(value string, maxLen int) string {
+	if len(value) <= maxLen {
+		return value
+	}
+	return value[:maxLen]
+}
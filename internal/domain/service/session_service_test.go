@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/testutil"
+)
+
+// banTestEnv 共用一个可控时钟的用户服务和会话服务，alice已登录一个会话
+type banTestEnv struct {
+	clock    *testutil.FakeClock
+	users    service.UserService
+	sessions service.SessionService
+	user     *entity.User
+	session  *entity.UserSession
+}
+
+func newBanTestEnv(t *testing.T) *banTestEnv {
+	t.Helper()
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	clk := testutil.NewFakeClock(time.Now())
+
+	userRepo := persistence.NewUserRepository(client)
+	users := service.NewUserService(userRepo, testutil.NewRBACService(t, client), testutil.NewEventBus(t),
+		service.UserServiceConfig{RequireRole: true, Clock: clk})
+	sessions := service.NewSessionService(persistence.NewUserSessionRepository(client), userRepo,
+		service.SessionServiceConfig{TTL: 24 * time.Hour, Clock: clk})
+
+	user, err := users.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	session, err := sessions.CreateSession(ctx, user.ID, "test-agent", "127.0.0.1")
+	if err != nil {
+		t.Fatalf("CreateSession() error = %v", err)
+	}
+	return &banTestEnv{clock: clk, users: users, sessions: sessions, user: user, session: session}
+}
+
+func TestUserService_ActiveBanBlocksLoginAndRefresh(t *testing.T) {
+	ctx := context.Background()
+	env := newBanTestEnv(t)
+
+	if err := env.users.BanUser(ctx, env.user.ID, "spam", nil); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+
+	if _, err := env.users.ValidateUser(ctx, "alice", "Password123!"); !errors.Is(err, service.ErrUserBanned) {
+		t.Errorf("ValidateUser() error = %v, want ErrUserBanned", err)
+	}
+	// 封禁前签发的刷新令牌不能继续换取新的访问令牌
+	if _, err := env.sessions.RotateSession(ctx, env.user.ID, env.session.ID, env.session.RefreshTokenID, "127.0.0.1"); !errors.Is(err, service.ErrUserBanned) {
+		t.Errorf("RotateSession() error = %v, want ErrUserBanned", err)
+	}
+
+	// 解除禁用后恢复为停用状态，停用的用户同样不能刷新
+	if err := env.users.DeactivateUser(ctx, env.user.ID, ""); err != nil {
+		t.Fatalf("DeactivateUser() error = %v", err)
+	}
+	if _, err := env.sessions.RotateSession(ctx, env.user.ID, env.session.ID, env.session.RefreshTokenID, "127.0.0.1"); !errors.Is(err, service.ErrUserInactive) {
+		t.Errorf("RotateSession() after deactivation error = %v, want ErrUserInactive", err)
+	}
+}
+
+func TestUserService_ExpiredBanAllowsLoginAndRefresh(t *testing.T) {
+	ctx := context.Background()
+	env := newBanTestEnv(t)
+
+	expiresAt := env.clock.Now().Add(time.Hour)
+	if err := env.users.BanUser(ctx, env.user.ID, "cool down", &expiresAt); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+	if _, err := env.users.ValidateUser(ctx, "alice", "Password123!"); !errors.Is(err, service.ErrUserBanned) {
+		t.Fatalf("ValidateUser() before expiry error = %v, want ErrUserBanned", err)
+	}
+
+	env.clock.Advance(time.Hour)
+
+	// 刷新时同样解除到期的禁用
+	rotated, err := env.sessions.RotateSession(ctx, env.user.ID, env.session.ID, env.session.RefreshTokenID, "127.0.0.1")
+	if err != nil {
+		t.Fatalf("RotateSession() after expiry error = %v", err)
+	}
+	if rotated.RefreshTokenID == env.session.RefreshTokenID {
+		t.Error("RotateSession() kept the old refresh token ID")
+	}
+	if _, err := env.users.ValidateUser(ctx, "alice", "Password123!"); err != nil {
+		t.Fatalf("ValidateUser() after expiry error = %v", err)
+	}
+
+	user, err := env.users.GetUserByID(ctx, env.user.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if !user.IsActive() || user.BanExpiresAt != nil {
+		t.Errorf("user status = %s, ban expires at = %v; want active with no expiry", user.Status, user.BanExpiresAt)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/pkg/push"
 	"nebula-live/pkg/logger"
 
 	"go.uber.org/zap"
@@ -18,16 +19,21 @@ var (
 	ErrInvalidUserPushSetting      = errors.New("invalid user push setting")
 	ErrDeviceAlreadyExists         = errors.New("device already exists")
 	ErrUserPushSettingUnavailable  = errors.New("user push setting service unavailable")
+	ErrDeviceLimitReached          = errors.New("push device limit reached")
+	ErrDeviceTransferToSameUser    = errors.New("device already belongs to the target user")
 )
 
 // UserPushSettingService 用户推送设置服务接口
 type UserPushSettingService interface {
-	// CreateSetting 创建用户推送设置
-	CreateSetting(ctx context.Context, userID uint, provider, deviceID, deviceName string, settings map[string]interface{}) (*entity.UserPushSetting, error)
+	// CreateSetting 创建用户推送设置，enabled为空时按配置的默认启用状态创建
+	CreateSetting(ctx context.Context, userID uint, provider, deviceID, deviceName string, settings map[string]interface{}, enabled *bool) (*entity.UserPushSetting, error)
 	
 	// GetSetting 获取用户推送设置
 	GetSetting(ctx context.Context, userID, settingID uint) (*entity.UserPushSetting, error)
 	
+	// GetSettingOwnerID 获取推送设置所属的用户ID
+	GetSettingOwnerID(ctx context.Context, settingID uint) (uint, error)
+	
 	// GetUserSettings 获取用户的所有推送设置
 	GetUserSettings(ctx context.Context, userID uint) ([]*entity.UserPushSetting, error)
 	
@@ -45,6 +51,9 @@ type UserPushSettingService interface {
 	
 	// DisableSetting 禁用推送设置
 	DisableSetting(ctx context.Context, userID, settingID uint) error
+
+	// SetAllEnabled 一次性启用或禁用用户的所有推送设置，返回状态发生变化的数量
+	SetAllEnabled(ctx context.Context, userID uint, enabled bool) (int, error)
 	
 	// DeleteSetting 删除推送设置
 	DeleteSetting(ctx context.Context, userID, settingID uint) error
@@ -54,34 +63,56 @@ type UserPushSettingService interface {
 	
 	// ListSettings 获取用户推送设置列表（带分页）
 	ListSettings(ctx context.Context, userID uint, page, limit int) ([]*entity.UserPushSetting, int64, error)
+
+	// ListSettingsByProvider 获取用户在指定提供商的推送设置列表（带分页）
+	ListSettingsByProvider(ctx context.Context, userID uint, provider string, page, limit int) ([]*entity.UserPushSetting, int64, error)
 	
 	// ValidateDeviceID 验证设备ID是否可用
 	ValidateDeviceID(ctx context.Context, provider, deviceID string) error
+
+	// TransferDevice 将fromUserID的推送设置转移给toUserID（管理员操作），返回转移后的设置
+	TransferDevice(ctx context.Context, fromUserID, toUserID, settingID uint) (*entity.UserPushSetting, error)
+}
+
+// UserPushSettingServiceConfig 用户推送设置服务配置
+type UserPushSettingServiceConfig struct {
+	// DeviceLimit 每个用户同时启用的推送设备上限，0表示不限制
+	DeviceLimit int
+	// RoleDeviceLimits 按角色名覆盖设备上限，用户有多个角色时取最大值，0表示不限制
+	RoleDeviceLimits map[string]int
+	// NewDevicesDisabled 新设备默认处于禁用状态，需要用户显式启用（opt-in）
+	NewDevicesDisabled bool
 }
 
 // userPushSettingService 实现用户推送设置服务
 type userPushSettingService struct {
 	userPushSettingRepo repository.UserPushSettingRepository
 	userRepo            repository.UserRepository
+	rbacService         RBACService
+	config              UserPushSettingServiceConfig
 }
 
 // NewUserPushSettingService 创建用户推送设置服务
 func NewUserPushSettingService(
 	userPushSettingRepo repository.UserPushSettingRepository,
 	userRepo repository.UserRepository,
+	rbacService RBACService,
+	config UserPushSettingServiceConfig,
 ) UserPushSettingService {
 	return &userPushSettingService{
 		userPushSettingRepo: userPushSettingRepo,
 		userRepo:            userRepo,
+		rbacService:         rbacService,
+		config:              config,
 	}
 }
 
 // CreateSetting 创建用户推送设置
-func (s *userPushSettingService) CreateSetting(ctx context.Context, userID uint, provider, deviceID, deviceName string, settings map[string]interface{}) (*entity.UserPushSetting, error) {
+func (s *userPushSettingService) CreateSetting(ctx context.Context, userID uint, provider, deviceID, deviceName string, settings map[string]interface{}, enabled *bool) (*entity.UserPushSetting, error) {
 	logger.Info("Creating user push setting",
 		zap.Uint("user_id", userID),
 		zap.String("provider", provider),
-		zap.String("device_id", deviceID))
+		zap.String("device_id", push.MaskDeviceID(deviceID)))
 
 	// 检查用户是否存在
 	user, err := s.userRepo.GetByID(ctx, userID)
@@ -98,22 +129,36 @@ func (s *userPushSettingService) CreateSetting(ctx context.Context, userID uint,
 	if err != nil {
 		logger.Error("Failed to check device existence",
 			zap.String("provider", provider),
-			zap.String("device_id", deviceID),
+			zap.String("device_id", push.MaskDeviceID(deviceID)),
 			zap.Error(err))
 		return nil, err
 	}
 	if exists {
 		logger.Warn("Device already exists",
 			zap.String("provider", provider),
-			zap.String("device_id", deviceID))
+			zap.String("device_id", push.MaskDeviceID(deviceID)))
 		return nil, ErrDeviceAlreadyExists
 	}
 
+	// 未指定时按配置决定新设备是否启用
+	enable := !s.config.NewDevicesDisabled
+	if enabled != nil {
+		enable = *enabled
+	}
+
+	// 启用的新设备占用一个设备名额，名额在写入的同一事务中检查
+	limit := 0
+	if enable {
+		if limit, err = s.deviceLimit(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
 	// 创建推送设置
 	setting := &entity.UserPushSetting{
 		UserID:     userID,
 		Provider:   provider,
-		Enabled:    true, // 默认启用
+		Enabled:    enable,
 		DeviceID:   deviceID,
 		DeviceName: deviceName,
 		Settings:   settings,
@@ -123,7 +168,18 @@ func (s *userPushSettingService) CreateSetting(ctx context.Context, userID uint,
 		return nil, ErrInvalidUserPushSetting
 	}
 
-	createdSetting, err := s.userPushSettingRepo.Create(ctx, setting)
+	createdSetting, err := s.userPushSettingRepo.Create(ctx, setting, limit)
+	if errors.Is(err, ErrDeviceLimitReached) {
+		logDeviceLimitReached(userID, limit)
+		return nil, err
+	}
+	if errors.Is(err, ErrDeviceAlreadyExists) {
+		// 检查之后被并发请求抢先注册
+		logger.Warn("Device already exists",
+			zap.String("provider", provider),
+			zap.String("device_id", push.MaskDeviceID(deviceID)))
+		return nil, err
+	}
 	if err != nil {
 		logger.Error("Failed to create user push setting",
 			zap.Uint("user_id", userID),
@@ -135,11 +191,25 @@ func (s *userPushSettingService) CreateSetting(ctx context.Context, userID uint,
 	logger.Info("User push setting created successfully",
 		zap.Uint("id", createdSetting.ID),
 		zap.Uint("user_id", userID),
-		zap.String("provider", provider))
+		zap.String("provider", provider),
+		zap.Bool("enabled", createdSetting.Enabled))
 
 	return createdSetting, nil
 }
 
+// GetSettingOwnerID 获取推送设置所属的用户ID
+func (s *userPushSettingService) GetSettingOwnerID(ctx context.Context, settingID uint) (uint, error) {
+	setting, err := s.userPushSettingRepo.GetByID(ctx, settingID)
+	if err != nil {
+		return 0, err
+	}
+	if setting == nil {
+		return 0, ErrUserPushSettingNotFound
+	}
+
+	return setting.UserID, nil
+}
+
 // GetSetting 获取用户推送设置
 func (s *userPushSettingService) GetSetting(ctx context.Context, userID, settingID uint) (*entity.UserPushSetting, error) {
 	setting, err := s.userPushSettingRepo.GetByID(ctx, settingID)
@@ -184,6 +254,13 @@ func (s *userPushSettingService) UpdateSetting(ctx context.Context, userID uint,
 		return nil, ErrUserPushSettingNotFound
 	}
 
+	// 重新启用时检查设备上限，并清零连续失败次数，避免下一次失败立即再次被自动禁用
+	if !existingSetting.Enabled && setting.Enabled {
+		if err := s.enable(ctx, userID, setting.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	// 更新设置
 	return s.userPushSettingRepo.Update(ctx, setting)
 }
@@ -195,8 +272,25 @@ func (s *userPushSettingService) EnableSetting(ctx context.Context, userID, sett
 		return err
 	}
 
-	setting.Enable()
-	_, err = s.userPushSettingRepo.Update(ctx, setting)
+	if setting.Enabled {
+		return nil
+	}
+
+	// 重新启用时检查设备上限，并清零连续失败次数，避免下一次失败立即再次被自动禁用
+	return s.enable(ctx, userID, setting.ID)
+}
+
+// enable 在检查设备上限的同一事务中启用推送设置
+func (s *userPushSettingService) enable(ctx context.Context, userID, settingID uint) error {
+	limit, err := s.deviceLimit(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.userPushSettingRepo.Enable(ctx, settingID, limit)
+	if errors.Is(err, ErrDeviceLimitReached) {
+		logDeviceLimitReached(userID, limit)
+	}
 	return err
 }
 
@@ -212,6 +306,34 @@ func (s *userPushSettingService) DisableSetting(ctx context.Context, userID, set
 	return err
 }
 
+// SetAllEnabled 一次性启用或禁用用户的所有推送设置，返回状态发生变化的数量
+//
+// 全部启用后超过设备上限时不做任何修改，返回ErrDeviceLimitReached。
+func (s *userPushSettingService) SetAllEnabled(ctx context.Context, userID uint, enabled bool) (int, error) {
+	limit := 0
+	if enabled {
+		var err error
+		if limit, err = s.deviceLimit(ctx, userID); err != nil {
+			return 0, err
+		}
+	}
+
+	count, err := s.userPushSettingRepo.SetEnabledByUserID(ctx, userID, enabled, limit)
+	if errors.Is(err, ErrDeviceLimitReached) {
+		logDeviceLimitReached(userID, limit)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Info("User push settings updated in bulk",
+		zap.Uint("user_id", userID),
+		zap.Bool("enabled", enabled),
+		zap.Int("updated", count))
+
+	return count, nil
+}
+
 // DeleteSetting 删除推送设置
 func (s *userPushSettingService) DeleteSetting(ctx context.Context, userID, settingID uint) error {
 	// 验证设置属于该用户
@@ -233,7 +355,8 @@ func (s *userPushSettingService) ListSettings(ctx context.Context, userID uint,
 	if page < 1 {
 		page = 1
 	}
-	if limit < 1 || limit > 100 {
+	// 每页数量上限由调用方（处理器层的分页配置）控制
+	if limit < 1 {
 		limit = 10
 	}
 
@@ -252,6 +375,67 @@ func (s *userPushSettingService) ListSettings(ctx context.Context, userID uint,
 	return settings, total, nil
 }
 
+// ListSettingsByProvider 获取用户在指定提供商的推送设置列表（带分页）
+func (s *userPushSettingService) ListSettingsByProvider(ctx context.Context, userID uint, provider string, page, limit int) ([]*entity.UserPushSetting, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	settings, err := s.userPushSettingRepo.ListByProvider(ctx, userID, provider, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.userPushSettingRepo.CountByProvider(ctx, userID, provider)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return settings, total, nil
+}
+
+// logDeviceLimitReached 记录用户启用的设备数达到上限，禁用或删除设备会释放名额
+func logDeviceLimitReached(userID uint, limit int) {
+	logger.Warn("Push device limit reached",
+		zap.Uint("user_id", userID),
+		zap.Int("limit", limit))
+}
+
+// deviceLimit 返回用户的设备上限，角色覆盖值优先于默认值，0表示不限制
+func (s *userPushSettingService) deviceLimit(ctx context.Context, userID uint) (int, error) {
+	if len(s.config.RoleDeviceLimits) == 0 || s.rbacService == nil {
+		return s.config.DeviceLimit, nil
+	}
+
+	roles, err := s.rbacService.GetUserRoles(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	limit, overridden := 0, false
+	for _, role := range roles {
+		roleLimit, ok := s.config.RoleDeviceLimits[role.Name]
+		if !ok {
+			continue
+		}
+		if roleLimit <= 0 {
+			return 0, nil
+		}
+		limit = max(limit, roleLimit)
+		overridden = true
+	}
+
+	if !overridden {
+		return s.config.DeviceLimit, nil
+	}
+	return limit, nil
+}
+
 // ValidateDeviceID 验证设备ID是否可用
 func (s *userPushSettingService) ValidateDeviceID(ctx context.Context, provider, deviceID string) error {
 	exists, err := s.userPushSettingRepo.ExistsByProviderAndDeviceID(ctx, provider, deviceID)
@@ -262,4 +446,52 @@ func (s *userPushSettingService) ValidateDeviceID(ctx context.Context, provider,
 		return ErrDeviceAlreadyExists
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// TransferDevice 将fromUserID的推送设置转移给toUserID
+//
+// 设备ID和提供商不变，(provider, device_id) 的唯一性不受影响。启用的设备会占用目标用户的设备名额。
+func (s *userPushSettingService) TransferDevice(ctx context.Context, fromUserID, toUserID, settingID uint) (*entity.UserPushSetting, error) {
+	if fromUserID == toUserID {
+		return nil, ErrDeviceTransferToSameUser
+	}
+
+	if _, err := s.GetSetting(ctx, fromUserID, settingID); err != nil {
+		return nil, err
+	}
+
+	// 检查目标用户是否存在
+	user, err := s.userRepo.GetByID(ctx, toUserID)
+	if err != nil {
+		logger.Error("Failed to get user", zap.Uint("user_id", toUserID), zap.Error(err))
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	// 启用的设备占用目标用户的名额，名额在转移的同一事务中检查
+	limit, err := s.deviceLimit(ctx, toUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	transferred, err := s.userPushSettingRepo.TransferOwner(ctx, settingID, fromUserID, toUserID, limit)
+	if errors.Is(err, ErrDeviceLimitReached) {
+		logDeviceLimitReached(toUserID, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 检查之后被并发删除或转移
+	if !transferred {
+		return nil, ErrUserPushSettingNotFound
+	}
+
+	logger.Info("User push setting transferred",
+		zap.Uint("id", settingID),
+		zap.Uint("from_user_id", fromUserID),
+		zap.Uint("to_user_id", toUserID))
+
+	return s.GetSetting(ctx, toUserID, settingID)
+}
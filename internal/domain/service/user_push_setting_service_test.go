@@ -0,0 +1,340 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"nebula-live/ent"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/testutil"
+)
+
+// pushSettingFixture 两个普通用户和按配置创建的推送设置服务
+type pushSettingFixture struct {
+	client      *ent.Client
+	rbacService service.RBACService
+	settings    service.UserPushSettingService
+	alice       *entity.User
+	bob         *entity.User
+}
+
+func newPushSettingFixture(t *testing.T, config service.UserPushSettingServiceConfig) *pushSettingFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	alice, err := userService.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	bob, err := userService.CreateUser(ctx, "bob", "bob@example.com", "Password123!", "Bob")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	settings := service.NewUserPushSettingService(
+		persistence.NewUserPushSettingRepository(client),
+		persistence.NewUserRepository(client),
+		rbacService,
+		config,
+	)
+	return &pushSettingFixture{client: client, rbacService: rbacService, settings: settings, alice: alice, bob: bob}
+}
+
+// addDevice 为用户添加一个Bark设备
+func (f *pushSettingFixture) addDevice(t *testing.T, user *entity.User, deviceID string, enabled bool) (*entity.UserPushSetting, error) {
+	t.Helper()
+	return f.settings.CreateSetting(context.Background(), user.ID, "bark", deviceID, deviceID, nil, &enabled)
+}
+
+// mustAddDevice 添加设备，失败时终止测试
+func (f *pushSettingFixture) mustAddDevice(t *testing.T, user *entity.User, deviceID string, enabled bool) *entity.UserPushSetting {
+	t.Helper()
+	setting, err := f.addDevice(t, user, deviceID, enabled)
+	if err != nil {
+		t.Fatalf("CreateSetting(%s) error = %v", deviceID, err)
+	}
+	return setting
+}
+
+func TestUserPushSettingService_DeviceLimit(t *testing.T) {
+	ctx := context.Background()
+	f := newPushSettingFixture(t, service.UserPushSettingServiceConfig{DeviceLimit: 2})
+
+	first := f.mustAddDevice(t, f.alice, "device-1", true)
+	f.mustAddDevice(t, f.alice, "device-2", true)
+
+	if _, err := f.addDevice(t, f.alice, "device-3", true); !errors.Is(err, service.ErrDeviceLimitReached) {
+		t.Fatalf("CreateSetting() over the limit error = %v, want ErrDeviceLimitReached", err)
+	}
+	// 达到上限的请求不会留下设备
+	if err := f.settings.ValidateDeviceID(ctx, "bark", "device-3"); err != nil {
+		t.Errorf("device-3 was stored after being rejected: %v", err)
+	}
+
+	// 禁用的设备不占用名额，但启用时受上限限制
+	disabled := f.mustAddDevice(t, f.alice, "device-3", false)
+	if err := f.settings.EnableSetting(ctx, f.alice.ID, disabled.ID); !errors.Is(err, service.ErrDeviceLimitReached) {
+		t.Errorf("EnableSetting() over the limit error = %v, want ErrDeviceLimitReached", err)
+	}
+	disabled.Enabled = true
+	if _, err := f.settings.UpdateSetting(ctx, f.alice.ID, disabled); !errors.Is(err, service.ErrDeviceLimitReached) {
+		t.Errorf("UpdateSetting(enabled) over the limit error = %v, want ErrDeviceLimitReached", err)
+	}
+	if _, err := f.settings.SetAllEnabled(ctx, f.alice.ID, true); !errors.Is(err, service.ErrDeviceLimitReached) {
+		t.Errorf("SetAllEnabled(true) over the limit error = %v, want ErrDeviceLimitReached", err)
+	}
+	enabled, err := f.settings.GetEnabledUserSettings(ctx, f.alice.ID)
+	if err != nil {
+		t.Fatalf("GetEnabledUserSettings() error = %v", err)
+	}
+	if len(enabled) != 2 {
+		t.Errorf("enabled devices = %d, want 2", len(enabled))
+	}
+
+	// 名额按用户计算
+	f.mustAddDevice(t, f.bob, "device-4", true)
+
+	// 禁用设备释放名额
+	if err := f.settings.DisableSetting(ctx, f.alice.ID, first.ID); err != nil {
+		t.Fatalf("DisableSetting() error = %v", err)
+	}
+	if err := f.settings.EnableSetting(ctx, f.alice.ID, disabled.ID); err != nil {
+		t.Errorf("EnableSetting() after freeing a slot error = %v", err)
+	}
+}
+
+func TestUserPushSettingService_RoleDeviceLimitOverridesDefault(t *testing.T) {
+	ctx := context.Background()
+	f := newPushSettingFixture(t, service.UserPushSettingServiceConfig{
+		DeviceLimit:      1,
+		RoleDeviceLimits: map[string]int{entity.RoleNameAdmin: 0},
+	})
+
+	f.mustAddDevice(t, f.alice, "device-1", true)
+	if _, err := f.addDevice(t, f.alice, "device-2", true); !errors.Is(err, service.ErrDeviceLimitReached) {
+		t.Fatalf("CreateSetting() over the default limit error = %v, want ErrDeviceLimitReached", err)
+	}
+
+	// 管理员角色的覆盖值0表示不限制
+	role, err := f.rbacService.GetRoleByName(ctx, entity.RoleNameAdmin)
+	if err != nil {
+		t.Fatalf("GetRoleByName() error = %v", err)
+	}
+	if err := f.rbacService.AssignRoleToUser(ctx, f.alice.ID, role.ID, f.alice.ID, nil); err != nil {
+		t.Fatalf("AssignRoleToUser() error = %v", err)
+	}
+	for _, deviceID := range []string{"device-2", "device-3"} {
+		f.mustAddDevice(t, f.alice, deviceID, true)
+	}
+}
+
+func TestUserPushSettingService_TransferDevice(t *testing.T) {
+	ctx := context.Background()
+	f := newPushSettingFixture(t, service.UserPushSettingServiceConfig{DeviceLimit: 1})
+	setting := f.mustAddDevice(t, f.alice, "device-1", true)
+
+	if _, err := f.settings.TransferDevice(ctx, f.alice.ID, f.alice.ID, setting.ID); !errors.Is(err, service.ErrDeviceTransferToSameUser) {
+		t.Errorf("TransferDevice() to self error = %v, want ErrDeviceTransferToSameUser", err)
+	}
+	if _, err := f.settings.TransferDevice(ctx, f.alice.ID, 9999, setting.ID); !errors.Is(err, service.ErrUserNotFound) {
+		t.Errorf("TransferDevice() to a missing user error = %v, want ErrUserNotFound", err)
+	}
+	if _, err := f.settings.TransferDevice(ctx, f.bob.ID, f.alice.ID, setting.ID); !errors.Is(err, service.ErrUserPushSettingNotFound) {
+		t.Errorf("TransferDevice() from a non-owner error = %v, want ErrUserPushSettingNotFound", err)
+	}
+
+	// 目标用户的名额已满时不能转入启用的设备，禁用的设备不受限制
+	f.mustAddDevice(t, f.bob, "device-2", true)
+	if _, err := f.settings.TransferDevice(ctx, f.alice.ID, f.bob.ID, setting.ID); !errors.Is(err, service.ErrDeviceLimitReached) {
+		t.Errorf("TransferDevice() over the target limit error = %v, want ErrDeviceLimitReached", err)
+	}
+	if err := f.settings.DisableSetting(ctx, f.alice.ID, setting.ID); err != nil {
+		t.Fatalf("DisableSetting() error = %v", err)
+	}
+
+	transferred, err := f.settings.TransferDevice(ctx, f.alice.ID, f.bob.ID, setting.ID)
+	if err != nil {
+		t.Fatalf("TransferDevice() error = %v", err)
+	}
+	if transferred.UserID != f.bob.ID || transferred.DeviceID != "device-1" || transferred.Provider != "bark" {
+		t.Errorf("transferred = %+v, want bark device-1 owned by %d", transferred, f.bob.ID)
+	}
+	if _, err := f.settings.GetSetting(ctx, f.alice.ID, setting.ID); !errors.Is(err, service.ErrUserPushSettingNotFound) {
+		t.Errorf("GetSetting() for the previous owner error = %v, want ErrUserPushSettingNotFound", err)
+	}
+}
+
+// deletingTransferRepo 在转移前删除设置，模拟所有权检查之后的并发删除
+type deletingTransferRepo struct {
+	repository.UserPushSettingRepository
+}
+
+func (r deletingTransferRepo) TransferOwner(ctx context.Context, id, fromUserID, toUserID uint, enabledLimit int) (bool, error) {
+	if err := r.Delete(ctx, id); err != nil {
+		return false, err
+	}
+	return r.UserPushSettingRepository.TransferOwner(ctx, id, fromUserID, toUserID, enabledLimit)
+}
+
+func TestUserPushSettingService_TransferDeviceDeletedConcurrently(t *testing.T) {
+	ctx := context.Background()
+	f := newPushSettingFixture(t, service.UserPushSettingServiceConfig{})
+	setting := f.mustAddDevice(t, f.alice, "device-1", true)
+
+	settings := service.NewUserPushSettingService(
+		deletingTransferRepo{persistence.NewUserPushSettingRepository(f.client)},
+		persistence.NewUserRepository(f.client),
+		f.rbacService,
+		service.UserPushSettingServiceConfig{},
+	)
+	if _, err := settings.TransferDevice(ctx, f.alice.ID, f.bob.ID, setting.ID); !errors.Is(err, service.ErrUserPushSettingNotFound) {
+		t.Errorf("TransferDevice() after a concurrent delete error = %v, want ErrUserPushSettingNotFound", err)
+	}
+	if got, err := f.settings.GetUserSettings(ctx, f.bob.ID); err != nil || len(got) != 0 {
+		t.Errorf("GetUserSettings(bob) = %d settings, %v; want none", len(got), err)
+	}
+}
+
+func TestUserPushSettingService_ListSettingsByProviderPaginates(t *testing.T) {
+	ctx := context.Background()
+	f := newPushSettingFixture(t, service.UserPushSettingServiceConfig{})
+	enabled := true
+	for i := 1; i <= 5; i++ {
+		f.mustAddDevice(t, f.alice, "alice-bark-"+strconv.Itoa(i), true)
+	}
+	for _, address := range []string{"alice@example.com", "alice@example.org"} {
+		if _, err := f.settings.CreateSetting(ctx, f.alice.ID, "email", address, address, nil, &enabled); err != nil {
+			t.Fatalf("CreateSetting(%s) error = %v", address, err)
+		}
+	}
+	f.mustAddDevice(t, f.bob, "bob-bark", true)
+
+	// 总数只统计该用户在该提供商下的设备，不受分页影响
+	seen := map[string]bool{}
+	for page, want := range map[int]int{1: 2, 2: 2, 3: 1, 4: 0} {
+		settings, total, err := f.settings.ListSettingsByProvider(ctx, f.alice.ID, "bark", page, 2)
+		if err != nil {
+			t.Fatalf("ListSettingsByProvider(page %d) error = %v", page, err)
+		}
+		if total != 5 || len(settings) != want {
+			t.Errorf("page %d = %d settings (total %d), want %d (total 5)", page, len(settings), total, want)
+		}
+		for _, setting := range settings {
+			if setting.Provider != "bark" || setting.UserID != f.alice.ID || seen[setting.DeviceID] {
+				t.Errorf("page %d returned %s/%s of user %d", page, setting.Provider, setting.DeviceID, setting.UserID)
+			}
+			seen[setting.DeviceID] = true
+		}
+	}
+	if len(seen) != 5 {
+		t.Errorf("pages returned %d distinct devices, want 5", len(seen))
+	}
+
+	settings, total, err := f.settings.ListSettingsByProvider(ctx, f.alice.ID, "email", 1, 10)
+	if err != nil || total != 2 || len(settings) != 2 {
+		t.Errorf("ListSettingsByProvider(email) = %d settings (total %d), %v; want 2", len(settings), total, err)
+	}
+}
+
+func TestUserPushSettingService_NewDevicesDisabled(t *testing.T) {
+	ctx := context.Background()
+	f := newPushSettingFixture(t, service.UserPushSettingServiceConfig{NewDevicesDisabled: true, DeviceLimit: 1})
+
+	// opt-in模式下未指定enabled的新设备默认禁用，且不占用设备名额
+	first, err := f.settings.CreateSetting(ctx, f.alice.ID, "bark", "device-1", "iPhone", nil, nil)
+	if err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+	second, err := f.settings.CreateSetting(ctx, f.alice.ID, "bark", "device-2", "iPad", nil, nil)
+	if err != nil {
+		t.Fatalf("CreateSetting() for a second disabled device error = %v", err)
+	}
+	if first.Enabled || second.Enabled {
+		t.Fatalf("new devices enabled = %v, %v; want both disabled", first.Enabled, second.Enabled)
+	}
+	enabled, err := f.settings.GetEnabledUserSettings(ctx, f.alice.ID)
+	if err != nil {
+		t.Fatalf("GetEnabledUserSettings() error = %v", err)
+	}
+	if len(enabled) != 0 {
+		t.Fatalf("enabled devices = %d before an explicit enable, want 0", len(enabled))
+	}
+
+	// 显式启用后才会接收推送
+	if err := f.settings.EnableSetting(ctx, f.alice.ID, first.ID); err != nil {
+		t.Fatalf("EnableSetting() error = %v", err)
+	}
+	enabled, err = f.settings.GetEnabledUserSettings(ctx, f.alice.ID)
+	if err != nil {
+		t.Fatalf("GetEnabledUserSettings() error = %v", err)
+	}
+	if len(enabled) != 1 || enabled[0].ID != first.ID {
+		t.Errorf("enabled devices = %v, want only device-1", enabled)
+	}
+
+	// 请求中显式的enabled优先于配置
+	explicit := true
+	if _, err := f.settings.CreateSetting(ctx, f.bob.ID, "bark", "device-3", "Mac", nil, &explicit); err != nil {
+		t.Fatalf("CreateSetting(enabled=true) error = %v", err)
+	}
+	enabled, err = f.settings.GetEnabledUserSettings(ctx, f.bob.ID)
+	if err != nil {
+		t.Fatalf("GetEnabledUserSettings() error = %v", err)
+	}
+	if len(enabled) != 1 {
+		t.Errorf("bob's enabled devices = %d, want the explicitly enabled device", len(enabled))
+	}
+
+	// 默认配置下新设备仍然启用
+	defaults := newPushSettingFixture(t, service.UserPushSettingServiceConfig{})
+	setting, err := defaults.settings.CreateSetting(ctx, defaults.alice.ID, "bark", "device-1", "iPhone", nil, nil)
+	if err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+	if !setting.Enabled {
+		t.Error("new device is disabled without new_devices_disabled, want enabled")
+	}
+}
+
+// staleExistsRepo 的存在性检查总是返回不存在，模拟两个并发请求都在对方写入之前通过了检查
+type staleExistsRepo struct {
+	repository.UserPushSettingRepository
+}
+
+func (r staleExistsRepo) ExistsByProviderAndDeviceID(ctx context.Context, provider, deviceID string) (bool, error) {
+	return false, nil
+}
+
+func TestUserPushSettingService_ConcurrentDuplicateDeviceConflicts(t *testing.T) {
+	ctx := context.Background()
+	f := newPushSettingFixture(t, service.UserPushSettingServiceConfig{})
+	settings := service.NewUserPushSettingService(
+		staleExistsRepo{persistence.NewUserPushSettingRepository(f.client)},
+		persistence.NewUserRepository(f.client),
+		f.rbacService,
+		service.UserPushSettingServiceConfig{},
+	)
+
+	// 两个请求都通过了检查，先写入的成功，后写入的被唯一索引拦截并返回设备已存在而不是数据库错误
+	if _, err := settings.CreateSetting(ctx, f.alice.ID, "bark", "device-1", "iPhone", nil, nil); err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+	if _, err := settings.CreateSetting(ctx, f.bob.ID, "bark", "device-1", "iPhone", nil, nil); !errors.Is(err, service.ErrDeviceAlreadyExists) {
+		t.Errorf("CreateSetting() for the losing request error = %v, want ErrDeviceAlreadyExists", err)
+	}
+	if got, err := f.settings.GetUserSettings(ctx, f.bob.ID); err != nil || len(got) != 0 {
+		t.Errorf("GetUserSettings(bob) = %d settings, %v; want none", len(got), err)
+	}
+	if err := f.settings.ValidateDeviceID(ctx, "bark", "device-1"); !errors.Is(err, service.ErrDeviceAlreadyExists) {
+		t.Errorf("ValidateDeviceID() error = %v, want the device registered once", err)
+	}
+}
@@ -3,10 +3,17 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/event"
 	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/pkg/eventbus"
+	"nebula-live/internal/pkg/push"
+	"nebula-live/pkg/clock"
+	apperrors "nebula-live/pkg/errors"
 	"nebula-live/pkg/logger"
 	"nebula-live/pkg/security"
 
@@ -14,13 +21,48 @@ import (
 )
 
 var (
-	ErrUserNotFound       = errors.New("user not found")
-	ErrUserAlreadyExists  = errors.New("user already exists")
+	ErrUserNotFound      = apperrors.NewDomainError(apperrors.KindNotFound, "user not found")
+	ErrUserAlreadyExists = apperrors.NewDomainError(apperrors.KindConflict, "user already exists")
+	// ErrInvalidCredentials 认证失败，由认证处理器返回401，不归入领域错误类别
 	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrUserBanned         = errors.New("user is banned")
-	ErrUserInactive       = errors.New("user is inactive")
+	ErrUserBanned         = apperrors.NewDomainError(apperrors.KindForbidden, "user is banned")
+	ErrUserInactive       = apperrors.NewDomainError(apperrors.KindForbidden, "user is inactive")
+	ErrUserMustHaveRole   = apperrors.NewDomainError(apperrors.KindConflict, "user must have at least one role")
+	ErrInvalidPushLevel   = apperrors.NewDomainError(apperrors.KindInvalid, "invalid push level")
+	// ErrImpersonateSelf 管理员不能模拟自己
+	ErrImpersonateSelf = apperrors.NewDomainError(apperrors.KindInvalid, "cannot impersonate yourself")
+	// ErrImpersonateAdmin 只有超级管理员可以模拟其他管理员
+	ErrImpersonateAdmin = apperrors.NewDomainError(apperrors.KindForbidden, "only super admins can impersonate administrators")
+	// ErrInvalidStatusTransition 状态转换规则不允许的变更，具体状态见StatusTransitionError
+	ErrInvalidStatusTransition = apperrors.NewDomainError(apperrors.KindConflict, "invalid user status transition")
+	// ErrStatusReasonRequired 禁用用户时必须填写原因
+	ErrStatusReasonRequired = apperrors.NewDomainError(apperrors.KindInvalid, "status change reason is required")
+	// ErrInvalidBanExpiry 禁用到期时间必须晚于当前时间
+	ErrInvalidBanExpiry = apperrors.NewDomainError(apperrors.KindInvalid, "ban expiry must be in the future")
 )
 
+// StatusTransitionError 状态转换规则不允许的变更，包装ErrInvalidStatusTransition
+type StatusTransitionError struct {
+	From entity.UserStatus
+	To   entity.UserStatus
+}
+
+// Error 返回错误消息
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("%s: %s -> %s", ErrInvalidStatusTransition, e.From, e.To)
+}
+
+// Unwrap 返回ErrInvalidStatusTransition，使errors.Is成立并让处理器得到错误类别
+func (e *StatusTransitionError) Unwrap() error {
+	return ErrInvalidStatusTransition
+}
+
+// bootstrapPasswordBytes 引导管理员随机密码的字节数
+const bootstrapPasswordBytes = 18
+
+// banExpiredReason 临时禁用到期自动解除时记录的状态变更原因
+const banExpiredReason = "ban expired"
+
 // UserService 用户领域服务接口
 type UserService interface {
 	// CreateUser 创建用户
@@ -29,9 +71,16 @@ type UserService interface {
 	// CreateUserWithRole 创建用户并分配指定角色
 	CreateUserWithRole(ctx context.Context, username, email, password, nickname, roleName string, assignerID uint) (*entity.User, error)
 
+	// BootstrapAdmin 在系统中还没有管理员时创建首个管理员，password为空时生成随机密码
+	// 返回创建的用户和实际使用的密码，已存在管理员时返回nil且不做任何修改
+	BootstrapAdmin(ctx context.Context, username, email, password string) (*entity.User, string, error)
+
 	// GetUserByID 根据ID获取用户
 	GetUserByID(ctx context.Context, id uint) (*entity.User, error)
 
+	// GetUsersByIDs 根据ID批量获取用户，重复和不存在的ID被跳过，结果按ID升序
+	GetUsersByIDs(ctx context.Context, ids []uint) ([]*entity.User, error)
+
 	// GetUserByUsername 根据用户名获取用户
 	GetUserByUsername(ctx context.Context, username string) (*entity.User, error)
 
@@ -50,25 +99,37 @@ type UserService interface {
 	// CountUsers 获取用户总数
 	CountUsers(ctx context.Context) (int64, error)
 
-	// ValidateUser 验证用户凭证
+	// ValidateUser 验证用户凭证，username也可以是邮箱
 	ValidateUser(ctx context.Context, username, password string) (*entity.User, error)
 
-	// ActivateUser 激活用户
-	ActivateUser(ctx context.Context, id uint) error
+	// ActivateUser 激活用户，reason可为空
+	ActivateUser(ctx context.Context, id uint, reason string) error
 
-	// DeactivateUser 停用用户
-	DeactivateUser(ctx context.Context, id uint) error
+	// DeactivateUser 停用用户，reason可为空
+	DeactivateUser(ctx context.Context, id uint, reason string) error
 
-	// BanUser 禁用用户
-	BanUser(ctx context.Context, id uint) error
+	// BanUser 禁用用户，reason必填，expiresAt不为空时为临时禁用，到期后用户登录时自动解除
+	BanUser(ctx context.Context, id uint, reason string, expiresAt *time.Time) error
+
+	// GetPushPreferences 获取用户推送偏好
+	GetPushPreferences(ctx context.Context, userID uint) (*entity.UserPushPreferences, error)
+
+	// UpdatePushPreferences 更新用户推送偏好
+	UpdatePushPreferences(ctx context.Context, userID uint, preferences entity.UserPushPreferences) (*entity.UserPushPreferences, error)
 
 	// 角色管理相关方法
-	// AssignRole 为用户分配角色
-	AssignRole(ctx context.Context, userID uint, roleName string, assignerID uint) error
+	// AssignRole 为用户分配角色，expiresAt不为空时分配在该时间后失效
+	AssignRole(ctx context.Context, userID uint, roleName string, assignerID uint, expiresAt *time.Time) error
 
 	// RemoveRole 移除用户角色
 	RemoveRole(ctx context.Context, userID uint, roleName string) error
 
+	// RemoveRoles 在事务中移除用户的多个角色，返回移除的数量
+	RemoveRoles(ctx context.Context, userID uint, roleNames []string) (int, error)
+
+	// ClearRoles 在事务中移除用户的所有角色，返回移除的数量
+	ClearRoles(ctx context.Context, userID uint) (int, error)
+
 	// GetUserRoles 获取用户的所有角色
 	GetUserRoles(ctx context.Context, userID uint) ([]*entity.Role, error)
 
@@ -80,19 +141,40 @@ type UserService interface {
 
 	// HasPermission 检查用户是否拥有指定权限
 	HasPermission(ctx context.Context, userID uint, resource, action string) (bool, error)
+
+	// AuthorizeImpersonation 检查管理员能否模拟目标用户，允许时返回目标用户
+	AuthorizeImpersonation(ctx context.Context, impersonatorID, targetID uint) (*entity.User, error)
+}
+
+// UserServiceConfig 用户服务配置
+type UserServiceConfig struct {
+	// RequireRole 为true时禁止移除用户的最后一个角色
+	RequireRole bool
+	// StatusTransitions 允许的用户状态转换，为空时使用entity.DefaultUserStatusTransitions
+	StatusTransitions entity.UserStatusTransitions
+	// UsernameCaseInsensitive 为true时新用户名保存为小写，查找和唯一性检查忽略大小写
+	UsernameCaseInsensitive bool
+	// Clock 禁用期限检查和时间戳使用的时间来源，为nil时使用系统时间
+	Clock clock.Clock
 }
 
 // userService 用户领域服务实现
 type userService struct {
 	userRepo    repository.UserRepository
 	rbacService RBACService
+	eventBus    *eventbus.Bus
+	config      UserServiceConfig
+	clock       clock.Clock
 }
 
 // NewUserService 创建用户服务实例
-func NewUserService(userRepo repository.UserRepository, rbacService RBACService) UserService {
+func NewUserService(userRepo repository.UserRepository, rbacService RBACService, eventBus *eventbus.Bus, config UserServiceConfig) UserService {
 	return &userService{
 		userRepo:    userRepo,
 		rbacService: rbacService,
+		eventBus:    eventBus,
+		config:      config,
+		clock:       clock.OrReal(config.Clock),
 	}
 }
 
@@ -102,15 +184,66 @@ func (s *userService) CreateUser(ctx context.Context, username, email, password,
 	return s.CreateUserWithRole(ctx, username, email, password, nickname, entity.RoleNameUser, 0)
 }
 
+// BootstrapAdmin 在系统中还没有管理员时创建首个管理员
+//
+// 只要任一用户持有admin角色就不做任何操作，因此可以在每次启动时重复执行。
+// 用户名或邮箱已被普通用户占用时返回ErrUserAlreadyExists，不会将已有用户提升为管理员。
+func (s *userService) BootstrapAdmin(ctx context.Context, username, email, password string) (*entity.User, string, error) {
+	adminRole, err := s.rbacService.GetRoleByName(ctx, entity.RoleNameAdmin)
+	if err != nil {
+		return nil, "", err
+	}
+
+	admins, err := s.rbacService.GetRoleUsers(ctx, adminRole.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(admins) > 0 {
+		logger.Info("Admin user already exists, skipping bootstrap",
+			zap.Int("admins", len(admins)))
+		return nil, "", nil
+	}
+
+	if password == "" {
+		password, err = security.GeneratePassword(bootstrapPasswordBytes)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	user, err := s.CreateUserWithRole(ctx, username, email, password, username, entity.RoleNameAdmin, 0)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// CreateUserWithRole不会因角色分配失败而报错，这里必须确认管理员角色已生效
+	isAdmin, err := s.rbacService.HasRole(ctx, user.ID, entity.RoleNameAdmin)
+	if err != nil {
+		return nil, "", err
+	}
+	if !isAdmin {
+		return nil, "", fmt.Errorf("failed to assign admin role to user %s", username)
+	}
+
+	logger.Info("Bootstrapped admin user",
+		zap.Uint("user_id", user.ID),
+		zap.String("username", user.Username))
+
+	return user, password, nil
+}
+
 // CreateUserWithRole 创建用户并分配指定角色
 func (s *userService) CreateUserWithRole(ctx context.Context, username, email, password, nickname, roleName string, assignerID uint) (*entity.User, error) {
+	username = s.normalizeUsername(username)
+	email = entity.NormalizeEmail(email)
+
 	logger.Info("Creating new user with role",
 		zap.String("username", username),
 		zap.String("email", email),
 		zap.String("role", roleName))
 
 	// 检查用户名是否已存在
-	exists, err := s.userRepo.ExistsByUsername(ctx, username)
+	exists, err := s.existsByUsername(ctx, username)
 	if err != nil {
 		logger.Error("Failed to check username existence",
 			zap.String("username", username),
@@ -153,8 +286,8 @@ func (s *userService) CreateUserWithRole(ctx context.Context, username, email, p
 		Password:  hashedPassword,
 		Nickname:  nickname,
 		Status:    entity.UserStatusActive,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		CreatedAt: s.clock.Now(),
+		UpdatedAt: s.clock.Now(),
 	}
 
 	// 保存用户
@@ -176,7 +309,7 @@ func (s *userService) CreateUserWithRole(ctx context.Context, username, email, p
 			zap.Error(err))
 		// 即使角色分配失败，用户已创建，不回滚
 	} else {
-		err = s.rbacService.AssignRoleToUser(ctx, user.ID, role.ID, assignerID)
+		err = s.rbacService.AssignRoleToUser(ctx, user.ID, role.ID, assignerID, nil)
 		if err != nil {
 			logger.Error("Failed to assign role to new user",
 				zap.Uint("user_id", user.ID),
@@ -193,6 +326,8 @@ func (s *userService) CreateUserWithRole(ctx context.Context, username, email, p
 		zap.Uint("user_id", user.ID),
 		zap.String("username", username))
 
+	s.eventBus.Publish(event.UserCreated{User: *user, OccurredAt: s.clock.Now()})
+
 	return user, nil
 }
 
@@ -201,25 +336,62 @@ func (s *userService) GetUserByID(ctx context.Context, id uint) (*entity.User, e
 	return s.userRepo.GetByID(ctx, id)
 }
 
+// GetUsersByIDs 根据ID批量获取用户，重复和不存在的ID被跳过，结果按ID升序
+func (s *userService) GetUsersByIDs(ctx context.Context, ids []uint) ([]*entity.User, error) {
+	return s.userRepo.GetByIDs(ctx, ids)
+}
+
 // GetUserByUsername 根据用户名获取用户
 func (s *userService) GetUserByUsername(ctx context.Context, username string) (*entity.User, error) {
-	return s.userRepo.GetByUsername(ctx, username)
+	return s.getByUsername(ctx, s.normalizeUsername(username))
 }
 
 // GetUserByEmail 根据邮箱获取用户
 func (s *userService) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
-	return s.userRepo.GetByEmail(ctx, email)
+	return s.userRepo.GetByEmail(ctx, entity.NormalizeEmail(email))
+}
+
+// normalizeUsername 按配置规范化用户名
+func (s *userService) normalizeUsername(username string) string {
+	return entity.NormalizeUsername(username, s.config.UsernameCaseInsensitive)
+}
+
+// getByUsername 按配置区分或忽略大小写查找用户
+func (s *userService) getByUsername(ctx context.Context, username string) (*entity.User, error) {
+	if s.config.UsernameCaseInsensitive {
+		return s.userRepo.GetByUsernameFold(ctx, username)
+	}
+	return s.userRepo.GetByUsername(ctx, username)
+}
+
+// existsByUsername 按配置区分或忽略大小写检查用户名是否已存在
+func (s *userService) existsByUsername(ctx context.Context, username string) (bool, error) {
+	if s.config.UsernameCaseInsensitive {
+		return s.userRepo.ExistsByUsernameFold(ctx, username)
+	}
+	return s.userRepo.ExistsByUsername(ctx, username)
 }
 
 // UpdateUser 更新用户信息
 func (s *userService) UpdateUser(ctx context.Context, user *entity.User) error {
-	user.UpdatedAt = time.Now()
+	user.UpdatedAt = s.clock.Now()
 	return s.userRepo.Update(ctx, user)
 }
 
 // DeleteUser 删除用户
 func (s *userService) DeleteUser(ctx context.Context, id uint) error {
-	return s.userRepo.Delete(ctx, id)
+	// 删除前获取用户信息用于事件数据
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.eventBus.Publish(event.UserDeleted{User: *user, OccurredAt: s.clock.Now()})
+	return nil
 }
 
 // ListUsers 获取用户列表
@@ -232,9 +404,13 @@ func (s *userService) CountUsers(ctx context.Context) (int64, error) {
 	return s.userRepo.Count(ctx)
 }
 
-// ValidateUser 验证用户凭证
+// ValidateUser 验证用户凭证，username也可以是邮箱
 func (s *userService) ValidateUser(ctx context.Context, username, password string) (*entity.User, error) {
-	user, err := s.userRepo.GetByUsername(ctx, username)
+	username = s.normalizeUsername(username)
+	user, err := s.getByUsername(ctx, username)
+	if errors.Is(err, ErrUserNotFound) && strings.Contains(username, "@") {
+		user, err = s.userRepo.GetByEmail(ctx, entity.NormalizeEmail(username))
+	}
 	if err != nil {
 		return nil, ErrInvalidCredentials
 	}
@@ -251,55 +427,134 @@ func (s *userService) ValidateUser(ctx context.Context, username, password strin
 		return nil, ErrInvalidCredentials
 	}
 
-	// 检查用户状态
-	if user.IsBanned() {
-		return nil, ErrUserBanned
+	if err := checkSignInStatus(ctx, s.userRepo, user, s.clock.Now()); err != nil {
+		return nil, err
 	}
 
-	if !user.IsActive() {
-		return nil, ErrUserInactive
+	return user, nil
+}
+
+// checkSignInStatus 检查用户能否登录或刷新令牌，被禁用时返回ErrUserBanned，未激活时返回ErrUserInactive
+//
+// 临时禁用已到期时自动解除，不受状态转换规则限制。
+func checkSignInStatus(ctx context.Context, userRepo repository.UserRepository, user *entity.User, now time.Time) error {
+	if user.IsBanExpired(now) {
+		user.ChangeStatus(entity.UserStatusActive, banExpiredReason, now)
+		if err := userRepo.UpdateStatus(ctx, user); err != nil {
+			return err
+		}
+		logger.Info("Lifted expired user ban", zap.Uint("user_id", user.ID))
 	}
 
-	return user, nil
+	if user.IsBanned() {
+		return ErrUserBanned
+	}
+	if !user.IsActive() {
+		return ErrUserInactive
+	}
+	return nil
 }
 
 // ActivateUser 激活用户
-func (s *userService) ActivateUser(ctx context.Context, id uint) error {
-	user, err := s.userRepo.GetByID(ctx, id)
+func (s *userService) ActivateUser(ctx context.Context, id uint, reason string) error {
+	return s.changeStatus(ctx, id, entity.UserStatusActive, reason)
+}
+
+// DeactivateUser 停用用户
+func (s *userService) DeactivateUser(ctx context.Context, id uint, reason string) error {
+	return s.changeStatus(ctx, id, entity.UserStatusInactive, reason)
+}
+
+// BanUser 禁用用户，对已禁用的用户再次禁用时更新原因和到期时间
+func (s *userService) BanUser(ctx context.Context, id uint, reason string, expiresAt *time.Time) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrStatusReasonRequired
+	}
+	if expiresAt != nil && !expiresAt.After(s.clock.Now()) {
+		return ErrInvalidBanExpiry
+	}
+
+	user, err := s.getUserForTransition(ctx, id, entity.UserStatusBanned)
 	if err != nil {
 		return err
 	}
 
-	user.Activate()
-	return s.userRepo.Update(ctx, user)
+	user.Ban(reason, expiresAt, s.clock.Now())
+	if err := s.userRepo.UpdateStatus(ctx, user); err != nil {
+		return err
+	}
+
+	s.eventBus.Publish(event.UserBanned{User: *user, OccurredAt: s.clock.Now()})
+	return nil
 }
 
-// DeactivateUser 停用用户
-func (s *userService) DeactivateUser(ctx context.Context, id uint) error {
-	user, err := s.userRepo.GetByID(ctx, id)
+// changeStatus 按状态转换规则变更用户状态并记录原因
+func (s *userService) changeStatus(ctx context.Context, id uint, status entity.UserStatus, reason string) error {
+	user, err := s.getUserForTransition(ctx, id, status)
 	if err != nil {
 		return err
 	}
 
-	user.Deactivate()
-	return s.userRepo.Update(ctx, user)
+	user.ChangeStatus(status, strings.TrimSpace(reason), s.clock.Now())
+	return s.userRepo.UpdateStatus(ctx, user)
 }
 
-// BanUser 禁用用户
-func (s *userService) BanUser(ctx context.Context, id uint) error {
+// getUserForTransition 获取用户并检查能否转换到指定状态，不允许的转换返回*StatusTransitionError
+func (s *userService) getUserForTransition(ctx context.Context, id uint, status entity.UserStatus) (*entity.User, error) {
 	user, err := s.userRepo.GetByID(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	user.Ban()
-	return s.userRepo.Update(ctx, user)
+	if !s.statusTransitions().Allows(user.Status, status) {
+		return nil, &StatusTransitionError{From: user.Status, To: status}
+	}
+
+	return user, nil
+}
+
+// statusTransitions 返回生效的状态转换规则
+func (s *userService) statusTransitions() entity.UserStatusTransitions {
+	if len(s.config.StatusTransitions) == 0 {
+		return entity.DefaultUserStatusTransitions
+	}
+	return s.config.StatusTransitions
+}
+
+// GetPushPreferences 获取用户推送偏好
+func (s *userService) GetPushPreferences(ctx context.Context, userID uint) (*entity.UserPushPreferences, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.PushPreferences, nil
+}
+
+// UpdatePushPreferences 更新用户推送偏好，空值表示不设置默认值
+func (s *userService) UpdatePushPreferences(ctx context.Context, userID uint, preferences entity.UserPushPreferences) (*entity.UserPushPreferences, error) {
+	if preferences.DefaultLevel != "" && !push.PushLevel(preferences.DefaultLevel).IsValid() {
+		return nil, ErrInvalidPushLevel
+	}
+
+	// 只写入推送偏好字段，不读取再整体写回用户，避免覆盖并发的资料和状态变更
+	if err := s.userRepo.UpdatePushPreferences(ctx, userID, preferences); err != nil {
+		if !errors.Is(err, ErrUserNotFound) {
+			logger.Error("Failed to update user push preferences",
+				zap.Uint("user_id", userID),
+				zap.Error(err))
+		}
+		return nil, err
+	}
+
+	return &preferences, nil
 }
 
 // RBAC相关方法实现
 
-// AssignRole 为用户分配角色
-func (s *userService) AssignRole(ctx context.Context, userID uint, roleName string, assignerID uint) error {
+// AssignRole 为用户分配角色，expiresAt不为空时分配在该时间后失效
+func (s *userService) AssignRole(ctx context.Context, userID uint, roleName string, assignerID uint, expiresAt *time.Time) error {
 	// 检查用户是否存在
 	_, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
@@ -313,7 +568,7 @@ func (s *userService) AssignRole(ctx context.Context, userID uint, roleName stri
 	}
 
 	// 分配角色
-	return s.rbacService.AssignRoleToUser(ctx, userID, role.ID, assignerID)
+	return s.rbacService.AssignRoleToUser(ctx, userID, role.ID, assignerID, expiresAt)
 }
 
 // RemoveRole 移除用户角色
@@ -334,6 +589,50 @@ func (s *userService) RemoveRole(ctx context.Context, userID uint, roleName stri
 	return s.rbacService.RemoveRoleFromUser(ctx, userID, role.ID)
 }
 
+// RemoveRoles 在事务中移除用户的多个角色，返回移除的数量
+func (s *userService) RemoveRoles(ctx context.Context, userID uint, roleNames []string) (int, error) {
+	// 检查用户是否存在
+	_, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return 0, ErrUserNotFound
+	}
+
+	if len(roleNames) == 0 {
+		return 0, nil
+	}
+
+	// 获取角色，任一角色不存在时不做任何移除
+	roleIDs := make([]uint, 0, len(roleNames))
+	for _, roleName := range roleNames {
+		role, err := s.rbacService.GetRoleByName(ctx, roleName)
+		if err != nil {
+			return 0, err
+		}
+		roleIDs = append(roleIDs, role.ID)
+	}
+
+	return s.rbacService.RemoveRolesFromUser(ctx, userID, roleIDs, s.minRemainingRoles())
+}
+
+// ClearRoles 在事务中移除用户的所有角色，返回移除的数量
+func (s *userService) ClearRoles(ctx context.Context, userID uint) (int, error) {
+	// 检查用户是否存在
+	_, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return 0, ErrUserNotFound
+	}
+
+	return s.rbacService.RemoveRolesFromUser(ctx, userID, nil, s.minRemainingRoles())
+}
+
+// minRemainingRoles 移除角色后用户至少需要保留的角色数量
+func (s *userService) minRemainingRoles() int {
+	if s.config.RequireRole {
+		return 1
+	}
+	return 0
+}
+
 // GetUserRoles 获取用户的所有角色
 func (s *userService) GetUserRoles(ctx context.Context, userID uint) ([]*entity.Role, error) {
 	// 检查用户是否存在
@@ -377,3 +676,38 @@ func (s *userService) HasPermission(ctx context.Context, userID uint, resource,
 
 	return s.rbacService.HasPermission(ctx, userID, resource, action)
 }
+
+// AuthorizeImpersonation 检查管理员能否模拟目标用户
+//
+// 不能模拟自己；目标为管理员或超级管理员时要求发起者是超级管理员。
+func (s *userService) AuthorizeImpersonation(ctx context.Context, impersonatorID, targetID uint) (*entity.User, error) {
+	if impersonatorID == targetID {
+		return nil, ErrImpersonateSelf
+	}
+
+	target, err := s.userRepo.GetByID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, roleName := range []string{entity.RoleNameAdmin, entity.RoleNameSuperAdmin} {
+		privileged, err := s.rbacService.HasRole(ctx, targetID, roleName)
+		if err != nil {
+			return nil, err
+		}
+		if !privileged {
+			continue
+		}
+
+		isSuperAdmin, err := s.rbacService.HasRole(ctx, impersonatorID, entity.RoleNameSuperAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if !isSuperAdmin {
+			return nil, ErrImpersonateAdmin
+		}
+		break
+	}
+
+	return target, nil
+}
@@ -0,0 +1,387 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/testutil"
+	apperrors "nebula-live/pkg/errors"
+)
+
+// 资料更新在封禁之前读取用户、在封禁之后写入，模拟并发请求的交错
+func TestUserService_ConcurrentProfileUpdateAndBanKeepBoth(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+
+	created, err := userService.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	stale, err := userService.GetUserByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+
+	if err := userService.BanUser(ctx, created.ID, "spam", nil); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+
+	stale.Nickname = "Alice Updated"
+	if err := userService.UpdateUser(ctx, stale); err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	got, err := userService.GetUserByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if got.Status != entity.UserStatusBanned {
+		t.Errorf("status = %q, want %q: the profile update overwrote the ban", got.Status, entity.UserStatusBanned)
+	}
+	if got.StatusReason != "spam" {
+		t.Errorf("status reason = %q, want %q", got.StatusReason, "spam")
+	}
+	if got.Nickname != "Alice Updated" {
+		t.Errorf("nickname = %q, want %q: the ban overwrote the profile update", got.Nickname, "Alice Updated")
+	}
+}
+
+// 封禁在资料更新之前读取用户、在资料更新之后写入
+func TestUserService_BanAfterConcurrentProfileUpdateKeepsNickname(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+
+	created, err := userService.CreateUser(ctx, "bob", "bob@example.com", "Password123!", "Bob")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	updated, err := userService.GetUserByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	updated.Nickname = "Bob Updated"
+	if err := userService.UpdateUser(ctx, updated); err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	if err := userService.BanUser(ctx, created.ID, "abuse", nil); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+
+	got, err := userService.GetUserByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if got.Status != entity.UserStatusBanned || got.Nickname != "Bob Updated" {
+		t.Errorf("user = {status: %q, nickname: %q}, want banned with the updated nickname", got.Status, got.Nickname)
+	}
+}
+
+// 推送偏好只写入偏好字段，与基于过期数据的资料更新和封禁互不覆盖
+func TestUserService_UpdatePushPreferencesKeepsConcurrentChanges(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+
+	created, err := userService.CreateUser(ctx, "carol", "carol@example.com", "Password123!", "Carol")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	stale, err := userService.GetUserByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+
+	preferences := entity.UserPushPreferences{DefaultGroup: "alerts", DefaultSound: "bell", DefaultLevel: "timeSensitive"}
+	if _, err := userService.UpdatePushPreferences(ctx, created.ID, preferences); err != nil {
+		t.Fatalf("UpdatePushPreferences() error = %v", err)
+	}
+	if err := userService.BanUser(ctx, created.ID, "spam", nil); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+
+	// 在偏好更新之前读取的资料更新不会清除偏好
+	stale.Nickname = "Carol Updated"
+	if err := userService.UpdateUser(ctx, stale); err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	got, err := userService.GetUserByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if got.PushPreferences != preferences {
+		t.Errorf("push preferences = %+v, want %+v", got.PushPreferences, preferences)
+	}
+	if got.Status != entity.UserStatusBanned || got.Nickname != "Carol Updated" {
+		t.Errorf("user = {status: %q, nickname: %q}, want banned with the updated nickname", got.Status, got.Nickname)
+	}
+}
+
+// newUserServiceWithClock 创建使用假时钟的用户服务，用于检查状态变更时间
+func newUserServiceWithClock(t *testing.T, clk *testutil.FakeClock) service.UserService {
+	t.Helper()
+	client := testutil.NewEntClient(t)
+	return service.NewUserService(
+		persistence.NewUserRepository(client),
+		testutil.NewRBACService(t, client),
+		testutil.NewEventBus(t),
+		service.UserServiceConfig{RequireRole: true, Clock: clk},
+	)
+}
+
+func TestUserService_DisallowedStatusTransition(t *testing.T) {
+	ctx := context.Background()
+	userService := newUserServiceWithClock(t, testutil.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+
+	user, err := userService.CreateUser(ctx, "dave", "dave@example.com", "Password123!", "Dave")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if err := userService.BanUser(ctx, user.ID, "spam", nil); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+
+	// 默认规则下被禁用的用户需先恢复为停用状态才能重新激活
+	err = userService.ActivateUser(ctx, user.ID, "appeal accepted")
+	var transitionErr *service.StatusTransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("ActivateUser() error = %v, want *StatusTransitionError", err)
+	}
+	if transitionErr.From != entity.UserStatusBanned || transitionErr.To != entity.UserStatusActive {
+		t.Errorf("transition = %s -> %s, want banned -> active", transitionErr.From, transitionErr.To)
+	}
+	if !errors.Is(err, service.ErrInvalidStatusTransition) || apperrors.KindOf(err) != apperrors.KindConflict {
+		t.Errorf("ActivateUser() error = %v, want ErrInvalidStatusTransition of kind conflict", err)
+	}
+
+	got, err := userService.GetUserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if got.Status != entity.UserStatusBanned || got.StatusReason != "spam" {
+		t.Errorf("user = {status: %q, reason: %q}, want unchanged ban", got.Status, got.StatusReason)
+	}
+
+	if err := userService.DeactivateUser(ctx, user.ID, "appeal accepted"); err != nil {
+		t.Fatalf("DeactivateUser() error = %v", err)
+	}
+	if err := userService.ActivateUser(ctx, user.ID, ""); err != nil {
+		t.Errorf("ActivateUser() after deactivation error = %v", err)
+	}
+}
+
+func TestUserService_BanRecordsReason(t *testing.T) {
+	ctx := context.Background()
+	clk := testutil.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	userService := newUserServiceWithClock(t, clk)
+
+	user, err := userService.CreateUser(ctx, "erin", "erin@example.com", "Password123!", "Erin")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	if err := userService.BanUser(ctx, user.ID, "  ", nil); !errors.Is(err, service.ErrStatusReasonRequired) {
+		t.Errorf("BanUser() without reason error = %v, want ErrStatusReasonRequired", err)
+	}
+
+	clk.Advance(time.Hour)
+	expiresAt := clk.Now().Add(24 * time.Hour)
+	if err := userService.BanUser(ctx, user.ID, " spam ", &expiresAt); err != nil {
+		t.Fatalf("BanUser() error = %v", err)
+	}
+
+	got, err := userService.GetUserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if got.Status != entity.UserStatusBanned || got.StatusReason != "spam" {
+		t.Errorf("user = {status: %q, reason: %q}, want banned for %q", got.Status, got.StatusReason, "spam")
+	}
+	if got.StatusChangedAt == nil || !got.StatusChangedAt.Equal(clk.Now()) {
+		t.Errorf("status_changed_at = %v, want %v", got.StatusChangedAt, clk.Now())
+	}
+	if got.BanExpiresAt == nil || !got.BanExpiresAt.Equal(expiresAt) {
+		t.Errorf("ban_expires_at = %v, want %v", got.BanExpiresAt, expiresAt)
+	}
+}
+
+// userRoleNames 返回用户当前角色名
+func userRoleNames(t *testing.T, userService service.UserService, userID uint) map[string]bool {
+	t.Helper()
+	roles, err := userService.GetUserRoles(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("GetUserRoles() error = %v", err)
+	}
+	names := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		names[role.Name] = true
+	}
+	return names
+}
+
+// RequireRole开启时移除全部角色的请求整体回滚，不会只移除其中一部分
+func TestUserService_RemoveRolesKeepsLastRole(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+
+	user, err := userService.CreateUser(ctx, "frank", "frank@example.com", "Password123!", "Frank")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if err := userService.AssignRole(ctx, user.ID, entity.RoleNameAdmin, user.ID, nil); err != nil {
+		t.Fatalf("AssignRole() error = %v", err)
+	}
+
+	if _, err := userService.RemoveRoles(ctx, user.ID, []string{entity.RoleNameAdmin, entity.RoleNameUser}); !errors.Is(err, service.ErrUserMustHaveRole) {
+		t.Errorf("RemoveRoles(all) error = %v, want ErrUserMustHaveRole", err)
+	}
+	if got := userRoleNames(t, userService, user.ID); !got[entity.RoleNameAdmin] || !got[entity.RoleNameUser] {
+		t.Errorf("roles after rejected RemoveRoles = %v, want admin and user", got)
+	}
+
+	if _, err := userService.ClearRoles(ctx, user.ID); !errors.Is(err, service.ErrUserMustHaveRole) {
+		t.Errorf("ClearRoles() error = %v, want ErrUserMustHaveRole", err)
+	}
+	if got := userRoleNames(t, userService, user.ID); len(got) != 2 {
+		t.Errorf("roles after rejected ClearRoles = %v, want admin and user", got)
+	}
+
+	removed, err := userService.RemoveRoles(ctx, user.ID, []string{entity.RoleNameAdmin})
+	if err != nil || removed != 1 {
+		t.Fatalf("RemoveRoles(admin) = %d, %v; want 1", removed, err)
+	}
+	if got := userRoleNames(t, userService, user.ID); len(got) != 1 || !got[entity.RoleNameUser] {
+		t.Errorf("roles = %v, want only user", got)
+	}
+}
+
+func TestUserService_ClearRolesWithoutRequireRole(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := service.NewUserService(persistence.NewUserRepository(client), rbacService, testutil.NewEventBus(t), service.UserServiceConfig{})
+
+	user, err := userService.CreateUser(ctx, "grace", "grace@example.com", "Password123!", "Grace")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	removed, err := userService.ClearRoles(ctx, user.ID)
+	if err != nil || removed != 1 {
+		t.Fatalf("ClearRoles() = %d, %v; want 1", removed, err)
+	}
+	if got := userRoleNames(t, userService, user.ID); len(got) != 0 {
+		t.Errorf("roles = %v, want none", got)
+	}
+}
+
+func TestUserService_BootstrapAdminGeneratesPassword(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+
+	admin, password, err := userService.BootstrapAdmin(ctx, "root", "root@example.com", "")
+	if err != nil {
+		t.Fatalf("BootstrapAdmin() error = %v", err)
+	}
+	if admin == nil || password == "" {
+		t.Fatalf("BootstrapAdmin() = %v, %q; want an admin with a generated password", admin, password)
+	}
+	if _, err := userService.ValidateUser(ctx, "root", password); err != nil {
+		t.Errorf("ValidateUser() with the generated password error = %v", err)
+	}
+	if ok, err := userService.HasRole(ctx, admin.ID, entity.RoleNameAdmin); err != nil || !ok {
+		t.Errorf("HasRole(admin) = %v, %v; want true", ok, err)
+	}
+
+	// 已有管理员时再次执行不做任何修改
+	again, password, err := userService.BootstrapAdmin(ctx, "root2", "root2@example.com", "Password123!")
+	if err != nil || again != nil || password != "" {
+		t.Errorf("second BootstrapAdmin() = %v, %q, %v; want a no-op", again, password, err)
+	}
+	if _, err := userService.GetUserByUsername(ctx, "root2"); !errors.Is(err, service.ErrUserNotFound) {
+		t.Errorf("GetUserByUsername(root2) error = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestUserService_BootstrapAdminRefusesExistingUsername(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+
+	existing, err := userService.CreateUser(ctx, "root", "someone@example.com", "Password123!", "Someone")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	// 同名的普通用户不会被提升为管理员
+	if _, _, err := userService.BootstrapAdmin(ctx, "root", "root@example.com", "Password123!"); !errors.Is(err, service.ErrUserAlreadyExists) {
+		t.Errorf("BootstrapAdmin() error = %v, want ErrUserAlreadyExists", err)
+	}
+	if ok, err := userService.HasRole(ctx, existing.ID, entity.RoleNameAdmin); err != nil || ok {
+		t.Errorf("HasRole(admin) = %v, %v; want false", ok, err)
+	}
+}
+
+func TestUserService_NormalizesUsernameAndEmail(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := service.NewUserService(persistence.NewUserRepository(client), testutil.NewRBACService(t, client),
+		testutil.NewEventBus(t), service.UserServiceConfig{RequireRole: true, UsernameCaseInsensitive: true})
+
+	created, err := userService.CreateUser(ctx, " Alice ", "Alice@EXAMPLE.com ", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if created.Username != "alice" || created.Email != "alice@example.com" {
+		t.Errorf("stored username = %q, email = %q; want alice, alice@example.com", created.Username, created.Email)
+	}
+
+	// 邮箱和不同大小写的用户名都登录到同一个账号
+	for _, login := range []string{"alice@example.com", " ALICE@example.COM", "Alice", "alice"} {
+		user, err := userService.ValidateUser(ctx, login, "Password123!")
+		if err != nil || user.ID != created.ID {
+			t.Errorf("ValidateUser(%q) = %v, %v; want user %d", login, user, err, created.ID)
+		}
+	}
+
+	// 只有大小写或空白不同的用户名和邮箱视为重复
+	if _, err := userService.CreateUser(ctx, "ALICE", "other@example.com", "Password123!", "Other"); !errors.Is(err, service.ErrUserAlreadyExists) {
+		t.Errorf("CreateUser(ALICE) error = %v, want ErrUserAlreadyExists", err)
+	}
+	if _, err := userService.CreateUser(ctx, "alice2", " ALICE@example.com", "Password123!", "Other"); !errors.Is(err, service.ErrUserAlreadyExists) {
+		t.Errorf("CreateUser(ALICE@example.com) error = %v, want ErrUserAlreadyExists", err)
+	}
+}
+
+func TestUserService_CaseSensitiveUsernameIsTrimmed(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+
+	created, err := userService.CreateUser(ctx, " Bob ", "Bob@Example.com", "Password123!", "Bob")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if created.Username != "Bob" || created.Email != "bob@example.com" {
+		t.Errorf("stored username = %q, email = %q; want Bob, bob@example.com", created.Username, created.Email)
+	}
+	if _, err := userService.ValidateUser(ctx, "bob", "Password123!"); !errors.Is(err, service.ErrInvalidCredentials) {
+		t.Errorf("ValidateUser(bob) error = %v, want ErrInvalidCredentials", err)
+	}
+	if _, err := userService.CreateUser(ctx, "bob", "bob2@example.com", "Password123!", "bob"); err != nil {
+		t.Errorf("CreateUser(bob) error = %v, want a distinct account", err)
+	}
+}
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/pkg/httpproxy"
+	"nebula-live/internal/pkg/webhook"
+	"nebula-live/pkg/clock"
+	"nebula-live/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Webhook投递的默认参数
+const (
+	defaultWebhookMaxAttempts  = 3
+	defaultWebhookRetryBackoff = time.Second
+	defaultWebhookTimeout      = 10 * time.Second
+)
+
+// WebhookService Webhook服务，向配置的端点投递签名的事件
+type WebhookService interface {
+	// Publish 异步向订阅了该事件的端点投递，不阻塞调用方，data序列化为事件的data字段
+	Publish(eventType string, data interface{})
+
+	// ListDeliveries 分页获取投递记录，event不为空时只返回该事件类型
+	ListDeliveries(ctx context.Context, event string, page, limit int) ([]*entity.WebhookDelivery, int64, error)
+
+	// Close 停止接受新事件并等待进行中的投递完成，ctx结束时放弃剩余的重试
+	Close(ctx context.Context) error
+}
+
+// WebhookEndpoint Webhook接收端点
+type WebhookEndpoint struct {
+	URL string
+	// Secret 签名密钥，为空时使用WebhookServiceConfig.Secret
+	Secret string
+	// Events 订阅的事件类型，为空时订阅全部事件
+	Events []string
+}
+
+// subscribes 检查端点是否订阅了事件
+func (e WebhookEndpoint) subscribes(eventType string) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, event := range e.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookServiceConfig Webhook服务配置
+type WebhookServiceConfig struct {
+	Endpoints []WebhookEndpoint
+	// Secret 端点未单独配置密钥时使用的签名密钥
+	Secret string
+	// MaxAttempts 每个端点的最大尝试次数，包含首次投递
+	MaxAttempts int
+	// RetryBackoff 首次重试前的等待时间，之后每次翻倍
+	RetryBackoff time.Duration
+	// Timeout 单次请求超时
+	Timeout time.Duration
+	// Proxy 出站请求使用的上游代理
+	Proxy httpproxy.Config
+	// Clock 事件时间和投递耗时使用的时间来源，为nil时使用系统时间
+	Clock clock.Clock
+}
+
+// WebhookUserData 用户生命周期事件的data字段
+type WebhookUserData struct {
+	ID           uint       `json:"id"`
+	Username     string     `json:"username"`
+	Email        string     `json:"email"`
+	Nickname     string     `json:"nickname"`
+	Status       string     `json:"status"`
+	StatusReason string     `json:"status_reason,omitempty"`
+	BanExpiresAt *time.Time `json:"ban_expires_at,omitempty"`
+}
+
+// NewWebhookUserData 根据用户创建事件数据，不包含密码等敏感字段
+func NewWebhookUserData(user *entity.User) WebhookUserData {
+	return WebhookUserData{
+		ID:           user.ID,
+		Username:     user.Username,
+		Email:        user.Email,
+		Nickname:     user.Nickname,
+		Status:       user.Status.String(),
+		StatusReason: user.StatusReason,
+		BanExpiresAt: user.BanExpiresAt,
+	}
+}
+
+// webhookService Webhook服务实现
+type webhookService struct {
+	deliveryRepo repository.WebhookDeliveryRepository
+	client       *webhook.Client
+	config       WebhookServiceConfig
+	clock        clock.Clock
+
+	// ctx 在Close超时后取消，中断等待中的重试
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewWebhookService 创建Webhook服务
+func NewWebhookService(deliveryRepo repository.WebhookDeliveryRepository, config WebhookServiceConfig) WebhookService {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = defaultWebhookMaxAttempts
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = defaultWebhookRetryBackoff
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultWebhookTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &webhookService{
+		deliveryRepo: deliveryRepo,
+		client:       webhook.NewClient(webhook.ClientConfig{Timeout: config.Timeout, Proxy: config.Proxy}),
+		config:       config,
+		clock:        clock.OrReal(config.Clock),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+}
+
+// Publish 为每个订阅了该事件的端点启动一个投递任务
+func (s *webhookService) Publish(eventType string, data interface{}) {
+	var endpoints []WebhookEndpoint
+	for _, endpoint := range s.config.Endpoints {
+		if endpoint.subscribes(eventType) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if len(endpoints) == 0 {
+		return
+	}
+
+	event := webhook.Event{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		CreatedAt: s.clock.Now().UTC(),
+		Data:      data,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("Failed to marshal webhook event",
+			zap.String("event", eventType),
+			zap.Error(err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		logger.Warn("Webhook service closed, dropping event", zap.String("event", eventType))
+		return
+	}
+
+	for _, endpoint := range endpoints {
+		s.wg.Add(1)
+		go s.deliver(endpoint, event, body)
+	}
+}
+
+// deliver 向端点投递事件，失败时按指数退避重试，每次尝试都写入投递记录
+func (s *webhookService) deliver(endpoint WebhookEndpoint, event webhook.Event, body []byte) {
+	defer s.wg.Done()
+
+	secret := endpoint.Secret
+	if secret == "" {
+		secret = s.config.Secret
+	}
+	deliveryID := uuid.NewString()
+	backoff := s.config.RetryBackoff
+
+	for attempt := 1; attempt <= s.config.MaxAttempts; attempt++ {
+		start := s.clock.Now()
+		statusCode, err := s.client.Send(s.ctx, endpoint.URL, secret, event.Type, deliveryID, body)
+
+		delivery := &entity.WebhookDelivery{
+			DeliveryID: deliveryID,
+			EventID:    event.ID,
+			Event:      event.Type,
+			URL:        endpoint.URL,
+			Attempt:    attempt,
+			StatusCode: statusCode,
+			Success:    err == nil,
+			DurationMs: s.clock.Now().Sub(start).Milliseconds(),
+		}
+		if err != nil {
+			delivery.Error = truncateWebhookError(err.Error())
+		}
+		s.recordDelivery(delivery)
+
+		if err == nil {
+			return
+		}
+
+		logger.Warn("Webhook delivery failed",
+			zap.String("event", event.Type),
+			zap.String("url", endpoint.URL),
+			zap.Int("attempt", attempt),
+			zap.Error(err))
+
+		if attempt == s.config.MaxAttempts {
+			return
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// recordDelivery 写入投递记录，写入失败只记录日志
+func (s *webhookService) recordDelivery(delivery *entity.WebhookDelivery) {
+	// 关闭时s.ctx可能已取消，记录仍需写入
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(s.ctx), 5*time.Second)
+	defer cancel()
+
+	if _, err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		logger.Error("Failed to record webhook delivery",
+			zap.String("delivery_id", delivery.DeliveryID),
+			zap.Error(err))
+	}
+}
+
+// truncateWebhookError 截断错误消息以适应投递记录的长度限制
+func truncateWebhookError(message string) string {
+	const maxLen = 1000
+	if len(message) <= maxLen {
+		return message
+	}
+	return message[:maxLen]
+}
+
+// ListDeliveries 分页获取投递记录
+func (s *webhookService) ListDeliveries(ctx context.Context, event string, page, limit int) ([]*entity.WebhookDelivery, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	// 每页数量上限由调用方（处理器层的分页配置）控制
+	if limit < 1 {
+		limit = 10
+	}
+
+	deliveries, err := s.deliveryRepo.List(ctx, event, (page-1)*limit, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.deliveryRepo.Count(ctx, event)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return deliveries, int64(total), nil
+}
+
+// Close 停止接受新事件并等待进行中的投递完成
+func (s *webhookService) Close(ctx context.Context) error {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		// 中断等待中的重试和请求，记录写入完成后再返回
+		s.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
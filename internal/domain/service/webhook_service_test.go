@@ -0,0 +1,199 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/pkg/webhook"
+	"nebula-live/internal/testutil"
+)
+
+// webhookRequest 接收端记录的一次请求
+type webhookRequest struct {
+	header     http.Header
+	body       []byte
+	receivedAt time.Time
+}
+
+// webhookReceiver 记录收到的请求，按顺序返回statuses中的状态码，用完后返回200
+type webhookReceiver struct {
+	server   *httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	requests []webhookRequest
+}
+
+func newWebhookReceiver(t *testing.T, statuses ...int) *webhookReceiver {
+	t.Helper()
+	r := &webhookReceiver{statuses: statuses}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		r.requests = append(r.requests, webhookRequest{header: req.Header.Clone(), body: body, receivedAt: time.Now()})
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *webhookReceiver) received() []webhookRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]webhookRequest(nil), r.requests...)
+}
+
+// newTestWebhookService 创建向receiver投递的Webhook服务，重试间隔缩短以加快测试
+func newTestWebhookService(t *testing.T, receiver *webhookReceiver) (service.WebhookService, service.WebhookEndpoint) {
+	t.Helper()
+	testutil.InitLogger()
+	endpoint := service.WebhookEndpoint{URL: receiver.server.URL, Secret: "webhook-secret"}
+	webhookService := service.NewWebhookService(
+		persistence.NewWebhookDeliveryRepository(testutil.NewEntClient(t)),
+		service.WebhookServiceConfig{
+			Endpoints:    []service.WebhookEndpoint{endpoint},
+			MaxAttempts:  3,
+			RetryBackoff: 20 * time.Millisecond,
+		},
+	)
+	t.Cleanup(func() {
+		webhookService.Close(context.Background())
+	})
+	return webhookService, endpoint
+}
+
+func TestWebhookService_UserCreatedSigned(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	bus := testutil.NewEventBus(t)
+	rbacService := testutil.NewRBACServiceWithBus(t, client, bus)
+	receiver := newWebhookReceiver(t)
+	webhookService, endpoint := newTestWebhookService(t, receiver)
+
+	// 按服务启动时的方式注册订阅者，用户创建事件经事件总线触发投递
+	cache := service.NewPermissionCache(rbacService, service.PermissionCacheConfig{TTL: time.Minute})
+	if err := service.RegisterEventSubscribers(bus, webhookService, cache); err != nil {
+		t.Fatalf("RegisterEventSubscribers() error = %v", err)
+	}
+	userService := service.NewUserService(persistence.NewUserRepository(client), rbacService, bus,
+		service.UserServiceConfig{RequireRole: true})
+
+	user, err := userService.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if err := bus.Close(ctx); err != nil {
+		t.Fatalf("bus.Close() error = %v", err)
+	}
+	if err := webhookService.Close(ctx); err != nil {
+		t.Fatalf("webhookService.Close() error = %v", err)
+	}
+
+	requests := receiver.received()
+	if len(requests) != 1 {
+		t.Fatalf("received %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+	if got := req.header.Get(webhook.HeaderEvent); got != entity.WebhookEventUserCreated {
+		t.Errorf("%s = %q, want %q", webhook.HeaderEvent, got, entity.WebhookEventUserCreated)
+	}
+	timestamp, err := strconv.ParseInt(req.header.Get(webhook.HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("parse %s error = %v", webhook.HeaderTimestamp, err)
+	}
+	if err := webhook.Verify(endpoint.Secret, timestamp, req.body, req.header.Get(webhook.HeaderSignature)); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+	if err := webhook.Verify("other-secret", timestamp, req.body, req.header.Get(webhook.HeaderSignature)); err == nil {
+		t.Error("Verify() with another secret succeeded, want error")
+	}
+
+	var payload struct {
+		Type string                 `json:"type"`
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("decode payload error = %v", err)
+	}
+	if payload.Type != entity.WebhookEventUserCreated {
+		t.Errorf("type = %q, want %q", payload.Type, entity.WebhookEventUserCreated)
+	}
+	if payload.Data["username"] != "alice" || payload.Data["id"] != float64(user.ID) {
+		t.Errorf("data = %v, want user %d alice", payload.Data, user.ID)
+	}
+	if strings.Contains(string(req.body), "password") {
+		t.Errorf("payload %s contains password", req.body)
+	}
+}
+
+func TestWebhookService_RetryBackoffAndDeliveryRecords(t *testing.T) {
+	ctx := context.Background()
+	receiver := newWebhookReceiver(t, http.StatusInternalServerError, http.StatusBadGateway)
+	webhookService, endpoint := newTestWebhookService(t, receiver)
+
+	webhookService.Publish(entity.WebhookEventUserBanned, map[string]string{"username": "bob"})
+	if err := webhookService.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	requests := receiver.received()
+	if len(requests) != 3 {
+		t.Fatalf("received %d requests, want 3", len(requests))
+	}
+	// 所有重试共用同一个投递ID，等待时间从RetryBackoff开始逐次翻倍
+	deliveryID := requests[0].header.Get(webhook.HeaderDelivery)
+	for i, req := range requests {
+		if got := req.header.Get(webhook.HeaderDelivery); got != deliveryID {
+			t.Errorf("attempt %d %s = %q, want %q", i+1, webhook.HeaderDelivery, got, deliveryID)
+		}
+	}
+	if gap := requests[1].receivedAt.Sub(requests[0].receivedAt); gap < 20*time.Millisecond {
+		t.Errorf("first retry after %v, want >= 20ms", gap)
+	}
+	if gap := requests[2].receivedAt.Sub(requests[1].receivedAt); gap < 40*time.Millisecond {
+		t.Errorf("second retry after %v, want >= 40ms", gap)
+	}
+
+	deliveries, total, err := webhookService.ListDeliveries(ctx, entity.WebhookEventUserBanned, 1, 10)
+	if err != nil {
+		t.Fatalf("ListDeliveries() error = %v", err)
+	}
+	if total != 3 || len(deliveries) != 3 {
+		t.Fatalf("ListDeliveries() = %d records (total %d), want 3", len(deliveries), total)
+	}
+
+	// 记录按时间倒序返回，每次尝试一条
+	wantStatus := map[int]int{1: http.StatusInternalServerError, 2: http.StatusBadGateway, 3: http.StatusOK}
+	for _, d := range deliveries {
+		if d.DeliveryID != deliveryID || d.URL != endpoint.URL || d.Event != entity.WebhookEventUserBanned {
+			t.Errorf("attempt %d = %+v, want delivery %s to %s", d.Attempt, d, deliveryID, endpoint.URL)
+		}
+		if d.StatusCode != wantStatus[d.Attempt] {
+			t.Errorf("attempt %d status_code = %d, want %d", d.Attempt, d.StatusCode, wantStatus[d.Attempt])
+		}
+		if wantSuccess := d.Attempt == 3; d.Success != wantSuccess || (d.Error == "") != wantSuccess {
+			t.Errorf("attempt %d success = %v, error = %q; want success %v", d.Attempt, d.Success, d.Error, wantSuccess)
+		}
+	}
+
+	if _, total, err := webhookService.ListDeliveries(ctx, entity.WebhookEventUserCreated, 1, 10); err != nil || total != 0 {
+		t.Errorf("ListDeliveries(user.created) total = %d, err = %v; want 0", total, err)
+	}
+}
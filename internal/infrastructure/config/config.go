@@ -1,8 +1,13 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"nebula-live/internal/pkg/httpproxy"
+
 	"github.com/spf13/viper"
 )
 
@@ -14,6 +19,15 @@ type Config struct {
 	Log      LogConfig      `mapstructure:"log"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
 	CORS     CORSConfig     `mapstructure:"cors"`
+	Push     PushConfig     `mapstructure:"push"`
+	RBAC     RBACConfig     `mapstructure:"rbac"`
+	User     UserConfig     `mapstructure:"user"`
+	Live     LiveConfig     `mapstructure:"livestream"`
+	Proxy    ProxyConfig    `mapstructure:"proxy"`
+	Webhooks WebhooksConfig `mapstructure:"webhooks"`
+	Events   EventsConfig   `mapstructure:"events"`
+	Jobs     JobsConfig     `mapstructure:"jobs"`
+	Captcha  CaptchaConfig  `mapstructure:"captcha"`
 }
 
 type AppConfig struct {
@@ -28,6 +42,66 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
 	IdleTimeout  time.Duration `mapstructure:"idle_timeout"`
+	// Compression 响应压缩配置
+	Compression CompressionConfig `mapstructure:"compression"`
+	// Pagination 列表接口的分页配置
+	Pagination PaginationConfig `mapstructure:"pagination"`
+	// API 业务接口的路由前缀和版本
+	API APIConfig `mapstructure:"api"`
+	// ResponseEnvelope 将业务接口的响应统一包装为 {"data","error","meta"}，默认返回原始对象
+	ResponseEnvelope bool `mapstructure:"response_envelope"`
+	// RequestTimeout 请求处理超时时间，0表示不限制
+	//
+	// 超时只是协作式的：到期时取消请求的UserContext，处理器和下游调用需要检查ctx才会提前结束，
+	// 中间件不会中断仍在运行的处理器。处理器在超时后失败（返回错误或5xx）时响应改为504；
+	// 忽略ctx并在超时后成功完成的处理器保留其响应。
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+	// RequestTimeoutOverrides 按路径前缀覆盖请求超时时间（最长前缀优先）
+	RequestTimeoutOverrides []RequestTimeoutOverrideConfig `mapstructure:"request_timeout_overrides"`
+	// ListenBeforeReady 启动时先监听端口再运行数据库迁移和系统数据初始化，期间/readyz和业务接口返回503；
+	// 默认在初始化完成后才监听端口
+	ListenBeforeReady bool `mapstructure:"listen_before_ready"`
+}
+
+type RequestTimeoutOverrideConfig struct {
+	PathPrefix string `mapstructure:"path_prefix"`
+	// Timeout 该前缀下请求的超时时间，0表示不限制
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type APIConfig struct {
+	// Prefix 业务接口的公共前缀，如 /api
+	Prefix string `mapstructure:"prefix"`
+	// Version 版本段，如 v1，为空时不添加版本段
+	Version string `mapstructure:"version"`
+}
+
+// BasePath 返回业务接口的挂载路径，如 /api/v1，前缀和版本都为空时返回空字符串表示挂载在根路径
+func (c APIConfig) BasePath() string {
+	var segments []string
+	for _, segment := range []string{c.Prefix, c.Version} {
+		if segment = strings.Trim(segment, "/"); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		return ""
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+type PaginationConfig struct {
+	// DefaultLimit 未指定limit时的每页数量，默认10
+	DefaultLimit int `mapstructure:"default_limit"`
+	// MaxLimit 每页数量上限，超过时截断为该值，默认100
+	MaxLimit int `mapstructure:"max_limit"`
+}
+
+type CompressionConfig struct {
+	// Level 压缩级别：disabled、default（默认）、best_speed、best_compression
+	Level string `mapstructure:"level"`
+	// MinSize 压缩的最小响应体字节数，默认1024
+	MinSize int `mapstructure:"min_size"`
 }
 
 type DatabaseConfig struct {
@@ -41,9 +115,15 @@ type DatabaseConfig struct {
 	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
 	MaxOpenConns    int           `mapstructure:"max_open_conns"`
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+	// ConnectMaxAttempts 启动时连接数据库的最大尝试次数，小于1时只尝试一次
+	ConnectMaxAttempts int `mapstructure:"connect_max_attempts"`
+	// ConnectRetryInterval 首次重试前的等待时间，之后每次翻倍
+	ConnectRetryInterval time.Duration `mapstructure:"connect_retry_interval"`
 }
 
 type RedisConfig struct {
+	// Enabled 启用Redis，多副本部署时用于分布式锁，保证后台任务同一时间只在一个副本上运行
+	Enabled      bool   `mapstructure:"enabled"`
 	Host         string `mapstructure:"host"`
 	Port         int    `mapstructure:"port"`
 	Password     string `mapstructure:"password"`
@@ -66,10 +146,16 @@ type LogConfig struct {
 }
 
 type JWTConfig struct {
-	Secret          string        `mapstructure:"secret"`
+	Secret string `mapstructure:"secret"`
+	// PreviousSecrets 轮换前的旧密钥，仅用于验证已签发的令牌，所有旧令牌过期后即可移除
+	PreviousSecrets []string      `mapstructure:"previous_secrets"`
 	AccessTokenTTL  time.Duration `mapstructure:"access_token_ttl"`
 	RefreshTokenTTL time.Duration `mapstructure:"refresh_token_ttl"`
 	Issuer          string        `mapstructure:"issuer"`
+	// Audience 令牌受众，多个服务共用密钥时用于区分各自的令牌，为空时不校验
+	Audience string `mapstructure:"audience"`
+	// ImpersonationTTL 管理员模拟登录令牌的有效期，应短于访问令牌
+	ImpersonationTTL time.Duration `mapstructure:"impersonation_ttl"`
 }
 
 type CORSConfig struct {
@@ -79,26 +165,268 @@ type CORSConfig struct {
 	ExposedHeaders   []string `mapstructure:"expose_headers"`
 	AllowCredentials bool     `mapstructure:"allow_credentials"`
 	MaxAge           int      `mapstructure:"max_age"`
+	// Overrides 按路径前缀覆盖的CORS配置，未设置的字段沿用全局配置
+	Overrides []CORSOverrideConfig `mapstructure:"overrides"`
+}
+
+type CORSOverrideConfig struct {
+	// PathPrefix 完整的路径前缀，如 /swagger
+	PathPrefix string `mapstructure:"path_prefix"`
+	// APIPath 相对业务接口基础路径（server.api）的前缀，如 /live-streams，修改API前缀或版本时无需同步修改；
+	// 与PathPrefix二选一
+	APIPath          string   `mapstructure:"api_path"`
+	AllowedOrigins   []string `mapstructure:"allowed_origins"`
+	AllowedMethods   []string `mapstructure:"allowed_methods"`
+	AllowedHeaders   []string `mapstructure:"allowed_headers"`
+	ExposedHeaders   []string `mapstructure:"expose_headers"`
+	AllowCredentials *bool    `mapstructure:"allow_credentials"`
+	MaxAge           *int     `mapstructure:"max_age"`
+}
+
+type RBACConfig struct {
+	// RequireUserRole 为true时用户必须至少保留一个角色
+	RequireUserRole bool `mapstructure:"require_user_role"`
+	// ExposeDeniedDetails 为true时403响应中返回缺少的权限或角色，默认关闭，仅用于开发调试
+	ExposeDeniedDetails bool `mapstructure:"expose_denied_details"`
+	// Engine 权限检查引擎：builtin（默认）或casbin
+	Engine string `mapstructure:"engine"`
+	// CasbinModel 自定义Casbin模型文件路径，为空时使用与内置实现等价的默认模型
+	CasbinModel string `mapstructure:"casbin_model"`
+	// PolicyRefreshInterval Casbin策略重新加载间隔，多实例部署时用于同步其他实例的变更
+	PolicyRefreshInterval time.Duration `mapstructure:"policy_refresh_interval"`
+	// AdminWildcard 初始化时为管理员分配 *:* 通配权限
+	AdminWildcard bool `mapstructure:"admin_wildcard"`
+	// PermissionCacheTTL 中间件缓存用户权限检查结果的时长，为0时不缓存
+	PermissionCacheTTL time.Duration `mapstructure:"permission_cache_ttl"`
+	// AllowReinitialize 是否允许通过管理接口重新初始化RBAC系统数据
+	AllowReinitialize bool `mapstructure:"allow_reinitialize"`
+	// ExpiredRoleCleanupInterval 清理已过期角色分配的间隔，默认1小时
+	ExpiredRoleCleanupInterval time.Duration `mapstructure:"expired_role_cleanup_interval"`
+	// BootstrapAdmin 启动时创建首个管理员，用户名为空时不创建
+	BootstrapAdmin BootstrapAdminConfig `mapstructure:"bootstrap_admin"`
+}
+
+// UserConfig 用户管理配置
+type UserConfig struct {
+	// StatusTransitions 允许的用户状态转换，键为当前状态，值为可转换到的状态，为空时使用默认规则
+	StatusTransitions map[string][]string `mapstructure:"status_transitions"`
+	// UsernameCaseInsensitive 用户名不区分大小写：新用户名保存为小写，登录和唯一性检查忽略大小写
+	UsernameCaseInsensitive bool `mapstructure:"username_case_insensitive"`
+}
+
+// BootstrapAdminConfig 首个管理员配置
+type BootstrapAdminConfig struct {
+	Username string `mapstructure:"username"`
+	Email    string `mapstructure:"email"`
+	// Password 为空时生成随机密码并在创建时打印一次
+	Password string `mapstructure:"password"`
+}
+
+type LiveConfig struct {
+	Twitch   TwitchConfig   `mapstructure:"twitch"`
+	Kuaishou KuaishouConfig `mapstructure:"kuaishou"`
+	// Retry 直播平台请求的重试策略
+	Retry LiveRetryConfig `mapstructure:"retry"`
+	// CacheMaxAge 公开直播接口成功响应的Cache-Control max-age，0使用默认值30秒，负数表示不缓存
+	CacheMaxAge time.Duration `mapstructure:"cache_max_age"`
+	// Requests 按平台名称（或别名）配置请求附带的请求头和Cookie，用于通过平台的反爬检查
+	Requests map[string]LiveRequestConfig `mapstructure:"requests"`
+	// SubscriptionStatusCacheTTL 订阅直播间状态汇总中单个直播间状态的缓存时间，0使用默认值30秒，负数表示不缓存
+	SubscriptionStatusCacheTTL time.Duration `mapstructure:"subscription_status_cache_ttl"`
+}
+
+// LiveRequestConfig 单个直播平台请求附带的请求头和Cookie
+type LiveRequestConfig struct {
+	// Headers 每个请求都会设置的请求头，覆盖内置的值（如User-Agent）
+	Headers map[string]string `mapstructure:"headers"`
+	// Cookie 原始Cookie请求头（a=1; b=2），配置后覆盖 livestream.kuaishou.cookie；日志中只输出脱敏后的值
+	Cookie string `mapstructure:"cookie"`
+}
+
+// LiveRetryConfig 直播平台请求的重试配置，只重试网络错误、429和5xx响应，404等其他状态码直接返回
+type LiveRetryConfig struct {
+	// Count 首次请求失败后的最大重试次数，0表示不重试
+	Count int `mapstructure:"count"`
+	// WaitTime 首次重试前的等待时间，之后每次翻倍并加入随机抖动
+	WaitTime time.Duration `mapstructure:"wait_time"`
+	// MaxWaitTime 两次请求之间的最长等待时间
+	MaxWaitTime time.Duration `mapstructure:"max_wait_time"`
+}
+
+type TwitchConfig struct {
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+}
+
+type KuaishouConfig struct {
+	// Cookie 请求快手接口时携带的浏览器Cookie，未配置时请求容易被反爬拦截
+	Cookie string `mapstructure:"cookie"`
+}
+
+type PushConfig struct {
+	Scheduler PushSchedulerConfig `mapstructure:"scheduler"`
+	// FailureThreshold 设备连续推送失败达到该次数后自动禁用，0表示不自动禁用
+	FailureThreshold int `mapstructure:"failure_threshold"`
+	// DedupWindow 同一用户在该时间内重复发送相同标题和内容的推送时跳过，0表示不去重
+	DedupWindow time.Duration `mapstructure:"dedup_window"`
+	// TestMode 测试模式，只记录组装好的消息并返回模拟的成功结果，不调用推送服务
+	TestMode bool `mapstructure:"test_mode"`
+	// CaptureRawResponse 保留推送服务返回的响应内容，拥有 system:manage 权限的用户可在推送结果中查看，用于排查问题
+	CaptureRawResponse bool `mapstructure:"capture_raw_response"`
+	// DeviceLimit 每个用户同时启用的推送设备上限，0表示不限制
+	DeviceLimit int `mapstructure:"device_limit"`
+	// RoleDeviceLimits 按角色名覆盖设备上限，用户有多个角色时取最大值，0表示不限制
+	RoleDeviceLimits map[string]int `mapstructure:"role_device_limits"`
+	// NewDevicesDisabled 新注册的设备默认禁用，需要用户显式启用；创建请求中的enabled字段优先
+	NewDevicesDisabled bool `mapstructure:"new_devices_disabled"`
+	// Bark Bark提供商配置
+	Bark PushBarkConfig `mapstructure:"bark"`
+	// Email 邮件提供商的SMTP配置
+	Email PushEmailConfig `mapstructure:"email"`
+	// LengthLimits 按提供商名称限制标题和内容长度，未配置的提供商不限制
+	LengthLimits map[string]PushLengthLimitConfig `mapstructure:"length_limits"`
+	// AllowedURLSchemes 推送消息中点击跳转链接允许的协议，如 https 和应用自定义的深度链接协议，为空时只允许 https
+	AllowedURLSchemes []string `mapstructure:"allowed_url_schemes"`
+}
+
+// PushLengthLimitConfig 单个推送提供商的长度限制，按字符计数，0表示不限制
+type PushLengthLimitConfig struct {
+	MaxTitle int `mapstructure:"max_title"`
+	MaxBody  int `mapstructure:"max_body"`
+	// Policy 超出限制时的处理方式：truncate 截断并以省略号结尾（默认），reject 该设备推送失败
+	Policy string `mapstructure:"policy"`
+}
+
+type PushBarkConfig struct {
+	// BaseURL 设备和请求都未指定服务器时使用的默认Bark服务器，为空时使用 https://api.day.app
+	BaseURL string `mapstructure:"base_url"`
+	// Disabled 停用Bark推送，按提供商推送时返回503，其余推送记为失败
+	Disabled bool `mapstructure:"disabled"`
+}
+
+// PushEmailConfig 邮件提供商的SMTP配置，设备ID即收件人邮箱地址
+type PushEmailConfig struct {
+	// Enabled 启用邮件推送，需要同时配置 host 和 from
+	Enabled bool   `mapstructure:"enabled"`
+	Host    string `mapstructure:"host"`
+	// Port SMTP端口，0时按TLS方式使用587或465
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// From 发件人地址，可带显示名称，如 "Nebula Live <noreply@example.com>"
+	From string `mapstructure:"from"`
+	// TLS 加密方式：starttls（默认）、tls（隐式TLS）、none（不加密，仅用于本地中继）
+	TLS string `mapstructure:"tls"`
+}
+
+// ProxyConfig 出站HTTP请求（直播平台、推送服务）使用的上游代理
+type ProxyConfig struct {
+	// URL 代理地址，支持 http、https 和 socks5，为空时直连
+	URL string `mapstructure:"url"`
+	// NoProxy 不经过代理的目标：主机名、域名后缀（.example.com）、IP或CIDR
+	NoProxy []string `mapstructure:"no_proxy"`
 }
 
+// WebhooksConfig 用户生命周期事件的Webhook配置
+type WebhooksConfig struct {
+	// Secret 签名密钥，端点未单独配置时使用
+	Secret string `mapstructure:"secret"`
+	// Timeout 单次请求超时
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxAttempts 每个端点的最大尝试次数，包含首次投递
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// RetryBackoff 首次重试前的等待时间，之后每次翻倍
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"`
+	// Endpoints 接收端点，为空时不投递
+	Endpoints []WebhookEndpointConfig `mapstructure:"endpoints"`
+}
+
+// WebhookEndpointConfig Webhook接收端点配置
+type WebhookEndpointConfig struct {
+	URL string `mapstructure:"url"`
+	// Secret 该端点的签名密钥，为空时使用 webhooks.secret
+	Secret string `mapstructure:"secret"`
+	// Events 订阅的事件类型，为空时订阅全部事件
+	Events []string `mapstructure:"events"`
+}
+
+// EventsConfig 进程内领域事件总线配置
+type EventsConfig struct {
+	// QueueSize 每个订阅者的待处理事件缓冲数，订阅者处理不过来且缓冲已满时丢弃新事件
+	QueueSize int `mapstructure:"queue_size"`
+}
+
+// JobsConfig 异步任务队列配置
+type JobsConfig struct {
+	// Enabled 运行任务工作器，未启用时任务只会提交不会执行
+	Enabled bool `mapstructure:"enabled"`
+	// PollInterval 轮询待执行任务的间隔，本副本提交的任务会立即开始执行
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Concurrency 同时执行的任务数
+	Concurrency int `mapstructure:"concurrency"`
+	// MaxAttempts 任务被服务重启中断后最多执行的次数，包含首次执行
+	MaxAttempts int `mapstructure:"max_attempts"`
+}
+
+// CaptchaConfig 注册和登录的人机验证配置
+type CaptchaConfig struct {
+	// Enabled 启用后注册和登录请求必须携带有效的 captcha_token
+	Enabled bool `mapstructure:"enabled"`
+	// Provider 验证服务，可选 hcaptcha、turnstile、recaptcha
+	Provider string `mapstructure:"provider"`
+	// Secret 验证服务分配的服务端密钥
+	Secret string `mapstructure:"secret"`
+	// VerifyURL 自定义校验接口地址，为空时使用验证服务的默认地址
+	VerifyURL string `mapstructure:"verify_url"`
+	// Timeout 单次校验请求超时
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type PushSchedulerConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+}
+
+// NewConfig 加载配置
+//
+// 先加载基础配置 config.yaml，再根据 app.env 合并环境配置 config.{env}.yaml（不存在时跳过），
+// 最后由 NEBULA_ 前缀的环境变量覆盖，如 NEBULA_APP_ENV、NEBULA_DATABASE_HOST。
 func NewConfig() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
 	viper.AddConfigPath("./configs")
 	viper.AddConfigPath(".")
 
-	// 设置环境变量前缀
+	// 设置环境变量前缀，嵌套键中的 "." 映射为 "_"
 	viper.SetEnvPrefix("NEBULA")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	viper.AutomaticEnv()
 
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, err
 	}
 
+	// 合并环境配置
+	if env := viper.GetString("app.env"); env != "" {
+		viper.SetConfigName("config." + env)
+		if err := viper.MergeInConfig(); err != nil {
+			var notFound viper.ConfigFileNotFoundError
+			if !errors.As(err, &notFound) {
+				return nil, err
+			}
+		}
+	}
+
 	var config Config
 	if err := viper.Unmarshal(&config); err != nil {
 		return nil, err
 	}
 
+	proxy := httpproxy.Config{URL: config.Proxy.URL, NoProxy: config.Proxy.NoProxy}
+	if err := proxy.Validate(); err != nil {
+		return nil, fmt.Errorf("proxy: %w", err)
+	}
+
 	return &config, nil
 }
@@ -0,0 +1,111 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"nebula-live/internal/infrastructure/config"
+
+	"github.com/spf13/viper"
+)
+
+// writeConfigs 在临时目录的configs下写入配置文件并切换到该目录，NewConfig从那里读取
+func writeConfigs(t *testing.T, files map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "configs"), 0o755); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, "configs", name), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", name, err)
+		}
+	}
+	t.Chdir(dir)
+
+	// NewConfig使用全局viper，每个测试从干净的状态开始
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+}
+
+const baseConfig = `
+app:
+  name: nebula-live
+  env: test
+server:
+  host: 0.0.0.0
+  port: 8080
+database:
+  host: base-db
+`
+
+func TestNewConfig_EnvOverlayOverridesBase(t *testing.T) {
+	writeConfigs(t, map[string]string{
+		"config.yaml": baseConfig,
+		"config.test.yaml": `
+server:
+  port: 9090
+database:
+  host: test-db
+`,
+	})
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		t.Fatalf("NewConfig() error = %v", err)
+	}
+	if cfg.Server.Port != 9090 || cfg.Database.Host != "test-db" {
+		t.Errorf("server.port = %d, database.host = %q; want the overlay values 9090, test-db", cfg.Server.Port, cfg.Database.Host)
+	}
+	// 覆盖文件中没有的键保留基础配置的值
+	if cfg.App.Name != "nebula-live" || cfg.Server.Host != "0.0.0.0" {
+		t.Errorf("app.name = %q, server.host = %q; want the base values", cfg.App.Name, cfg.Server.Host)
+	}
+}
+
+func TestNewConfig_EnvVarOverridesBaseAndOverlay(t *testing.T) {
+	writeConfigs(t, map[string]string{
+		"config.yaml":      baseConfig,
+		"config.test.yaml": "server:\n  port: 9090\n",
+	})
+	t.Setenv("NEBULA_SERVER_PORT", "7070")
+	t.Setenv("NEBULA_DATABASE_HOST", "env-db")
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		t.Fatalf("NewConfig() error = %v", err)
+	}
+	if cfg.Server.Port != 7070 || cfg.Database.Host != "env-db" {
+		t.Errorf("server.port = %d, database.host = %q; want the environment values 7070, env-db", cfg.Server.Port, cfg.Database.Host)
+	}
+}
+
+func TestNewConfig_EnvVarSelectsOverlay(t *testing.T) {
+	writeConfigs(t, map[string]string{
+		"config.yaml":         baseConfig,
+		"config.test.yaml":    "server:\n  port: 9090\n",
+		"config.staging.yaml": "server:\n  port: 6060\n",
+	})
+	t.Setenv("NEBULA_APP_ENV", "staging")
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		t.Fatalf("NewConfig() error = %v", err)
+	}
+	if cfg.App.Env != "staging" || cfg.Server.Port != 6060 {
+		t.Errorf("app.env = %q, server.port = %d; want staging, 6060", cfg.App.Env, cfg.Server.Port)
+	}
+}
+
+func TestNewConfig_MissingOverlayIsSkipped(t *testing.T) {
+	writeConfigs(t, map[string]string{"config.yaml": baseConfig})
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		t.Fatalf("NewConfig() without config.test.yaml error = %v", err)
+	}
+	if cfg.Server.Port != 8080 {
+		t.Errorf("server.port = %d, want the base value 8080", cfg.Server.Port)
+	}
+}
@@ -12,6 +12,9 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
+// NewLogger 根据日志配置创建logger
+//
+// 注入的logger直接记录调用位置，全局logger在pkg/logger初始化时额外跳过一层包装函数。
 func NewLogger(cfg *config.Config) (*zap.Logger, error) {
 	// 只有在需要输出到文件时才创建日志目录
 	if cfg.Log.EnableFile {
@@ -21,83 +24,76 @@ func NewLogger(cfg *config.Config) (*zap.Logger, error) {
 		}
 	}
 
-	// 配置日志级别
-	var level zapcore.Level
-	switch cfg.Log.Level {
-	case "debug":
-		level = zapcore.DebugLevel
-	case "info":
-		level = zapcore.InfoLevel
-	case "warn":
-		level = zapcore.WarnLevel
-	case "error":
-		level = zapcore.ErrorLevel
-	case "fatal":
-		level = zapcore.FatalLevel
-	default:
-		level = zapcore.InfoLevel
+	core, err := NewCore(cfg.Log, zapcore.AddSync(os.Stdout))
+	if err != nil {
+		return nil, err
 	}
 
-	// 配置编码器
-	var encoderConfig zapcore.EncoderConfig
-	if cfg.Log.Format == "json" {
-		encoderConfig = zap.NewProductionEncoderConfig()
-	} else {
-		encoderConfig = zap.NewDevelopmentEncoderConfig()
+	return zap.New(core, zap.AddCaller()), nil
+}
+
+// NewCore 根据日志配置创建输出到控制台和文件的core，console为控制台写入目标
+//
+// format为json时控制台和文件都输出JSON行，否则输出便于阅读的文本格式；
+// 彩色级别只用于文本格式的控制台输出，文件输出使用lumberjack按大小轮转。
+func NewCore(cfg config.LogConfig, console zapcore.WriteSyncer) (zapcore.Core, error) {
+	level, err := parseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
 	}
-	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 
-	// 配置彩色级别编码
-	if cfg.Log.EnableColor {
-		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
-	} else {
-		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+	var cores []zapcore.Core
+
+	// 控制台输出，未启用任何输出时默认输出到控制台
+	if cfg.EnableConsole || !cfg.EnableFile {
+		cores = append(cores, zapcore.NewCore(newEncoder(cfg.Format, cfg.EnableColor), console, level))
 	}
 
-	// 文件写入器（使用 lumberjack 实现日志轮转）
-	fileWriter := &lumberjack.Logger{
-		Filename:   cfg.Log.Output,
-		MaxSize:    cfg.Log.MaxSize,
-		MaxAge:     cfg.Log.MaxAge,
-		MaxBackups: cfg.Log.MaxBackups,
-		Compress:   cfg.Log.Compress,
+	// 文件输出（使用 lumberjack 实现日志轮转）
+	if cfg.EnableFile {
+		fileWriter := &lumberjack.Logger{
+			Filename:   cfg.Output,
+			MaxSize:    cfg.MaxSize,
+			MaxAge:     cfg.MaxAge,
+			MaxBackups: cfg.MaxBackups,
+			Compress:   cfg.Compress,
+		}
+		cores = append(cores, zapcore.NewCore(newEncoder(cfg.Format, false), zapcore.AddSync(fileWriter), level))
 	}
 
-	// 控制台写入器
-	consoleWriter := zapcore.AddSync(os.Stdout)
+	if len(cores) == 1 {
+		return cores[0], nil
+	}
+	return zapcore.NewTee(cores...), nil
+}
 
-	// 根据配置决定输出目标
-	var cores []zapcore.Core
+// parseLevel 解析日志级别，为空时使用info
+func parseLevel(level string) (zapcore.Level, error) {
+	if level == "" {
+		return zapcore.InfoLevel, nil
+	}
 
-	// 控制台输出
-	if cfg.Log.EnableConsole {
-		consoleEncoder := zapcore.NewConsoleEncoder(encoderConfig)
-		cores = append(cores, zapcore.NewCore(consoleEncoder, consoleWriter, level))
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return parsed, fmt.Errorf("invalid log level %q: %w", level, err)
 	}
+	return parsed, nil
+}
 
-	// 文件输出
-	if cfg.Log.EnableFile {
-		// 文件编码器始终使用普通级别编码器
-		fileEncoderConfig := encoderConfig
-		fileEncoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
-		fileEncoder := zapcore.NewJSONEncoder(fileEncoderConfig)
-		cores = append(cores, zapcore.NewCore(fileEncoder, zapcore.AddSync(fileWriter), level))
+// newEncoder 创建日志编码器，color仅对文本格式生效
+func newEncoder(format string, color bool) zapcore.Encoder {
+	if format == "json" {
+		encoderConfig := zap.NewProductionEncoderConfig()
+		encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+		return zapcore.NewJSONEncoder(encoderConfig)
 	}
 
-	// 创建core
-	var core zapcore.Core
-	if len(cores) == 0 {
-		// 如果没有启用任何输出，默认输出到控制台
-		consoleEncoder := zapcore.NewConsoleEncoder(encoderConfig)
-		core = zapcore.NewCore(consoleEncoder, consoleWriter, level)
-	} else if len(cores) == 1 {
-		core = cores[0]
+	encoderConfig := zap.NewDevelopmentEncoderConfig()
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	if color {
+		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	} else {
-		core = zapcore.NewTee(cores...)
+		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
 	}
-
-	// 创建 logger
-	logger := zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1))
-
-	return logger, nil
+	return zapcore.NewConsoleEncoder(encoderConfig)
 }
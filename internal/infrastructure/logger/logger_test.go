@@ -0,0 +1,110 @@
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// newBufferedLogger 按配置创建输出到内存的logger
+func newBufferedLogger(t *testing.T, cfg config.LogConfig) (*zap.Logger, *bytes.Buffer) {
+	t.Helper()
+	var buf bytes.Buffer
+	core, err := logger.NewCore(cfg, zapcore.AddSync(&buf))
+	if err != nil {
+		t.Fatalf("NewCore() error = %v", err)
+	}
+	return zap.New(core), &buf
+}
+
+// jsonLines 把输出逐行解析为JSON
+func jsonLines(t *testing.T, output string) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("line %q is not JSON: %v", line, err)
+		}
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestNewCore_JSONFormatAndLevel(t *testing.T) {
+	log, buf := newBufferedLogger(t, config.LogConfig{Level: "info", Format: "json", EnableConsole: true})
+	log.Debug("hidden")
+	log.Info("started", zap.Int("port", 8080))
+	log.Warn("slow", zap.String("path", "/users"))
+
+	lines := jsonLines(t, buf.String())
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2 (debug filtered): %s", len(lines), buf)
+	}
+	if lines[0]["msg"] != "started" || lines[0]["level"] != "info" || lines[0]["port"] != float64(8080) {
+		t.Errorf("first line = %v", lines[0])
+	}
+	if lines[1]["msg"] != "slow" || lines[1]["path"] != "/users" {
+		t.Errorf("second line = %v", lines[1])
+	}
+
+	// debug级别输出调试日志
+	log, buf = newBufferedLogger(t, config.LogConfig{Level: "debug", Format: "json"})
+	log.Debug("visible")
+	if lines := jsonLines(t, buf.String()); len(lines) != 1 || lines[0]["level"] != "debug" {
+		t.Errorf("debug lines = %v, want one debug entry", lines)
+	}
+}
+
+func TestNewCore_ConsoleFormat(t *testing.T) {
+	log, buf := newBufferedLogger(t, config.LogConfig{Format: "console"})
+	log.Info("started")
+	output := buf.String()
+	if json.Valid([]byte(strings.TrimSpace(output))) {
+		t.Errorf("console output %q is JSON", output)
+	}
+	if !strings.Contains(output, "INFO") || !strings.Contains(output, "started") {
+		t.Errorf("console output = %q, want level and message", output)
+	}
+	// 未开启颜色时不输出ANSI转义序列
+	if strings.Contains(output, "\x1b[") {
+		t.Errorf("console output %q contains color codes", output)
+	}
+
+	if _, err := logger.NewCore(config.LogConfig{Level: "verbose"}, zapcore.AddSync(buf)); err == nil {
+		t.Error("NewCore() with an invalid level succeeded, want error")
+	}
+}
+
+func TestNewCore_FileOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "app.log")
+	var console bytes.Buffer
+	core, err := logger.NewCore(config.LogConfig{Format: "json", Output: path, EnableFile: true, MaxSize: 1}, zapcore.AddSync(&console))
+	if err != nil {
+		t.Fatalf("NewCore() error = %v", err)
+	}
+	log := zap.New(core)
+	log.Info("to file")
+	log.Sync()
+
+	// 只启用文件输出时不写控制台
+	if console.Len() != 0 {
+		t.Errorf("console output = %q, want none", console.String())
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if lines := jsonLines(t, string(data)); len(lines) != 1 || lines[0]["msg"] != "to file" {
+		t.Errorf("file lines = %v", lines)
+	}
+}
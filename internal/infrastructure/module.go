@@ -1,18 +1,290 @@
 package infrastructure
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
 	"nebula-live/internal/infrastructure/config"
 	"nebula-live/internal/infrastructure/logger"
 	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/pkg/captcha"
+	"nebula-live/internal/pkg/eventbus"
+	"nebula-live/internal/pkg/httpproxy"
+	"nebula-live/internal/pkg/livestream"
+	"nebula-live/internal/pkg/lock"
+	"nebula-live/internal/pkg/push"
+	"nebula-live/pkg/auth"
+	"nebula-live/pkg/clock"
 
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
 // InfrastructureModule 基础设施层模块
 var InfrastructureModule = fx.Options(
 	fx.Provide(
 		config.NewConfig,
+		NewClock,
+		NewJWTManager,
 		logger.NewLogger,
 		persistence.NewEntClient,
+		persistence.NewRedisClient,
+		NewLocker,
+		NewUserServiceConfig,
+		NewRBACServiceConfig,
+		NewPermissionCacheConfig,
+		NewPushServiceConfig,
+		NewUserPushSettingServiceConfig,
+		NewSessionServiceConfig,
+		NewWebhookServiceConfig,
+		NewJobServiceConfig,
+		NewLiveStreamClientConfig,
+		NewLiveSubscriptionServiceConfig,
+		NewEventBus,
+		NewCaptchaVerifier,
 	),
 )
+
+// NewClock 提供应用使用的时间来源，测试中可以用fx.Replace替换为可控时钟
+func NewClock() clock.Clock {
+	return clock.Real
+}
+
+// NewJWTManager 根据应用配置创建JWT管理器，签发令牌的处理器和校验令牌的中间件共用同一实例
+func NewJWTManager(cfg *config.Config, clk clock.Clock) *auth.JWTManager {
+	return auth.NewJWTManager(&auth.TokenConfig{
+		SecretKey:          cfg.JWT.Secret,
+		PreviousSecretKeys: cfg.JWT.PreviousSecrets,
+		AccessTokenTTL:     cfg.JWT.AccessTokenTTL,
+		RefreshTokenTTL:    cfg.JWT.RefreshTokenTTL,
+		Issuer:             cfg.JWT.Issuer,
+		Audience:           cfg.JWT.Audience,
+		ImpersonationTTL:   cfg.JWT.ImpersonationTTL,
+		Clock:              clk,
+	})
+}
+
+// NewUserServiceConfig 根据应用配置创建用户服务配置
+func NewUserServiceConfig(cfg *config.Config, clk clock.Clock) (service.UserServiceConfig, error) {
+	transitions, err := entity.ParseUserStatusTransitions(cfg.User.StatusTransitions)
+	if err != nil {
+		return service.UserServiceConfig{}, fmt.Errorf("user.status_transitions: %w", err)
+	}
+
+	return service.UserServiceConfig{
+		RequireRole:             cfg.RBAC.RequireUserRole,
+		StatusTransitions:       transitions,
+		UsernameCaseInsensitive: cfg.User.UsernameCaseInsensitive,
+		Clock:                   clk,
+	}, nil
+}
+
+// NewRBACServiceConfig 根据应用配置创建RBAC服务配置
+func NewRBACServiceConfig(cfg *config.Config, clk clock.Clock) service.RBACServiceConfig {
+	return service.RBACServiceConfig{
+		Engine:                cfg.RBAC.Engine,
+		CasbinModelPath:       cfg.RBAC.CasbinModel,
+		PolicyRefreshInterval: cfg.RBAC.PolicyRefreshInterval,
+		AdminWildcard:         cfg.RBAC.AdminWildcard,
+		Clock:                 clk,
+	}
+}
+
+// NewPermissionCacheConfig 根据应用配置创建权限缓存配置
+func NewPermissionCacheConfig(cfg *config.Config, clk clock.Clock) service.PermissionCacheConfig {
+	return service.PermissionCacheConfig{TTL: cfg.RBAC.PermissionCacheTTL, Clock: clk}
+}
+
+// NewPushServiceConfig 根据应用配置创建推送服务配置，长度限制或邮件配置无效时返回错误
+// 启用Redis时去重窗口保存在Redis中，所有副本共享
+func NewPushServiceConfig(cfg *config.Config, redisClient *redis.Client, clk clock.Clock) (service.PushServiceConfig, error) {
+	lengthLimits := make(map[string]push.LengthLimits, len(cfg.Push.LengthLimits))
+	for provider, limit := range cfg.Push.LengthLimits {
+		policy := push.LengthPolicy(limit.Policy)
+		if !policy.IsValid() {
+			return service.PushServiceConfig{}, fmt.Errorf("push.length_limits.%s: unknown policy %q", provider, limit.Policy)
+		}
+		if limit.MaxTitle < 0 || limit.MaxBody < 0 {
+			return service.PushServiceConfig{}, fmt.Errorf("push.length_limits.%s: limits must not be negative", provider)
+		}
+		lengthLimits[provider] = push.LengthLimits{
+			MaxTitle: limit.MaxTitle,
+			MaxBody:  limit.MaxBody,
+			Policy:   policy,
+		}
+	}
+
+	email := cfg.Push.Email
+	if !push.IsValidSMTPTLSMode(email.TLS) {
+		return service.PushServiceConfig{}, fmt.Errorf("push.email.tls: unknown mode %q", email.TLS)
+	}
+	if email.Enabled && (email.Host == "" || email.From == "") {
+		return service.PushServiceConfig{}, fmt.Errorf("push.email: host and from are required when enabled")
+	}
+
+	allowedURLSchemes := make([]string, 0, len(cfg.Push.AllowedURLSchemes))
+	for _, scheme := range cfg.Push.AllowedURLSchemes {
+		scheme = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(scheme), ":"))
+		if scheme == "" {
+			return service.PushServiceConfig{}, fmt.Errorf("push.allowed_url_schemes: scheme must not be empty")
+		}
+		allowedURLSchemes = append(allowedURLSchemes, scheme)
+	}
+
+	var dedupLocker *lock.Locker
+	if redisClient != nil {
+		dedupLocker = lock.NewLocker(redisClient, cfg.App.Name+":")
+	}
+
+	return service.PushServiceConfig{
+		FailureThreshold:   cfg.Push.FailureThreshold,
+		BarkBaseURL:        cfg.Push.Bark.BaseURL,
+		BarkDisabled:       cfg.Push.Bark.Disabled,
+		Email:              push.SMTPConfig(email),
+		Proxy:              NewProxyConfig(cfg),
+		DedupWindow:        cfg.Push.DedupWindow,
+		DedupLocker:        dedupLocker,
+		TestMode:           cfg.Push.TestMode,
+		CaptureRawResponse: cfg.Push.CaptureRawResponse,
+		LengthLimits:       lengthLimits,
+		AllowedURLSchemes:  allowedURLSchemes,
+		Clock:              clk,
+	}, nil
+}
+
+// NewUserPushSettingServiceConfig 根据应用配置创建用户推送设置服务配置
+func NewUserPushSettingServiceConfig(cfg *config.Config) service.UserPushSettingServiceConfig {
+	return service.UserPushSettingServiceConfig{
+		DeviceLimit:        cfg.Push.DeviceLimit,
+		RoleDeviceLimits:   cfg.Push.RoleDeviceLimits,
+		NewDevicesDisabled: cfg.Push.NewDevicesDisabled,
+	}
+}
+
+// NewSessionServiceConfig 根据应用配置创建会话服务配置
+func NewSessionServiceConfig(cfg *config.Config, clk clock.Clock) service.SessionServiceConfig {
+	return service.SessionServiceConfig{
+		TTL:   cfg.JWT.RefreshTokenTTL,
+		Clock: clk,
+	}
+}
+
+// NewWebhookServiceConfig 根据应用配置创建Webhook服务配置，端点地址和事件类型无效时返回错误
+func NewWebhookServiceConfig(cfg *config.Config, clk clock.Clock) (service.WebhookServiceConfig, error) {
+	endpoints := make([]service.WebhookEndpoint, 0, len(cfg.Webhooks.Endpoints))
+	for i, endpoint := range cfg.Webhooks.Endpoints {
+		u, err := url.Parse(endpoint.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return service.WebhookServiceConfig{}, fmt.Errorf("webhooks.endpoints[%d]: invalid url %q", i, endpoint.URL)
+		}
+		for _, event := range endpoint.Events {
+			if !entity.IsValidWebhookEvent(event) {
+				return service.WebhookServiceConfig{}, fmt.Errorf("webhooks.endpoints[%d]: unknown event %q", i, event)
+			}
+		}
+		if endpoint.Secret == "" && cfg.Webhooks.Secret == "" {
+			return service.WebhookServiceConfig{}, fmt.Errorf("webhooks.endpoints[%d]: no signing secret configured", i)
+		}
+
+		endpoints = append(endpoints, service.WebhookEndpoint{
+			URL:    endpoint.URL,
+			Secret: endpoint.Secret,
+			Events: endpoint.Events,
+		})
+	}
+
+	return service.WebhookServiceConfig{
+		Endpoints:    endpoints,
+		Secret:       cfg.Webhooks.Secret,
+		MaxAttempts:  cfg.Webhooks.MaxAttempts,
+		RetryBackoff: cfg.Webhooks.RetryBackoff,
+		Timeout:      cfg.Webhooks.Timeout,
+		Proxy:        NewProxyConfig(cfg),
+		Clock:        clk,
+	}, nil
+}
+
+// NewJobServiceConfig 根据应用配置创建异步任务服务配置
+func NewJobServiceConfig(cfg *config.Config, clk clock.Clock) service.JobServiceConfig {
+	return service.JobServiceConfig{
+		MaxAttempts: cfg.Jobs.MaxAttempts,
+		Clock:       clk,
+	}
+}
+
+// NewEventBus 根据应用配置创建领域事件总线
+func NewEventBus(cfg *config.Config) *eventbus.Bus {
+	return eventbus.New(eventbus.Config{QueueSize: cfg.Events.QueueSize})
+}
+
+// NewCaptchaVerifier 根据应用配置创建人机验证器，未启用时不做校验，验证服务或密钥无效时返回错误
+func NewCaptchaVerifier(cfg *config.Config) (captcha.Verifier, error) {
+	if !cfg.Captcha.Enabled {
+		return captcha.NoopVerifier{}, nil
+	}
+
+	verifier, err := captcha.NewVerifier(captcha.Config{
+		Provider:  cfg.Captcha.Provider,
+		Secret:    cfg.Captcha.Secret,
+		VerifyURL: cfg.Captcha.VerifyURL,
+		Timeout:   cfg.Captcha.Timeout,
+		Proxy:     NewProxyConfig(cfg),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("captcha: %w", err)
+	}
+	return verifier, nil
+}
+
+// NewLocker 创建基于Redis的分布式锁，未启用Redis时返回nil，后台任务直接在本副本运行
+func NewLocker(cfg *config.Config, client *redis.Client) *lock.Locker {
+	if client == nil {
+		return nil
+	}
+	return lock.NewLocker(client, cfg.App.Name+":lock:")
+}
+
+// NewLiveStreamClientConfig 根据应用配置创建直播平台客户端配置
+func NewLiveStreamClientConfig(cfg *config.Config, log *zap.Logger) livestream.ClientConfig {
+	requests := make(map[string]livestream.RequestConfig, len(cfg.Live.Requests))
+	for platform, request := range cfg.Live.Requests {
+		requests[platform] = livestream.RequestConfig(request)
+		// Cookie和认证类请求头只输出脱敏后的值
+		log.Info("Live stream platform request config",
+			zap.String("platform", platform),
+			zap.Any("request", requests[platform].Redacted()))
+	}
+
+	return livestream.ClientConfig{
+		Twitch: livestream.TwitchConfig{
+			ClientID:     cfg.Live.Twitch.ClientID,
+			ClientSecret: cfg.Live.Twitch.ClientSecret,
+		},
+		Kuaishou: livestream.KuaishouConfig{
+			Cookie: cfg.Live.Kuaishou.Cookie,
+		},
+		Proxy:    NewProxyConfig(cfg),
+		Retry:    livestream.RetryConfig(cfg.Live.Retry),
+		Requests: requests,
+	}
+}
+
+// NewLiveSubscriptionServiceConfig 根据应用配置创建直播间订阅服务配置
+func NewLiveSubscriptionServiceConfig(cfg *config.Config, clk clock.Clock) service.LiveSubscriptionServiceConfig {
+	return service.LiveSubscriptionServiceConfig{
+		StatusCacheTTL: cfg.Live.SubscriptionStatusCacheTTL,
+		Clock:          clk,
+	}
+}
+
+// NewProxyConfig 根据应用配置创建出站请求的代理配置
+func NewProxyConfig(cfg *config.Config) httpproxy.Config {
+	return httpproxy.Config{
+		URL:     cfg.Proxy.URL,
+		NoProxy: cfg.Proxy.NoProxy,
+	}
+}
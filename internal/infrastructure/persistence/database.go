@@ -3,12 +3,17 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
 
 	"nebula-live/ent"
 	"nebula-live/internal/infrastructure/config"
+	"nebula-live/pkg/logger"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
@@ -18,15 +23,16 @@ import (
 )
 
 // NewEntClient 创建Ent客户端
-func NewEntClient(cfg *config.Config, logger *zap.Logger) (*ent.Client, error) {
+func NewEntClient(cfg *config.Config, log *zap.Logger) (*ent.Client, error) {
 	var db *sql.DB
 	var dbDialect string
+	var dsn string
 	var err error
 
 	switch cfg.Database.Driver {
 	case "sqlite":
 		dbDialect = dialect.SQLite
-		dsn := cfg.Database.Database
+		dsn = cfg.Database.Database
 
 		// 如果不是内存数据库，确保目录存在
 		if dsn != ":memory:" && dsn != "" {
@@ -50,15 +56,15 @@ func NewEntClient(cfg *config.Config, logger *zap.Logger) (*ent.Client, error) {
 			return nil, fmt.Errorf("failed to open sqlite connection: %w", err)
 		}
 
-		logger.Info("SQLite database connection established successfully",
+		log.Info("SQLite database connection established successfully",
 			zap.String("driver", cfg.Database.Driver),
 			zap.String("database", cfg.Database.Database),
 		)
 
 	case "postgres", "postgresql":
 		dbDialect = dialect.Postgres
-		// 构建PostgreSQL连接字符串
-		dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		// 构建PostgreSQL连接字符串（包含密码，只能通过logger.RedactDSN写入日志）
+		dsn = fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 			cfg.Database.Host,
 			cfg.Database.Port,
 			cfg.Database.Username,
@@ -73,11 +79,12 @@ func NewEntClient(cfg *config.Config, logger *zap.Logger) (*ent.Client, error) {
 			return nil, fmt.Errorf("failed to open postgres connection: %w", err)
 		}
 
-		logger.Info("PostgreSQL database connection established successfully",
+		log.Info("PostgreSQL database connection established successfully",
 			zap.String("driver", cfg.Database.Driver),
 			zap.String("host", cfg.Database.Host),
 			zap.Int("port", cfg.Database.Port),
 			zap.String("database", cfg.Database.Database),
+			zap.String("dsn", logger.RedactDSN(dsn)),
 		)
 
 	default:
@@ -91,9 +98,12 @@ func NewEntClient(cfg *config.Config, logger *zap.Logger) (*ent.Client, error) {
 		db.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
 	}
 
-	// 测试连接
-	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("failed to ping database: %w", err)
+	// 测试连接，数据库尚未就绪时重试，等待期间收到退出信号立即放弃
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := pingWithRetry(ctx, db, cfg.Database, log); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database %s: %w", logger.RedactDSN(dsn), err)
 	}
 
 	// 创建Ent客户端
@@ -103,6 +113,56 @@ func NewEntClient(cfg *config.Config, logger *zap.Logger) (*ent.Client, error) {
 	return client, nil
 }
 
+const (
+	// maxConnectRetryInterval 启动连接重试的最长等待时间
+	maxConnectRetryInterval = 30 * time.Second
+	// connectPingTimeout 单次Ping的超时时间，避免网络不通时一次尝试挂起过久
+	connectPingTimeout = 5 * time.Second
+)
+
+// pingWithRetry 按指数退避重试Ping，避免容器启动时数据库晚于服务就绪导致启动失败。
+// ctx 取消时（如收到退出信号）停止等待并返回
+func pingWithRetry(ctx context.Context, db *sql.DB, cfg config.DatabaseConfig, logger *zap.Logger) error {
+	maxAttempts := max(cfg.ConnectMaxAttempts, 1)
+	interval := cfg.ConnectRetryInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = pingOnce(ctx, db); err == nil {
+			return nil
+		}
+		if attempt >= maxAttempts {
+			return err
+		}
+
+		logger.Warn("Database is not ready, retrying",
+			zap.Int("attempt", attempt),
+			zap.Int("max_attempts", maxAttempts),
+			zap.Duration("retry_in", interval),
+			zap.Error(err),
+		)
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("stopped waiting for database: %w", errors.Join(ctx.Err(), err))
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxConnectRetryInterval)
+	}
+}
+
+// pingOnce 在单次超时内Ping数据库
+func pingOnce(ctx context.Context, db *sql.DB) error {
+	ctx, cancel := context.WithTimeout(ctx, connectPingTimeout)
+	defer cancel()
+	return db.PingContext(ctx)
+}
+
 // RunMigrations 运行数据库迁移
 func RunMigrations(ctx context.Context, client *ent.Client, logger *zap.Logger) error {
 	logger.Info("Running database migrations")
@@ -0,0 +1,77 @@
+package persistence_test
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/persistence"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// newBufferLogger 创建写入内存的JSON日志，用于检查实际输出的内容
+func newBufferLogger() (*zap.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	core := zapcore.NewCore(
+		zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+		zapcore.AddSync(&buf),
+		zapcore.DebugLevel,
+	)
+	return zap.New(core), &buf
+}
+
+// closedPort 返回本机一个没有监听的端口
+func closedPort(t *testing.T) int {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+	return port
+}
+
+func TestNewEntClient_PostgresPasswordNeverLogged(t *testing.T) {
+	const password = "pg-s3cret-Passw0rd"
+
+	log, buf := newBufferLogger()
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{
+			Driver:               "postgres",
+			Host:                 "127.0.0.1",
+			Port:                 closedPort(t),
+			Username:             "nebula",
+			Password:             password,
+			Database:             "nebula_live",
+			SSLMode:              "disable",
+			ConnectMaxAttempts:   2,
+			ConnectRetryInterval: 10 * time.Millisecond,
+		},
+	}
+
+	client, err := persistence.NewEntClient(cfg, log)
+	if err == nil {
+		client.Close()
+		t.Fatal("NewEntClient() error = nil, want a connection error")
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "Database is not ready, retrying") {
+		t.Fatalf("expected retry log, got %q", output)
+	}
+	if strings.Contains(output, password) {
+		t.Errorf("log output contains the database password: %s", output)
+	}
+	if !strings.Contains(output, "password=***") {
+		t.Errorf("log output does not contain the redacted DSN: %s", output)
+	}
+	if strings.Contains(err.Error(), password) {
+		t.Errorf("error contains the database password: %v", err)
+	}
+}
@@ -0,0 +1,232 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"nebula-live/ent"
+	"nebula-live/ent/job"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type jobRepository struct {
+	client *ent.Client
+}
+
+// NewJobRepository 创建异步任务仓储实例
+func NewJobRepository(client *ent.Client) repository.JobRepository {
+	return &jobRepository{
+		client: client,
+	}
+}
+
+// convertToEntity 转换EntGo实体到Domain实体
+func (r *jobRepository) convertToEntity(entJob *ent.Job) *entity.Job {
+	result := &entity.Job{
+		ID:         entJob.ID,
+		Type:       entJob.Type,
+		Status:     entity.JobStatus(entJob.Status),
+		Error:      entJob.Error,
+		Attempts:   entJob.Attempts,
+		CreatedBy:  entJob.CreatedBy,
+		StartedAt:  entJob.StartedAt,
+		FinishedAt: entJob.FinishedAt,
+		CreatedAt:  entJob.CreatedAt,
+		UpdatedAt:  entJob.UpdatedAt,
+	}
+	if entJob.Payload != "" {
+		result.Payload = json.RawMessage(entJob.Payload)
+	}
+	if entJob.Result != "" {
+		result.Result = json.RawMessage(entJob.Result)
+	}
+	return result
+}
+
+// Create 创建待执行的任务
+func (r *jobRepository) Create(ctx context.Context, j *entity.Job) (*entity.Job, error) {
+	entJob, err := r.client.Job.
+		Create().
+		SetType(j.Type).
+		SetPayload(string(j.Payload)).
+		SetNillableCreatedBy(j.CreatedBy).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to create job",
+			zap.String("type", j.Type),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return r.convertToEntity(entJob), nil
+}
+
+// GetByID 根据ID获取任务，不存在时返回nil
+func (r *jobRepository) GetByID(ctx context.Context, id uint) (*entity.Job, error) {
+	entJob, err := r.client.Job.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		logger.Error("Failed to get job by ID",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return r.convertToEntity(entJob), nil
+}
+
+// ClaimPending 按提交顺序领取待执行的任务
+//
+// 与定时推送的领取方式相同，每条记录通过带状态条件的UPDATE领取，只有更新成功的记录才会返回，
+// 因此多个工作器同时轮询时同一个任务也只会被领取一次。
+func (r *jobRepository) ClaimPending(ctx context.Context, now time.Time, limit int) ([]*entity.Job, error) {
+	entJobs, err := r.client.Job.
+		Query().
+		Where(job.StatusEQ(job.StatusPending)).
+		Order(ent.Asc(job.FieldID)).
+		Limit(limit).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to query pending jobs", zap.Error(err))
+		return nil, err
+	}
+
+	var claimed []*entity.Job
+	for _, entJob := range entJobs {
+		affected, err := r.client.Job.
+			Update().
+			Where(
+				job.ID(entJob.ID),
+				job.StatusEQ(job.StatusPending),
+			).
+			SetStatus(job.StatusRunning).
+			AddAttempts(1).
+			SetStartedAt(now).
+			Save(ctx)
+
+		if err != nil {
+			logger.Error("Failed to claim job",
+				zap.Uint("id", entJob.ID),
+				zap.Error(err))
+			return claimed, err
+		}
+
+		// 已被其他工作器领取
+		if affected == 0 {
+			continue
+		}
+
+		entJob.Status = job.StatusRunning
+		entJob.Attempts++
+		entJob.StartedAt = &now
+		claimed = append(claimed, r.convertToEntity(entJob))
+	}
+
+	return claimed, nil
+}
+
+// MarkCompleted 标记任务执行成功并保存结果
+func (r *jobRepository) MarkCompleted(ctx context.Context, id uint, result []byte, finishedAt time.Time) error {
+	err := r.client.Job.
+		UpdateOneID(id).
+		SetStatus(job.StatusCompleted).
+		SetResult(string(result)).
+		ClearError().
+		SetFinishedAt(finishedAt).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to mark job as completed",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// MarkFailed 标记任务执行失败
+func (r *jobRepository) MarkFailed(ctx context.Context, id uint, reason string, finishedAt time.Time) error {
+	err := r.client.Job.
+		UpdateOneID(id).
+		SetStatus(job.StatusFailed).
+		SetError(reason).
+		SetFinishedAt(finishedAt).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to mark job as failed",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// FailInterrupted 将遗留在running状态的指定类型任务标记为失败
+func (r *jobRepository) FailInterrupted(ctx context.Context, types []string, reason string, now time.Time) (int, error) {
+	if len(types) == 0 {
+		return 0, nil
+	}
+
+	failed, err := r.client.Job.
+		Update().
+		Where(
+			job.StatusEQ(job.StatusRunning),
+			job.TypeIn(types...),
+		).
+		SetStatus(job.StatusFailed).
+		SetError(reason).
+		SetFinishedAt(now).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to fail interrupted jobs", zap.Error(err))
+		return 0, err
+	}
+
+	return failed, nil
+}
+
+// RecoverInterrupted 处理遗留在running状态的任务，执行次数未达到上限的重新排队，其余标记为失败
+func (r *jobRepository) RecoverInterrupted(ctx context.Context, maxAttempts int, reason string, now time.Time) (requeued, failed int, err error) {
+	failed, err = r.client.Job.
+		Update().
+		Where(
+			job.StatusEQ(job.StatusRunning),
+			job.AttemptsGTE(maxAttempts),
+		).
+		SetStatus(job.StatusFailed).
+		SetError(reason).
+		SetFinishedAt(now).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to fail interrupted jobs", zap.Error(err))
+		return 0, 0, err
+	}
+
+	requeued, err = r.client.Job.
+		Update().
+		Where(job.StatusEQ(job.StatusRunning)).
+		SetStatus(job.StatusPending).
+		ClearStartedAt().
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to requeue interrupted jobs", zap.Error(err))
+		return 0, failed, err
+	}
+
+	return requeued, failed, nil
+}
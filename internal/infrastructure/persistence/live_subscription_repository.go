@@ -0,0 +1,143 @@
+package persistence
+
+import (
+	"context"
+
+	"nebula-live/ent"
+	"nebula-live/ent/livesubscription"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/domain/service"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type liveSubscriptionRepository struct {
+	client *ent.Client
+}
+
+// NewLiveSubscriptionRepository 创建直播间订阅仓储实例
+func NewLiveSubscriptionRepository(client *ent.Client) repository.LiveSubscriptionRepository {
+	return &liveSubscriptionRepository{
+		client: client,
+	}
+}
+
+// convertToEntity 转换EntGo实体到Domain实体
+func (r *liveSubscriptionRepository) convertToEntity(entSubscription *ent.LiveSubscription) *entity.LiveSubscription {
+	return &entity.LiveSubscription{
+		ID:        entSubscription.ID,
+		UserID:    entSubscription.UserID,
+		Platform:  entSubscription.Platform,
+		RoomID:    entSubscription.RoomID,
+		CreatedAt: entSubscription.CreatedAt,
+	}
+}
+
+// Create 创建订阅
+func (r *liveSubscriptionRepository) Create(ctx context.Context, subscription *entity.LiveSubscription) (*entity.LiveSubscription, error) {
+	entSubscription, err := r.client.LiveSubscription.
+		Create().
+		SetUserID(subscription.UserID).
+		SetPlatform(subscription.Platform).
+		SetRoomID(subscription.RoomID).
+		Save(ctx)
+
+	if err != nil {
+		// 重复订阅（包括并发订阅）由 (user_id, platform, room_id) 唯一索引拦截
+		if ent.IsConstraintError(err) {
+			return nil, service.ErrLiveSubscriptionExists
+		}
+		logger.Error("Failed to create live subscription",
+			zap.Uint("user_id", subscription.UserID),
+			zap.String("platform", subscription.Platform),
+			zap.String("room_id", subscription.RoomID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return r.convertToEntity(entSubscription), nil
+}
+
+// List 获取用户的订阅列表（带分页）
+func (r *liveSubscriptionRepository) List(ctx context.Context, userID uint, offset, limit int) ([]*entity.LiveSubscription, error) {
+	entSubscriptions, err := r.client.LiveSubscription.
+		Query().
+		Where(livesubscription.UserID(userID)).
+		Offset(offset).
+		Limit(limit).
+		Order(ent.Desc(livesubscription.FieldCreatedAt), ent.Desc(livesubscription.FieldID)).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to list live subscriptions",
+			zap.Uint("user_id", userID),
+			zap.Int("offset", offset),
+			zap.Int("limit", limit),
+			zap.Error(err))
+		return nil, err
+	}
+
+	subscriptions := make([]*entity.LiveSubscription, len(entSubscriptions))
+	for i, entSubscription := range entSubscriptions {
+		subscriptions[i] = r.convertToEntity(entSubscription)
+	}
+
+	return subscriptions, nil
+}
+
+// Count 获取用户的订阅总数
+func (r *liveSubscriptionRepository) Count(ctx context.Context, userID uint) (int64, error) {
+	count, err := r.client.LiveSubscription.
+		Query().
+		Where(livesubscription.UserID(userID)).
+		Count(ctx)
+
+	if err != nil {
+		logger.Error("Failed to count live subscriptions",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return int64(count), nil
+}
+
+// Delete 删除用户的指定订阅
+func (r *liveSubscriptionRepository) Delete(ctx context.Context, userID, id uint) (bool, error) {
+	affected, err := r.client.LiveSubscription.
+		Delete().
+		Where(
+			livesubscription.ID(id),
+			livesubscription.UserID(userID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to delete live subscription",
+			zap.Uint("id", id),
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// DeleteByUserID 删除用户的所有订阅
+func (r *liveSubscriptionRepository) DeleteByUserID(ctx context.Context, userID uint) (int, error) {
+	affected, err := r.client.LiveSubscription.
+		Delete().
+		Where(livesubscription.UserID(userID)).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to delete live subscriptions",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return affected, nil
+}
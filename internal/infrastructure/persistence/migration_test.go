@@ -0,0 +1,98 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"nebula-live/ent"
+	"nebula-live/internal/infrastructure/persistence"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+	_ "modernc.org/sqlite"
+)
+
+// tableIndexes 读取SQLite表上的索引，键为逗号连接的列名，值为是否唯一
+func tableIndexes(t *testing.T, db *sql.DB, table string) map[string]bool {
+	t.Helper()
+	rows, err := db.Query("SELECT name, \"unique\" FROM pragma_index_list(?)", table)
+	if err != nil {
+		t.Fatalf("index_list(%s) error = %v", table, err)
+	}
+	type index struct {
+		name   string
+		unique bool
+	}
+	var list []index
+	for rows.Next() {
+		var idx index
+		if err := rows.Scan(&idx.name, &idx.unique); err != nil {
+			t.Fatalf("scan index_list(%s) error = %v", table, err)
+		}
+		list = append(list, idx)
+	}
+	rows.Close()
+
+	indexes := make(map[string]bool, len(list))
+	for _, idx := range list {
+		rows, err := db.Query("SELECT name FROM pragma_index_info(?) ORDER BY seqno", idx.name)
+		if err != nil {
+			t.Fatalf("index_info(%s) error = %v", idx.name, err)
+		}
+		var columns []string
+		for rows.Next() {
+			var column string
+			if err := rows.Scan(&column); err != nil {
+				t.Fatalf("scan index_info(%s) error = %v", idx.name, err)
+			}
+			columns = append(columns, column)
+		}
+		rows.Close()
+		key := strings.Join(columns, ",")
+		indexes[key] = indexes[key] || idx.unique
+	}
+	return indexes
+}
+
+func TestRunMigrations_CreatesQueryIndexes(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "test.db")+"?_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+
+	if err := persistence.RunMigrations(context.Background(), client, zap.NewNop()); err != nil {
+		t.Fatalf("RunMigrations() error = %v", err)
+	}
+
+	tests := []struct {
+		table   string
+		columns string
+		unique  bool
+	}{
+		{"users", "username", true},
+		{"users", "email", true},
+		{"roles", "name", true},
+		{"permissions", "name", true},
+		{"user_push_settings", "provider,device_id", true},
+		{"user_push_settings", "device_id", false},
+		{"user_push_settings", "user_id", false},
+		{"user_push_settings", "user_id,enabled", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.table+"("+tt.columns+")", func(t *testing.T) {
+			unique, ok := tableIndexes(t, db, tt.table)[tt.columns]
+			if !ok {
+				t.Fatalf("no index on %s(%s)", tt.table, tt.columns)
+			}
+			if tt.unique && !unique {
+				t.Errorf("index on %s(%s) is not unique", tt.table, tt.columns)
+			}
+		})
+	}
+}
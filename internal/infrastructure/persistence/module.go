@@ -11,5 +11,11 @@ var PersistenceModule = fx.Options(
 		NewUserRoleRepository,
 		NewRolePermissionRepository,
 		NewUserPushSettingRepository,
+		NewScheduledPushRepository,
+		NewRecurringPushRepository,
+		NewUserSessionRepository,
+		NewLiveSubscriptionRepository,
+		NewWebhookDeliveryRepository,
+		NewJobRepository,
 	),
 )
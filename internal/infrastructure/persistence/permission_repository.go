@@ -6,6 +6,7 @@ import (
 	"nebula-live/ent/permission"
 	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/domain/service"
 	"nebula-live/pkg/logger"
 
 	"go.uber.org/zap"
@@ -28,7 +29,9 @@ func (r *permissionRepository) Create(ctx context.Context, permEntity *entity.Pe
 		SetNillableDescription(&permEntity.Description).
 		SetResource(permEntity.Resource).
 		SetAction(permEntity.Action).
+		SetCategory(permEntity.Category).
 		SetIsSystem(permEntity.IsSystem).
+		SetNillableCreatedBy(permEntity.CreatedBy).
 		Save(ctx)
 
 	if err != nil {
@@ -103,11 +106,20 @@ func (r *permissionRepository) List(ctx context.Context, offset, limit int) ([]*
 	return result, nil
 }
 
+func (r *permissionRepository) Count(ctx context.Context) (int64, error) {
+	count, err := r.client.Permission.
+		Query().
+		Count(ctx)
+	return int64(count), err
+}
+
 func (r *permissionRepository) Update(ctx context.Context, permEntity *entity.Permission) (*entity.Permission, error) {
 	updated, err := r.client.Permission.
 		UpdateOneID(permEntity.ID).
 		SetDisplayName(permEntity.DisplayName).
 		SetNillableDescription(&permEntity.Description).
+		SetCategory(permEntity.Category).
+		SetNillableUpdatedBy(permEntity.UpdatedBy).
 		Save(ctx)
 
 	if err != nil {
@@ -193,6 +205,109 @@ func (r *permissionRepository) GetByResource(ctx context.Context, resource strin
 	return result, nil
 }
 
+func (r *permissionRepository) ListAll(ctx context.Context) ([]*entity.Permission, error) {
+	permissions, err := r.client.Permission.
+		Query().
+		Order(ent.Asc(permission.FieldCategory), ent.Asc(permission.FieldName)).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to list all permissions", zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]*entity.Permission, len(permissions))
+	for i, perm := range permissions {
+		result[i] = r.convertToEntity(perm)
+	}
+
+	return result, nil
+}
+
+func (r *permissionRepository) CreateMissing(ctx context.Context, permissions []*entity.Permission) ([]*entity.Permission, error) {
+	names := make([]string, len(permissions))
+	for i, permEntity := range permissions {
+		names[i] = permEntity.Name
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		logger.Error("Failed to start transaction for creating permissions",
+			zap.Int("count", len(permissions)),
+			zap.Error(err))
+		return nil, err
+	}
+
+	existing, err := tx.Permission.
+		Query().
+		Where(permission.NameIn(names...)).
+		Select(permission.FieldName).
+		Strings(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to check existing permissions",
+			zap.Strings("names", names),
+			zap.Error(err))
+		return nil, err
+	}
+
+	taken := make(map[string]bool, len(permissions))
+	for _, name := range existing {
+		taken[name] = true
+	}
+
+	var (
+		builders []*ent.PermissionCreate
+		indexes  []int
+	)
+	for i, permEntity := range permissions {
+		if taken[permEntity.Name] {
+			continue
+		}
+		taken[permEntity.Name] = true
+
+		builders = append(builders, tx.Permission.
+			Create().
+			SetName(permEntity.Name).
+			SetDisplayName(permEntity.DisplayName).
+			SetNillableDescription(&permEntity.Description).
+			SetResource(permEntity.Resource).
+			SetAction(permEntity.Action).
+			SetCategory(permEntity.Category).
+			SetIsSystem(permEntity.IsSystem).
+			SetNillableCreatedBy(permEntity.CreatedBy))
+		indexes = append(indexes, i)
+	}
+
+	result := make([]*entity.Permission, len(permissions))
+	if len(builders) > 0 {
+		created, err := tx.Permission.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			// 并发创建了同名权限
+			if ent.IsConstraintError(err) {
+				return nil, service.ErrPermissionAlreadyExists
+			}
+			logger.Error("Failed to create permissions",
+				zap.Strings("names", names),
+				zap.Error(err))
+			return nil, err
+		}
+		for i, permEnt := range created {
+			result[indexes[i]] = r.convertToEntity(permEnt)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit permissions creation",
+			zap.Strings("names", names),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // convertToEntity 将EntGo实体转换为领域实体
 func (r *permissionRepository) convertToEntity(permEnt *ent.Permission) *entity.Permission {
 	return &entity.Permission{
@@ -202,7 +317,10 @@ func (r *permissionRepository) convertToEntity(permEnt *ent.Permission) *entity.
 		Description: permEnt.Description,
 		Resource:    permEnt.Resource,
 		Action:      permEnt.Action,
+		Category:    permEnt.Category,
 		IsSystem:    permEnt.IsSystem,
+		CreatedBy:   permEnt.CreatedBy,
+		UpdatedBy:   permEnt.UpdatedBy,
 		CreatedAt:   permEnt.CreatedAt,
 		UpdatedAt:   permEnt.UpdatedAt,
 	}
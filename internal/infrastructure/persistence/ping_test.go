@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"nebula-live/internal/infrastructure/config"
+
+	"go.uber.org/zap"
+)
+
+var errDatabaseStarting = errors.New("the database system is starting up")
+
+// flakyConnector 前 failures 次Ping失败的数据库驱动，模拟启动较慢的数据库
+type flakyConnector struct {
+	failures int32
+	pings    atomic.Int32
+}
+
+func (c *flakyConnector) Connect(context.Context) (driver.Conn, error) { return &flakyConn{c}, nil }
+func (c *flakyConnector) Driver() driver.Driver                        { return nil }
+
+type flakyConn struct{ connector *flakyConnector }
+
+func (c *flakyConn) Ping(context.Context) error {
+	if c.connector.pings.Add(1) <= c.connector.failures {
+		return errDatabaseStarting
+	}
+	return nil
+}
+
+func (c *flakyConn) Prepare(string) (driver.Stmt, error) { return nil, errors.ErrUnsupported }
+func (c *flakyConn) Close() error                        { return nil }
+func (c *flakyConn) Begin() (driver.Tx, error)           { return nil, errors.ErrUnsupported }
+
+func openFlakyDB(t *testing.T, failures int32) (*sql.DB, *flakyConnector) {
+	t.Helper()
+	connector := &flakyConnector{failures: failures}
+	db := sql.OpenDB(connector)
+	t.Cleanup(func() { db.Close() })
+	return db, connector
+}
+
+func TestPingWithRetry_ConnectsAfterFailedPings(t *testing.T) {
+	db, connector := openFlakyDB(t, 2)
+	cfg := config.DatabaseConfig{ConnectMaxAttempts: 5, ConnectRetryInterval: time.Millisecond}
+
+	if err := pingWithRetry(context.Background(), db, cfg, zap.NewNop()); err != nil {
+		t.Fatalf("pingWithRetry() error = %v", err)
+	}
+	if got := connector.pings.Load(); got != 3 {
+		t.Errorf("pings = %d, want 3", got)
+	}
+}
+
+func TestPingWithRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	db, connector := openFlakyDB(t, 10)
+	cfg := config.DatabaseConfig{ConnectMaxAttempts: 3, ConnectRetryInterval: time.Millisecond}
+
+	err := pingWithRetry(context.Background(), db, cfg, zap.NewNop())
+	if !errors.Is(err, errDatabaseStarting) {
+		t.Fatalf("pingWithRetry() error = %v, want %v", err, errDatabaseStarting)
+	}
+	if got := connector.pings.Load(); got != 3 {
+		t.Errorf("pings = %d, want 3", got)
+	}
+}
+
+func TestPingWithRetry_StopsWhenContextCancelled(t *testing.T) {
+	db, connector := openFlakyDB(t, 10)
+	cfg := config.DatabaseConfig{ConnectMaxAttempts: 10, ConnectRetryInterval: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := pingWithRetry(ctx, db, cfg, zap.NewNop())
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, errDatabaseStarting) {
+		t.Fatalf("pingWithRetry() error = %v, want context.Canceled wrapping the last ping error", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("pingWithRetry() returned after %v, want it to stop waiting on cancel", elapsed)
+	}
+	if got := connector.pings.Load(); got != 1 {
+		t.Errorf("pings = %d, want 1", got)
+	}
+}
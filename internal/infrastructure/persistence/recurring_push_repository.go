@@ -0,0 +1,246 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"nebula-live/ent"
+	"nebula-live/ent/recurringpush"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type recurringPushRepository struct {
+	client *ent.Client
+}
+
+// NewRecurringPushRepository 创建周期推送仓储实例
+func NewRecurringPushRepository(client *ent.Client) repository.RecurringPushRepository {
+	return &recurringPushRepository{
+		client: client,
+	}
+}
+
+// convertToEntity 转换EntGo实体到Domain实体
+func (r *recurringPushRepository) convertToEntity(entPush *ent.RecurringPush) *entity.RecurringPush {
+	return &entity.RecurringPush{
+		ID:        entPush.ID,
+		UserID:    entPush.UserID,
+		CronExpr:  entPush.CronExpr,
+		Provider:  entPush.Provider,
+		Title:     entPush.Title,
+		Body:      entPush.Body,
+		URL:       entPush.URL,
+		Sound:     entPush.Sound,
+		Icon:      entPush.Icon,
+		Group:     entPush.Group,
+		Level:     entPush.Level,
+		Enabled:   entPush.Enabled,
+		NextRunAt: entPush.NextRunAt,
+		LastRunAt: entPush.LastRunAt,
+		CreatedAt: entPush.CreatedAt,
+		UpdatedAt: entPush.UpdatedAt,
+	}
+}
+
+// Create 创建周期推送
+func (r *recurringPushRepository) Create(ctx context.Context, recurringPush *entity.RecurringPush) (*entity.RecurringPush, error) {
+	entPush, err := r.client.RecurringPush.
+		Create().
+		SetUserID(recurringPush.UserID).
+		SetCronExpr(recurringPush.CronExpr).
+		SetProvider(recurringPush.Provider).
+		SetTitle(recurringPush.Title).
+		SetBody(recurringPush.Body).
+		SetURL(recurringPush.URL).
+		SetSound(recurringPush.Sound).
+		SetIcon(recurringPush.Icon).
+		SetGroup(recurringPush.Group).
+		SetLevel(recurringPush.Level).
+		SetEnabled(recurringPush.Enabled).
+		SetNextRunAt(recurringPush.NextRunAt).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to create recurring push",
+			zap.Uint("user_id", recurringPush.UserID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Recurring push created successfully",
+		zap.Uint("id", entPush.ID),
+		zap.Uint("user_id", entPush.UserID),
+		zap.String("cron_expr", entPush.CronExpr))
+
+	return r.convertToEntity(entPush), nil
+}
+
+// GetByID 根据ID获取周期推送
+func (r *recurringPushRepository) GetByID(ctx context.Context, id uint) (*entity.RecurringPush, error) {
+	entPush, err := r.client.RecurringPush.
+		Query().
+		Where(recurringpush.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		logger.Error("Failed to get recurring push by ID",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return r.convertToEntity(entPush), nil
+}
+
+// Update 更新周期推送
+func (r *recurringPushRepository) Update(ctx context.Context, recurringPush *entity.RecurringPush) (*entity.RecurringPush, error) {
+	entPush, err := r.client.RecurringPush.
+		UpdateOneID(recurringPush.ID).
+		SetCronExpr(recurringPush.CronExpr).
+		SetProvider(recurringPush.Provider).
+		SetTitle(recurringPush.Title).
+		SetBody(recurringPush.Body).
+		SetURL(recurringPush.URL).
+		SetSound(recurringPush.Sound).
+		SetIcon(recurringPush.Icon).
+		SetGroup(recurringPush.Group).
+		SetLevel(recurringPush.Level).
+		SetEnabled(recurringPush.Enabled).
+		SetNextRunAt(recurringPush.NextRunAt).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to update recurring push",
+			zap.Uint("id", recurringPush.ID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Recurring push updated successfully",
+		zap.Uint("id", recurringPush.ID),
+		zap.Uint("user_id", recurringPush.UserID))
+
+	return r.convertToEntity(entPush), nil
+}
+
+// Delete 删除周期推送
+func (r *recurringPushRepository) Delete(ctx context.Context, id uint) error {
+	err := r.client.RecurringPush.
+		DeleteOneID(id).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to delete recurring push",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return err
+	}
+
+	logger.Info("Recurring push deleted successfully",
+		zap.Uint("id", id))
+
+	return nil
+}
+
+// List 获取用户周期推送列表（带分页）
+func (r *recurringPushRepository) List(ctx context.Context, userID uint, offset, limit int) ([]*entity.RecurringPush, error) {
+	entPushes, err := r.client.RecurringPush.
+		Query().
+		Where(recurringpush.UserID(userID)).
+		Offset(offset).
+		Limit(limit).
+		Order(ent.Desc(recurringpush.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to list recurring pushes",
+			zap.Uint("user_id", userID),
+			zap.Int("offset", offset),
+			zap.Int("limit", limit),
+			zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]*entity.RecurringPush, len(entPushes))
+	for i, entPush := range entPushes {
+		result[i] = r.convertToEntity(entPush)
+	}
+
+	return result, nil
+}
+
+// Count 获取用户周期推送总数
+func (r *recurringPushRepository) Count(ctx context.Context, userID uint) (int64, error) {
+	count, err := r.client.RecurringPush.
+		Query().
+		Where(recurringpush.UserID(userID)).
+		Count(ctx)
+
+	if err != nil {
+		logger.Error("Failed to count recurring pushes",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return int64(count), nil
+}
+
+// GetDue 获取已到运行时间的启用周期推送
+func (r *recurringPushRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.RecurringPush, error) {
+	entPushes, err := r.client.RecurringPush.
+		Query().
+		Where(
+			recurringpush.EnabledEQ(true),
+			recurringpush.NextRunAtLTE(now),
+		).
+		Order(ent.Asc(recurringpush.FieldNextRunAt)).
+		Limit(limit).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to query due recurring pushes",
+			zap.Time("now", now),
+			zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]*entity.RecurringPush, len(entPushes))
+	for i, entPush := range entPushes {
+		result[i] = r.convertToEntity(entPush)
+	}
+
+	return result, nil
+}
+
+// Advance 将已到期的周期推送的下次运行时间推进到next，仅当其在now时仍处于到期状态时生效，返回是否推进成功
+//
+// 带条件的UPDATE保证同一次运行只会被一个调度器实例推进。
+func (r *recurringPushRepository) Advance(ctx context.Context, id uint, now, next time.Time) (bool, error) {
+	affected, err := r.client.RecurringPush.
+		Update().
+		Where(
+			recurringpush.ID(id),
+			recurringpush.EnabledEQ(true),
+			recurringpush.NextRunAtLTE(now),
+		).
+		SetNextRunAt(next).
+		SetLastRunAt(now).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to advance recurring push",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return false, err
+	}
+
+	return affected > 0, nil
+}
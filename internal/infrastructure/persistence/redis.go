@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"nebula-live/internal/infrastructure/config"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// redisPingTimeout 启动时检查Redis连接的超时时间
+const redisPingTimeout = 5 * time.Second
+
+// NewRedisClient 创建Redis客户端，未启用Redis时返回nil
+func NewRedisClient(cfg *config.Config, logger *zap.Logger) (*redis.Client, error) {
+	if !cfg.Redis.Enabled {
+		return nil, nil
+	}
+
+	addr := net.JoinHostPort(cfg.Redis.Host, strconv.Itoa(cfg.Redis.Port))
+	client := redis.NewClient(&redis.Options{
+		Addr:         addr,
+		Password:     cfg.Redis.Password,
+		DB:           cfg.Redis.DB,
+		PoolSize:     cfg.Redis.PoolSize,
+		MinIdleConns: cfg.Redis.MinIdleConns,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisPingTimeout)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to ping redis at %s: %w", addr, err)
+	}
+
+	logger.Info("Redis connection established successfully",
+		zap.String("addr", addr),
+		zap.Int("db", cfg.Redis.DB),
+	)
+
+	return client, nil
+}
+
+// CloseRedisClient 关闭Redis客户端，client为nil（未启用Redis）时不做任何操作
+func CloseRedisClient(client *redis.Client, logger *zap.Logger) error {
+	if client == nil {
+		return nil
+	}
+	logger.Info("Closing redis connection")
+	return client.Close()
+}
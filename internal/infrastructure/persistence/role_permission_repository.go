@@ -6,9 +6,11 @@ import (
 	"nebula-live/ent/permission"
 	"nebula-live/ent/role"
 	"nebula-live/ent/rolepermission"
+	"nebula-live/ent/user"
 	"nebula-live/ent/userrole"
 	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/clock"
 	"nebula-live/pkg/logger"
 
 	"go.uber.org/zap"
@@ -16,11 +18,12 @@ import (
 
 type rolePermissionRepository struct {
 	client *ent.Client
+	clock  clock.Clock
 }
 
-// NewRolePermissionRepository 创建角色权限仓储实例
-func NewRolePermissionRepository(client *ent.Client) repository.RolePermissionRepository {
-	return &rolePermissionRepository{client: client}
+// NewRolePermissionRepository 创建角色权限仓储实例，clk决定按用户查询权限时哪些角色分配已过期
+func NewRolePermissionRepository(client *ent.Client, clk clock.Clock) repository.RolePermissionRepository {
+	return &rolePermissionRepository{client: client, clock: clock.OrReal(clk)}
 }
 
 func (r *rolePermissionRepository) AssignPermission(ctx context.Context, rolePermission *entity.RolePermission) (*entity.RolePermission, error) {
@@ -96,6 +99,8 @@ func (r *rolePermissionRepository) GetRolePermissions(ctx context.Context, roleI
 			Resource:    permEnt.Resource,
 			Action:      permEnt.Action,
 			IsSystem:    permEnt.IsSystem,
+			CreatedBy:   permEnt.CreatedBy,
+			UpdatedBy:   permEnt.UpdatedBy,
 			CreatedAt:   permEnt.CreatedAt,
 			UpdatedAt:   permEnt.UpdatedAt,
 		}
@@ -125,6 +130,8 @@ func (r *rolePermissionRepository) GetPermissionRoles(ctx context.Context, permi
 			DisplayName: roleEnt.DisplayName,
 			Description: roleEnt.Description,
 			IsSystem:    roleEnt.IsSystem,
+			CreatedBy:   roleEnt.CreatedBy,
+			UpdatedBy:   roleEnt.UpdatedBy,
 			CreatedAt:   roleEnt.CreatedAt,
 			UpdatedAt:   roleEnt.UpdatedAt,
 		}
@@ -179,7 +186,7 @@ func (r *rolePermissionRepository) GetUserPermissions(ctx context.Context, userI
 		Where(
 			permission.HasRolePermissionsWith(
 				rolepermission.HasRoleWith(
-					role.HasUserRolesWith(userrole.UserID(userID)),
+					role.HasUserRolesWith(userrole.UserID(userID), activeUserRole(r.clock.Now())),
 				),
 			),
 		).
@@ -202,6 +209,8 @@ func (r *rolePermissionRepository) GetUserPermissions(ctx context.Context, userI
 			Resource:    permEnt.Resource,
 			Action:      permEnt.Action,
 			IsSystem:    permEnt.IsSystem,
+			CreatedBy:   permEnt.CreatedBy,
+			UpdatedBy:   permEnt.UpdatedBy,
 			CreatedAt:   permEnt.CreatedAt,
 			UpdatedAt:   permEnt.UpdatedAt,
 		}
@@ -214,11 +223,12 @@ func (r *rolePermissionRepository) CheckUserPermission(ctx context.Context, user
 	exists, err := r.client.Permission.
 		Query().
 		Where(
-			permission.Resource(resource),
-			permission.Action(action),
+			// 资源或操作为*的通配权限同样满足检查
+			permission.ResourceIn(resource, entity.PermissionWildcard),
+			permission.ActionIn(action, entity.PermissionWildcard),
 			permission.HasRolePermissionsWith(
 				rolepermission.HasRoleWith(
-					role.HasUserRolesWith(userrole.UserID(userID)),
+					role.HasUserRolesWith(userrole.UserID(userID), activeUserRole(r.clock.Now())),
 				),
 			),
 		).
@@ -235,3 +245,40 @@ func (r *rolePermissionRepository) CheckUserPermission(ctx context.Context, user
 
 	return exists, nil
 }
+
+func (r *rolePermissionRepository) GetPermissionUsers(ctx context.Context, resource, action string) ([]*entity.User, error) {
+	// 以用户为查询主体，多个角色授予同一权限时用户也只返回一次
+	users, err := r.client.User.
+		Query().
+		Where(
+			user.HasUserRolesWith(
+				activeUserRole(r.clock.Now()),
+				userrole.HasRoleWith(
+					role.HasRolePermissionsWith(
+						rolepermission.HasPermissionWith(
+							// 资源或操作为*的通配权限同样授予该权限
+							permission.ResourceIn(resource, entity.PermissionWildcard),
+							permission.ActionIn(action, entity.PermissionWildcard),
+						),
+					),
+				),
+			),
+		).
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to get permission users",
+			zap.String("resource", resource),
+			zap.String("action", action),
+			zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]*entity.User, len(users))
+	for i, userEnt := range users {
+		result[i] = entUserToDomainUser(userEnt)
+	}
+
+	return result, nil
+}
@@ -27,6 +27,7 @@ func (r *roleRepository) Create(ctx context.Context, roleEntity *entity.Role) (*
 		SetDisplayName(roleEntity.DisplayName).
 		SetNillableDescription(&roleEntity.Description).
 		SetIsSystem(roleEntity.IsSystem).
+		SetNillableCreatedBy(roleEntity.CreatedBy).
 		Save(ctx)
 
 	if err != nil {
@@ -101,11 +102,19 @@ func (r *roleRepository) List(ctx context.Context, offset, limit int) ([]*entity
 	return result, nil
 }
 
+func (r *roleRepository) Count(ctx context.Context) (int64, error) {
+	count, err := r.client.Role.
+		Query().
+		Count(ctx)
+	return int64(count), err
+}
+
 func (r *roleRepository) Update(ctx context.Context, roleEntity *entity.Role) (*entity.Role, error) {
 	updated, err := r.client.Role.
 		UpdateOneID(roleEntity.ID).
 		SetDisplayName(roleEntity.DisplayName).
 		SetNillableDescription(&roleEntity.Description).
+		SetNillableUpdatedBy(roleEntity.UpdatedBy).
 		Save(ctx)
 
 	if err != nil {
@@ -177,6 +186,8 @@ func (r *roleRepository) convertToEntity(roleEnt *ent.Role) *entity.Role {
 		DisplayName: roleEnt.DisplayName,
 		Description: roleEnt.Description,
 		IsSystem:    roleEnt.IsSystem,
+		CreatedBy:   roleEnt.CreatedBy,
+		UpdatedBy:   roleEnt.UpdatedBy,
 		CreatedAt:   roleEnt.CreatedAt,
 		UpdatedAt:   roleEnt.UpdatedAt,
 	}
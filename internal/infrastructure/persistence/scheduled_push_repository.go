@@ -0,0 +1,327 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"nebula-live/ent"
+	"nebula-live/ent/scheduledpush"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type scheduledPushRepository struct {
+	client *ent.Client
+}
+
+// NewScheduledPushRepository 创建定时推送仓储实例
+func NewScheduledPushRepository(client *ent.Client) repository.ScheduledPushRepository {
+	return &scheduledPushRepository{
+		client: client,
+	}
+}
+
+// convertToEntity 转换EntGo实体到Domain实体
+func (r *scheduledPushRepository) convertToEntity(entPush *ent.ScheduledPush) *entity.ScheduledPush {
+	return &entity.ScheduledPush{
+		ID:        entPush.ID,
+		UserID:    entPush.UserID,
+		Provider:  entPush.Provider,
+		Title:     entPush.Title,
+		Body:      entPush.Body,
+		URL:       entPush.URL,
+		Sound:     entPush.Sound,
+		Icon:      entPush.Icon,
+		Group:     entPush.Group,
+		Level:     entPush.Level,
+		SendAt:    entPush.SendAt,
+		Status:    entity.ScheduledPushStatus(entPush.Status),
+		Error:     entPush.Error,
+		SentAt:    entPush.SentAt,
+		CreatedAt: entPush.CreatedAt,
+		UpdatedAt: entPush.UpdatedAt,
+	}
+}
+
+// Create 创建定时推送
+func (r *scheduledPushRepository) Create(ctx context.Context, scheduledPush *entity.ScheduledPush) (*entity.ScheduledPush, error) {
+	entPush, err := r.client.ScheduledPush.
+		Create().
+		SetUserID(scheduledPush.UserID).
+		SetProvider(scheduledPush.Provider).
+		SetTitle(scheduledPush.Title).
+		SetBody(scheduledPush.Body).
+		SetURL(scheduledPush.URL).
+		SetSound(scheduledPush.Sound).
+		SetIcon(scheduledPush.Icon).
+		SetGroup(scheduledPush.Group).
+		SetLevel(scheduledPush.Level).
+		SetSendAt(scheduledPush.SendAt).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to create scheduled push",
+			zap.Uint("user_id", scheduledPush.UserID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Scheduled push created successfully",
+		zap.Uint("id", entPush.ID),
+		zap.Uint("user_id", entPush.UserID),
+		zap.Time("send_at", entPush.SendAt))
+
+	return r.convertToEntity(entPush), nil
+}
+
+// GetByID 根据ID获取定时推送
+func (r *scheduledPushRepository) GetByID(ctx context.Context, id uint) (*entity.ScheduledPush, error) {
+	entPush, err := r.client.ScheduledPush.
+		Query().
+		Where(scheduledpush.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		logger.Error("Failed to get scheduled push by ID",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return r.convertToEntity(entPush), nil
+}
+
+// Update 更新待发送的定时推送，仅当状态仍为pending时生效
+func (r *scheduledPushRepository) Update(ctx context.Context, scheduledPush *entity.ScheduledPush) (*entity.ScheduledPush, error) {
+	affected, err := r.client.ScheduledPush.
+		Update().
+		Where(
+			scheduledpush.ID(scheduledPush.ID),
+			scheduledpush.StatusEQ(scheduledpush.StatusPending),
+		).
+		SetProvider(scheduledPush.Provider).
+		SetTitle(scheduledPush.Title).
+		SetBody(scheduledPush.Body).
+		SetURL(scheduledPush.URL).
+		SetSound(scheduledPush.Sound).
+		SetIcon(scheduledPush.Icon).
+		SetGroup(scheduledPush.Group).
+		SetLevel(scheduledPush.Level).
+		SetSendAt(scheduledPush.SendAt).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to update scheduled push",
+			zap.Uint("id", scheduledPush.ID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	// 状态已不是pending（已被调度器领取或已取消），不做更新
+	if affected == 0 {
+		return nil, nil
+	}
+
+	logger.Info("Scheduled push updated successfully",
+		zap.Uint("id", scheduledPush.ID),
+		zap.Uint("user_id", scheduledPush.UserID))
+
+	return r.GetByID(ctx, scheduledPush.ID)
+}
+
+// Delete 删除定时推送
+func (r *scheduledPushRepository) Delete(ctx context.Context, id uint) error {
+	err := r.client.ScheduledPush.
+		DeleteOneID(id).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to delete scheduled push",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return err
+	}
+
+	logger.Info("Scheduled push deleted successfully",
+		zap.Uint("id", id))
+
+	return nil
+}
+
+// List 获取用户定时推送列表（带分页）
+func (r *scheduledPushRepository) List(ctx context.Context, userID uint, offset, limit int) ([]*entity.ScheduledPush, error) {
+	entPushes, err := r.client.ScheduledPush.
+		Query().
+		Where(scheduledpush.UserID(userID)).
+		Offset(offset).
+		Limit(limit).
+		Order(ent.Desc(scheduledpush.FieldSendAt)).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to list scheduled pushes",
+			zap.Uint("user_id", userID),
+			zap.Int("offset", offset),
+			zap.Int("limit", limit),
+			zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]*entity.ScheduledPush, len(entPushes))
+	for i, entPush := range entPushes {
+		result[i] = r.convertToEntity(entPush)
+	}
+
+	return result, nil
+}
+
+// Count 获取用户定时推送总数
+func (r *scheduledPushRepository) Count(ctx context.Context, userID uint) (int64, error) {
+	count, err := r.client.ScheduledPush.
+		Query().
+		Where(scheduledpush.UserID(userID)).
+		Count(ctx)
+
+	if err != nil {
+		logger.Error("Failed to count scheduled pushes",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return int64(count), nil
+}
+
+// Cancel 取消待发送的定时推送，返回是否取消成功
+func (r *scheduledPushRepository) Cancel(ctx context.Context, id uint) (bool, error) {
+	affected, err := r.client.ScheduledPush.
+		Update().
+		Where(
+			scheduledpush.ID(id),
+			scheduledpush.StatusEQ(scheduledpush.StatusPending),
+		).
+		SetStatus(scheduledpush.StatusCancelled).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to cancel scheduled push",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// ClaimDue 领取到期的定时推送，将其状态从pending原子地更新为sending
+//
+// 每条记录通过带状态条件的UPDATE领取，只有更新成功的记录才会返回，
+// 因此即使多个调度器实例同时轮询，同一条推送也只会被领取一次。
+func (r *scheduledPushRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*entity.ScheduledPush, error) {
+	entPushes, err := r.client.ScheduledPush.
+		Query().
+		Where(
+			scheduledpush.StatusEQ(scheduledpush.StatusPending),
+			scheduledpush.SendAtLTE(now),
+		).
+		Order(ent.Asc(scheduledpush.FieldSendAt)).
+		Limit(limit).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to query due scheduled pushes",
+			zap.Time("now", now),
+			zap.Error(err))
+		return nil, err
+	}
+
+	var claimed []*entity.ScheduledPush
+	for _, entPush := range entPushes {
+		affected, err := r.client.ScheduledPush.
+			Update().
+			Where(
+				scheduledpush.ID(entPush.ID),
+				scheduledpush.StatusEQ(scheduledpush.StatusPending),
+			).
+			SetStatus(scheduledpush.StatusSending).
+			Save(ctx)
+
+		if err != nil {
+			logger.Error("Failed to claim scheduled push",
+				zap.Uint("id", entPush.ID),
+				zap.Error(err))
+			return claimed, err
+		}
+
+		// 已被其他实例领取或被用户取消
+		if affected == 0 {
+			continue
+		}
+
+		entPush.Status = scheduledpush.StatusSending
+		claimed = append(claimed, r.convertToEntity(entPush))
+	}
+
+	return claimed, nil
+}
+
+// MarkSent 标记定时推送发送成功
+func (r *scheduledPushRepository) MarkSent(ctx context.Context, id uint, sentAt time.Time) error {
+	err := r.client.ScheduledPush.
+		UpdateOneID(id).
+		SetStatus(scheduledpush.StatusSent).
+		SetSentAt(sentAt).
+		ClearError().
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to mark scheduled push as sent",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// MarkFailed 标记定时推送发送失败
+func (r *scheduledPushRepository) MarkFailed(ctx context.Context, id uint, reason string) error {
+	err := r.client.ScheduledPush.
+		UpdateOneID(id).
+		SetStatus(scheduledpush.StatusFailed).
+		SetError(reason).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to mark scheduled push as failed",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// FailInterrupted 将遗留在sending状态的定时推送标记为失败，返回受影响的数量
+func (r *scheduledPushRepository) FailInterrupted(ctx context.Context, reason string) (int, error) {
+	affected, err := r.client.ScheduledPush.
+		Update().
+		Where(scheduledpush.StatusEQ(scheduledpush.StatusSending)).
+		SetStatus(scheduledpush.StatusFailed).
+		SetError(reason).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to fail interrupted scheduled pushes",
+			zap.Error(err))
+		return 0, err
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,123 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/testutil"
+)
+
+// newScheduledPushes 创建一个用户和若干条待发送定时推送，发送时间从sendAt起依次间隔一秒
+func newScheduledPushes(t *testing.T, sendAt time.Time, count int) (repository.ScheduledPushRepository, []*entity.ScheduledPush) {
+	t.Helper()
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+	user, err := userService.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	repo := persistence.NewScheduledPushRepository(client)
+	pushes := make([]*entity.ScheduledPush, count)
+	for i := range pushes {
+		pushes[i], err = repo.Create(ctx, &entity.ScheduledPush{
+			UserID: user.ID,
+			Title:  "标题",
+			Body:   "内容",
+			SendAt: sendAt.Add(time.Duration(i) * time.Second),
+			Status: entity.ScheduledPushStatusPending,
+		})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	return repo, pushes
+}
+
+func TestScheduledPushRepository_ClaimDueClaimsEachPushOnce(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	repo, _ := newScheduledPushes(t, now.Add(-time.Minute), 5)
+
+	// 两个调度器先后领取，第二次只能领取到第一次没有领取的推送
+	claimed := make([][]*entity.ScheduledPush, 2)
+	for i, limit := range []int{3, 10} {
+		pushes, err := repo.ClaimDue(ctx, now, limit)
+		if err != nil {
+			t.Fatalf("ClaimDue() error = %v", err)
+		}
+		claimed[i] = pushes
+	}
+	if len(claimed[0]) != 3 || len(claimed[1]) != 2 {
+		t.Errorf("claimed %d then %d pushes, want 3 then 2", len(claimed[0]), len(claimed[1]))
+	}
+
+	seen := make(map[uint]bool)
+	for _, pushes := range claimed {
+		for _, push := range pushes {
+			if seen[push.ID] {
+				t.Errorf("push %d claimed twice", push.ID)
+			}
+			seen[push.ID] = true
+			if push.Status != entity.ScheduledPushStatusSending {
+				t.Errorf("claimed push %d status = %s, want sending", push.ID, push.Status)
+			}
+		}
+	}
+	if len(seen) != 5 {
+		t.Errorf("claimed %d pushes, want 5", len(seen))
+	}
+
+	again, err := repo.ClaimDue(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("ClaimDue() error = %v", err)
+	}
+	if len(again) != 0 {
+		t.Errorf("second ClaimDue() returned %d pushes, want 0", len(again))
+	}
+}
+
+func TestScheduledPushRepository_FailInterruptedOnlyTouchesSending(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	repo, pushes := newScheduledPushes(t, now.Add(-time.Minute), 3)
+
+	// 第一条已领取后中断，第二条已发送成功，第三条仍在等待
+	if _, err := repo.ClaimDue(ctx, now, 2); err != nil {
+		t.Fatalf("ClaimDue() error = %v", err)
+	}
+	if err := repo.MarkSent(ctx, pushes[1].ID, now); err != nil {
+		t.Fatalf("MarkSent() error = %v", err)
+	}
+
+	count, err := repo.FailInterrupted(ctx, "interrupted")
+	if err != nil {
+		t.Fatalf("FailInterrupted() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("FailInterrupted() = %d, want 1", count)
+	}
+
+	want := []entity.ScheduledPushStatus{
+		entity.ScheduledPushStatusFailed,
+		entity.ScheduledPushStatusSent,
+		entity.ScheduledPushStatusPending,
+	}
+	for i, push := range pushes {
+		got, err := repo.GetByID(ctx, push.ID)
+		if err != nil {
+			t.Fatalf("GetByID() error = %v", err)
+		}
+		if got.Status != want[i] {
+			t.Errorf("push %d status = %s, want %s", i, got.Status, want[i])
+		}
+	}
+	if got, _ := repo.GetByID(ctx, pushes[0].ID); got.Error != "interrupted" {
+		t.Errorf("interrupted push error = %q, want %q", got.Error, "interrupted")
+	}
+}
@@ -2,12 +2,20 @@ package persistence
 
 import (
 	"context"
+	"errors"
+	"time"
+
 	"nebula-live/ent"
+	"nebula-live/ent/user"
 	"nebula-live/ent/userpushsetting"
 	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/pkg/push"
 	"nebula-live/pkg/logger"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"go.uber.org/zap"
 )
 
@@ -34,22 +42,98 @@ func (r *userPushSettingRepository) convertToEntity(entSetting *ent.UserPushSett
 		Settings:   entSetting.Settings,
 		CreatedAt:  entSetting.CreatedAt,
 		UpdatedAt:  entSetting.UpdatedAt,
+
+		ConsecutiveFailures: entSetting.ConsecutiveFailures,
+		LastFailureAt:       entSetting.LastFailureAt,
 	}
 }
 
-// Create 创建用户推送设置
-func (r *userPushSettingRepository) Create(ctx context.Context, setting *entity.UserPushSetting) (*entity.UserPushSetting, error) {
-	entSetting, err := r.client.UserPushSetting.
-		Create().
-		SetUserID(setting.UserID).
-		SetProvider(userpushsetting.Provider(setting.Provider)).
-		SetEnabled(setting.Enabled).
-		SetDeviceID(setting.DeviceID).
-		SetNillableDeviceName(&setting.DeviceName).
-		SetSettings(setting.Settings).
-		Save(ctx)
+// withTx 在事务中执行fn，fn返回错误时回滚
+func (r *userPushSettingRepository) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// lockDeviceQuota 锁定用户行，使同一用户的设备名额检查和写入串行执行，用户不存在时返回 service.ErrUserNotFound。
+// SQLite 不支持 FOR UPDATE，其写事务本身是串行的
+func lockDeviceQuota(ctx context.Context, tx *ent.Tx, userID uint) error {
+	_, err := tx.User.
+		Query().
+		Where(user.ID(userID), func(s *entsql.Selector) {
+			if s.Dialect() != dialect.SQLite {
+				s.ForUpdate()
+			}
+		}).
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return service.ErrUserNotFound
+	}
+	return err
+}
+
+// checkEnabledLimit 锁定用户后检查启用的设备数是否已达到上限，limit不大于0时不限制
+func checkEnabledLimit(ctx context.Context, tx *ent.Tx, userID uint, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+	if err := lockDeviceQuota(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	count, err := tx.UserPushSetting.
+		Query().
+		Where(
+			userpushsetting.UserID(userID),
+			userpushsetting.EnabledEQ(true),
+		).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return service.ErrDeviceLimitReached
+	}
+	return nil
+}
+
+// Create 创建用户推送设置，启用的设置在同一事务中检查设备上限
+func (r *userPushSettingRepository) Create(ctx context.Context, setting *entity.UserPushSetting, enabledLimit int) (*entity.UserPushSetting, error) {
+	var entSetting *ent.UserPushSetting
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		if setting.Enabled {
+			if err := checkEnabledLimit(ctx, tx, setting.UserID, enabledLimit); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		entSetting, err = tx.UserPushSetting.
+			Create().
+			SetUserID(setting.UserID).
+			SetProvider(userpushsetting.Provider(setting.Provider)).
+			SetEnabled(setting.Enabled).
+			SetDeviceID(setting.DeviceID).
+			SetNillableDeviceName(&setting.DeviceName).
+			SetSettings(setting.Settings).
+			Save(ctx)
+		return err
+	})
 
 	if err != nil {
+		if errors.Is(err, service.ErrDeviceLimitReached) || errors.Is(err, service.ErrUserNotFound) {
+			return nil, err
+		}
+		// 并发注册了同一设备，由 (provider, device_id) 唯一索引拦截
+		if ent.IsConstraintError(err) {
+			return nil, service.ErrDeviceAlreadyExists
+		}
 		logger.Error("Failed to create user push setting",
 			zap.Uint("user_id", setting.UserID),
 			zap.String("provider", setting.Provider),
@@ -212,6 +296,51 @@ func (r *userPushSettingRepository) Update(ctx context.Context, setting *entity.
 	return r.convertToEntity(entSetting), nil
 }
 
+// Enable 启用推送设置并清零连续失败次数，在同一事务中检查设备上限，已启用时不做修改
+func (r *userPushSettingRepository) Enable(ctx context.Context, id uint, enabledLimit int) error {
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		entSetting, err := tx.UserPushSetting.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if entSetting.Enabled {
+			return nil
+		}
+
+		if err := checkEnabledLimit(ctx, tx, entSetting.UserID, enabledLimit); err != nil {
+			return err
+		}
+
+		// 锁定用户前读取的设置可能已被转移或删除，按读取时的所有者条件更新
+		affected, err := tx.UserPushSetting.
+			Update().
+			Where(
+				userpushsetting.ID(id),
+				userpushsetting.UserID(entSetting.UserID),
+			).
+			SetEnabled(true).
+			SetConsecutiveFailures(0).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return service.ErrUserPushSettingNotFound
+		}
+		return nil
+	})
+
+	switch {
+	case ent.IsNotFound(err):
+		return service.ErrUserPushSettingNotFound
+	case err != nil && !errors.Is(err, service.ErrDeviceLimitReached) && !errors.Is(err, service.ErrUserPushSettingNotFound):
+		logger.Error("Failed to enable user push setting",
+			zap.Uint("id", id),
+			zap.Error(err))
+	}
+	return err
+}
+
 // Delete 删除用户推送设置
 func (r *userPushSettingRepository) Delete(ctx context.Context, id uint) error {
 	err := r.client.UserPushSetting.
@@ -246,7 +375,7 @@ func (r *userPushSettingRepository) DeleteByUserIDAndDeviceID(ctx context.Contex
 		logger.Error("Failed to delete user push setting by device ID",
 			zap.Uint("user_id", userID),
 			zap.String("provider", provider),
-			zap.String("device_id", deviceID),
+			zap.String("device_id", push.MaskDeviceID(deviceID)),
 			zap.Error(err))
 		return err
 	}
@@ -254,7 +383,7 @@ func (r *userPushSettingRepository) DeleteByUserIDAndDeviceID(ctx context.Contex
 	logger.Info("User push setting deleted by device ID",
 		zap.Uint("user_id", userID),
 		zap.String("provider", provider),
-		zap.String("device_id", deviceID))
+		zap.String("device_id", push.MaskDeviceID(deviceID)))
 
 	return nil
 }
@@ -272,7 +401,7 @@ func (r *userPushSettingRepository) ExistsByProviderAndDeviceID(ctx context.Cont
 	if err != nil {
 		logger.Error("Failed to check user push setting existence",
 			zap.String("provider", provider),
-			zap.String("device_id", deviceID),
+			zap.String("device_id", push.MaskDeviceID(deviceID)),
 			zap.Error(err))
 		return false, err
 	}
@@ -322,4 +451,241 @@ func (r *userPushSettingRepository) Count(ctx context.Context, userID uint) (int
 	}
 
 	return int64(count), nil
-}
\ No newline at end of file
+}
+
+// CountEnabled 获取用户启用的推送设置总数
+func (r *userPushSettingRepository) CountEnabled(ctx context.Context, userID uint) (int64, error) {
+	count, err := r.client.UserPushSetting.
+		Query().
+		Where(
+			userpushsetting.UserID(userID),
+			userpushsetting.EnabledEQ(true),
+		).
+		Count(ctx)
+
+	if err != nil {
+		logger.Error("Failed to count enabled user push settings",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return int64(count), nil
+}
+
+// ListByProvider 获取用户在指定提供商的推送设置列表（带分页）
+func (r *userPushSettingRepository) ListByProvider(ctx context.Context, userID uint, provider string, offset, limit int) ([]*entity.UserPushSetting, error) {
+	entSettings, err := r.client.UserPushSetting.
+		Query().
+		Where(
+			userpushsetting.UserID(userID),
+			userpushsetting.ProviderEQ(userpushsetting.Provider(provider)),
+		).
+		Offset(offset).
+		Limit(limit).
+		Order(ent.Desc(userpushsetting.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to list user push settings by provider",
+			zap.Uint("user_id", userID),
+			zap.String("provider", provider),
+			zap.Int("offset", offset),
+			zap.Int("limit", limit),
+			zap.Error(err))
+		return nil, err
+	}
+
+	result := make([]*entity.UserPushSetting, len(entSettings))
+	for i, entSetting := range entSettings {
+		result[i] = r.convertToEntity(entSetting)
+	}
+
+	return result, nil
+}
+
+// CountByProvider 获取用户在指定提供商的推送设置总数
+func (r *userPushSettingRepository) CountByProvider(ctx context.Context, userID uint, provider string) (int64, error) {
+	count, err := r.client.UserPushSetting.
+		Query().
+		Where(
+			userpushsetting.UserID(userID),
+			userpushsetting.ProviderEQ(userpushsetting.Provider(provider)),
+		).
+		Count(ctx)
+
+	if err != nil {
+		logger.Error("Failed to count user push settings by provider",
+			zap.Uint("user_id", userID),
+			zap.String("provider", provider),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return int64(count), nil
+}
+
+// SetEnabledByUserID 将用户所有状态不同的推送设置改为指定启用状态，返回变更的数量。
+// 启用时在同一事务中检查全部设置启用后是否超过设备上限
+func (r *userPushSettingRepository) SetEnabledByUserID(ctx context.Context, userID uint, enabled bool, enabledLimit int) (int, error) {
+	var count int
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		if enabled && enabledLimit > 0 {
+			if err := lockDeviceQuota(ctx, tx, userID); err != nil {
+				return err
+			}
+			total, err := tx.UserPushSetting.
+				Query().
+				Where(userpushsetting.UserID(userID)).
+				Count(ctx)
+			if err != nil {
+				return err
+			}
+			if total > enabledLimit {
+				return service.ErrDeviceLimitReached
+			}
+		}
+
+		update := tx.UserPushSetting.
+			Update().
+			Where(
+				userpushsetting.UserID(userID),
+				userpushsetting.EnabledEQ(!enabled),
+			).
+			SetEnabled(enabled)
+
+		// 重新启用时清零连续失败次数，避免下一次失败立即再次被自动禁用
+		if enabled {
+			update.SetConsecutiveFailures(0)
+		}
+
+		var err error
+		count, err = update.Save(ctx)
+		return err
+	})
+	if errors.Is(err, service.ErrDeviceLimitReached) || errors.Is(err, service.ErrUserNotFound) {
+		return 0, err
+	}
+	if err != nil {
+		logger.Error("Failed to set enabled for user push settings",
+			zap.Uint("user_id", userID),
+			zap.Bool("enabled", enabled),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// TransferOwner 将属于fromUserID的推送设置转移给toUserID
+//
+// 按原所有者条件更新，并发转移或删除时只有一个请求生效。启用的设置在同一事务中检查目标用户的设备上限。
+func (r *userPushSettingRepository) TransferOwner(ctx context.Context, id, fromUserID, toUserID uint, enabledLimit int) (bool, error) {
+	var count int
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		entSetting, err := tx.UserPushSetting.
+			Query().
+			Where(
+				userpushsetting.ID(id),
+				userpushsetting.UserID(fromUserID),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if entSetting.Enabled {
+			if err := checkEnabledLimit(ctx, tx, toUserID, enabledLimit); err != nil {
+				return err
+			}
+		}
+
+		count, err = tx.UserPushSetting.
+			Update().
+			Where(
+				userpushsetting.ID(id),
+				userpushsetting.UserID(fromUserID),
+			).
+			SetUserID(toUserID).
+			Save(ctx)
+		return err
+	})
+	if errors.Is(err, service.ErrDeviceLimitReached) || errors.Is(err, service.ErrUserNotFound) {
+		return false, err
+	}
+	if err != nil {
+		logger.Error("Failed to transfer user push setting",
+			zap.Uint("id", id),
+			zap.Uint("from_user_id", fromUserID),
+			zap.Uint("to_user_id", toUserID),
+			zap.Error(err))
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// ResetFailures 清零连续推送失败次数
+func (r *userPushSettingRepository) ResetFailures(ctx context.Context, id uint) error {
+	// 仅在存在失败记录时更新，避免每次推送成功都写库
+	_, err := r.client.UserPushSetting.
+		Update().
+		Where(
+			userpushsetting.ID(id),
+			userpushsetting.ConsecutiveFailuresGT(0),
+		).
+		SetConsecutiveFailures(0).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to reset push setting failures",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// RecordFailure 累加连续推送失败次数，达到阈值（大于0时）且设置仍启用时将其禁用
+func (r *userPushSettingRepository) RecordFailure(ctx context.Context, id uint, threshold int) (int, bool, error) {
+	entSetting, err := r.client.UserPushSetting.
+		UpdateOneID(id).
+		AddConsecutiveFailures(1).
+		SetLastFailureAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to record push setting failure",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return 0, false, err
+	}
+
+	failures := entSetting.ConsecutiveFailures
+	if threshold <= 0 || failures < threshold {
+		return failures, false, nil
+	}
+
+	// 条件更新保证并发推送时只有一次调用完成禁用
+	affected, err := r.client.UserPushSetting.
+		Update().
+		Where(
+			userpushsetting.ID(id),
+			userpushsetting.Enabled(true),
+		).
+		SetEnabled(false).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to disable failing push setting",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return failures, false, err
+	}
+
+	return failures, affected > 0, nil
+}
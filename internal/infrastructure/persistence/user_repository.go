@@ -2,8 +2,10 @@ package persistence
 
 import (
 	"context"
+	"slices"
 
 	"nebula-live/ent"
+	"nebula-live/ent/predicate"
 	"nebula-live/ent/user"
 	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/repository"
@@ -41,15 +43,23 @@ func entUserToDomainUser(entUser *ent.User) *entity.User {
 	}
 
 	return &entity.User{
-		ID:        entUser.ID,
-		Username:  entUser.Username,
-		Email:     entUser.Email,
-		Password:  entUser.Password,
-		Nickname:  entUser.Nickname,
-		Avatar:    entUser.Avatar,
-		Status:    status,
-		CreatedAt: entUser.CreatedAt,
-		UpdatedAt: entUser.UpdatedAt,
+		ID:              entUser.ID,
+		Username:        entUser.Username,
+		Email:           entUser.Email,
+		Password:        entUser.Password,
+		Nickname:        entUser.Nickname,
+		Avatar:          entUser.Avatar,
+		Status:          status,
+		StatusReason:    entUser.StatusReason,
+		StatusChangedAt: entUser.StatusChangedAt,
+		BanExpiresAt:    entUser.BanExpiresAt,
+		CreatedAt:       entUser.CreatedAt,
+		UpdatedAt:       entUser.UpdatedAt,
+		PushPreferences: entity.UserPushPreferences{
+			DefaultGroup: entUser.PushDefaultGroup,
+			DefaultSound: entUser.PushDefaultSound,
+			DefaultLevel: entUser.PushDefaultLevel,
+		},
 	}
 }
 
@@ -106,6 +116,34 @@ func (r *userRepository) GetByID(ctx context.Context, id uint) (*entity.User, er
 	return entUserToDomainUser(entUser), nil
 }
 
+// userIDsChunkSize GetByIDs单条查询的最大ID数量，避免超出数据库的参数个数限制
+const userIDsChunkSize = 500
+
+// GetByIDs 根据ID批量获取用户，重复和不存在的ID被跳过，结果按ID升序
+func (r *userRepository) GetByIDs(ctx context.Context, ids []uint) ([]*entity.User, error) {
+	// 去重排序后分批查询，各批结果拼接起来仍然有序
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	users := make([]*entity.User, 0, len(ids))
+	for start := 0; start < len(ids); start += userIDsChunkSize {
+		end := min(start+userIDsChunkSize, len(ids))
+		entUsers, err := r.client.User.
+			Query().
+			Where(user.IDIn(ids[start:end]...)).
+			Order(ent.Asc(user.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entUser := range entUsers {
+			users = append(users, entUserToDomainUser(entUser))
+		}
+	}
+	return users, nil
+}
+
 // GetByUsername 根据用户名获取用户
 func (r *userRepository) GetByUsername(ctx context.Context, username string) (*entity.User, error) {
 	entUser, err := r.client.User.
@@ -122,12 +160,36 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*e
 	return entUserToDomainUser(entUser), nil
 }
 
-// GetByEmail 根据邮箱获取用户
+// GetByUsernameFold 根据用户名获取用户，不区分大小写
+func (r *userRepository) GetByUsernameFold(ctx context.Context, username string) (*entity.User, error) {
+	return r.getPreferExact(ctx, user.Username(username), user.UsernameEqualFold(username))
+}
+
+// GetByEmail 根据邮箱获取用户，不区分大小写
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+	return r.getPreferExact(ctx, user.Email(email), user.EmailEqualFold(email))
+}
+
+// getPreferExact 获取不区分大小写匹配的用户
+//
+// 规范化之前保存的数据可能存在仅大小写不同的多个用户，此时优先返回完全匹配的用户，否则返回最早创建的用户。
+func (r *userRepository) getPreferExact(ctx context.Context, exact, fold predicate.User) (*entity.User, error) {
 	entUser, err := r.client.User.
 		Query().
-		Where(user.Email(email)).
+		Where(exact).
 		Only(ctx)
+	if err == nil {
+		return entUserToDomainUser(entUser), nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	entUser, err = r.client.User.
+		Query().
+		Where(fold).
+		Order(ent.Asc(user.FieldID)).
+		First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, service.ErrUserNotFound
@@ -138,7 +200,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.
 	return entUserToDomainUser(entUser), nil
 }
 
-// Update 更新用户信息
+// Update 更新用户信息，状态相关字段只由 UpdateStatus 写入，推送偏好只由 UpdatePushPreferences 写入
 func (r *userRepository) Update(ctx context.Context, u *entity.User) error {
 	_, err := r.client.User.
 		UpdateOneID(u.ID).
@@ -147,12 +209,50 @@ func (r *userRepository) Update(ctx context.Context, u *entity.User) error {
 		SetPassword(u.Password).
 		SetNillableNickname(&u.Nickname).
 		SetNillableAvatar(&u.Avatar).
-		SetStatus(domainUserStatusToEntStatus(u.Status)).
 		SetUpdatedAt(u.UpdatedAt).
 		Save(ctx)
 	return err
 }
 
+// UpdatePushPreferences 只更新用户的推送偏好字段
+func (r *userRepository) UpdatePushPreferences(ctx context.Context, id uint, preferences entity.UserPushPreferences) error {
+	err := r.client.User.
+		UpdateOneID(id).
+		SetPushDefaultGroup(preferences.DefaultGroup).
+		SetPushDefaultSound(preferences.DefaultSound).
+		SetPushDefaultLevel(preferences.DefaultLevel).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return service.ErrUserNotFound
+	}
+	return err
+}
+
+// UpdateStatus 只更新用户的状态相关字段，避免与并发的资料更新互相覆盖
+func (r *userRepository) UpdateStatus(ctx context.Context, u *entity.User) error {
+	update := r.client.User.
+		UpdateOneID(u.ID).
+		SetStatus(domainUserStatusToEntStatus(u.Status)).
+		SetStatusReason(u.StatusReason).
+		SetNillableStatusChangedAt(u.StatusChangedAt).
+		SetUpdatedAt(u.UpdatedAt)
+
+	// 解除禁用或改为永久禁用时清除到期时间
+	if u.BanExpiresAt != nil {
+		update.SetBanExpiresAt(*u.BanExpiresAt)
+	} else {
+		update.ClearBanExpiresAt()
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return service.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
 // Delete 删除用户
 func (r *userRepository) Delete(ctx context.Context, id uint) error {
 	err := r.client.User.
@@ -206,11 +306,19 @@ func (r *userRepository) ExistsByUsername(ctx context.Context, username string)
 	return count > 0, nil
 }
 
-// ExistsByEmail 检查邮箱是否已存在
+// ExistsByUsernameFold 检查用户名是否已存在，不区分大小写
+func (r *userRepository) ExistsByUsernameFold(ctx context.Context, username string) (bool, error) {
+	return r.client.User.
+		Query().
+		Where(user.UsernameEqualFold(username)).
+		Exist(ctx)
+}
+
+// ExistsByEmail 检查邮箱是否已存在，不区分大小写
 func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	count, err := r.client.User.
 		Query().
-		Where(user.Email(email)).
+		Where(user.EmailEqualFold(email)).
 		Count(ctx)
 	if err != nil {
 		return false, err
@@ -0,0 +1,114 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/testutil"
+)
+
+// 昵称更新和封禁都基于同一份旧数据，依次写入后两者的变更都保留
+func TestUserRepository_UpdateAndUpdateStatusDoNotClobber(t *testing.T) {
+	ctx := context.Background()
+	repo := persistence.NewUserRepository(testutil.NewEntClient(t))
+
+	created := &entity.User{Username: "alice", Email: "alice@example.com", Password: "hash", Nickname: "Alice", Status: entity.UserStatusActive}
+	if err := repo.Create(ctx, created); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	profile, err := repo.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	banned, err := repo.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	banned.Ban("spam", &expiresAt, now)
+	if err := repo.UpdateStatus(ctx, banned); err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+
+	profile.Nickname = "Alice Updated"
+	if err := repo.Update(ctx, profile); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	got, err := repo.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if got.Status != entity.UserStatusBanned || got.StatusReason != "spam" || got.BanExpiresAt == nil {
+		t.Errorf("status = %q, reason = %q, ban_expires_at = %v; the nickname update overwrote the ban",
+			got.Status, got.StatusReason, got.BanExpiresAt)
+	}
+	if got.Nickname != "Alice Updated" {
+		t.Errorf("nickname = %q, want %q", got.Nickname, "Alice Updated")
+	}
+
+	// 反向交错：封禁基于昵称更新之前的数据写入，同样不会回滚昵称
+	banned.Nickname = "Alice"
+	banned.ChangeStatus(entity.UserStatusInactive, "appeal", now)
+	if err := repo.UpdateStatus(ctx, banned); err != nil {
+		t.Fatalf("UpdateStatus() error = %v", err)
+	}
+	got, err = repo.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if got.Nickname != "Alice Updated" || got.Status != entity.UserStatusInactive || got.BanExpiresAt != nil {
+		t.Errorf("user = {nickname: %q, status: %q, ban_expires_at: %v}, want the updated nickname, inactive and no expiry",
+			got.Nickname, got.Status, got.BanExpiresAt)
+	}
+}
+
+// 批量查询跳过不存在和重复的ID，结果按ID升序
+func TestUserRepository_GetByIDsSkipsMissing(t *testing.T) {
+	ctx := context.Background()
+	repo := persistence.NewUserRepository(testutil.NewEntClient(t))
+
+	var ids []uint
+	for _, name := range []string{"alice", "bob", "carol"} {
+		created := &entity.User{Username: name, Email: name + "@example.com", Password: "hash", Status: entity.UserStatusActive}
+		if err := repo.Create(ctx, created); err != nil {
+			t.Fatalf("Create(%s) error = %v", name, err)
+		}
+		ids = append(ids, created.ID)
+	}
+
+	got, err := repo.GetByIDs(ctx, []uint{ids[2], 9999, ids[0], ids[2], 0})
+	if err != nil {
+		t.Fatalf("GetByIDs() error = %v", err)
+	}
+	if len(got) != 2 || got[0].ID != ids[0] || got[1].ID != ids[2] {
+		t.Fatalf("GetByIDs() = %v, want alice and carol in ID order", got)
+	}
+	if got[0].Username != "alice" || got[1].Username != "carol" {
+		t.Errorf("GetByIDs() usernames = %q, %q; want alice, carol", got[0].Username, got[1].Username)
+	}
+
+	// 超过单条查询上限的ID列表分批查询
+	many := make([]uint, 0, 1200)
+	for id := uint(1); id <= 1200; id++ {
+		many = append(many, id)
+	}
+	got, err = repo.GetByIDs(ctx, many)
+	if err != nil {
+		t.Fatalf("GetByIDs(1200 ids) error = %v", err)
+	}
+	if len(got) != len(ids) {
+		t.Errorf("GetByIDs(1200 ids) returned %d users, want %d", len(got), len(ids))
+	}
+
+	got, err = repo.GetByIDs(ctx, nil)
+	if err != nil || len(got) != 0 {
+		t.Errorf("GetByIDs(nil) = %v, %v; want no users", got, err)
+	}
+}
@@ -2,12 +2,17 @@ package persistence
 
 import (
 	"context"
+	"time"
+
 	"nebula-live/ent"
+	"nebula-live/ent/predicate"
 	"nebula-live/ent/role"
 	"nebula-live/ent/user"
 	"nebula-live/ent/userrole"
 	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/repository"
+	"nebula-live/internal/domain/service"
+	"nebula-live/pkg/clock"
 	"nebula-live/pkg/logger"
 
 	"go.uber.org/zap"
@@ -15,20 +20,35 @@ import (
 
 type userRoleRepository struct {
 	client *ent.Client
+	clock  clock.Clock
+}
+
+// NewUserRoleRepository 创建用户角色仓储实例，clk决定角色分配何时过期
+func NewUserRoleRepository(client *ent.Client, clk clock.Clock) repository.UserRoleRepository {
+	return &userRoleRepository{client: client, clock: clock.OrReal(clk)}
 }
 
-// NewUserRoleRepository 创建用户角色仓储实例
-func NewUserRoleRepository(client *ent.Client) repository.UserRoleRepository {
-	return &userRoleRepository{client: client}
+// activeUserRole 在now时未过期的用户角色分配
+func activeUserRole(now time.Time) predicate.UserRole {
+	return userrole.Or(
+		userrole.ExpiresAtIsNil(),
+		userrole.ExpiresAtGT(now),
+	)
 }
 
 func (r *userRoleRepository) AssignRole(ctx context.Context, userRole *entity.UserRole) (*entity.UserRole, error) {
-	created, err := r.client.UserRole.
+	create := r.client.UserRole.
 		Create().
 		SetUserID(userRole.UserID).
 		SetRoleID(userRole.RoleID).
-		SetNillableAssignedBy(&userRole.AssignedBy).
-		Save(ctx)
+		SetNillableExpiresAt(userRole.ExpiresAt)
+
+	// 分配者为0表示系统分配（如注册、引导管理员），不关联用户
+	if userRole.AssignedBy != 0 {
+		create.SetAssignedBy(userRole.AssignedBy)
+	}
+
+	created, err := create.Save(ctx)
 
 	if err != nil {
 		logger.Error("Failed to assign role to user",
@@ -44,6 +64,7 @@ func (r *userRoleRepository) AssignRole(ctx context.Context, userRole *entity.Us
 		RoleID:     created.RoleID,
 		AssignedBy: created.AssignedBy,
 		AssignedAt: created.AssignedAt,
+		ExpiresAt:  created.ExpiresAt,
 	}, nil
 }
 
@@ -67,10 +88,65 @@ func (r *userRoleRepository) RemoveRole(ctx context.Context, userID, roleID uint
 	return nil
 }
 
+func (r *userRoleRepository) RemoveRoles(ctx context.Context, userID uint, roleIDs []uint, minRemaining int) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		logger.Error("Failed to start transaction for removing user roles",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return 0, err
+	}
+
+	deleteQuery := tx.UserRole.
+		Delete().
+		Where(userrole.UserID(userID))
+	if len(roleIDs) > 0 {
+		deleteQuery = deleteQuery.Where(userrole.RoleIDIn(roleIDs...))
+	}
+
+	removed, err := deleteQuery.Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to remove roles from user",
+			zap.Uint("user_id", userID),
+			zap.Uints("role_ids", roleIDs),
+			zap.Error(err))
+		return 0, err
+	}
+
+	// 检查剩余角色数量
+	if removed > 0 && minRemaining > 0 {
+		remaining, err := tx.UserRole.
+			Query().
+			Where(userrole.UserID(userID), activeUserRole(r.clock.Now())).
+			Count(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			logger.Error("Failed to count remaining user roles",
+				zap.Uint("user_id", userID),
+				zap.Error(err))
+			return 0, err
+		}
+		if remaining < minRemaining {
+			_ = tx.Rollback()
+			return 0, service.ErrUserMustHaveRole
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit user roles removal",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return removed, nil
+}
+
 func (r *userRoleRepository) GetUserRoles(ctx context.Context, userID uint) ([]*entity.Role, error) {
 	roles, err := r.client.Role.
 		Query().
-		Where(role.HasUserRolesWith(userrole.UserID(userID))).
+		Where(role.HasUserRolesWith(userrole.UserID(userID), activeUserRole(r.clock.Now()))).
 		All(ctx)
 
 	if err != nil {
@@ -88,6 +164,8 @@ func (r *userRoleRepository) GetUserRoles(ctx context.Context, userID uint) ([]*
 			DisplayName: roleEnt.DisplayName,
 			Description: roleEnt.Description,
 			IsSystem:    roleEnt.IsSystem,
+			CreatedBy:   roleEnt.CreatedBy,
+			UpdatedBy:   roleEnt.UpdatedBy,
 			CreatedAt:   roleEnt.CreatedAt,
 			UpdatedAt:   roleEnt.UpdatedAt,
 		}
@@ -99,7 +177,7 @@ func (r *userRoleRepository) GetUserRoles(ctx context.Context, userID uint) ([]*
 func (r *userRoleRepository) GetRoleUsers(ctx context.Context, roleID uint) ([]*entity.User, error) {
 	users, err := r.client.User.
 		Query().
-		Where(user.HasUserRolesWith(userrole.RoleID(roleID))).
+		Where(user.HasUserRolesWith(userrole.RoleID(roleID), activeUserRole(r.clock.Now()))).
 		All(ctx)
 
 	if err != nil {
@@ -133,6 +211,7 @@ func (r *userRoleRepository) HasRole(ctx context.Context, userID, roleID uint) (
 		Where(
 			userrole.UserID(userID),
 			userrole.RoleID(roleID),
+			activeUserRole(r.clock.Now()),
 		).
 		Exist(ctx)
 
@@ -153,6 +232,7 @@ func (r *userRoleRepository) HasRoleByName(ctx context.Context, userID uint, rol
 		Where(
 			userrole.UserID(userID),
 			userrole.HasRoleWith(role.Name(roleName)),
+			activeUserRole(r.clock.Now()),
 		).
 		Exist(ctx)
 
@@ -188,12 +268,27 @@ func (r *userRoleRepository) GetUserRoleAssignments(ctx context.Context, userID
 			RoleID:     ur.RoleID,
 			AssignedBy: ur.AssignedBy,
 			AssignedAt: ur.AssignedAt,
+			ExpiresAt:  ur.ExpiresAt,
 		}
 	}
 
 	return result, nil
 }
 
+func (r *userRoleRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	deleted, err := r.client.UserRole.
+		Delete().
+		Where(userrole.ExpiresAtLTE(now)).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to delete expired user roles", zap.Error(err))
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
 // convertUserStatus 转换用户状态
 func convertUserStatus(status user.Status) int {
 	switch status {
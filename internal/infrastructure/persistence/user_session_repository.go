@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"nebula-live/ent"
+	"nebula-live/ent/usersession"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type userSessionRepository struct {
+	client *ent.Client
+}
+
+// NewUserSessionRepository 创建用户会话仓储实例
+func NewUserSessionRepository(client *ent.Client) repository.UserSessionRepository {
+	return &userSessionRepository{
+		client: client,
+	}
+}
+
+// convertToEntity 转换EntGo实体到Domain实体
+func (r *userSessionRepository) convertToEntity(entSession *ent.UserSession) *entity.UserSession {
+	return &entity.UserSession{
+		ID:             entSession.ID,
+		UserID:         entSession.UserID,
+		RefreshTokenID: entSession.RefreshTokenID,
+		UserAgent:      entSession.UserAgent,
+		IP:             entSession.IP,
+		CreatedAt:      entSession.CreatedAt,
+		LastUsedAt:     entSession.LastUsedAt,
+		ExpiresAt:      entSession.ExpiresAt,
+	}
+}
+
+// Create 创建会话
+func (r *userSessionRepository) Create(ctx context.Context, session *entity.UserSession) (*entity.UserSession, error) {
+	entSession, err := r.client.UserSession.
+		Create().
+		SetUserID(session.UserID).
+		SetRefreshTokenID(session.RefreshTokenID).
+		SetUserAgent(session.UserAgent).
+		SetIP(session.IP).
+		SetLastUsedAt(session.LastUsedAt).
+		SetExpiresAt(session.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to create user session",
+			zap.Uint("user_id", session.UserID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return r.convertToEntity(entSession), nil
+}
+
+// GetByID 根据ID获取会话
+func (r *userSessionRepository) GetByID(ctx context.Context, id uint) (*entity.UserSession, error) {
+	entSession, err := r.client.UserSession.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		logger.Error("Failed to get user session",
+			zap.Uint("id", id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return r.convertToEntity(entSession), nil
+}
+
+// ListActive 获取用户未过期的会话
+func (r *userSessionRepository) ListActive(ctx context.Context, userID uint, now time.Time) ([]*entity.UserSession, error) {
+	entSessions, err := r.client.UserSession.
+		Query().
+		Where(
+			usersession.UserID(userID),
+			usersession.ExpiresAtGT(now),
+		).
+		Order(ent.Desc(usersession.FieldLastUsedAt)).
+		All(ctx)
+
+	if err != nil {
+		logger.Error("Failed to list user sessions",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	sessions := make([]*entity.UserSession, len(entSessions))
+	for i, entSession := range entSessions {
+		sessions[i] = r.convertToEntity(entSession)
+	}
+
+	return sessions, nil
+}
+
+// Rotate 轮换会话的刷新令牌ID
+func (r *userSessionRepository) Rotate(ctx context.Context, session *entity.UserSession, oldTokenID string) (bool, error) {
+	// 以旧令牌ID为条件更新，同一刷新令牌并发使用时只有一次成功
+	affected, err := r.client.UserSession.
+		Update().
+		Where(
+			usersession.ID(session.ID),
+			usersession.RefreshTokenID(oldTokenID),
+		).
+		SetRefreshTokenID(session.RefreshTokenID).
+		SetIP(session.IP).
+		SetLastUsedAt(session.LastUsedAt).
+		SetExpiresAt(session.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		logger.Error("Failed to rotate user session",
+			zap.Uint("id", session.ID),
+			zap.Error(err))
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// Delete 删除用户的指定会话
+func (r *userSessionRepository) Delete(ctx context.Context, userID, id uint) (bool, error) {
+	affected, err := r.client.UserSession.
+		Delete().
+		Where(
+			usersession.ID(id),
+			usersession.UserID(userID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to delete user session",
+			zap.Uint("id", id),
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// DeleteByUserExcept 删除用户除指定会话外的所有会话
+func (r *userSessionRepository) DeleteByUserExcept(ctx context.Context, userID, exceptID uint) (int, error) {
+	affected, err := r.client.UserSession.
+		Delete().
+		Where(
+			usersession.UserID(userID),
+			usersession.IDNEQ(exceptID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		logger.Error("Failed to delete user sessions",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return 0, err
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,91 @@
+package persistence
+
+import (
+	"context"
+
+	"nebula-live/ent"
+	"nebula-live/ent/webhookdelivery"
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/repository"
+)
+
+type webhookDeliveryRepository struct {
+	client *ent.Client
+}
+
+// NewWebhookDeliveryRepository 创建Webhook投递记录仓储实例
+func NewWebhookDeliveryRepository(client *ent.Client) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		client: client,
+	}
+}
+
+// convertToEntity 转换EntGo实体到Domain实体
+func (r *webhookDeliveryRepository) convertToEntity(entDelivery *ent.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:         entDelivery.ID,
+		DeliveryID: entDelivery.DeliveryID,
+		EventID:    entDelivery.EventID,
+		Event:      entDelivery.Event,
+		URL:        entDelivery.URL,
+		Attempt:    entDelivery.Attempt,
+		StatusCode: entDelivery.StatusCode,
+		Success:    entDelivery.Success,
+		Error:      entDelivery.Error,
+		DurationMs: entDelivery.DurationMs,
+		CreatedAt:  entDelivery.CreatedAt,
+	}
+}
+
+// Create 记录一次投递尝试
+func (r *webhookDeliveryRepository) Create(ctx context.Context, delivery *entity.WebhookDelivery) (*entity.WebhookDelivery, error) {
+	entDelivery, err := r.client.WebhookDelivery.
+		Create().
+		SetDeliveryID(delivery.DeliveryID).
+		SetEventID(delivery.EventID).
+		SetEvent(delivery.Event).
+		SetURL(delivery.URL).
+		SetAttempt(delivery.Attempt).
+		SetStatusCode(delivery.StatusCode).
+		SetSuccess(delivery.Success).
+		SetError(delivery.Error).
+		SetDurationMs(delivery.DurationMs).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertToEntity(entDelivery), nil
+}
+
+// List 分页获取投递记录
+func (r *webhookDeliveryRepository) List(ctx context.Context, event string, offset, limit int) ([]*entity.WebhookDelivery, error) {
+	query := r.client.WebhookDelivery.Query()
+	if event != "" {
+		query = query.Where(webhookdelivery.EventEQ(event))
+	}
+
+	entDeliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*entity.WebhookDelivery, len(entDeliveries))
+	for i, entDelivery := range entDeliveries {
+		deliveries[i] = r.convertToEntity(entDelivery)
+	}
+	return deliveries, nil
+}
+
+// Count 获取投递记录数量
+func (r *webhookDeliveryRepository) Count(ctx context.Context, event string) (int, error) {
+	query := r.client.WebhookDelivery.Query()
+	if event != "" {
+		query = query.Where(webhookdelivery.EventEQ(event))
+	}
+	return query.Count(ctx)
+}
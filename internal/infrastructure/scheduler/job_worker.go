@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/pkg/lock"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultJobPollInterval = 5 * time.Second
+	defaultJobConcurrency  = 4
+
+	// jobWorkerLockKey 多副本部署时只有持有该锁的副本执行任务，避免恢复中断任务时重复执行其他副本的任务
+	jobWorkerLockKey = "job-worker"
+)
+
+// JobWorker 异步任务工作器，按提交顺序领取并执行持久化的任务
+type JobWorker struct {
+	jobService   service.JobService
+	locker       *lock.Locker
+	enabled      bool
+	pollInterval time.Duration
+	concurrency  int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJobWorker 创建异步任务工作器
+func NewJobWorker(cfg *config.Config, jobService service.JobService, locker *lock.Locker) *JobWorker {
+	pollInterval := cfg.Jobs.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultJobPollInterval
+	}
+
+	concurrency := cfg.Jobs.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultJobConcurrency
+	}
+
+	return &JobWorker{
+		jobService:   jobService,
+		locker:       locker,
+		enabled:      cfg.Jobs.Enabled,
+		pollInterval: pollInterval,
+		concurrency:  concurrency,
+	}
+}
+
+// Start 启动工作器
+//
+// 上次运行中断时遗留的任务重新排队后继续执行，因此任务在服务重启后不会丢失。
+func (w *JobWorker) Start(ctx context.Context) error {
+	if !w.enabled {
+		logger.Info("Job worker is disabled")
+		return nil
+	}
+
+	if w.locker == nil {
+		if _, err := w.jobService.RecoverInterrupted(ctx); err != nil {
+			return err
+		}
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		if w.locker == nil {
+			w.run(runCtx)
+			return
+		}
+		w.locker.RunExclusive(runCtx, jobWorkerLockKey, leaderLockTTL, w.lead)
+	}()
+
+	logger.Info("Job worker started",
+		zap.Duration("poll_interval", w.pollInterval),
+		zap.Int("concurrency", w.concurrency),
+		zap.Bool("distributed_lock", w.locker != nil))
+
+	return nil
+}
+
+// lead 取得分布式锁后运行工作循环，先恢复上一个持有者中断时遗留的任务
+func (w *JobWorker) lead(ctx context.Context) {
+	if _, err := w.jobService.RecoverInterrupted(ctx); err != nil {
+		// 返回后释放锁并重新竞争，稍后重试
+		logger.Error("Failed to recover interrupted jobs", zap.Error(err))
+		return
+	}
+	w.run(ctx)
+}
+
+// Stop 停止工作器，正在执行的任务被取消，保持running状态，下次启动时重新执行
+func (w *JobWorker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+
+	w.cancel()
+	w.wg.Wait()
+	w.cancel = nil
+
+	logger.Info("Job worker stopped")
+}
+
+// run 工作循环，定时轮询，本副本提交新任务时立即处理
+func (w *JobWorker) run(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.jobService.Notify():
+		}
+	}
+}
+
+// poll 每次领取最多concurrency个任务并发执行，直到没有待执行的任务为止
+func (w *JobWorker) poll(ctx context.Context) {
+	for ctx.Err() == nil {
+		processed, err := w.jobService.ProcessPending(ctx, w.concurrency)
+		if err != nil {
+			logger.Error("Failed to process pending jobs", zap.Error(err))
+			return
+		}
+		if processed < w.concurrency {
+			return
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package scheduler
+
+import (
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// leaderLockTTL 后台任务分布式锁的有效期，持有锁的副本崩溃后其他副本最多等待该时间接替
+const leaderLockTTL = 30 * time.Second
+
+// SchedulerModule 后台调度模块
+var SchedulerModule = fx.Options(
+	fx.Provide(
+		NewPushScheduler,
+		NewRoleCleanupJob,
+		NewJobWorker,
+	),
+)
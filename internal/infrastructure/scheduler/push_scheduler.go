@@ -0,0 +1,167 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/pkg/lock"
+	"nebula-live/pkg/clock"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = 30 * time.Second
+	defaultBatchSize    = 50
+
+	// pushSchedulerLockKey 多副本部署时只有持有该锁的副本运行调度器
+	pushSchedulerLockKey = "push-scheduler"
+)
+
+// PushScheduler 定时推送调度器，周期性地为到期的周期推送生成定时推送，并领取发送到期的定时推送
+type PushScheduler struct {
+	scheduledPushService service.ScheduledPushService
+	recurringPushService service.RecurringPushService
+	locker               *lock.Locker
+	clock                clock.Clock
+	enabled              bool
+	pollInterval         time.Duration
+	batchSize            int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPushScheduler 创建定时推送调度器
+func NewPushScheduler(
+	cfg *config.Config,
+	scheduledPushService service.ScheduledPushService,
+	recurringPushService service.RecurringPushService,
+	locker *lock.Locker,
+	clk clock.Clock,
+) *PushScheduler {
+	pollInterval := cfg.Push.Scheduler.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	batchSize := cfg.Push.Scheduler.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	return &PushScheduler{
+		scheduledPushService: scheduledPushService,
+		recurringPushService: recurringPushService,
+		locker:               locker,
+		clock:                clock.OrReal(clk),
+		enabled:              cfg.Push.Scheduler.Enabled,
+		pollInterval:         pollInterval,
+		batchSize:            batchSize,
+	}
+}
+
+// Start 启动调度器
+//
+// 配置了分布式锁时调度器只在持有锁的副本上运行，其他副本等待锁释放或过期后接替。
+func (s *PushScheduler) Start(ctx context.Context) error {
+	if !s.enabled {
+		logger.Info("Push scheduler is disabled")
+		return nil
+	}
+
+	if s.locker == nil {
+		// 上次运行中断时遗留的推送不再重发，避免重复发送
+		if _, err := s.scheduledPushService.RecoverInterrupted(ctx); err != nil {
+			return err
+		}
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if s.locker == nil {
+			s.run(runCtx)
+			return
+		}
+		s.locker.RunExclusive(runCtx, pushSchedulerLockKey, leaderLockTTL, s.lead)
+	}()
+
+	logger.Info("Push scheduler started",
+		zap.Duration("poll_interval", s.pollInterval),
+		zap.Int("batch_size", s.batchSize),
+		zap.Bool("distributed_lock", s.locker != nil))
+
+	return nil
+}
+
+// lead 取得分布式锁后运行调度循环，先恢复上一个持有者中断时遗留的推送
+func (s *PushScheduler) lead(ctx context.Context) {
+	if _, err := s.scheduledPushService.RecoverInterrupted(ctx); err != nil {
+		// 返回后释放锁并重新竞争，稍后重试
+		logger.Error("Failed to recover interrupted scheduled pushes", zap.Error(err))
+		return
+	}
+	s.run(ctx)
+}
+
+// Stop 停止调度器并等待当前批次处理完成
+func (s *PushScheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+
+	s.cancel()
+	s.wg.Wait()
+	s.cancel = nil
+
+	logger.Info("Push scheduler stopped")
+}
+
+// run 调度循环
+func (s *PushScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll 先为到期的周期推送生成定时推送，再处理所有到期的定时推送，一次最多处理batchSize条，直到没有到期推送为止
+func (s *PushScheduler) poll(ctx context.Context) {
+	for ctx.Err() == nil {
+		enqueued, err := s.recurringPushService.EnqueueDue(ctx, s.clock.Now(), s.batchSize)
+		if err != nil {
+			logger.Error("Failed to enqueue due recurring pushes", zap.Error(err))
+			break
+		}
+		if enqueued < s.batchSize {
+			break
+		}
+	}
+
+	for ctx.Err() == nil {
+		processed, err := s.scheduledPushService.ProcessDuePushes(ctx, s.clock.Now(), s.batchSize)
+		if err != nil {
+			logger.Error("Failed to process due scheduled pushes", zap.Error(err))
+			return
+		}
+		if processed < s.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/pkg/lock"
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultRoleCleanupInterval = time.Hour
+
+	// roleCleanupLockKey 多副本部署时只有持有该锁的副本运行清理任务
+	roleCleanupLockKey = "role-cleanup"
+)
+
+// RoleCleanupJob 周期性删除已过期的用户角色分配
+//
+// 过期的分配在检查时已被忽略，清理只是为了避免无用记录堆积。
+type RoleCleanupJob struct {
+	rbacService service.RBACService
+	locker      *lock.Locker
+	interval    time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRoleCleanupJob 创建过期角色清理任务
+func NewRoleCleanupJob(cfg *config.Config, rbacService service.RBACService, locker *lock.Locker) *RoleCleanupJob {
+	interval := cfg.RBAC.ExpiredRoleCleanupInterval
+	if interval <= 0 {
+		interval = defaultRoleCleanupInterval
+	}
+
+	return &RoleCleanupJob{
+		rbacService: rbacService,
+		locker:      locker,
+		interval:    interval,
+	}
+}
+
+// Start 启动清理任务
+func (j *RoleCleanupJob) Start() {
+	runCtx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		if j.locker == nil {
+			j.run(runCtx)
+			return
+		}
+		j.locker.RunExclusive(runCtx, roleCleanupLockKey, leaderLockTTL, j.run)
+	}()
+
+	logger.Info("Expired role cleanup job started",
+		zap.Duration("interval", j.interval),
+		zap.Bool("distributed_lock", j.locker != nil))
+}
+
+// Stop 停止清理任务并等待当前清理完成
+func (j *RoleCleanupJob) Stop() {
+	if j.cancel == nil {
+		return
+	}
+
+	j.cancel()
+	j.wg.Wait()
+	j.cancel = nil
+
+	logger.Info("Expired role cleanup job stopped")
+}
+
+// run 清理循环
+func (j *RoleCleanupJob) run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.rbacService.CleanupExpiredRoles(ctx); err != nil && ctx.Err() == nil {
+			logger.Error("Failed to clean up expired user roles", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
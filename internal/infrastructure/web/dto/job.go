@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JobResponse 异步任务响应
+type JobResponse struct {
+	ID         uint            `json:"id"`
+	Type       string          `json:"type"`
+	Status     string          `json:"status" enums:"pending,running,completed,failed"`
+	Payload    json.RawMessage `json:"payload,omitempty" swaggertype:"object"`
+	Result     json.RawMessage `json:"result,omitempty" swaggertype:"object"`
+	Error      string          `json:"error,omitempty"`
+	Attempts   int             `json:"attempts"`
+	CreatedBy  *uint           `json:"created_by"`
+	StartedAt  *time.Time      `json:"started_at"`
+	FinishedAt *time.Time      `json:"finished_at"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"strings"
+	"time"
+)
+
+// CreateLiveSubscriptionRequest 订阅直播间请求
+type CreateLiveSubscriptionRequest struct {
+	Platform string `json:"platform" validate:"required,max=50" example:"bilibili"` // 平台名称或别名
+	RoomID   string `json:"room_id" validate:"required,max=100" example:"5440"`
+}
+
+// Validate 验证订阅直播间请求
+func (r *CreateLiveSubscriptionRequest) Validate() error {
+	var errs ValidationErrors
+
+	errs.requireLength("platform", strings.TrimSpace(r.Platform), 50)
+	errs.requireLength("room_id", strings.TrimSpace(r.RoomID), 100)
+
+	return errs.Err()
+}
+
+// LiveSubscriptionResponse 直播间订阅响应
+type LiveSubscriptionResponse struct {
+	ID        uint      `json:"id"`
+	Platform  string    `json:"platform" example:"bilibili"` // 规范的平台名称
+	RoomID    string    `json:"room_id" example:"5440"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UnsubscribeAllResponse 取消所有订阅响应
+type UnsubscribeAllResponse struct {
+	Removed int `json:"removed"` // 取消的订阅数量
+}
+
+// LiveSubscriptionStatusResponse 订阅的直播间及其当前状态，直播间信息在获取失败时省略
+type LiveSubscriptionStatusResponse struct {
+	ID            uint       `json:"id"` // 订阅ID
+	Platform      string     `json:"platform" example:"bilibili"`
+	RoomID        string     `json:"room_id" example:"5440"`
+	CreatedAt     time.Time  `json:"created_at"`
+	Status        string     `json:"status" enums:"online,offline,unknown" example:"online"` // 获取失败时为unknown
+	Title         string     `json:"title,omitempty" example:"【六神】游戏室"`
+	Cover         string     `json:"cover,omitempty" example:"https://example.com/cover.jpg"`
+	OwnerName     string     `json:"owner_name,omitempty" example:"丨马老六丨"`
+	OwnerAvatar   string     `json:"owner_avatar,omitempty" example:"https://example.com/avatar.jpg"`
+	Category      string     `json:"category,omitempty" example:"第五人格"`
+	ViewerCount   int64      `json:"viewer_count,omitempty" example:"1234"`
+	LiveStartTime *time.Time `json:"live_start_time,omitempty" example:"2021-01-01T00:00:00Z"`
+	LiveDuration  int64      `json:"live_duration,omitempty" example:"3600"`                     // 秒
+	CheckedAt     time.Time  `json:"checked_at"`                                                 // 获取直播状态的时间，状态会被缓存一小段时间
+	Error         string     `json:"error,omitempty" example:"Failed to fetch live room status"` // 获取直播状态失败的原因
+}
+
+// LiveSubscriptionStatusesResponse 当前用户订阅直播间的状态汇总
+type LiveSubscriptionStatusesResponse struct {
+	Data   []LiveSubscriptionStatusResponse `json:"data"`
+	Total  int                              `json:"total"`  // 返回的订阅数量
+	Online int                              `json:"online"` // 正在直播的数量
+}
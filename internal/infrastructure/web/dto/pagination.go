@@ -0,0 +1,28 @@
+package dto
+
+// Pagination 列表响应的分页信息，TotalPages、HasNext和HasPrev由Total和Limit计算得出
+type Pagination struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	TotalPages int   `json:"total_pages"`
+	HasNext    bool  `json:"has_next"`
+	HasPrev    bool  `json:"has_prev"`
+}
+
+// NewPagination 根据总数和分页参数创建分页信息
+//
+// 没有数据时总页数为0；总数未知（小于0）时总页数为0且HasNext为false。
+func NewPagination(total int64, page, limit int) Pagination {
+	pagination := Pagination{
+		Total:   total,
+		Page:    page,
+		Limit:   limit,
+		HasPrev: page > 1,
+	}
+	if total > 0 && limit > 0 {
+		pagination.TotalPages = int((total + int64(limit) - 1) / int64(limit))
+		pagination.HasNext = page < pagination.TotalPages
+	}
+	return pagination
+}
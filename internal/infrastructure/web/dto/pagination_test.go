@@ -0,0 +1,40 @@
+package dto_test
+
+import (
+	"testing"
+
+	"nebula-live/internal/infrastructure/web/dto"
+)
+
+func TestNewPagination(t *testing.T) {
+	tests := []struct {
+		name           string
+		total          int64
+		page, limit    int
+		wantTotalPages int
+		wantHasNext    bool
+		wantHasPrev    bool
+	}{
+		{"empty", 0, 1, 10, 0, false, false},
+		{"single page", 3, 1, 10, 1, false, false},
+		{"exact multiple first page", 20, 1, 10, 2, true, false},
+		{"exact multiple last page", 20, 2, 10, 2, false, true},
+		{"partial last page", 21, 3, 10, 3, false, true},
+		{"middle page", 21, 2, 10, 3, true, true},
+		{"past the last page", 5, 3, 10, 1, false, true},
+		{"unknown total", -1, 2, 10, 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dto.NewPagination(tt.total, tt.page, tt.limit)
+			if got.Total != tt.total || got.Page != tt.page || got.Limit != tt.limit {
+				t.Errorf("NewPagination() = %+v, want total %d page %d limit %d", got, tt.total, tt.page, tt.limit)
+			}
+			if got.TotalPages != tt.wantTotalPages || got.HasNext != tt.wantHasNext || got.HasPrev != tt.wantHasPrev {
+				t.Errorf("NewPagination(%d, %d, %d) = {total_pages: %d, has_next: %v, has_prev: %v}, want {%d, %v, %v}",
+					tt.total, tt.page, tt.limit, got.TotalPages, got.HasNext, got.HasPrev,
+					tt.wantTotalPages, tt.wantHasNext, tt.wantHasPrev)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"nebula-live/internal/pkg/push"
+)
+
+// RecurringPushRequest 创建/更新周期推送请求
+type RecurringPushRequest struct {
+	CronExpr string         `json:"cron_expr" validate:"required,max=100" example:"0 9 * * *"`
+	Provider string         `json:"provider,omitempty"`
+	Title    string         `json:"title" validate:"required,min=1,max=200"`
+	Body     string         `json:"body" validate:"required,min=1,max=1000"`
+	URL      string         `json:"url,omitempty"`
+	Sound    string         `json:"sound,omitempty"`
+	Icon     string         `json:"icon,omitempty"`
+	Group    string         `json:"group,omitempty"`
+	Level    push.PushLevel `json:"level,omitempty" enums:"passive,active,timeSensitive,critical"`
+	Enabled  *bool          `json:"enabled,omitempty"`
+}
+
+// Validate 验证周期推送请求
+func (r *RecurringPushRequest) Validate() error {
+	var errs ValidationErrors
+
+	errs.requireLength("cron_expr", r.CronExpr, 100)
+	errs.requireLength("title", r.Title, 200)
+	errs.requireLength("body", r.Body, 1000)
+	errs.pushLevel("level", r.Level)
+
+	return errs.Err()
+}
+
+// RecurringPushResponse 周期推送响应
+type RecurringPushResponse struct {
+	ID        uint       `json:"id"`
+	UserID    uint       `json:"user_id"`
+	CronExpr  string     `json:"cron_expr"`
+	Provider  string     `json:"provider,omitempty"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	URL       string     `json:"url,omitempty"`
+	Sound     string     `json:"sound,omitempty"`
+	Icon      string     `json:"icon,omitempty"`
+	Group     string     `json:"group,omitempty"`
+	Level     string     `json:"level,omitempty"`
+	Enabled   bool       `json:"enabled"`
+	NextRunAt time.Time  `json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"nebula-live/internal/pkg/push"
+)
+
+// ScheduledPushRequest 创建/更新定时推送请求
+type ScheduledPushRequest struct {
+	Provider string         `json:"provider,omitempty"`
+	Title    string         `json:"title" validate:"required,min=1,max=200"`
+	Body     string         `json:"body" validate:"required,min=1,max=1000"`
+	URL      string         `json:"url,omitempty"`
+	Sound    string         `json:"sound,omitempty"`
+	Icon     string         `json:"icon,omitempty"`
+	Group    string         `json:"group,omitempty"`
+	Level    push.PushLevel `json:"level,omitempty" enums:"passive,active,timeSensitive,critical"`
+	SendAt   time.Time      `json:"send_at" validate:"required"`
+}
+
+// Validate 验证定时推送请求
+func (r *ScheduledPushRequest) Validate() error {
+	var errs ValidationErrors
+
+	errs.requireLength("title", r.Title, 200)
+	errs.requireLength("body", r.Body, 1000)
+	errs.pushLevel("level", r.Level)
+
+	if r.SendAt.IsZero() {
+		errs.Add("send_at", "is required")
+	} else if !r.SendAt.After(time.Now()) {
+		errs.Add("send_at", "must be in the future")
+	}
+
+	return errs.Err()
+}
+
+// ScheduledPushResponse 定时推送响应
+type ScheduledPushResponse struct {
+	ID        uint       `json:"id"`
+	UserID    uint       `json:"user_id"`
+	Provider  string     `json:"provider,omitempty"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	URL       string     `json:"url,omitempty"`
+	Sound     string     `json:"sound,omitempty"`
+	Icon      string     `json:"icon,omitempty"`
+	Group     string     `json:"group,omitempty"`
+	Level     string     `json:"level,omitempty"`
+	SendAt    time.Time  `json:"send_at"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error,omitempty"`
+	SentAt    *time.Time `json:"sent_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
@@ -1,45 +1,31 @@
 package dto
 
 import (
-	"errors"
+	"net/url"
 	"time"
+
+	"nebula-live/internal/pkg/push"
 )
 
 // CreateUserPushSettingRequest 创建用户推送设置请求
 type CreateUserPushSettingRequest struct {
-	Provider   string                 `json:"provider" validate:"required,oneof=bark"`
+	Provider   string                 `json:"provider" validate:"required,oneof=bark email"`
 	DeviceID   string                 `json:"device_id" validate:"required,min=1,max=255"`
 	DeviceName string                 `json:"device_name" validate:"required,min=1,max=100"`
 	Settings   map[string]interface{} `json:"settings,omitempty"`
+	Enabled    *bool                  `json:"enabled,omitempty"` // 不传时使用服务端配置的默认值
 }
 
 // Validate 验证创建用户推送设置请求
 func (r *CreateUserPushSettingRequest) Validate() error {
-	if r.Provider == "" {
-		return errors.New("provider is required")
-	}
-	
-	if r.Provider != "bark" {
-		return errors.New("provider must be one of: bark")
-	}
-	
-	if r.DeviceID == "" {
-		return errors.New("device_id is required")
-	}
-	
-	if len(r.DeviceID) > 255 {
-		return errors.New("device_id must not exceed 255 characters")
-	}
-	
-	if r.DeviceName == "" {
-		return errors.New("device_name is required")
-	}
-	
-	if len(r.DeviceName) > 100 {
-		return errors.New("device_name must not exceed 100 characters")
-	}
-	
-	return nil
+	var errs ValidationErrors
+
+	errs.validateProvider(r.Provider)
+	errs.requireLength("device_id", r.DeviceID, 255)
+	errs.validateDeviceID(r.Provider, r.DeviceID)
+	errs.requireLength("device_name", r.DeviceName, 100)
+
+	return errs.Err()
 }
 
 // UpdateUserPushSettingRequest 更新用户推送设置请求
@@ -51,42 +37,56 @@ type UpdateUserPushSettingRequest struct {
 
 // Validate 验证更新用户推送设置请求
 func (r *UpdateUserPushSettingRequest) Validate() error {
-	if r.DeviceName != nil && *r.DeviceName == "" {
-		return errors.New("device_name cannot be empty")
-	}
-	
-	if r.DeviceName != nil && len(*r.DeviceName) > 100 {
-		return errors.New("device_name must not exceed 100 characters")
+	var errs ValidationErrors
+
+	if r.DeviceName != nil {
+		if *r.DeviceName == "" {
+			errs.Add("device_name", "cannot be empty")
+		}
+		errs.maxLength("device_name", *r.DeviceName, 100)
 	}
-	
-	return nil
+
+	return errs.Err()
 }
 
 // ValidateDeviceRequest 验证设备请求
 type ValidateDeviceRequest struct {
-	Provider string `json:"provider" validate:"required,oneof=bark"`
+	Provider string `json:"provider" validate:"required,oneof=bark email"`
 	DeviceID string `json:"device_id" validate:"required,min=1,max=255"`
 }
 
 // Validate 验证设备请求
 func (r *ValidateDeviceRequest) Validate() error {
-	if r.Provider == "" {
-		return errors.New("provider is required")
-	}
-	
-	if r.Provider != "bark" {
-		return errors.New("provider must be one of: bark")
+	var errs ValidationErrors
+
+	errs.validateProvider(r.Provider)
+	errs.requireLength("device_id", r.DeviceID, 255)
+	errs.validateDeviceID(r.Provider, r.DeviceID)
+
+	return errs.Err()
+}
+
+// TransferUserPushSettingRequest 转移推送设备请求
+type TransferUserPushSettingRequest struct {
+	FromUserID uint `json:"from_user_id" validate:"required"` // 设备当前所属的用户ID
+	ToUserID   uint `json:"to_user_id" validate:"required"`   // 设备转移到的用户ID
+}
+
+// Validate 验证转移推送设备请求
+func (r *TransferUserPushSettingRequest) Validate() error {
+	var errs ValidationErrors
+
+	if r.FromUserID == 0 {
+		errs.Add("from_user_id", "is required")
 	}
-	
-	if r.DeviceID == "" {
-		return errors.New("device_id is required")
+	if r.ToUserID == 0 {
+		errs.Add("to_user_id", "is required")
 	}
-	
-	if len(r.DeviceID) > 255 {
-		return errors.New("device_id must not exceed 255 characters")
+	if r.FromUserID != 0 && r.FromUserID == r.ToUserID {
+		errs.Add("to_user_id", "must differ from from_user_id")
 	}
-	
-	return nil
+
+	return errs.Err()
 }
 
 // UserPushSettingResponse 用户推送设置响应
@@ -95,45 +95,101 @@ type UserPushSettingResponse struct {
 	UserID     uint                   `json:"user_id"`
 	Provider   string                 `json:"provider"`
 	Enabled    bool                   `json:"enabled"`
-	DeviceID   string                 `json:"device_id"`
+	DeviceID   string                 `json:"device_id" example:"abcd****wxyz"` // 默认脱敏，所有者使用reveal_device_id=true查询参数时返回完整值
 	DeviceName string                 `json:"device_name"`
 	Settings   map[string]interface{} `json:"settings,omitempty"`
 	CreatedAt  time.Time              `json:"created_at"`
 	UpdatedAt  time.Time              `json:"updated_at"`
+
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	LastFailureAt       *time.Time `json:"last_failure_at,omitempty"`
+}
+
+// UserDeviceResponse 管理员查看的用户推送设备，设备ID已脱敏，不包含提供商设置
+type UserDeviceResponse struct {
+	ID         uint      `json:"id"`
+	Provider   string    `json:"provider"`
+	Enabled    bool      `json:"enabled"`
+	DeviceID   string    `json:"device_id" example:"abcd****wxyz"`
+	DeviceName string    `json:"device_name"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	LastFailureAt       *time.Time `json:"last_failure_at,omitempty"`
+}
+
+// UserDevicesResponse 指定用户的推送设备列表
+type UserDevicesResponse struct {
+	UserID  uint                 `json:"user_id"`
+	Devices []UserDeviceResponse `json:"devices"`
 }
 
 // UserPushRequest 用户推送请求
 type UserPushRequest struct {
-	Title    string `json:"title" validate:"required,min=1,max=200"`
-	Body     string `json:"body" validate:"required,min=1,max=1000"`
-	URL      string `json:"url,omitempty"`
-	Sound    string `json:"sound,omitempty"`
-	Icon     string `json:"icon,omitempty"`
-	Group    string `json:"group,omitempty"`
-	Level    string `json:"level,omitempty"`
-	AutoCopy bool   `json:"auto_copy,omitempty"`
-	Call     bool   `json:"call,omitempty"`
+	Title    string         `json:"title" validate:"required,min=1,max=200"`
+	Subtitle string         `json:"subtitle,omitempty" validate:"omitempty,max=200"`
+	Body     string         `json:"body" validate:"required,min=1,max=1000"`
+	Badge    int            `json:"badge,omitempty" validate:"omitempty,min=0"`
+	URL      string         `json:"url,omitempty"`
+	Sound    string         `json:"sound,omitempty"`
+	Icon     string         `json:"icon,omitempty"`
+	Image    string         `json:"image,omitempty"` // 通知中展示的图片，必须是https地址，仅Bark支持
+	Group    string         `json:"group,omitempty"`
+	Level    push.PushLevel `json:"level,omitempty" enums:"passive,active,timeSensitive,critical"`
+	AutoCopy bool           `json:"auto_copy,omitempty"`
+	Copy     string         `json:"copy,omitempty" validate:"omitempty,max=1000"` // 复制通知时得到的内容，auto_copy为true时自动复制
+	Call     bool           `json:"call,omitempty"`
+	// ServerURL 本次推送使用的服务器，优先于设备设置和默认配置
+	ServerURL string `json:"server_url,omitempty" validate:"omitempty,url"`
 }
 
 // Validate 验证用户推送请求
 func (r *UserPushRequest) Validate() error {
-	if r.Title == "" {
-		return errors.New("title is required")
+	var errs ValidationErrors
+
+	errs.requireLength("title", r.Title, 200)
+	errs.maxLength("subtitle", r.Subtitle, 200)
+	errs.requireLength("body", r.Body, 1000)
+	errs.maxLength("copy", r.Copy, 1000)
+	errs.pushLevel("level", r.Level)
+
+	if r.Badge < 0 {
+		errs.Add("badge", "must not be negative")
 	}
-	
-	if len(r.Title) > 200 {
-		return errors.New("title must not exceed 200 characters")
+
+	if r.Image != "" && push.ValidateImageURL(r.Image) != nil {
+		errs.Add("image", "must be an absolute https URL")
 	}
-	
-	if r.Body == "" {
-		return errors.New("body is required")
+
+	if r.ServerURL != "" {
+		if u, err := url.Parse(r.ServerURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs.Add("server_url", "must be an absolute http or https URL")
+		}
 	}
-	
-	if len(r.Body) > 1000 {
-		return errors.New("body must not exceed 1000 characters")
+
+	return errs.Err()
+}
+
+// ToPushMessage 转换为推送消息
+func (r *UserPushRequest) ToPushMessage() *push.PushMessage {
+	return &push.PushMessage{
+		Title:    r.Title,
+		Subtitle: r.Subtitle,
+		Body:     r.Body,
+		Badge:    r.Badge,
+		URL:      r.URL,
+		Sound:    r.Sound,
+		Icon:     r.Icon,
+		Image:    r.Image,
+		Group:    r.Group,
+		Level:    r.Level,
+		AutoCopy: r.AutoCopy,
+		Copy:     r.Copy,
+		Call:     r.Call,
+
+		ServerURL: r.ServerURL,
 	}
-	
-	return nil
 }
 
 // PushResponse 推送响应
@@ -142,6 +198,41 @@ type PushResponse struct {
 	MessageID string `json:"message_id,omitempty"`
 	Provider  string `json:"provider"`
 	Error     string `json:"error,omitempty"`
+	Cancelled bool   `json:"cancelled,omitempty"`
+	TestMode  bool   `json:"test_mode,omitempty"` // 测试模式下未真正发送
+	Rejected  bool   `json:"rejected,omitempty"`  // 超出提供商长度限制等原因未发送
+	// StatusCode 推送服务返回的状态码（Bark为HTTP状态码，邮件为SMTP回复码），仅对拥有 system:manage 权限的用户返回
+	StatusCode int `json:"status_code,omitempty"`
+	// RawResponse 推送服务返回的原始响应，需启用 push.capture_raw_response，仅对拥有 system:manage 权限的用户返回
+	RawResponse string `json:"raw_response,omitempty"`
+}
+
+// PushPreviewResponse 推送预览结果，每个启用的设备一项
+type PushPreviewResponse struct {
+	UserID  uint                `json:"user_id"`
+	Devices []PushDevicePreview `json:"devices"`
+}
+
+// PushDevicePreview 设备将收到的最终消息，已应用设备设置、用户默认值、长度限制，并去掉提供商不支持的字段
+type PushDevicePreview struct {
+	SettingID  uint           `json:"setting_id"`
+	DeviceName string         `json:"device_name"`
+	Provider   string         `json:"provider"`
+	Endpoint   string         `json:"endpoint,omitempty"` // 推送服务器地址
+	Title      string         `json:"title,omitempty"`
+	Subtitle   string         `json:"subtitle,omitempty"`
+	Body       string         `json:"body"`
+	Badge      int            `json:"badge,omitempty"`
+	Sound      string         `json:"sound,omitempty"`
+	Icon       string         `json:"icon,omitempty"`
+	Image      string         `json:"image,omitempty"`
+	Group      string         `json:"group,omitempty"`
+	Level      push.PushLevel `json:"level,omitempty"`
+	URL        string         `json:"url,omitempty"`
+	Call       bool           `json:"call,omitempty"`
+	AutoCopy   bool           `json:"auto_copy,omitempty"`
+	Copy       string         `json:"copy,omitempty"`
+	Error      string         `json:"error,omitempty"` // 该设备不会收到消息的原因，如超出长度限制
 }
 
 // UserPushResult 用户推送结果
@@ -157,8 +248,36 @@ type UserPushResult struct {
 
 // ListResponse 通用列表响应
 type ListResponse[T any] struct {
-	Data  []T   `json:"data"`
-	Total int64 `json:"total"`
-	Page  int   `json:"page"`
-	Limit int   `json:"limit"`
-}
\ No newline at end of file
+	Data []T `json:"data"`
+	Pagination
+}
+
+// UserPushPreferencesRequest 用户推送偏好请求，空值表示不设置默认值
+type UserPushPreferencesRequest struct {
+	DefaultGroup string `json:"default_group" validate:"max=100"`
+	DefaultSound string `json:"default_sound" validate:"max=100"`
+	DefaultLevel string `json:"default_level" validate:"omitempty,oneof=active critical timeSensitive passive"`
+}
+
+// Validate 验证用户推送偏好请求
+func (r *UserPushPreferencesRequest) Validate() error {
+	var errs ValidationErrors
+
+	errs.maxLength("default_group", r.DefaultGroup, 100)
+	errs.maxLength("default_sound", r.DefaultSound, 100)
+
+	return errs.Err()
+}
+
+// UserPushPreferencesResponse 用户推送偏好响应
+type UserPushPreferencesResponse struct {
+	DefaultGroup string `json:"default_group"`
+	DefaultSound string `json:"default_sound"`
+	DefaultLevel string `json:"default_level"`
+}
+
+// SetAllEnabledResponse 批量启用/禁用推送设置响应
+type SetAllEnabledResponse struct {
+	Enabled bool `json:"enabled"`
+	Updated int  `json:"updated"` // 状态实际发生变化的设置数量
+}
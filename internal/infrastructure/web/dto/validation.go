@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+
+	"nebula-live/internal/pkg/push"
+)
+
+// FieldError 单个字段的校验错误
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors 请求校验错误，包含所有未通过校验的字段
+type ValidationErrors []FieldError
+
+// Error 将所有字段错误拼接为一条消息
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Add 添加字段错误，消息以字段名开头
+func (e *ValidationErrors) Add(field, format string, args ...interface{}) {
+	*e = append(*e, FieldError{
+		Field:   field,
+		Message: field + " " + fmt.Sprintf(format, args...),
+	})
+}
+
+// Err 没有字段错误时返回nil，避免返回包含空切片的非nil错误
+func (e ValidationErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// requireLength 校验必填字符串字段及其最大长度
+func (e *ValidationErrors) requireLength(field, value string, max int) {
+	if value == "" {
+		e.Add(field, "is required")
+		return
+	}
+	e.maxLength(field, value, max)
+}
+
+// maxLength 校验字符串字段的最大长度
+func (e *ValidationErrors) maxLength(field, value string, max int) {
+	if len(value) > max {
+		e.Add(field, "must not exceed %d characters", max)
+	}
+}
+
+// pushLevel 校验可选的推送级别是否为通用级别之一
+func (e *ValidationErrors) pushLevel(field string, level push.PushLevel) {
+	if level != "" && !level.IsValid() {
+		e.Add(field, "must be one of %s", push.LevelNames())
+	}
+}
+
+// pushProviders 支持的推送提供商，与推送设置表provider字段的枚举值一致
+var pushProviders = []string{"bark", "email"}
+
+// validateProvider 校验必填的推送提供商是否受支持
+func (e *ValidationErrors) validateProvider(provider string) {
+	if provider == "" {
+		e.Add("provider", "is required")
+		return
+	}
+	for _, p := range pushProviders {
+		if p == provider {
+			return
+		}
+	}
+	e.Add("provider", "must be one of: %s", strings.Join(pushProviders, ", "))
+}
+
+// validateDeviceID 按提供商校验设备ID格式，邮件提供商的设备ID必须是邮箱地址
+func (e *ValidationErrors) validateDeviceID(provider, deviceID string) {
+	if provider == "email" && deviceID != "" && push.ValidateEmailAddress(deviceID) != nil {
+		e.Add("device_id", "must be a valid email address")
+	}
+}
@@ -0,0 +1,63 @@
+package dto_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/infrastructure/web/dto"
+)
+
+// fieldNames 返回校验错误中的字段名
+func fieldNames(t *testing.T, err error) []string {
+	t.Helper()
+	var fieldErrs dto.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		t.Fatalf("error = %v (%T), want dto.ValidationErrors", err, err)
+	}
+	names := make([]string, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		names[i] = fieldErr.Field
+	}
+	return names
+}
+
+func TestCreateUserPushSettingRequest_ValidateReportsAllFields(t *testing.T) {
+	req := dto.CreateUserPushSettingRequest{
+		Provider:   "bark",
+		DeviceID:   "",
+		DeviceName: strings.Repeat("a", 101),
+	}
+	err := req.Validate()
+	if got := strings.Join(fieldNames(t, err), ","); got != "device_id,device_name" {
+		t.Errorf("failing fields = %s, want device_id,device_name", got)
+	}
+	if !strings.Contains(err.Error(), "device_id is required") || !strings.Contains(err.Error(), "device_name must not exceed 100 characters") {
+		t.Errorf("Error() = %q, want both field messages", err.Error())
+	}
+
+	req = dto.CreateUserPushSettingRequest{Provider: "sms", DeviceID: "not-an-email", DeviceName: "phone"}
+	if got := strings.Join(fieldNames(t, req.Validate()), ","); got != "provider" {
+		t.Errorf("failing fields = %s, want provider", got)
+	}
+	req = dto.CreateUserPushSettingRequest{Provider: "email", DeviceID: "not-an-email", DeviceName: "mail"}
+	if got := strings.Join(fieldNames(t, req.Validate()), ","); got != "device_id" {
+		t.Errorf("failing fields = %s, want device_id", got)
+	}
+
+	req = dto.CreateUserPushSettingRequest{Provider: "bark", DeviceID: "device-key", DeviceName: "iPhone"}
+	if err := req.Validate(); err != nil {
+		t.Errorf("Validate() of a valid request error = %v, want nil", err)
+	}
+}
+
+func TestValidationErrors_ErrIsNilWhenEmpty(t *testing.T) {
+	var errs dto.ValidationErrors
+	if err := errs.Err(); err != nil {
+		t.Errorf("Err() with no field errors = %v, want nil", err)
+	}
+	errs.Add("title", "is required")
+	if err := errs.Err(); err == nil || err.Error() != "title is required" {
+		t.Errorf("Err() = %v, want %q", err, "title is required")
+	}
+}
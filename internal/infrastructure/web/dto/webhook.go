@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// WebhookDeliveryResponse Webhook投递记录响应
+type WebhookDeliveryResponse struct {
+	ID         uint      `json:"id"`
+	DeliveryID string    `json:"delivery_id"`
+	EventID    string    `json:"event_id"`
+	Event      string    `json:"event" enums:"user.created,user.banned,user.deleted"`
+	URL        string    `json:"url"`
+	Attempt    int       `json:"attempt"`
+	StatusCode int       `json:"status_code"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
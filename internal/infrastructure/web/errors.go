@@ -0,0 +1,84 @@
+package web
+
+import (
+	"errors"
+
+	apierrors "nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// kindTitles 各类领域错误响应的error字段
+var kindTitles = map[apierrors.ErrorKind]string{
+	apierrors.KindInvalid:   "Invalid request",
+	apierrors.KindNotFound:  "Not found",
+	apierrors.KindConflict:  "Conflict",
+	apierrors.KindForbidden: "Forbidden",
+	apierrors.KindInternal:  "Internal server error",
+}
+
+// NewServiceError 将服务层错误转换为APIError
+//
+// 领域错误按类别映射状态码，消息为领域错误自身的消息（不含被包装的底层错误）；
+// 其他错误一律为500，消息为fallback，避免向客户端暴露数据库等内部错误。
+func NewServiceError(err error, fallback string) *apierrors.APIError {
+	var domainErr *apierrors.DomainError
+	if !errors.As(err, &domainErr) || domainErr.Kind == apierrors.KindInternal {
+		return apierrors.NewAPIError(fiber.StatusInternalServerError, kindTitles[apierrors.KindInternal], fallback)
+	}
+
+	message := domainErr.Message
+	if message == "" {
+		message = fallback
+	}
+	return apierrors.NewAPIError(domainErr.Kind.HTTPStatus(), kindTitles[domainErr.Kind], message)
+}
+
+// ServiceError 按NewServiceError写出错误响应，处理器记录日志后直接返回：
+//
+//	if err != nil {
+//		h.logger.Error("Failed to get user", zap.Error(err))
+//		return web.ServiceError(c, err, "Failed to get user")
+//	}
+func ServiceError(c *fiber.Ctx, err error, fallback string) error {
+	apiErr := NewServiceError(err, fallback)
+	return c.Status(apiErr.Code).JSON(apiErr)
+}
+
+// NewErrorHandler 创建全局错误处理器，将处理器和中间件返回的错误转换为APIError响应
+//
+// *RequestError按原样输出，领域错误按类别映射状态码，*fiber.Error（如请求体过大）保留其状态码，
+// 其余错误记录日志后返回500。
+func NewErrorHandler(log *zap.Logger) fiber.ErrorHandler {
+	return func(c *fiber.Ctx, err error) error {
+		// 请求本身无效，按处理器给出的错误原样返回
+		var requestErr *RequestError
+		if errors.As(err, &requestErr) {
+			return c.Status(requestErr.APIError.Code).JSON(requestErr.APIError)
+		}
+
+		// 处理器直接返回的领域错误按类别映射状态码，内部错误走下方的通用500
+		if apierrors.KindOf(err) != apierrors.KindInternal {
+			apiErr := NewServiceError(err, "")
+			return c.Status(apiErr.Code).JSON(apiErr)
+		}
+
+		code := fiber.StatusInternalServerError
+		message := "Internal server error"
+
+		if e, ok := err.(*fiber.Error); ok {
+			code = e.Code
+			message = e.Message
+		}
+
+		log.Error("Request failed",
+			zap.String("method", c.Method()),
+			zap.String("path", c.Path()),
+			zap.Int("status", code),
+			zap.Error(err),
+		)
+
+		return c.Status(code).JSON(apierrors.NewAPIError(code, "Request failed", message))
+	}
+}
@@ -0,0 +1,81 @@
+package web_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/infrastructure/web"
+	apierrors "nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+func TestNewServiceError(t *testing.T) {
+	notFound := apierrors.NewDomainError(apierrors.KindNotFound, "user not found")
+	dbErr := errors.New("sql: database is locked (SQLITE_BUSY)")
+
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    int
+		wantMessage string
+	}{
+		{"not found", notFound, fiber.StatusNotFound, "user not found"},
+		{"wrapped not found", fmt.Errorf("get user 7: %w", notFound), fiber.StatusNotFound, "user not found"},
+		{"conflict", apierrors.NewDomainError(apierrors.KindConflict, "username already exists"), fiber.StatusConflict, "username already exists"},
+		{"invalid", apierrors.NewDomainError(apierrors.KindInvalid, "invalid status"), fiber.StatusBadRequest, "invalid status"},
+		{"forbidden", apierrors.NewDomainError(apierrors.KindForbidden, "system role"), fiber.StatusForbidden, "system role"},
+		// 包装的底层错误只出现在日志中，响应使用领域错误自身的消息
+		{"invalid wrapping a db error", apierrors.WrapDomainError(apierrors.KindInvalid, "invalid role", dbErr), fiber.StatusBadRequest, "invalid role"},
+		{"raw db error", dbErr, fiber.StatusInternalServerError, "Failed to get user"},
+		{"internal domain error", apierrors.WrapDomainError(apierrors.KindInternal, "query failed", dbErr), fiber.StatusInternalServerError, "Failed to get user"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := web.NewServiceError(tt.err, "Failed to get user")
+			if apiErr.Code != tt.wantCode || apiErr.Message != tt.wantMessage {
+				t.Errorf("NewServiceError() = %d %q, want %d %q", apiErr.Code, apiErr.Message, tt.wantCode, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestErrorHandler_DomainAndUnexpectedErrors(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	app.Get("/missing", func(c *fiber.Ctx) error {
+		return apierrors.NewDomainError(apierrors.KindNotFound, "room not found")
+	})
+	app.Get("/broken", func(c *fiber.Ctx) error {
+		return errors.New("pq: relation \"users\" does not exist")
+	})
+
+	tests := []struct {
+		path        string
+		wantCode    int
+		wantMessage string
+	}{
+		{"/missing", fiber.StatusNotFound, "room not found"},
+		{"/broken", fiber.StatusInternalServerError, "Internal server error"},
+	}
+	for _, tt := range tests {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil))
+		if err != nil {
+			t.Fatalf("app.Test(%s) error = %v", tt.path, err)
+		}
+		var body apierrors.APIError
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("decode %s response error = %v", tt.path, err)
+		}
+		if resp.StatusCode != tt.wantCode || body.Message != tt.wantMessage {
+			t.Errorf("GET %s = %d %q, want %d %q", tt.path, resp.StatusCode, body.Message, tt.wantCode, tt.wantMessage)
+		}
+		if strings.Contains(body.Message, "relation") {
+			t.Errorf("GET %s leaked the internal error: %q", tt.path, body.Message)
+		}
+	}
+}
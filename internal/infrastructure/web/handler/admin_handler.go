@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/pkg/auth"
+	"nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// AdminHandler 系统运维处理器
+type AdminHandler struct {
+	rbacService       service.RBACService
+	allowReinitialize bool
+	logger            *zap.Logger
+}
+
+// NewAdminHandler 创建系统运维处理器实例
+func NewAdminHandler(rbacService service.RBACService, config *config.Config, logger *zap.Logger) *AdminHandler {
+	return &AdminHandler{
+		rbacService:       rbacService,
+		allowReinitialize: config.RBAC.AllowReinitialize,
+		logger:            logger,
+	}
+}
+
+// RouteResponse 已注册路由
+type RouteResponse struct {
+	Method  string `json:"method"`
+	Path    string `json:"path"`
+	Name    string `json:"name,omitempty"`
+	Handler string `json:"handler"`
+}
+
+// ListRoutesResponse 已注册路由列表响应
+type ListRoutesResponse struct {
+	Routes []RouteResponse `json:"routes"`
+	Total  int             `json:"total"`
+}
+
+// ListRoutes godoc
+// @Summary      List Registered Routes
+// @Description  List all routes registered in the application with method, path and handler name (requires system:manage permission)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} ListRoutesResponse "Registered routes"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Forbidden"
+// @Security     Bearer
+// @Router       /admin/routes [get]
+func (h *AdminHandler) ListRoutes(c *fiber.Ctx) error {
+	// 过滤中间件注册的路由，Fiber为每个GET路由自动注册的HEAD路由也一并省略
+	routes := make([]RouteResponse, 0)
+	for _, route := range c.App().GetRoutes(true) {
+		if route.Method == fiber.MethodHead || len(route.Handlers) == 0 {
+			continue
+		}
+
+		routes = append(routes, RouteResponse{
+			Method:  route.Method,
+			Path:    route.Path,
+			Name:    route.Name,
+			Handler: handlerName(route.Handlers[len(route.Handlers)-1]),
+		})
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	return c.JSON(ListRoutesResponse{
+		Routes: routes,
+		Total:  len(routes),
+	})
+}
+
+// ReinitializeRBAC godoc
+// @Summary      Reinitialize RBAC System Data
+// @Description  Re-run the RBAC system data initialization: create missing system roles and permissions and grant the default permissions of the admin and user roles again. Existing roles, permissions and assignments are kept, so the call is idempotent (requires system:manage permission, can be disabled with rbac.allow_reinitialize)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} map[string]string "System data reinitialized"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Forbidden or reinitialization disabled"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /admin/rbac/reinitialize [post]
+func (h *AdminHandler) ReinitializeRBAC(c *fiber.Ctx) error {
+	if !h.allowReinitialize {
+		return c.Status(fiber.StatusForbidden).JSON(errors.NewAPIError(fiber.StatusForbidden, "Forbidden", "RBAC reinitialization is disabled"))
+	}
+
+	if err := h.rbacService.InitializeSystemData(c.UserContext()); err != nil {
+		h.logger.Error("Failed to reinitialize RBAC system data", zap.Error(err))
+		return web.ServiceError(c, err, "Failed to reinitialize RBAC system data")
+	}
+
+	h.logger.Info("RBAC system data reinitialized", zap.Uint("admin_id", auth.MustGetCurrentUserID(c)))
+
+	return c.JSON(fiber.Map{
+		"message": "RBAC system data reinitialized successfully",
+	})
+}
+
+// handlerName 返回路由最终处理函数的名称，去掉方法值的 -fm 后缀
+func handlerName(handler fiber.Handler) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(handler).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+	return strings.TrimSuffix(fn.Name(), "-fm")
+}
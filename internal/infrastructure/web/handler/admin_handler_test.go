@@ -0,0 +1,187 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// reinitialize 以管理员身份请求重新初始化RBAC系统数据，返回响应状态码
+func reinitialize(t *testing.T, rbacService service.RBACService, adminID uint, allow bool) int {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.RBAC.AllowReinitialize = allow
+
+	adminHandler := handler.NewAdminHandler(rbacService, cfg, zap.NewNop())
+	app := fiber.New()
+	app.Post("/admin/rbac/reinitialize",
+		func(c *fiber.Ctx) error {
+			c.Locals(auth.UserIDContextKey, adminID)
+			return c.Next()
+		},
+		adminHandler.ReinitializeRBAC,
+	)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodPost, "/admin/rbac/reinitialize", nil))
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	return resp.StatusCode
+}
+
+// permissionNames 返回角色拥有的权限名称，按名称排序
+func permissionNames(t *testing.T, rbacService service.RBACService, roleName string) []string {
+	t.Helper()
+	ctx := context.Background()
+	role, err := rbacService.GetRoleByName(ctx, roleName)
+	if err != nil {
+		t.Fatalf("GetRoleByName(%s) error = %v", roleName, err)
+	}
+	permissions, err := rbacService.GetRolePermissions(ctx, role.ID)
+	if err != nil {
+		t.Fatalf("GetRolePermissions(%s) error = %v", roleName, err)
+	}
+	names := make([]string, len(permissions))
+	for i, permission := range permissions {
+		names[i] = permission.Name
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestAdminHandler_ReinitializeGrantsNewSystemPermission(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	operator, err := userService.CreateUser(ctx, "ivy", "ivy@example.com", "Password123!", "Ivy")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	// 已有部署中的自定义数据：管理员角色额外拥有的权限、自定义角色和用户的角色分配
+	custom, err := rbacService.CreatePermission(ctx, "report:read", "Read Reports", "", "report", "read", entity.PermissionCategoryOther, false, operator.ID)
+	if err != nil {
+		t.Fatalf("CreatePermission() error = %v", err)
+	}
+	adminRole, err := rbacService.GetRoleByName(ctx, entity.RoleNameAdmin)
+	if err != nil {
+		t.Fatalf("GetRoleByName(admin) error = %v", err)
+	}
+	if err := rbacService.AssignPermissionToRole(ctx, adminRole.ID, custom.ID, operator.ID); err != nil {
+		t.Fatalf("AssignPermissionToRole() error = %v", err)
+	}
+	supportRole, err := rbacService.CreateRole(ctx, "support", "Support", "", false, operator.ID)
+	if err != nil {
+		t.Fatalf("CreateRole() error = %v", err)
+	}
+	if err := rbacService.AssignPermissionToRole(ctx, supportRole.ID, custom.ID, operator.ID); err != nil {
+		t.Fatalf("AssignPermissionToRole() error = %v", err)
+	}
+	user, err := userService.CreateUser(ctx, "henry", "henry@example.com", "Password123!", "Henry")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if err := rbacService.AssignRoleToUser(ctx, user.ID, supportRole.ID, operator.ID, nil); err != nil {
+		t.Fatalf("AssignRoleToUser() error = %v", err)
+	}
+
+	adminBefore := permissionNames(t, rbacService, entity.RoleNameAdmin)
+	supportBefore := permissionNames(t, rbacService, "support")
+	userBefore := permissionNames(t, rbacService, entity.RoleNameUser)
+
+	// 模拟代码中新增的系统权限，在初始化之后才出现在数据库中
+	if _, err := rbacService.CreatePermission(ctx, "report:export", "Export Reports", "", "report", "export", entity.PermissionCategorySystem, true, 0); err != nil {
+		t.Fatalf("CreatePermission() error = %v", err)
+	}
+	if slices.Contains(permissionNames(t, rbacService, entity.RoleNameAdmin), "report:export") {
+		t.Fatal("admin has report:export before reinitialization")
+	}
+
+	if got := reinitialize(t, rbacService, operator.ID, false); got != fiber.StatusForbidden {
+		t.Errorf("status when disabled = %d, want %d", got, fiber.StatusForbidden)
+	}
+	if got := reinitialize(t, rbacService, operator.ID, true); got != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d", got, fiber.StatusOK)
+	}
+	// 重复调用不会产生重复数据
+	if got := reinitialize(t, rbacService, operator.ID, true); got != fiber.StatusOK {
+		t.Fatalf("second status = %d, want %d", got, fiber.StatusOK)
+	}
+
+	wantAdmin := append(slices.Clone(adminBefore), "report:export")
+	slices.Sort(wantAdmin)
+	if got := permissionNames(t, rbacService, entity.RoleNameAdmin); !slices.Equal(got, wantAdmin) {
+		t.Errorf("admin permissions = %v, want %v", got, wantAdmin)
+	}
+	if got := permissionNames(t, rbacService, "support"); !slices.Equal(got, supportBefore) {
+		t.Errorf("support permissions = %v, want unchanged %v", got, supportBefore)
+	}
+	if got := permissionNames(t, rbacService, entity.RoleNameUser); !slices.Equal(got, userBefore) {
+		t.Errorf("user permissions = %v, want unchanged %v", got, userBefore)
+	}
+
+	roles, err := rbacService.GetUserRoles(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetUserRoles() error = %v", err)
+	}
+	roleNames := make([]string, len(roles))
+	for i, role := range roles {
+		roleNames[i] = role.Name
+	}
+	if !slices.Contains(roleNames, "support") {
+		t.Errorf("henry roles = %v, want support kept", roleNames)
+	}
+}
+
+func TestAdminHandler_ListRoutes(t *testing.T) {
+	adminHandler := handler.NewAdminHandler(nil, &config.Config{}, zap.NewNop())
+	ok := func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) }
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error { return c.Next() })
+	app.Get("/users", ok)
+	app.Post("/users", ok)
+	app.Get("/roles", ok)
+	app.Get("/admin/routes", adminHandler.ListRoutes)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/admin/routes", nil))
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	var body handler.ListRoutesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+
+	got := make(map[string]string, len(body.Routes))
+	for _, route := range body.Routes {
+		got[route.Method+" "+route.Path] = route.Handler
+	}
+	for _, route := range []string{"GET /users", "POST /users", "GET /roles", "GET /admin/routes"} {
+		if _, ok := got[route]; !ok {
+			t.Errorf("routes %v missing %s", got, route)
+		}
+	}
+	// 自动注册的HEAD路由和中间件不出现在列表中
+	if body.Total != 4 || len(body.Routes) != 4 {
+		t.Errorf("total = %d, routes = %v; want 4 routes", body.Total, got)
+	}
+	if handlerName := got["GET /admin/routes"]; !strings.HasSuffix(handlerName, "handler.(*AdminHandler).ListRoutes") {
+		t.Errorf("handler = %q, want the AdminHandler.ListRoutes method", handlerName)
+	}
+}
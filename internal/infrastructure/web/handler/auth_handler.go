@@ -1,8 +1,14 @@
 package handler
 
 import (
+	stderrors "errors"
+	"net/mail"
+	"strconv"
+
 	"nebula-live/internal/domain/service"
-	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/internal/pkg/captcha"
 	"nebula-live/pkg/auth"
 	"nebula-live/pkg/errors"
 
@@ -12,25 +18,21 @@ import (
 
 // AuthHandler 认证处理器
 type AuthHandler struct {
-	userService service.UserService
-	jwtManager  *auth.JWTManager
-	logger      *zap.Logger
+	userService    service.UserService
+	sessionService service.SessionService
+	jwtManager     *auth.JWTManager
+	captcha        captcha.Verifier
+	logger         *zap.Logger
 }
 
 // NewAuthHandler 创建认证处理器实例
-func NewAuthHandler(userService service.UserService, config *config.Config, logger *zap.Logger) *AuthHandler {
-	// 创建JWT管理器
-	tokenConfig := &auth.TokenConfig{
-		SecretKey:       config.JWT.Secret,
-		AccessTokenTTL:  config.JWT.AccessTokenTTL,
-		RefreshTokenTTL: config.JWT.RefreshTokenTTL,
-		Issuer:          config.JWT.Issuer,
-	}
-
+func NewAuthHandler(userService service.UserService, sessionService service.SessionService, captchaVerifier captcha.Verifier, jwtManager *auth.JWTManager, logger *zap.Logger) *AuthHandler {
 	return &AuthHandler{
-		userService: userService,
-		jwtManager:  auth.NewJWTManager(tokenConfig),
-		logger:      logger,
+		userService:    userService,
+		sessionService: sessionService,
+		jwtManager:     jwtManager,
+		captcha:        captchaVerifier,
+		logger:         logger,
 	}
 }
 
@@ -40,12 +42,67 @@ type RegisterRequest struct {
 	Email    string `json:"email" validate:"required,email,max=100"`
 	Password string `json:"password" validate:"required,min=6,max=100"`
 	Nickname string `json:"nickname" validate:"max=100"`
+	// CaptchaToken 人机验证令牌，启用 captcha 时必填
+	CaptchaToken string `json:"captcha_token,omitempty"`
+}
+
+// Validate 验证用户注册请求
+func (r *RegisterRequest) Validate() error {
+	var errs dto.ValidationErrors
+
+	switch {
+	case r.Username == "":
+		errs.Add("username", "is required")
+	case len(r.Username) < 3 || len(r.Username) > 50:
+		errs.Add("username", "must be between 3 and 50 characters")
+	}
+	switch {
+	case r.Email == "":
+		errs.Add("email", "is required")
+	case len(r.Email) > 100:
+		errs.Add("email", "must not exceed 100 characters")
+	default:
+		if _, err := mail.ParseAddress(r.Email); err != nil {
+			errs.Add("email", "must be a valid email address")
+		}
+	}
+	switch {
+	case r.Password == "":
+		errs.Add("password", "is required")
+	case len(r.Password) < 6 || len(r.Password) > 100:
+		errs.Add("password", "must be between 6 and 100 characters")
+	}
+	if len(r.Nickname) > 100 {
+		errs.Add("nickname", "must not exceed 100 characters")
+	}
+
+	return errs.Err()
 }
 
 // LoginRequest 用户登录请求
 type LoginRequest struct {
 	Username string `json:"username" validate:"required,min=3,max=50"`
 	Password string `json:"password" validate:"required,min=6,max=100"`
+	// CaptchaToken 人机验证令牌，启用 captcha 时必填
+	CaptchaToken string `json:"captcha_token,omitempty"`
+}
+
+// Validate 验证用户登录请求，只检查必填和长度上限，凭据是否正确由登录流程判断
+func (r *LoginRequest) Validate() error {
+	var errs dto.ValidationErrors
+
+	if r.Username == "" {
+		errs.Add("username", "is required")
+	} else if len(r.Username) > 50 {
+		errs.Add("username", "must not exceed 50 characters")
+	}
+	if r.Password == "" {
+		errs.Add("password", "is required")
+	} else if len(r.Password) > 100 {
+		errs.Add("password", "must not exceed 100 characters")
+	}
+
+	return errs.Err()
 }
 
 // AuthResponse 认证响应
@@ -66,20 +123,22 @@ type AuthResponse struct {
 // @Produce      json
 // @Param        user body RegisterRequest true "User registration information"
 // @Success      201 {object} AuthResponse "Registration successful"
-// @Failure      400 {object} errors.APIError "Invalid request parameters"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or captcha verification failed"
 // @Failure      409 {object} errors.APIError "User already exists"
 // @Failure      500 {object} errors.APIError "Internal server error"
+// @Failure      503 {object} errors.APIError "Captcha provider unavailable"
 // @Router       /auth/register [post]
 func (h *AuthHandler) Register(c *fiber.Ctx) error {
 	var req RegisterRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse register request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
-	// TODO: 添加请求验证
+	if apiErr := h.verifyCaptcha(c, req.CaptchaToken); apiErr != nil {
+		return c.Status(apiErr.Code).JSON(apiErr)
+	}
 
-	user, err := h.userService.CreateUser(c.Context(), req.Username, req.Email, req.Password, req.Nickname)
+	user, err := h.userService.CreateUser(c.UserContext(), req.Username, req.Email, req.Password, req.Nickname)
 	if err != nil {
 		h.logger.Error("Failed to register user", zap.Error(err))
 
@@ -109,6 +168,24 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(response)
 }
 
+// verifyCaptcha 校验人机验证令牌，通过时返回nil，令牌缺失或无效时返回400错误，验证服务不可用时返回503错误
+func (h *AuthHandler) verifyCaptcha(c *fiber.Ctx, token string) *errors.APIError {
+	err := h.captcha.Verify(c.UserContext(), token, c.IP())
+	if err == nil {
+		return nil
+	}
+
+	if stderrors.Is(err, captcha.ErrMissingToken) || stderrors.Is(err, captcha.ErrVerificationFailed) {
+		h.logger.Warn("Captcha verification failed",
+			zap.String("ip", c.IP()),
+			zap.Error(err))
+		return errors.NewAPIError(fiber.StatusBadRequest, "Captcha verification failed", "Please complete the captcha and try again")
+	}
+
+	h.logger.Error("Failed to verify captcha", zap.Error(err))
+	return errors.NewAPIError(fiber.StatusServiceUnavailable, "Captcha unavailable", "Captcha verification is temporarily unavailable")
+}
+
 // Login godoc
 // @Summary      User Login
 // @Description  Authenticate user with username and password
@@ -117,21 +194,23 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 // @Produce      json
 // @Param        credentials body LoginRequest true "Login credentials"
 // @Success      200 {object} AuthResponse "Login successful"
-// @Failure      400 {object} errors.APIError "Invalid request parameters"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or captcha verification failed"
 // @Failure      401 {object} errors.APIError "Authentication failed"
 // @Failure      403 {object} errors.APIError "Account banned or inactive"
 // @Failure      500 {object} errors.APIError "Internal server error"
+// @Failure      503 {object} errors.APIError "Captcha provider unavailable"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	var req LoginRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse login request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
-	// TODO: 添加请求验证
+	if apiErr := h.verifyCaptcha(c, req.CaptchaToken); apiErr != nil {
+		return c.Status(apiErr.Code).JSON(apiErr)
+	}
 
-	user, err := h.userService.ValidateUser(c.Context(), req.Username, req.Password)
+	user, err := h.userService.ValidateUser(c.UserContext(), req.Username, req.Password)
 	if err != nil {
 		h.logger.Error("Failed to validate user credentials",
 			zap.String("username", req.Username),
@@ -149,8 +228,17 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		}
 	}
 
+	// 创建登录会话
+	session, err := h.sessionService.CreateSession(c.UserContext(), user.ID, c.Get(fiber.HeaderUserAgent), c.IP())
+	if err != nil {
+		h.logger.Error("Failed to create session",
+			zap.Uint("user_id", user.ID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to create session"))
+	}
+
 	// 生成JWT令牌
-	tokenPair, err := h.jwtManager.GenerateTokenPair(user.ID, user.Username, user.Email)
+	tokenPair, err := h.jwtManager.GenerateSessionTokenPair(user.ID, user.Username, user.Email, session.ID, session.RefreshTokenID)
 	if err != nil {
 		h.logger.Error("Failed to generate JWT tokens",
 			zap.Uint("user_id", user.ID),
@@ -201,7 +289,7 @@ func (h *AuthHandler) GetCurrentUser(c *fiber.Ctx) error {
 	}
 
 	// 从数据库获取最新用户信息
-	user, err := h.userService.GetUserByID(c.Context(), currentUser.UserID)
+	user, err := h.userService.GetUserByID(c.UserContext(), currentUser.UserID)
 	if err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "Current user not found"))
@@ -232,9 +320,20 @@ type RefreshRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
 }
 
+// Validate 验证刷新令牌请求
+func (r *RefreshRequest) Validate() error {
+	var errs dto.ValidationErrors
+
+	if r.RefreshToken == "" {
+		errs.Add("refresh_token", "is required")
+	}
+
+	return errs.Err()
+}
+
 // RefreshToken godoc
 // @Summary      Refresh Access Token
-// @Description  Use refresh token to get a new access token
+// @Description  Use refresh token to get a new access token. Each refresh token can be used only once; the response contains a new one. Reusing an old refresh token revokes its session.
 // @Tags         Authentication
 // @Accept       json
 // @Produce      json
@@ -242,23 +341,49 @@ type RefreshRequest struct {
 // @Success      200 {object} map[string]interface{} "Token refreshed successfully"
 // @Failure      400 {object} errors.APIError "Invalid request parameters"
 // @Failure      401 {object} errors.APIError "Invalid refresh token"
+// @Failure      403 {object} errors.APIError "Account banned or inactive"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Router       /auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
-
 	var req RefreshRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse refresh token request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
-	// 使用刷新令牌生成新的令牌对
-	tokenPair, err := h.jwtManager.RefreshToken(req.RefreshToken)
+	// 访问令牌和模拟登录令牌不能用于刷新
+	claims, err := h.jwtManager.ValidateRefreshToken(req.RefreshToken)
 	if err != nil {
 		h.logger.Error("Failed to refresh token", zap.Error(err))
 		return c.Status(fiber.StatusUnauthorized).JSON(errors.NewAPIError(fiber.StatusUnauthorized, "Invalid refresh token", "Failed to refresh authentication token"))
 	}
 
+	// 轮换会话的刷新令牌，已撤销或已使用过的刷新令牌在此被拒绝
+	session, err := h.sessionService.RotateSession(c.UserContext(), claims.UserID, claims.SessionID, claims.ID, c.IP())
+	if err != nil {
+		switch err {
+		case service.ErrSessionNotFound:
+			return c.Status(fiber.StatusUnauthorized).JSON(errors.NewAPIError(fiber.StatusUnauthorized, "Invalid refresh token", "Session has been revoked or expired"))
+		case service.ErrUserBanned:
+			return c.Status(fiber.StatusForbidden).JSON(errors.NewAPIError(fiber.StatusForbidden, "Account banned", "Your account has been banned"))
+		case service.ErrUserInactive:
+			return c.Status(fiber.StatusForbidden).JSON(errors.NewAPIError(fiber.StatusForbidden, "Account inactive", "Your account is inactive"))
+		}
+
+		h.logger.Error("Failed to rotate session",
+			zap.Uint("session_id", claims.SessionID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to refresh authentication token"))
+	}
+
+	// 使用刷新令牌生成新的令牌对
+	tokenPair, err := h.jwtManager.GenerateSessionTokenPair(claims.UserID, claims.Username, claims.Email, session.ID, session.RefreshTokenID)
+	if err != nil {
+		h.logger.Error("Failed to generate JWT tokens",
+			zap.Uint("user_id", claims.UserID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to generate authentication tokens"))
+	}
+
 	response := map[string]interface{}{
 		"access_token":  tokenPair.AccessToken,
 		"refresh_token": tokenPair.RefreshToken,
@@ -269,3 +394,152 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(response)
 }
+
+// SessionResponse 登录会话响应
+type SessionResponse struct {
+	ID         uint   `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	Current    bool   `json:"current"` // 是否为发起请求的会话
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+// ListSessionsResponse 登录会话列表响应
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+	Total    int               `json:"total"`
+}
+
+// ListSessions godoc
+// @Summary      List Active Sessions
+// @Description  List the current user's active login sessions
+// @Tags         Authentication
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} ListSessionsResponse "Active sessions"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Impersonation tokens cannot manage sessions"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Router       /auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *fiber.Ctx) error {
+	currentUser, apiErr := currentSession(c)
+	if apiErr != nil {
+		return c.Status(apiErr.Code).JSON(apiErr)
+	}
+
+	sessions, err := h.sessionService.ListSessions(c.UserContext(), currentUser.UserID)
+	if err != nil {
+		h.logger.Error("Failed to list sessions",
+			zap.Uint("user_id", currentUser.UserID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list sessions"))
+	}
+
+	sessionResponses := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		sessionResponses[i] = SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IP:         session.IP,
+			Current:    session.ID == currentUser.SessionID,
+			CreatedAt:  session.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			LastUsedAt: session.LastUsedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ExpiresAt:  session.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	return c.JSON(ListSessionsResponse{
+		Sessions: sessionResponses,
+		Total:    len(sessionResponses),
+	})
+}
+
+// RevokeSession godoc
+// @Summary      Revoke Session
+// @Description  Revoke one of the current user's sessions, its refresh token stops working immediately. Access tokens already issued stay valid until they expire.
+// @Tags         Authentication
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Session ID"
+// @Success      204 "Session revoked"
+// @Failure      400 {object} errors.APIError "Invalid session ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Impersonation tokens cannot manage sessions"
+// @Failure      404 {object} errors.APIError "Session not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Router       /auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *fiber.Ctx) error {
+	currentUser, apiErr := currentSession(c)
+	if apiErr != nil {
+		return c.Status(apiErr.Code).JSON(apiErr)
+	}
+
+	sessionID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid session ID", "Session ID must be a valid number"))
+	}
+
+	if err := h.sessionService.RevokeSession(c.UserContext(), currentUser.UserID, uint(sessionID)); err != nil {
+		if err == service.ErrSessionNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Session not found", "The specified session does not exist"))
+		}
+
+		h.logger.Error("Failed to revoke session",
+			zap.Uint("user_id", currentUser.UserID),
+			zap.Uint64("session_id", sessionID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to revoke session"))
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// RevokeOtherSessions godoc
+// @Summary      Revoke Other Sessions
+// @Description  Revoke all of the current user's sessions except the one making the request
+// @Tags         Authentication
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} map[string]interface{} "Sessions revoked"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Impersonation tokens cannot manage sessions"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Router       /auth/sessions [delete]
+func (h *AuthHandler) RevokeOtherSessions(c *fiber.Ctx) error {
+	currentUser, apiErr := currentSession(c)
+	if apiErr != nil {
+		return c.Status(apiErr.Code).JSON(apiErr)
+	}
+
+	revoked, err := h.sessionService.RevokeOtherSessions(c.UserContext(), currentUser.UserID, currentUser.SessionID)
+	if err != nil {
+		h.logger.Error("Failed to revoke other sessions",
+			zap.Uint("user_id", currentUser.UserID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to revoke sessions"))
+	}
+
+	return c.JSON(map[string]interface{}{
+		"revoked": revoked,
+		"message": "Other sessions revoked successfully",
+	})
+}
+
+// currentSession 返回发起请求的登录会话的令牌声明
+//
+// 模拟登录令牌不属于任何会话（会话ID为0），若允许其管理会话，"撤销其他会话"会撤销目标用户的全部会话，因此一律拒绝。
+func currentSession(c *fiber.Ctx) (*auth.UserClaims, *errors.APIError) {
+	currentUser, exists := auth.GetCurrentUser(c)
+	if !exists {
+		return nil, errors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "No authenticated user found")
+	}
+	if currentUser.IsImpersonated() || currentUser.SessionID == 0 {
+		return nil, errors.NewAPIError(fiber.StatusForbidden, "Forbidden", "Sessions can only be managed from a login session")
+	}
+	return currentUser, nil
+}
@@ -0,0 +1,267 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/infrastructure/web/middleware"
+	"nebula-live/internal/pkg/captcha"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// stubVerifier 记录收到的令牌并返回固定结果的人机验证器
+type stubVerifier struct {
+	err    error
+	tokens []string
+}
+
+func (v *stubVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	v.tokens = append(v.tokens, token)
+	return v.err
+}
+
+// newAuthTestApp 创建使用指定验证器的认证路由
+func newAuthTestApp(t *testing.T, verifier captcha.Verifier) (*fiber.App, service.UserService) {
+	t.Helper()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+
+	// 验证失败的请求在创建会话和签发令牌之前返回
+	authHandler := handler.NewAuthHandler(userService, nil, verifier, nil, zap.NewNop())
+	app := fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	app.Post("/auth/register", authHandler.Register)
+	app.Post("/auth/login", authHandler.Login)
+	return app, userService
+}
+
+// post 发送JSON请求并返回响应状态码
+func post(t *testing.T, app *fiber.App, path, body string) int {
+	t.Helper()
+	req := httptest.NewRequest(fiber.MethodPost, path, strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	return resp.StatusCode
+}
+
+const registerBody = `{"username":"erin","email":"erin@example.com","password":"Password123!","captcha_token":"solved"}`
+
+// userExists 检查注册请求是否创建了用户
+func userExists(t *testing.T, userService service.UserService) bool {
+	t.Helper()
+	_, err := userService.GetUserByUsername(context.Background(), "erin")
+	return err == nil
+}
+
+func TestAuthHandler_RegisterCaptchaPassed(t *testing.T) {
+	verifier := &stubVerifier{}
+	app, userService := newAuthTestApp(t, verifier)
+
+	if got := post(t, app, "/auth/register", registerBody); got != fiber.StatusCreated {
+		t.Fatalf("register status = %d, want %d", got, fiber.StatusCreated)
+	}
+	if len(verifier.tokens) != 1 || verifier.tokens[0] != "solved" {
+		t.Errorf("verified tokens = %v, want [solved]", verifier.tokens)
+	}
+	if !userExists(t, userService) {
+		t.Error("user was not created")
+	}
+}
+
+func TestAuthHandler_CaptchaFailed(t *testing.T) {
+	verifier := &stubVerifier{err: captcha.ErrVerificationFailed}
+	app, userService := newAuthTestApp(t, verifier)
+
+	if got := post(t, app, "/auth/register", registerBody); got != fiber.StatusBadRequest {
+		t.Errorf("register status = %d, want %d", got, fiber.StatusBadRequest)
+	}
+	if userExists(t, userService) {
+		t.Error("user was created although captcha verification failed")
+	}
+
+	verifier.err = captcha.ErrMissingToken
+	if got := post(t, app, "/auth/login", `{"username":"erin","password":"Password123!"}`); got != fiber.StatusBadRequest {
+		t.Errorf("login status = %d, want %d", got, fiber.StatusBadRequest)
+	}
+}
+
+func TestAuthHandler_CaptchaUnavailable(t *testing.T) {
+	app, userService := newAuthTestApp(t, &stubVerifier{err: errors.New("failed to reach captcha provider")})
+
+	if got := post(t, app, "/auth/register", registerBody); got != fiber.StatusServiceUnavailable {
+		t.Errorf("register status = %d, want %d", got, fiber.StatusServiceUnavailable)
+	}
+	if userExists(t, userService) {
+		t.Error("user was created although captcha could not be verified")
+	}
+}
+
+func TestAuthHandler_CaptchaDisabled(t *testing.T) {
+	verifier, err := infrastructure.NewCaptchaVerifier(&config.Config{})
+	if err != nil {
+		t.Fatalf("NewCaptchaVerifier() error = %v", err)
+	}
+	app, userService := newAuthTestApp(t, verifier)
+
+	// 未启用时不需要令牌
+	body := `{"username":"erin","email":"erin@example.com","password":"Password123!"}`
+	if got := post(t, app, "/auth/register", body); got != fiber.StatusCreated {
+		t.Fatalf("register status = %d, want %d", got, fiber.StatusCreated)
+	}
+	if !userExists(t, userService) {
+		t.Error("user was not created")
+	}
+}
+
+func TestAuthHandler_InvalidRequestsRejectedBeforeCaptcha(t *testing.T) {
+	verifier := &stubVerifier{}
+	app, userService := newAuthTestApp(t, verifier)
+
+	tests := map[string]struct {
+		path string
+		body string
+	}{
+		"register without username": {"/auth/register", `{"email":"erin@example.com","password":"Password123!"}`},
+		"register short username":   {"/auth/register", `{"username":"er","email":"erin@example.com","password":"Password123!"}`},
+		"register invalid email":    {"/auth/register", `{"username":"erin","email":"not-an-email","password":"Password123!"}`},
+		"register short password":   {"/auth/register", `{"username":"erin","email":"erin@example.com","password":"12345"}`},
+		"login without password":    {"/auth/login", `{"username":"erin"}`},
+		"malformed json":            {"/auth/register", `{"username":`},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := post(t, app, tt.path, tt.body); got != fiber.StatusBadRequest {
+				t.Errorf("status = %d, want %d", got, fiber.StatusBadRequest)
+			}
+		})
+	}
+	if len(verifier.tokens) != 0 {
+		t.Errorf("captcha verified %d times, want invalid requests rejected first", len(verifier.tokens))
+	}
+	if userExists(t, userService) {
+		t.Error("invalid register request created a user")
+	}
+}
+
+// sessionTestEnv 带会话服务和JWT管理器的认证路由，用户alice已登录两个会话
+type sessionTestEnv struct {
+	app        *fiber.App
+	sessions   service.SessionService
+	jwtManager *auth.JWTManager
+	user       *entity.User
+	pair       *auth.TokenPair
+}
+
+func newSessionTestEnv(t *testing.T) *sessionTestEnv {
+	t.Helper()
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	userService := testutil.NewUserService(t, client, testutil.NewRBACService(t, client))
+	sessionService := testutil.NewSessionService(t, client)
+	jwtManager := auth.NewJWTManager(&auth.TokenConfig{
+		SecretKey:       "test-secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+	})
+
+	user, err := userService.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	var pair *auth.TokenPair
+	for range 2 {
+		session, err := sessionService.CreateSession(ctx, user.ID, "test-agent", "127.0.0.1")
+		if err != nil {
+			t.Fatalf("CreateSession() error = %v", err)
+		}
+		pair, err = jwtManager.GenerateSessionTokenPair(user.ID, user.Username, user.Email, session.ID, session.RefreshTokenID)
+		if err != nil {
+			t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+		}
+	}
+
+	authHandler := handler.NewAuthHandler(userService, sessionService, captcha.NoopVerifier{}, jwtManager, zap.NewNop())
+	authMiddleware := middleware.NewAuthMiddleware(jwtManager, zap.NewNop())
+	app := fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	app.Post("/auth/refresh", authHandler.RefreshToken)
+	app.Get("/auth/sessions", authMiddleware.RequireAuth(), authHandler.ListSessions)
+	app.Delete("/auth/sessions", authMiddleware.RequireAuth(), authHandler.RevokeOtherSessions)
+
+	return &sessionTestEnv{app: app, sessions: sessionService, jwtManager: jwtManager, user: user, pair: pair}
+}
+
+// request 携带Bearer令牌发送请求并返回响应状态码
+func (e *sessionTestEnv) request(t *testing.T, method, path, token string) int {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	resp, err := e.app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	return resp.StatusCode
+}
+
+func TestAuthHandler_RefreshRejectsAccessToken(t *testing.T) {
+	env := newSessionTestEnv(t)
+
+	if got := post(t, env.app, "/auth/refresh", `{"refresh_token":"`+env.pair.AccessToken+`"}`); got != fiber.StatusUnauthorized {
+		t.Errorf("refresh with access token status = %d, want %d", got, fiber.StatusUnauthorized)
+	}
+	if got := post(t, env.app, "/auth/refresh", `{"refresh_token":"`+env.pair.RefreshToken+`"}`); got != fiber.StatusOK {
+		t.Errorf("refresh with refresh token status = %d, want %d", got, fiber.StatusOK)
+	}
+}
+
+func TestAuthHandler_ImpersonationCannotManageSessions(t *testing.T) {
+	env := newSessionTestEnv(t)
+
+	token, _, err := env.jwtManager.GenerateImpersonationToken(env.user.ID, env.user.Username, env.user.Email, 99)
+	if err != nil {
+		t.Fatalf("GenerateImpersonationToken() error = %v", err)
+	}
+
+	if got := env.request(t, fiber.MethodDelete, "/auth/sessions", token); got != fiber.StatusForbidden {
+		t.Errorf("revoke other sessions status = %d, want %d", got, fiber.StatusForbidden)
+	}
+	if got := env.request(t, fiber.MethodGet, "/auth/sessions", token); got != fiber.StatusForbidden {
+		t.Errorf("list sessions status = %d, want %d", got, fiber.StatusForbidden)
+	}
+
+	// 目标用户的会话都还在
+	sessions, err := env.sessions.ListSessions(context.Background(), env.user.ID)
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Errorf("sessions = %d, want 2 kept", len(sessions))
+	}
+
+	// 登录会话的访问令牌只撤销其他会话
+	if got := env.request(t, fiber.MethodDelete, "/auth/sessions", env.pair.AccessToken); got != fiber.StatusOK {
+		t.Fatalf("revoke other sessions with login token status = %d, want %d", got, fiber.StatusOK)
+	}
+	sessions, err = env.sessions.ListSessions(context.Background(), env.user.ID)
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Errorf("sessions after revoke = %d, want 1", len(sessions))
+	}
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"strconv"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/pkg/auth"
+	apierrors "nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// JobHandler 异步任务处理器
+type JobHandler struct {
+	jobService service.JobService
+}
+
+// NewJobHandler 创建异步任务处理器
+func NewJobHandler(jobService service.JobService) *JobHandler {
+	return &JobHandler{
+		jobService: jobService,
+	}
+}
+
+// GetMyJob godoc
+// @Summary      Get Job
+// @Description  Get the status and result of an asynchronous job submitted by the current user
+// @Tags         Jobs
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Job ID"
+// @Success      200 {object} dto.JobResponse "Job status"
+// @Failure      400 {object} errors.APIError "Invalid job ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Job not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /jobs/{id} [get]
+func (h *JobHandler) GetMyJob(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	return h.getJob(c, userID)
+}
+
+// GetJob godoc
+// @Summary      Get Any Job
+// @Description  Get the status and result of any asynchronous job, including jobs submitted by the system
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Job ID"
+// @Success      200 {object} dto.JobResponse "Job status"
+// @Failure      400 {object} errors.APIError "Invalid job ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Forbidden"
+// @Failure      404 {object} errors.APIError "Job not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /admin/jobs/{id} [get]
+func (h *JobHandler) GetJob(c *fiber.Ctx) error {
+	return h.getJob(c, 0)
+}
+
+// getJob 获取任务，userID不为0时只返回该用户提交的任务
+func (h *JobHandler) getJob(c *fiber.Ctx, userID uint) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid job ID"),
+		)
+	}
+
+	job, err := h.jobService.GetJob(c.UserContext(), userID, uint(id))
+	if err != nil {
+		return web.ServiceError(c, err, "Failed to get job")
+	}
+
+	return c.JSON(toJobResponse(job))
+}
+
+// toJobResponse 将任务实体转换为响应
+func toJobResponse(job *entity.Job) dto.JobResponse {
+	return dto.JobResponse{
+		ID:         job.ID,
+		Type:       job.Type,
+		Status:     string(job.Status),
+		Payload:    job.Payload,
+		Result:     job.Result,
+		Error:      job.Error,
+		Attempts:   job.Attempts,
+		CreatedBy:  job.CreatedBy,
+		StartedAt:  job.StartedAt,
+		FinishedAt: job.FinishedAt,
+		CreatedAt:  job.CreatedAt,
+		UpdatedAt:  job.UpdatedAt,
+	}
+}
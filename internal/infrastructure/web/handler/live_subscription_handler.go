@@ -0,0 +1,313 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/internal/pkg/livestream"
+	"nebula-live/pkg/auth"
+	apierrors "nebula-live/pkg/errors"
+	"nebula-live/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// LiveSubscriptionHandler 直播间订阅处理器，所有操作都限定在当前用户的订阅内
+type LiveSubscriptionHandler struct {
+	subscriptionService service.LiveSubscriptionService
+	paginator           *Paginator
+}
+
+// NewLiveSubscriptionHandler 创建直播间订阅处理器
+func NewLiveSubscriptionHandler(subscriptionService service.LiveSubscriptionService, paginator *Paginator) *LiveSubscriptionHandler {
+	return &LiveSubscriptionHandler{
+		subscriptionService: subscriptionService,
+		paginator:           paginator,
+	}
+}
+
+// Subscribe godoc
+// @Summary      Subscribe to Live Room
+// @Description  Subscribe the current user to a live room; platform aliases are stored as the canonical platform name, so a room can only be subscribed once
+// @Tags         Live Subscriptions
+// @Accept       json
+// @Produce      json
+// @Param        subscription body dto.CreateLiveSubscriptionRequest true "Live room to subscribe to"
+// @Success      201 {object} dto.LiveSubscriptionResponse "Live room subscribed successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or unsupported platform"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      409 {object} errors.APIError "Live room already subscribed"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /live-streams/subscriptions [post]
+func (h *LiveSubscriptionHandler) Subscribe(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	var req dto.CreateLiveSubscriptionRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	subscription, err := h.subscriptionService.Subscribe(c.UserContext(), userID, req.Platform, req.RoomID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrLiveSubscriptionExists):
+			return c.Status(fiber.StatusConflict).JSON(
+				apierrors.NewAPIError(fiber.StatusConflict, "Already subscribed", "The live room is already subscribed"),
+			)
+		case errors.Is(err, livestream.ErrPlatformNotFound):
+			return c.Status(fiber.StatusBadRequest).JSON(
+				apierrors.NewAPIError(fiber.StatusBadRequest, "Unsupported platform", "The specified platform is not supported"),
+			)
+		case errors.Is(err, livestream.ErrInvalidRoomID):
+			return c.Status(fiber.StatusBadRequest).JSON(
+				apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid room ID", "The provided room ID is invalid"),
+			)
+		default:
+			logger.Error("Failed to subscribe live room",
+				zap.Uint("user_id", userID),
+				zap.String("platform", req.Platform),
+				zap.String("room_id", req.RoomID),
+				zap.Error(err))
+			return c.Status(fiber.StatusInternalServerError).JSON(
+				apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to subscribe live room"),
+			)
+		}
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toLiveSubscriptionResponse(subscription))
+}
+
+// ListSubscriptions godoc
+// @Summary      List Live Subscriptions
+// @Description  Get the current user's live room subscriptions with pagination, newest first
+// @Tags         Live Subscriptions
+// @Produce      json
+// @Param        page query int false "Page number" default(1)
+// @Param        limit query int false "Items per page, values above server.pagination.max_limit are clamped" default(10)
+// @Success      200 {object} dto.ListResponse[dto.LiveSubscriptionResponse] "List of the user's live subscriptions"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /live-streams/subscriptions [get]
+func (h *LiveSubscriptionHandler) ListSubscriptions(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	page, limit, _ := h.paginator.Parse(c)
+
+	subscriptions, total, err := h.subscriptionService.ListSubscriptions(c.UserContext(), userID, page, limit)
+	if err != nil {
+		logger.Error("Failed to list live subscriptions",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list live subscriptions"),
+		)
+	}
+
+	items := make([]dto.LiveSubscriptionResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		items[i] = toLiveSubscriptionResponse(subscription)
+	}
+
+	response := dto.ListResponse[dto.LiveSubscriptionResponse]{
+		Data:       items,
+		Pagination: dto.NewPagination(total, page, limit),
+	}
+
+	return web.List(c, response, response.Pagination)
+}
+
+// Unsubscribe godoc
+// @Summary      Unsubscribe from Live Room
+// @Description  Remove one of the current user's live room subscriptions
+// @Tags         Live Subscriptions
+// @Produce      json
+// @Param        id path int true "Subscription ID"
+// @Success      200 {object} map[string]string "Live room unsubscribed successfully"
+// @Failure      400 {object} errors.APIError "Invalid subscription ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Subscription not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /live-streams/subscriptions/{id} [delete]
+func (h *LiveSubscriptionHandler) Unsubscribe(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	subscriptionID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid subscription ID"),
+		)
+	}
+
+	// 其他用户的订阅同样视为不存在，不暴露其是否存在
+	err = h.subscriptionService.Unsubscribe(c.UserContext(), userID, uint(subscriptionID))
+	if errors.Is(err, service.ErrLiveSubscriptionNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			apierrors.NewAPIError(fiber.StatusNotFound, "Subscription not found", "Live subscription not found"),
+		)
+	}
+	if err != nil {
+		logger.Error("Failed to unsubscribe live room",
+			zap.Uint("user_id", userID),
+			zap.Uint("subscription_id", uint(subscriptionID)),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to unsubscribe live room"),
+		)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Live room unsubscribed successfully",
+	})
+}
+
+// UnsubscribeAll godoc
+// @Summary      Unsubscribe from All Live Rooms
+// @Description  Remove all of the current user's live room subscriptions at once
+// @Tags         Live Subscriptions
+// @Produce      json
+// @Success      200 {object} dto.UnsubscribeAllResponse "Number of subscriptions that were removed"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /live-streams/subscriptions [delete]
+func (h *LiveSubscriptionHandler) UnsubscribeAll(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	removed, err := h.subscriptionService.UnsubscribeAll(c.UserContext(), userID)
+	if err != nil {
+		logger.Error("Failed to unsubscribe all live rooms",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to unsubscribe live rooms"),
+		)
+	}
+
+	return c.JSON(dto.UnsubscribeAllResponse{
+		Removed: removed,
+	})
+}
+
+// ListSubscriptionStatuses godoc
+// @Summary      List My Subscribed Live Rooms with Status
+// @Description  Get the current status and basic info of each live room the current user subscribes to, online rooms first, then offline rooms, then rooms whose status could not be fetched (with an error field). Room status is cached briefly per room (livestream.subscription_status_cache_ttl). At most the 200 most recent subscriptions are included
+// @Tags         Live Subscriptions
+// @Produce      json
+// @Success      200 {object} dto.LiveSubscriptionStatusesResponse "Subscribed live rooms with their status"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /live-streams/my-subscriptions [get]
+func (h *LiveSubscriptionHandler) ListSubscriptionStatuses(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	statuses, err := h.subscriptionService.ListSubscriptionStatuses(c.UserContext(), userID)
+	if err != nil {
+		logger.Error("Failed to list live subscription statuses",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list live subscription statuses"),
+		)
+	}
+
+	response := dto.LiveSubscriptionStatusesResponse{
+		Data:  make([]dto.LiveSubscriptionStatusResponse, len(statuses)),
+		Total: len(statuses),
+	}
+	for i, status := range statuses {
+		if status.Err == nil && status.Room.Status == livestream.StreamStatusOnline {
+			response.Online++
+		}
+		response.Data[i] = toLiveSubscriptionStatusResponse(status)
+	}
+
+	return c.JSON(response)
+}
+
+// toLiveSubscriptionStatusResponse 将订阅的直播间状态转换为响应
+func toLiveSubscriptionStatusResponse(status *service.LiveSubscriptionStatus) dto.LiveSubscriptionStatusResponse {
+	item := dto.LiveSubscriptionStatusResponse{
+		ID:        status.Subscription.ID,
+		Platform:  status.Subscription.Platform,
+		RoomID:    status.Subscription.RoomID,
+		CreatedAt: status.Subscription.CreatedAt,
+		Status:    "unknown",
+		CheckedAt: status.CheckedAt,
+	}
+	if status.Err != nil {
+		item.Error = liveRoomStatusError(status.Err)
+		return item
+	}
+
+	room := status.Room
+	item.Status = string(room.Status)
+	item.Title = room.Title
+	item.Cover = room.Cover
+	item.OwnerName = room.OwnerName
+	item.OwnerAvatar = room.OwnerAvatar
+	item.Category = room.Category
+	item.ViewerCount = room.ViewerCount
+	if !room.LiveStartTime.IsZero() {
+		item.LiveStartTime = &room.LiveStartTime
+		item.LiveDuration = int64(room.LiveDuration.Seconds())
+	}
+	return item
+}
+
+// liveRoomStatusError 将获取直播间状态的错误转换为返回给用户的说明，不暴露上游的错误详情
+func liveRoomStatusError(err error) string {
+	switch {
+	case errors.Is(err, livestream.ErrRoomNotFound):
+		return "Live room not found"
+	case errors.Is(err, livestream.ErrPlatformNotFound):
+		return "Platform is not supported"
+	case errors.Is(err, livestream.ErrInvalidRoomID):
+		return "Invalid room ID"
+	default:
+		return "Failed to fetch live room status"
+	}
+}
+
+// toLiveSubscriptionResponse 将直播间订阅实体转换为响应
+func toLiveSubscriptionResponse(subscription *entity.LiveSubscription) dto.LiveSubscriptionResponse {
+	return dto.LiveSubscriptionResponse{
+		ID:        subscription.ID,
+		Platform:  subscription.Platform,
+		RoomID:    subscription.RoomID,
+		CreatedAt: subscription.CreatedAt,
+	}
+}
@@ -1,8 +1,9 @@
 package handler
 
 import (
-	"context"
 	"errors"
+	"net/url"
+	"time"
 
 	"nebula-live/internal/domain/service"
 	"nebula-live/internal/pkg/livestream"
@@ -33,19 +34,28 @@ type SupportedPlatformsResponse struct {
 }
 
 type RoomInfoResponse struct {
-	Platform      string `json:"platform" example:"douyu"`
-	RoomID        string `json:"room_id" example:"534740"`
-	Status        string `json:"status" example:"online"`
-	Title         string `json:"title,omitempty" example:"【六神】游戏室"`
-	Description   string `json:"description,omitempty" example:"欢迎来到直播间"`
-	Cover         string `json:"cover,omitempty" example:"https://example.com/cover.jpg"`
-	Keyframe      string `json:"keyframe,omitempty" example:"https://example.com/keyframe.jpg"`
-	OwnerID       string `json:"owner_id,omitempty" example:"28206057"`
-	OwnerName     string `json:"owner_name,omitempty" example:"丨马老六丨"`
-	OwnerAvatar   string `json:"owner_avatar,omitempty" example:"https://example.com/avatar.jpg"`
-	LiveStartTime int64  `json:"live_start_time,omitempty" example:"1609459200"`
-	ViewerCount   int64  `json:"viewer_count,omitempty" example:"1234"`
-	Category      string `json:"category,omitempty" example:"第五人格"`
+	Platform      string                 `json:"platform" example:"douyu"`
+	RoomID        string                 `json:"room_id" example:"534740"`
+	Status        string                 `json:"status" example:"online"`
+	Title         string                 `json:"title,omitempty" example:"【六神】游戏室"`
+	Description   string                 `json:"description,omitempty" example:"欢迎来到直播间"`
+	Cover         string                 `json:"cover,omitempty" example:"https://example.com/cover.jpg"`
+	Keyframe      string                 `json:"keyframe,omitempty" example:"https://example.com/keyframe.jpg"`
+	OwnerID       string                 `json:"owner_id,omitempty" example:"28206057"`
+	OwnerName     string                 `json:"owner_name,omitempty" example:"丨马老六丨"`
+	OwnerAvatar   string                 `json:"owner_avatar,omitempty" example:"https://example.com/avatar.jpg"`
+	LiveStartTime *time.Time             `json:"live_start_time,omitempty" example:"2021-01-01T00:00:00Z"`
+	LiveDuration  int64                  `json:"live_duration,omitempty" example:"3600"`
+	ViewerCount   int64                  `json:"viewer_count,omitempty" example:"1234"`
+	Category      string                 `json:"category,omitempty" example:"第五人格"`
+	Streams       []livestream.StreamURL `json:"streams,omitempty"`
+}
+
+type RoomResolveResponse struct {
+	Platform string `json:"platform" example:"bilibili"`
+	RoomID   string `json:"room_id" example:"5440"`
+	ShortID  string `json:"short_id,omitempty" example:"1"`
+	OwnerID  string `json:"owner_id" example:"9617619"`
 }
 
 func NewLiveStreamHandler(liveStreamService service.LiveStreamService, logger *zap.Logger) *LiveStreamHandler {
@@ -61,7 +71,7 @@ func NewLiveStreamHandler(liveStreamService service.LiveStreamService, logger *z
 // @Tags         Live Streaming
 // @Accept       json
 // @Produce      json
-// @Param        platform path string true "Streaming platform" Enums(douyu, bilibili) example(douyu)
+// @Param        platform path string true "Streaming platform or an alias such as bili, b站, 斗鱼" example(douyu)
 // @Param        roomId path string true "Room ID" example(534740)
 // @Success      200 {object} StreamStatusResponse "Stream status retrieved successfully"
 // @Failure      400 {object} errors.APIError "Invalid request parameters"
@@ -69,7 +79,7 @@ func NewLiveStreamHandler(liveStreamService service.LiveStreamService, logger *z
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Router       /live-streams/{platform}/rooms/{roomId}/status [get]
 func (h *LiveStreamHandler) GetStreamStatus(c *fiber.Ctx) error {
-	platform := c.Params("platform")
+	platform := platformParam(c)
 	roomID := c.Params("roomId")
 
 	if platform == "" {
@@ -84,7 +94,7 @@ func (h *LiveStreamHandler) GetStreamStatus(c *fiber.Ctx) error {
 		)
 	}
 
-	streamInfo, err := h.liveStreamService.GetStreamStatus(context.Background(), platform, roomID)
+	streamInfo, err := h.liveStreamService.GetStreamStatus(c.UserContext(), platform, roomID)
 	if err != nil {
 		h.logger.Error("Failed to get live stream status",
 			zap.String("platform", platform),
@@ -148,15 +158,17 @@ func (h *LiveStreamHandler) GetSupportedPlatforms(c *fiber.Ctx) error {
 // @Tags         Live Streaming
 // @Accept       json
 // @Produce      json
-// @Param        platform path string true "Streaming platform" Enums(douyu, bilibili) example(douyu)
+// @Param        platform path string true "Streaming platform or an alias such as bili, b站, 斗鱼" example(douyu)
 // @Param        roomId path string true "Room ID" example(534740)
+// @Param        include_streams query bool false "Also return stream URLs when the room is online and the platform supports it"
+// @Param        quality query string false "Platform-specific stream quality code used with include_streams, e.g. bilibili qn 10000/400/250/150/80; empty selects the best"
 // @Success      200 {object} RoomInfoResponse "Room information retrieved successfully"
 // @Failure      400 {object} errors.APIError "Invalid request parameters"
 // @Failure      404 {object} errors.APIError "Room not found"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Router       /live-streams/{platform}/rooms/{roomId}/info [get]
 func (h *LiveStreamHandler) GetRoomInfo(c *fiber.Ctx) error {
-	platform := c.Params("platform")
+	platform := platformParam(c)
 	roomID := c.Params("roomId")
 
 	if platform == "" {
@@ -171,7 +183,10 @@ func (h *LiveStreamHandler) GetRoomInfo(c *fiber.Ctx) error {
 		)
 	}
 
-	roomInfo, err := h.liveStreamService.GetRoomInfo(context.Background(), platform, roomID)
+	includeStreams := c.QueryBool("include_streams")
+	quality := c.Query("quality")
+
+	roomInfo, err := h.liveStreamService.GetRoomInfo(c.UserContext(), platform, roomID, includeStreams, quality)
 	if err != nil {
 		h.logger.Error("Failed to get room info",
 			zap.String("platform", platform),
@@ -192,6 +207,10 @@ func (h *LiveStreamHandler) GetRoomInfo(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusBadRequest).JSON(
 				apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid room ID", "The provided room ID is invalid"),
 			)
+		case errors.Is(err, livestream.ErrInvalidQuality):
+			return c.Status(fiber.StatusBadRequest).JSON(
+				apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid quality", "The requested stream quality is not supported by the platform"),
+			)
 		default:
 			return c.Status(fiber.StatusInternalServerError).JSON(
 				apierrors.NewAPIError(fiber.StatusInternalServerError, "Failed to get room info", err.Error()),
@@ -201,20 +220,102 @@ func (h *LiveStreamHandler) GetRoomInfo(c *fiber.Ctx) error {
 
 	// Create structured response using the defined type
 	response := RoomInfoResponse{
-		Platform:      roomInfo.Platform,
-		RoomID:        roomInfo.RoomID,
-		Status:        string(roomInfo.Status),
-		Title:         roomInfo.Title,
-		Description:   roomInfo.Description,
-		Cover:         roomInfo.Cover,
-		Keyframe:      roomInfo.Keyframe,
-		OwnerID:       roomInfo.OwnerID,
-		OwnerName:     roomInfo.OwnerName,
-		OwnerAvatar:   roomInfo.OwnerAvatar,
-		LiveStartTime: roomInfo.LiveStartTime,
-		ViewerCount:   roomInfo.ViewerCount,
-		Category:      roomInfo.Category,
+		Platform:    roomInfo.Platform,
+		RoomID:      roomInfo.RoomID,
+		Status:      string(roomInfo.Status),
+		Title:       roomInfo.Title,
+		Description: roomInfo.Description,
+		Cover:       roomInfo.Cover,
+		Keyframe:    roomInfo.Keyframe,
+		OwnerID:     roomInfo.OwnerID,
+		OwnerName:   roomInfo.OwnerName,
+		OwnerAvatar: roomInfo.OwnerAvatar,
+		ViewerCount: roomInfo.ViewerCount,
+		Category:    roomInfo.Category,
+		Streams:     roomInfo.Streams,
+	}
+
+	if !roomInfo.LiveStartTime.IsZero() {
+		response.LiveStartTime = &roomInfo.LiveStartTime
+		response.LiveDuration = int64(roomInfo.LiveDuration.Seconds())
 	}
 
 	return c.JSON(response)
 }
+
+// ResolveRoomID godoc
+// @Summary      Resolve Live Room ID
+// @Description  Resolve a short room ID (e.g. bilibili room 1) to the real room ID, short ID and owner UID; a real room ID resolves to itself
+// @Tags         Live Streaming
+// @Accept       json
+// @Produce      json
+// @Param        platform path string true "Streaming platform or an alias, only platforms with short room IDs such as bilibili are supported" example(bilibili)
+// @Param        roomId path string true "Short or real room ID" example(1)
+// @Success      200 {object} RoomResolveResponse "Room ID resolved successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or platform without short room IDs"
+// @Failure      404 {object} errors.APIError "Room not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Router       /live-streams/{platform}/rooms/{roomId}/resolve [get]
+func (h *LiveStreamHandler) ResolveRoomID(c *fiber.Ctx) error {
+	platform := platformParam(c)
+	roomID := c.Params("roomId")
+
+	if platform == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "platform is required"),
+		)
+	}
+
+	if roomID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "room_id is required"),
+		)
+	}
+
+	resolved, err := h.liveStreamService.ResolveRoomID(c.UserContext(), platform, roomID)
+	if err != nil {
+		h.logger.Error("Failed to resolve room ID",
+			zap.String("platform", platform),
+			zap.String("room_id", roomID),
+			zap.Error(err))
+
+		switch {
+		case errors.Is(err, livestream.ErrRoomNotFound):
+			return c.Status(fiber.StatusNotFound).JSON(
+				apierrors.NewAPIError(fiber.StatusNotFound, "Room not found", "The specified live room does not exist"),
+			)
+		case errors.Is(err, livestream.ErrPlatformNotFound):
+			return c.Status(fiber.StatusBadRequest).JSON(
+				apierrors.NewAPIError(fiber.StatusBadRequest, "Unsupported platform", "The specified platform is not supported"),
+			)
+		case errors.Is(err, livestream.ErrNotSupported):
+			return c.Status(fiber.StatusBadRequest).JSON(
+				apierrors.NewAPIError(fiber.StatusBadRequest, "Unsupported operation", "The specified platform has no short room IDs"),
+			)
+		case errors.Is(err, livestream.ErrInvalidRoomID):
+			return c.Status(fiber.StatusBadRequest).JSON(
+				apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid room ID", "The provided room ID is invalid"),
+			)
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(
+				apierrors.NewAPIError(fiber.StatusInternalServerError, "Failed to resolve room ID", err.Error()),
+			)
+		}
+	}
+
+	return c.JSON(RoomResolveResponse{
+		Platform: resolved.Platform,
+		RoomID:   resolved.RoomID,
+		ShortID:  resolved.ShortID,
+		OwnerID:  resolved.OwnerID,
+	})
+}
+
+// platformParam 获取路径中的平台名称，中文别名（如 b站、斗鱼）在路径中经过URL编码，需要先解码
+func platformParam(c *fiber.Ctx) string {
+	platform := c.Params("platform")
+	if decoded, err := url.PathUnescape(platform); err == nil {
+		return decoded
+	}
+	return platform
+}
@@ -5,12 +5,19 @@ import "go.uber.org/fx"
 // HandlerModule 处理器层模块
 var HandlerModule = fx.Options(
 	fx.Provide(
+		NewPaginator,
 		NewUserHandler,
 		NewAuthHandler,
 		NewRoleHandler,
 		NewPermissionHandler,
 		NewLiveStreamHandler,
+		NewLiveSubscriptionHandler,
 		NewUserPushSettingHandler,
 		NewUserPushHandler,
+		NewScheduledPushHandler,
+		NewRecurringPushHandler,
+		NewAdminHandler,
+		NewWebhookHandler,
+		NewJobHandler,
 	),
 )
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"nebula-live/internal/infrastructure/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// 未配置时使用的分页参数
+const (
+	defaultPageLimit    = 10
+	defaultMaxPageLimit = 100
+)
+
+// Paginator 分页参数解析器，所有列表接口使用同一个最大每页数量
+type Paginator struct {
+	defaultLimit int
+	maxLimit     int
+}
+
+// NewPaginator 创建分页参数解析器
+func NewPaginator(cfg *config.Config) *Paginator {
+	maxLimit := cfg.Server.Pagination.MaxLimit
+	if maxLimit < 1 {
+		maxLimit = defaultMaxPageLimit
+	}
+
+	defaultLimit := cfg.Server.Pagination.DefaultLimit
+	if defaultLimit < 1 {
+		defaultLimit = defaultPageLimit
+	}
+	if defaultLimit > maxLimit {
+		defaultLimit = maxLimit
+	}
+
+	return &Paginator{
+		defaultLimit: defaultLimit,
+		maxLimit:     maxLimit,
+	}
+}
+
+// Parse 解析page和limit查询参数，返回页码、每页数量和偏移量
+//
+// 缺失或无效的page视为1，缺失或无效的limit使用默认值，超过最大值的limit截断为最大值。
+func (p *Paginator) Parse(c *fiber.Ctx) (page, limit, offset int) {
+	page = c.QueryInt("page", 1)
+	if page < 1 {
+		page = 1
+	}
+
+	limit = c.QueryInt("limit", p.defaultLimit)
+	if limit < 1 {
+		limit = p.defaultLimit
+	}
+	if limit > p.maxLimit {
+		limit = p.maxLimit
+	}
+
+	return page, limit, (page - 1) * limit
+}
@@ -0,0 +1,207 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// paginationConfig 返回指定默认值和上限的分页配置
+func paginationConfig(defaultLimit, maxLimit int) *config.Config {
+	cfg := &config.Config{}
+	cfg.Server.Pagination = config.PaginationConfig{DefaultLimit: defaultLimit, MaxLimit: maxLimit}
+	return cfg
+}
+
+func TestPaginator_Parse(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        *config.Config
+		query      string
+		wantPage   int
+		wantLimit  int
+		wantOffset int
+	}{
+		{"defaults", paginationConfig(20, 50), "", 1, 20, 0},
+		{"within max", paginationConfig(20, 50), "?page=3&limit=30", 3, 30, 60},
+		{"above max clamped", paginationConfig(20, 50), "?limit=1000", 1, 50, 0},
+		{"zero limit", paginationConfig(20, 50), "?limit=0", 1, 20, 0},
+		{"negative limit", paginationConfig(20, 50), "?limit=-5", 1, 20, 0},
+		{"invalid limit", paginationConfig(20, 50), "?limit=abc", 1, 20, 0},
+		{"invalid page", paginationConfig(20, 50), "?page=0&limit=10", 1, 10, 0},
+		{"unconfigured", &config.Config{}, "?limit=1000", 1, 100, 0},
+		{"unconfigured default", &config.Config{}, "", 1, 10, 0},
+		{"default above max", paginationConfig(80, 50), "", 1, 50, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paginator := handler.NewPaginator(tt.cfg)
+			var page, limit, offset int
+			app := fiber.New()
+			app.Get("/", func(c *fiber.Ctx) error {
+				page, limit, offset = paginator.Parse(c)
+				return nil
+			})
+			if _, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/"+tt.query, nil)); err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			if page != tt.wantPage || limit != tt.wantLimit || offset != tt.wantOffset {
+				t.Errorf("Parse(%q) = (%d, %d, %d), want (%d, %d, %d)",
+					tt.query, page, limit, offset, tt.wantPage, tt.wantLimit, tt.wantOffset)
+			}
+		})
+	}
+}
+
+// listTestEnv 用户、角色、权限和推送设置列表接口，共用同一个分页参数解析器
+type listTestEnv struct {
+	app *fiber.App
+}
+
+func newListTestEnv(t *testing.T, cfg *config.Config) *listTestEnv {
+	t.Helper()
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	// 每个列表都准备多于上限的数据
+	var owner uint
+	for i := 0; i < 4; i++ {
+		name := fmt.Sprintf("user%d", i)
+		user, err := userService.CreateUser(ctx, name, name+"@example.com", "Password123!", name)
+		if err != nil {
+			t.Fatalf("CreateUser(%s) error = %v", name, err)
+		}
+		owner = user.ID
+		if _, err := rbacService.CreateRole(ctx, "role"+name, name, "", false, 0); err != nil {
+			t.Fatalf("CreateRole() error = %v", err)
+		}
+	}
+	settings := service.NewUserPushSettingService(
+		persistence.NewUserPushSettingRepository(client),
+		persistence.NewUserRepository(client),
+		rbacService,
+		service.UserPushSettingServiceConfig{},
+	)
+	enabled := true
+	for i := 0; i < 4; i++ {
+		deviceID := fmt.Sprintf("device-%d", i)
+		if _, err := settings.CreateSetting(ctx, owner, "bark", deviceID, deviceID, nil, &enabled); err != nil {
+			t.Fatalf("CreateSetting(%s) error = %v", deviceID, err)
+		}
+	}
+
+	paginator := handler.NewPaginator(cfg)
+	userHandler := handler.NewUserHandler(userService, paginator, nil, zap.NewNop())
+	roleHandler := handler.NewRoleHandler(rbacService, userService, paginator, zap.NewNop())
+	permissionHandler := handler.NewPermissionHandler(rbacService, paginator, zap.NewNop())
+	settingHandler := handler.NewUserPushSettingHandler(settings, nil, paginator)
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals(auth.AuthContextKey, &auth.UserClaims{UserID: owner})
+		c.Locals(auth.UserIDContextKey, owner)
+		return c.Next()
+	})
+	app.Get("/users", userHandler.ListUsers)
+	app.Get("/roles", roleHandler.ListRoles)
+	app.Get("/permissions", permissionHandler.ListPermissions)
+	app.Get("/push-settings", settingHandler.GetSettings)
+	return &listTestEnv{app: app}
+}
+
+// listEndpoints 列表接口路径和响应中的数据字段
+var listEndpoints = map[string]string{
+	"/users":         "users",
+	"/roles":         "roles",
+	"/permissions":   "permissions",
+	"/push-settings": "data",
+}
+
+// list 请求列表接口，返回解析后的响应
+func (e *listTestEnv) list(t *testing.T, path string) map[string]any {
+	t.Helper()
+	resp, err := e.app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+	if err != nil {
+		t.Fatalf("app.Test(%s) error = %v", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("GET %s status = %d, want %d", path, resp.StatusCode, fiber.StatusOK)
+	}
+	var body map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode %s response error = %v", path, err)
+	}
+	return body
+}
+
+func TestListEndpoints_LimitClampedToConfiguredMax(t *testing.T) {
+	env := newListTestEnv(t, paginationConfig(2, 3))
+
+	for path, field := range listEndpoints {
+		body := env.list(t, path+"?limit=1000")
+		if body["limit"] != float64(3) {
+			t.Errorf("GET %s?limit=1000 limit = %v, want 3", path, body["limit"])
+		}
+		if items, _ := body[field].([]any); len(items) != 3 {
+			t.Errorf("GET %s?limit=1000 returned %d %s, want 3", path, len(items), field)
+		}
+
+		body = env.list(t, path)
+		if body["limit"] != float64(2) {
+			t.Errorf("GET %s limit = %v, want the default 2", path, body["limit"])
+		}
+	}
+}
+
+// 所有列表接口按同样的规则计算总页数和前后页
+func TestListEndpoints_PaginationMetadata(t *testing.T) {
+	env := newListTestEnv(t, paginationConfig(10, 100))
+
+	for path := range listEndpoints {
+		total := int(env.list(t, path)["total"].(float64))
+		if total < 4 {
+			t.Fatalf("GET %s total = %d, want at least 4", path, total)
+		}
+
+		// limit恰好等于总数时只有一页
+		body := env.list(t, fmt.Sprintf("%s?limit=%d", path, total))
+		if body["total_pages"] != float64(1) || body["has_next"] != false || body["has_prev"] != false {
+			t.Errorf("GET %s?limit=%d = {total_pages: %v, has_next: %v, has_prev: %v}, want one page",
+				path, total, body["total_pages"], body["has_next"], body["has_prev"])
+		}
+
+		wantPages := (total + 2) / 3
+		first := env.list(t, path+"?limit=3")
+		if first["total_pages"] != float64(wantPages) || first["has_next"] != true || first["has_prev"] != false {
+			t.Errorf("GET %s?limit=3 = {total_pages: %v, has_next: %v, has_prev: %v}, want %d pages with a next page",
+				path, first["total_pages"], first["has_next"], first["has_prev"], wantPages)
+		}
+		last := env.list(t, fmt.Sprintf("%s?limit=3&page=%d", path, wantPages))
+		if last["has_next"] != false || last["has_prev"] != true {
+			t.Errorf("GET %s last page = {has_next: %v, has_prev: %v}, want only a previous page",
+				path, last["has_next"], last["has_prev"])
+		}
+	}
+
+	// 没有数据时总页数为0
+	empty := env.list(t, "/push-settings?provider=email")
+	if empty["total"] != float64(0) || empty["total_pages"] != float64(0) || empty["has_next"] != false {
+		t.Errorf("empty list = {total: %v, total_pages: %v, has_next: %v}, want 0, 0, false",
+			empty["total"], empty["total_pages"], empty["has_next"])
+	}
+}
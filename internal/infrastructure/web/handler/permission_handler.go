@@ -1,9 +1,13 @@
 package handler
 
 import (
+	"fmt"
 	"strconv"
 
+	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
 	"nebula-live/pkg/auth"
 	"nebula-live/pkg/errors"
 
@@ -14,24 +18,91 @@ import (
 // PermissionHandler 权限处理器
 type PermissionHandler struct {
 	rbacService service.RBACService
+	paginator   *Paginator
 	logger      *zap.Logger
 }
 
 // NewPermissionHandler 创建权限处理器实例
-func NewPermissionHandler(rbacService service.RBACService, logger *zap.Logger) *PermissionHandler {
+func NewPermissionHandler(rbacService service.RBACService, paginator *Paginator, logger *zap.Logger) *PermissionHandler {
 	return &PermissionHandler{
 		rbacService: rbacService,
+		paginator:   paginator,
 		logger:      logger,
 	}
 }
 
-// CreatePermissionRequest 创建权限请求
+// CreatePermissionRequest 创建权限请求，resource或action为*时表示通配权限
 type CreatePermissionRequest struct {
 	Name        string `json:"name" validate:"required,min=3,max=100"`
 	DisplayName string `json:"display_name" validate:"required,min=2,max=100"`
 	Description string `json:"description" validate:"max=500"`
-	Resource    string `json:"resource" validate:"required,min=2,max=50"`
-	Action      string `json:"action" validate:"required,min=2,max=50"`
+	Resource    string `json:"resource" validate:"required,min=1,max=50"`
+	Action      string `json:"action" validate:"required,min=1,max=50"`
+	Category    string `json:"category" validate:"omitempty,oneof=user rbac system other"` // 为空时使用other
+}
+
+// maxBatchPermissions 单次批量创建的权限数量上限
+const maxBatchPermissions = 100
+
+// 批量创建权限的单项结果状态
+const (
+	BatchPermissionCreated = "created" // 已创建
+	BatchPermissionExists  = "exists"  // 名称已存在，已跳过
+)
+
+// CreatePermissionsBatchRequest 批量创建权限请求
+type CreatePermissionsBatchRequest struct {
+	Permissions []CreatePermissionRequest `json:"permissions" validate:"required,min=1,max=100"`
+}
+
+// Validate 验证批量创建权限请求，错误字段以 permissions[i].name 的形式标明所在的项
+func (r *CreatePermissionsBatchRequest) Validate() error {
+	var errs dto.ValidationErrors
+
+	if len(r.Permissions) == 0 {
+		errs.Add("permissions", "must contain at least one permission")
+	}
+	if len(r.Permissions) > maxBatchPermissions {
+		errs.Add("permissions", "must not contain more than %d permissions", maxBatchPermissions)
+	}
+
+	for i, item := range r.Permissions {
+		prefix := fmt.Sprintf("permissions[%d].", i)
+		if len(item.Name) < 3 || len(item.Name) > 100 {
+			errs.Add(prefix+"name", "must be between 3 and 100 characters")
+		}
+		if len(item.DisplayName) < 2 || len(item.DisplayName) > 100 {
+			errs.Add(prefix+"display_name", "must be between 2 and 100 characters")
+		}
+		if len(item.Description) > 500 {
+			errs.Add(prefix+"description", "must not exceed 500 characters")
+		}
+		if item.Resource == "" || len(item.Resource) > 50 {
+			errs.Add(prefix+"resource", "must be between 1 and 50 characters")
+		}
+		if item.Action == "" || len(item.Action) > 50 {
+			errs.Add(prefix+"action", "must be between 1 and 50 characters")
+		}
+		if item.Category != "" && !entity.IsValidPermissionCategory(item.Category) {
+			errs.Add(prefix+"category", "must be one of: user, rbac, system, other")
+		}
+	}
+
+	return errs.Err()
+}
+
+// BatchPermissionResult 批量创建权限的单项结果，与请求中的项顺序一致
+type BatchPermissionResult struct {
+	Name       string              `json:"name"`
+	Status     string              `json:"status"`               // created 或 exists
+	Permission *PermissionResponse `json:"permission,omitempty"` // 仅在创建成功时返回
+}
+
+// CreatePermissionsBatchResponse 批量创建权限响应
+type CreatePermissionsBatchResponse struct {
+	Results []BatchPermissionResult `json:"results"`
+	Created int                     `json:"created"`
+	Skipped int                     `json:"skipped"`
 }
 
 // UpdatePermissionRequest 更新权限请求
@@ -53,7 +124,10 @@ type PermissionResponse struct {
 	Description string `json:"description"`
 	Resource    string `json:"resource"`
 	Action      string `json:"action"`
+	Category    string `json:"category"`
 	IsSystem    bool   `json:"is_system"`
+	CreatedBy   *uint  `json:"created_by"` // 创建者的用户ID，系统初始化时为null
+	UpdatedBy   *uint  `json:"updated_by"` // 最后修改者的用户ID，未修改过时为null
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
@@ -61,9 +135,21 @@ type PermissionResponse struct {
 // ListPermissionsResponse 权限列表响应
 type ListPermissionsResponse struct {
 	Permissions []PermissionResponse `json:"permissions"`
-	Total       int                  `json:"total"`
-	Page        int                  `json:"page"`
-	Limit       int                  `json:"limit"`
+	dto.Pagination
+}
+
+// GroupedPermissionsResponse 按分类分组的权限响应
+type GroupedPermissionsResponse struct {
+	Categories map[string][]PermissionResponse `json:"categories"`
+	Total      int                             `json:"total"`
+}
+
+// PermissionUsersResponse 拥有指定权限的用户列表响应
+type PermissionUsersResponse struct {
+	Resource string         `json:"resource"`
+	Action   string         `json:"action"`
+	Users    []UserResponse `json:"users"`
+	Total    int            `json:"total"`
 }
 
 // CreatePermission godoc
@@ -82,22 +168,24 @@ type ListPermissionsResponse struct {
 // @Router       /permissions [post]
 func (h *PermissionHandler) CreatePermission(c *fiber.Ctx) error {
 	var req CreatePermissionRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse create permission request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	// TODO: 添加请求验证
 
-	permission, err := h.rbacService.CreatePermission(c.Context(), req.Name, req.DisplayName, req.Description, req.Resource, req.Action, false)
+	permission, err := h.rbacService.CreatePermission(c.UserContext(), req.Name, req.DisplayName, req.Description, req.Resource, req.Action, req.Category, false, auth.MustGetCurrentUserID(c))
 	if err != nil {
 		h.logger.Error("Failed to create permission", zap.Error(err))
 
 		if err == service.ErrPermissionAlreadyExists {
 			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "Permission already exists", "A permission with this name already exists"))
 		}
+		if err == service.ErrInvalidPermissionCategory {
+			return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid category", "Category must be one of: user, rbac, system, other"))
+		}
 
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to create permission"))
+		return web.ServiceError(c, err, "Failed to create permission")
 	}
 
 	response := PermissionResponse{
@@ -107,7 +195,10 @@ func (h *PermissionHandler) CreatePermission(c *fiber.Ctx) error {
 		Description: permission.Description,
 		Resource:    permission.Resource,
 		Action:      permission.Action,
+		Category:    permission.Category,
 		IsSystem:    permission.IsSystem,
+		CreatedBy:   permission.CreatedBy,
+		UpdatedBy:   permission.UpdatedBy,
 		CreatedAt:   permission.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   permission.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -115,6 +206,90 @@ func (h *PermissionHandler) CreatePermission(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(response)
 }
 
+// CreatePermissionsBatch godoc
+// @Summary      Create Permissions in Batch
+// @Description  Create several permissions in one transaction. Permissions whose name already exists (or repeats an earlier item) are skipped and reported with status "exists". Any invalid item rejects the whole batch.
+// @Tags         RBAC Permission Management
+// @Accept       json
+// @Produce      json
+// @Param        permissions body CreatePermissionsBatchRequest true "Permissions to create (1-100)"
+// @Success      201 {object} CreatePermissionsBatchResponse "At least one permission created"
+// @Success      200 {object} CreatePermissionsBatchResponse "All permissions already exist"
+// @Failure      400 {object} errors.APIError "Invalid request parameters"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      409 {object} errors.APIError "A permission was created concurrently, retry the request"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /permissions/batch [post]
+func (h *PermissionHandler) CreatePermissionsBatch(c *fiber.Ctx) error {
+	var req CreatePermissionsBatchRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	inputs := make([]service.PermissionInput, len(req.Permissions))
+	for i, item := range req.Permissions {
+		inputs[i] = service.PermissionInput{
+			Name:        item.Name,
+			DisplayName: item.DisplayName,
+			Description: item.Description,
+			Resource:    item.Resource,
+			Action:      item.Action,
+			Category:    item.Category,
+		}
+	}
+
+	permissions, err := h.rbacService.CreatePermissions(c.UserContext(), inputs, auth.MustGetCurrentUserID(c))
+	if err != nil {
+		h.logger.Error("Failed to create permissions", zap.Error(err), zap.Int("count", len(inputs)))
+		return web.ServiceError(c, err, "Failed to create permissions")
+	}
+
+	response := CreatePermissionsBatchResponse{
+		Results: make([]BatchPermissionResult, len(permissions)),
+	}
+	for i, permission := range permissions {
+		if permission == nil {
+			response.Results[i] = BatchPermissionResult{Name: inputs[i].Name, Status: BatchPermissionExists}
+			response.Skipped++
+			continue
+		}
+
+		permissionResponse := toPermissionResponse(permission)
+		response.Results[i] = BatchPermissionResult{
+			Name:       permission.Name,
+			Status:     BatchPermissionCreated,
+			Permission: &permissionResponse,
+		}
+		response.Created++
+	}
+
+	status := fiber.StatusOK
+	if response.Created > 0 {
+		status = fiber.StatusCreated
+	}
+
+	return c.Status(status).JSON(response)
+}
+
+// toPermissionResponse 将权限实体转换为响应
+func toPermissionResponse(permission *entity.Permission) PermissionResponse {
+	return PermissionResponse{
+		ID:          permission.ID,
+		Name:        permission.Name,
+		DisplayName: permission.DisplayName,
+		Description: permission.Description,
+		Resource:    permission.Resource,
+		Action:      permission.Action,
+		Category:    permission.Category,
+		IsSystem:    permission.IsSystem,
+		CreatedBy:   permission.CreatedBy,
+		UpdatedBy:   permission.UpdatedBy,
+		CreatedAt:   permission.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   permission.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
 // GetPermission godoc
 // @Summary      Get Permission
 // @Description  Get permission information by ID
@@ -136,14 +311,14 @@ func (h *PermissionHandler) GetPermission(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid permission ID", "Permission ID must be a valid number"))
 	}
 
-	permission, err := h.rbacService.GetPermissionByID(c.Context(), uint(id))
+	permission, err := h.rbacService.GetPermissionByID(c.UserContext(), uint(id))
 	if err != nil {
 		if err == service.ErrPermissionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Permission not found", "Permission with the given ID does not exist"))
 		}
 
 		h.logger.Error("Failed to get permission", zap.Error(err), zap.Uint("permission_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get permission"))
+		return web.ServiceError(c, err, "Failed to get permission")
 	}
 
 	response := PermissionResponse{
@@ -153,7 +328,10 @@ func (h *PermissionHandler) GetPermission(c *fiber.Ctx) error {
 		Description: permission.Description,
 		Resource:    permission.Resource,
 		Action:      permission.Action,
+		Category:    permission.Category,
 		IsSystem:    permission.IsSystem,
+		CreatedBy:   permission.CreatedBy,
+		UpdatedBy:   permission.UpdatedBy,
 		CreatedAt:   permission.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   permission.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -184,19 +362,18 @@ func (h *PermissionHandler) UpdatePermission(c *fiber.Ctx) error {
 	}
 
 	var req UpdatePermissionRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse update permission request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
-	permission, err := h.rbacService.UpdatePermission(c.Context(), uint(id), req.DisplayName, req.Description)
+	permission, err := h.rbacService.UpdatePermission(c.UserContext(), uint(id), req.DisplayName, req.Description, auth.MustGetCurrentUserID(c))
 	if err != nil {
 		if err == service.ErrPermissionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Permission not found", "Permission with the given ID does not exist"))
 		}
 
 		h.logger.Error("Failed to update permission", zap.Error(err), zap.Uint("permission_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to update permission"))
+		return web.ServiceError(c, err, "Failed to update permission")
 	}
 
 	response := PermissionResponse{
@@ -206,7 +383,10 @@ func (h *PermissionHandler) UpdatePermission(c *fiber.Ctx) error {
 		Description: permission.Description,
 		Resource:    permission.Resource,
 		Action:      permission.Action,
+		Category:    permission.Category,
 		IsSystem:    permission.IsSystem,
+		CreatedBy:   permission.CreatedBy,
+		UpdatedBy:   permission.UpdatedBy,
 		CreatedAt:   permission.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   permission.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -236,7 +416,7 @@ func (h *PermissionHandler) DeletePermission(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid permission ID", "Permission ID must be a valid number"))
 	}
 
-	if err := h.rbacService.DeletePermission(c.Context(), uint(id)); err != nil {
+	if err := h.rbacService.DeletePermission(c.UserContext(), uint(id)); err != nil {
 		if err == service.ErrPermissionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Permission not found", "Permission with the given ID does not exist"))
 		}
@@ -245,7 +425,7 @@ func (h *PermissionHandler) DeletePermission(c *fiber.Ctx) error {
 		}
 
 		h.logger.Error("Failed to delete permission", zap.Error(err), zap.Uint("permission_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to delete permission"))
+		return web.ServiceError(c, err, "Failed to delete permission")
 	}
 
 	return c.Status(fiber.StatusNoContent).Send(nil)
@@ -258,29 +438,25 @@ func (h *PermissionHandler) DeletePermission(c *fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Param        page query int false "Page number" default(1)
-// @Param        limit query int false "Items per page" default(10)
+// @Param        limit query int false "Items per page, values above server.pagination.max_limit are clamped" default(10)
 // @Success      200 {object} ListPermissionsResponse "List of permissions"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /permissions [get]
 func (h *PermissionHandler) ListPermissions(c *fiber.Ctx) error {
-	page := c.QueryInt("page", 1)
-	limit := c.QueryInt("limit", 10)
+	page, limit, offset := h.paginator.Parse(c)
 
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
+	permissions, err := h.rbacService.ListPermissions(c.UserContext(), offset, limit)
+	if err != nil {
+		h.logger.Error("Failed to list permissions", zap.Error(err))
+		return web.ServiceError(c, err, "Failed to list permissions")
 	}
 
-	offset := (page - 1) * limit
-
-	permissions, err := h.rbacService.ListPermissions(c.Context(), offset, limit)
+	total, err := h.rbacService.CountPermissions(c.UserContext())
 	if err != nil {
-		h.logger.Error("Failed to list permissions", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list permissions"))
+		h.logger.Error("Failed to count permissions", zap.Error(err))
+		return web.ServiceError(c, err, "Failed to list permissions")
 	}
 
 	permissionResponses := make([]PermissionResponse, len(permissions))
@@ -292,7 +468,10 @@ func (h *PermissionHandler) ListPermissions(c *fiber.Ctx) error {
 			Description: permission.Description,
 			Resource:    permission.Resource,
 			Action:      permission.Action,
+			Category:    permission.Category,
 			IsSystem:    permission.IsSystem,
+			CreatedBy:   permission.CreatedBy,
+			UpdatedBy:   permission.UpdatedBy,
 			CreatedAt:   permission.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:   permission.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
@@ -300,9 +479,53 @@ func (h *PermissionHandler) ListPermissions(c *fiber.Ctx) error {
 
 	response := ListPermissionsResponse{
 		Permissions: permissionResponses,
-		Total:       len(permissionResponses),
-		Page:        page,
-		Limit:       limit,
+		Pagination:  dto.NewPagination(total, page, limit),
+	}
+
+	return web.List(c, response, response.Pagination)
+}
+
+// ListPermissionsGrouped godoc
+// @Summary      List Permissions Grouped by Category
+// @Description  Get all permissions keyed by category for UI organization
+// @Tags         RBAC Permission Management
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} GroupedPermissionsResponse "Permissions grouped by category"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /permissions/grouped [get]
+func (h *PermissionHandler) ListPermissionsGrouped(c *fiber.Ctx) error {
+	grouped, err := h.rbacService.ListPermissionsGrouped(c.UserContext())
+	if err != nil {
+		h.logger.Error("Failed to list grouped permissions", zap.Error(err))
+		return web.ServiceError(c, err, "Failed to list permissions")
+	}
+
+	response := GroupedPermissionsResponse{
+		Categories: make(map[string][]PermissionResponse, len(grouped)),
+	}
+	for category, permissions := range grouped {
+		permissionResponses := make([]PermissionResponse, len(permissions))
+		for i, permission := range permissions {
+			permissionResponses[i] = PermissionResponse{
+				ID:          permission.ID,
+				Name:        permission.Name,
+				DisplayName: permission.DisplayName,
+				Description: permission.Description,
+				Resource:    permission.Resource,
+				Action:      permission.Action,
+				Category:    permission.Category,
+				IsSystem:    permission.IsSystem,
+				CreatedBy:   permission.CreatedBy,
+				UpdatedBy:   permission.UpdatedBy,
+				CreatedAt:   permission.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+				UpdatedAt:   permission.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			}
+		}
+		response.Categories[category] = permissionResponses
+		response.Total += len(permissionResponses)
 	}
 
 	return c.JSON(response)
@@ -332,9 +555,8 @@ func (h *PermissionHandler) AssignPermissionToRole(c *fiber.Ctx) error {
 	}
 
 	var req AssignPermissionToRoleRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse assign permission request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	// 获取当前用户作为分配者
@@ -344,33 +566,33 @@ func (h *PermissionHandler) AssignPermissionToRole(c *fiber.Ctx) error {
 	}
 
 	// 检查权限是否存在
-	_, err = h.rbacService.GetPermissionByID(c.Context(), uint(permissionID))
+	_, err = h.rbacService.GetPermissionByID(c.UserContext(), uint(permissionID))
 	if err != nil {
 		if err == service.ErrPermissionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Permission not found", "Permission with the given ID does not exist"))
 		}
 		h.logger.Error("Failed to get permission for assignment", zap.Error(err), zap.Uint("permission_id", uint(permissionID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get permission"))
+		return web.ServiceError(c, err, "Failed to get permission")
 	}
 
 	// 检查角色是否存在
-	_, err = h.rbacService.GetRoleByID(c.Context(), req.RoleID)
+	_, err = h.rbacService.GetRoleByID(c.UserContext(), req.RoleID)
 	if err != nil {
 		if err == service.ErrRoleNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
 		}
 		h.logger.Error("Failed to get role for permission assignment", zap.Error(err), zap.Uint("role_id", req.RoleID))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get role"))
+		return web.ServiceError(c, err, "Failed to get role")
 	}
 
 	// 分配权限到角色
-	if err := h.rbacService.AssignPermissionToRole(c.Context(), req.RoleID, uint(permissionID), currentUser.UserID); err != nil {
+	if err := h.rbacService.AssignPermissionToRole(c.UserContext(), req.RoleID, uint(permissionID), currentUser.UserID); err != nil {
 		if err == service.ErrRolePermissionAlreadyExists {
 			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "Permission already assigned", "Role already has this permission"))
 		}
 
 		h.logger.Error("Failed to assign permission to role", zap.Error(err), zap.Uint("role_id", req.RoleID), zap.Uint("permission_id", uint(permissionID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to assign permission"))
+		return web.ServiceError(c, err, "Failed to assign permission")
 	}
 
 	return c.JSON(fiber.Map{
@@ -407,33 +629,33 @@ func (h *PermissionHandler) RemovePermissionFromRole(c *fiber.Ctx) error {
 	}
 
 	// 检查权限是否存在
-	_, err = h.rbacService.GetPermissionByID(c.Context(), uint(permissionID))
+	_, err = h.rbacService.GetPermissionByID(c.UserContext(), uint(permissionID))
 	if err != nil {
 		if err == service.ErrPermissionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Permission not found", "Permission with the given ID does not exist"))
 		}
 		h.logger.Error("Failed to get permission for removal", zap.Error(err), zap.Uint("permission_id", uint(permissionID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get permission"))
+		return web.ServiceError(c, err, "Failed to get permission")
 	}
 
 	// 检查角色是否存在
-	_, err = h.rbacService.GetRoleByID(c.Context(), uint(roleID))
+	_, err = h.rbacService.GetRoleByID(c.UserContext(), uint(roleID))
 	if err != nil {
 		if err == service.ErrRoleNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
 		}
 		h.logger.Error("Failed to get role for permission removal", zap.Error(err), zap.Uint("role_id", uint(roleID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get role"))
+		return web.ServiceError(c, err, "Failed to get role")
 	}
 
 	// 移除角色的权限
-	if err := h.rbacService.RemovePermissionFromRole(c.Context(), uint(roleID), uint(permissionID)); err != nil {
+	if err := h.rbacService.RemovePermissionFromRole(c.UserContext(), uint(roleID), uint(permissionID)); err != nil {
 		if err == service.ErrRolePermissionNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role permission not found", "Role does not have this permission"))
 		}
 
 		h.logger.Error("Failed to remove permission from role", zap.Error(err), zap.Uint("role_id", uint(roleID)), zap.Uint("permission_id", uint(permissionID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to remove permission"))
+		return web.ServiceError(c, err, "Failed to remove permission")
 	}
 
 	return c.JSON(fiber.Map{
@@ -463,19 +685,19 @@ func (h *PermissionHandler) GetRolePermissions(c *fiber.Ctx) error {
 	}
 
 	// 检查角色是否存在
-	_, err = h.rbacService.GetRoleByID(c.Context(), uint(roleID))
+	_, err = h.rbacService.GetRoleByID(c.UserContext(), uint(roleID))
 	if err != nil {
 		if err == service.ErrRoleNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
 		}
 		h.logger.Error("Failed to get role for permissions", zap.Error(err), zap.Uint("role_id", uint(roleID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get role"))
+		return web.ServiceError(c, err, "Failed to get role")
 	}
 
-	permissions, err := h.rbacService.GetRolePermissions(c.Context(), uint(roleID))
+	permissions, err := h.rbacService.GetRolePermissions(c.UserContext(), uint(roleID))
 	if err != nil {
 		h.logger.Error("Failed to get role permissions", zap.Error(err), zap.Uint("role_id", uint(roleID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get role permissions"))
+		return web.ServiceError(c, err, "Failed to get role permissions")
 	}
 
 	permissionResponses := make([]PermissionResponse, len(permissions))
@@ -487,7 +709,10 @@ func (h *PermissionHandler) GetRolePermissions(c *fiber.Ctx) error {
 			Description: permission.Description,
 			Resource:    permission.Resource,
 			Action:      permission.Action,
+			Category:    permission.Category,
 			IsSystem:    permission.IsSystem,
+			CreatedBy:   permission.CreatedBy,
+			UpdatedBy:   permission.UpdatedBy,
 			CreatedAt:   permission.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:   permission.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
@@ -518,10 +743,10 @@ func (h *PermissionHandler) GetUserPermissions(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
 	}
 
-	permissions, err := h.rbacService.GetUserPermissions(c.Context(), uint(userID))
+	permissions, err := h.rbacService.GetUserPermissions(c.UserContext(), uint(userID))
 	if err != nil {
 		h.logger.Error("Failed to get user permissions", zap.Error(err), zap.Uint("user_id", uint(userID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get user permissions"))
+		return web.ServiceError(c, err, "Failed to get user permissions")
 	}
 
 	permissionResponses := make([]PermissionResponse, len(permissions))
@@ -533,7 +758,10 @@ func (h *PermissionHandler) GetUserPermissions(c *fiber.Ctx) error {
 			Description: permission.Description,
 			Resource:    permission.Resource,
 			Action:      permission.Action,
+			Category:    permission.Category,
 			IsSystem:    permission.IsSystem,
+			CreatedBy:   permission.CreatedBy,
+			UpdatedBy:   permission.UpdatedBy,
 			CreatedAt:   permission.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:   permission.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
@@ -543,3 +771,44 @@ func (h *PermissionHandler) GetUserPermissions(c *fiber.Ctx) error {
 		"permissions": permissionResponses,
 	})
 }
+
+// GetPermissionUsers godoc
+// @Summary      Get Users With Permission
+// @Description  List the users holding a permission through any of their active roles, including wildcard grants. Users granted it by several roles appear once
+// @Tags         RBAC Permission Management
+// @Accept       json
+// @Produce      json
+// @Param        resource query string true "Permission resource, e.g. user"
+// @Param        action query string true "Permission action, e.g. delete"
+// @Success      200 {object} PermissionUsersResponse "Users holding the permission"
+// @Failure      400 {object} errors.APIError "Missing resource or action"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Forbidden"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /permissions/by-action/users [get]
+func (h *PermissionHandler) GetPermissionUsers(c *fiber.Ctx) error {
+	resource := c.Query("resource")
+	action := c.Query("action")
+	if resource == "" || action == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "resource and action query parameters are required"))
+	}
+
+	users, err := h.rbacService.GetUsersWithPermission(c.UserContext(), resource, action)
+	if err != nil {
+		h.logger.Error("Failed to get permission users", zap.Error(err), zap.String("resource", resource), zap.String("action", action))
+		return web.ServiceError(c, err, "Failed to get permission users")
+	}
+
+	userResponses := make([]UserResponse, len(users))
+	for i, user := range users {
+		userResponses[i] = newAdminUserResponse(user)
+	}
+
+	return c.JSON(PermissionUsersResponse{
+		Resource: resource,
+		Action:   action,
+		Users:    userResponses,
+		Total:    len(userResponses),
+	})
+}
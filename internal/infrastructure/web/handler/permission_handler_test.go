@@ -0,0 +1,84 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+func TestPermissionHandler_CreatePermissionsBatchSkipsExisting(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+
+	const adminID uint = 1
+	existing, err := rbacService.CreatePermission(ctx, "report:read", "Read Reports", "", "report", "read", entity.PermissionCategoryOther, false, adminID)
+	if err != nil {
+		t.Fatalf("CreatePermission() error = %v", err)
+	}
+
+	permissionHandler := handler.NewPermissionHandler(rbacService, nil, zap.NewNop())
+	app := fiber.New()
+	app.Post("/permissions/batch",
+		func(c *fiber.Ctx) error {
+			c.Locals(auth.UserIDContextKey, adminID)
+			return c.Next()
+		},
+		permissionHandler.CreatePermissionsBatch,
+	)
+
+	body := `{"permissions":[
+		{"name":"report:read","display_name":"Read Reports","resource":"report","action":"read"},
+		{"name":"report:write","display_name":"Write Reports","resource":"report","action":"write"},
+		{"name":"report:delete","display_name":"Delete Reports","resource":"report","action":"delete"},
+		{"name":"report:manage","display_name":"Manage Reports","resource":"report","action":"manage"}
+	]}`
+	req := httptest.NewRequest(fiber.MethodPost, "/permissions/batch", strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	if resp.StatusCode != fiber.StatusCreated {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, fiber.StatusCreated)
+	}
+
+	var result handler.CreatePermissionsBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if result.Created != 3 || result.Skipped != 1 || len(result.Results) != 4 {
+		t.Fatalf("created = %d, skipped = %d, results = %d; want 3, 1, 4", result.Created, result.Skipped, len(result.Results))
+	}
+
+	wantStatus := []string{handler.BatchPermissionExists, handler.BatchPermissionCreated, handler.BatchPermissionCreated, handler.BatchPermissionCreated}
+	for i, item := range result.Results {
+		if item.Status != wantStatus[i] {
+			t.Errorf("results[%d] (%s) status = %q, want %q", i, item.Name, item.Status, wantStatus[i])
+		}
+		if (item.Permission != nil) != (wantStatus[i] == handler.BatchPermissionCreated) {
+			t.Errorf("results[%d] (%s) permission = %+v", i, item.Name, item.Permission)
+		}
+	}
+
+	// 已存在的权限保持原样，新权限全部写入
+	for _, name := range []string{"report:read", "report:write", "report:delete", "report:manage"} {
+		permission, err := rbacService.GetPermissionByName(ctx, name)
+		if err != nil {
+			t.Fatalf("GetPermissionByName(%s) error = %v", name, err)
+		}
+		if name == "report:read" && permission.ID != existing.ID {
+			t.Errorf("report:read was recreated with ID %d, want %d", permission.ID, existing.ID)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/internal/pkg/push"
+	"nebula-live/pkg/auth"
+	apierrors "nebula-live/pkg/errors"
+	"nebula-live/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// RecurringPushHandler 周期推送处理器
+type RecurringPushHandler struct {
+	recurringPushService service.RecurringPushService
+	paginator            *Paginator
+}
+
+// NewRecurringPushHandler 创建周期推送处理器
+func NewRecurringPushHandler(recurringPushService service.RecurringPushService, paginator *Paginator) *RecurringPushHandler {
+	return &RecurringPushHandler{
+		recurringPushService: recurringPushService,
+		paginator:            paginator,
+	}
+}
+
+// CreateRecurringPush godoc
+// @Summary      Create Recurring Push
+// @Description  Create a push notification sent to current user's devices on a cron schedule
+// @Tags         Recurring Push
+// @Accept       json
+// @Produce      json
+// @Param        recurringPush body dto.RecurringPushRequest true "Recurring push data"
+// @Success      201 {object} dto.RecurringPushResponse "Recurring push created successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters, validation failed or invalid cron expression"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/recurring [post]
+func (h *RecurringPushHandler) CreateRecurringPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	var req dto.RecurringPushRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	recurringPush, err := h.recurringPushService.CreateRecurringPush(c.UserContext(), userID, toRecurringPushEntity(&req))
+	if err != nil {
+		return h.handleError(c, err, "Failed to create recurring push")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toRecurringPushResponse(recurringPush))
+}
+
+// GetRecurringPushes godoc
+// @Summary      Get Recurring Pushes
+// @Description  Get current user's recurring push notifications with pagination
+// @Tags         Recurring Push
+// @Accept       json
+// @Produce      json
+// @Param        page query int false "Page number" default(1)
+// @Param        limit query int false "Items per page, values above server.pagination.max_limit are clamped" default(10)
+// @Success      200 {object} dto.ListResponse[dto.RecurringPushResponse] "List of user's recurring pushes"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/recurring [get]
+func (h *RecurringPushHandler) GetRecurringPushes(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	page, limit, _ := h.paginator.Parse(c)
+
+	recurringPushes, total, err := h.recurringPushService.ListRecurringPushes(c.UserContext(), userID, page, limit)
+	if err != nil {
+		logger.Error("Failed to list recurring pushes",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list recurring pushes"),
+		)
+	}
+
+	data := make([]dto.RecurringPushResponse, len(recurringPushes))
+	for i, recurringPush := range recurringPushes {
+		data[i] = toRecurringPushResponse(recurringPush)
+	}
+
+	pagination := dto.NewPagination(total, page, limit)
+	return web.List(c, dto.ListResponse[dto.RecurringPushResponse]{
+		Data:       data,
+		Pagination: pagination,
+	}, pagination)
+}
+
+// GetRecurringPush godoc
+// @Summary      Get Recurring Push
+// @Description  Get a specific recurring push notification by ID
+// @Tags         Recurring Push
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Recurring push ID"
+// @Success      200 {object} dto.RecurringPushResponse "Recurring push retrieved successfully"
+// @Failure      400 {object} errors.APIError "Invalid recurring push ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Recurring push not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/recurring/{id} [get]
+func (h *RecurringPushHandler) GetRecurringPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid recurring push ID"),
+		)
+	}
+
+	recurringPush, err := h.recurringPushService.GetRecurringPush(c.UserContext(), userID, uint(id))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get recurring push")
+	}
+
+	return c.JSON(toRecurringPushResponse(recurringPush))
+}
+
+// UpdateRecurringPush godoc
+// @Summary      Update Recurring Push
+// @Description  Update a recurring push notification, recomputing its next run time
+// @Tags         Recurring Push
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Recurring push ID"
+// @Param        recurringPush body dto.RecurringPushRequest true "Recurring push data"
+// @Success      200 {object} dto.RecurringPushResponse "Recurring push updated successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters, validation failed or invalid cron expression"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Recurring push not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/recurring/{id} [put]
+func (h *RecurringPushHandler) UpdateRecurringPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid recurring push ID"),
+		)
+	}
+
+	var req dto.RecurringPushRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	recurringPush := toRecurringPushEntity(&req)
+	recurringPush.ID = uint(id)
+
+	updated, err := h.recurringPushService.UpdateRecurringPush(c.UserContext(), userID, recurringPush)
+	if err != nil {
+		return h.handleError(c, err, "Failed to update recurring push")
+	}
+
+	return c.JSON(toRecurringPushResponse(updated))
+}
+
+// DeleteRecurringPush godoc
+// @Summary      Delete Recurring Push
+// @Description  Delete a recurring push notification
+// @Tags         Recurring Push
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Recurring push ID"
+// @Success      200 {object} map[string]string "Recurring push deleted successfully"
+// @Failure      400 {object} errors.APIError "Invalid recurring push ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Recurring push not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/recurring/{id} [delete]
+func (h *RecurringPushHandler) DeleteRecurringPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid recurring push ID"),
+		)
+	}
+
+	if err := h.recurringPushService.DeleteRecurringPush(c.UserContext(), userID, uint(id)); err != nil {
+		return h.handleError(c, err, "Failed to delete recurring push")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Recurring push deleted successfully",
+	})
+}
+
+// handleError 将周期推送服务错误映射为HTTP响应
+func (h *RecurringPushHandler) handleError(c *fiber.Ctx, err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrRecurringPushNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(
+			apierrors.NewAPIError(fiber.StatusNotFound, "Recurring push not found", "Recurring push not found"),
+		)
+	case errors.Is(err, service.ErrInvalidCronExpression):
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid cron expression", err.Error()),
+		)
+	case errors.Is(err, service.ErrInvalidRecurringPush), errors.Is(err, push.ErrURLSchemeNotAllowed):
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error()),
+		)
+	default:
+		logger.Error(message, zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", message),
+		)
+	}
+}
+
+// toRecurringPushEntity 将请求转换为周期推送实体
+func toRecurringPushEntity(req *dto.RecurringPushRequest) *entity.RecurringPush {
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	return &entity.RecurringPush{
+		CronExpr: req.CronExpr,
+		Provider: req.Provider,
+		Title:    req.Title,
+		Body:     req.Body,
+		URL:      req.URL,
+		Sound:    req.Sound,
+		Icon:     req.Icon,
+		Group:    req.Group,
+		Level:    string(req.Level),
+		Enabled:  enabled,
+	}
+}
+
+// toRecurringPushResponse 将周期推送实体转换为响应
+func toRecurringPushResponse(recurringPush *entity.RecurringPush) dto.RecurringPushResponse {
+	return dto.RecurringPushResponse{
+		ID:        recurringPush.ID,
+		UserID:    recurringPush.UserID,
+		CronExpr:  recurringPush.CronExpr,
+		Provider:  recurringPush.Provider,
+		Title:     recurringPush.Title,
+		Body:      recurringPush.Body,
+		URL:       recurringPush.URL,
+		Sound:     recurringPush.Sound,
+		Icon:      recurringPush.Icon,
+		Group:     recurringPush.Group,
+		Level:     recurringPush.Level,
+		Enabled:   recurringPush.Enabled,
+		NextRunAt: recurringPush.NextRunAt,
+		LastRunAt: recurringPush.LastRunAt,
+		CreatedAt: recurringPush.CreatedAt,
+		UpdatedAt: recurringPush.UpdatedAt,
+	}
+}
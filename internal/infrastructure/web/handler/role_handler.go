@@ -2,8 +2,11 @@ package handler
 
 import (
 	"strconv"
+	"time"
 
 	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
 	"nebula-live/pkg/auth"
 	"nebula-live/pkg/errors"
 
@@ -15,14 +18,16 @@ import (
 type RoleHandler struct {
 	rbacService service.RBACService
 	userService service.UserService
+	paginator   *Paginator
 	logger      *zap.Logger
 }
 
 // NewRoleHandler 创建角色处理器实例
-func NewRoleHandler(rbacService service.RBACService, userService service.UserService, logger *zap.Logger) *RoleHandler {
+func NewRoleHandler(rbacService service.RBACService, userService service.UserService, paginator *Paginator, logger *zap.Logger) *RoleHandler {
 	return &RoleHandler{
 		rbacService: rbacService,
 		userService: userService,
+		paginator:   paginator,
 		logger:      logger,
 	}
 }
@@ -40,9 +45,30 @@ type UpdateRoleRequest struct {
 	Description string `json:"description" validate:"max=500"`
 }
 
+// PatchRoleRequest 部分更新角色请求，省略的字段保持不变，显式传入空字符串会清空描述
+type PatchRoleRequest struct {
+	DisplayName *string `json:"display_name,omitempty" validate:"omitempty,min=2,max=100"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=500"`
+}
+
+// Validate 验证部分更新角色请求
+func (r *PatchRoleRequest) Validate() error {
+	var errs dto.ValidationErrors
+
+	if r.DisplayName != nil && (len(*r.DisplayName) < 2 || len(*r.DisplayName) > 100) {
+		errs.Add("display_name", "must be between 2 and 100 characters")
+	}
+	if r.Description != nil && len(*r.Description) > 500 {
+		errs.Add("description", "must not exceed 500 characters")
+	}
+
+	return errs.Err()
+}
+
 // AssignRoleRequest 分配角色请求
 type AssignRoleRequest struct {
-	UserID uint `json:"user_id" validate:"required,min=1"`
+	UserID    uint       `json:"user_id" validate:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // 可选，分配在该时间后失效，需晚于当前时间
 }
 
 // RoleResponse 角色响应
@@ -52,6 +78,8 @@ type RoleResponse struct {
 	DisplayName string `json:"display_name"`
 	Description string `json:"description"`
 	IsSystem    bool   `json:"is_system"`
+	CreatedBy   *uint  `json:"created_by"` // 创建者的用户ID，系统初始化时为null
+	UpdatedBy   *uint  `json:"updated_by"` // 最后修改者的用户ID，未修改过时为null
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
@@ -59,9 +87,7 @@ type RoleResponse struct {
 // ListRolesResponse 角色列表响应
 type ListRolesResponse struct {
 	Roles []RoleResponse `json:"roles"`
-	Total int            `json:"total"`
-	Page  int            `json:"page"`
-	Limit int            `json:"limit"`
+	dto.Pagination
 }
 
 // CreateRole godoc
@@ -80,14 +106,13 @@ type ListRolesResponse struct {
 // @Router       /roles [post]
 func (h *RoleHandler) CreateRole(c *fiber.Ctx) error {
 	var req CreateRoleRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse create role request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	// TODO: 添加请求验证
 
-	role, err := h.rbacService.CreateRole(c.Context(), req.Name, req.DisplayName, req.Description, false)
+	role, err := h.rbacService.CreateRole(c.UserContext(), req.Name, req.DisplayName, req.Description, false, auth.MustGetCurrentUserID(c))
 	if err != nil {
 		h.logger.Error("Failed to create role", zap.Error(err))
 
@@ -95,7 +120,7 @@ func (h *RoleHandler) CreateRole(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "Role already exists", "A role with this name already exists"))
 		}
 
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to create role"))
+		return web.ServiceError(c, err, "Failed to create role")
 	}
 
 	response := RoleResponse{
@@ -104,6 +129,8 @@ func (h *RoleHandler) CreateRole(c *fiber.Ctx) error {
 		DisplayName: role.DisplayName,
 		Description: role.Description,
 		IsSystem:    role.IsSystem,
+		CreatedBy:   role.CreatedBy,
+		UpdatedBy:   role.UpdatedBy,
 		CreatedAt:   role.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   role.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -132,14 +159,14 @@ func (h *RoleHandler) GetRole(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid role ID", "Role ID must be a valid number"))
 	}
 
-	role, err := h.rbacService.GetRoleByID(c.Context(), uint(id))
+	role, err := h.rbacService.GetRoleByID(c.UserContext(), uint(id))
 	if err != nil {
 		if err == service.ErrRoleNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
 		}
 
 		h.logger.Error("Failed to get role", zap.Error(err), zap.Uint("role_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get role"))
+		return web.ServiceError(c, err, "Failed to get role")
 	}
 
 	response := RoleResponse{
@@ -148,6 +175,8 @@ func (h *RoleHandler) GetRole(c *fiber.Ctx) error {
 		DisplayName: role.DisplayName,
 		Description: role.Description,
 		IsSystem:    role.IsSystem,
+		CreatedBy:   role.CreatedBy,
+		UpdatedBy:   role.UpdatedBy,
 		CreatedAt:   role.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   role.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -178,19 +207,18 @@ func (h *RoleHandler) UpdateRole(c *fiber.Ctx) error {
 	}
 
 	var req UpdateRoleRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse update role request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
-	role, err := h.rbacService.UpdateRole(c.Context(), uint(id), req.DisplayName, req.Description)
+	role, err := h.rbacService.UpdateRole(c.UserContext(), uint(id), req.DisplayName, req.Description, auth.MustGetCurrentUserID(c))
 	if err != nil {
 		if err == service.ErrRoleNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
 		}
 
 		h.logger.Error("Failed to update role", zap.Error(err), zap.Uint("role_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to update role"))
+		return web.ServiceError(c, err, "Failed to update role")
 	}
 
 	response := RoleResponse{
@@ -199,6 +227,79 @@ func (h *RoleHandler) UpdateRole(c *fiber.Ctx) error {
 		DisplayName: role.DisplayName,
 		Description: role.Description,
 		IsSystem:    role.IsSystem,
+		CreatedBy:   role.CreatedBy,
+		UpdatedBy:   role.UpdatedBy,
+		CreatedAt:   role.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   role.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	return c.JSON(response)
+}
+
+// PatchRole godoc
+// @Summary      Partially Update Role
+// @Description  Update only the provided role fields; an explicit empty description clears it
+// @Tags         RBAC Role Management
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Role ID"
+// @Param        role body PatchRoleRequest true "Fields to update"
+// @Success      200 {object} RoleResponse "Role updated successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Role not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /roles/{id} [patch]
+func (h *RoleHandler) PatchRole(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid role ID", "Role ID must be a valid number"))
+	}
+
+	var req PatchRoleRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	role, err := h.rbacService.GetRoleByID(c.UserContext(), uint(id))
+	if err != nil {
+		if err == service.ErrRoleNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
+		}
+
+		h.logger.Error("Failed to get role for patch", zap.Error(err), zap.Uint("role_id", uint(id)))
+		return web.ServiceError(c, err, "Failed to get role")
+	}
+
+	// 未提供的字段沿用当前值
+	displayName, description := role.DisplayName, role.Description
+	if req.DisplayName != nil {
+		displayName = *req.DisplayName
+	}
+	if req.Description != nil {
+		description = *req.Description
+	}
+
+	role, err = h.rbacService.UpdateRole(c.UserContext(), uint(id), displayName, description, auth.MustGetCurrentUserID(c))
+	if err != nil {
+		if err == service.ErrRoleNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
+		}
+
+		h.logger.Error("Failed to patch role", zap.Error(err), zap.Uint("role_id", uint(id)))
+		return web.ServiceError(c, err, "Failed to update role")
+	}
+
+	response := RoleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		DisplayName: role.DisplayName,
+		Description: role.Description,
+		IsSystem:    role.IsSystem,
+		CreatedBy:   role.CreatedBy,
+		UpdatedBy:   role.UpdatedBy,
 		CreatedAt:   role.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   role.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -228,7 +329,7 @@ func (h *RoleHandler) DeleteRole(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid role ID", "Role ID must be a valid number"))
 	}
 
-	if err := h.rbacService.DeleteRole(c.Context(), uint(id)); err != nil {
+	if err := h.rbacService.DeleteRole(c.UserContext(), uint(id)); err != nil {
 		if err == service.ErrRoleNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
 		}
@@ -237,7 +338,7 @@ func (h *RoleHandler) DeleteRole(c *fiber.Ctx) error {
 		}
 
 		h.logger.Error("Failed to delete role", zap.Error(err), zap.Uint("role_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to delete role"))
+		return web.ServiceError(c, err, "Failed to delete role")
 	}
 
 	return c.Status(fiber.StatusNoContent).Send(nil)
@@ -250,29 +351,25 @@ func (h *RoleHandler) DeleteRole(c *fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Param        page query int false "Page number" default(1)
-// @Param        limit query int false "Items per page" default(10)
+// @Param        limit query int false "Items per page, values above server.pagination.max_limit are clamped" default(10)
 // @Success      200 {object} ListRolesResponse "List of roles"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /roles [get]
 func (h *RoleHandler) ListRoles(c *fiber.Ctx) error {
-	page := c.QueryInt("page", 1)
-	limit := c.QueryInt("limit", 10)
+	page, limit, offset := h.paginator.Parse(c)
 
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
+	roles, err := h.rbacService.ListRoles(c.UserContext(), offset, limit)
+	if err != nil {
+		h.logger.Error("Failed to list roles", zap.Error(err))
+		return web.ServiceError(c, err, "Failed to list roles")
 	}
 
-	offset := (page - 1) * limit
-
-	roles, err := h.rbacService.ListRoles(c.Context(), offset, limit)
+	total, err := h.rbacService.CountRoles(c.UserContext())
 	if err != nil {
-		h.logger.Error("Failed to list roles", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list roles"))
+		h.logger.Error("Failed to count roles", zap.Error(err))
+		return web.ServiceError(c, err, "Failed to list roles")
 	}
 
 	roleResponses := make([]RoleResponse, len(roles))
@@ -283,24 +380,24 @@ func (h *RoleHandler) ListRoles(c *fiber.Ctx) error {
 			DisplayName: role.DisplayName,
 			Description: role.Description,
 			IsSystem:    role.IsSystem,
+			CreatedBy:   role.CreatedBy,
+			UpdatedBy:   role.UpdatedBy,
 			CreatedAt:   role.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:   role.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
 	}
 
 	response := ListRolesResponse{
-		Roles: roleResponses,
-		Total: len(roleResponses),
-		Page:  page,
-		Limit: limit,
+		Roles:      roleResponses,
+		Pagination: dto.NewPagination(total, page, limit),
 	}
 
-	return c.JSON(response)
+	return web.List(c, response, response.Pagination)
 }
 
 // AssignRole godoc
 // @Summary      Assign Role to User
-// @Description  Assign a role to a user
+// @Description  Assign a role to a user, optionally until expires_at after which the assignment no longer grants the role
 // @Tags         RBAC Role Management
 // @Accept       json
 // @Produce      json
@@ -322,9 +419,8 @@ func (h *RoleHandler) AssignRole(c *fiber.Ctx) error {
 	}
 
 	var req AssignRoleRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse assign role request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	// 获取当前用户作为分配者
@@ -334,26 +430,29 @@ func (h *RoleHandler) AssignRole(c *fiber.Ctx) error {
 	}
 
 	// 检查角色是否存在
-	role, err := h.rbacService.GetRoleByID(c.Context(), uint(roleID))
+	role, err := h.rbacService.GetRoleByID(c.UserContext(), uint(roleID))
 	if err != nil {
 		if err == service.ErrRoleNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
 		}
 		h.logger.Error("Failed to get role for assignment", zap.Error(err), zap.Uint("role_id", uint(roleID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get role"))
+		return web.ServiceError(c, err, "Failed to get role")
 	}
 
 	// 使用用户服务分配角色
-	if err := h.userService.AssignRole(c.Context(), req.UserID, role.Name, currentUser.UserID); err != nil {
+	if err := h.userService.AssignRole(c.UserContext(), req.UserID, role.Name, currentUser.UserID, req.ExpiresAt); err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
 		if err == service.ErrUserRoleAlreadyExists {
 			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "Role already assigned", "User already has this role"))
 		}
+		if err == service.ErrInvalidRoleExpiry {
+			return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid expiry", "expires_at must be in the future"))
+		}
 
 		h.logger.Error("Failed to assign role to user", zap.Error(err), zap.Uint("user_id", req.UserID), zap.Uint("role_id", uint(roleID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to assign role"))
+		return web.ServiceError(c, err, "Failed to assign role")
 	}
 
 	return c.JSON(fiber.Map{
@@ -390,17 +489,17 @@ func (h *RoleHandler) RemoveRole(c *fiber.Ctx) error {
 	}
 
 	// 检查角色是否存在
-	role, err := h.rbacService.GetRoleByID(c.Context(), uint(roleID))
+	role, err := h.rbacService.GetRoleByID(c.UserContext(), uint(roleID))
 	if err != nil {
 		if err == service.ErrRoleNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "Role with the given ID does not exist"))
 		}
 		h.logger.Error("Failed to get role for removal", zap.Error(err), zap.Uint("role_id", uint(roleID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get role"))
+		return web.ServiceError(c, err, "Failed to get role")
 	}
 
 	// 使用用户服务移除角色
-	if err := h.userService.RemoveRole(c.Context(), uint(userID), role.Name); err != nil {
+	if err := h.userService.RemoveRole(c.UserContext(), uint(userID), role.Name); err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
@@ -409,7 +508,7 @@ func (h *RoleHandler) RemoveRole(c *fiber.Ctx) error {
 		}
 
 		h.logger.Error("Failed to remove role from user", zap.Error(err), zap.Uint("user_id", uint(userID)), zap.Uint("role_id", uint(roleID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to remove role"))
+		return web.ServiceError(c, err, "Failed to remove role")
 	}
 
 	return c.JSON(fiber.Map{
@@ -438,14 +537,14 @@ func (h *RoleHandler) GetUserRoles(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
 	}
 
-	roles, err := h.userService.GetUserRoles(c.Context(), uint(userID))
+	roles, err := h.userService.GetUserRoles(c.UserContext(), uint(userID))
 	if err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
 
 		h.logger.Error("Failed to get user roles", zap.Error(err), zap.Uint("user_id", uint(userID)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get user roles"))
+		return web.ServiceError(c, err, "Failed to get user roles")
 	}
 
 	roleResponses := make([]RoleResponse, len(roles))
@@ -456,6 +555,8 @@ func (h *RoleHandler) GetUserRoles(c *fiber.Ctx) error {
 			DisplayName: role.DisplayName,
 			Description: role.Description,
 			IsSystem:    role.IsSystem,
+			CreatedBy:   role.CreatedBy,
+			UpdatedBy:   role.UpdatedBy,
 			CreatedAt:   role.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:   role.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
@@ -0,0 +1,116 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+func TestRoleHandler_CreateRoleRecordsActingAdmin(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	admin, err := userService.CreateUser(ctx, "grace", "grace@example.com", "Password123!", "Grace")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	roleHandler := handler.NewRoleHandler(rbacService, userService, nil, zap.NewNop())
+	app := fiber.New()
+	app.Post("/roles",
+		func(c *fiber.Ctx) error {
+			c.Locals(auth.AuthContextKey, &auth.UserClaims{UserID: admin.ID, Username: admin.Username})
+			c.Locals(auth.UserIDContextKey, admin.ID)
+			return c.Next()
+		},
+		roleHandler.CreateRole,
+	)
+
+	req := httptest.NewRequest(fiber.MethodPost, "/roles", strings.NewReader(`{"name":"moderator","display_name":"Moderator"}`))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	if resp.StatusCode != fiber.StatusCreated {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, fiber.StatusCreated)
+	}
+
+	var created handler.RoleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if created.CreatedBy == nil || *created.CreatedBy != admin.ID {
+		t.Errorf("response created_by = %v, want %d", created.CreatedBy, admin.ID)
+	}
+	if created.UpdatedBy != nil {
+		t.Errorf("response updated_by = %d, want nil for a new role", *created.UpdatedBy)
+	}
+
+	stored, err := rbacService.GetRoleByName(ctx, "moderator")
+	if err != nil {
+		t.Fatalf("GetRoleByName() error = %v", err)
+	}
+	if stored.CreatedBy == nil || *stored.CreatedBy != admin.ID {
+		t.Errorf("stored created_by = %v, want %d", stored.CreatedBy, admin.ID)
+	}
+
+	// 系统初始化的角色没有创建者
+	system, err := rbacService.GetRoleByName(ctx, "admin")
+	if err != nil {
+		t.Fatalf("GetRoleByName(admin) error = %v", err)
+	}
+	if system.CreatedBy != nil {
+		t.Errorf("system role created_by = %d, want nil", *system.CreatedBy)
+	}
+}
+
+func TestRoleHandler_PatchRole(t *testing.T) {
+	ctx := context.Background()
+	env := newPatchTestEnv(t)
+	role, err := env.rbac.CreateRole(ctx, "moderator", "Moderator", "Moderates chat", false, env.admin.ID)
+	if err != nil {
+		t.Fatalf("CreateRole() error = %v", err)
+	}
+	path := fmt.Sprintf("/roles/%d", role.ID)
+
+	if status := env.patch(t, path, `{"display_name":"Chat Moderator"}`); status != fiber.StatusOK {
+		t.Fatalf("PATCH display_name status = %d, want %d", status, fiber.StatusOK)
+	}
+	got, err := env.rbac.GetRoleByID(ctx, role.ID)
+	if err != nil {
+		t.Fatalf("GetRoleByID() error = %v", err)
+	}
+	if got.DisplayName != "Chat Moderator" || got.Description != "Moderates chat" {
+		t.Errorf("after PATCH display_name = {%q, %q}, want the description unchanged", got.DisplayName, got.Description)
+	}
+
+	// 显式传入空描述会清空描述
+	if status := env.patch(t, path, `{"description":""}`); status != fiber.StatusOK {
+		t.Fatalf("PATCH empty description status = %d, want %d", status, fiber.StatusOK)
+	}
+	got, err = env.rbac.GetRoleByID(ctx, role.ID)
+	if err != nil {
+		t.Fatalf("GetRoleByID() error = %v", err)
+	}
+	if got.DisplayName != "Chat Moderator" || got.Description != "" {
+		t.Errorf("after PATCH description=\"\" = {%q, %q}, want an empty description", got.DisplayName, got.Description)
+	}
+
+	// 显示名称不能清空
+	if status := env.patch(t, path, `{"display_name":""}`); status != fiber.StatusBadRequest {
+		t.Errorf("PATCH empty display_name status = %d, want %d", status, fiber.StatusBadRequest)
+	}
+}
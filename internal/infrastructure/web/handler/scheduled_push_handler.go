@@ -0,0 +1,346 @@
+package handler
+
+import (
+	"strconv"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/internal/pkg/push"
+	"nebula-live/pkg/auth"
+	apierrors "nebula-live/pkg/errors"
+	"nebula-live/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// ScheduledPushHandler 定时推送处理器
+type ScheduledPushHandler struct {
+	scheduledPushService service.ScheduledPushService
+	paginator            *Paginator
+}
+
+// NewScheduledPushHandler 创建定时推送处理器
+func NewScheduledPushHandler(scheduledPushService service.ScheduledPushService, paginator *Paginator) *ScheduledPushHandler {
+	return &ScheduledPushHandler{
+		scheduledPushService: scheduledPushService,
+		paginator:            paginator,
+	}
+}
+
+// CreateScheduledPush godoc
+// @Summary      Create Scheduled Push
+// @Description  Schedule a push notification to be sent to current user's devices at a later time
+// @Tags         Scheduled Push
+// @Accept       json
+// @Produce      json
+// @Param        scheduledPush body dto.ScheduledPushRequest true "Scheduled push data"
+// @Success      201 {object} dto.ScheduledPushResponse "Scheduled push created successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/scheduled [post]
+func (h *ScheduledPushHandler) CreateScheduledPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	var req dto.ScheduledPushRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	scheduledPush, err := h.scheduledPushService.CreateScheduledPush(c.UserContext(), userID, toScheduledPushEntity(&req))
+	if err != nil {
+		logger.Error("Failed to create scheduled push",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+
+		switch err {
+		case service.ErrInvalidScheduledPush, service.ErrInvalidSendTime, push.ErrURLSchemeNotAllowed:
+			return c.Status(fiber.StatusBadRequest).JSON(
+				apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error()),
+			)
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(
+				apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to create scheduled push"),
+			)
+		}
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(toScheduledPushResponse(scheduledPush))
+}
+
+// GetScheduledPushes godoc
+// @Summary      Get Scheduled Pushes
+// @Description  Get current user's scheduled push notifications with pagination
+// @Tags         Scheduled Push
+// @Accept       json
+// @Produce      json
+// @Param        page query int false "Page number" default(1)
+// @Param        limit query int false "Items per page, values above server.pagination.max_limit are clamped" default(10)
+// @Success      200 {object} dto.ListResponse[dto.ScheduledPushResponse] "List of user's scheduled pushes"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/scheduled [get]
+func (h *ScheduledPushHandler) GetScheduledPushes(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	page, limit, _ := h.paginator.Parse(c)
+
+	scheduledPushes, total, err := h.scheduledPushService.ListScheduledPushes(c.UserContext(), userID, page, limit)
+	if err != nil {
+		logger.Error("Failed to list scheduled pushes",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list scheduled pushes"),
+		)
+	}
+
+	data := make([]dto.ScheduledPushResponse, len(scheduledPushes))
+	for i, scheduledPush := range scheduledPushes {
+		data[i] = toScheduledPushResponse(scheduledPush)
+	}
+
+	pagination := dto.NewPagination(total, page, limit)
+	return web.List(c, dto.ListResponse[dto.ScheduledPushResponse]{
+		Data:       data,
+		Pagination: pagination,
+	}, pagination)
+}
+
+// GetScheduledPush godoc
+// @Summary      Get Scheduled Push
+// @Description  Get a specific scheduled push notification by ID
+// @Tags         Scheduled Push
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Scheduled push ID"
+// @Success      200 {object} dto.ScheduledPushResponse "Scheduled push retrieved successfully"
+// @Failure      400 {object} errors.APIError "Invalid scheduled push ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Scheduled push not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/scheduled/{id} [get]
+func (h *ScheduledPushHandler) GetScheduledPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid scheduled push ID"),
+		)
+	}
+
+	scheduledPush, err := h.scheduledPushService.GetScheduledPush(c.UserContext(), userID, uint(id))
+	if err != nil {
+		return h.handleError(c, err, "Failed to get scheduled push")
+	}
+
+	return c.JSON(toScheduledPushResponse(scheduledPush))
+}
+
+// UpdateScheduledPush godoc
+// @Summary      Update Scheduled Push
+// @Description  Update a scheduled push notification that has not been sent yet
+// @Tags         Scheduled Push
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Scheduled push ID"
+// @Param        scheduledPush body dto.ScheduledPushRequest true "Scheduled push data"
+// @Success      200 {object} dto.ScheduledPushResponse "Scheduled push updated successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Scheduled push not found"
+// @Failure      409 {object} errors.APIError "Scheduled push is no longer pending"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/scheduled/{id} [put]
+func (h *ScheduledPushHandler) UpdateScheduledPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid scheduled push ID"),
+		)
+	}
+
+	var req dto.ScheduledPushRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	scheduledPush := toScheduledPushEntity(&req)
+	scheduledPush.ID = uint(id)
+	scheduledPush.UserID = userID
+
+	updated, err := h.scheduledPushService.UpdateScheduledPush(c.UserContext(), userID, scheduledPush)
+	if err != nil {
+		return h.handleError(c, err, "Failed to update scheduled push")
+	}
+
+	return c.JSON(toScheduledPushResponse(updated))
+}
+
+// CancelScheduledPush godoc
+// @Summary      Cancel Scheduled Push
+// @Description  Cancel a scheduled push notification before it is sent
+// @Tags         Scheduled Push
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Scheduled push ID"
+// @Success      200 {object} map[string]string "Scheduled push cancelled successfully"
+// @Failure      400 {object} errors.APIError "Invalid scheduled push ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Scheduled push not found"
+// @Failure      409 {object} errors.APIError "Scheduled push is no longer pending"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/scheduled/{id}/cancel [post]
+func (h *ScheduledPushHandler) CancelScheduledPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid scheduled push ID"),
+		)
+	}
+
+	if err := h.scheduledPushService.CancelScheduledPush(c.UserContext(), userID, uint(id)); err != nil {
+		return h.handleError(c, err, "Failed to cancel scheduled push")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Scheduled push cancelled successfully",
+	})
+}
+
+// DeleteScheduledPush godoc
+// @Summary      Delete Scheduled Push
+// @Description  Delete a scheduled push notification
+// @Tags         Scheduled Push
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Scheduled push ID"
+// @Success      200 {object} map[string]string "Scheduled push deleted successfully"
+// @Failure      400 {object} errors.APIError "Invalid scheduled push ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "Scheduled push not found"
+// @Failure      409 {object} errors.APIError "Scheduled push is being sent"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/scheduled/{id} [delete]
+func (h *ScheduledPushHandler) DeleteScheduledPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid scheduled push ID"),
+		)
+	}
+
+	if err := h.scheduledPushService.DeleteScheduledPush(c.UserContext(), userID, uint(id)); err != nil {
+		return h.handleError(c, err, "Failed to delete scheduled push")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Scheduled push deleted successfully",
+	})
+}
+
+// handleError 将定时推送服务错误映射为HTTP响应
+func (h *ScheduledPushHandler) handleError(c *fiber.Ctx, err error, message string) error {
+	switch err {
+	case service.ErrScheduledPushNotFound:
+		return c.Status(fiber.StatusNotFound).JSON(
+			apierrors.NewAPIError(fiber.StatusNotFound, "Scheduled push not found", "Scheduled push not found"),
+		)
+	case service.ErrScheduledPushNotPending:
+		return c.Status(fiber.StatusConflict).JSON(
+			apierrors.NewAPIError(fiber.StatusConflict, "Scheduled push not pending", "Scheduled push has already been processed or cancelled"),
+		)
+	case service.ErrInvalidScheduledPush, service.ErrInvalidSendTime, push.ErrURLSchemeNotAllowed:
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error()),
+		)
+	default:
+		logger.Error(message, zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", message),
+		)
+	}
+}
+
+// toScheduledPushEntity 将请求转换为定时推送实体
+func toScheduledPushEntity(req *dto.ScheduledPushRequest) *entity.ScheduledPush {
+	return &entity.ScheduledPush{
+		Provider: req.Provider,
+		Title:    req.Title,
+		Body:     req.Body,
+		URL:      req.URL,
+		Sound:    req.Sound,
+		Icon:     req.Icon,
+		Group:    req.Group,
+		Level:    string(req.Level),
+		SendAt:   req.SendAt,
+	}
+}
+
+// toScheduledPushResponse 将定时推送实体转换为响应
+func toScheduledPushResponse(scheduledPush *entity.ScheduledPush) dto.ScheduledPushResponse {
+	return dto.ScheduledPushResponse{
+		ID:        scheduledPush.ID,
+		UserID:    scheduledPush.UserID,
+		Provider:  scheduledPush.Provider,
+		Title:     scheduledPush.Title,
+		Body:      scheduledPush.Body,
+		URL:       scheduledPush.URL,
+		Sound:     scheduledPush.Sound,
+		Icon:      scheduledPush.Icon,
+		Group:     scheduledPush.Group,
+		Level:     scheduledPush.Level,
+		SendAt:    scheduledPush.SendAt,
+		Status:    string(scheduledPush.Status),
+		Error:     scheduledPush.Error,
+		SentAt:    scheduledPush.SentAt,
+		CreatedAt: scheduledPush.CreatedAt,
+		UpdatedAt: scheduledPush.UpdatedAt,
+	}
+}
@@ -1,9 +1,16 @@
 package handler
 
 import (
+	stderrors "errors"
 	"strconv"
+	"strings"
+	"time"
 
+	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/pkg/auth"
 	"nebula-live/pkg/errors"
 
 	"github.com/gofiber/fiber/v2"
@@ -13,13 +20,17 @@ import (
 // UserHandler 用户处理器
 type UserHandler struct {
 	userService service.UserService
+	paginator   *Paginator
+	jwtManager  *auth.JWTManager
 	logger      *zap.Logger
 }
 
 // NewUserHandler 创建用户处理器实例
-func NewUserHandler(userService service.UserService, logger *zap.Logger) *UserHandler {
+func NewUserHandler(userService service.UserService, paginator *Paginator, jwtManager *auth.JWTManager, logger *zap.Logger) *UserHandler {
 	return &UserHandler{
 		userService: userService,
+		paginator:   paginator,
+		jwtManager:  jwtManager,
 		logger:      logger,
 	}
 }
@@ -38,6 +49,66 @@ type UpdateUserRequest struct {
 	Avatar   string `json:"avatar" validate:"max=500"`
 }
 
+// PatchUserRequest 部分更新用户请求，省略的字段保持不变，显式传入空字符串会清空该字段
+type PatchUserRequest struct {
+	Nickname *string `json:"nickname,omitempty" validate:"omitempty,max=100"`
+	Avatar   *string `json:"avatar,omitempty" validate:"omitempty,max=500"`
+}
+
+// Validate 验证部分更新用户请求
+func (r *PatchUserRequest) Validate() error {
+	var errs dto.ValidationErrors
+
+	if r.Nickname != nil && len(*r.Nickname) > 100 {
+		errs.Add("nickname", "must not exceed 100 characters")
+	}
+	if r.Avatar != nil && len(*r.Avatar) > 500 {
+		errs.Add("avatar", "must not exceed 500 characters")
+	}
+
+	return errs.Err()
+}
+
+// RemoveUserRolesRequest 批量移除用户角色请求
+type RemoveUserRolesRequest struct {
+	Roles []string `json:"roles"`
+}
+
+// UserStatusRequest 变更用户状态请求，禁用用户时reason必填
+type UserStatusRequest struct {
+	Reason string `json:"reason" validate:"max=500"`
+}
+
+// Validate 验证变更用户状态请求
+func (r *UserStatusRequest) Validate() error {
+	var errs dto.ValidationErrors
+
+	if len(r.Reason) > 500 {
+		errs.Add("reason", "must not exceed 500 characters")
+	}
+
+	return errs.Err()
+}
+
+// BanUserRequest 禁用用户请求
+type BanUserRequest struct {
+	Reason    string     `json:"reason" validate:"required,max=500"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // 可选，临时禁用的到期时间，需晚于当前时间，省略时永久禁用
+}
+
+// Validate 验证禁用用户请求
+func (r *BanUserRequest) Validate() error {
+	var errs dto.ValidationErrors
+
+	if strings.TrimSpace(r.Reason) == "" {
+		errs.Add("reason", "is required")
+	} else if len(r.Reason) > 500 {
+		errs.Add("reason", "must not exceed 500 characters")
+	}
+
+	return errs.Err()
+}
+
 // UserResponse 用户响应
 type UserResponse struct {
 	ID        uint   `json:"id"`
@@ -48,14 +119,47 @@ type UserResponse struct {
 	Status    string `json:"status"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
+	// 以下状态变更信息仅在用户管理接口中返回
+	StatusReason    string `json:"status_reason,omitempty"`
+	StatusChangedAt string `json:"status_changed_at,omitempty"`
+	BanExpiresAt    string `json:"ban_expires_at,omitempty"` // 临时禁用的到期时间，永久禁用时为空
+}
+
+// ImpersonateUserResponse 模拟登录响应，令牌不可刷新
+type ImpersonateUserResponse struct {
+	User           UserResponse `json:"user"`
+	AccessToken    string       `json:"access_token"`
+	TokenType      string       `json:"token_type"`
+	ExpiresAt      int64        `json:"expires_at"`
+	ImpersonatedBy uint         `json:"impersonated_by"`
+}
+
+// newAdminUserResponse 创建用户管理接口的用户响应，包含状态变更原因和禁用到期时间
+func newAdminUserResponse(user *entity.User) UserResponse {
+	response := UserResponse{
+		ID:           user.ID,
+		Username:     user.Username,
+		Email:        user.Email,
+		Nickname:     user.Nickname,
+		Avatar:       user.Avatar,
+		Status:       user.Status.String(),
+		CreatedAt:    user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		StatusReason: user.StatusReason,
+	}
+	if user.StatusChangedAt != nil {
+		response.StatusChangedAt = user.StatusChangedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if user.BanExpiresAt != nil {
+		response.BanExpiresAt = user.BanExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return response
 }
 
 // ListUsersResponse 用户列表响应
 type ListUsersResponse struct {
 	Users []UserResponse `json:"users"`
-	Total int64          `json:"total"`
-	Page  int            `json:"page"`
-	Limit int            `json:"limit"`
+	dto.Pagination
 }
 
 // CreateUser godoc
@@ -74,14 +178,13 @@ type ListUsersResponse struct {
 // @Router       /users [post]
 func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 	var req CreateUserRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse create user request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	// TODO: 添加请求验证
 
-	user, err := h.userService.CreateUser(c.Context(), req.Username, req.Email, req.Password, req.Nickname)
+	user, err := h.userService.CreateUser(c.UserContext(), req.Username, req.Email, req.Password, req.Nickname)
 	if err != nil {
 		h.logger.Error("Failed to create user", zap.Error(err))
 
@@ -89,19 +192,10 @@ func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "User already exists", "Username or email already exists"))
 		}
 
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to create user"))
+		return web.ServiceError(c, err, "Failed to create user")
 	}
 
-	response := UserResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Nickname:  user.Nickname,
-		Avatar:    user.Avatar,
-		Status:    user.Status.String(),
-		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
+	response := newAdminUserResponse(user)
 
 	return c.Status(fiber.StatusCreated).JSON(response)
 }
@@ -127,26 +221,17 @@ func (h *UserHandler) GetUser(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
 	}
 
-	user, err := h.userService.GetUserByID(c.Context(), uint(id))
+	user, err := h.userService.GetUserByID(c.UserContext(), uint(id))
 	if err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
 
 		h.logger.Error("Failed to get user", zap.Error(err), zap.Uint("user_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get user"))
+		return web.ServiceError(c, err, "Failed to get user")
 	}
 
-	response := UserResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Nickname:  user.Nickname,
-		Avatar:    user.Avatar,
-		Status:    user.Status.String(),
-		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
+	response := newAdminUserResponse(user)
 
 	return c.JSON(response)
 }
@@ -174,20 +259,19 @@ func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 	}
 
 	var req UpdateUserRequest
-	if err := c.BodyParser(&req); err != nil {
-		h.logger.Error("Failed to parse update user request", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	// 获取现有用户
-	user, err := h.userService.GetUserByID(c.Context(), uint(id))
+	user, err := h.userService.GetUserByID(c.UserContext(), uint(id))
 	if err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
 
 		h.logger.Error("Failed to get user for update", zap.Error(err), zap.Uint("user_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get user"))
+		return web.ServiceError(c, err, "Failed to get user")
 	}
 
 	// 更新字段
@@ -198,22 +282,68 @@ func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 		user.Avatar = req.Avatar
 	}
 
-	if err := h.userService.UpdateUser(c.Context(), user); err != nil {
+	if err := h.userService.UpdateUser(c.UserContext(), user); err != nil {
 		h.logger.Error("Failed to update user", zap.Error(err), zap.Uint("user_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to update user"))
+		return web.ServiceError(c, err, "Failed to update user")
 	}
 
-	response := UserResponse{
-		ID:        user.ID,
-		Username:  user.Username,
-		Email:     user.Email,
-		Nickname:  user.Nickname,
-		Avatar:    user.Avatar,
-		Status:    user.Status.String(),
-		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	response := newAdminUserResponse(user)
+
+	return c.JSON(response)
+}
+
+// PatchUser godoc
+// @Summary      Partially Update User
+// @Description  Update only the provided user fields; an explicit empty string clears the field
+// @Tags         User Management
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "User ID"
+// @Param        user body PatchUserRequest true "Fields to update"
+// @Success      200 {object} UserResponse "User updated successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "User not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /users/{id} [patch]
+func (h *UserHandler) PatchUser(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
 	}
 
+	var req PatchUserRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	user, err := h.userService.GetUserByID(c.UserContext(), uint(id))
+	if err != nil {
+		if err == service.ErrUserNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
+		}
+
+		h.logger.Error("Failed to get user for patch", zap.Error(err), zap.Uint("user_id", uint(id)))
+		return web.ServiceError(c, err, "Failed to get user")
+	}
+
+	// 仅更新请求中出现的字段
+	if req.Nickname != nil {
+		user.Nickname = *req.Nickname
+	}
+	if req.Avatar != nil {
+		user.Avatar = *req.Avatar
+	}
+
+	if err := h.userService.UpdateUser(c.UserContext(), user); err != nil {
+		h.logger.Error("Failed to patch user", zap.Error(err), zap.Uint("user_id", uint(id)))
+		return web.ServiceError(c, err, "Failed to update user")
+	}
+
+	response := newAdminUserResponse(user)
+
 	return c.JSON(response)
 }
 
@@ -238,13 +368,13 @@ func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
 	}
 
-	if err := h.userService.DeleteUser(c.Context(), uint(id)); err != nil {
+	if err := h.userService.DeleteUser(c.UserContext(), uint(id)); err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
 
 		h.logger.Error("Failed to delete user", zap.Error(err), zap.Uint("user_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to delete user"))
+		return web.ServiceError(c, err, "Failed to delete user")
 	}
 
 	return c.Status(fiber.StatusNoContent).Send(nil)
@@ -257,7 +387,7 @@ func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Param        page query int false "Page number" default(1)
-// @Param        limit query int false "Items per page" default(10)
+// @Param        limit query int false "Items per page, values above server.pagination.max_limit are clamped" default(10)
 // @Success      200 {object} ListUsersResponse "List of users"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      500 {object} errors.APIError "Internal server error"
@@ -265,26 +395,16 @@ func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 // @Router       /users [get]
 func (h *UserHandler) ListUsers(c *fiber.Ctx) error {
 	// 解析分页参数
-	page := c.QueryInt("page", 1)
-	limit := c.QueryInt("limit", 10)
-
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
-	}
+	page, limit, offset := h.paginator.Parse(c)
 
-	offset := (page - 1) * limit
-
-	users, err := h.userService.ListUsers(c.Context(), offset, limit)
+	users, err := h.userService.ListUsers(c.UserContext(), offset, limit)
 	if err != nil {
 		h.logger.Error("Failed to list users", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list users"))
+		return web.ServiceError(c, err, "Failed to list users")
 	}
 
 	// 获取总数
-	total, err := h.userService.CountUsers(c.Context())
+	total, err := h.userService.CountUsers(c.UserContext())
 	if err != nil {
 		h.logger.Error("Failed to count users", zap.Error(err))
 		// 如果获取总数失败，仍然返回用户列表，但总数设为-1
@@ -293,26 +413,15 @@ func (h *UserHandler) ListUsers(c *fiber.Ctx) error {
 
 	userResponses := make([]UserResponse, len(users))
 	for i, user := range users {
-		userResponses[i] = UserResponse{
-			ID:        user.ID,
-			Username:  user.Username,
-			Email:     user.Email,
-			Nickname:  user.Nickname,
-			Avatar:    user.Avatar,
-			Status:    user.Status.String(),
-			CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		}
+		userResponses[i] = newAdminUserResponse(user)
 	}
 
 	response := ListUsersResponse{
-		Users: userResponses,
-		Total: total,
-		Page:  page,
-		Limit: limit,
+		Users:      userResponses,
+		Pagination: dto.NewPagination(total, page, limit),
 	}
 
-	return c.JSON(response)
+	return web.List(c, response, response.Pagination)
 }
 
 // ActivateUser godoc
@@ -322,10 +431,12 @@ func (h *UserHandler) ListUsers(c *fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Param        id path int true "User ID"
+// @Param        request body UserStatusRequest false "Optional reason for the status change"
 // @Success      200 {object} map[string]string "User activated successfully"
 // @Failure      400 {object} errors.APIError "Invalid user ID"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      404 {object} errors.APIError "User not found"
+// @Failure      409 {object} errors.APIError "Status transition not allowed"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /users/{id}/activate [post]
@@ -336,13 +447,24 @@ func (h *UserHandler) ActivateUser(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
 	}
 
-	if err := h.userService.ActivateUser(c.Context(), uint(id)); err != nil {
+	// 请求体可选，用于记录状态变更原因
+	var req UserStatusRequest
+	if len(c.Body()) > 0 {
+		if err := web.ParseBody(c, &req); err != nil {
+			return err
+		}
+	}
+
+	if err := h.userService.ActivateUser(c.UserContext(), uint(id), req.Reason); err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
+		if stderrors.Is(err, service.ErrInvalidStatusTransition) {
+			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "Invalid status transition", err.Error()))
+		}
 
 		h.logger.Error("Failed to activate user", zap.Error(err), zap.Uint("user_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to activate user"))
+		return web.ServiceError(c, err, "Failed to activate user")
 	}
 
 	return c.JSON(fiber.Map{
@@ -357,10 +479,12 @@ func (h *UserHandler) ActivateUser(c *fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Param        id path int true "User ID"
+// @Param        request body UserStatusRequest false "Optional reason for the status change"
 // @Success      200 {object} map[string]string "User deactivated successfully"
 // @Failure      400 {object} errors.APIError "Invalid user ID"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      404 {object} errors.APIError "User not found"
+// @Failure      409 {object} errors.APIError "Status transition not allowed"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /users/{id}/deactivate [post]
@@ -371,13 +495,24 @@ func (h *UserHandler) DeactivateUser(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
 	}
 
-	if err := h.userService.DeactivateUser(c.Context(), uint(id)); err != nil {
+	// 请求体可选，用于记录状态变更原因
+	var req UserStatusRequest
+	if len(c.Body()) > 0 {
+		if err := web.ParseBody(c, &req); err != nil {
+			return err
+		}
+	}
+
+	if err := h.userService.DeactivateUser(c.UserContext(), uint(id), req.Reason); err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
+		if stderrors.Is(err, service.ErrInvalidStatusTransition) {
+			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "Invalid status transition", err.Error()))
+		}
 
 		h.logger.Error("Failed to deactivate user", zap.Error(err), zap.Uint("user_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to deactivate user"))
+		return web.ServiceError(c, err, "Failed to deactivate user")
 	}
 
 	return c.JSON(fiber.Map{
@@ -387,15 +522,17 @@ func (h *UserHandler) DeactivateUser(c *fiber.Ctx) error {
 
 // BanUser godoc
 // @Summary      Ban User
-// @Description  Ban a user account
+// @Description  Ban a user account; a reason is required. With expires_at the ban is temporary and is lifted on the user's next login after it expires; banning an already banned user replaces the reason and expiry.
 // @Tags         User Management
 // @Accept       json
 // @Produce      json
 // @Param        id path int true "User ID"
+// @Param        request body BanUserRequest true "Reason and optional expiry of the ban"
 // @Success      200 {object} map[string]string "User banned successfully"
-// @Failure      400 {object} errors.APIError "Invalid user ID"
+// @Failure      400 {object} errors.APIError "Invalid user ID, missing reason or expiry in the past"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      404 {object} errors.APIError "User not found"
+// @Failure      409 {object} errors.APIError "Status transition not allowed"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /users/{id}/ban [post]
@@ -406,16 +543,154 @@ func (h *UserHandler) BanUser(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
 	}
 
-	if err := h.userService.BanUser(c.Context(), uint(id)); err != nil {
+	var req BanUserRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.userService.BanUser(c.UserContext(), uint(id), req.Reason, req.ExpiresAt); err != nil {
 		if err == service.ErrUserNotFound {
 			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
 		}
+		if err == service.ErrStatusReasonRequired {
+			return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Reason required", "A reason is required to ban a user"))
+		}
+		if err == service.ErrInvalidBanExpiry {
+			return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid expiry", "expires_at must be in the future"))
+		}
+		if stderrors.Is(err, service.ErrInvalidStatusTransition) {
+			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "Invalid status transition", err.Error()))
+		}
 
 		h.logger.Error("Failed to ban user", zap.Error(err), zap.Uint("user_id", uint(id)))
-		return c.Status(fiber.StatusInternalServerError).JSON(errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to ban user"))
+		return web.ServiceError(c, err, "Failed to ban user")
 	}
 
 	return c.JSON(fiber.Map{
 		"message": "User banned successfully",
 	})
 }
+
+// RemoveRoles godoc
+// @Summary      Remove User Roles
+// @Description  Remove several roles from a user in one transaction; an empty list removes all roles
+// @Tags         User Management
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "User ID"
+// @Param        roles body RemoveUserRolesRequest false "Role names to remove (empty to remove all)"
+// @Success      200 {object} map[string]interface{} "Roles removed successfully"
+// @Failure      400 {object} errors.APIError "Invalid request parameters"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "User or role not found"
+// @Failure      409 {object} errors.APIError "User must keep at least one role"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /users/{id}/roles [delete]
+func (h *UserHandler) RemoveRoles(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
+	}
+
+	// 请求体可选，为空时移除全部角色
+	var req RemoveUserRolesRequest
+	if len(c.Body()) > 0 {
+		if err := web.ParseBody(c, &req); err != nil {
+			return err
+		}
+	}
+
+	var removed int
+	if len(req.Roles) == 0 {
+		removed, err = h.userService.ClearRoles(c.UserContext(), uint(id))
+	} else {
+		removed, err = h.userService.RemoveRoles(c.UserContext(), uint(id), req.Roles)
+	}
+	if err != nil {
+		switch err {
+		case service.ErrUserNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
+		case service.ErrRoleNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "Role not found", "One or more roles do not exist"))
+		case service.ErrUserMustHaveRole:
+			return c.Status(fiber.StatusConflict).JSON(errors.NewAPIError(fiber.StatusConflict, "Cannot remove roles", "User must keep at least one role"))
+		}
+
+		h.logger.Error("Failed to remove user roles", zap.Error(err), zap.Uint("user_id", uint(id)))
+		return web.ServiceError(c, err, "Failed to remove user roles")
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Roles removed successfully",
+		"removed": removed,
+	})
+}
+
+// ImpersonateUser godoc
+// @Summary      Impersonate User
+// @Description  Issue a short-lived access token for the target user carrying an impersonated_by claim. The token cannot be refreshed, and requests made with it return the X-Impersonated-By header. Impersonating another administrator requires the super_admin role; impersonated sessions cannot impersonate again.
+// @Tags         User Management
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "User ID"
+// @Success      200 {object} ImpersonateUserResponse "Impersonation token issued"
+// @Failure      400 {object} errors.APIError "Invalid user ID or impersonating yourself"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Target is an administrator or the session is already impersonated"
+// @Failure      404 {object} errors.APIError "User not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /users/{id}/impersonate [post]
+func (h *UserHandler) ImpersonateUser(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Invalid user ID", "User ID must be a valid number"))
+	}
+
+	// 模拟会话不能再发起模拟，避免通过链式模拟掩盖真实操作者
+	if auth.IsImpersonated(c) {
+		return c.Status(fiber.StatusForbidden).JSON(errors.NewAPIError(fiber.StatusForbidden, "Forbidden", "Impersonated sessions cannot impersonate other users"))
+	}
+
+	adminID := auth.MustGetCurrentUserID(c)
+	user, err := h.userService.AuthorizeImpersonation(c.UserContext(), adminID, uint(id))
+	if err != nil {
+		switch err {
+		case service.ErrUserNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(errors.NewAPIError(fiber.StatusNotFound, "User not found", "User with the given ID does not exist"))
+		case service.ErrImpersonateSelf:
+			return c.Status(fiber.StatusBadRequest).JSON(errors.NewAPIError(fiber.StatusBadRequest, "Cannot impersonate", "You cannot impersonate yourself"))
+		case service.ErrImpersonateAdmin:
+			return c.Status(fiber.StatusForbidden).JSON(errors.NewAPIError(fiber.StatusForbidden, "Cannot impersonate", "Only super admins can impersonate administrators"))
+		}
+
+		h.logger.Error("Failed to authorize impersonation", zap.Error(err), zap.Uint("admin_id", adminID), zap.Uint("user_id", uint(id)))
+		return web.ServiceError(c, err, "Failed to impersonate user")
+	}
+
+	token, expiresAt, err := h.jwtManager.GenerateImpersonationToken(user.ID, user.Username, user.Email, adminID)
+	if err != nil {
+		h.logger.Error("Failed to generate impersonation token", zap.Error(err), zap.Uint("user_id", user.ID))
+		return web.ServiceError(c, err, "Failed to impersonate user")
+	}
+
+	// 系统没有审计日志存储，模拟登录以固定的审计日志行记录，便于从日志中检索
+	h.logger.Info("Audit: user impersonation",
+		zap.String("audit_action", "user.impersonate"),
+		zap.Uint("admin_id", adminID),
+		zap.Uint("user_id", user.ID),
+		zap.String("username", user.Username),
+		zap.String("ip", c.IP()),
+		zap.Time("expires_at", expiresAt))
+
+	return c.JSON(ImpersonateUserResponse{
+		User:           newAdminUserResponse(user),
+		AccessToken:    token,
+		TokenType:      "Bearer",
+		ExpiresAt:      expiresAt.Unix(),
+		ImpersonatedBy: adminID,
+	})
+}
@@ -0,0 +1,186 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/infrastructure/web/middleware"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// impersonateTestEnv 按生产路由组装模拟登录接口：认证、管理员角色检查和处理器
+type impersonateTestEnv struct {
+	app        *fiber.App
+	clock      *testutil.FakeClock
+	jwtManager *auth.JWTManager
+	users      map[string]*entity.User
+}
+
+func newImpersonateTestEnv(t *testing.T) *impersonateTestEnv {
+	t.Helper()
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	bus := testutil.NewEventBus(t)
+	rbacService := testutil.NewRBACServiceWithBus(t, client, bus)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	clk := testutil.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	jwtManager := auth.NewJWTManager(&auth.TokenConfig{
+		SecretKey:        "test-secret",
+		AccessTokenTTL:   15 * time.Minute,
+		RefreshTokenTTL:  24 * time.Hour,
+		ImpersonationTTL: 5 * time.Minute,
+		Clock:            clk,
+	})
+
+	// alice和bob是管理员，carol同时拥有超级管理员角色，dave是普通用户
+	roles := map[string][]string{
+		"alice": {entity.RoleNameAdmin},
+		"bob":   {entity.RoleNameAdmin},
+		"carol": {entity.RoleNameAdmin, entity.RoleNameSuperAdmin},
+		"dave":  nil,
+	}
+	users := make(map[string]*entity.User, len(roles))
+	for _, name := range []string{"alice", "bob", "carol", "dave"} {
+		user, err := userService.CreateUser(ctx, name, name+"@example.com", "Password123!", name)
+		if err != nil {
+			t.Fatalf("CreateUser(%s) error = %v", name, err)
+		}
+		for _, roleName := range roles[name] {
+			role, err := rbacService.GetRoleByName(ctx, roleName)
+			if err != nil {
+				t.Fatalf("GetRoleByName(%s) error = %v", roleName, err)
+			}
+			if err := rbacService.AssignRoleToUser(ctx, user.ID, role.ID, user.ID, nil); err != nil {
+				t.Fatalf("AssignRoleToUser(%s, %s) error = %v", name, roleName, err)
+			}
+		}
+		users[name] = user
+	}
+
+	authMiddleware := middleware.NewAuthMiddleware(jwtManager, zap.NewNop())
+	rbacMiddleware := middleware.NewRBACMiddleware(&config.Config{}, rbacService,
+		testutil.NewPermissionCache(t, rbacService, bus, time.Minute), zap.NewNop())
+	userHandler := handler.NewUserHandler(userService, handler.NewPaginator(&config.Config{}), jwtManager, zap.NewNop())
+
+	app := fiber.New()
+	app.Post("/users/:id/impersonate", authMiddleware.RequireAuth(), rbacMiddleware.RequireAdmin(), userHandler.ImpersonateUser)
+	app.Get("/me", authMiddleware.RequireAuth(), func(c *fiber.Ctx) error {
+		return c.SendString(strconv.FormatUint(uint64(auth.MustGetCurrentUserID(c)), 10))
+	})
+
+	return &impersonateTestEnv{app: app, clock: clk, jwtManager: jwtManager, users: users}
+}
+
+// token 为用户签发普通会话的访问令牌
+func (e *impersonateTestEnv) token(t *testing.T, name string) string {
+	t.Helper()
+	user := e.users[name]
+	pair, err := e.jwtManager.GenerateSessionTokenPair(user.ID, user.Username, user.Email, 1, "refresh-"+name)
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair(%s) error = %v", name, err)
+	}
+	return pair.AccessToken
+}
+
+// impersonate 以actor的令牌请求模拟target，返回状态码和成功时的响应
+func (e *impersonateTestEnv) impersonate(t *testing.T, token, target string) (int, handler.ImpersonateUserResponse) {
+	t.Helper()
+	path := fmt.Sprintf("/users/%d/impersonate", e.users[target].ID)
+	req := httptest.NewRequest(fiber.MethodPost, path, nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	resp, err := e.app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	var body handler.ImpersonateUserResponse
+	if resp.StatusCode == fiber.StatusOK {
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("decode response error = %v", err)
+		}
+	}
+	return resp.StatusCode, body
+}
+
+func TestUserHandler_ImpersonateAuthorization(t *testing.T) {
+	env := newImpersonateTestEnv(t)
+
+	tests := []struct {
+		actor  string
+		target string
+		want   int
+	}{
+		{"dave", "alice", fiber.StatusForbidden}, // 普通用户没有管理员角色
+		{"dave", "bob", fiber.StatusForbidden},
+		{"alice", "bob", fiber.StatusForbidden}, // 管理员不能模拟其他管理员
+		{"alice", "carol", fiber.StatusForbidden},
+		{"alice", "alice", fiber.StatusBadRequest},
+		{"alice", "dave", fiber.StatusOK},
+		{"carol", "bob", fiber.StatusOK}, // 超级管理员可以模拟管理员
+	}
+	for _, tt := range tests {
+		t.Run(tt.actor+" as "+tt.target, func(t *testing.T) {
+			if got, _ := env.impersonate(t, env.token(t, tt.actor), tt.target); got != tt.want {
+				t.Errorf("status = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserHandler_ImpersonationTokenClaimsAndTTL(t *testing.T) {
+	env := newImpersonateTestEnv(t)
+	admin, target := env.users["alice"], env.users["dave"]
+
+	status, resp := env.impersonate(t, env.token(t, "alice"), "dave")
+	if status != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d", status, fiber.StatusOK)
+	}
+	if resp.User.ID != target.ID || resp.ImpersonatedBy != admin.ID {
+		t.Errorf("response user = %d, impersonated_by = %d; want %d, %d", resp.User.ID, resp.ImpersonatedBy, target.ID, admin.ID)
+	}
+
+	// 有效期使用ImpersonationTTL而不是AccessTokenTTL
+	if want := env.clock.Now().Add(5 * time.Minute).Unix(); resp.ExpiresAt != want {
+		t.Errorf("expires_at = %d, want %d", resp.ExpiresAt, want)
+	}
+	claims, err := env.jwtManager.ValidateAccessToken(resp.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateAccessToken() error = %v", err)
+	}
+	if claims.UserID != target.ID || claims.ImpersonatedBy != admin.ID {
+		t.Errorf("claims user = %d, impersonated_by = %d; want %d, %d", claims.UserID, claims.ImpersonatedBy, target.ID, admin.ID)
+	}
+
+	// 使用模拟令牌的请求以目标用户身份执行，并带有发起模拟的管理员ID
+	req := httptest.NewRequest(fiber.MethodGet, "/me", nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer "+resp.AccessToken)
+	me, err := env.app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	if me.StatusCode != fiber.StatusOK {
+		t.Fatalf("/me status = %d, want %d", me.StatusCode, fiber.StatusOK)
+	}
+	if got, want := me.Header.Get(auth.ImpersonatedByHeader), strconv.FormatUint(uint64(admin.ID), 10); got != want {
+		t.Errorf("%s = %q, want %q", auth.ImpersonatedByHeader, got, want)
+	}
+
+	env.clock.Advance(5 * time.Minute)
+	if _, err := env.jwtManager.ValidateAccessToken(resp.AccessToken); err == nil {
+		t.Error("ValidateAccessToken() after ImpersonationTTL succeeded, want expired")
+	}
+}
@@ -0,0 +1,107 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// patchTestEnv 用户和角色的PATCH接口，请求以admin身份执行
+type patchTestEnv struct {
+	app   *fiber.App
+	users service.UserService
+	rbac  service.RBACService
+	admin *entity.User
+}
+
+func newPatchTestEnv(t *testing.T) *patchTestEnv {
+	t.Helper()
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	admin, err := userService.CreateUser(context.Background(), "admin", "admin@example.com", "Password123!", "Admin")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	userHandler := handler.NewUserHandler(userService, handler.NewPaginator(&config.Config{}), nil, zap.NewNop())
+	roleHandler := handler.NewRoleHandler(rbacService, userService, nil, zap.NewNop())
+
+	app := fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals(auth.AuthContextKey, &auth.UserClaims{UserID: admin.ID, Username: admin.Username})
+		c.Locals(auth.UserIDContextKey, admin.ID)
+		return c.Next()
+	})
+	app.Patch("/users/:id", userHandler.PatchUser)
+	app.Patch("/roles/:id", roleHandler.PatchRole)
+	return &patchTestEnv{app: app, users: userService, rbac: rbacService, admin: admin}
+}
+
+// patch 发送PATCH请求，返回响应状态码
+func (e *patchTestEnv) patch(t *testing.T, path, body string) int {
+	t.Helper()
+	req := httptest.NewRequest(fiber.MethodPatch, path, strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err := e.app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestUserHandler_PatchUser(t *testing.T) {
+	ctx := context.Background()
+	env := newPatchTestEnv(t)
+	user, err := env.users.CreateUser(ctx, "judy", "judy@example.com", "Password123!", "Judy")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	path := fmt.Sprintf("/users/%d", user.ID)
+
+	// 只传avatar时昵称保持不变
+	if status := env.patch(t, path, `{"avatar":"https://example.com/judy.png"}`); status != fiber.StatusOK {
+		t.Fatalf("PATCH avatar status = %d, want %d", status, fiber.StatusOK)
+	}
+	got, err := env.users.GetUserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if got.Nickname != "Judy" || got.Avatar != "https://example.com/judy.png" {
+		t.Errorf("after PATCH avatar = {nickname: %q, avatar: %q}, want the nickname unchanged", got.Nickname, got.Avatar)
+	}
+
+	// 显式传入空字符串清空昵称，省略的avatar保持不变
+	if status := env.patch(t, path, `{"nickname":""}`); status != fiber.StatusOK {
+		t.Fatalf("PATCH empty nickname status = %d, want %d", status, fiber.StatusOK)
+	}
+	got, err = env.users.GetUserByID(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if got.Nickname != "" || got.Avatar != "https://example.com/judy.png" {
+		t.Errorf("after PATCH nickname=\"\" = {nickname: %q, avatar: %q}, want an empty nickname and the avatar unchanged", got.Nickname, got.Avatar)
+	}
+
+	if status := env.patch(t, path, `{"nickname":"`+strings.Repeat("a", 101)+`"}`); status != fiber.StatusBadRequest {
+		t.Errorf("PATCH over-long nickname status = %d, want %d", status, fiber.StatusBadRequest)
+	}
+	if status := env.patch(t, "/users/9999", `{"nickname":"x"}`); status != fiber.StatusNotFound {
+		t.Errorf("PATCH missing user status = %d, want %d", status, fiber.StatusNotFound)
+	}
+}
@@ -0,0 +1,66 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/testutil"
+	apierrors "nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// 不存在的资源返回404，数据库错误返回通用的500且不暴露底层错误
+func TestHandlers_ServiceErrorsMapToStatus(t *testing.T) {
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	app := fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	app.Get("/users/:id", handler.NewUserHandler(userService, handler.NewPaginator(&config.Config{}), nil, zap.NewNop()).GetUser)
+	app.Get("/roles/:id", handler.NewRoleHandler(rbacService, userService, nil, zap.NewNop()).GetRole)
+
+	get := func(path string) (int, apierrors.APIError) {
+		t.Helper()
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+		if err != nil {
+			t.Fatalf("app.Test(%s) error = %v", path, err)
+		}
+		var body apierrors.APIError
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatalf("decode %s response error = %v", path, err)
+		}
+		return resp.StatusCode, body
+	}
+
+	for _, path := range []string{"/users/9999", "/roles/9999"} {
+		if status, _ := get(path); status != fiber.StatusNotFound {
+			t.Errorf("GET %s status = %d, want %d", path, status, fiber.StatusNotFound)
+		}
+	}
+
+	// 关闭数据库后查询失败，属于未预期的内部错误
+	client.Close()
+	tests := []struct {
+		path        string
+		wantMessage string
+	}{
+		{"/users/1", "Failed to get user"},
+		{"/roles/1", "Failed to get role"},
+	}
+	for _, tt := range tests {
+		status, body := get(tt.path)
+		if status != fiber.StatusInternalServerError || body.Message != tt.wantMessage {
+			t.Errorf("GET %s = %d %q, want %d %q", tt.path, status, body.Message, fiber.StatusInternalServerError, tt.wantMessage)
+		}
+		if strings.Contains(strings.ToLower(body.Error+body.Message), "sql") {
+			t.Errorf("GET %s leaked the database error: %+v", tt.path, body)
+		}
+	}
+}
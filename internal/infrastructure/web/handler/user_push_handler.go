@@ -1,7 +1,11 @@
 package handler
 
 import (
+	"errors"
+
+	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
 	"nebula-live/internal/infrastructure/web/dto"
 	"nebula-live/internal/pkg/push"
 	"nebula-live/pkg/auth"
@@ -15,12 +19,16 @@ import (
 // UserPushHandler 用户推送处理器
 type UserPushHandler struct {
 	pushService service.PushService
+	userService service.UserService
+	jobService  service.JobService
 }
 
 // NewUserPushHandler 创建用户推送处理器
-func NewUserPushHandler(pushService service.PushService) *UserPushHandler {
+func NewUserPushHandler(pushService service.PushService, userService service.UserService, jobService service.JobService) *UserPushHandler {
 	return &UserPushHandler{
 		pushService: pushService,
+		userService: userService,
+		jobService:  jobService,
 	}
 }
 
@@ -34,6 +42,7 @@ func NewUserPushHandler(pushService service.PushService) *UserPushHandler {
 // @Success      200 {object} dto.UserPushResult "Push notification sent successfully"
 // @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
 // @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      409 {object} errors.APIError "Same notification sent recently"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /push/my-devices [post]
@@ -46,34 +55,25 @@ func (h *UserPushHandler) SendToMyDevices(c *fiber.Ctx) error {
 	}
 
 	var req dto.UserPushRequest
-	if err := c.BodyParser(&req); err != nil {
-		logger.Error("Failed to parse request body", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "Failed to parse request body"),
-		)
-	}
-
-	if err := req.Validate(); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error()),
-		)
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	// 创建推送消息
-	message := &push.PushMessage{
-		Title:    req.Title,
-		Body:     req.Body,
-		URL:      req.URL,
-		Sound:    req.Sound,
-		Icon:     req.Icon,
-		Group:    req.Group,
-		Level:    push.PushLevel(req.Level),
-		AutoCopy: req.AutoCopy,
-		Call:     req.Call,
-	}
+	message := req.ToPushMessage()
 
 	// 发送到用户的所有设备
-	responses, err := h.pushService.SendToUserDevices(c.Context(), userID, message)
+	responses, err := h.pushService.SendToUserDevices(c.UserContext(), userID, message)
+	if errors.Is(err, service.ErrDuplicatePush) {
+		return c.Status(fiber.StatusConflict).JSON(
+			apierrors.NewAPIError(fiber.StatusConflict, "Duplicate notification", "The same notification was sent recently"),
+		)
+	}
+	if errors.Is(err, push.ErrURLSchemeNotAllowed) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid URL", "URL scheme is not allowed"),
+		)
+	}
 	if err != nil {
 		logger.Error("Failed to send push notification to user devices", 
 			zap.Uint("user_id", userID), 
@@ -84,20 +84,7 @@ func (h *UserPushHandler) SendToMyDevices(c *fiber.Ctx) error {
 	}
 
 	// 转换响应
-	responseData := make([]dto.PushResponse, len(responses))
-	successCount := 0
-	
-	for i, resp := range responses {
-		responseData[i] = dto.PushResponse{
-			Success:   resp.Success,
-			MessageID: resp.MessageID,
-			Provider:  resp.Provider,
-			Error:     resp.Error,
-		}
-		if resp.Success {
-			successCount++
-		}
-	}
+	responseData, successCount := toPushResponses(responses, h.canViewProviderDetails(c, userID))
 
 	result := dto.UserPushResult{
 		UserID:       userID,
@@ -110,18 +97,71 @@ func (h *UserPushHandler) SendToMyDevices(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(result)
 }
 
+// SendToMyDevicesAsync godoc
+// @Summary      Send Push to My Devices Asynchronously
+// @Description  Submit a push notification to current user's all enabled devices as a background job and return immediately. Poll GET /jobs/{id} for the result, which holds total_devices, success_count and failed_count, or duplicate when the same notification was sent recently
+// @Tags         Push Notifications
+// @Accept       json
+// @Produce      json
+// @Param        notification body dto.UserPushRequest true "Push notification data"
+// @Success      202 {object} dto.JobResponse "Push job submitted"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/my-devices/async [post]
+func (h *UserPushHandler) SendToMyDevicesAsync(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	var req dto.UserPushRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	message := req.ToPushMessage()
+
+	// 提交前校验链接，避免任务执行时才失败
+	if err := h.pushService.ValidateURL(message.URL); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid URL", "URL scheme is not allowed"),
+		)
+	}
+
+	job, err := h.jobService.Enqueue(c.UserContext(), service.JobTypeSendToUserDevices, service.SendToUserDevicesPayload{
+		UserID:  userID,
+		Message: *message,
+	}, userID)
+	if err != nil {
+		logger.Error("Failed to submit push job",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to submit push job"),
+		)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(toJobResponse(job))
+}
+
 // SendToMyDevicesByProvider godoc
 // @Summary      Send Push to My Devices by Provider
 // @Description  Send push notification to current user's devices for specific provider
 // @Tags         Push Notifications
 // @Accept       json
 // @Produce      json
-// @Param        provider path string true "Push provider name" Enums(bark) example(bark)
+// @Param        provider path string true "Push provider name" Enums(bark, email) example(bark)
 // @Param        notification body dto.UserPushRequest true "Push notification data"
 // @Success      200 {object} dto.UserPushResult "Push notification sent successfully"
-// @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
+// @Failure      400 {object} errors.APIError "Invalid request parameters, validation failed or unsupported provider"
 // @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      409 {object} errors.APIError "Same notification sent recently"
 // @Failure      500 {object} errors.APIError "Internal server error"
+// @Failure      503 {object} errors.APIError "Push provider is disabled"
 // @Security     Bearer
 // @Router       /push/my-devices/{provider} [post]
 func (h *UserPushHandler) SendToMyDevicesByProvider(c *fiber.Ctx) error {
@@ -140,34 +180,35 @@ func (h *UserPushHandler) SendToMyDevicesByProvider(c *fiber.Ctx) error {
 	}
 
 	var req dto.UserPushRequest
-	if err := c.BodyParser(&req); err != nil {
-		logger.Error("Failed to parse request body", zap.Error(err))
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	// 创建推送消息
+	message := req.ToPushMessage()
+
+	// 发送到用户指定提供商的设备
+	responses, err := h.pushService.SendToUserDevicesByProvider(c.UserContext(), userID, provider, message)
+	if errors.Is(err, service.ErrDuplicatePush) {
+		return c.Status(fiber.StatusConflict).JSON(
+			apierrors.NewAPIError(fiber.StatusConflict, "Duplicate notification", "The same notification was sent recently"),
+		)
+	}
+	if errors.Is(err, push.ErrURLSchemeNotAllowed) {
 		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "Failed to parse request body"),
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid URL", "URL scheme is not allowed"),
 		)
 	}
-
-	if err := req.Validate(); err != nil {
+	if errors.Is(err, push.ErrProviderNotFound) {
 		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error()),
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid provider", "Unsupported push provider: "+provider),
 		)
 	}
-
-	// 创建推送消息
-	message := &push.PushMessage{
-		Title:    req.Title,
-		Body:     req.Body,
-		URL:      req.URL,
-		Sound:    req.Sound,
-		Icon:     req.Icon,
-		Group:    req.Group,
-		Level:    push.PushLevel(req.Level),
-		AutoCopy: req.AutoCopy,
-		Call:     req.Call,
+	if errors.Is(err, push.ErrProviderNotEnabled) {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(
+			apierrors.NewAPIError(fiber.StatusServiceUnavailable, "Provider unavailable", "Push provider is disabled: "+provider),
+		)
 	}
-
-	// 发送到用户指定提供商的设备
-	responses, err := h.pushService.SendToUserDevicesByProvider(c.Context(), userID, provider, message)
 	if err != nil {
 		logger.Error("Failed to send push notification to user devices by provider", 
 			zap.Uint("user_id", userID), 
@@ -179,20 +220,7 @@ func (h *UserPushHandler) SendToMyDevicesByProvider(c *fiber.Ctx) error {
 	}
 
 	// 转换响应
-	responseData := make([]dto.PushResponse, len(responses))
-	successCount := 0
-	
-	for i, resp := range responses {
-		responseData[i] = dto.PushResponse{
-			Success:   resp.Success,
-			MessageID: resp.MessageID,
-			Provider:  resp.Provider,
-			Error:     resp.Error,
-		}
-		if resp.Success {
-			successCount++
-		}
-	}
+	responseData, successCount := toPushResponses(responses, h.canViewProviderDetails(c, userID))
 
 	result := dto.UserPushResult{
 		UserID:       userID,
@@ -214,6 +242,7 @@ func (h *UserPushHandler) SendToMyDevicesByProvider(c *fiber.Ctx) error {
 // @Produce      json
 // @Success      200 {object} dto.UserPushResult "Test notification sent successfully"
 // @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      409 {object} errors.APIError "Same notification sent recently"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /push/test [post]
@@ -232,7 +261,12 @@ func (h *UserPushHandler) TestMyPushSettings(c *fiber.Ctx) error {
 	}
 
 	// 发送到用户的所有设备
-	responses, err := h.pushService.SendToUserDevices(c.Context(), userID, message)
+	responses, err := h.pushService.SendToUserDevices(c.UserContext(), userID, message)
+	if errors.Is(err, service.ErrDuplicatePush) {
+		return c.Status(fiber.StatusConflict).JSON(
+			apierrors.NewAPIError(fiber.StatusConflict, "Duplicate notification", "The same notification was sent recently"),
+		)
+	}
 	if err != nil {
 		logger.Error("Failed to send test push notification", 
 			zap.Uint("user_id", userID), 
@@ -243,29 +277,223 @@ func (h *UserPushHandler) TestMyPushSettings(c *fiber.Ctx) error {
 	}
 
 	// 转换响应
-	responseData := make([]dto.PushResponse, len(responses))
+	responseData, successCount := toPushResponses(responses, h.canViewProviderDetails(c, userID))
+
+	result := dto.UserPushResult{
+		UserID:       userID,
+		TotalDevices: len(responses),
+		SuccessCount: successCount,
+		FailedCount:  len(responses) - successCount,
+		Responses:    responseData,
+		Message:      "Test notification sent",
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+// PreviewMyPush godoc
+// @Summary      Preview Push on My Devices
+// @Description  Resolve the notification for each of current user's enabled devices as it would be sent, applying device settings, user defaults, provider length limits and field support, without sending it
+// @Tags         Push Notifications
+// @Accept       json
+// @Produce      json
+// @Param        notification body dto.UserPushRequest true "Push notification data"
+// @Success      200 {object} dto.PushPreviewResponse "Resolved message per device"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/preview [post]
+func (h *UserPushHandler) PreviewMyPush(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	var req dto.UserPushRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	previews, err := h.pushService.PreviewUserDevices(c.UserContext(), userID, req.ToPushMessage())
+	if errors.Is(err, push.ErrURLSchemeNotAllowed) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid URL", "URL scheme is not allowed"),
+		)
+	}
+	if err != nil {
+		logger.Error("Failed to preview push notification",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to preview notification"),
+		)
+	}
+
+	devices := make([]dto.PushDevicePreview, len(previews))
+	for i, preview := range previews {
+		message := preview.Message
+		devices[i] = dto.PushDevicePreview{
+			SettingID:  preview.SettingID,
+			DeviceName: preview.DeviceName,
+			Provider:   preview.Provider,
+			Endpoint:   preview.Endpoint,
+			Title:      message.Title,
+			Subtitle:   message.Subtitle,
+			Body:       message.Body,
+			Badge:      message.Badge,
+			Sound:      message.Sound,
+			Icon:       message.Icon,
+			Image:      message.Image,
+			Group:      message.Group,
+			Level:      message.Level,
+			URL:        message.URL,
+			Call:       message.Call,
+			AutoCopy:   message.AutoCopy,
+			Copy:       message.Copy,
+			Error:      preview.Error,
+		}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.PushPreviewResponse{
+		UserID:  userID,
+		Devices: devices,
+	})
+}
+
+// GetMyPushPreferences godoc
+// @Summary      Get My Push Preferences
+// @Description  Get current user's default push group, sound and level. Precedence when sending: explicit message field > device setting > user default
+// @Tags         Push Notifications
+// @Produce      json
+// @Success      200 {object} dto.UserPushPreferencesResponse "Push preferences"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "User not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/preferences [get]
+func (h *UserPushHandler) GetMyPushPreferences(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	preferences, err := h.userService.GetPushPreferences(c.UserContext(), userID)
+	if err != nil {
+		return h.handlePreferencesError(c, userID, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(toPushPreferencesResponse(preferences))
+}
+
+// UpdateMyPushPreferences godoc
+// @Summary      Update My Push Preferences
+// @Description  Replace current user's default push group, sound and level; empty values clear the default
+// @Tags         Push Notifications
+// @Accept       json
+// @Produce      json
+// @Param        preferences body dto.UserPushPreferencesRequest true "Push preferences"
+// @Success      200 {object} dto.UserPushPreferencesResponse "Push preferences updated"
+// @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      404 {object} errors.APIError "User not found"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push/preferences [put]
+func (h *UserPushHandler) UpdateMyPushPreferences(c *fiber.Ctx) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	var req dto.UserPushPreferencesRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	preferences, err := h.userService.UpdatePushPreferences(c.UserContext(), userID, entity.UserPushPreferences{
+		DefaultGroup: req.DefaultGroup,
+		DefaultSound: req.DefaultSound,
+		DefaultLevel: req.DefaultLevel,
+	})
+	if err != nil {
+		return h.handlePreferencesError(c, userID, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(toPushPreferencesResponse(preferences))
+}
+
+// handlePreferencesError 将推送偏好相关错误转换为HTTP响应
+func (h *UserPushHandler) handlePreferencesError(c *fiber.Ctx, userID uint, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPushLevel):
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", "default_level must be one of: active, critical, timeSensitive, passive"),
+		)
+	case errors.Is(err, service.ErrUserNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(
+			apierrors.NewAPIError(fiber.StatusNotFound, "User not found", "The requested user does not exist"),
+		)
+	default:
+		logger.Error("Failed to handle push preferences",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to handle push preferences"),
+		)
+	}
+}
+
+// toPushPreferencesResponse 转换推送偏好为响应DTO
+func toPushPreferencesResponse(preferences *entity.UserPushPreferences) dto.UserPushPreferencesResponse {
+	return dto.UserPushPreferencesResponse{
+		DefaultGroup: preferences.DefaultGroup,
+		DefaultSound: preferences.DefaultSound,
+		DefaultLevel: preferences.DefaultLevel,
+	}
+}
+
+// canViewProviderDetails 判断当前用户是否可以查看推送服务返回的状态码和原始响应
+func (h *UserPushHandler) canViewProviderDetails(c *fiber.Ctx, userID uint) bool {
+	allowed, err := h.userService.HasPermission(c.UserContext(), userID, "system", "manage")
+	if err != nil {
+		logger.Warn("Failed to check permission for push provider details",
+			zap.Uint("user_id", userID),
+			zap.Error(err))
+		return false
+	}
+	return allowed
+}
+
+// toPushResponses 转换推送结果并统计成功数量，withDetails 为 true 时包含推送服务返回的状态码和原始响应
+func toPushResponses(responses []*push.PushResponse, withDetails bool) ([]dto.PushResponse, int) {
+	data := make([]dto.PushResponse, len(responses))
 	successCount := 0
-	
+
 	for i, resp := range responses {
-		responseData[i] = dto.PushResponse{
+		data[i] = dto.PushResponse{
 			Success:   resp.Success,
 			MessageID: resp.MessageID,
 			Provider:  resp.Provider,
 			Error:     resp.Error,
+			Cancelled: resp.Cancelled,
+			TestMode:  resp.TestMode,
+			Rejected:  resp.Rejected,
+		}
+		if withDetails {
+			data[i].StatusCode = resp.StatusCode
+			data[i].RawResponse = resp.RawResponse
 		}
 		if resp.Success {
 			successCount++
 		}
 	}
 
-	result := dto.UserPushResult{
-		UserID:       userID,
-		TotalDevices: len(responses),
-		SuccessCount: successCount,
-		FailedCount:  len(responses) - successCount,
-		Responses:    responseData,
-		Message:      "Test notification sent",
-	}
-
-	return c.Status(fiber.StatusOK).JSON(result)
-}
\ No newline at end of file
+	return data, successCount
+}
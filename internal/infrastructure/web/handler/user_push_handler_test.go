@@ -0,0 +1,259 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// pushTestEnv 推送路由、一个拥有Bark设备的普通用户和记录请求的假Bark服务器
+type pushTestEnv struct {
+	app  *fiber.App
+	rbac service.RBACService
+	user *entity.User
+
+	// barkStatus Bark服务器返回的HTTP状态码
+	barkStatus int
+
+	mu           sync.Mutex
+	barkPayloads []map[string]any
+}
+
+func newPushTestEnv(t *testing.T, barkStatus int) *pushTestEnv {
+	t.Helper()
+	ctx := context.Background()
+	env := &pushTestEnv{barkStatus: barkStatus}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		env.mu.Lock()
+		env.barkPayloads = append(env.barkPayloads, payload)
+		env.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(env.barkStatus)
+		if env.barkStatus == http.StatusOK {
+			w.Write([]byte(`{"code":200,"message":"success","timestamp":1700000000}`))
+			return
+		}
+		w.Write([]byte(`{"code":400,"message":"failed to get device token: device not registered"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	user, err := userService.CreateUser(ctx, "frank", "frank@example.com", "Password123!", "Frank")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	settingRepo := persistence.NewUserPushSettingRepository(client)
+	userRepo := persistence.NewUserRepository(client)
+	settings := service.NewUserPushSettingService(settingRepo, userRepo, rbacService, service.UserPushSettingServiceConfig{})
+	enabled := true
+	if _, err := settings.CreateSetting(ctx, user.ID, "bark", "frank-device-key", "iPhone", nil, &enabled); err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+
+	pushService := service.NewPushService(settings, settingRepo, userRepo, testutil.NewEventBus(t), service.PushServiceConfig{
+		BarkBaseURL:        server.URL,
+		CaptureRawResponse: true,
+	})
+	pushHandler := handler.NewUserPushHandler(pushService, userService, nil)
+
+	env.app = fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	env.app.Post("/push/my-devices",
+		func(c *fiber.Ctx) error {
+			c.Locals(auth.AuthContextKey, &auth.UserClaims{UserID: user.ID, Username: user.Username})
+			c.Locals(auth.UserIDContextKey, user.ID)
+			return c.Next()
+		},
+		pushHandler.SendToMyDevices,
+	)
+	env.rbac = rbacService
+	env.user = user
+	return env
+}
+
+// send 以用户身份发送推送，返回响应状态码和解析后的结果
+func (e *pushTestEnv) send(t *testing.T, body string) (int, dto.UserPushResult) {
+	t.Helper()
+	req := httptest.NewRequest(fiber.MethodPost, "/push/my-devices", strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	resp, err := e.app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+
+	var result dto.UserPushResult
+	if resp.StatusCode == fiber.StatusOK {
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return resp.StatusCode, result
+}
+
+// payloads 返回Bark服务器收到的请求体
+func (e *pushTestEnv) payloads() []map[string]any {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]map[string]any(nil), e.barkPayloads...)
+}
+
+// grantAdmin 为用户分配拥有 system:manage 的管理员角色
+func (e *pushTestEnv) grantAdmin(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	role, err := e.rbac.GetRoleByName(ctx, "admin")
+	if err != nil {
+		t.Fatalf("GetRoleByName(admin) error = %v", err)
+	}
+	if err := e.rbac.AssignRoleToUser(ctx, e.user.ID, role.ID, 0, nil); err != nil {
+		t.Fatalf("AssignRoleToUser() error = %v", err)
+	}
+}
+
+func TestUserPushHandler_BarkStatusCodeShownToAdmins(t *testing.T) {
+	env := newPushTestEnv(t, http.StatusBadRequest)
+	const body = `{"title":"开播提醒","body":"主播开播了"}`
+
+	code, result := env.send(t, body)
+	if code != fiber.StatusOK || len(result.Responses) != 1 {
+		t.Fatalf("status = %d, responses = %+v; want 200 with one response", code, result.Responses)
+	}
+	if resp := result.Responses[0]; resp.Success || resp.StatusCode != 0 || resp.RawResponse != "" {
+		t.Errorf("response for regular user = %+v, want failure without provider details", resp)
+	}
+
+	env.grantAdmin(t)
+	code, result = env.send(t, body)
+	if code != fiber.StatusOK || len(result.Responses) != 1 {
+		t.Fatalf("status = %d, responses = %+v; want 200 with one response", code, result.Responses)
+	}
+	resp := result.Responses[0]
+	if resp.Success || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("response for admin = %+v, want failure with status code 400", resp)
+	}
+	if !strings.Contains(resp.RawResponse, "device not registered") {
+		t.Errorf("raw response = %q, want the Bark response body", resp.RawResponse)
+	}
+}
+
+func TestUserPushHandler_ImageReachesBarkPayload(t *testing.T) {
+	env := newPushTestEnv(t, http.StatusOK)
+
+	code, result := env.send(t, `{"title":"开播提醒","body":"主播开播了","image":"https://example.com/cover.jpg"}`)
+	if code != fiber.StatusOK || result.SuccessCount != 1 {
+		t.Fatalf("status = %d, result = %+v; want 200 with one success", code, result)
+	}
+
+	payloads := env.payloads()
+	if len(payloads) != 1 {
+		t.Fatalf("bark received %d requests, want 1", len(payloads))
+	}
+	if got := payloads[0]["image"]; got != "https://example.com/cover.jpg" {
+		t.Errorf("bark payload image = %v, want the image URL", got)
+	}
+}
+
+func TestUserPushHandler_InvalidImageRejected(t *testing.T) {
+	env := newPushTestEnv(t, http.StatusOK)
+
+	for _, image := range []string{"http://example.com/cover.jpg", "cover.jpg"} {
+		code, _ := env.send(t, `{"title":"开播提醒","body":"主播开播了","image":"`+image+`"}`)
+		if code != fiber.StatusBadRequest {
+			t.Errorf("image %q: status = %d, want %d", image, code, fiber.StatusBadRequest)
+		}
+	}
+	if got := len(env.payloads()); got != 0 {
+		t.Errorf("bark received %d requests, want 0", got)
+	}
+}
+
+func TestUserPushHandler_LevelValidatedAndMapped(t *testing.T) {
+	env := newPushTestEnv(t, http.StatusOK)
+
+	for _, level := range []string{"urgent", "high", "Critical"} {
+		if code, _ := env.send(t, `{"title":"开播提醒","body":"主播开播了","level":"`+level+`"}`); code != fiber.StatusBadRequest {
+			t.Errorf("level %q: status = %d, want %d", level, code, fiber.StatusBadRequest)
+		}
+	}
+	if got := len(env.payloads()); got != 0 {
+		t.Fatalf("bark received %d requests, want 0", got)
+	}
+
+	code, result := env.send(t, `{"title":"开播提醒","body":"主播开播了","level":"timeSensitive"}`)
+	if code != fiber.StatusOK || result.SuccessCount != 1 {
+		t.Fatalf("status = %d, result = %+v; want 200 with one success", code, result)
+	}
+	if got := env.payloads()[0]["level"]; got != "timeSensitive" {
+		t.Errorf("bark payload level = %v, want timeSensitive", got)
+	}
+}
+
+func TestUserPushHandler_URLSchemeAllowlist(t *testing.T) {
+	env := newPushTestEnv(t, http.StatusOK)
+
+	for _, url := range []string{"javascript:alert(document.cookie)", "http://example.com", "intent://scan"} {
+		if code, _ := env.send(t, `{"title":"开播提醒","body":"主播开播了","url":"`+url+`"}`); code != fiber.StatusBadRequest {
+			t.Errorf("url %q: status = %d, want %d", url, code, fiber.StatusBadRequest)
+		}
+	}
+	if got := len(env.payloads()); got != 0 {
+		t.Fatalf("bark received %d requests, want 0", got)
+	}
+
+	code, result := env.send(t, `{"title":"开播提醒","body":"主播开播了","url":"https://live.bilibili.com/5440"}`)
+	if code != fiber.StatusOK || result.SuccessCount != 1 {
+		t.Fatalf("status = %d, result = %+v; want 200 with one success", code, result)
+	}
+	if got := env.payloads()[0]["url"]; got != "https://live.bilibili.com/5440" {
+		t.Errorf("bark payload url = %v, want the https URL", got)
+	}
+}
+
+func TestUserPushHandler_SubtitleBadgeCopyReachBarkPayload(t *testing.T) {
+	env := newPushTestEnv(t, http.StatusOK)
+
+	for _, body := range []string{
+		`{"title":"开播提醒","body":"主播开播了","badge":-1}`,
+		`{"title":"开播提醒","body":"主播开播了","subtitle":"` + strings.Repeat("a", 201) + `"}`,
+		`{"title":"开播提醒","body":"主播开播了","copy":"` + strings.Repeat("a", 1001) + `"}`,
+	} {
+		if code, _ := env.send(t, body); code != fiber.StatusBadRequest {
+			t.Errorf("body %.60s: status = %d, want %d", body, code, fiber.StatusBadRequest)
+		}
+	}
+	if got := len(env.payloads()); got != 0 {
+		t.Fatalf("bark received %d requests, want 0", got)
+	}
+
+	code, result := env.send(t, `{"title":"开播提醒","subtitle":"哔哩哔哩","body":"主播开播了","badge":3,"copy":"5440","auto_copy":true}`)
+	if code != fiber.StatusOK || result.SuccessCount != 1 {
+		t.Fatalf("status = %d, result = %+v; want 200 with one success", code, result)
+	}
+	payload := env.payloads()[0]
+	if payload["subtitle"] != "哔哩哔哩" || payload["badge"] != float64(3) || payload["copy"] != "5440" || payload["autoCopy"] != "1" {
+		t.Errorf("bark payload = %v, want subtitle, badge, copy and autoCopy", payload)
+	}
+}
@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"nebula-live/internal/domain/entity"
 	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
 	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/internal/pkg/push"
 	"nebula-live/pkg/auth"
 	apierrors "nebula-live/pkg/errors"
 	"nebula-live/pkg/logger"
@@ -15,15 +18,37 @@ import (
 // UserPushSettingHandler 用户推送设置处理器
 type UserPushSettingHandler struct {
 	userPushSettingService service.UserPushSettingService
+	pushService            service.PushService
+	paginator              *Paginator
 }
 
 // NewUserPushSettingHandler 创建用户推送设置处理器
-func NewUserPushSettingHandler(userPushSettingService service.UserPushSettingService) *UserPushSettingHandler {
+func NewUserPushSettingHandler(userPushSettingService service.UserPushSettingService, pushService service.PushService, paginator *Paginator) *UserPushSettingHandler {
 	return &UserPushSettingHandler{
 		userPushSettingService: userPushSettingService,
+		pushService:            pushService,
+		paginator:              paginator,
 	}
 }
 
+// ResolveSettingOwner 解析路径参数中推送设置的所有者ID，供所有权校验中间件使用
+func (h *UserPushSettingHandler) ResolveSettingOwner(c *fiber.Ctx) (uint, error) {
+	settingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "Invalid setting ID")
+	}
+
+	ownerID, err := h.userPushSettingService.GetSettingOwnerID(c.UserContext(), uint(settingID))
+	if err != nil {
+		if err == service.ErrUserPushSettingNotFound {
+			return 0, fiber.NewError(fiber.StatusNotFound, "Push setting not found")
+		}
+		return 0, err
+	}
+
+	return ownerID, nil
+}
+
 // CreateSetting godoc
 // @Summary      Create Push Setting
 // @Description  Create a new push notification setting for current user
@@ -31,10 +56,11 @@ func NewUserPushSettingHandler(userPushSettingService service.UserPushSettingSer
 // @Accept       json
 // @Produce      json
 // @Param        setting body dto.CreateUserPushSettingRequest true "Push setting creation data"
+// @Param        reveal_device_id query bool false "Return the full device ID instead of the masked one, only honored for the owner"
 // @Success      201 {object} dto.UserPushSettingResponse "Push setting created successfully"
 // @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
 // @Failure      401 {object} errors.APIError "Unauthorized"
-// @Failure      409 {object} errors.APIError "Device already exists"
+// @Failure      409 {object} errors.APIError "Device already exists or device limit reached"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /push-settings [post]
@@ -47,26 +73,18 @@ func (h *UserPushSettingHandler) CreateSetting(c *fiber.Ctx) error {
 	}
 
 	var req dto.CreateUserPushSettingRequest
-	if err := c.BodyParser(&req); err != nil {
-		logger.Error("Failed to parse request body", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "Failed to parse request body"),
-		)
-	}
-
-	if err := req.Validate(); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error()),
-		)
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	setting, err := h.userPushSettingService.CreateSetting(
-		c.Context(),
+		c.UserContext(),
 		userID,
 		req.Provider,
 		req.DeviceID,
 		req.DeviceName,
 		req.Settings,
+		req.Enabled,
 	)
 
 	if err != nil {
@@ -79,6 +97,8 @@ func (h *UserPushSettingHandler) CreateSetting(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusConflict).JSON(
 				apierrors.NewAPIError(fiber.StatusConflict, "Device already exists", "Device with this ID already registered"),
 			)
+		case service.ErrDeviceLimitReached:
+			return deviceLimitReached(c)
 		case service.ErrInvalidUserPushSetting:
 			return c.Status(fiber.StatusBadRequest).JSON(
 				apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid setting", "Invalid push setting configuration"),
@@ -90,17 +110,7 @@ func (h *UserPushSettingHandler) CreateSetting(c *fiber.Ctx) error {
 		}
 	}
 
-	response := dto.UserPushSettingResponse{
-		ID:         setting.ID,
-		UserID:     setting.UserID,
-		Provider:   setting.Provider,
-		Enabled:    setting.Enabled,
-		DeviceID:   setting.DeviceID,
-		DeviceName: setting.DeviceName,
-		Settings:   setting.Settings,
-		CreatedAt:  setting.CreatedAt,
-		UpdatedAt:  setting.UpdatedAt,
-	}
+	response := toUserPushSettingResponse(setting, revealDeviceID(c, setting))
 
 	return c.Status(fiber.StatusCreated).JSON(response)
 }
@@ -112,8 +122,9 @@ func (h *UserPushSettingHandler) CreateSetting(c *fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Param        page query int false "Page number" default(1)
-// @Param        limit query int false "Items per page" default(10)
-// @Param        provider query string false "Filter by provider" Enums(bark)
+// @Param        limit query int false "Items per page, values above server.pagination.max_limit are clamped" default(10)
+// @Param        provider query string false "Filter by provider" Enums(bark, email)
+// @Param        reveal_device_id query bool false "Return the full device ID instead of the masked one, only honored for the owner"
 // @Success      200 {object} dto.ListResponse[dto.UserPushSettingResponse] "List of user's push settings"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      500 {object} errors.APIError "Internal server error"
@@ -128,78 +139,41 @@ func (h *UserPushSettingHandler) GetSettings(c *fiber.Ctx) error {
 	}
 
 	// 解析查询参数
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	page, limit, _ := h.paginator.Parse(c)
 	provider := c.Query("provider")
 
-	var settings []dto.UserPushSettingResponse
+	var userSettings []*entity.UserPushSetting
 	var total int64
+	var err error
 
 	if provider != "" {
-		// 获取指定提供商的设置
-		userSettings, err := h.userPushSettingService.GetEnabledUserSettingsByProvider(c.Context(), userID, provider)
-		if err != nil {
-			logger.Error("Failed to get user push settings by provider", 
-				zap.Uint("user_id", userID), 
-				zap.String("provider", provider),
-				zap.Error(err))
-			return c.Status(fiber.StatusInternalServerError).JSON(
-				apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get push settings"),
-			)
-		}
-
-		settings = make([]dto.UserPushSettingResponse, len(userSettings))
-		for i, setting := range userSettings {
-			settings[i] = dto.UserPushSettingResponse{
-				ID:         setting.ID,
-				UserID:     setting.UserID,
-				Provider:   setting.Provider,
-				Enabled:    setting.Enabled,
-				DeviceID:   setting.DeviceID,
-				DeviceName: setting.DeviceName,
-				Settings:   setting.Settings,
-				CreatedAt:  setting.CreatedAt,
-				UpdatedAt:  setting.UpdatedAt,
-			}
-		}
-		total = int64(len(settings))
+		// 获取指定提供商的分页设置列表
+		userSettings, total, err = h.userPushSettingService.ListSettingsByProvider(c.UserContext(), userID, provider, page, limit)
 	} else {
 		// 获取分页的设置列表
-		userSettings, totalCount, err := h.userPushSettingService.ListSettings(c.Context(), userID, page, limit)
-		if err != nil {
-			logger.Error("Failed to list user push settings", 
-				zap.Uint("user_id", userID), 
-				zap.Error(err))
-			return c.Status(fiber.StatusInternalServerError).JSON(
-				apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list push settings"),
-			)
-		}
+		userSettings, total, err = h.userPushSettingService.ListSettings(c.UserContext(), userID, page, limit)
+	}
+	if err != nil {
+		logger.Error("Failed to list user push settings", 
+			zap.Uint("user_id", userID), 
+			zap.String("provider", provider),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list push settings"),
+		)
+	}
 
-		settings = make([]dto.UserPushSettingResponse, len(userSettings))
-		for i, setting := range userSettings {
-			settings[i] = dto.UserPushSettingResponse{
-				ID:         setting.ID,
-				UserID:     setting.UserID,
-				Provider:   setting.Provider,
-				Enabled:    setting.Enabled,
-				DeviceID:   setting.DeviceID,
-				DeviceName: setting.DeviceName,
-				Settings:   setting.Settings,
-				CreatedAt:  setting.CreatedAt,
-				UpdatedAt:  setting.UpdatedAt,
-			}
-		}
-		total = totalCount
+	settings := make([]dto.UserPushSettingResponse, len(userSettings))
+	for i, setting := range userSettings {
+		settings[i] = toUserPushSettingResponse(setting, revealDeviceID(c, setting))
 	}
 
 	response := dto.ListResponse[dto.UserPushSettingResponse]{
-		Data:  settings,
-		Total: total,
-		Page:  page,
-		Limit: limit,
+		Data:       settings,
+		Pagination: dto.NewPagination(total, page, limit),
 	}
 
-	return c.JSON(response)
+	return web.List(c, response, response.Pagination)
 }
 
 // GetSetting godoc
@@ -209,6 +183,7 @@ func (h *UserPushSettingHandler) GetSettings(c *fiber.Ctx) error {
 // @Accept       json
 // @Produce      json
 // @Param        id path int true "Push setting ID"
+// @Param        reveal_device_id query bool false "Return the full device ID instead of the masked one, only honored for the owner"
 // @Success      200 {object} dto.UserPushSettingResponse "Push setting retrieved successfully"
 // @Failure      400 {object} errors.APIError "Invalid setting ID"
 // @Failure      401 {object} errors.APIError "Unauthorized"
@@ -217,7 +192,7 @@ func (h *UserPushSettingHandler) GetSettings(c *fiber.Ctx) error {
 // @Security     Bearer
 // @Router       /push-settings/{id} [get]
 func (h *UserPushSettingHandler) GetSetting(c *fiber.Ctx) error {
-	userID, exists := auth.GetCurrentUserID(c)
+	userID, exists := auth.GetTargetUserID(c)
 	if !exists {
 		return c.Status(fiber.StatusUnauthorized).JSON(
 			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
@@ -231,7 +206,7 @@ func (h *UserPushSettingHandler) GetSetting(c *fiber.Ctx) error {
 		)
 	}
 
-	setting, err := h.userPushSettingService.GetSetting(c.Context(), userID, uint(settingID))
+	setting, err := h.userPushSettingService.GetSetting(c.UserContext(), userID, uint(settingID))
 	if err != nil {
 		logger.Error("Failed to get user push setting", 
 			zap.Uint("user_id", userID), 
@@ -250,17 +225,7 @@ func (h *UserPushSettingHandler) GetSetting(c *fiber.Ctx) error {
 		}
 	}
 
-	response := dto.UserPushSettingResponse{
-		ID:         setting.ID,
-		UserID:     setting.UserID,
-		Provider:   setting.Provider,
-		Enabled:    setting.Enabled,
-		DeviceID:   setting.DeviceID,
-		DeviceName: setting.DeviceName,
-		Settings:   setting.Settings,
-		CreatedAt:  setting.CreatedAt,
-		UpdatedAt:  setting.UpdatedAt,
-	}
+	response := toUserPushSettingResponse(setting, revealDeviceID(c, setting))
 
 	return c.JSON(response)
 }
@@ -273,15 +238,17 @@ func (h *UserPushSettingHandler) GetSetting(c *fiber.Ctx) error {
 // @Produce      json
 // @Param        id path int true "Push setting ID"
 // @Param        setting body dto.UpdateUserPushSettingRequest true "Push setting update data"
+// @Param        reveal_device_id query bool false "Return the full device ID instead of the masked one, only honored for the owner"
 // @Success      200 {object} dto.UserPushSettingResponse "Push setting updated successfully"
 // @Failure      400 {object} errors.APIError "Invalid request parameters or validation failed"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      404 {object} errors.APIError "Push setting not found"
+// @Failure      409 {object} errors.APIError "Device limit reached when enabling"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /push-settings/{id} [put]
 func (h *UserPushSettingHandler) UpdateSetting(c *fiber.Ctx) error {
-	userID, exists := auth.GetCurrentUserID(c)
+	userID, exists := auth.GetTargetUserID(c)
 	if !exists {
 		return c.Status(fiber.StatusUnauthorized).JSON(
 			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
@@ -296,21 +263,12 @@ func (h *UserPushSettingHandler) UpdateSetting(c *fiber.Ctx) error {
 	}
 
 	var req dto.UpdateUserPushSettingRequest
-	if err := c.BodyParser(&req); err != nil {
-		logger.Error("Failed to parse request body", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "Failed to parse request body"),
-		)
-	}
-
-	if err := req.Validate(); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error()),
-		)
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
 	// 获取现有设置
-	existingSetting, err := h.userPushSettingService.GetSetting(c.Context(), userID, uint(settingID))
+	existingSetting, err := h.userPushSettingService.GetSetting(c.UserContext(), userID, uint(settingID))
 	if err != nil {
 		switch err {
 		case service.ErrUserPushSettingNotFound:
@@ -335,7 +293,10 @@ func (h *UserPushSettingHandler) UpdateSetting(c *fiber.Ctx) error {
 		existingSetting.Settings = req.Settings
 	}
 
-	setting, err := h.userPushSettingService.UpdateSetting(c.Context(), userID, existingSetting)
+	setting, err := h.userPushSettingService.UpdateSetting(c.UserContext(), userID, existingSetting)
+	if err == service.ErrDeviceLimitReached {
+		return deviceLimitReached(c)
+	}
 	if err != nil {
 		logger.Error("Failed to update user push setting", 
 			zap.Uint("user_id", userID), 
@@ -346,17 +307,7 @@ func (h *UserPushSettingHandler) UpdateSetting(c *fiber.Ctx) error {
 		)
 	}
 
-	response := dto.UserPushSettingResponse{
-		ID:         setting.ID,
-		UserID:     setting.UserID,
-		Provider:   setting.Provider,
-		Enabled:    setting.Enabled,
-		DeviceID:   setting.DeviceID,
-		DeviceName: setting.DeviceName,
-		Settings:   setting.Settings,
-		CreatedAt:  setting.CreatedAt,
-		UpdatedAt:  setting.UpdatedAt,
-	}
+	response := toUserPushSettingResponse(setting, revealDeviceID(c, setting))
 
 	return c.JSON(response)
 }
@@ -372,11 +323,12 @@ func (h *UserPushSettingHandler) UpdateSetting(c *fiber.Ctx) error {
 // @Failure      400 {object} errors.APIError "Invalid setting ID"
 // @Failure      401 {object} errors.APIError "Unauthorized"
 // @Failure      404 {object} errors.APIError "Push setting not found"
+// @Failure      409 {object} errors.APIError "Device limit reached"
 // @Failure      500 {object} errors.APIError "Internal server error"
 // @Security     Bearer
 // @Router       /push-settings/{id}/enable [post]
 func (h *UserPushSettingHandler) EnableSetting(c *fiber.Ctx) error {
-	userID, exists := auth.GetCurrentUserID(c)
+	userID, exists := auth.GetTargetUserID(c)
 	if !exists {
 		return c.Status(fiber.StatusUnauthorized).JSON(
 			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
@@ -390,7 +342,7 @@ func (h *UserPushSettingHandler) EnableSetting(c *fiber.Ctx) error {
 		)
 	}
 
-	err = h.userPushSettingService.EnableSetting(c.Context(), userID, uint(settingID))
+	err = h.userPushSettingService.EnableSetting(c.UserContext(), userID, uint(settingID))
 	if err != nil {
 		logger.Error("Failed to enable user push setting", 
 			zap.Uint("user_id", userID), 
@@ -402,6 +354,8 @@ func (h *UserPushSettingHandler) EnableSetting(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusNotFound).JSON(
 				apierrors.NewAPIError(fiber.StatusNotFound, "Setting not found", "Push setting not found"),
 			)
+		case service.ErrDeviceLimitReached:
+			return deviceLimitReached(c)
 		default:
 			return c.Status(fiber.StatusInternalServerError).JSON(
 				apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to enable push setting"),
@@ -429,7 +383,7 @@ func (h *UserPushSettingHandler) EnableSetting(c *fiber.Ctx) error {
 // @Security     Bearer
 // @Router       /push-settings/{id}/disable [post]
 func (h *UserPushSettingHandler) DisableSetting(c *fiber.Ctx) error {
-	userID, exists := auth.GetCurrentUserID(c)
+	userID, exists := auth.GetTargetUserID(c)
 	if !exists {
 		return c.Status(fiber.StatusUnauthorized).JSON(
 			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
@@ -443,7 +397,7 @@ func (h *UserPushSettingHandler) DisableSetting(c *fiber.Ctx) error {
 		)
 	}
 
-	err = h.userPushSettingService.DisableSetting(c.Context(), userID, uint(settingID))
+	err = h.userPushSettingService.DisableSetting(c.UserContext(), userID, uint(settingID))
 	if err != nil {
 		logger.Error("Failed to disable user push setting", 
 			zap.Uint("user_id", userID), 
@@ -482,7 +436,7 @@ func (h *UserPushSettingHandler) DisableSetting(c *fiber.Ctx) error {
 // @Security     Bearer
 // @Router       /push-settings/{id} [delete]
 func (h *UserPushSettingHandler) DeleteSetting(c *fiber.Ctx) error {
-	userID, exists := auth.GetCurrentUserID(c)
+	userID, exists := auth.GetTargetUserID(c)
 	if !exists {
 		return c.Status(fiber.StatusUnauthorized).JSON(
 			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
@@ -496,7 +450,7 @@ func (h *UserPushSettingHandler) DeleteSetting(c *fiber.Ctx) error {
 		)
 	}
 
-	err = h.userPushSettingService.DeleteSetting(c.Context(), userID, uint(settingID))
+	err = h.userPushSettingService.DeleteSetting(c.UserContext(), userID, uint(settingID))
 	if err != nil {
 		logger.Error("Failed to delete user push setting", 
 			zap.Uint("user_id", userID), 
@@ -529,23 +483,25 @@ func (h *UserPushSettingHandler) DeleteSetting(c *fiber.Ctx) error {
 // @Success      200 {object} map[string]interface{} "List of supported providers with configuration options"
 // @Router       /push-settings/providers [get]
 func (h *UserPushSettingHandler) GetSupportedProviders(c *fiber.Ctx) error {
-	// 返回支持的推送提供商列表
-	providers := []fiber.Map{
-		{
-			"name":        "bark",
-			"display_name": "Bark",
-			"description":  "iOS Bark push notification service",
-			"platform":     "ios",
-			"settings": fiber.Map{
-				"base_url":  "Custom Bark server URL (optional)",
-				"sound":     "Notification sound (optional)",
-				"icon":      "Notification icon URL (optional)", 
-				"group":     "Notification group (optional)",
-				"level":     "Notification level: active, critical, timeSensitive, passive (optional)",
-				"auto_copy": "Auto copy message to clipboard (optional)",
-				"call":      "Ring for 30 seconds (optional)",
-			},
-		},
+	// 根据已注册提供商的能力生成支持的推送提供商列表
+	capabilities := h.pushService.GetProviderCapabilities()
+
+	providers := make([]fiber.Map, len(capabilities))
+	for i, capability := range capabilities {
+		settings := fiber.Map{}
+		for _, setting := range capability.Settings {
+			settings[setting.Name] = setting.Description
+		}
+
+		providers[i] = fiber.Map{
+			"name":         capability.Name,
+			"display_name": capability.DisplayName,
+			"description":  capability.Description,
+			"platform":     capability.Platform,
+			"fields":       capability.Fields,
+			"settings":     settings,
+			"enabled":      capability.Enabled,
+		}
 	}
 
 	return c.JSON(fiber.Map{
@@ -554,6 +510,66 @@ func (h *UserPushSettingHandler) GetSupportedProviders(c *fiber.Ctx) error {
 	})
 }
 
+// GetProviderSchema godoc
+// @Summary      Get Push Provider Settings Schema
+// @Description  Get the settings fields, types and validation rules of a push provider for building configuration forms
+// @Tags         Push Settings
+// @Accept       json
+// @Produce      json
+// @Param        provider path string true "Provider name"
+// @Success      200 {object} map[string]interface{} "Settings schema of the provider"
+// @Failure      404 {object} errors.APIError "Provider not found"
+// @Router       /push-settings/providers/{provider}/schema [get]
+func (h *UserPushSettingHandler) GetProviderSchema(c *fiber.Ctx) error {
+	capability, err := h.pushService.GetProviderCapability(c.Params("provider"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(
+			apierrors.NewAPIError(fiber.StatusNotFound, "Provider not found", "Push provider is not supported"),
+		)
+	}
+
+	// 设备字段的校验规则与创建推送设置请求的校验保持一致
+	deviceFields := []push.SettingField{
+		{Name: "device_id", Type: push.SettingTypeString, Description: "Device key issued by the provider", Required: true, MaxLength: 255},
+		{Name: "device_name", Type: push.SettingTypeString, Description: "Display name of the device", Required: true, MaxLength: 100},
+	}
+
+	return c.JSON(fiber.Map{
+		"name":          capability.Name,
+		"display_name":  capability.DisplayName,
+		"enabled":       capability.Enabled,
+		"device_fields": deviceFields,
+		"settings":      capability.Settings,
+		"levels":        capability.Levels,
+	})
+}
+
+// GetProvidersHealth godoc
+// @Summary      Get Push Providers Health
+// @Description  Check whether the upstream of each enabled push provider is reachable (results are cached briefly)
+// @Tags         Push Settings
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} map[string]interface{} "Reachability status of each provider"
+// @Router       /push-settings/providers/health [get]
+func (h *UserPushSettingHandler) GetProvidersHealth(c *fiber.Ctx) error {
+	results := h.pushService.CheckProviderHealth(c.UserContext())
+
+	healthy := true
+	for _, result := range results {
+		if !result.Reachable {
+			healthy = false
+			break
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"healthy":   healthy,
+		"providers": results,
+		"total":     len(results),
+	})
+}
+
 // ValidateDevice godoc
 // @Summary      Validate Device ID
 // @Description  Validate if a device ID is available for registration
@@ -568,20 +584,11 @@ func (h *UserPushSettingHandler) GetSupportedProviders(c *fiber.Ctx) error {
 // @Router       /push-settings/validate-device [post]
 func (h *UserPushSettingHandler) ValidateDevice(c *fiber.Ctx) error {
 	var req dto.ValidateDeviceRequest
-	if err := c.BodyParser(&req); err != nil {
-		logger.Error("Failed to parse request body", zap.Error(err))
-		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "Failed to parse request body"),
-		)
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
 	}
 
-	if err := req.Validate(); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error()),
-		)
-	}
-
-	err := h.userPushSettingService.ValidateDeviceID(c.Context(), req.Provider, req.DeviceID)
+	err := h.userPushSettingService.ValidateDeviceID(c.UserContext(), req.Provider, req.DeviceID)
 	if err != nil {
 		switch err {
 		case service.ErrDeviceAlreadyExists:
@@ -591,7 +598,7 @@ func (h *UserPushSettingHandler) ValidateDevice(c *fiber.Ctx) error {
 		default:
 			logger.Error("Failed to validate device ID", 
 				zap.String("provider", req.Provider),
-				zap.String("device_id", req.DeviceID),
+				zap.String("device_id", push.MaskDeviceID(req.DeviceID)),
 				zap.Error(err))
 			return c.Status(fiber.StatusInternalServerError).JSON(
 				apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to validate device"),
@@ -603,4 +610,218 @@ func (h *UserPushSettingHandler) ValidateDevice(c *fiber.Ctx) error {
 		"valid": true,
 		"message": "Device ID is available",
 	})
-}
\ No newline at end of file
+}
+
+// EnableAllSettings godoc
+// @Summary      Enable All Push Settings
+// @Description  Enable all of current user's push notification settings at once
+// @Tags         Push Settings
+// @Produce      json
+// @Success      200 {object} dto.SetAllEnabledResponse "Number of settings that were enabled"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      409 {object} errors.APIError "Enabling all devices would exceed the device limit"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push-settings/enable-all [post]
+func (h *UserPushSettingHandler) EnableAllSettings(c *fiber.Ctx) error {
+	return h.setAllEnabled(c, true)
+}
+
+// DisableAllSettings godoc
+// @Summary      Disable All Push Settings
+// @Description  Disable all of current user's push notification settings at once, e.g. to pause notifications
+// @Tags         Push Settings
+// @Produce      json
+// @Success      200 {object} dto.SetAllEnabledResponse "Number of settings that were disabled"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push-settings/disable-all [post]
+func (h *UserPushSettingHandler) DisableAllSettings(c *fiber.Ctx) error {
+	return h.setAllEnabled(c, false)
+}
+
+// setAllEnabled 批量修改当前用户所有推送设置的启用状态
+func (h *UserPushSettingHandler) setAllEnabled(c *fiber.Ctx, enabled bool) error {
+	userID, exists := auth.GetCurrentUserID(c)
+	if !exists {
+		return c.Status(fiber.StatusUnauthorized).JSON(
+			apierrors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "User not authenticated"),
+		)
+	}
+
+	updated, err := h.userPushSettingService.SetAllEnabled(c.UserContext(), userID, enabled)
+	if err == service.ErrDeviceLimitReached {
+		return deviceLimitReached(c)
+	}
+	if err != nil {
+		logger.Error("Failed to set enabled for all user push settings",
+			zap.Uint("user_id", userID),
+			zap.Bool("enabled", enabled),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to update push settings"),
+		)
+	}
+
+	return c.JSON(dto.SetAllEnabledResponse{
+		Enabled: enabled,
+		Updated: updated,
+	})
+}
+
+// TransferSetting godoc
+// @Summary      Transfer Push Setting
+// @Description  Move a push device from one user to another, e.g. when its owner switches accounts. The provider and device ID stay the same; an enabled device counts against the target user's device limit (requires user:manage permission)
+// @Tags         Push Settings
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Push setting ID"
+// @Param        request body dto.TransferUserPushSettingRequest true "Current and target owner"
+// @Success      200 {object} dto.UserPushSettingResponse "Push setting transferred"
+// @Failure      400 {object} errors.APIError "Invalid setting ID or request"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Forbidden"
+// @Failure      404 {object} errors.APIError "Push setting or target user not found"
+// @Failure      409 {object} errors.APIError "Device limit of the target user reached"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /push-settings/{id}/transfer [post]
+func (h *UserPushSettingHandler) TransferSetting(c *fiber.Ctx) error {
+	settingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid setting ID"),
+		)
+	}
+
+	var req dto.TransferUserPushSettingRequest
+	if err := web.ParseBody(c, &req); err != nil {
+		return err
+	}
+
+	setting, err := h.userPushSettingService.TransferDevice(c.UserContext(), req.FromUserID, req.ToUserID, uint(settingID))
+	if err != nil {
+		logger.Error("Failed to transfer user push setting",
+			zap.Uint("setting_id", uint(settingID)),
+			zap.Uint("from_user_id", req.FromUserID),
+			zap.Uint("to_user_id", req.ToUserID),
+			zap.Error(err))
+
+		switch err {
+		case service.ErrUserPushSettingNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(
+				apierrors.NewAPIError(fiber.StatusNotFound, "Setting not found", "Push setting not found"),
+			)
+		case service.ErrUserNotFound:
+			return c.Status(fiber.StatusNotFound).JSON(
+				apierrors.NewAPIError(fiber.StatusNotFound, "User not found", "Target user not found"),
+			)
+		case service.ErrDeviceTransferToSameUser:
+			return c.Status(fiber.StatusBadRequest).JSON(
+				apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request", "Device already belongs to the target user"),
+			)
+		case service.ErrDeviceLimitReached:
+			return deviceLimitReached(c)
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(
+				apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to transfer push setting"),
+			)
+		}
+	}
+
+	return c.JSON(toUserPushSettingResponse(setting, revealDeviceID(c, setting)))
+}
+
+// GetUserDevices godoc
+// @Summary      Get User Devices
+// @Description  List all push devices registered by a user for support staff debugging notifications. Device IDs are masked and provider settings are omitted (requires user:read or user:manage permission)
+// @Tags         Push Settings
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "User ID"
+// @Success      200 {object} dto.UserDevicesResponse "Push devices of the user"
+// @Failure      400 {object} errors.APIError "Invalid user ID"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Forbidden"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /users/{id}/devices [get]
+func (h *UserPushSettingHandler) GetUserDevices(c *fiber.Ctx) error {
+	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid ID", "Invalid user ID"),
+		)
+	}
+
+	userSettings, err := h.userPushSettingService.GetUserSettings(c.UserContext(), uint(userID))
+	if err != nil {
+		logger.Error("Failed to get user push devices",
+			zap.Uint64("user_id", userID),
+			zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to get push devices"),
+		)
+	}
+
+	devices := make([]dto.UserDeviceResponse, len(userSettings))
+	for i, setting := range userSettings {
+		devices[i] = dto.UserDeviceResponse{
+			ID:         setting.ID,
+			Provider:   setting.Provider,
+			Enabled:    setting.Enabled,
+			DeviceID:   push.MaskDeviceID(setting.DeviceID),
+			DeviceName: setting.DeviceName,
+			CreatedAt:  setting.CreatedAt,
+			UpdatedAt:  setting.UpdatedAt,
+
+			ConsecutiveFailures: setting.ConsecutiveFailures,
+			LastFailureAt:       setting.LastFailureAt,
+		}
+	}
+
+	return c.JSON(dto.UserDevicesResponse{
+		UserID:  uint(userID),
+		Devices: devices,
+	})
+}
+
+// revealDeviceID 判断响应中是否返回完整设备ID，仅所有者通过reveal_device_id=true查询参数请求时返回
+func revealDeviceID(c *fiber.Ctx, setting *entity.UserPushSetting) bool {
+	if !c.QueryBool("reveal_device_id") {
+		return false
+	}
+	currentUserID, exists := auth.GetCurrentUserID(c)
+	return exists && currentUserID == setting.UserID
+}
+
+// toUserPushSettingResponse 将推送设置转换为响应，除非reveal为true，设备ID会被脱敏
+func toUserPushSettingResponse(setting *entity.UserPushSetting, reveal bool) dto.UserPushSettingResponse {
+	deviceID := setting.DeviceID
+	if !reveal {
+		deviceID = push.MaskDeviceID(deviceID)
+	}
+
+	return dto.UserPushSettingResponse{
+		ID:         setting.ID,
+		UserID:     setting.UserID,
+		Provider:   setting.Provider,
+		Enabled:    setting.Enabled,
+		DeviceID:   deviceID,
+		DeviceName: setting.DeviceName,
+		Settings:   setting.Settings,
+		CreatedAt:  setting.CreatedAt,
+		UpdatedAt:  setting.UpdatedAt,
+
+		ConsecutiveFailures: setting.ConsecutiveFailures,
+		LastFailureAt:       setting.LastFailureAt,
+	}
+}
+
+// deviceLimitReached 返回设备数量达到上限的409响应
+func deviceLimitReached(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusConflict).JSON(
+		apierrors.NewAPIError(fiber.StatusConflict, "Device limit reached", "Disable or delete a push device before adding another"),
+	)
+}
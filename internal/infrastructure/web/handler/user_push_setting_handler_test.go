@@ -0,0 +1,401 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/pkg/push"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// testUserHeader 测试请求中指定当前用户名的请求头
+const testUserHeader = "X-Test-User"
+
+// pushSettingTestEnv 推送设置路由，当前用户由testUserHeader指定
+type pushSettingTestEnv struct {
+	app      *fiber.App
+	settings service.UserPushSettingService
+	users    map[string]*entity.User
+}
+
+func newPushSettingTestEnv(t *testing.T) *pushSettingTestEnv {
+	t.Helper()
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	users := map[string]*entity.User{}
+	for _, name := range []string{"ivy", "kate"} {
+		user, err := userService.CreateUser(ctx, name, name+"@example.com", "Password123!", name)
+		if err != nil {
+			t.Fatalf("CreateUser(%s) error = %v", name, err)
+		}
+		users[name] = user
+	}
+
+	settingRepo := persistence.NewUserPushSettingRepository(client)
+	userRepo := persistence.NewUserRepository(client)
+	settings := service.NewUserPushSettingService(settingRepo, userRepo, rbacService, service.UserPushSettingServiceConfig{})
+	pushService := service.NewPushService(settings, settingRepo, userRepo, testutil.NewEventBus(t), service.PushServiceConfig{})
+	settingHandler := handler.NewUserPushSettingHandler(settings, pushService, handler.NewPaginator(&config.Config{}))
+
+	app := fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	app.Use(func(c *fiber.Ctx) error {
+		user := users[c.Get(testUserHeader)]
+		c.Locals(auth.AuthContextKey, &auth.UserClaims{UserID: user.ID, Username: user.Username})
+		c.Locals(auth.UserIDContextKey, user.ID)
+		return c.Next()
+	})
+	app.Post("/push-settings", settingHandler.CreateSetting)
+	app.Get("/push-settings", settingHandler.GetSettings)
+	app.Post("/push-settings/enable-all", settingHandler.EnableAllSettings)
+	app.Post("/push-settings/disable-all", settingHandler.DisableAllSettings)
+	app.Get("/push-settings/providers", settingHandler.GetSupportedProviders)
+	app.Get("/push-settings/providers/:provider/schema", settingHandler.GetProviderSchema)
+	app.Get("/push-settings/:id", settingHandler.GetSetting)
+	app.Get("/users/:id/devices", settingHandler.GetUserDevices)
+
+	return &pushSettingTestEnv{app: app, settings: settings, users: users}
+}
+
+// request 以用户身份发送请求，返回状态码和响应体
+func (e *pushSettingTestEnv) request(t *testing.T, user, method, path, body string) (int, []byte) {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(testUserHeader, user)
+	if body != "" {
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	resp, err := e.app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read response error = %v", err)
+	}
+	return resp.StatusCode, data
+}
+
+func TestUserPushSettingHandler_CreateReportsAllInvalidFields(t *testing.T) {
+	env := newPushSettingTestEnv(t)
+
+	body := `{"provider":"bark","device_id":"","device_name":"` + strings.Repeat("a", 101) + `"}`
+	status, data := env.request(t, "ivy", fiber.MethodPost, "/push-settings", body)
+	if status != fiber.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", status, fiber.StatusBadRequest)
+	}
+
+	var apiErr struct {
+		Details struct {
+			Fields []struct {
+				Field   string `json:"field"`
+				Message string `json:"message"`
+			} `json:"fields"`
+		} `json:"details"`
+	}
+	if err := json.Unmarshal(data, &apiErr); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	fields := map[string]string{}
+	for _, field := range apiErr.Details.Fields {
+		fields[field.Field] = field.Message
+	}
+	if len(fields) != 2 || fields["device_id"] == "" || fields["device_name"] == "" {
+		t.Errorf("details.fields = %+v, want device_id and device_name", apiErr.Details.Fields)
+	}
+
+	if got, err := env.settings.GetUserSettings(context.Background(), env.users["ivy"].ID); err != nil || len(got) != 0 {
+		t.Errorf("GetUserSettings() = %d settings, %v; want none after a rejected request", len(got), err)
+	}
+}
+
+func TestUserPushSettingHandler_DeviceIDMaskedUnlessOwnerReveals(t *testing.T) {
+	const deviceKey = "abcd1234secretefgh5678"
+	const masked = "abcd****5678"
+	env := newPushSettingTestEnv(t)
+	enabled := true
+	setting, err := env.settings.CreateSetting(context.Background(), env.users["ivy"].ID, "bark", deviceKey, "iPhone", nil, &enabled)
+	if err != nil {
+		t.Fatalf("CreateSetting() error = %v", err)
+	}
+
+	// deviceID 以用户身份请求设置详情或列表，返回响应中的设备ID
+	deviceID := func(user, path string) string {
+		t.Helper()
+		status, data := env.request(t, user, fiber.MethodGet, path, "")
+		if status != fiber.StatusOK {
+			t.Fatalf("GET %s as %s status = %d, want %d", path, user, status, fiber.StatusOK)
+		}
+		var single dto.UserPushSettingResponse
+		var list dto.ListResponse[dto.UserPushSettingResponse]
+		var devices dto.UserDevicesResponse
+		switch {
+		case strings.HasPrefix(path, "/users/"):
+			if err := json.Unmarshal(data, &devices); err != nil || len(devices.Devices) != 1 {
+				t.Fatalf("decode %s = %s, %v", path, data, err)
+			}
+			return devices.Devices[0].DeviceID
+		case strings.HasPrefix(path, "/push-settings?"):
+			if err := json.Unmarshal(data, &list); err != nil || len(list.Data) != 1 {
+				t.Fatalf("decode %s = %s, %v", path, data, err)
+			}
+			return list.Data[0].DeviceID
+		default:
+			if err := json.Unmarshal(data, &single); err != nil {
+				t.Fatalf("decode %s = %s, %v", path, data, err)
+			}
+			return single.DeviceID
+		}
+	}
+
+	getPath := fmt.Sprintf("/push-settings/%d", setting.ID)
+	tests := []struct {
+		user string
+		path string
+		want string
+	}{
+		{"ivy", "/push-settings?page=1", masked},
+		{"ivy", getPath, masked},
+		{"ivy", "/push-settings?reveal_device_id=true", deviceKey},
+		{"ivy", getPath + "?reveal_device_id=true", deviceKey},
+		{"ivy", getPath + "?reveal_device_id=false", masked},
+		// 设备列表接口面向管理员，不支持显示完整设备ID
+		{"kate", fmt.Sprintf("/users/%d/devices?reveal_device_id=true", env.users["ivy"].ID), masked},
+	}
+	for _, tt := range tests {
+		if got := deviceID(tt.user, tt.path); got != tt.want {
+			t.Errorf("GET %s as %s device_id = %q, want %q", tt.path, tt.user, got, tt.want)
+		}
+	}
+}
+
+func TestUserPushSettingHandler_ProvidersReflectCapabilities(t *testing.T) {
+	env := newPushSettingTestEnv(t)
+
+	status, data := env.request(t, "ivy", fiber.MethodGet, "/push-settings/providers", "")
+	if status != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d", status, fiber.StatusOK)
+	}
+	var body struct {
+		Providers []struct {
+			Name     string            `json:"name"`
+			Fields   []string          `json:"fields"`
+			Settings map[string]string `json:"settings"`
+			Enabled  bool              `json:"enabled"`
+		} `json:"providers"`
+		Total int `json:"total"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	if body.Total != len(body.Providers) || body.Total != 2 {
+		t.Fatalf("providers = %+v, want bark and email", body.Providers)
+	}
+
+	bark := body.Providers[0]
+	if bark.Name != "bark" || !bark.Enabled {
+		t.Fatalf("providers[0] = %+v, want enabled bark", bark)
+	}
+	wantBark := push.NewBarkProvider(nil, push.BarkConfig{}).Capabilities()
+	if strings.Join(bark.Fields, ",") != strings.Join(wantBark.Fields, ",") {
+		t.Errorf("bark fields = %v, want %v", bark.Fields, wantBark.Fields)
+	}
+	for _, setting := range wantBark.Settings {
+		if bark.Settings[setting.Name] != setting.Description {
+			t.Errorf("bark setting %s = %q, want %q", setting.Name, bark.Settings[setting.Name], setting.Description)
+		}
+	}
+
+	// 未配置SMTP时邮件提供商列出但未启用
+	if email := body.Providers[1]; email.Name != "email" || email.Enabled || slices.Contains(email.Fields, push.FieldImage) {
+		t.Errorf("providers[1] = %+v, want disabled email without image support", email)
+	}
+}
+
+func TestUserPushSettingHandler_ProviderSchema(t *testing.T) {
+	env := newPushSettingTestEnv(t)
+
+	status, data := env.request(t, "ivy", fiber.MethodGet, "/push-settings/providers/bark/schema", "")
+	if status != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d", status, fiber.StatusOK)
+	}
+	var schema struct {
+		Name         string              `json:"name"`
+		Enabled      bool                `json:"enabled"`
+		DeviceFields []push.SettingField `json:"device_fields"`
+		Settings     []push.SettingField `json:"settings"`
+		Levels       map[string]string   `json:"levels"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	if schema.Name != "bark" || !schema.Enabled {
+		t.Errorf("schema = %+v, want enabled bark", schema)
+	}
+
+	settings := map[string]push.SettingField{}
+	for _, setting := range schema.Settings {
+		settings[setting.Name] = setting
+	}
+	for name, wantType := range map[string]string{
+		"base_url":  push.SettingTypeURL,
+		"sound":     push.SettingTypeString,
+		"level":     push.SettingTypeEnum,
+		"auto_copy": push.SettingTypeBool,
+	} {
+		if settings[name].Type != wantType {
+			t.Errorf("setting %s type = %q, want %q", name, settings[name].Type, wantType)
+		}
+	}
+	if got := settings["level"].Options; len(got) != len(push.Levels) {
+		t.Errorf("level options = %v, want the %d common levels", got, len(push.Levels))
+	}
+	if schema.Levels["timeSensitive"] != "timeSensitive" {
+		t.Errorf("levels = %v, want the Bark level mapping", schema.Levels)
+	}
+
+	// 设备字段的规则与创建请求的校验一致
+	deviceFields := map[string]push.SettingField{}
+	for _, field := range schema.DeviceFields {
+		deviceFields[field.Name] = field
+	}
+	if f := deviceFields["device_id"]; !f.Required || f.MaxLength != 255 {
+		t.Errorf("device_id field = %+v, want required with max length 255", f)
+	}
+	if f := deviceFields["device_name"]; !f.Required || f.MaxLength != 100 {
+		t.Errorf("device_name field = %+v, want required with max length 100", f)
+	}
+
+	if status, _ := env.request(t, "ivy", fiber.MethodGet, "/push-settings/providers/sms/schema", ""); status != fiber.StatusNotFound {
+		t.Errorf("unknown provider schema status = %d, want %d", status, fiber.StatusNotFound)
+	}
+}
+
+func TestUserPushSettingHandler_DisableAndEnableAll(t *testing.T) {
+	ctx := context.Background()
+	env := newPushSettingTestEnv(t)
+	add := func(user, deviceID string, enabled bool) {
+		t.Helper()
+		if _, err := env.settings.CreateSetting(ctx, env.users[user].ID, "bark", deviceID, deviceID, nil, &enabled); err != nil {
+			t.Fatalf("CreateSetting(%s) error = %v", deviceID, err)
+		}
+	}
+	add("ivy", "ivy-1", true)
+	add("ivy", "ivy-2", true)
+	add("ivy", "ivy-3", false)
+	add("kate", "kate-1", true)
+
+	setAll := func(path string) dto.SetAllEnabledResponse {
+		t.Helper()
+		status, data := env.request(t, "ivy", fiber.MethodPost, path, "")
+		if status != fiber.StatusOK {
+			t.Fatalf("POST %s status = %d, body = %s", path, status, data)
+		}
+		var resp dto.SetAllEnabledResponse
+		if err := json.Unmarshal(data, &resp); err != nil {
+			t.Fatalf("decode response error = %v", err)
+		}
+		return resp
+	}
+	enabledCount := func(user string) int {
+		t.Helper()
+		settings, err := env.settings.GetEnabledUserSettings(ctx, env.users[user].ID)
+		if err != nil {
+			t.Fatalf("GetEnabledUserSettings(%s) error = %v", user, err)
+		}
+		return len(settings)
+	}
+
+	// 只统计状态实际发生变化的设备
+	if resp := setAll("/push-settings/disable-all"); resp.Enabled || resp.Updated != 2 {
+		t.Errorf("disable-all = %+v, want enabled false and 2 updated", resp)
+	}
+	if got := enabledCount("ivy"); got != 0 {
+		t.Errorf("ivy enabled devices = %d, want 0", got)
+	}
+	if got := enabledCount("kate"); got != 1 {
+		t.Errorf("kate enabled devices = %d, want 1 (other users untouched)", got)
+	}
+	if resp := setAll("/push-settings/disable-all"); resp.Updated != 0 {
+		t.Errorf("repeated disable-all updated = %d, want 0", resp.Updated)
+	}
+
+	if resp := setAll("/push-settings/enable-all"); !resp.Enabled || resp.Updated != 3 {
+		t.Errorf("enable-all = %+v, want enabled true and 3 updated", resp)
+	}
+	if got := enabledCount("ivy"); got != 3 {
+		t.Errorf("ivy enabled devices = %d, want 3", got)
+	}
+}
+
+func TestUserPushSettingHandler_GetUserDevicesListsTargetUser(t *testing.T) {
+	ctx := context.Background()
+	env := newPushSettingTestEnv(t)
+	ivy, kate := env.users["ivy"], env.users["kate"]
+	enabled, disabled := true, false
+	for _, device := range []struct {
+		user    *entity.User
+		id      string
+		name    string
+		enabled *bool
+	}{
+		{ivy, "ivy-iphone-device-key", "iPhone", &enabled},
+		{ivy, "ivy-ipad-device-key", "iPad", &disabled},
+		{kate, "kate-iphone-device-key", "Kate's iPhone", &enabled},
+	} {
+		if _, err := env.settings.CreateSetting(ctx, device.user.ID, "bark", device.id, device.name, map[string]interface{}{"sound": "bell"}, device.enabled); err != nil {
+			t.Fatalf("CreateSetting(%s) error = %v", device.id, err)
+		}
+	}
+
+	// 客服以自己的身份查看其他用户的设备
+	status, data := env.request(t, "kate", fiber.MethodGet, fmt.Sprintf("/users/%d/devices", ivy.ID), "")
+	if status != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", status, fiber.StatusOK, data)
+	}
+	var resp dto.UserDevicesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("decode response error = %v", err)
+	}
+	if resp.UserID != ivy.ID || len(resp.Devices) != 2 {
+		t.Fatalf("response = %+v, want ivy's two devices", resp)
+	}
+	got := map[string]dto.UserDeviceResponse{}
+	for _, device := range resp.Devices {
+		got[device.DeviceName] = device
+	}
+	if d := got["iPhone"]; d.Provider != "bark" || !d.Enabled || d.DeviceID != "ivy-****-key" {
+		t.Errorf("iPhone = %+v, want enabled bark device with a masked ID", d)
+	}
+	if d := got["iPad"]; d.Enabled || d.DeviceID != "ivy-****-key" {
+		t.Errorf("iPad = %+v, want disabled device with a masked ID", d)
+	}
+	// 响应中既没有完整设备ID，也没有提供商设置
+	if strings.Contains(string(data), "device-key") || strings.Contains(string(data), "bell") {
+		t.Errorf("response leaked the device ID or settings: %s", data)
+	}
+
+	if status, _ := env.request(t, "kate", fiber.MethodGet, "/users/abc/devices", ""); status != fiber.StatusBadRequest {
+		t.Errorf("GET /users/abc/devices status = %d, want %d", status, fiber.StatusBadRequest)
+	}
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+	apierrors "nebula-live/pkg/errors"
+	"nebula-live/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// WebhookHandler Webhook处理器
+type WebhookHandler struct {
+	webhookService service.WebhookService
+	paginator      *Paginator
+}
+
+// NewWebhookHandler 创建Webhook处理器
+func NewWebhookHandler(webhookService service.WebhookService, paginator *Paginator) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		paginator:      paginator,
+	}
+}
+
+// ListDeliveries godoc
+// @Summary      List Webhook Deliveries
+// @Description  List webhook delivery attempts, newest first. Each retry is a separate entry sharing the delivery_id.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        event query string false "Filter by event type" Enums(user.created, user.banned, user.deleted)
+// @Param        page query int false "Page number" default(1)
+// @Param        limit query int false "Items per page, values above server.pagination.max_limit are clamped" default(10)
+// @Success      200 {object} dto.ListResponse[dto.WebhookDeliveryResponse] "Webhook deliveries"
+// @Failure      400 {object} errors.APIError "Unknown event type"
+// @Failure      401 {object} errors.APIError "Unauthorized"
+// @Failure      403 {object} errors.APIError "Forbidden"
+// @Failure      500 {object} errors.APIError "Internal server error"
+// @Security     Bearer
+// @Router       /admin/webhooks/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c *fiber.Ctx) error {
+	event := c.Query("event")
+	if event != "" && !entity.IsValidWebhookEvent(event) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid event", "Unknown webhook event type: "+event),
+		)
+	}
+
+	page, limit, _ := h.paginator.Parse(c)
+
+	deliveries, total, err := h.webhookService.ListDeliveries(c.UserContext(), event, page, limit)
+	if err != nil {
+		logger.Error("Failed to list webhook deliveries", zap.Error(err))
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			apierrors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to list webhook deliveries"),
+		)
+	}
+
+	data := make([]dto.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		data[i] = dto.WebhookDeliveryResponse{
+			ID:         delivery.ID,
+			DeliveryID: delivery.DeliveryID,
+			EventID:    delivery.EventID,
+			Event:      delivery.Event,
+			URL:        delivery.URL,
+			Attempt:    delivery.Attempt,
+			StatusCode: delivery.StatusCode,
+			Success:    delivery.Success,
+			Error:      delivery.Error,
+			DurationMs: delivery.DurationMs,
+			CreatedAt:  delivery.CreatedAt,
+		}
+	}
+
+	pagination := dto.NewPagination(total, page, limit)
+	return web.List(c, dto.ListResponse[dto.WebhookDeliveryResponse]{
+		Data:       data,
+		Pagination: pagination,
+	}, pagination)
+}
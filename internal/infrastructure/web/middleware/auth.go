@@ -1,11 +1,12 @@
 package middleware
 
 import (
+	"strconv"
 	"strings"
 
-	"nebula-live/internal/infrastructure/config"
 	"nebula-live/pkg/auth"
 	"nebula-live/pkg/errors"
+	"nebula-live/pkg/logger"
 
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/zap"
@@ -25,16 +26,9 @@ type AuthMiddleware struct {
 }
 
 // NewAuthMiddleware 创建认证中间件
-func NewAuthMiddleware(config *config.Config, logger *zap.Logger) *AuthMiddleware {
-	tokenConfig := &auth.TokenConfig{
-		SecretKey:       config.JWT.Secret,
-		AccessTokenTTL:  config.JWT.AccessTokenTTL,
-		RefreshTokenTTL: config.JWT.RefreshTokenTTL,
-		Issuer:          config.JWT.Issuer,
-	}
-
+func NewAuthMiddleware(jwtManager *auth.JWTManager, logger *zap.Logger) *AuthMiddleware {
 	return &AuthMiddleware{
-		jwtManager: auth.NewJWTManager(tokenConfig),
+		jwtManager: jwtManager,
 		logger:     logger,
 	}
 }
@@ -54,7 +48,7 @@ func (m *AuthMiddleware) RequireAuth() fiber.Handler {
 		// 检查Bearer前缀
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			m.logger.Debug("Invalid authorization header format", zap.String("header", authHeader))
+			m.logger.Debug("Invalid authorization header format", logger.RedactedString("header", authHeader))
 			return c.Status(fiber.StatusUnauthorized).JSON(
 				errors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "Invalid authorization header format"),
 			)
@@ -69,18 +63,19 @@ func (m *AuthMiddleware) RequireAuth() fiber.Handler {
 		}
 
 		// 验证token
-		claims, err := m.jwtManager.ValidateToken(token)
+		claims, err := m.jwtManager.ValidateAccessToken(token)
 		if err != nil {
 			m.logger.Debug("Token validation failed",
 				zap.Error(err),
-				zap.String("token", token[:min(len(token), 50)]+"..."))
+				logger.RedactedString("token", token))
 
 			switch err {
 			case auth.ErrExpiredToken:
 				return c.Status(fiber.StatusUnauthorized).JSON(
 					errors.NewAPIError(fiber.StatusUnauthorized, "Token expired", "Your session has expired, please login again"),
 				)
-			case auth.ErrInvalidToken:
+			case auth.ErrInvalidToken, auth.ErrWrongTokenType:
+				// 刷新令牌只能用于 /auth/refresh
 				return c.Status(fiber.StatusUnauthorized).JSON(
 					errors.NewAPIError(fiber.StatusUnauthorized, "Invalid token", "Invalid authentication token"),
 				)
@@ -96,12 +91,12 @@ func (m *AuthMiddleware) RequireAuth() fiber.Handler {
 		}
 
 		// 将用户信息存储到上下文中
-		c.Locals(AuthContextKey, claims)
-		c.Locals(UserIDContextKey, claims.UserID)
+		setAuthLocals(c, claims)
 
 		m.logger.Debug("User authenticated successfully",
 			zap.Uint("user_id", claims.UserID),
-			zap.String("username", claims.Username))
+			zap.String("username", claims.Username),
+			zap.Uint("impersonated_by", claims.ImpersonatedBy))
 
 		return c.Next()
 	}
@@ -131,18 +126,17 @@ func (m *AuthMiddleware) OptionalAuth() fiber.Handler {
 		}
 
 		// 验证token
-		claims, err := m.jwtManager.ValidateToken(token)
+		claims, err := m.jwtManager.ValidateAccessToken(token)
 		if err != nil {
 			// token无效，记录日志但不返回错误
 			m.logger.Debug("Optional auth token validation failed",
 				zap.Error(err),
-				zap.String("token", token[:min(len(token), 50)]+"..."))
+				logger.RedactedString("token", token))
 			return c.Next()
 		}
 
 		// token有效，将用户信息存储到上下文中
-		c.Locals(AuthContextKey, claims)
-		c.Locals(UserIDContextKey, claims.UserID)
+		setAuthLocals(c, claims)
 
 		m.logger.Debug("User optionally authenticated",
 			zap.Uint("user_id", claims.UserID),
@@ -152,10 +146,13 @@ func (m *AuthMiddleware) OptionalAuth() fiber.Handler {
 	}
 }
 
-// min 获取两个数的最小值
-func min(a, b int) int {
-	if a < b {
-		return a
+// setAuthLocals 将令牌声明存储到上下文中，模拟会话额外记录管理员ID并通过响应头告知客户端
+func setAuthLocals(c *fiber.Ctx, claims *auth.UserClaims) {
+	c.Locals(AuthContextKey, claims)
+	c.Locals(UserIDContextKey, claims.UserID)
+
+	if claims.IsImpersonated() {
+		c.Locals(auth.ImpersonatorIDContextKey, claims.ImpersonatedBy)
+		c.Set(auth.ImpersonatedByHeader, strconv.FormatUint(uint64(claims.ImpersonatedBy), 10))
 	}
-	return b
 }
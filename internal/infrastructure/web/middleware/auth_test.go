@@ -0,0 +1,58 @@
+package middleware_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"nebula-live/internal/infrastructure/web/middleware"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// newAuthTestApp 创建一个需要认证的路由，返回应用和签发令牌用的JWT管理器
+func newAuthTestApp(t *testing.T) (*fiber.App, *auth.JWTManager) {
+	t.Helper()
+	jwtManager := auth.NewJWTManager(&auth.TokenConfig{
+		SecretKey:       "test-secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+	})
+	authMiddleware := middleware.NewAuthMiddleware(jwtManager, zap.NewNop())
+
+	app := fiber.New()
+	app.Get("/me", authMiddleware.RequireAuth(), func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+	return app, jwtManager
+}
+
+// getWithToken 携带Bearer令牌请求路由并返回响应状态码
+func getWithToken(t *testing.T, app *fiber.App, path, token string) int {
+	t.Helper()
+	req := httptest.NewRequest(fiber.MethodGet, path, nil)
+	req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	return resp.StatusCode
+}
+
+func TestAuthMiddleware_RequireAuthRejectsRefreshToken(t *testing.T) {
+	app, jwtManager := newAuthTestApp(t)
+
+	pair, err := jwtManager.GenerateSessionTokenPair(1, "alice", "alice@example.com", 3, "refresh-id")
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+	}
+
+	if got := getWithToken(t, app, "/me", pair.AccessToken); got != fiber.StatusNoContent {
+		t.Errorf("access token status = %d, want %d", got, fiber.StatusNoContent)
+	}
+	if got := getWithToken(t, app, "/me", pair.RefreshToken); got != fiber.StatusUnauthorized {
+		t.Errorf("refresh token status = %d, want %d", got, fiber.StatusUnauthorized)
+	}
+}
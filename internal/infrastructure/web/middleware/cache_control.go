@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// defaultPublicCacheMaxAge 未配置时公开接口响应的缓存时间
+const defaultPublicCacheMaxAge = 30 * time.Second
+
+// NewPublicCacheControl 创建公开接口的缓存头中间件
+//
+// 成功的GET和HEAD响应设置 Cache-Control: public, max-age=N，允许浏览器和CDN缓存；
+// 错误响应和其他方法设置 no-store，避免缓存临时错误。maxAge为0时使用默认值30秒，负数表示不缓存。
+// 处理器自行设置了Cache-Control时保持不变。
+func NewPublicCacheControl(maxAge time.Duration) fiber.Handler {
+	if maxAge == 0 {
+		maxAge = defaultPublicCacheMaxAge
+	}
+	cacheable := fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds()))
+
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+
+		if len(c.Response().Header.Peek(fiber.HeaderCacheControl)) > 0 {
+			return err
+		}
+
+		// 处理器返回的错误由全局错误处理器在之后写出，这里按错误响应处理
+		if maxAge < 0 || err != nil || c.Response().StatusCode() >= fiber.StatusBadRequest ||
+			(c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead) {
+			c.Set(fiber.HeaderCacheControl, "no-store")
+			return err
+		}
+
+		c.Set(fiber.HeaderCacheControl, cacheable)
+		return nil
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"nebula-live/internal/infrastructure/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+// defaultCompressionMinSize 未配置时压缩的最小响应体大小，更小的响应压缩后收益不大
+const defaultCompressionMinSize = 1024
+
+// NewCompression 创建响应压缩中间件，按客户端的Accept-Encoding使用br、gzip或deflate
+//
+// 响应体小于最小大小、已设置Content-Encoding或内容类型不可压缩（如图片）时不压缩。
+// 与fiber的compress中间件相同，但在压缩前检查响应体大小，因此最小大小可以配置。
+func NewCompression(cfg config.CompressionConfig) fiber.Handler {
+	var compressor fasthttp.RequestHandler
+	noop := func(ctx *fasthttp.RequestCtx) {}
+
+	switch cfg.Level {
+	case "disabled":
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	case "best_speed":
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop,
+			fasthttp.CompressBrotliBestSpeed,
+			fasthttp.CompressBestSpeed,
+		)
+	case "best_compression":
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop,
+			fasthttp.CompressBrotliBestCompression,
+			fasthttp.CompressBestCompression,
+		)
+	default:
+		compressor = fasthttp.CompressHandlerBrotliLevel(noop,
+			fasthttp.CompressBrotliDefaultCompression,
+			fasthttp.CompressDefaultCompression,
+		)
+	}
+
+	minSize := cfg.MinSize
+	if minSize <= 0 {
+		minSize = defaultCompressionMinSize
+	}
+
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		if len(c.Response().Body()) < minSize || len(c.Response().Header.Peek(fiber.HeaderContentEncoding)) > 0 {
+			return nil
+		}
+
+		compressor(c.Context())
+		return nil
+	}
+}
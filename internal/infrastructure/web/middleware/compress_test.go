@@ -0,0 +1,106 @@
+package middleware_test
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// newCompressionTestApp 挂载压缩中间件，/users返回较大的JSON列表，/health返回很小的响应
+func newCompressionTestApp(cfg config.CompressionConfig) *fiber.App {
+	app := fiber.New()
+	app.Use(middleware.NewCompression(cfg))
+	app.Get("/users", func(c *fiber.Ctx) error {
+		users := make([]fiber.Map, 100)
+		for i := range users {
+			users[i] = fiber.Map{"id": i + 1, "username": fmt.Sprintf("user%d", i+1), "email": fmt.Sprintf("user%d@example.com", i+1)}
+		}
+		return c.JSON(fiber.Map{"data": users})
+	})
+	app.Get("/health", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"status": "ok"})
+	})
+	app.Get("/archive", func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderContentEncoding, "gzip")
+		return c.Send(make([]byte, 4096))
+	})
+	return app
+}
+
+// get 以给定的Accept-Encoding发送GET请求
+func get(t *testing.T, app *fiber.App, path, acceptEncoding string) *http.Response {
+	t.Helper()
+	req := httptest.NewRequest(fiber.MethodGet, path, nil)
+	if acceptEncoding != "" {
+		req.Header.Set(fiber.HeaderAcceptEncoding, acceptEncoding)
+	}
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestCompression_LargeJSONListGzipped(t *testing.T) {
+	app := newCompressionTestApp(config.CompressionConfig{})
+
+	resp := get(t, app, "/users", "gzip")
+	if got := resp.Header.Get(fiber.HeaderContentEncoding); got != "gzip" {
+		t.Fatalf("Content-Encoding = %q, want gzip", got)
+	}
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+	var body struct {
+		Data []map[string]any `json:"data"`
+	}
+	if err := json.NewDecoder(reader).Decode(&body); err != nil {
+		t.Fatalf("decode gzipped body error = %v", err)
+	}
+	if len(body.Data) != 100 {
+		t.Errorf("decoded %d users, want 100", len(body.Data))
+	}
+
+	// 客户端未声明支持压缩时原样返回
+	if got := get(t, app, "/users", "").Header.Get(fiber.HeaderContentEncoding); got != "" {
+		t.Errorf("Content-Encoding without Accept-Encoding = %q, want none", got)
+	}
+}
+
+func TestCompression_SkipsTinyAndEncodedBodies(t *testing.T) {
+	app := newCompressionTestApp(config.CompressionConfig{})
+
+	resp := get(t, app, "/health", "gzip")
+	if got := resp.Header.Get(fiber.HeaderContentEncoding); got != "" {
+		t.Errorf("tiny body Content-Encoding = %q, want none", got)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"status":"ok"}` {
+		t.Errorf("tiny body = %q, want the plain JSON", body)
+	}
+
+	// 已压缩的响应不再重复压缩
+	resp = get(t, app, "/archive", "gzip")
+	if body, _ := io.ReadAll(resp.Body); len(body) != 4096 {
+		t.Errorf("encoded body length = %d, want 4096 (unchanged)", len(body))
+	}
+
+	// 最小大小可配置，disabled关闭压缩
+	if got := get(t, newCompressionTestApp(config.CompressionConfig{MinSize: 1 << 20}), "/users", "gzip").Header.Get(fiber.HeaderContentEncoding); got != "" {
+		t.Errorf("Content-Encoding below min_size = %q, want none", got)
+	}
+	if got := get(t, newCompressionTestApp(config.CompressionConfig{Level: "disabled"}), "/users", "gzip").Header.Get(fiber.HeaderContentEncoding); got != "" {
+		t.Errorf("Content-Encoding when disabled = %q, want none", got)
+	}
+}
@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"nebula-live/internal/infrastructure/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+// corsRoute 按路径前缀匹配的CORS处理器
+type corsRoute struct {
+	prefix  string
+	handler fiber.Handler
+}
+
+// NewCORS 创建CORS中间件，basePath为业务接口的基础路径，用于解析覆盖配置的api_path
+//
+// 请求路径匹配 overrides 中的前缀时使用对应的覆盖配置（最长前缀优先），否则使用全局配置。
+// 预检请求在此处直接响应，因此覆盖必须在全局中间件中解析，而不能在路由组上叠加。
+// 全局配置或合并后的覆盖配置不安全（如允许任意来源同时允许携带凭证）时返回错误。
+func NewCORS(cfg config.CORSConfig, basePath string) (fiber.Handler, error) {
+	if err := validateCORSConfig(cfg); err != nil {
+		return nil, fmt.Errorf("cors: %w", err)
+	}
+	defaultHandler := cors.New(toCORSConfig(cfg))
+
+	routes := make([]corsRoute, 0, len(cfg.Overrides))
+	for i, override := range cfg.Overrides {
+		prefix, err := overridePrefix(override, basePath)
+		if err != nil {
+			return nil, fmt.Errorf("cors.overrides[%d]: %w", i, err)
+		}
+		merged := mergeCORSOverride(cfg, override)
+		if err := validateCORSConfig(merged); err != nil {
+			return nil, fmt.Errorf("cors.overrides[%d] (%s): %w", i, prefix, err)
+		}
+		routes = append(routes, corsRoute{
+			prefix:  prefix,
+			handler: cors.New(toCORSConfig(merged)),
+		})
+	}
+
+	// 最长前缀优先匹配
+	sort.Slice(routes, func(i, j int) bool {
+		return len(routes[i].prefix) > len(routes[j].prefix)
+	})
+
+	return func(c *fiber.Ctx) error {
+		path := c.Path()
+		for _, route := range routes {
+			if matchPathPrefix(path, route.prefix) {
+				return route.handler(c)
+			}
+		}
+		return defaultHandler(c)
+	}, nil
+}
+
+// overridePrefix 返回覆盖配置匹配的完整路径前缀，api_path拼接在业务接口基础路径之后
+func overridePrefix(override config.CORSOverrideConfig, basePath string) (string, error) {
+	switch {
+	case override.PathPrefix != "" && override.APIPath != "":
+		return "", errors.New("path_prefix and api_path are mutually exclusive")
+	case override.PathPrefix != "":
+		return strings.TrimSuffix(override.PathPrefix, "/"), nil
+	case override.APIPath != "":
+		return basePath + "/" + strings.Trim(override.APIPath, "/"), nil
+	default:
+		return "", errors.New("path_prefix or api_path is required")
+	}
+}
+
+// validateCORSConfig 检查浏览器会拒绝或不安全的组合，allowed_origins为空时等同于 "*"
+func validateCORSConfig(cfg config.CORSConfig) error {
+	allowAll := len(cfg.AllowedOrigins) == 0 || slices.Contains(cfg.AllowedOrigins, "*")
+	if cfg.AllowCredentials && allowAll {
+		return errors.New(`allowed_origins "*" cannot be combined with allow_credentials`)
+	}
+	return nil
+}
+
+// mergeCORSOverride 将覆盖配置合并到全局配置，未设置的字段沿用全局值
+func mergeCORSOverride(base config.CORSConfig, override config.CORSOverrideConfig) config.CORSConfig {
+	merged := base
+	merged.Overrides = nil
+
+	if len(override.AllowedOrigins) > 0 {
+		merged.AllowedOrigins = override.AllowedOrigins
+	}
+	if len(override.AllowedMethods) > 0 {
+		merged.AllowedMethods = override.AllowedMethods
+	}
+	if len(override.AllowedHeaders) > 0 {
+		merged.AllowedHeaders = override.AllowedHeaders
+	}
+	if len(override.ExposedHeaders) > 0 {
+		merged.ExposedHeaders = override.ExposedHeaders
+	}
+	if override.AllowCredentials != nil {
+		merged.AllowCredentials = *override.AllowCredentials
+	}
+	if override.MaxAge != nil {
+		merged.MaxAge = *override.MaxAge
+	}
+
+	return merged
+}
+
+// toCORSConfig 转换为fiber的CORS配置
+func toCORSConfig(cfg config.CORSConfig) cors.Config {
+	return cors.Config{
+		AllowOrigins:     strings.Join(cfg.AllowedOrigins, ","),
+		AllowMethods:     strings.Join(cfg.AllowedMethods, ","),
+		AllowHeaders:     strings.Join(cfg.AllowedHeaders, ","),
+		ExposeHeaders:    strings.Join(cfg.ExposedHeaders, ","),
+		AllowCredentials: cfg.AllowCredentials,
+		// 预检结果缓存时间（秒），0表示不发送Access-Control-Max-Age，负数表示禁止缓存
+		MaxAge: cfg.MaxAge,
+	}
+}
+
+// matchPathPrefix 判断路径是否位于前缀之下（按路径段匹配，/api/v1/user 不匹配 /api/v1/users）
+func matchPathPrefix(path, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	return len(path) == len(prefix) || path[len(prefix)] == '/'
+}
@@ -0,0 +1,167 @@
+package middleware_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// corsTestConfig 全局只允许管理后台来源，直播信息接口允许任意来源
+func corsTestConfig() config.CORSConfig {
+	maxAge := 86400
+	return config.CORSConfig{
+		AllowedOrigins: []string{"https://admin.example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type", "Authorization"},
+		MaxAge:         600,
+		Overrides: []config.CORSOverrideConfig{
+			{APIPath: "/live-streams", AllowedOrigins: []string{"*"}, MaxAge: &maxAge},
+		},
+	}
+}
+
+// newCORSTestApp 在basePath下注册业务路由并挂载CORS中间件
+func newCORSTestApp(t *testing.T, cfg config.CORSConfig, basePath string) *fiber.App {
+	t.Helper()
+	handler, err := middleware.NewCORS(cfg, basePath)
+	if err != nil {
+		t.Fatalf("NewCORS() error = %v", err)
+	}
+	app := fiber.New()
+	app.Use(handler)
+	app.All(basePath+"/*", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+	return app
+}
+
+// preflight 发送预检请求
+func preflight(t *testing.T, app *fiber.App, path, origin string) (int, func(string) string) {
+	t.Helper()
+	req := httptest.NewRequest(fiber.MethodOptions, path, nil)
+	req.Header.Set(fiber.HeaderOrigin, origin)
+	req.Header.Set(fiber.HeaderAccessControlRequestMethod, fiber.MethodGet)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	return resp.StatusCode, resp.Header.Get
+}
+
+func TestCORS_OverrideFollowsAPIBasePath(t *testing.T) {
+	app := newCORSTestApp(t, corsTestConfig(), "/api/v2")
+
+	tests := []struct {
+		path       string
+		origin     string
+		wantOrigin string
+		wantMaxAge string
+	}{
+		// api_path 拼接在配置的基础路径之后
+		{"/api/v2/live-streams/bilibili/5440", "https://any.example.com", "*", "86400"},
+		{"/api/v2/live-streams", "https://any.example.com", "*", "86400"},
+		// 其他接口和按路径段不匹配的路径使用全局配置
+		{"/api/v2/users", "https://any.example.com", "", "600"},
+		{"/api/v2/live-streamsx", "https://any.example.com", "", "600"},
+		{"/api/v2/users", "https://admin.example.com", "https://admin.example.com", "600"},
+		// 旧的 /api/v1 前缀不再匹配覆盖配置
+		{"/api/v1/live-streams/bilibili/5440", "https://any.example.com", "", "600"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path+" from "+tt.origin, func(t *testing.T) {
+			status, header := preflight(t, app, tt.path, tt.origin)
+			if status != fiber.StatusNoContent {
+				t.Fatalf("preflight status = %d, want %d", status, fiber.StatusNoContent)
+			}
+			if got := header(fiber.HeaderAccessControlAllowOrigin); got != tt.wantOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			if got := header(fiber.HeaderAccessControlMaxAge); got != tt.wantMaxAge {
+				t.Errorf("Access-Control-Max-Age = %q, want %q", got, tt.wantMaxAge)
+			}
+			if got := header(fiber.HeaderAccessControlAllowMethods); got != "GET,POST" {
+				t.Errorf("Access-Control-Allow-Methods = %q, want GET,POST", got)
+			}
+			// 预检响应随来源和请求的方法、头变化，共享缓存必须分别缓存
+			vary := header(fiber.HeaderVary)
+			for _, want := range []string{fiber.HeaderOrigin, fiber.HeaderAccessControlRequestMethod, fiber.HeaderAccessControlRequestHeaders} {
+				if !strings.Contains(vary, want) {
+					t.Errorf("Vary = %q, want it to contain %s", vary, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCORS_VaryOnRestrictedOrigins(t *testing.T) {
+	app := newCORSTestApp(t, corsTestConfig(), "/api/v1")
+
+	tests := []struct {
+		path     string
+		wantVary bool
+	}{
+		// 响应随来源变化时需要Vary: Origin，允许任意来源时不需要
+		{"/api/v1/users", true},
+		{"/api/v1/live-streams/bilibili/5440", false},
+	}
+	for _, tt := range tests {
+		for _, origin := range []string{"", "https://admin.example.com"} {
+			req := httptest.NewRequest(fiber.MethodGet, tt.path, nil)
+			if origin != "" {
+				req.Header.Set(fiber.HeaderOrigin, origin)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			if got := strings.Contains(resp.Header.Get(fiber.HeaderVary), fiber.HeaderOrigin); got != tt.wantVary {
+				t.Errorf("%s with origin %q: Vary = %q, want Origin listed = %v", tt.path, origin, resp.Header.Get(fiber.HeaderVary), tt.wantVary)
+			}
+		}
+	}
+}
+
+func TestNewCORS_RejectsInvalidConfig(t *testing.T) {
+	allow := true
+	tests := map[string]func(cfg *config.CORSConfig){
+		"override with credentials keeps wildcard origin": func(cfg *config.CORSConfig) {
+			cfg.Overrides[0].AllowCredentials = &allow
+		},
+		"credentials inherited by wildcard override": func(cfg *config.CORSConfig) {
+			cfg.AllowCredentials = true
+		},
+		"global wildcard with credentials": func(cfg *config.CORSConfig) {
+			cfg.AllowedOrigins = []string{"*"}
+			cfg.AllowCredentials = true
+			cfg.Overrides = nil
+		},
+		"override without path": func(cfg *config.CORSConfig) {
+			cfg.Overrides[0].APIPath = ""
+		},
+		"override with both paths": func(cfg *config.CORSConfig) {
+			cfg.Overrides[0].PathPrefix = "/swagger"
+		},
+	}
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := corsTestConfig()
+			modify(&cfg)
+			if _, err := middleware.NewCORS(cfg, "/api/v1"); err == nil {
+				t.Error("NewCORS() error = nil, want the config rejected")
+			}
+		})
+	}
+
+	// 覆盖配置改为具体来源后可以携带凭证
+	cfg := corsTestConfig()
+	cfg.Overrides[0].AllowedOrigins = []string{"https://app.example.com"}
+	cfg.Overrides[0].AllowCredentials = &allow
+	if _, err := middleware.NewCORS(cfg, "/api/v1"); err != nil {
+		t.Errorf("NewCORS() with explicit origins and credentials error = %v", err)
+	}
+}
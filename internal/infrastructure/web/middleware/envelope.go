@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewEnvelope 创建响应信封中间件，启用 server.response_envelope 时将业务接口的JSON响应包装为
+// {"data","error","meta"}，未启用时保持原有的响应格式
+//
+// 处理器返回的错误先交给全局错误处理器写出再包装，因此错误响应的格式与成功响应一致。
+// /health 和 /swagger 不在业务接口路径下，不做包装。
+func NewEnvelope(cfg config.ServerConfig) fiber.Handler {
+	if !cfg.ResponseEnvelope {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	basePath := cfg.API.BasePath()
+
+	return func(c *fiber.Ctx) error {
+		path := c.Path()
+		if (basePath != "" && !matchPathPrefix(path, basePath)) ||
+			path == "/health" || matchPathPrefix(path, "/swagger") {
+			return c.Next()
+		}
+
+		if err := c.Next(); err != nil {
+			if err := c.App().ErrorHandler(c, err); err != nil {
+				return err
+			}
+		}
+
+		return web.WrapEnvelope(c)
+	}
+}
@@ -0,0 +1,109 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/middleware"
+	apierrors "nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// newEnvelopeTestApp 在/api/v1下注册单个对象、列表和错误接口，/health不在业务路径下
+func newEnvelopeTestApp(enabled bool) *fiber.App {
+	cfg := config.ServerConfig{
+		API:              config.APIConfig{Prefix: "/api", Version: "v1"},
+		ResponseEnvelope: enabled,
+	}
+	app := fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	app.Use(middleware.NewEnvelope(cfg))
+	app.Get("/api/v1/users/1", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"id": 1, "username": "alice"})
+	})
+	app.Get("/api/v1/users", func(c *fiber.Ctx) error {
+		return web.List(c, fiber.Map{"users": []fiber.Map{{"id": 1}}, "total": 21, "page": 2, "limit": 10},
+			web.PageMeta{Total: 21, Page: 2, Limit: 10})
+	})
+	app.Get("/api/v1/users/2", func(c *fiber.Ctx) error {
+		return apierrors.NewDomainError(apierrors.KindNotFound, "user not found")
+	})
+	app.Get("/health", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"status": "ok"})
+	})
+	return app
+}
+
+// getJSON 发送GET请求并解析JSON响应体
+func getJSON(t *testing.T, app *fiber.App, path string) (int, map[string]json.RawMessage) {
+	t.Helper()
+	resp := get(t, app, path, "")
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decode %s response error = %v", path, err)
+	}
+	return resp.StatusCode, body
+}
+
+func TestEnvelope_WrapsDataErrorAndMeta(t *testing.T) {
+	app := newEnvelopeTestApp(true)
+
+	status, body := getJSON(t, app, "/api/v1/users/1")
+	if status != fiber.StatusOK || string(body["error"]) != "null" || string(body["meta"]) != "null" {
+		t.Errorf("GET /users/1 = %d %v, want 200 with null error and meta", status, body)
+	}
+	var user struct {
+		Username string `json:"username"`
+	}
+	if err := json.Unmarshal(body["data"], &user); err != nil || user.Username != "alice" {
+		t.Errorf("data = %s, want the user object", body["data"])
+	}
+
+	// 列表接口的分页信息在meta中，data与未包装时的响应体相同
+	status, body = getJSON(t, app, "/api/v1/users")
+	var meta web.PageMeta
+	if err := json.Unmarshal(body["meta"], &meta); err != nil {
+		t.Fatalf("decode meta %s error = %v", body["meta"], err)
+	}
+	if status != fiber.StatusOK || meta != (web.PageMeta{Total: 21, Page: 2, Limit: 10}) {
+		t.Errorf("GET /users = %d meta %+v, want 200 {21 2 10}", status, meta)
+	}
+	if _, ok := body["data"]; !ok || string(body["data"]) == "null" {
+		t.Errorf("list data = %s, want the list body", body["data"])
+	}
+
+	// 处理器返回的错误经全局错误处理器写出后放在error中，状态码不变
+	status, body = getJSON(t, app, "/api/v1/users/2")
+	var apiErr apierrors.APIError
+	if err := json.Unmarshal(body["error"], &apiErr); err != nil {
+		t.Fatalf("decode error %s error = %v", body["error"], err)
+	}
+	if status != fiber.StatusNotFound || apiErr.Message != "user not found" || string(body["data"]) != "null" {
+		t.Errorf("GET /users/2 = %d %v, want 404 with the error in error and null data", status, body)
+	}
+
+	// 业务路径之外的接口不包装
+	_, body = getJSON(t, app, "/health")
+	if string(body["status"]) != `"ok"` || body["data"] != nil {
+		t.Errorf("GET /health = %v, want the bare body", body)
+	}
+}
+
+func TestEnvelope_DisabledKeepsBareResponses(t *testing.T) {
+	app := newEnvelopeTestApp(false)
+
+	_, body := getJSON(t, app, "/api/v1/users/1")
+	if string(body["username"]) != `"alice"` || body["data"] != nil {
+		t.Errorf("GET /users/1 = %v, want the bare user object", body)
+	}
+	status, body := getJSON(t, app, "/api/v1/users/2")
+	if status != fiber.StatusNotFound || string(body["message"]) != `"user not found"` {
+		t.Errorf("GET /users/2 = %d %v, want the bare 404 error body", status, body)
+	}
+	if body["data"] != nil || body["meta"] != nil {
+		t.Errorf("GET /users/2 = %v, want no envelope fields", body)
+	}
+}
@@ -1,28 +1,45 @@
 package middleware
 
 import (
+	stderrors "errors"
+
 	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
 	"nebula-live/pkg/auth"
 	"nebula-live/pkg/errors"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
 	"go.uber.org/zap"
 )
 
 // RBACMiddleware RBAC权限验证中间件
 type RBACMiddleware struct {
-	rbacService service.RBACService
-	logger      *zap.Logger
+	rbacService     service.RBACService
+	permissionCache *service.PermissionCache
+	logger          *zap.Logger
+	exposeDetails   bool
 }
 
 // NewRBACMiddleware 创建RBAC中间件
-func NewRBACMiddleware(rbacService service.RBACService, logger *zap.Logger) *RBACMiddleware {
+func NewRBACMiddleware(config *config.Config, rbacService service.RBACService, permissionCache *service.PermissionCache, logger *zap.Logger) *RBACMiddleware {
 	return &RBACMiddleware{
-		rbacService: rbacService,
-		logger:      logger,
+		rbacService:     rbacService,
+		permissionCache: permissionCache,
+		logger:          logger,
+		exposeDetails:   config.RBAC.ExposeDeniedDetails,
 	}
 }
 
+// forbidden 返回403响应，开启配置时在详情中说明缺少的权限或角色
+func (m *RBACMiddleware) forbidden(c *fiber.Ctx, message string, details map[string]interface{}) error {
+	apiErr := errors.NewAPIError(fiber.StatusForbidden, "Forbidden", message)
+	if m.exposeDetails {
+		apiErr.WithDetails(details)
+	}
+	return c.Status(fiber.StatusForbidden).JSON(apiErr)
+}
+
 // RequirePermission 要求指定权限的中间件
 func (m *RBACMiddleware) RequirePermission(resource, action string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
@@ -38,7 +55,7 @@ func (m *RBACMiddleware) RequirePermission(resource, action string) fiber.Handle
 		}
 
 		// 检查用户权限
-		hasPermission, err := m.rbacService.HasPermission(c.Context(), currentUser.UserID, resource, action)
+		hasPermission, err := m.permissionCache.HasPermission(c.UserContext(), currentUser.UserID, resource, action)
 		if err != nil {
 			m.logger.Error("Failed to check user permission",
 				zap.Uint("user_id", currentUser.UserID),
@@ -56,9 +73,11 @@ func (m *RBACMiddleware) RequirePermission(resource, action string) fiber.Handle
 				zap.String("username", currentUser.Username),
 				zap.String("resource", resource),
 				zap.String("action", action))
-			return c.Status(fiber.StatusForbidden).JSON(
-				errors.NewAPIError(fiber.StatusForbidden, "Forbidden", "Insufficient permissions"),
-			)
+			return m.forbidden(c, "Insufficient permissions", map[string]interface{}{
+				"required_permission": resource + ":" + action,
+				"resource":            resource,
+				"action":              action,
+			})
 		}
 
 		m.logger.Debug("Permission check passed",
@@ -85,7 +104,7 @@ func (m *RBACMiddleware) RequireRole(roleName string) fiber.Handler {
 		}
 
 		// 检查用户角色
-		hasRole, err := m.rbacService.HasRole(c.Context(), currentUser.UserID, roleName)
+		hasRole, err := m.rbacService.HasRole(c.UserContext(), currentUser.UserID, roleName)
 		if err != nil {
 			m.logger.Error("Failed to check user role",
 				zap.Uint("user_id", currentUser.UserID),
@@ -101,9 +120,9 @@ func (m *RBACMiddleware) RequireRole(roleName string) fiber.Handler {
 				zap.Uint("user_id", currentUser.UserID),
 				zap.String("username", currentUser.Username),
 				zap.String("role", roleName))
-			return c.Status(fiber.StatusForbidden).JSON(
-				errors.NewAPIError(fiber.StatusForbidden, "Forbidden", "Required role not found"),
-			)
+			return m.forbidden(c, "Required role not found", map[string]interface{}{
+				"required_role": roleName,
+			})
 		}
 
 		m.logger.Debug("Role check passed",
@@ -127,7 +146,7 @@ func (m *RBACMiddleware) RequireAdmin() fiber.Handler {
 		}
 
 		// 检查是否为管理员
-		isAdmin, err := m.rbacService.HasRole(c.Context(), currentUser.UserID, "admin")
+		isAdmin, err := m.rbacService.HasRole(c.UserContext(), currentUser.UserID, "admin")
 		if err != nil {
 			m.logger.Error("Failed to check admin role",
 				zap.Uint("user_id", currentUser.UserID),
@@ -141,9 +160,9 @@ func (m *RBACMiddleware) RequireAdmin() fiber.Handler {
 			m.logger.Debug("User is not an admin",
 				zap.Uint("user_id", currentUser.UserID),
 				zap.String("username", currentUser.Username))
-			return c.Status(fiber.StatusForbidden).JSON(
-				errors.NewAPIError(fiber.StatusForbidden, "Forbidden", "Administrator privileges required"),
-			)
+			return m.forbidden(c, "Administrator privileges required", map[string]interface{}{
+				"required_role": "admin",
+			})
 		}
 
 		m.logger.Debug("Admin check passed",
@@ -153,3 +172,148 @@ func (m *RBACMiddleware) RequireAdmin() fiber.Handler {
 		return c.Next()
 	}
 }
+
+// RequireAnyPermission 要求拥有任一指定权限的中间件，每项为 {resource, action}
+func (m *RBACMiddleware) RequireAnyPermission(pairs ...[2]string) fiber.Handler {
+	return m.requirePermissions(pairs, false)
+}
+
+// RequireAllPermissions 要求拥有全部指定权限的中间件，每项为 {resource, action}
+func (m *RBACMiddleware) RequireAllPermissions(pairs ...[2]string) fiber.Handler {
+	return m.requirePermissions(pairs, true)
+}
+
+// requirePermissions 按顺序逐项检查权限，与 RequirePermission 同样经由权限缓存和当前权限引擎。
+// requireAll 为false时第一个满足的权限即放行，为true时第一个缺少的权限即拒绝
+func (m *RBACMiddleware) requirePermissions(pairs [][2]string, requireAll bool) fiber.Handler {
+	required := make([]string, len(pairs))
+	for i, pair := range pairs {
+		required[i] = pair[0] + ":" + pair[1]
+	}
+
+	return func(c *fiber.Ctx) error {
+		// 从上下文获取当前用户
+		currentUser, exists := auth.GetCurrentUser(c)
+		if !exists {
+			m.logger.Debug("No authenticated user found for permission check",
+				zap.Strings("permissions", required))
+			return c.Status(fiber.StatusUnauthorized).JSON(
+				errors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "Authentication required"),
+			)
+		}
+
+		allowed := requireAll
+		for i, pair := range pairs {
+			hasPermission, err := m.permissionCache.HasPermission(c.UserContext(), currentUser.UserID, pair[0], pair[1])
+			if err != nil {
+				m.logger.Error("Failed to check user permission",
+					zap.Uint("user_id", currentUser.UserID),
+					zap.String("permission", required[i]),
+					zap.Error(err))
+				return c.Status(fiber.StatusInternalServerError).JSON(
+					errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to verify permissions"),
+				)
+			}
+
+			if requireAll && !hasPermission {
+				m.logger.Debug("User lacks required permission",
+					zap.Uint("user_id", currentUser.UserID),
+					zap.String("username", currentUser.Username),
+					zap.String("missing", required[i]))
+				return m.forbidden(c, "Insufficient permissions", map[string]interface{}{
+					"required_permissions": required,
+					"missing_permission":   required[i],
+				})
+			}
+			if !requireAll && hasPermission {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			m.logger.Debug("User lacks any of the required permissions",
+				zap.Uint("user_id", currentUser.UserID),
+				zap.String("username", currentUser.Username),
+				zap.Strings("permissions", required))
+			return m.forbidden(c, "Insufficient permissions", map[string]interface{}{
+				"required_any_permission": required,
+			})
+		}
+
+		m.logger.Debug("Permission check passed",
+			zap.Uint("user_id", currentUser.UserID),
+			zap.String("username", currentUser.Username),
+			zap.Strings("permissions", required))
+
+		return c.Next()
+	}
+}
+
+// RequireOwnerOrPermission 要求当前用户为资源所有者或拥有指定权限的中间件
+//
+// resolveOwnerID 解析请求所操作资源的所有者ID，返回 *fiber.Error 时按其状态码响应（如资源不存在返回404）。
+// 校验通过后所有者ID写入上下文，处理器可通过 auth.GetTargetUserID 以所有者身份操作资源。
+func (m *RBACMiddleware) RequireOwnerOrPermission(resolveOwnerID func(c *fiber.Ctx) (uint, error), resource, action string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// 从上下文获取当前用户
+		currentUser, exists := auth.GetCurrentUser(c)
+		if !exists {
+			m.logger.Debug("No authenticated user found for ownership check",
+				zap.String("resource", resource),
+				zap.String("action", action))
+			return c.Status(fiber.StatusUnauthorized).JSON(
+				errors.NewAPIError(fiber.StatusUnauthorized, "Unauthorized", "Authentication required"),
+			)
+		}
+
+		ownerID, err := resolveOwnerID(c)
+		if err != nil {
+			var fiberErr *fiber.Error
+			if stderrors.As(err, &fiberErr) {
+				return c.Status(fiberErr.Code).JSON(
+					errors.NewAPIError(fiberErr.Code, utils.StatusMessage(fiberErr.Code), fiberErr.Message),
+				)
+			}
+			m.logger.Error("Failed to resolve resource owner",
+				zap.Uint("user_id", currentUser.UserID),
+				zap.String("resource", resource),
+				zap.Error(err))
+			return c.Status(fiber.StatusInternalServerError).JSON(
+				errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to verify resource ownership"),
+			)
+		}
+
+		// 非所有者需要拥有指定权限
+		if ownerID != currentUser.UserID {
+			hasPermission, err := m.permissionCache.HasPermission(c.UserContext(), currentUser.UserID, resource, action)
+			if err != nil {
+				m.logger.Error("Failed to check user permission",
+					zap.Uint("user_id", currentUser.UserID),
+					zap.String("resource", resource),
+					zap.String("action", action),
+					zap.Error(err))
+				return c.Status(fiber.StatusInternalServerError).JSON(
+					errors.NewAPIError(fiber.StatusInternalServerError, "Internal server error", "Failed to verify permissions"),
+				)
+			}
+
+			if !hasPermission {
+				m.logger.Debug("User is neither the owner nor has the required permission",
+					zap.Uint("user_id", currentUser.UserID),
+					zap.Uint("owner_id", ownerID),
+					zap.String("resource", resource),
+					zap.String("action", action))
+				return m.forbidden(c, "Insufficient permissions", map[string]interface{}{
+					"required_permission": resource + ":" + action,
+					"resource":            resource,
+					"action":              action,
+				})
+			}
+		}
+
+		c.Locals(auth.ResourceOwnerIDContextKey, ownerID)
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,256 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/entity"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web/middleware"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+	"nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// rbacTestEnv 带权限缓存的RBAC中间件和一个只有user角色的用户
+type rbacTestEnv struct {
+	rbac       service.RBACService
+	middleware *middleware.RBACMiddleware
+	user       *entity.User
+}
+
+func newRBACTestEnv(t *testing.T) *rbacTestEnv {
+	t.Helper()
+	return newRBACTestEnvWithConfig(t, &config.Config{})
+}
+
+// newRBACTestEnvWithConfig 与 newRBACTestEnv 相同，中间件使用指定配置
+func newRBACTestEnvWithConfig(t *testing.T, cfg *config.Config) *rbacTestEnv {
+	t.Helper()
+	client := testutil.NewEntClient(t)
+	bus := testutil.NewEventBus(t)
+	rbacService := testutil.NewRBACServiceWithBus(t, client, bus)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	user, err := userService.CreateUser(context.Background(), "carol", "carol@example.com", "Password123!", "Carol")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	cache := testutil.NewPermissionCache(t, rbacService, bus, time.Minute)
+	return &rbacTestEnv{
+		rbac:       rbacService,
+		middleware: middleware.NewRBACMiddleware(cfg, rbacService, cache, zap.NewNop()),
+		user:       user,
+	}
+}
+
+// grantRole 创建拥有指定系统权限的角色并分配给用户
+func (e *rbacTestEnv) grantRole(t *testing.T, roleName string, permissionNames ...string) {
+	t.Helper()
+	ctx := context.Background()
+
+	role, err := e.rbac.CreateRole(ctx, roleName, roleName, "", false, 0)
+	if err != nil {
+		t.Fatalf("CreateRole() error = %v", err)
+	}
+	for _, name := range permissionNames {
+		permission, err := e.rbac.GetPermissionByName(ctx, name)
+		if err != nil {
+			t.Fatalf("GetPermissionByName(%s) error = %v", name, err)
+		}
+		if err := e.rbac.AssignPermissionToRole(ctx, role.ID, permission.ID, 0); err != nil {
+			t.Fatalf("AssignPermissionToRole() error = %v", err)
+		}
+	}
+	if err := e.rbac.AssignRoleToUser(ctx, e.user.ID, role.ID, 0, nil); err != nil {
+		t.Fatalf("AssignRoleToUser() error = %v", err)
+	}
+}
+
+// status 以用户身份请求受 guard 保护的路由，返回响应状态码
+func (e *rbacTestEnv) status(t *testing.T, guard fiber.Handler) int {
+	t.Helper()
+	resp := e.request(t, guard)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// request 以用户身份请求受 guard 保护的路由
+func (e *rbacTestEnv) request(t *testing.T, guard fiber.Handler) *http.Response {
+	t.Helper()
+	app := fiber.New()
+	app.Get("/guarded",
+		func(c *fiber.Ctx) error {
+			c.Locals(auth.AuthContextKey, &auth.UserClaims{UserID: e.user.ID, Username: e.user.Username})
+			return c.Next()
+		},
+		guard,
+		func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) },
+	)
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/guarded", nil))
+	if err != nil {
+		t.Fatalf("app.Test() error = %v", err)
+	}
+	return resp
+}
+
+var (
+	userWrite  = [2]string{"user", "write"}
+	userManage = [2]string{"user", "manage"}
+)
+
+func TestRBACMiddleware_RequireAnyPermission(t *testing.T) {
+	env := newRBACTestEnv(t)
+	if got := env.status(t, env.middleware.RequireAnyPermission(userWrite, userManage)); got != fiber.StatusForbidden {
+		t.Fatalf("status without permissions = %d, want 403", got)
+	}
+
+	env.grantRole(t, "user-manager", entity.PermissionUserManage)
+
+	if got := env.status(t, env.middleware.RequireAnyPermission(userWrite, userManage)); got != fiber.StatusOK {
+		t.Errorf("status with only user:manage = %d, want 200", got)
+	}
+}
+
+func TestRBACMiddleware_RequireAllPermissions(t *testing.T) {
+	env := newRBACTestEnv(t)
+	env.grantRole(t, "user-manager", entity.PermissionUserManage)
+
+	if got := env.status(t, env.middleware.RequireAllPermissions(userWrite, userManage)); got != fiber.StatusForbidden {
+		t.Errorf("status with only user:manage = %d, want 403", got)
+	}
+
+	env.grantRole(t, "user-editor", entity.PermissionUserWrite)
+
+	if got := env.status(t, env.middleware.RequireAllPermissions(userWrite, userManage)); got != fiber.StatusOK {
+		t.Errorf("status with user:write and user:manage = %d, want 200", got)
+	}
+}
+
+func TestRBACMiddleware_RoleAssignmentTakesEffectImmediately(t *testing.T) {
+	env := newRBACTestEnv(t)
+	guard := env.middleware.RequirePermission("user", "manage")
+
+	// 第一次检查的拒绝结果会被缓存
+	if got := env.status(t, guard); got != fiber.StatusForbidden {
+		t.Fatalf("status before assignment = %d, want 403", got)
+	}
+
+	env.grantRole(t, "user-manager", entity.PermissionUserManage)
+
+	if got := env.status(t, guard); got != fiber.StatusOK {
+		t.Errorf("status after assignment = %d, want 200", got)
+	}
+}
+
+func TestRBACMiddleware_RolePermissionChangesTakeEffectImmediately(t *testing.T) {
+	ctx := context.Background()
+	env := newRBACTestEnv(t)
+	guard := env.middleware.RequirePermission("user", "manage")
+
+	// 用户先获得一个空角色，之后只修改角色的权限
+	env.grantRole(t, "support")
+	role, err := env.rbac.GetRoleByName(ctx, "support")
+	if err != nil {
+		t.Fatalf("GetRoleByName() error = %v", err)
+	}
+	permission, err := env.rbac.GetPermissionByName(ctx, entity.PermissionUserManage)
+	if err != nil {
+		t.Fatalf("GetPermissionByName() error = %v", err)
+	}
+
+	if got := env.status(t, guard); got != fiber.StatusForbidden {
+		t.Fatalf("status before granting = %d, want 403", got)
+	}
+
+	if err := env.rbac.AssignPermissionToRole(ctx, role.ID, permission.ID, 0); err != nil {
+		t.Fatalf("AssignPermissionToRole() error = %v", err)
+	}
+	if got := env.status(t, guard); got != fiber.StatusOK {
+		t.Errorf("status after granting = %d, want 200", got)
+	}
+
+	if err := env.rbac.RemovePermissionFromRole(ctx, role.ID, permission.ID); err != nil {
+		t.Fatalf("RemovePermissionFromRole() error = %v", err)
+	}
+	if got := env.status(t, guard); got != fiber.StatusForbidden {
+		t.Errorf("status after revoking = %d, want 403", got)
+	}
+}
+
+func TestRBACMiddleware_DeniedDetails(t *testing.T) {
+	guards := map[string]func(m *middleware.RBACMiddleware) fiber.Handler{
+		"permission": func(m *middleware.RBACMiddleware) fiber.Handler { return m.RequirePermission("user", "manage") },
+		"role":       func(m *middleware.RBACMiddleware) fiber.Handler { return m.RequireRole(entity.RoleNameAdmin) },
+		"all":        func(m *middleware.RBACMiddleware) fiber.Handler { return m.RequireAllPermissions(userWrite, userManage) },
+	}
+	wantDetail := map[string]string{
+		"permission": "required_permission",
+		"role":       "required_role",
+		"all":        "missing_permission",
+	}
+
+	for _, expose := range []bool{false, true} {
+		cfg := &config.Config{}
+		cfg.RBAC.ExposeDeniedDetails = expose
+		env := newRBACTestEnvWithConfig(t, cfg)
+
+		for name, guard := range guards {
+			resp := env.request(t, guard(env.middleware))
+			var body errors.APIError
+			err := json.NewDecoder(resp.Body).Decode(&body)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatalf("%s: decode response error = %v", name, err)
+			}
+			if resp.StatusCode != fiber.StatusForbidden {
+				t.Fatalf("%s: status = %d, want 403", name, resp.StatusCode)
+			}
+
+			_, listed := body.Details[wantDetail[name]]
+			if listed != expose {
+				t.Errorf("%s with expose_denied_details=%v: details = %v", name, expose, body.Details)
+			}
+		}
+	}
+}
+
+func TestRBACMiddleware_RequireOwnerOrPermission(t *testing.T) {
+	env := newRBACTestEnv(t)
+	ownedBy := func(ownerID uint) fiber.Handler {
+		return env.middleware.RequireOwnerOrPermission(func(c *fiber.Ctx) (uint, error) {
+			return ownerID, nil
+		}, "user", "manage")
+	}
+	otherUserID := env.user.ID + 100
+
+	if got := env.status(t, ownedBy(env.user.ID)); got != fiber.StatusOK {
+		t.Errorf("owner status = %d, want 200", got)
+	}
+	if got := env.status(t, ownedBy(otherUserID)); got != fiber.StatusForbidden {
+		t.Errorf("non-owner without user:manage status = %d, want 403", got)
+	}
+
+	missing := env.middleware.RequireOwnerOrPermission(func(c *fiber.Ctx) (uint, error) {
+		return 0, fiber.NewError(fiber.StatusNotFound, "Push setting not found")
+	}, "user", "manage")
+	if got := env.status(t, missing); got != fiber.StatusNotFound {
+		t.Errorf("missing resource status = %d, want 404", got)
+	}
+
+	env.grantRole(t, "user-manager", entity.PermissionUserManage)
+
+	if got := env.status(t, ownedBy(otherUserID)); got != fiber.StatusOK {
+		t.Errorf("non-owner with user:manage status = %d, want 200", got)
+	}
+}
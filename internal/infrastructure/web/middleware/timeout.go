@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+	"strings"
+	"time"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// timeoutRoute 按路径前缀匹配的请求超时时间
+type timeoutRoute struct {
+	prefix  string
+	timeout time.Duration
+}
+
+// NewTimeout 创建请求超时中间件
+//
+// 请求的UserContext在超时后被取消，处理器把c.UserContext()传给服务层，从而取消数据库查询、
+// 直播平台和推送服务等下游调用。超时是协作式的，中间件不会中断处理器，只能等它返回：
+// 超时后处理器返回错误或5xx响应（通常是被取消的下游调用导致）时改为返回504；
+// 处理器已经成功写出的响应（2xx-4xx）保留不变，避免丢弃已经完成的操作结果。
+// 请求路径匹配 request_timeout_overrides 中的前缀时使用对应的超时时间（最长前缀优先）。
+func NewTimeout(cfg config.ServerConfig) fiber.Handler {
+	routes := make([]timeoutRoute, 0, len(cfg.RequestTimeoutOverrides))
+	for _, override := range cfg.RequestTimeoutOverrides {
+		if override.PathPrefix == "" {
+			continue
+		}
+		routes = append(routes, timeoutRoute{
+			prefix:  strings.TrimSuffix(override.PathPrefix, "/"),
+			timeout: override.Timeout,
+		})
+	}
+
+	// 最长前缀优先匹配
+	sort.Slice(routes, func(i, j int) bool {
+		return len(routes[i].prefix) > len(routes[j].prefix)
+	})
+
+	return func(c *fiber.Ctx) error {
+		timeout := cfg.RequestTimeout
+		path := c.Path()
+		for _, route := range routes {
+			if matchPathPrefix(path, route.prefix) {
+				timeout = route.timeout
+				break
+			}
+		}
+		if timeout <= 0 {
+			return c.Next()
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), timeout)
+		defer cancel()
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+		if !stderrors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return err
+		}
+		if err == nil && c.Response().StatusCode() < fiber.StatusInternalServerError {
+			return nil
+		}
+		return c.Status(fiber.StatusGatewayTimeout).JSON(
+			errors.NewAPIError(fiber.StatusGatewayTimeout, "Gateway timeout", "Request timed out"),
+		)
+	}
+}
@@ -0,0 +1,83 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestTimeout(t *testing.T) {
+	app := fiber.New()
+	app.Use(middleware.NewTimeout(config.ServerConfig{
+		RequestTimeout: 50 * time.Millisecond,
+		RequestTimeoutOverrides: []config.RequestTimeoutOverrideConfig{
+			{PathPrefix: "/slow", Timeout: time.Second},
+		},
+	}))
+
+	// 检查ctx的处理器在超时后停止，下游调用失败返回500
+	honour := func(c *fiber.Ctx) error {
+		select {
+		case <-c.UserContext().Done():
+			return c.Status(fiber.StatusInternalServerError).SendString("query cancelled")
+		case <-time.After(200 * time.Millisecond):
+			return c.SendString("done")
+		}
+	}
+	app.Get("/honour", honour)
+	app.Get("/slow/honour", honour)
+	app.Get("/honour-error", func(c *fiber.Ctx) error {
+		<-c.UserContext().Done()
+		return c.UserContext().Err()
+	})
+	// 不检查ctx的处理器一直运行到完成
+	app.Get("/ignore", func(c *fiber.Ctx) error {
+		time.Sleep(100 * time.Millisecond)
+		return c.Status(fiber.StatusCreated).SendString("created")
+	})
+	app.Get("/ignore-not-found", func(c *fiber.Ctx) error {
+		time.Sleep(100 * time.Millisecond)
+		return c.Status(fiber.StatusNotFound).SendString("missing")
+	})
+	app.Get("/fast", func(c *fiber.Ctx) error {
+		return c.SendString("fast")
+	})
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{"/fast", fiber.StatusOK, "fast"},
+		{"/honour", fiber.StatusGatewayTimeout, ""},
+		{"/honour-error", fiber.StatusGatewayTimeout, ""},
+		{"/slow/honour", fiber.StatusOK, "done"},
+		// 已经成功写出的响应不被504覆盖
+		{"/ignore", fiber.StatusCreated, "created"},
+		{"/ignore-not-found", fiber.StatusNotFound, "missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil), -1)
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantBody == "" {
+				return
+			}
+			if body, _ := io.ReadAll(resp.Body); string(body) != tt.wantBody {
+				t.Errorf("body = %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
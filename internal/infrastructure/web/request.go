@@ -0,0 +1,72 @@
+// Package web 提供HTTP层处理器共用的请求解析和错误响应工具
+package web
+
+import (
+	"errors"
+	"strings"
+
+	"nebula-live/internal/infrastructure/web/dto"
+	apierrors "nebula-live/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequestError 请求本身无效导致的错误，由全局错误处理器按APIError原样输出，不记录为服务端错误
+type RequestError struct {
+	APIError *apierrors.APIError
+}
+
+// Error 返回错误消息
+func (e *RequestError) Error() string {
+	return e.APIError.Message
+}
+
+// validator 可自我校验的请求
+type validator interface {
+	Validate() error
+}
+
+// ParseBody 将JSON请求体解析到req，req实现Validate时一并校验
+//
+// 非JSON的Content-Type、格式错误的JSON和校验失败都返回400的*RequestError，
+// 处理器直接返回该错误即可：
+//
+//	if err := web.ParseBody(c, &req); err != nil {
+//		return err
+//	}
+func ParseBody(c *fiber.Ctx, req interface{}) error {
+	contentType := strings.ToLower(string(c.Request().Header.ContentType()))
+	if !strings.HasPrefix(contentType, fiber.MIMEApplicationJSON) {
+		return &RequestError{
+			APIError: apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid content type", "Content-Type must be application/json"),
+		}
+	}
+
+	if err := c.BodyParser(req); err != nil {
+		return &RequestError{
+			APIError: apierrors.NewAPIError(fiber.StatusBadRequest, "Invalid request body", err.Error()),
+		}
+	}
+
+	if v, ok := req.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return &RequestError{APIError: NewValidationError(err)}
+		}
+	}
+
+	return nil
+}
+
+// NewValidationError 创建校验失败的APIError，字段级错误放入details.fields
+func NewValidationError(err error) *apierrors.APIError {
+	apiErr := apierrors.NewAPIError(fiber.StatusBadRequest, "Validation failed", err.Error())
+
+	var fieldErrs dto.ValidationErrors
+	if errors.As(err, &fieldErrs) {
+		apiErr.WithDetails(map[string]interface{}{
+			"fields": fieldErrs,
+		})
+	}
+
+	return apiErr
+}
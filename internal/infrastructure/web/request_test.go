@@ -0,0 +1,114 @@
+package web_test
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// nameRequest 校验name必填的测试请求
+type nameRequest struct {
+	Name string `json:"name"`
+}
+
+func (r *nameRequest) Validate() error {
+	var errs dto.ValidationErrors
+	if r.Name == "" {
+		errs.Add("name", "is required")
+	}
+	return errs.Err()
+}
+
+// errorBody 错误响应中测试关心的字段
+type errorBody struct {
+	Code    int    `json:"code"`
+	Error   string `json:"error"`
+	Details struct {
+		Fields []dto.FieldError `json:"fields"`
+	} `json:"details"`
+}
+
+func TestParseBody(t *testing.T) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler:          web.NewErrorHandler(zap.NewNop()),
+		BodyLimit:             64,
+		DisableStartupMessage: true,
+	})
+	app.Post("/names", func(c *fiber.Ctx) error {
+		var req nameRequest
+		if err := web.ParseBody(c, &req); err != nil {
+			return err
+		}
+		return c.SendString(req.Name)
+	})
+
+	// 超过BodyLimit的请求在读取时被拒绝，app.Test无法得到响应，因此监听真实端口
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	go app.Listener(listener)
+	t.Cleanup(func() { app.Shutdown() })
+	url := "http://" + listener.Addr().String() + "/names"
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantStatus  int
+		wantError   string
+		wantField   string
+	}{
+		{"valid", fiber.MIMEApplicationJSON, `{"name":"alice"}`, fiber.StatusOK, "", ""},
+		{"charset suffix", fiber.MIMEApplicationJSONCharsetUTF8, `{"name":"alice"}`, fiber.StatusOK, "", ""},
+		{"form content type", fiber.MIMEApplicationForm, `name=alice`, fiber.StatusBadRequest, "Invalid content type", ""},
+		{"missing content type", "", `{"name":"alice"}`, fiber.StatusBadRequest, "Invalid content type", ""},
+		{"malformed json", fiber.MIMEApplicationJSON, `{"name":`, fiber.StatusBadRequest, "Invalid request body", ""},
+		{"wrong field type", fiber.MIMEApplicationJSON, `{"name":42}`, fiber.StatusBadRequest, "Invalid request body", ""},
+		{"validation failed", fiber.MIMEApplicationJSON, `{}`, fiber.StatusBadRequest, "Validation failed", "name"},
+		{"oversized body", fiber.MIMEApplicationJSON, `{"name":"` + strings.Repeat("a", 100) + `"}`, fiber.StatusRequestEntityTooLarge, "Request failed", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(fiber.MethodPost, url, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("http.NewRequest() error = %v", err)
+			}
+			if tt.contentType != "" {
+				req.Header.Set(fiber.HeaderContentType, tt.contentType)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus == fiber.StatusOK {
+				return
+			}
+
+			// 错误响应统一为APIError格式
+			var body errorBody
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decode error response: %v", err)
+			}
+			if body.Code != tt.wantStatus || body.Error != tt.wantError {
+				t.Errorf("response code = %d, error = %q; want %d, %q", body.Code, body.Error, tt.wantStatus, tt.wantError)
+			}
+			if tt.wantField != "" && (len(body.Details.Fields) != 1 || body.Details.Fields[0].Field != tt.wantField) {
+				t.Errorf("details.fields = %+v, want a single %s error", body.Details.Fields, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package web
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"nebula-live/internal/infrastructure/web/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// pageMetaKey 列表接口分页信息在Locals中的键
+const pageMetaKey = "web.page_meta"
+
+// PageMeta 列表接口的分页信息，启用响应信封时放在meta字段
+type PageMeta = dto.Pagination
+
+// Envelope 启用响应信封（server.response_envelope）时所有业务接口的响应格式
+//
+// 成功时data为原响应体，失败时error为原错误响应体，另一个为null；meta只有列表接口才有。
+type Envelope struct {
+	Data  json.RawMessage `json:"data" swaggertype:"object"`
+	Error json.RawMessage `json:"error" swaggertype:"object"`
+	Meta  *PageMeta       `json:"meta"`
+}
+
+// List 写出列表响应并记录分页信息，列表接口使用它代替c.JSON，使响应信封能带上分页信息：
+//
+//	pagination := dto.NewPagination(total, page, limit)
+//	return web.List(c, response, pagination)
+func List(c *fiber.Ctx, body any, meta PageMeta) error {
+	c.Locals(pageMetaKey, meta)
+	return c.JSON(body)
+}
+
+// WrapEnvelope 将已写出的JSON响应包装为Envelope，状态码不变
+//
+// 响应体为空或不是JSON（如重定向、文件）时保持原样。
+func WrapEnvelope(c *fiber.Ctx) error {
+	body := c.Response().Body()
+	contentType := string(c.Response().Header.ContentType())
+	if len(body) == 0 || !strings.HasPrefix(contentType, fiber.MIMEApplicationJSON) || !json.Valid(body) {
+		return nil
+	}
+
+	var envelope Envelope
+	if meta, ok := c.Locals(pageMetaKey).(PageMeta); ok {
+		envelope.Meta = &meta
+	}
+
+	// 响应体缓冲区会被c.JSON复用，需要先复制
+	raw := json.RawMessage(slices.Clone(body))
+	if c.Response().StatusCode() >= fiber.StatusBadRequest {
+		envelope.Error = raw
+	} else {
+		envelope.Data = raw
+	}
+
+	return c.JSON(envelope)
+}
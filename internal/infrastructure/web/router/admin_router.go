@@ -0,0 +1,48 @@
+package router
+
+import (
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/infrastructure/web/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminRouter 系统运维路由器
+type AdminRouter struct {
+	adminHandler   *handler.AdminHandler
+	webhookHandler *handler.WebhookHandler
+	jobHandler     *handler.JobHandler
+	authMiddleware *middleware.AuthMiddleware
+	rbacMiddleware *middleware.RBACMiddleware
+}
+
+// NewAdminRouter 创建系统运维路由器
+func NewAdminRouter(adminHandler *handler.AdminHandler, webhookHandler *handler.WebhookHandler, jobHandler *handler.JobHandler, authMiddleware *middleware.AuthMiddleware, rbacMiddleware *middleware.RBACMiddleware) Router {
+	return &AdminRouter{
+		adminHandler:   adminHandler,
+		webhookHandler: webhookHandler,
+		jobHandler:     jobHandler,
+		authMiddleware: authMiddleware,
+		rbacMiddleware: rbacMiddleware,
+	}
+}
+
+// RegisterRoutes 注册系统运维路由
+func (r *AdminRouter) RegisterRoutes(router fiber.Router) {
+	// 系统运维路由组 - 需要认证和系统管理权限
+	admin := router.Group("/admin").Use(
+		r.authMiddleware.RequireAuth(),
+		r.rbacMiddleware.RequirePermission("system", "manage"),
+	)
+	{
+		admin.Get("/routes", r.adminHandler.ListRoutes)                    // 获取已注册的路由列表
+		admin.Get("/webhooks/deliveries", r.webhookHandler.ListDeliveries) // 获取Webhook投递记录
+		admin.Get("/jobs/:id", r.jobHandler.GetJob)                        // 获取任意异步任务状态
+		admin.Post("/rbac/reinitialize", r.adminHandler.ReinitializeRBAC)  // 重新初始化RBAC系统数据
+	}
+}
+
+// GetPrefix 获取相对于API基础路径的路由前缀
+func (r *AdminRouter) GetPrefix() string {
+	return ""
+}
@@ -37,10 +37,15 @@ func (r *AuthRouter) RegisterRoutes(router fiber.Router) {
 	authenticated := auth.Use(r.authMiddleware.RequireAuth())
 	{
 		authenticated.Get("/me", r.authHandler.GetCurrentUser) // 获取当前用户信息
+
+		// 登录会话管理
+		authenticated.Get("/sessions", r.authHandler.ListSessions)           // 获取活跃会话
+		authenticated.Delete("/sessions", r.authHandler.RevokeOtherSessions) // 撤销除当前会话外的所有会话
+		authenticated.Delete("/sessions/:id", r.authHandler.RevokeSession)   // 撤销指定会话
 	}
 }
 
-// GetPrefix 获取路由前缀
+// GetPrefix 获取相对于API基础路径的路由前缀
 func (r *AuthRouter) GetPrefix() string {
-	return "/api/v1"
+	return ""
 }
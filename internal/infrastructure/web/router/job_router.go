@@ -0,0 +1,36 @@
+package router
+
+import (
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/infrastructure/web/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// JobRouter 异步任务路由器
+type JobRouter struct {
+	jobHandler     *handler.JobHandler
+	authMiddleware *middleware.AuthMiddleware
+}
+
+// NewJobRouter 创建异步任务路由器
+func NewJobRouter(jobHandler *handler.JobHandler, authMiddleware *middleware.AuthMiddleware) Router {
+	return &JobRouter{
+		jobHandler:     jobHandler,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// RegisterRoutes 注册异步任务路由
+func (r *JobRouter) RegisterRoutes(router fiber.Router) {
+	// 用户只能查询自己提交的任务，管理员通过 /admin/jobs/:id 查询任意任务
+	jobs := router.Group("/jobs", r.authMiddleware.RequireAuth())
+	{
+		jobs.Get("/:id", r.jobHandler.GetMyJob) // 获取我提交的任务状态
+	}
+}
+
+// GetPrefix 获取相对于API基础路径的路由前缀
+func (r *JobRouter) GetPrefix() string {
+	return ""
+}
@@ -1,40 +1,71 @@
 package router
 
 import (
+	"time"
+
+	"nebula-live/internal/infrastructure/config"
 	"nebula-live/internal/infrastructure/web/handler"
 	"nebula-live/internal/infrastructure/web/middleware"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// LiveStreamRouter 直播信息路由器，除直播间订阅外所有路由都是公开接口
 type LiveStreamRouter struct {
-	handler        *handler.LiveStreamHandler
-	authMiddleware *middleware.AuthMiddleware
+	handler             *handler.LiveStreamHandler
+	subscriptionHandler *handler.LiveSubscriptionHandler
+	authMiddleware      *middleware.AuthMiddleware
+	cacheMaxAge         time.Duration
 }
 
 func NewLiveStreamRouter(
 	handler *handler.LiveStreamHandler,
+	subscriptionHandler *handler.LiveSubscriptionHandler,
 	authMiddleware *middleware.AuthMiddleware,
+	cfg *config.Config,
 ) Router {
 	return &LiveStreamRouter{
-		handler:        handler,
-		authMiddleware: authMiddleware,
+		handler:             handler,
+		subscriptionHandler: subscriptionHandler,
+		authMiddleware:      authMiddleware,
+		cacheMaxAge:         cfg.Live.CacheMaxAge,
 	}
 }
 
 func (r *LiveStreamRouter) GetPrefix() string {
-	return "/api/v1"
+	return ""
 }
 
+// RegisterRoutes 注册直播信息路由
+//
+// 公开路由不挂载认证中间件，响应带可缓存的Cache-Control头；跨域（含预检）由全局CORS中间件
+// 按 cors.overrides 中该路径前缀的配置处理。
+//
+// 缓存头中间件只挂在各个公开路由上，而不是 /live-streams 路由组上：直播间订阅路由需要认证且按用户
+// 返回数据，不能被标记为可公开缓存，且不应依赖路由的注册顺序。
 func (r *LiveStreamRouter) RegisterRoutes(router fiber.Router) {
+	// 当前用户的直播间订阅管理
+	subscriptions := router.Group("/live-streams/subscriptions", r.authMiddleware.RequireAuth())
+	subscriptions.Post("/", r.subscriptionHandler.Subscribe)        // 订阅直播间
+	subscriptions.Get("/", r.subscriptionHandler.ListSubscriptions) // 获取订阅列表
+	subscriptions.Delete("/", r.subscriptionHandler.UnsubscribeAll) // 取消所有订阅
+	subscriptions.Delete("/:id", r.subscriptionHandler.Unsubscribe) // 取消指定订阅
+
+	// 当前用户订阅直播间的状态汇总
+	router.Get("/live-streams/my-subscriptions", r.authMiddleware.RequireAuth(), r.subscriptionHandler.ListSubscriptionStatuses)
+
+	publicCache := middleware.NewPublicCacheControl(r.cacheMaxAge)
 	liveStreamGroup := router.Group("/live-streams")
 
 	// Get supported platforms (public endpoint)
-	liveStreamGroup.Get("/platforms", r.handler.GetSupportedPlatforms)
+	liveStreamGroup.Get("/platforms", publicCache, r.handler.GetSupportedPlatforms)
 
 	// Get stream status (public endpoint)
-	liveStreamGroup.Get("/:platform/rooms/:roomId/status", r.handler.GetStreamStatus)
+	liveStreamGroup.Get("/:platform/rooms/:roomId/status", publicCache, r.handler.GetStreamStatus)
 
 	// Get room info (public endpoint)
-	liveStreamGroup.Get("/:platform/rooms/:roomId/info", r.handler.GetRoomInfo)
+	liveStreamGroup.Get("/:platform/rooms/:roomId/info", publicCache, r.handler.GetRoomInfo)
+
+	// Resolve a short room ID to the real room ID (public endpoint)
+	liveStreamGroup.Get("/:platform/rooms/:roomId/resolve", publicCache, r.handler.ResolveRoomID)
 }
@@ -0,0 +1,95 @@
+package router_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/infrastructure/web"
+	"nebula-live/internal/infrastructure/web/handler"
+	"nebula-live/internal/infrastructure/web/middleware"
+	"nebula-live/internal/infrastructure/web/router"
+	"nebula-live/internal/pkg/livestream"
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+func TestLiveStreamRouter_OnlyPublicRoutesArePubliclyCacheable(t *testing.T) {
+	ctx := context.Background()
+	client := testutil.NewEntClient(t)
+	user, err := testutil.NewUserService(t, client, testutil.NewRBACService(t, client)).
+		CreateUser(ctx, "dave", "dave@example.com", "Password123!", "Dave")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	provider := testutil.NewFakeLiveStreamProvider("douyu")
+	provider.SetRoom(&livestream.RoomInfo{RoomID: "100", Status: livestream.StreamStatusOnline, Title: "直播中"})
+	liveClient := livestream.NewClient(livestream.ClientConfig{})
+	liveClient.RegisterProvider(provider)
+	liveStreamService := service.NewLiveStreamServiceWithClient(liveClient)
+	subscriptionService := service.NewLiveSubscriptionService(
+		persistence.NewLiveSubscriptionRepository(client), liveStreamService, service.LiveSubscriptionServiceConfig{})
+
+	jwtManager := auth.NewJWTManager(&auth.TokenConfig{
+		SecretKey:       "test-secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+	})
+	cfg := &config.Config{}
+	liveRouter := router.NewLiveStreamRouter(
+		handler.NewLiveStreamHandler(liveStreamService, zap.NewNop()),
+		handler.NewLiveSubscriptionHandler(subscriptionService, handler.NewPaginator(cfg)),
+		middleware.NewAuthMiddleware(jwtManager, zap.NewNop()),
+		cfg,
+	)
+	app := fiber.New(fiber.Config{ErrorHandler: web.NewErrorHandler(zap.NewNop())})
+	liveRouter.RegisterRoutes(app)
+
+	pair, err := jwtManager.GenerateSessionTokenPair(user.ID, user.Username, user.Email, 1, "refresh-id")
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+	}
+
+	tests := []struct {
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantPublic bool
+	}{
+		{fiber.MethodGet, "/live-streams/platforms", "", fiber.StatusOK, true},
+		{fiber.MethodGet, "/live-streams/douyu/rooms/100/status", "", fiber.StatusOK, true},
+		{fiber.MethodPost, "/live-streams/subscriptions", `{"platform":"douyu","room_id":"100"}`, fiber.StatusCreated, false},
+		{fiber.MethodGet, "/live-streams/subscriptions", "", fiber.StatusOK, false},
+		{fiber.MethodGet, "/live-streams/my-subscriptions", "", fiber.StatusOK, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+pair.AccessToken)
+			if tt.body != "" {
+				req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			cacheControl := resp.Header.Get(fiber.HeaderCacheControl)
+			if got := strings.Contains(cacheControl, "public"); got != tt.wantPublic {
+				t.Errorf("Cache-Control = %q, want public = %v", cacheControl, tt.wantPublic)
+			}
+		})
+	}
+}
@@ -23,6 +23,8 @@ var RouterModule = fx.Options(
 	fx.Provide(asRoute(NewLiveStreamRouter)),
 	fx.Provide(asRoute(NewUserPushSettingRouter)),
 	fx.Provide(asRoute(NewUserPushRouter)),
+	fx.Provide(asRoute(NewAdminRouter)),
+	fx.Provide(asRoute(NewJobRouter)),
 
 	// 提供路由注册器
 	fx.Provide(NewRouterRegistry),
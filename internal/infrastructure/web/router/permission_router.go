@@ -32,21 +32,26 @@ func (r *PermissionRouter) RegisterRoutes(router fiber.Router) {
 	)
 	{
 		// 基础CRUD操作
-		permissions.Post("/", r.permissionHandler.CreatePermission)      // 创建权限
-		permissions.Get("/:id", r.permissionHandler.GetPermission)       // 获取权限信息
-		permissions.Put("/:id", r.permissionHandler.UpdatePermission)    // 更新权限信息
-		permissions.Delete("/:id", r.permissionHandler.DeletePermission) // 删除权限
-		permissions.Get("/", r.permissionHandler.ListPermissions)        // 获取权限列表
+		permissions.Post("/", r.permissionHandler.CreatePermission)             // 创建权限
+		permissions.Post("/batch", r.permissionHandler.CreatePermissionsBatch)  // 批量创建权限
+		permissions.Get("/grouped", r.permissionHandler.ListPermissionsGrouped) // 按分类获取所有权限
+		permissions.Get("/:id", r.permissionHandler.GetPermission)              // 获取权限信息
+		permissions.Put("/:id", r.permissionHandler.UpdatePermission)           // 更新权限信息
+		permissions.Delete("/:id", r.permissionHandler.DeletePermission)        // 删除权限
+		permissions.Get("/", r.permissionHandler.ListPermissions)               // 获取权限列表
 
 		// 权限分配管理
 		permissions.Post("/:id/assign", r.permissionHandler.AssignPermissionToRole)            // 为角色分配权限
 		permissions.Delete("/:id/roles/:roleId", r.permissionHandler.RemovePermissionFromRole) // 移除角色权限
 		permissions.Get("/roles/:roleId", r.permissionHandler.GetRolePermissions)              // 获取角色的所有权限
 		permissions.Get("/users/:userId", r.permissionHandler.GetUserPermissions)              // 获取用户的所有权限
+
+		// 权限审计：获取拥有指定权限的用户
+		permissions.Get("/by-action/users", r.rbacMiddleware.RequirePermission("permission", "read"), r.permissionHandler.GetPermissionUsers)
 	}
 }
 
-// GetPrefix 获取路由前缀
+// GetPrefix 获取相对于API基础路径的路由前缀
 func (r *PermissionRouter) GetPrefix() string {
-	return "/api/v1"
+	return ""
 }
@@ -1,41 +1,56 @@
 package router
 
 import (
+	"nebula-live/internal/infrastructure/config"
+
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/fx"
 )
 
 // RouterRegistry 路由注册器
 type RouterRegistry struct {
-	routers []Router
+	routers  []Router
+	basePath string
 }
 
 // RouterRegistryParams 路由注册器参数
 type RouterRegistryParams struct {
 	fx.In
 
+	Config  *config.Config
 	Routers []Router `group:"routers"`
 }
 
 // NewRouterRegistry 创建路由注册器
 func NewRouterRegistry(params RouterRegistryParams) *RouterRegistry {
 	return &RouterRegistry{
-		routers: params.Routers,
+		routers:  params.Routers,
+		basePath: params.Config.Server.API.BasePath(),
 	}
 }
 
-// RegisterAllRoutes 注册所有路由
+// BasePath 返回业务接口的挂载路径
+func (r *RouterRegistry) BasePath() string {
+	return r.basePath
+}
+
+// RegisterAllRoutes 在API基础路径下注册所有路由
 func (r *RouterRegistry) RegisterAllRoutes(app *fiber.App) {
+	var api fiber.Router = app
+	if r.basePath != "" {
+		api = app.Group(r.basePath)
+	}
+
 	// 为每个路由器创建对应的路由组
 	for _, router := range r.routers {
 		prefix := router.GetPrefix()
 		if prefix != "" {
-			group := app.Group(prefix)
+			group := api.Group(prefix)
 			// 在路由组中注册路由
 			router.RegisterRoutes(group)
 		} else {
-			// 直接在app上注册路由
-			router.RegisterRoutes(app)
+			// 直接在API基础路径上注册路由
+			router.RegisterRoutes(api)
 		}
 	}
 }
@@ -0,0 +1,86 @@
+package router_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"nebula-live/internal/infrastructure/config"
+	"nebula-live/internal/infrastructure/web/router"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// stubRouter 在给定前缀下注册一个返回200的路由
+type stubRouter struct {
+	prefix string
+	path   string
+}
+
+func (r stubRouter) RegisterRoutes(router fiber.Router) {
+	router.Get(r.path, func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+}
+
+func (r stubRouter) GetPrefix() string {
+	return r.prefix
+}
+
+// newRegistryTestApp 按API配置注册/users和/admin/routes
+func newRegistryTestApp(api config.APIConfig) (*fiber.App, *router.RouterRegistry) {
+	cfg := &config.Config{}
+	cfg.Server.API = api
+	registry := router.NewRouterRegistry(router.RouterRegistryParams{
+		Config: cfg,
+		Routers: []router.Router{
+			stubRouter{path: "/users"},
+			stubRouter{prefix: "/admin", path: "/routes"},
+		},
+	})
+	app := fiber.New()
+	app.Get("/health", func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+	registry.RegisterAllRoutes(app)
+	return app, registry
+}
+
+func TestRouterRegistry_APIBasePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		api      config.APIConfig
+		basePath string
+	}{
+		{"versioned", config.APIConfig{Prefix: "/api", Version: "v1"}, "/api/v1"},
+		{"slashes trimmed", config.APIConfig{Prefix: "api/", Version: "/v2/"}, "/api/v2"},
+		{"prefix only", config.APIConfig{Prefix: "/api"}, "/api"},
+		{"root", config.APIConfig{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, registry := newRegistryTestApp(tt.api)
+			if got := registry.BasePath(); got != tt.basePath {
+				t.Fatalf("BasePath() = %q, want %q", got, tt.basePath)
+			}
+
+			status := func(path string) int {
+				resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil))
+				if err != nil {
+					t.Fatalf("app.Test(%s) error = %v", path, err)
+				}
+				return resp.StatusCode
+			}
+			for _, path := range []string{tt.basePath + "/users", tt.basePath + "/admin/routes", "/health"} {
+				if got := status(path); got != fiber.StatusOK {
+					t.Errorf("GET %s status = %d, want %d", path, got, fiber.StatusOK)
+				}
+			}
+			// 配置前缀后根路径下不再注册业务接口
+			if tt.basePath != "" {
+				if got := status("/users"); got != fiber.StatusNotFound {
+					t.Errorf("GET /users status = %d, want %d", got, fiber.StatusNotFound)
+				}
+			}
+		})
+	}
+}
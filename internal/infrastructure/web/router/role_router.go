@@ -35,6 +35,7 @@ func (r *RoleRouter) RegisterRoutes(router fiber.Router) {
 		roles.Post("/", r.roleHandler.CreateRole)      // 创建角色
 		roles.Get("/:id", r.roleHandler.GetRole)       // 获取角色信息
 		roles.Put("/:id", r.roleHandler.UpdateRole)    // 更新角色信息
+		roles.Patch("/:id", r.roleHandler.PatchRole)   // 部分更新角色信息
 		roles.Delete("/:id", r.roleHandler.DeleteRole) // 删除角色
 		roles.Get("/", r.roleHandler.ListRoles)        // 获取角色列表
 
@@ -45,7 +46,7 @@ func (r *RoleRouter) RegisterRoutes(router fiber.Router) {
 	}
 }
 
-// GetPrefix 获取路由前缀
+// GetPrefix 获取相对于API基础路径的路由前缀
 func (r *RoleRouter) GetPrefix() string {
-	return "/api/v1"
+	return ""
 }
@@ -9,18 +9,24 @@ import (
 
 // UserPushRouter 用户推送路由器
 type UserPushRouter struct {
-	handler        *handler.UserPushHandler
-	authMiddleware *middleware.AuthMiddleware
+	handler              *handler.UserPushHandler
+	scheduledPushHandler *handler.ScheduledPushHandler
+	recurringPushHandler *handler.RecurringPushHandler
+	authMiddleware       *middleware.AuthMiddleware
 }
 
 // NewUserPushRouter 创建用户推送路由器
 func NewUserPushRouter(
 	handler *handler.UserPushHandler,
+	scheduledPushHandler *handler.ScheduledPushHandler,
+	recurringPushHandler *handler.RecurringPushHandler,
 	authMiddleware *middleware.AuthMiddleware,
 ) Router {
 	return &UserPushRouter{
-		handler:        handler,
-		authMiddleware: authMiddleware,
+		handler:              handler,
+		scheduledPushHandler: scheduledPushHandler,
+		recurringPushHandler: recurringPushHandler,
+		authMiddleware:       authMiddleware,
 	}
 }
 
@@ -34,11 +40,32 @@ func (r *UserPushRouter) RegisterRoutes(router fiber.Router) {
 	
 	// 用户推送功能
 	userPush.Post("/my-devices", r.handler.SendToMyDevices)                    // 发送到我的所有设备
+	userPush.Post("/my-devices/async", r.handler.SendToMyDevicesAsync)         // 以异步任务发送到我的所有设备，需在 :provider 路由之前注册
 	userPush.Post("/my-devices/:provider", r.handler.SendToMyDevicesByProvider) // 发送到我指定提供商的设备
 	userPush.Post("/test", r.handler.TestMyPushSettings)                       // 测试我的推送设置
+	userPush.Post("/preview", r.handler.PreviewMyPush)                         // 预览各设备最终收到的消息
+
+	// 用户推送偏好
+	userPush.Get("/preferences", r.handler.GetMyPushPreferences)    // 获取我的推送偏好
+	userPush.Put("/preferences", r.handler.UpdateMyPushPreferences) // 更新我的推送偏好
+
+	// 定时推送管理
+	userPush.Post("/scheduled", r.scheduledPushHandler.CreateScheduledPush)            // 创建定时推送
+	userPush.Get("/scheduled", r.scheduledPushHandler.GetScheduledPushes)              // 获取定时推送列表
+	userPush.Get("/scheduled/:id", r.scheduledPushHandler.GetScheduledPush)            // 获取指定定时推送
+	userPush.Put("/scheduled/:id", r.scheduledPushHandler.UpdateScheduledPush)         // 更新定时推送
+	userPush.Delete("/scheduled/:id", r.scheduledPushHandler.DeleteScheduledPush)      // 删除定时推送
+	userPush.Post("/scheduled/:id/cancel", r.scheduledPushHandler.CancelScheduledPush) // 取消定时推送
+
+	// 周期推送管理
+	userPush.Post("/recurring", r.recurringPushHandler.CreateRecurringPush)       // 创建周期推送
+	userPush.Get("/recurring", r.recurringPushHandler.GetRecurringPushes)         // 获取周期推送列表
+	userPush.Get("/recurring/:id", r.recurringPushHandler.GetRecurringPush)       // 获取指定周期推送
+	userPush.Put("/recurring/:id", r.recurringPushHandler.UpdateRecurringPush)    // 更新周期推送
+	userPush.Delete("/recurring/:id", r.recurringPushHandler.DeleteRecurringPush) // 删除周期推送
 }
 
-// GetPrefix 获取路由前缀
+// GetPrefix 获取相对于API基础路径的路由前缀
 func (r *UserPushRouter) GetPrefix() string {
-	return "/api/v1"
+	return ""
 }
\ No newline at end of file
@@ -11,16 +11,19 @@ import (
 type UserPushSettingRouter struct {
 	handler        *handler.UserPushSettingHandler
 	authMiddleware *middleware.AuthMiddleware
+	rbacMiddleware *middleware.RBACMiddleware
 }
 
 // NewUserPushSettingRouter 创建用户推送设置路由器
 func NewUserPushSettingRouter(
 	handler *handler.UserPushSettingHandler,
 	authMiddleware *middleware.AuthMiddleware,
+	rbacMiddleware *middleware.RBACMiddleware,
 ) Router {
 	return &UserPushSettingRouter{
 		handler:        handler,
 		authMiddleware: authMiddleware,
+		rbacMiddleware: rbacMiddleware,
 	}
 }
 
@@ -34,21 +37,31 @@ func (r *UserPushSettingRouter) RegisterRoutes(router fiber.Router) {
 
 	// 公开端点（不需要认证）
 	router.Get("/push-settings/providers", r.handler.GetSupportedProviders)     // 获取支持的推送提供商
+	router.Get("/push-settings/providers/health", r.handler.GetProvidersHealth) // 获取推送提供商可达性
+	router.Get("/push-settings/providers/:provider/schema", r.handler.GetProviderSchema) // 获取推送提供商设置结构
 	router.Post("/push-settings/validate-device", r.handler.ValidateDevice)     // 验证设备ID是否可用
 	
 	// 用户推送设置管理
 	pushSettings.Post("/", r.handler.CreateSetting)      // 创建推送设置
 	pushSettings.Get("/", r.handler.GetSettings)         // 获取推送设置列表
-	pushSettings.Get("/:id", r.handler.GetSetting)       // 获取指定推送设置
-	pushSettings.Put("/:id", r.handler.UpdateSetting)    // 更新推送设置
-	pushSettings.Delete("/:id", r.handler.DeleteSetting) // 删除推送设置
+	pushSettings.Post("/enable-all", r.handler.EnableAllSettings)   // 启用当前用户的所有推送设置
+	pushSettings.Post("/disable-all", r.handler.DisableAllSettings) // 禁用当前用户的所有推送设置
+
+	// 指定推送设置仅所有者或拥有用户管理权限者可访问
+	ownerOrAdmin := r.rbacMiddleware.RequireOwnerOrPermission(r.handler.ResolveSettingOwner, "user", "manage")
+	pushSettings.Get("/:id", ownerOrAdmin, r.handler.GetSetting)       // 获取指定推送设置
+	pushSettings.Put("/:id", ownerOrAdmin, r.handler.UpdateSetting)    // 更新推送设置
+	pushSettings.Delete("/:id", ownerOrAdmin, r.handler.DeleteSetting) // 删除推送设置
 
 	// 推送设置状态管理
-	pushSettings.Post("/:id/enable", r.handler.EnableSetting)   // 启用推送设置
-	pushSettings.Post("/:id/disable", r.handler.DisableSetting) // 禁用推送设置
+	pushSettings.Post("/:id/enable", ownerOrAdmin, r.handler.EnableSetting)   // 启用推送设置
+	pushSettings.Post("/:id/disable", ownerOrAdmin, r.handler.DisableSetting) // 禁用推送设置
+
+	// 在用户之间转移设备，仅拥有用户管理权限者可操作
+	pushSettings.Post("/:id/transfer", r.rbacMiddleware.RequirePermission("user", "manage"), r.handler.TransferSetting)
 }
 
-// GetPrefix 获取路由前缀
+// GetPrefix 获取相对于API基础路径的路由前缀
 func (r *UserPushSettingRouter) GetPrefix() string {
-	return "/api/v1"
+	return ""
 }
@@ -9,22 +9,31 @@ import (
 
 // UserRouter 用户路由器
 type UserRouter struct {
-	userHandler    *handler.UserHandler
-	authMiddleware *middleware.AuthMiddleware
-	rbacMiddleware *middleware.RBACMiddleware
+	userHandler            *handler.UserHandler
+	userPushSettingHandler *handler.UserPushSettingHandler
+	authMiddleware         *middleware.AuthMiddleware
+	rbacMiddleware         *middleware.RBACMiddleware
 }
 
 // NewUserRouter 创建用户路由器
-func NewUserRouter(userHandler *handler.UserHandler, authMiddleware *middleware.AuthMiddleware, rbacMiddleware *middleware.RBACMiddleware) Router {
+func NewUserRouter(userHandler *handler.UserHandler, userPushSettingHandler *handler.UserPushSettingHandler, authMiddleware *middleware.AuthMiddleware, rbacMiddleware *middleware.RBACMiddleware) Router {
 	return &UserRouter{
-		userHandler:    userHandler,
-		authMiddleware: authMiddleware,
-		rbacMiddleware: rbacMiddleware,
+		userHandler:            userHandler,
+		userPushSettingHandler: userPushSettingHandler,
+		authMiddleware:         authMiddleware,
+		rbacMiddleware:         rbacMiddleware,
 	}
 }
 
 // RegisterRoutes 注册用户相关路由
 func (r *UserRouter) RegisterRoutes(router fiber.Router) {
+	// 用户推送设备只需要user:read或user:manage权限，供客服排查通知问题；需在admin路由组之前注册，避免被admin中间件拦截
+	router.Get("/users/:id/devices",
+		r.authMiddleware.RequireAuth(),
+		r.rbacMiddleware.RequireAnyPermission([2]string{"user", "read"}, [2]string{"user", "manage"}),
+		r.userPushSettingHandler.GetUserDevices,
+	)
+
 	// 用户路由组 - 所有路由都需要认证和admin角色
 	users := router.Group("/users").Use(
 		r.authMiddleware.RequireAuth(),
@@ -34,6 +43,7 @@ func (r *UserRouter) RegisterRoutes(router fiber.Router) {
 		users.Post("/", r.userHandler.CreateUser)      // 创建用户
 		users.Get("/:id", r.userHandler.GetUser)       // 获取用户信息
 		users.Put("/:id", r.userHandler.UpdateUser)    // 更新用户信息
+		users.Patch("/:id", r.userHandler.PatchUser)   // 部分更新用户信息
 		users.Delete("/:id", r.userHandler.DeleteUser) // 删除用户
 		users.Get("/", r.userHandler.ListUsers)        // 获取用户列表
 
@@ -41,10 +51,16 @@ func (r *UserRouter) RegisterRoutes(router fiber.Router) {
 		users.Post("/:id/activate", r.userHandler.ActivateUser)     // 激活用户
 		users.Post("/:id/deactivate", r.userHandler.DeactivateUser) // 停用用户
 		users.Post("/:id/ban", r.userHandler.BanUser)               // 禁用用户
+
+		// 模拟登录
+		users.Post("/:id/impersonate", r.userHandler.ImpersonateUser) // 以目标用户身份签发短期令牌
+
+		// 用户角色管理
+		users.Delete("/:id/roles", r.userHandler.RemoveRoles) // 批量移除用户角色
 	}
 }
 
-// GetPrefix 获取路由前缀
+// GetPrefix 获取相对于API基础路径的路由前缀
 func (r *UserRouter) GetPrefix() string {
-	return "/api/v1"
+	return ""
 }
@@ -0,0 +1,153 @@
+// Package captcha verifies CAPTCHA tokens solved by clients with the provider's siteverify API
+package captcha
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"nebula-live/internal/pkg/httpproxy"
+
+	"resty.dev/v3"
+)
+
+// Supported providers
+const (
+	ProviderHCaptcha  = "hcaptcha"
+	ProviderTurnstile = "turnstile"
+	ProviderReCaptcha = "recaptcha"
+)
+
+// Default siteverify endpoints of the supported providers
+var defaultVerifyURLs = map[string]string{
+	ProviderHCaptcha:  "https://api.hcaptcha.com/siteverify",
+	ProviderTurnstile: "https://challenges.cloudflare.com/turnstile/v0/siteverify",
+	ProviderReCaptcha: "https://www.google.com/recaptcha/api/siteverify",
+}
+
+const defaultTimeout = 5 * time.Second
+
+var (
+	// ErrMissingToken is returned when the client did not submit a token
+	ErrMissingToken = errors.New("captcha token is required")
+	// ErrVerificationFailed is returned when the provider rejects the token
+	ErrVerificationFailed = errors.New("captcha verification failed")
+)
+
+// Verifier checks a token solved by the client, remoteIP is optional and only forwarded to the provider
+type Verifier interface {
+	// Verify returns nil when the token is valid, ErrMissingToken or ErrVerificationFailed when the
+	// client should be rejected, and any other error when the provider could not be reached
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// NoopVerifier accepts every request, used when CAPTCHA verification is disabled
+type NoopVerifier struct{}
+
+// Verify always succeeds
+func (NoopVerifier) Verify(context.Context, string, string) error {
+	return nil
+}
+
+// Config holds the options of a siteverify based verifier
+type Config struct {
+	// Provider is one of ProviderHCaptcha, ProviderTurnstile or ProviderReCaptcha
+	Provider string
+	// Secret is the server-side secret key issued by the provider
+	Secret string
+	// VerifyURL overrides the siteverify endpoint of the provider, e.g. for a self-hosted compatible service
+	VerifyURL string
+	// Timeout bounds a single verification request
+	Timeout time.Duration
+	// Proxy routes verification requests through an upstream proxy when set
+	Proxy httpproxy.Config
+}
+
+// siteVerifier verifies tokens with the siteverify API shared by hCaptcha, Turnstile and reCAPTCHA
+type siteVerifier struct {
+	httpClient *resty.Client
+	verifyURL  string
+	secret     string
+}
+
+// siteVerifyResponse is the common subset of the siteverify responses
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// NewVerifier creates a verifier for the configured provider
+func NewVerifier(config Config) (Verifier, error) {
+	verifyURL, ok := defaultVerifyURLs[config.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown captcha provider %q", config.Provider)
+	}
+	if config.Secret == "" {
+		return nil, errors.New("captcha secret is required")
+	}
+	if config.VerifyURL != "" {
+		verifyURL = config.VerifyURL
+	}
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	httpClient := resty.New().SetTimeout(timeout)
+	if err := httpproxy.Apply(httpClient, config.Proxy); err != nil {
+		return nil, fmt.Errorf("failed to configure proxy: %w", err)
+	}
+
+	return &siteVerifier{
+		httpClient: httpClient,
+		verifyURL:  verifyURL,
+		secret:     config.Secret,
+	}, nil
+}
+
+// Verify posts the token to the siteverify endpoint
+func (v *siteVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return ErrMissingToken
+	}
+
+	form := map[string]string{
+		"secret":   v.secret,
+		"response": token,
+	}
+	if remoteIP != "" {
+		form["remoteip"] = remoteIP
+	}
+
+	var result siteVerifyResponse
+	resp, err := v.httpClient.R().
+		SetContext(ctx).
+		SetFormData(form).
+		SetResult(&result).
+		Post(v.verifyURL)
+	if err != nil {
+		return fmt.Errorf("failed to reach captcha provider: %w", err)
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return fmt.Errorf("captcha provider returned status code: %d", resp.StatusCode())
+	}
+
+	if !result.Success {
+		// A rejected secret is a server misconfiguration rather than a bad client token
+		for _, code := range result.ErrorCodes {
+			if code == "missing-input-secret" || code == "invalid-input-secret" {
+				return fmt.Errorf("captcha provider rejected the secret: %s", code)
+			}
+		}
+		if len(result.ErrorCodes) > 0 {
+			return fmt.Errorf("%w: %s", ErrVerificationFailed, strings.Join(result.ErrorCodes, ", "))
+		}
+		return ErrVerificationFailed
+	}
+
+	return nil
+}
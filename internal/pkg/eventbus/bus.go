@@ -0,0 +1,222 @@
+// Package eventbus provides an in-process publish/subscribe bus with asynchronous subscribers,
+// and synchronous ones for work that must be done before Publish returns
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// DefaultQueueSize is the per-subscriber buffer used when Config.QueueSize is not set
+const DefaultQueueSize = 256
+
+// ErrClosed is returned by Subscribe after the bus has been closed
+var ErrClosed = errors.New("event bus closed")
+
+// Event is a domain event, EventName identifies its type for subscriptions, e.g. "user.created"
+type Event interface {
+	EventName() string
+}
+
+// Handler consumes an event, errors and panics are logged and never reach the publisher
+type Handler func(ctx context.Context, event Event) error
+
+// Config holds the options of the bus
+type Config struct {
+	// QueueSize is the number of pending events buffered per subscriber.
+	// When a subscriber falls behind and its queue is full, new events for it are dropped.
+	QueueSize int
+}
+
+// Bus delivers published events to subscribers.
+// Every asynchronous subscriber has its own queue and goroutine, so a slow or failing
+// subscriber neither blocks publishers nor delays other subscribers.
+type Bus struct {
+	queueSize int
+
+	mu          sync.RWMutex
+	closed      bool
+	subscribers []*subscriber
+	wg          sync.WaitGroup
+
+	// ctx is cancelled when Close gives up waiting, handlers should stop early
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+type subscriber struct {
+	name    string
+	events  map[string]bool
+	handler Handler
+	// queue is nil for synchronous subscribers
+	queue chan Event
+}
+
+// accepts reports whether the subscriber listens to the event, no event names means all events
+func (s *subscriber) accepts(name string) bool {
+	return len(s.events) == 0 || s.events[name]
+}
+
+// New creates an event bus
+func New(config Config) *Bus {
+	queueSize := config.QueueSize
+	if queueSize < 1 {
+		queueSize = DefaultQueueSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Bus{
+		queueSize: queueSize,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// Subscribe registers a handler for the given event names, none means every event.
+// name identifies the subscriber in logs.
+func (b *Bus) Subscribe(name string, handler Handler, eventNames ...string) error {
+	return b.subscribe(name, handler, false, eventNames)
+}
+
+// SubscribeSync registers a handler that runs in the publisher's goroutine before Publish returns,
+// so its effect is visible to the publisher right away, e.g. dropping a cache entry.
+// The handler must be fast and must not block; it must not publish or subscribe either.
+func (b *Bus) SubscribeSync(name string, handler Handler, eventNames ...string) error {
+	return b.subscribe(name, handler, true, eventNames)
+}
+
+// subscribe registers a subscriber, asynchronous subscribers get their own queue and goroutine
+func (b *Bus) subscribe(name string, handler Handler, sync bool, eventNames []string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrClosed
+	}
+
+	events := make(map[string]bool, len(eventNames))
+	for _, eventName := range eventNames {
+		events[eventName] = true
+	}
+
+	sub := &subscriber{
+		name:    name,
+		events:  events,
+		handler: handler,
+	}
+	b.subscribers = append(b.subscribers, sub)
+
+	if sync {
+		return nil
+	}
+
+	sub.queue = make(chan Event, b.queueSize)
+	b.wg.Add(1)
+	go b.run(sub)
+
+	return nil
+}
+
+// Publish runs the synchronous subscribers of the event, then queues it for every matching
+// asynchronous subscriber without blocking. Events published after Close are dropped.
+func (b *Bus) Publish(event Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		logger.Warn("Event bus closed, dropping event", zap.String("event", event.EventName()))
+		return
+	}
+
+	for _, sub := range b.subscribers {
+		if !sub.accepts(event.EventName()) {
+			continue
+		}
+
+		if sub.queue == nil {
+			b.report(sub, event, b.handle(sub, event))
+			continue
+		}
+
+		select {
+		case sub.queue <- event:
+		default:
+			logger.Warn("Event subscriber queue full, dropping event",
+				zap.String("subscriber", sub.name),
+				zap.String("event", event.EventName()))
+		}
+	}
+}
+
+// Close stops accepting events and waits until subscribers have handled the queued ones.
+// When ctx ends first, the context passed to handlers is cancelled and Close returns ctx.Err()
+// once the running handlers return.
+func (b *Bus) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	for _, sub := range b.subscribers {
+		if sub.queue != nil {
+			close(sub.queue)
+		}
+	}
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		b.cancel()
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// run handles the subscriber's events in order until its queue is closed
+func (b *Bus) run(sub *subscriber) {
+	defer b.wg.Done()
+
+	for event := range sub.queue {
+		if b.ctx.Err() != nil {
+			continue
+		}
+		b.report(sub, event, b.handle(sub, event))
+	}
+}
+
+// report logs a failed handler
+func (b *Bus) report(sub *subscriber, event Event, err error) {
+	if err != nil {
+		logger.Error("Event subscriber failed",
+			zap.String("subscriber", sub.name),
+			zap.String("event", event.EventName()),
+			zap.Error(err))
+	}
+}
+
+// handle calls the handler, converting a panic into an error
+func (b *Bus) handle(sub *subscriber, event Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return sub.handler(b.ctx, event)
+}
@@ -0,0 +1,149 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"nebula-live/internal/pkg/eventbus"
+	"nebula-live/internal/testutil"
+)
+
+// testEvent is an event whose name is chosen by the test
+type testEvent struct {
+	name string
+	id   int
+}
+
+func (e testEvent) EventName() string {
+	return e.name
+}
+
+// recorder collects the ids of the events a subscriber handled
+type recorder struct {
+	mu  sync.Mutex
+	ids []int
+}
+
+func (r *recorder) handler(ctx context.Context, event eventbus.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, event.(testEvent).id)
+	return nil
+}
+
+func (r *recorder) received() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.ids)
+}
+
+func TestBus_PublishReachesSubscribers(t *testing.T) {
+	testutil.InitLogger()
+	bus := eventbus.New(eventbus.Config{})
+
+	var created, all recorder
+	if err := bus.Subscribe("created", created.handler, "user.created"); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	if err := bus.Subscribe("all", all.handler); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	bus.Publish(testEvent{name: "user.created", id: 1})
+	bus.Publish(testEvent{name: "room.online", id: 2})
+	bus.Publish(testEvent{name: "user.created", id: 3})
+
+	// Close waits until the queued events are handled
+	if err := bus.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if got := created.received(); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("user.created subscriber received %v, want [1 3]", got)
+	}
+	if got := all.received(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("catch-all subscriber received %v, want [1 2 3] in order", got)
+	}
+
+	if err := bus.Subscribe("late", all.handler); !errors.Is(err, eventbus.ErrClosed) {
+		t.Errorf("Subscribe() after Close error = %v, want ErrClosed", err)
+	}
+	bus.Publish(testEvent{name: "user.created", id: 4})
+	if got := created.received(); len(got) != 2 {
+		t.Errorf("event published after Close was delivered: %v", got)
+	}
+}
+
+func TestBus_SlowOrFailingSubscriberDoesNotBlock(t *testing.T) {
+	testutil.InitLogger()
+	bus := eventbus.New(eventbus.Config{QueueSize: 1})
+
+	release := make(chan struct{})
+	if err := bus.Subscribe("slow", func(ctx context.Context, event eventbus.Event) error {
+		<-release
+		return nil
+	}); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	if err := bus.Subscribe("failing", func(ctx context.Context, event eventbus.Event) error {
+		return errors.New("boom")
+	}); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	if err := bus.Subscribe("panicking", func(ctx context.Context, event eventbus.Event) error {
+		panic("boom")
+	}); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	var fast recorder
+	if err := bus.Subscribe("fast", fast.handler); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	// The slow subscriber's queue overflows, so its extra events are dropped instead of blocking Publish
+	published := make(chan struct{})
+	go func() {
+		for id := 1; id <= 3; id++ {
+			bus.Publish(testEvent{name: "push.sent", id: id})
+			time.Sleep(10 * time.Millisecond)
+		}
+		close(published)
+	}()
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Publish blocked on a slow subscriber")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(fast.received()) < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := fast.received(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("fast subscriber received %v while another one was stuck, want [1 2 3]", got)
+	}
+
+	close(release)
+	if err := bus.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+}
+
+func TestBus_SyncSubscriberRunsBeforePublishReturns(t *testing.T) {
+	testutil.InitLogger()
+	bus := eventbus.New(eventbus.Config{})
+	t.Cleanup(func() { bus.Close(context.Background()) })
+
+	var cache recorder
+	if err := bus.SubscribeSync("cache", cache.handler, "role.updated"); err != nil {
+		t.Fatalf("SubscribeSync() error = %v", err)
+	}
+	bus.Publish(testEvent{name: "role.updated", id: 1})
+	bus.Publish(testEvent{name: "user.created", id: 2})
+	if got := cache.received(); !slices.Equal(got, []int{1}) {
+		t.Errorf("sync subscriber received %v right after Publish, want [1]", got)
+	}
+}
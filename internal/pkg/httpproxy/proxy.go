@@ -0,0 +1,87 @@
+// Package httpproxy routes outbound HTTP clients through an upstream proxy
+package httpproxy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+	"resty.dev/v3"
+)
+
+// ErrInvalidProxyURL is returned when the proxy URL cannot be used by http.Transport
+var ErrInvalidProxyURL = errors.New("invalid proxy url")
+
+// Config holds the upstream proxy for outbound requests
+type Config struct {
+	// URL is the proxy server, e.g. http://proxy.internal:3128 or socks5://127.0.0.1:1080; empty disables the proxy
+	URL string `mapstructure:"url"`
+	// NoProxy lists destinations that bypass the proxy: host names, domain suffixes (.example.com), IPs, CIDRs or "*"
+	NoProxy []string `mapstructure:"no_proxy"`
+}
+
+// Enabled reports whether a proxy is configured
+func (c Config) Enabled() bool {
+	return c.URL != ""
+}
+
+// Validate checks that the proxy URL is absolute and uses a scheme supported by http.Transport
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProxyURL, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("%w: unsupported scheme %q", ErrInvalidProxyURL, u.Scheme)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("%w: missing host", ErrInvalidProxyURL)
+	}
+
+	return nil
+}
+
+// ProxyFunc returns the proxy selector for http.Transport.
+// Requests to NoProxy destinations and to localhost are sent directly.
+func (c Config) ProxyFunc() func(*http.Request) (*url.URL, error) {
+	proxyFor := (&httpproxy.Config{
+		HTTPProxy:  c.URL,
+		HTTPSProxy: c.URL,
+		NoProxy:    strings.Join(c.NoProxy, ","),
+	}).ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFor(req.URL)
+	}
+}
+
+// Apply configures the resty client to send requests through the proxy,
+// a Config without URL leaves the client unchanged
+func Apply(client *resty.Client, config Config) error {
+	if !config.Enabled() {
+		return nil
+	}
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	transport, err := client.HTTPTransport()
+	if err != nil {
+		return err
+	}
+
+	transport.Proxy = config.ProxyFunc()
+	return nil
+}
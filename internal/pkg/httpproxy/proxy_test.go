@@ -0,0 +1,106 @@
+package httpproxy_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"nebula-live/internal/pkg/httpproxy"
+
+	"resty.dev/v3"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"", false},
+		{"http://proxy.internal:3128", false},
+		{"socks5://127.0.0.1:1080", false},
+		{"ftp://proxy.internal", true},
+		{"http://", true},
+		{"proxy.internal:3128", true},
+	}
+	for _, tt := range tests {
+		err := httpproxy.Config{URL: tt.url}.Validate()
+		if tt.wantErr != (err != nil) || (err != nil && !errors.Is(err, httpproxy.ErrInvalidProxyURL)) {
+			t.Errorf("Validate(%q) error = %v, want error %v", tt.url, err, tt.wantErr)
+		}
+	}
+}
+
+func TestConfig_ProxyFuncNoProxy(t *testing.T) {
+	config := httpproxy.Config{
+		URL:     "http://proxy.internal:3128",
+		NoProxy: []string{".internal.example.com", "10.0.0.0/8", "bark.local"},
+	}
+	proxyFor := config.ProxyFunc()
+
+	tests := []struct {
+		target string
+		direct bool
+	}{
+		{"https://api.day.app/key", false},
+		{"https://api.live.bilibili.com/room", false},
+		{"https://push.internal.example.com/key", true},
+		{"http://10.1.2.3/key", true},
+		{"http://bark.local/key", true},
+		{"http://localhost:8080/key", true},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodGet, tt.target, nil)
+		proxy, err := proxyFor(req)
+		if err != nil {
+			t.Fatalf("proxy for %s error = %v", tt.target, err)
+		}
+		if direct := proxy == nil; direct != tt.direct {
+			t.Errorf("proxy for %s = %v, want direct %v", tt.target, proxy, tt.direct)
+		}
+		if proxy != nil && proxy.Host != "proxy.internal:3128" {
+			t.Errorf("proxy for %s = %v, want proxy.internal:3128", tt.target, proxy)
+		}
+	}
+}
+
+func TestApply_RequestsGoThroughProxy(t *testing.T) {
+	// the proxy receives the absolute URL of the target
+	var requested string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(proxy.Close)
+
+	client := resty.New()
+	t.Cleanup(func() { client.Close() })
+	if err := httpproxy.Apply(client, httpproxy.Config{URL: proxy.URL}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	transport, err := client.HTTPTransport()
+	if err != nil {
+		t.Fatalf("HTTPTransport() error = %v", err)
+	}
+	if transport.Proxy == nil {
+		t.Fatal("transport.Proxy is nil after Apply()")
+	}
+
+	resp, err := client.R().Get("http://bark.example.com/device-key")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK || requested != "http://bark.example.com/device-key" {
+		t.Errorf("status = %d, proxy received %q; want the request forwarded through the proxy", resp.StatusCode(), requested)
+	}
+
+	// an empty or invalid config leaves the client unchanged
+	direct := resty.New()
+	t.Cleanup(func() { direct.Close() })
+	if err := httpproxy.Apply(direct, httpproxy.Config{}); err != nil {
+		t.Fatalf("Apply(empty) error = %v", err)
+	}
+	if err := httpproxy.Apply(direct, httpproxy.Config{URL: "ftp://proxy.internal"}); !errors.Is(err, httpproxy.ErrInvalidProxyURL) {
+		t.Errorf("Apply(ftp) error = %v, want ErrInvalidProxyURL", err)
+	}
+}
@@ -0,0 +1,31 @@
+package livestream
+
+import "strings"
+
+// platformAliases maps common abbreviations and localized names to canonical platform names,
+// keys are lower case since lookups are case-insensitive
+var platformAliases = map[string]string{
+	"bili":     "bilibili",
+	"blive":    "bilibili",
+	"b站":       "bilibili",
+	"哔哩哔哩":     "bilibili",
+	"哔哩哔哩直播":   "bilibili",
+	"douyutv":  "douyu",
+	"斗鱼":       "douyu",
+	"斗鱼直播":     "douyu",
+	"ks":       "kuaishou",
+	"快手":       "kuaishou",
+	"快手直播":     "kuaishou",
+	"twitchtv": "twitch",
+}
+
+// ResolvePlatform returns the canonical name of a platform given by its name or an alias,
+// ignoring case and surrounding whitespace. Unknown names are returned normalized,
+// so the provider lookup reports them as ErrPlatformNotFound.
+func ResolvePlatform(name string) string {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if canonical, ok := platformAliases[normalized]; ok {
+		return canonical
+	}
+	return normalized
+}
@@ -0,0 +1,70 @@
+package livestream_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"nebula-live/internal/pkg/livestream"
+	"nebula-live/internal/testutil"
+)
+
+func TestResolvePlatform(t *testing.T) {
+	tests := map[string]string{
+		"bilibili":  "bilibili",
+		"bili":      "bilibili",
+		"B站":        "bilibili",
+		" BiliBili": "bilibili",
+		"哔哩哔哩":      "bilibili",
+		"斗鱼":        "douyu",
+		"DouyuTV":   "douyu",
+		"快手":        "kuaishou",
+		"unknown":   "unknown",
+	}
+	for name, want := range tests {
+		if got := livestream.ResolvePlatform(name); got != want {
+			t.Errorf("ResolvePlatform(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestClient_AliasesResolveToSameProvider(t *testing.T) {
+	ctx := context.Background()
+
+	provider := testutil.NewFakeLiveStreamProvider("bilibili")
+	provider.SetRoom(&livestream.RoomInfo{RoomID: "5440", Status: livestream.StreamStatusOnline})
+
+	client := livestream.NewClient(livestream.ClientConfig{})
+	client.RegisterProvider(provider)
+
+	aliases := []string{"bilibili", "bili", "b站", "哔哩哔哩"}
+	for _, alias := range aliases {
+		status, err := client.GetStreamStatus(ctx, alias, "5440")
+		if err != nil {
+			t.Fatalf("GetStreamStatus(%q) error = %v", alias, err)
+		}
+		if status.Platform != "bilibili" {
+			t.Errorf("GetStreamStatus(%q) platform = %q, want bilibili", alias, status.Platform)
+		}
+	}
+	if got := provider.StatusCalls(); got != len(aliases) {
+		t.Errorf("StatusCalls() = %d, want %d", got, len(aliases))
+	}
+
+	for _, name := range []string{"youtube", "b站站", ""} {
+		if _, err := client.GetStreamStatus(ctx, name, "5440"); !errors.Is(err, livestream.ErrPlatformNotFound) {
+			t.Errorf("GetStreamStatus(%q) error = %v, want ErrPlatformNotFound", name, err)
+		}
+	}
+
+	// 支持的平台列表只返回规范名称
+	for _, platform := range client.GetSupportedPlatforms() {
+		if livestream.ResolvePlatform(platform) != platform {
+			t.Errorf("supported platform %q is not canonical", platform)
+		}
+	}
+	if !slices.Contains(client.GetSupportedPlatforms(), "bilibili") {
+		t.Errorf("GetSupportedPlatforms() = %v, want bilibili included", client.GetSupportedPlatforms())
+	}
+}
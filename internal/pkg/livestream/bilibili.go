@@ -1,25 +1,34 @@
 package livestream
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
 	"resty.dev/v3"
 )
 
+const bilibiliAPIURL = "https://api.live.bilibili.com"
+
 // Bilibili provider implementation
 type bilibiliProvider struct {
 	client *resty.Client
+	apiURL string
 }
 
 type bilibiliResponse struct {
-	Code    int    `json:"code"`
-	Msg     string `json:"msg"`
-	Message string `json:"message"`
-	TTL     int    `json:"ttl"`
-	Data    any    `json:"data"` // Can be struct or empty array
+	Code    int             `json:"code"`
+	Msg     string          `json:"msg"`
+	Message string          `json:"message"`
+	TTL     int             `json:"ttl"`
+	Data    json.RawMessage `json:"data"` // Can be struct or empty array
 }
 
 type bilibiliRoomData struct {
@@ -52,6 +61,7 @@ type bilibiliRoomData struct {
 func NewBilibiliProvider(client *resty.Client) Provider {
 	return &bilibiliProvider{
 		client: client,
+		apiURL: bilibiliAPIURL,
 	}
 }
 
@@ -64,65 +74,222 @@ func (b *bilibiliProvider) GetStreamStatus(ctx context.Context, roomID string) (
 		return nil, ErrInvalidRoomID
 	}
 
-	url := "https://api.live.bilibili.com/room/v1/Room/get_info"
-
-	var bilibiliResp bilibiliResponse
-	resp, err := b.client.R().
-		SetContext(ctx).
-		SetResult(&bilibiliResp).
-		SetQueryParam("room_id", roomID).
-		SetHeader("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36").
-		Get(url)
+	streamInfo := &StreamInfo{
+		Platform: b.GetPlatformName(),
+		RoomID:   roomID,
+		Status:   StreamStatusOffline,
+	}
 
+	roomData, err := b.getRoomData(ctx, roomID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch bilibili stream status: %w", err)
+		return nil, err
 	}
 
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("bilibili API returned status code: %d", resp.StatusCode())
+	// live_status: 0=not streaming, 1=streaming, 2=rebroadcast
+	if roomData != nil && roomData.LiveStatus == 1 {
+		streamInfo.Status = StreamStatusOnline
 	}
 
-	// Check API response code
-	if bilibiliResp.Code != 0 {
-		// Code 1 means room not found
-		if bilibiliResp.Code == 1 {
-			return nil, ErrRoomNotFound
-		}
-		return nil, fmt.Errorf("bilibili API error: %s (code: %d)", bilibiliResp.Message, bilibiliResp.Code)
+	return streamInfo, nil
+}
+
+func (b *bilibiliProvider) GetRoomInfo(ctx context.Context, roomID string) (*RoomInfo, error) {
+	if roomID == "" {
+		return nil, ErrInvalidRoomID
 	}
 
-	streamInfo := &StreamInfo{
+	roomInfo := &RoomInfo{
 		Platform: b.GetPlatformName(),
 		RoomID:   roomID,
 		Status:   StreamStatusOffline,
 	}
 
-	// Parse the data field - it can be a struct or empty array
-	dataBytes, err := json.Marshal(bilibiliResp.Data)
+	roomData, err := b.getRoomData(ctx, roomID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal bilibili data: %w", err)
+		return nil, err
 	}
 
-	// Try to unmarshal as room data struct
-	var roomData bilibiliRoomData
-	if err := json.Unmarshal(dataBytes, &roomData); err == nil {
-		// Successfully parsed as room data
+	if roomData != nil {
+		roomInfo.Title = roomData.Title
+		roomInfo.Description = roomData.Description
+		roomInfo.Cover = roomData.UserCover
+		roomInfo.Keyframe = roomData.Keyframe
+		roomInfo.OwnerID = strconv.Itoa(roomData.UID)
+		roomInfo.ViewerCount = int64(roomData.Online)
+		roomInfo.Category = roomData.AreaName
+		roomInfo.LiveStartTime = parseBilibiliLiveTime(roomData.LiveTime)
+
 		// live_status: 0=not streaming, 1=streaming, 2=rebroadcast
 		if roomData.LiveStatus == 1 {
-			streamInfo.Status = StreamStatusOnline
+			roomInfo.Status = StreamStatusOnline
+		}
+
+		// Get owner information from user API
+		ownerInfo, err := b.getOwnerInfo(ctx, roomData.UID)
+		if err == nil {
+			roomInfo.OwnerName = ownerInfo.Name
+			roomInfo.OwnerAvatar = ownerInfo.Avatar
 		}
 	}
-	// If unmarshal fails, it's likely an empty array, so room is offline (default)
 
-	return streamInfo, nil
+	return roomInfo, nil
 }
 
-func (b *bilibiliProvider) GetRoomInfo(ctx context.Context, roomID string) (*RoomInfo, error) {
+// bilibiliPlayURLResponse represents the Bilibili play URL API response
+type bilibiliPlayURLResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    struct {
+		CurrentQn          int `json:"current_qn"`
+		QualityDescription []struct {
+			Qn   int    `json:"qn"`
+			Desc string `json:"desc"`
+		} `json:"quality_description"`
+		Durl []struct {
+			URL   string `json:"url"`
+			Order int    `json:"order"`
+		} `json:"durl"`
+	} `json:"data"`
+}
+
+// bilibiliBestQuality is the qn code of the original quality, requested when no quality is given
+const bilibiliBestQuality = "10000"
+
+// GetStreamURLs returns the FLV stream URLs of a Bilibili room, empty when the room is offline.
+// quality is a Bilibili qn code such as 10000 (original), 400, 250, 150 or 80;
+// the API falls back to the closest available quality.
+func (b *bilibiliProvider) GetStreamURLs(ctx context.Context, roomID, quality string) ([]StreamURL, error) {
 	if roomID == "" {
 		return nil, ErrInvalidRoomID
 	}
 
-	url := "https://api.live.bilibili.com/room/v1/Room/get_info"
+	qn := bilibiliBestQuality
+	if quality != "" {
+		if n, err := strconv.Atoi(quality); err != nil || n <= 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidQuality, quality)
+		}
+		qn = quality
+	}
+
+	// Resolve the real room ID, the play URL API does not accept short IDs
+	roomData, err := b.getRoomData(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if roomData == nil || roomData.LiveStatus != 1 {
+		return []StreamURL{}, nil
+	}
+
+	url := b.apiURL + "/room/v1/Room/playUrl"
+
+	var playResp bilibiliPlayURLResponse
+	resp, err := b.client.R().
+		SetContext(ctx).
+		SetResult(&playResp).
+		SetQueryParams(map[string]string{
+			"cid":      strconv.Itoa(roomData.RoomID),
+			"platform": "web",
+			"qn":       qn,
+		}).
+		SetHeader("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36").
+		Get(url)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch bilibili play url: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("bilibili API returned status code: %d", resp.StatusCode())
+	}
+
+	if playResp.Code != 0 {
+		return nil, fmt.Errorf("bilibili API error: %s (code: %d)", playResp.Message, playResp.Code)
+	}
+
+	currentQuality := strconv.Itoa(playResp.Data.CurrentQn)
+	for _, description := range playResp.Data.QualityDescription {
+		if description.Qn == playResp.Data.CurrentQn {
+			currentQuality = description.Desc
+			break
+		}
+	}
+
+	streams := make([]StreamURL, 0, len(playResp.Data.Durl))
+	for _, durl := range playResp.Data.Durl {
+		streams = append(streams, StreamURL{
+			Quality: currentQuality,
+			Format:  "flv",
+			URL:     durl.URL,
+		})
+	}
+
+	return streams, nil
+}
+
+// bilibiliRoomInitData is the data of the room_init API response, which is an empty array on errors
+type bilibiliRoomInitData struct {
+	RoomID  int `json:"room_id"`
+	ShortID int `json:"short_id"`
+	UID     int `json:"uid"`
+}
+
+// bilibiliRoomNotExist is the room_init error code of a nonexistent room
+const bilibiliRoomNotExist = 60004
+
+// ResolveRoomID resolves a short room ID (e.g. 1) to the real room ID (e.g. 5440) with the room_init API,
+// a real room ID resolves to itself
+func (b *bilibiliProvider) ResolveRoomID(ctx context.Context, roomID string) (*ResolvedRoom, error) {
+	if _, err := strconv.ParseUint(roomID, 10, 64); err != nil {
+		return nil, ErrInvalidRoomID
+	}
+
+	url := b.apiURL + "/room/v1/Room/room_init"
+
+	var initResp bilibiliResponse
+	resp, err := b.client.R().
+		SetContext(ctx).
+		SetResult(&initResp).
+		SetQueryParam("id", roomID).
+		SetHeader("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36").
+		Get(url)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch bilibili room init: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("bilibili API returned status code: %d", resp.StatusCode())
+	}
+
+	if initResp.Code != 0 {
+		if initResp.Code == bilibiliRoomNotExist {
+			return nil, ErrRoomNotFound
+		}
+		return nil, fmt.Errorf("bilibili API error: %s (code: %d)", initResp.Message, initResp.Code)
+	}
+
+	var data bilibiliRoomInitData
+	if err := json.Unmarshal(initResp.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse bilibili room init data: %w", err)
+	}
+
+	resolved := &ResolvedRoom{
+		Platform: b.GetPlatformName(),
+		RoomID:   strconv.Itoa(data.RoomID),
+		OwnerID:  strconv.Itoa(data.UID),
+	}
+	if data.ShortID != 0 {
+		resolved.ShortID = strconv.Itoa(data.ShortID)
+	}
+
+	return resolved, nil
+}
+
+// getRoomData fetches the room data used by both GetStreamStatus and GetRoomInfo,
+// so the two share one request, error mapping and data parsing path.
+// A nil result with no error means the room exists but returned no data (closed).
+func (b *bilibiliProvider) getRoomData(ctx context.Context, roomID string) (*bilibiliRoomData, error) {
+	url := b.apiURL + "/room/v1/Room/get_info"
 
 	var bilibiliResp bilibiliResponse
 	resp, err := b.client.R().
@@ -133,7 +300,7 @@ func (b *bilibiliProvider) GetRoomInfo(ctx context.Context, roomID string) (*Roo
 		Get(url)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch bilibili room info: %w", err)
+		return nil, fmt.Errorf("failed to fetch bilibili room data: %w", err)
 	}
 
 	if resp.StatusCode() != 200 {
@@ -149,44 +316,52 @@ func (b *bilibiliProvider) GetRoomInfo(ctx context.Context, roomID string) (*Roo
 		return nil, fmt.Errorf("bilibili API error: %s (code: %d)", bilibiliResp.Message, bilibiliResp.Code)
 	}
 
-	roomInfo := &RoomInfo{
-		Platform: b.GetPlatformName(),
-		RoomID:   roomID,
-		Status:   StreamStatusOffline,
+	return parseBilibiliRoomData(bilibiliResp.Data, roomID)
+}
+
+// bilibiliTimeZone is the time zone of Bilibili's live_time (China Standard Time)
+var bilibiliTimeZone = time.FixedZone("CST", 8*60*60)
+
+// parseBilibiliLiveTime parses Bilibili's live_time ("2006-01-02 15:04:05" in CST),
+// which is "0000-00-00 00:00:00" when the room is offline
+func parseBilibiliLiveTime(liveTime string) time.Time {
+	if liveTime == "" || strings.HasPrefix(liveTime, "0000-00-00") {
+		return time.Time{}
 	}
 
-	// Parse the data field - it can be a struct or empty array
-	dataBytes, err := json.Marshal(bilibiliResp.Data)
+	startTime, err := time.ParseInLocation(time.DateTime, liveTime, bilibiliTimeZone)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal bilibili data: %w", err)
+		return time.Time{}
 	}
+	return startTime.UTC()
+}
 
-	// Try to unmarshal as room data struct
-	var roomData bilibiliRoomData
-	if err := json.Unmarshal(dataBytes, &roomData); err == nil {
-		// Successfully parsed as room data
-		roomInfo.Title = roomData.Title
-		roomInfo.Description = roomData.Description
-		roomInfo.Cover = roomData.UserCover
-		roomInfo.Keyframe = roomData.Keyframe
-		roomInfo.OwnerID = strconv.Itoa(roomData.UID)
-		roomInfo.ViewerCount = int64(roomData.Online)
-		roomInfo.Category = roomData.AreaName
+// parseBilibiliRoomData parses the polymorphic data field of the room API.
+// An empty array or null means the room is closed and yields (nil, nil);
+// any other payload that fails to decode is reported so API schema changes surface.
+func parseBilibiliRoomData(data json.RawMessage, roomID string) (*bilibiliRoomData, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, nil
+	}
 
-		// live_status: 0=not streaming, 1=streaming, 2=rebroadcast
-		if roomData.LiveStatus == 1 {
-			roomInfo.Status = StreamStatusOnline
+	if trimmed[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(trimmed, &items); err == nil && len(items) == 0 {
+			return nil, nil
 		}
+	}
 
-		// Get owner information from user API
-		ownerInfo, err := b.getOwnerInfo(ctx, roomData.UID)
-		if err == nil {
-			roomInfo.OwnerName = ownerInfo.Name
-			roomInfo.OwnerAvatar = ownerInfo.Avatar
-		}
+	var roomData bilibiliRoomData
+	if err := json.Unmarshal(trimmed, &roomData); err != nil {
+		logger.Error("Failed to parse bilibili room data",
+			zap.String("room_id", roomID),
+			zap.ByteString("data", trimmed),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to parse bilibili room data: %w", err)
 	}
 
-	return roomInfo, nil
+	return &roomData, nil
 }
 
 type bilibiliMasterResponse struct {
@@ -230,7 +405,7 @@ func (b *bilibiliProvider) getOwnerInfo(ctx context.Context, uid int) (*struct {
 	Name   string
 	Avatar string
 }, error) {
-	url := "https://api.live.bilibili.com/live_user/v1/Master/info"
+	url := b.apiURL + "/live_user/v1/Master/info"
 	
 	var masterResp bilibiliMasterResponse
 	resp, err := b.client.R().
@@ -0,0 +1,280 @@
+package livestream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+	"resty.dev/v3"
+)
+
+// initTestLogger 为解析失败时的日志提供全局logger
+func initTestLogger() {
+	if logger.Logger == nil {
+		logger.Logger = zap.NewNop()
+	}
+}
+
+// newBilibiliTestProvider 创建请求假服务器的B站提供商，routes按请求路径和查询参数返回响应体
+func newBilibiliTestProvider(t *testing.T, routes map[string]func(query url.Values) (int, string)) *bilibiliProvider {
+	t.Helper()
+	initTestLogger()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		status, body := route(r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := resty.New()
+	t.Cleanup(func() { client.Close() })
+	provider := NewBilibiliProvider(client).(*bilibiliProvider)
+	provider.apiURL = server.URL
+	return provider
+}
+
+func TestBilibiliProvider_ResolveRoomID(t *testing.T) {
+	ctx := context.Background()
+	provider := newBilibiliTestProvider(t, map[string]func(url.Values) (int, string){
+		"/room/v1/Room/room_init": func(query url.Values) (int, string) {
+			switch query.Get("id") {
+			case "1", "5440":
+				return http.StatusOK, `{"code":0,"msg":"ok","message":"ok","data":{"room_id":5440,"short_id":1,"uid":9617619}}`
+			case "21452505":
+				return http.StatusOK, `{"code":0,"msg":"ok","message":"ok","data":{"room_id":21452505,"short_id":0,"uid":12345}}`
+			case "500":
+				return http.StatusInternalServerError, ``
+			default:
+				return http.StatusOK, `{"code":60004,"msg":"直播间不存在","message":"直播间不存在","data":[]}`
+			}
+		},
+	})
+
+	tests := []struct {
+		roomID      string
+		wantRoomID  string
+		wantShortID string
+	}{
+		{"1", "5440", "1"},
+		{"5440", "5440", "1"},
+		{"21452505", "21452505", ""}, // 没有短号的直播间解析为自身
+	}
+	for _, tt := range tests {
+		resolved, err := provider.ResolveRoomID(ctx, tt.roomID)
+		if err != nil {
+			t.Errorf("ResolveRoomID(%s) error = %v", tt.roomID, err)
+			continue
+		}
+		if resolved.RoomID != tt.wantRoomID || resolved.ShortID != tt.wantShortID || resolved.Platform != "bilibili" {
+			t.Errorf("ResolveRoomID(%s) = %+v, want room %s short %q", tt.roomID, resolved, tt.wantRoomID, tt.wantShortID)
+		}
+	}
+
+	if _, err := provider.ResolveRoomID(ctx, "999999999"); !errors.Is(err, ErrRoomNotFound) {
+		t.Errorf("ResolveRoomID(nonexistent) error = %v, want ErrRoomNotFound", err)
+	}
+	if _, err := provider.ResolveRoomID(ctx, "abc"); !errors.Is(err, ErrInvalidRoomID) {
+		t.Errorf("ResolveRoomID(abc) error = %v, want ErrInvalidRoomID", err)
+	}
+	if _, err := provider.ResolveRoomID(ctx, "500"); err == nil {
+		t.Error("ResolveRoomID() with a failing API succeeded, want error")
+	}
+}
+
+func TestParseBilibiliRoomData(t *testing.T) {
+	initTestLogger()
+	tests := []struct {
+		name     string
+		data     string
+		wantRoom bool
+		wantErr  bool
+	}{
+		{"object", `{"room_id":5440,"live_status":1,"title":"直播中"}`, true, false},
+		{"empty array", `[]`, false, false},
+		{"empty array with spaces", ` [ ] `, false, false},
+		{"null", `null`, false, false},
+		{"missing", ``, false, false},
+		{"non-empty array", `[{"room_id":5440}]`, false, true},
+		{"wrong field type", `{"room_id":"5440"}`, false, true},
+		{"string", `"closed"`, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roomData, err := parseBilibiliRoomData(json.RawMessage(tt.data), "5440")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseBilibiliRoomData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (roomData != nil) != tt.wantRoom {
+				t.Errorf("parseBilibiliRoomData() = %+v, want room %v", roomData, tt.wantRoom)
+			}
+		})
+	}
+}
+
+func TestBilibiliProvider_RoomDataShapes(t *testing.T) {
+	ctx := context.Background()
+	provider := newBilibiliTestProvider(t, map[string]func(url.Values) (int, string){
+		"/room/v1/Room/get_info": func(query url.Values) (int, string) {
+			switch query.Get("room_id") {
+			case "5440":
+				return http.StatusOK, `{"code":0,"message":"ok","data":{"uid":9617619,"room_id":5440,"live_status":1,"title":"直播中"}}`
+			case "100":
+				return http.StatusOK, `{"code":0,"message":"ok","data":[]}`
+			case "200":
+				return http.StatusOK, `{"code":0,"message":"ok","data":{"room_id":"200","live_status":"1"}}`
+			default:
+				return http.StatusOK, `{"code":1,"message":"未找到该房间","data":[]}`
+			}
+		},
+	})
+
+	status, err := provider.GetStreamStatus(ctx, "5440")
+	if err != nil || status.Status != StreamStatusOnline {
+		t.Errorf("GetStreamStatus(object) = %+v, %v; want online", status, err)
+	}
+
+	// 空数组表示直播间已关闭，按未开播处理
+	status, err = provider.GetStreamStatus(ctx, "100")
+	if err != nil || status.Status != StreamStatusOffline {
+		t.Errorf("GetStreamStatus(empty array) = %+v, %v; want offline", status, err)
+	}
+	roomInfo, err := provider.GetRoomInfo(ctx, "100")
+	if err != nil || roomInfo.Status != StreamStatusOffline || roomInfo.Title != "" {
+		t.Errorf("GetRoomInfo(empty array) = %+v, %v; want an empty offline room", roomInfo, err)
+	}
+
+	// 结构变化导致的解析失败返回错误，不能当作未开播
+	if _, err := provider.GetStreamStatus(ctx, "200"); err == nil {
+		t.Error("GetStreamStatus(malformed object) succeeded, want error")
+	}
+	if _, err := provider.GetRoomInfo(ctx, "200"); err == nil {
+		t.Error("GetRoomInfo(malformed object) succeeded, want error")
+	}
+
+	if _, err := provider.GetStreamStatus(ctx, "300"); !errors.Is(err, ErrRoomNotFound) {
+		t.Errorf("GetStreamStatus(code 1) error = %v, want ErrRoomNotFound", err)
+	}
+}
+
+// 状态、房间信息和流地址都经同一个get_info请求获取房间数据，流地址使用其中的真实房间号
+func TestBilibiliProvider_SharedRoomDataPath(t *testing.T) {
+	ctx := context.Background()
+	var mu sync.Mutex
+	requests := map[string][]string{}
+	record := func(path, id string) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests[path] = append(requests[path], id)
+	}
+
+	provider := newBilibiliTestProvider(t, map[string]func(url.Values) (int, string){
+		"/room/v1/Room/get_info": func(query url.Values) (int, string) {
+			record("get_info", query.Get("room_id"))
+			return http.StatusOK, `{"code":0,"message":"ok","data":{"uid":9617619,"room_id":5440,"short_id":1,"live_status":1,"title":"直播中"}}`
+		},
+		"/room/v1/Room/playUrl": func(query url.Values) (int, string) {
+			record("playUrl", query.Get("cid"))
+			return http.StatusOK, `{"code":0,"message":"0","data":{"current_qn":10000,"quality_description":[{"qn":10000,"desc":"原画"}],"durl":[{"url":"https://example.com/live.flv","order":1}]}}`
+		},
+		"/live_user/v1/Master/info": func(query url.Values) (int, string) {
+			record("master", query.Get("uid"))
+			return http.StatusOK, `{"code":0,"data":{"info":{"uid":9617619,"uname":"主播","face":"https://example.com/face.jpg"}}}`
+		},
+	})
+
+	if status, err := provider.GetStreamStatus(ctx, "1"); err != nil || status.Status != StreamStatusOnline {
+		t.Fatalf("GetStreamStatus() = %+v, %v; want online", status, err)
+	}
+	roomInfo, err := provider.GetRoomInfo(ctx, "1")
+	if err != nil {
+		t.Fatalf("GetRoomInfo() error = %v", err)
+	}
+	if roomInfo.Title != "直播中" || roomInfo.OwnerName != "主播" {
+		t.Errorf("GetRoomInfo() = %+v, want title and owner from the fake API", roomInfo)
+	}
+	streams, err := provider.GetStreamURLs(ctx, "1", "")
+	if err != nil {
+		t.Fatalf("GetStreamURLs() error = %v", err)
+	}
+	if len(streams) != 1 || streams[0].Quality != "原画" || streams[0].URL != "https://example.com/live.flv" {
+		t.Errorf("GetStreamURLs() = %+v, want one 原画 stream", streams)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got := requests["get_info"]; len(got) != 3 {
+		t.Errorf("get_info requests = %v, want one per call", got)
+	}
+	if got := requests["playUrl"]; len(got) != 1 || got[0] != "5440" {
+		t.Errorf("playUrl cid = %v, want the real room ID 5440", got)
+	}
+	if got := requests["master"]; len(got) != 1 || got[0] != "9617619" {
+		t.Errorf("master info uid = %v, want 9617619", got)
+	}
+}
+
+func TestBilibiliProvider_GetStreamURLs(t *testing.T) {
+	ctx := context.Background()
+	var qualities []string
+	provider := newBilibiliTestProvider(t, map[string]func(url.Values) (int, string){
+		"/room/v1/Room/get_info": func(query url.Values) (int, string) {
+			if query.Get("room_id") == "100" {
+				return http.StatusOK, `{"code":0,"message":"ok","data":{"uid":1,"room_id":100,"live_status":0}}`
+			}
+			return http.StatusOK, `{"code":0,"message":"ok","data":{"uid":9617619,"room_id":5440,"short_id":1,"live_status":1}}`
+		},
+		"/room/v1/Room/playUrl": func(query url.Values) (int, string) {
+			qualities = append(qualities, query.Get("cid")+":"+query.Get("qn"))
+			return http.StatusOK, `{"code":0,"message":"0","data":{"current_qn":400,"quality_description":[{"qn":10000,"desc":"原画"},{"qn":400,"desc":"蓝光"}],"durl":[{"url":"https://example.com/a.flv","order":1},{"url":"https://example.com/b.flv","order":2}]}}`
+		},
+	})
+
+	var urlProvider StreamURLProvider = provider
+	streams, err := urlProvider.GetStreamURLs(ctx, "1", "400")
+	if err != nil {
+		t.Fatalf("GetStreamURLs() error = %v", err)
+	}
+	if len(streams) != 2 || streams[0] != (StreamURL{Quality: "蓝光", Format: "flv", URL: "https://example.com/a.flv"}) {
+		t.Errorf("streams = %+v, want two 蓝光 FLV streams", streams)
+	}
+
+	// 未指定清晰度时请求原画，短号使用真实房间号请求播放地址
+	if _, err := provider.GetStreamURLs(ctx, "1", ""); err != nil {
+		t.Fatalf("GetStreamURLs(best) error = %v", err)
+	}
+	if want := []string{"5440:400", "5440:10000"}; !slices.Equal(qualities, want) {
+		t.Errorf("playUrl requests = %v, want %v", qualities, want)
+	}
+
+	if streams, err := provider.GetStreamURLs(ctx, "100", ""); err != nil || len(streams) != 0 {
+		t.Errorf("GetStreamURLs(offline) = %+v, %v; want no streams", streams, err)
+	}
+	if _, err := provider.GetStreamURLs(ctx, "1", "best"); !errors.Is(err, ErrInvalidQuality) {
+		t.Errorf("GetStreamURLs(invalid quality) error = %v, want ErrInvalidQuality", err)
+	}
+}
+
+func TestClient_GetStreamURLsNotSupported(t *testing.T) {
+	client := NewClient(ClientConfig{})
+	if _, ok := client.providers["douyu"].(StreamURLProvider); ok {
+		t.Fatal("douyu implements StreamURLProvider, want a platform without stream URL extraction")
+	}
+	if _, err := client.GetStreamURLs(context.Background(), "douyu", "9999", ""); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("GetStreamURLs(douyu) error = %v, want ErrNotSupported", err)
+	}
+}
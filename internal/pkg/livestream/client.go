@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"nebula-live/internal/pkg/httpproxy"
+
 	"resty.dev/v3"
 )
 
@@ -13,12 +15,28 @@ type Client struct {
 	httpClient *resty.Client
 }
 
+// ClientConfig holds the configuration for platforms that require credentials
+type ClientConfig struct {
+	Twitch   TwitchConfig   `mapstructure:"twitch"`
+	Kuaishou KuaishouConfig `mapstructure:"kuaishou"`
+	// Proxy routes outbound requests through an upstream proxy when set
+	Proxy httpproxy.Config `mapstructure:"proxy"`
+	// Retry controls retries of transient upstream failures
+	Retry RetryConfig `mapstructure:"retry"`
+	// Requests adds headers and cookies to the requests of a platform, keyed by platform name or alias
+	Requests map[string]RequestConfig `mapstructure:"requests"`
+}
+
 // NewClient creates a new livestream client
-func NewClient() *Client {
+func NewClient(config ClientConfig) *Client {
 	httpClient := resty.New()
 	httpClient.SetTimeout(10 * time.Second)
-	httpClient.SetRetryCount(3)
-	httpClient.SetRetryWaitTime(1 * time.Second)
+	applyRetry(httpClient, config.Retry)
+	applyRequestConfigs(httpClient, config.Requests)
+
+	if err := httpproxy.Apply(httpClient, config.Proxy); err != nil {
+		httpClient.Logger().Errorf("failed to configure proxy: %v", err)
+	}
 
 	client := &Client{
 		providers:  make(map[string]Provider),
@@ -28,6 +46,12 @@ func NewClient() *Client {
 	// Register default providers
 	client.RegisterProvider(NewDouyuProvider(httpClient))
 	client.RegisterProvider(NewBilibiliProvider(httpClient))
+	client.RegisterProvider(NewKuaishouProvider(httpClient, config.Kuaishou))
+
+	// Twitch requires Helix API credentials
+	if config.Twitch.ClientID != "" && config.Twitch.ClientSecret != "" {
+		client.RegisterProvider(NewTwitchProvider(httpClient, config.Twitch))
+	}
 
 	return client
 }
@@ -37,27 +61,76 @@ func (c *Client) RegisterProvider(provider Provider) {
 	c.providers[provider.GetPlatformName()] = provider
 }
 
+// getProvider looks up a provider by its platform name or an alias of it
+func (c *Client) getProvider(platform string) (Provider, bool) {
+	provider, exists := c.providers[ResolvePlatform(platform)]
+	return provider, exists
+}
+
+// providerContext marks ctx with the provider's platform so its requests get the platform's request config
+func providerContext(ctx context.Context, provider Provider) context.Context {
+	return withPlatform(ctx, provider.GetPlatformName())
+}
+
 // GetStreamStatus gets the status of a live stream
 func (c *Client) GetStreamStatus(ctx context.Context, platform, roomID string) (*StreamInfo, error) {
-	provider, exists := c.providers[platform]
+	provider, exists := c.getProvider(platform)
 	if !exists {
 		return nil, ErrPlatformNotFound
 	}
 
-	return provider.GetStreamStatus(ctx, roomID)
+	return provider.GetStreamStatus(providerContext(ctx, provider), roomID)
 }
 
 // GetRoomInfo gets detailed information about a live room
 func (c *Client) GetRoomInfo(ctx context.Context, platform, roomID string) (*RoomInfo, error) {
-	provider, exists := c.providers[platform]
+	provider, exists := c.getProvider(platform)
 	if !exists {
 		return nil, ErrPlatformNotFound
 	}
 
-	return provider.GetRoomInfo(ctx, roomID)
+	roomInfo, err := provider.GetRoomInfo(providerContext(ctx, provider), roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	roomInfo.normalizeLiveTime(time.Now())
+	return roomInfo, nil
+}
+
+// GetStreamURLs gets the playable stream URLs of a live room in the requested quality, empty for the best.
+// Returns ErrNotSupported if the platform cannot extract stream URLs.
+func (c *Client) GetStreamURLs(ctx context.Context, platform, roomID, quality string) ([]StreamURL, error) {
+	provider, exists := c.getProvider(platform)
+	if !exists {
+		return nil, ErrPlatformNotFound
+	}
+
+	urlProvider, ok := provider.(StreamURLProvider)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+
+	return urlProvider.GetStreamURLs(providerContext(ctx, provider), roomID, quality)
+}
+
+// ResolveRoomID resolves a short room ID to the real room ID.
+// Returns ErrNotSupported if the platform has no short room IDs.
+func (c *Client) ResolveRoomID(ctx context.Context, platform, roomID string) (*ResolvedRoom, error) {
+	provider, exists := c.getProvider(platform)
+	if !exists {
+		return nil, ErrPlatformNotFound
+	}
+
+	resolver, ok := provider.(RoomIDResolver)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+
+	return resolver.ResolveRoomID(providerContext(ctx, provider), roomID)
 }
 
-// GetSupportedPlatforms returns a list of supported platforms
+// GetSupportedPlatforms returns the canonical names of the supported platforms
 func (c *Client) GetSupportedPlatforms() []string {
 	platforms := make([]string, 0, len(c.providers))
 	for name := range c.providers {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"resty.dev/v3"
 )
@@ -130,7 +131,7 @@ func (d *douyuProvider) GetRoomInfo(ctx context.Context, roomID string) (*RoomIn
 		OwnerID:       strconv.Itoa(douyuResp.Room.OwnerUID),
 		OwnerName:     douyuResp.Room.Nickname,
 		OwnerAvatar:   douyuResp.Room.Avatar.Big,
-		LiveStartTime: douyuResp.Room.ShowTime,
+		LiveStartTime: parseDouyuShowTime(douyuResp.Room.ShowTime),
 		ViewerCount:   viewerCount,
 		Category:      douyuResp.Room.CateName,
 	}
@@ -141,3 +142,11 @@ func (d *douyuProvider) GetRoomInfo(ctx context.Context, roomID string) (*RoomIn
 
 	return roomInfo, nil
 }
+
+// parseDouyuShowTime parses Douyu's show_time (unix seconds, 0 when never streamed)
+func parseDouyuShowTime(showTime int64) time.Time {
+	if showTime <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(showTime, 0).UTC()
+}
@@ -0,0 +1,106 @@
+package livestream
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"nebula-live/pkg/logger"
+
+	"resty.dev/v3"
+)
+
+// RequestConfig holds extra request data sent to one platform, e.g. headers and cookies
+// that get requests past its anti-crawler checks
+type RequestConfig struct {
+	// Headers are set on every request, replacing built-in values such as the User-Agent
+	Headers map[string]string `mapstructure:"headers"`
+	// Cookie is the raw Cookie header ("a=1; b=2") sent with every request.
+	// It is kept as one string since cookie names are case-sensitive and config keys are not.
+	Cookie string `mapstructure:"cookie"`
+}
+
+// platformContextKey marks the context of a provider call with the platform it belongs to
+type platformContextKey struct{}
+
+// withPlatform returns a context whose requests get the request config of the platform
+func withPlatform(ctx context.Context, platform string) context.Context {
+	return context.WithValue(ctx, platformContextKey{}, platform)
+}
+
+// platformFromContext returns the platform set by withPlatform
+func platformFromContext(ctx context.Context) (string, bool) {
+	platform, ok := ctx.Value(platformContextKey{}).(string)
+	return platform, ok
+}
+
+// applyRequestConfigs adds a request middleware to the shared HTTP client that merges the
+// request config of the calling platform into each request. Platform names may be aliases.
+func applyRequestConfigs(client *resty.Client, configs map[string]RequestConfig) {
+	if len(configs) == 0 {
+		return
+	}
+
+	byPlatform := make(map[string]RequestConfig, len(configs))
+	for name, config := range configs {
+		byPlatform[ResolvePlatform(name)] = config
+	}
+
+	// Runs before resty prepares the raw request, so it also overrides headers the provider set on the request
+	client.AddRequestMiddleware(func(_ *resty.Client, r *resty.Request) error {
+		platform, ok := platformFromContext(r.Context())
+		if !ok {
+			return nil
+		}
+		config, ok := byPlatform[platform]
+		if !ok {
+			return nil
+		}
+
+		for name, value := range config.Headers {
+			r.SetHeader(name, value)
+		}
+		if config.Cookie != "" {
+			r.SetHeader("Cookie", config.Cookie)
+		}
+		return nil
+	})
+}
+
+// Redacted returns a copy safe for logging, with cookie values and credential headers masked
+func (c RequestConfig) Redacted() RequestConfig {
+	headers := make(map[string]string, len(c.Headers))
+	for name, value := range c.Headers {
+		name = http.CanonicalHeaderKey(name)
+		switch {
+		case name == "Cookie":
+			value = maskCookie(value)
+		case logger.IsSensitiveKey(name):
+			value = logger.Redact(value)
+		}
+		headers[name] = value
+	}
+
+	return RequestConfig{
+		Headers: headers,
+		Cookie:  maskCookie(c.Cookie),
+	}
+}
+
+// maskCookie keeps the cookie names of a Cookie header and redacts their values
+func maskCookie(cookie string) string {
+	if cookie == "" {
+		return ""
+	}
+
+	parts := strings.Split(cookie, ";")
+	for i, part := range parts {
+		name, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			parts[i] = logger.Redact(name)
+			continue
+		}
+		parts[i] = name + "=" + logger.Redact(value)
+	}
+	return strings.Join(parts, "; ")
+}
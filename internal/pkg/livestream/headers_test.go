@@ -0,0 +1,110 @@
+package livestream
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// headerRecorder 记录收到的请求头，并按B站room_init接口的格式响应
+type headerRecorder struct {
+	mu      sync.Mutex
+	headers []http.Header
+}
+
+func newHeaderRecorder(t *testing.T) (*httptest.Server, *headerRecorder) {
+	t.Helper()
+	recorder := &headerRecorder{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder.mu.Lock()
+		recorder.headers = append(recorder.headers, r.Header.Clone())
+		recorder.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":0,"msg":"ok","message":"ok","data":{"room_id":5440,"short_id":1,"uid":9617619}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, recorder
+}
+
+// last 返回最近一次请求的请求头
+func (r *headerRecorder) last(t *testing.T) http.Header {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.headers) == 0 {
+		t.Fatal("server received no request")
+	}
+	return r.headers[len(r.headers)-1]
+}
+
+// 按别名配置的请求头和Cookie合并到该平台提供商的请求中，覆盖内置的User-Agent
+func TestClient_PlatformRequestConfigApplied(t *testing.T) {
+	initTestLogger()
+	server, recorder := newHeaderRecorder(t)
+
+	client := NewClient(ClientConfig{
+		Requests: map[string]RequestConfig{
+			"bili": {
+				Headers: map[string]string{"User-Agent": "nebula-test/1.0", "Referer": "https://live.bilibili.com/"},
+				Cookie:  "SESSDATA=secret-session; buvid3=abc",
+			},
+		},
+	})
+	t.Cleanup(func() { client.httpClient.Close() })
+	client.providers["bilibili"].(*bilibiliProvider).apiURL = server.URL
+
+	if _, err := client.ResolveRoomID(context.Background(), "bilibili", "1"); err != nil {
+		t.Fatalf("ResolveRoomID() error = %v", err)
+	}
+	headers := recorder.last(t)
+	if got := headers.Get("User-Agent"); got != "nebula-test/1.0" {
+		t.Errorf("User-Agent = %q, want the configured value", got)
+	}
+	if got := headers.Get("Referer"); got != "https://live.bilibili.com/" {
+		t.Errorf("Referer = %q, want the configured value", got)
+	}
+	if got := headers.Get("Cookie"); got != "SESSDATA=secret-session; buvid3=abc" {
+		t.Errorf("Cookie = %q, want the configured cookie", got)
+	}
+
+	// 其他平台和未标记平台的请求不受影响
+	for _, ctx := range []context.Context{withPlatform(context.Background(), "douyu"), context.Background()} {
+		if _, err := client.httpClient.R().SetContext(ctx).Get(server.URL); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if headers := recorder.last(t); headers.Get("Cookie") != "" || headers.Get("Referer") != "" {
+			t.Errorf("request outside bilibili got headers %v", headers)
+		}
+	}
+}
+
+func TestRequestConfig_RedactedMasksCookies(t *testing.T) {
+	config := RequestConfig{
+		Headers: map[string]string{
+			"referer":       "https://live.douyu.com/",
+			"cookie":        "acf_auth=secret-token-value",
+			"Authorization": "Bearer secret-token-value",
+		},
+		Cookie: "SESSDATA=secret-session; buvid3=abcdefgh",
+	}
+	redacted := config.Redacted()
+
+	if got := redacted.Headers["Referer"]; got != "https://live.douyu.com/" {
+		t.Errorf("Referer = %q, want it unchanged", got)
+	}
+	for _, value := range []string{redacted.Headers["Cookie"], redacted.Headers["Authorization"], redacted.Cookie} {
+		if strings.Contains(value, "secret") {
+			t.Errorf("redacted value %q still contains the secret", value)
+		}
+	}
+	// Cookie名称保留，便于确认配置了哪些Cookie
+	if !strings.HasPrefix(redacted.Cookie, "SESSDATA=") || !strings.Contains(redacted.Cookie, "; buvid3=") {
+		t.Errorf("redacted cookie = %q, want the cookie names kept", redacted.Cookie)
+	}
+	if config.Cookie != "SESSDATA=secret-session; buvid3=abcdefgh" {
+		t.Errorf("Redacted() modified the original config: %q", config.Cookie)
+	}
+}
@@ -0,0 +1,194 @@
+package livestream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"resty.dev/v3"
+)
+
+const kuaishouAPIURL = "https://live.kuaishou.com/live_api/liveroom/livedetail"
+
+// Kuaishou livedetail result codes
+const (
+	kuaishouResultOK = 1
+	// kuaishouResultBlocked is returned by the anti-crawler check, usually when no valid cookie is sent
+	kuaishouResultBlocked = 2
+	// kuaishouResultLiveEnded is returned for existing rooms that are not streaming
+	kuaishouResultLiveEnded = 671
+)
+
+// KuaishouConfig holds the request options of the Kuaishou provider
+type KuaishouConfig struct {
+	// Cookie is sent with every request, Kuaishou rejects most anonymous requests without a browser cookie (e.g. did=web_...)
+	Cookie string `mapstructure:"cookie"`
+}
+
+// Kuaishou provider implementation, room IDs are the streamer's principal ID shown in live.kuaishou.com/u/{id}
+type kuaishouProvider struct {
+	client *resty.Client
+	cookie string
+	apiURL string
+}
+
+type kuaishouResponse struct {
+	Data struct {
+		Result   int  `json:"result"`
+		IsLiving bool `json:"isLiving"`
+		Author   struct {
+			ID          string `json:"id"`
+			Name        string `json:"name"`
+			Description string `json:"description"`
+			Avatar      string `json:"avatar"`
+		} `json:"author"`
+		LiveStream struct {
+			ID        string `json:"id"`
+			Caption   string `json:"caption"`
+			Poster    string `json:"poster"`
+			StartTime int64  `json:"startTime"`
+		} `json:"liveStream"`
+		GameInfo struct {
+			Name          string `json:"name"`
+			WatchingCount string `json:"watchingCount"`
+		} `json:"gameInfo"`
+	} `json:"data"`
+}
+
+func NewKuaishouProvider(client *resty.Client, config KuaishouConfig) Provider {
+	return &kuaishouProvider{
+		client: client,
+		cookie: config.Cookie,
+		apiURL: kuaishouAPIURL,
+	}
+}
+
+func (k *kuaishouProvider) GetPlatformName() string {
+	return "kuaishou"
+}
+
+func (k *kuaishouProvider) GetStreamStatus(ctx context.Context, roomID string) (*StreamInfo, error) {
+	if roomID == "" {
+		return nil, ErrInvalidRoomID
+	}
+
+	detail, err := k.getLiveDetail(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	streamInfo := &StreamInfo{
+		Platform: k.GetPlatformName(),
+		RoomID:   roomID,
+		Status:   StreamStatusOffline,
+	}
+	if detail.Data.IsLiving {
+		streamInfo.Status = StreamStatusOnline
+	}
+
+	return streamInfo, nil
+}
+
+func (k *kuaishouProvider) GetRoomInfo(ctx context.Context, roomID string) (*RoomInfo, error) {
+	if roomID == "" {
+		return nil, ErrInvalidRoomID
+	}
+
+	detail, err := k.getLiveDetail(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	roomInfo := &RoomInfo{
+		Platform:    k.GetPlatformName(),
+		RoomID:      roomID,
+		Status:      StreamStatusOffline,
+		Description: detail.Data.Author.Description,
+		OwnerID:     detail.Data.Author.ID,
+		OwnerName:   detail.Data.Author.Name,
+		OwnerAvatar: detail.Data.Author.Avatar,
+	}
+
+	if detail.Data.IsLiving {
+		roomInfo.Status = StreamStatusOnline
+		roomInfo.Title = detail.Data.LiveStream.Caption
+		roomInfo.Cover = detail.Data.LiveStream.Poster
+		roomInfo.Category = detail.Data.GameInfo.Name
+		roomInfo.ViewerCount = parseKuaishouCount(detail.Data.GameInfo.WatchingCount)
+		roomInfo.LiveStartTime = parseKuaishouStartTime(detail.Data.LiveStream.StartTime)
+	}
+
+	return roomInfo, nil
+}
+
+// getLiveDetail fetches the live detail used by both GetStreamStatus and GetRoomInfo
+func (k *kuaishouProvider) getLiveDetail(ctx context.Context, roomID string) (*kuaishouResponse, error) {
+	var kuaishouResp kuaishouResponse
+	req := k.client.R().
+		SetContext(ctx).
+		SetResult(&kuaishouResp).
+		SetQueryParam("principalId", roomID).
+		SetHeader("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36").
+		SetHeader("Referer", "https://live.kuaishou.com/u/"+roomID)
+	if k.cookie != "" {
+		req.SetHeader("Cookie", k.cookie)
+	}
+
+	resp, err := req.Get(k.apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch kuaishou live detail: %w", err)
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("kuaishou API returned status code: %d", resp.StatusCode())
+	}
+
+	switch kuaishouResp.Data.Result {
+	case kuaishouResultOK, kuaishouResultLiveEnded:
+	case kuaishouResultBlocked:
+		return nil, fmt.Errorf("kuaishou API rejected the request (result: %d), check livestream.kuaishou.cookie", kuaishouResp.Data.Result)
+	default:
+		return nil, fmt.Errorf("kuaishou API error (result: %d)", kuaishouResp.Data.Result)
+	}
+
+	// Unknown principal IDs come back without an author
+	if kuaishouResp.Data.Author.ID == "" {
+		return nil, ErrRoomNotFound
+	}
+
+	return &kuaishouResp, nil
+}
+
+// parseKuaishouCount parses Kuaishou's display counts such as "8,312", "1.2万" or "3亿", 0 when unparsable
+func parseKuaishouCount(count string) int64 {
+	count = strings.ReplaceAll(strings.TrimSpace(count), ",", "")
+	if count == "" {
+		return 0
+	}
+
+	multiplier := 1.0
+	switch {
+	case strings.HasSuffix(count, "万"):
+		multiplier = 1e4
+		count = strings.TrimSuffix(count, "万")
+	case strings.HasSuffix(count, "亿"):
+		multiplier = 1e8
+		count = strings.TrimSuffix(count, "亿")
+	}
+
+	value, err := strconv.ParseFloat(count, 64)
+	if err != nil || value < 0 {
+		return 0
+	}
+	return int64(value * multiplier)
+}
+
+// parseKuaishouStartTime parses Kuaishou's startTime (unix milliseconds, 0 when unknown)
+func parseKuaishouStartTime(startTime int64) time.Time {
+	if startTime <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(startTime).UTC()
+}
@@ -0,0 +1,116 @@
+package livestream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// newKuaishouTestProvider 创建请求假服务器的快手提供商，服务器按principalId返回body，需要Cookie时校验请求头
+func newKuaishouTestProvider(t *testing.T, status int, bodies map[string]string) *kuaishouProvider {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Cookie") != "did=web_test" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"data":{"result":2}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		body, ok := bodies[r.URL.Query().Get("principalId")]
+		if !ok {
+			body = `{"data":{"result":1,"isLiving":false,"author":{}}}`
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := resty.New()
+	t.Cleanup(func() { client.Close() })
+	provider := NewKuaishouProvider(client, KuaishouConfig{Cookie: "did=web_test"}).(*kuaishouProvider)
+	provider.apiURL = server.URL
+	return provider
+}
+
+const (
+	kuaishouLiveBody    = `{"data":{"result":1,"isLiving":true,"author":{"id":"3xabc","name":"主播","description":"简介","avatar":"https://img/a.jpg"},"liveStream":{"id":"s1","caption":"直播中","poster":"https://img/p.jpg","startTime":1767261600000},"gameInfo":{"name":"王者荣耀","watchingCount":"1.2万"}}}`
+	kuaishouOfflineBody = `{"data":{"result":671,"isLiving":false,"author":{"id":"3xdef","name":"休息中的主播"}}}`
+)
+
+func TestKuaishouProvider_Live(t *testing.T) {
+	ctx := context.Background()
+	provider := newKuaishouTestProvider(t, http.StatusOK, map[string]string{"3xabc": kuaishouLiveBody})
+
+	status, err := provider.GetStreamStatus(ctx, "3xabc")
+	if err != nil {
+		t.Fatalf("GetStreamStatus() error = %v", err)
+	}
+	if status.Status != StreamStatusOnline {
+		t.Errorf("status = %v, want online", status.Status)
+	}
+
+	info, err := provider.GetRoomInfo(ctx, "3xabc")
+	if err != nil {
+		t.Fatalf("GetRoomInfo() error = %v", err)
+	}
+	if info.Title != "直播中" || info.Category != "王者荣耀" || info.ViewerCount != 12000 || info.OwnerName != "主播" {
+		t.Errorf("room info = %+v, want the live stream", info)
+	}
+	if want := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC); !info.LiveStartTime.Equal(want) {
+		t.Errorf("live start time = %v, want %v", info.LiveStartTime, want)
+	}
+}
+
+func TestKuaishouProvider_Offline(t *testing.T) {
+	ctx := context.Background()
+	provider := newKuaishouTestProvider(t, http.StatusOK, map[string]string{"3xdef": kuaishouOfflineBody})
+
+	info, err := provider.GetRoomInfo(ctx, "3xdef")
+	if err != nil {
+		t.Fatalf("GetRoomInfo() error = %v", err)
+	}
+	if info.Status != StreamStatusOffline || info.Title != "" || info.OwnerName != "休息中的主播" {
+		t.Errorf("room info = %+v, want offline with the author", info)
+	}
+
+	// 不存在的主播不返回作者信息
+	if _, err := provider.GetStreamStatus(ctx, "unknown"); !errors.Is(err, ErrRoomNotFound) {
+		t.Errorf("GetStreamStatus(unknown) error = %v, want ErrRoomNotFound", err)
+	}
+}
+
+func TestKuaishouProvider_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	provider := newKuaishouTestProvider(t, http.StatusForbidden, nil)
+	if _, err := provider.GetStreamStatus(ctx, "3xabc"); err == nil || !strings.Contains(err.Error(), "status code: 403") {
+		t.Errorf("GetStreamStatus() with 403 error = %v, want status code error", err)
+	}
+
+	provider = newKuaishouTestProvider(t, http.StatusOK, map[string]string{"3xabc": `{"data":{"result":500}}`})
+	if _, err := provider.GetStreamStatus(ctx, "3xabc"); err == nil || !strings.Contains(err.Error(), "result: 500") {
+		t.Errorf("GetStreamStatus() with an API error = %v, want result error", err)
+	}
+
+	// 反爬拦截时提示检查Cookie配置
+	provider = newKuaishouTestProvider(t, http.StatusOK, nil)
+	provider.cookie = ""
+	if _, err := provider.GetStreamStatus(ctx, "3xabc"); err == nil || !strings.Contains(err.Error(), "cookie") {
+		t.Errorf("GetStreamStatus() without cookie error = %v, want a cookie hint", err)
+	}
+}
+
+func TestParseKuaishouCount(t *testing.T) {
+	tests := map[string]int64{"8,312": 8312, "1.2万": 12000, "3亿": 300000000, "": 0, "n/a": 0, "-5": 0}
+	for input, want := range tests {
+		if got := parseKuaishouCount(input); got != want {
+			t.Errorf("parseKuaishouCount(%q) = %d, want %d", input, got, want)
+		}
+	}
+}
@@ -8,3 +8,18 @@ type Provider interface {
 	GetRoomInfo(ctx context.Context, roomID string) (*RoomInfo, error)
 	GetPlatformName() string
 }
+
+// StreamURLProvider is implemented by providers that can extract playable stream URLs
+type StreamURLProvider interface {
+	// GetStreamURLs returns the stream URLs of a room, empty when the room is offline.
+	// quality is a platform-specific quality code, empty selects the best available;
+	// an unrecognized code returns ErrInvalidQuality.
+	GetStreamURLs(ctx context.Context, roomID, quality string) ([]StreamURL, error)
+}
+
+// RoomIDResolver is implemented by providers whose rooms can be reached by a short ID or alias
+type RoomIDResolver interface {
+	// ResolveRoomID returns the canonical room ID of a room given by any of its IDs.
+	// A nonexistent room returns ErrRoomNotFound.
+	ResolveRoomID(ctx context.Context, roomID string) (*ResolvedRoom, error)
+}
@@ -0,0 +1,64 @@
+package livestream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// Defaults applied to zero backoff settings
+const (
+	defaultRetryWaitTime    = 1 * time.Second
+	defaultRetryMaxWaitTime = 10 * time.Second
+)
+
+// RetryConfig controls how failed upstream calls are retried, see IsRetryable for which failures qualify
+type RetryConfig struct {
+	// Count is the number of retries after the first attempt, 0 disables retrying
+	Count int `mapstructure:"count"`
+	// WaitTime is the backoff before the first retry, it doubles with jitter on every further retry
+	WaitTime time.Duration `mapstructure:"wait_time"`
+	// MaxWaitTime caps the backoff between two attempts
+	MaxWaitTime time.Duration `mapstructure:"max_wait_time"`
+}
+
+// IsRetryable reports whether an upstream call is worth retrying.
+// Network errors, 429 and 5xx responses are transient, while any other status is final,
+// e.g. a 404 for a room that does not exist fails right away.
+func IsRetryable(resp *resty.Response, err error) bool {
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode()
+	}
+
+	// Without a status the request never got an answer, unless the caller gave up
+	if status == 0 {
+		return err != nil && !errors.Is(err, context.Canceled)
+	}
+
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// applyRetry configures the retry policy of the shared HTTP client, replacing resty's default conditions with IsRetryable
+func applyRetry(client *resty.Client, config RetryConfig) {
+	waitTime := config.WaitTime
+	if waitTime <= 0 {
+		waitTime = defaultRetryWaitTime
+	}
+	maxWaitTime := config.MaxWaitTime
+	if maxWaitTime <= 0 {
+		maxWaitTime = defaultRetryMaxWaitTime
+	}
+	if maxWaitTime < waitTime {
+		maxWaitTime = waitTime
+	}
+
+	client.SetRetryCount(max(config.Count, 0)).
+		SetRetryWaitTime(waitTime).
+		SetRetryMaxWaitTime(maxWaitTime).
+		SetRetryDefaultConditions(false).
+		AddRetryConditions(IsRetryable)
+}
@@ -0,0 +1,90 @@
+package livestream
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// countingServer 按固定状态码响应并统计请求次数的服务器
+func countingServer(t *testing.T, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &hits
+}
+
+// newRetryingClient 创建重试两次且几乎不等待的HTTP客户端
+func newRetryingClient(t *testing.T) *resty.Client {
+	t.Helper()
+	client := resty.New()
+	applyRetry(client, RetryConfig{Count: 2, WaitTime: time.Millisecond, MaxWaitTime: time.Millisecond})
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestRetry_StatusClassification(t *testing.T) {
+	tests := []struct {
+		status   int
+		wantHits int32
+	}{
+		{http.StatusNotFound, 1},
+		{http.StatusBadRequest, 1},
+		{http.StatusForbidden, 1},
+		{http.StatusTooManyRequests, 3},
+		{http.StatusServiceUnavailable, 3},
+		{http.StatusBadGateway, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server, hits := countingServer(t, tt.status)
+
+			resp, err := newRetryingClient(t).R().Get(server.URL)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if resp.StatusCode() != tt.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode(), tt.status)
+			}
+			if got := hits.Load(); got != tt.wantHits {
+				t.Errorf("server received %d requests, want %d", got, tt.wantHits)
+			}
+		})
+	}
+}
+
+func TestRetry_NetworkErrorRetried(t *testing.T) {
+	server, _ := countingServer(t, http.StatusOK)
+	url := server.URL
+	server.Close()
+
+	var attempts atomic.Int32
+	client := newRetryingClient(t)
+	client.AddRequestMiddleware(func(*resty.Client, *resty.Request) error {
+		attempts.Add(1)
+		return nil
+	})
+
+	if _, err := client.R().Get(url); err == nil {
+		t.Fatal("Get() error = nil, want connection error")
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestIsRetryable_CancelledRequest(t *testing.T) {
+	if IsRetryable(nil, context.Canceled) {
+		t.Error("IsRetryable(context.Canceled) = true, want false")
+	}
+}
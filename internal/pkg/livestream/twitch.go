@@ -0,0 +1,245 @@
+package livestream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"resty.dev/v3"
+)
+
+const (
+	twitchAuthURL = "https://id.twitch.tv/oauth2/token"
+	twitchAPIURL  = "https://api.twitch.tv/helix"
+
+	// twitchTokenRefreshMargin refreshes the app access token shortly before it expires
+	twitchTokenRefreshMargin = time.Minute
+)
+
+// TwitchConfig holds the Helix API credentials for the Twitch provider
+type TwitchConfig struct {
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+}
+
+// Twitch provider implementation, room IDs are channel login names
+type twitchProvider struct {
+	client       *resty.Client
+	clientID     string
+	clientSecret string
+	authURL      string
+	apiURL       string
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+type twitchTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+type twitchStreamsResponse struct {
+	Data []struct {
+		ID           string    `json:"id"`
+		UserID       string    `json:"user_id"`
+		UserLogin    string    `json:"user_login"`
+		UserName     string    `json:"user_name"`
+		GameID       string    `json:"game_id"`
+		GameName     string    `json:"game_name"`
+		Type         string    `json:"type"`
+		Title        string    `json:"title"`
+		ViewerCount  int64     `json:"viewer_count"`
+		StartedAt    time.Time `json:"started_at"`
+		ThumbnailURL string    `json:"thumbnail_url"`
+	} `json:"data"`
+}
+
+type twitchUsersResponse struct {
+	Data []struct {
+		ID              string `json:"id"`
+		Login           string `json:"login"`
+		DisplayName     string `json:"display_name"`
+		Description     string `json:"description"`
+		ProfileImageURL string `json:"profile_image_url"`
+		OfflineImageURL string `json:"offline_image_url"`
+	} `json:"data"`
+}
+
+func NewTwitchProvider(client *resty.Client, config TwitchConfig) Provider {
+	return &twitchProvider{
+		client:       client,
+		clientID:     config.ClientID,
+		clientSecret: config.ClientSecret,
+		authURL:      twitchAuthURL,
+		apiURL:       twitchAPIURL,
+	}
+}
+
+func (t *twitchProvider) GetPlatformName() string {
+	return "twitch"
+}
+
+func (t *twitchProvider) GetStreamStatus(ctx context.Context, roomID string) (*StreamInfo, error) {
+	if roomID == "" {
+		return nil, ErrInvalidRoomID
+	}
+
+	streams, err := t.getStreams(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	streamInfo := &StreamInfo{
+		Platform: t.GetPlatformName(),
+		RoomID:   roomID,
+		Status:   StreamStatusOffline,
+	}
+
+	// Helix only returns streams that are currently live
+	if len(streams.Data) > 0 && streams.Data[0].Type == "live" {
+		streamInfo.Status = StreamStatusOnline
+	}
+
+	return streamInfo, nil
+}
+
+func (t *twitchProvider) GetRoomInfo(ctx context.Context, roomID string) (*RoomInfo, error) {
+	if roomID == "" {
+		return nil, ErrInvalidRoomID
+	}
+
+	var users twitchUsersResponse
+	if err := t.get(ctx, "/users", map[string]string{"login": roomID}, &users); err != nil {
+		return nil, err
+	}
+	if len(users.Data) == 0 {
+		return nil, ErrRoomNotFound
+	}
+	user := users.Data[0]
+
+	roomInfo := &RoomInfo{
+		Platform:    t.GetPlatformName(),
+		RoomID:      roomID,
+		Status:      StreamStatusOffline,
+		Description: user.Description,
+		Cover:       user.OfflineImageURL,
+		OwnerID:     user.ID,
+		OwnerName:   user.DisplayName,
+		OwnerAvatar: user.ProfileImageURL,
+	}
+
+	streams, err := t.getStreams(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(streams.Data) > 0 && streams.Data[0].Type == "live" {
+		stream := streams.Data[0]
+		roomInfo.Status = StreamStatusOnline
+		roomInfo.Title = stream.Title
+		roomInfo.Category = stream.GameName
+		roomInfo.ViewerCount = stream.ViewerCount
+		roomInfo.LiveStartTime = stream.StartedAt
+		roomInfo.Keyframe = twitchThumbnail(stream.ThumbnailURL)
+	}
+
+	return roomInfo, nil
+}
+
+// getStreams fetches the live stream of a channel, empty data means offline
+func (t *twitchProvider) getStreams(ctx context.Context, login string) (*twitchStreamsResponse, error) {
+	var streams twitchStreamsResponse
+	if err := t.get(ctx, "/streams", map[string]string{"user_login": login}, &streams); err != nil {
+		return nil, err
+	}
+	return &streams, nil
+}
+
+// get performs an authenticated Helix request.
+// A 401 response means the app access token was revoked or expired early,
+// so the token is refreshed and the request retried once.
+func (t *twitchProvider) get(ctx context.Context, path string, params map[string]string, result any) error {
+	for attempt := 0; attempt < 2; attempt++ {
+		token, err := t.getAccessToken(ctx, attempt > 0)
+		if err != nil {
+			return err
+		}
+
+		resp, err := t.client.R().
+			SetContext(ctx).
+			SetResult(result).
+			SetQueryParams(params).
+			SetHeader("Client-Id", t.clientID).
+			SetAuthToken(token).
+			Get(t.apiURL + path)
+
+		if err != nil {
+			return fmt.Errorf("failed to fetch twitch %s: %w", path, err)
+		}
+
+		if resp.StatusCode() == http.StatusUnauthorized {
+			continue
+		}
+
+		if resp.StatusCode() != http.StatusOK {
+			return fmt.Errorf("twitch API returned status code: %d", resp.StatusCode())
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("twitch API rejected the app access token")
+}
+
+// getAccessToken returns a cached app access token, requesting a new one when
+// missing, about to expire or when forceRefresh is set
+func (t *twitchProvider) getAccessToken(ctx context.Context, forceRefresh bool) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !forceRefresh && t.accessToken != "" && time.Now().Before(t.expiresAt.Add(-twitchTokenRefreshMargin)) {
+		return t.accessToken, nil
+	}
+
+	if t.clientID == "" || t.clientSecret == "" {
+		return "", fmt.Errorf("twitch client ID and secret are not configured")
+	}
+
+	var tokenResp twitchTokenResponse
+	resp, err := t.client.R().
+		SetContext(ctx).
+		SetResult(&tokenResp).
+		SetQueryParams(map[string]string{
+			"client_id":     t.clientID,
+			"client_secret": t.clientSecret,
+			"grant_type":    "client_credentials",
+		}).
+		Post(t.authURL)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch twitch access token: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK || tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("twitch token endpoint returned status code: %d", resp.StatusCode())
+	}
+
+	t.accessToken = tokenResp.AccessToken
+	t.expiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+
+	return t.accessToken, nil
+}
+
+// twitchThumbnail fills the size placeholders of a Helix thumbnail URL
+func twitchThumbnail(url string) string {
+	if url == "" {
+		return ""
+	}
+	return strings.NewReplacer("{width}", "1280", "{height}", "720").Replace(url)
+}
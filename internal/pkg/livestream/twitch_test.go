@@ -0,0 +1,163 @@
+package livestream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// fakeTwitch 模拟Twitch的令牌接口和Helix接口，live为false时频道不在直播
+type fakeTwitch struct {
+	live        bool
+	status      int // Helix接口返回的状态码，0表示200
+	revokeFirst bool
+	tokens      atomic.Int32
+	rejected    atomic.Bool
+}
+
+func newTwitchTestProvider(t *testing.T, fake *fakeTwitch) *twitchProvider {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/token" {
+			n := fake.tokens.Add(1)
+			json.NewEncoder(w).Encode(map[string]any{"access_token": "token-" + strconv.Itoa(int(n)), "expires_in": 3600})
+			return
+		}
+
+		if r.Header.Get("Client-Id") != "client-id" || !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer token-") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// 第一个令牌被提前吊销
+		if fake.revokeFirst && r.Header.Get("Authorization") == "Bearer token-1" {
+			fake.rejected.Store(true)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if fake.status != 0 {
+			w.WriteHeader(fake.status)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/helix/users":
+			if r.URL.Query().Get("login") != "streamer" {
+				w.Write([]byte(`{"data":[]}`))
+				return
+			}
+			w.Write([]byte(`{"data":[{"id":"42","login":"streamer","display_name":"Streamer","description":"desc","profile_image_url":"https://img/avatar.png","offline_image_url":"https://img/offline.png"}]}`))
+		case "/helix/streams":
+			if !fake.live || r.URL.Query().Get("user_login") != "streamer" {
+				w.Write([]byte(`{"data":[]}`))
+				return
+			}
+			w.Write([]byte(`{"data":[{"id":"1","user_login":"streamer","game_name":"Just Chatting","type":"live","title":"Hello","viewer_count":1234,"started_at":"2026-01-01T10:00:00Z","thumbnail_url":"https://img/live-{width}x{height}.jpg"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := resty.New()
+	t.Cleanup(func() { client.Close() })
+	provider := NewTwitchProvider(client, TwitchConfig{ClientID: "client-id", ClientSecret: "secret"}).(*twitchProvider)
+	provider.authURL = server.URL + "/token"
+	provider.apiURL = server.URL + "/helix"
+	return provider
+}
+
+func TestTwitchProvider_Live(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeTwitch{live: true}
+	provider := newTwitchTestProvider(t, fake)
+
+	status, err := provider.GetStreamStatus(ctx, "streamer")
+	if err != nil {
+		t.Fatalf("GetStreamStatus() error = %v", err)
+	}
+	if status.Status != StreamStatusOnline {
+		t.Errorf("status = %v, want online", status.Status)
+	}
+
+	info, err := provider.GetRoomInfo(ctx, "streamer")
+	if err != nil {
+		t.Fatalf("GetRoomInfo() error = %v", err)
+	}
+	if info.Status != StreamStatusOnline || info.Title != "Hello" || info.Category != "Just Chatting" || info.ViewerCount != 1234 {
+		t.Errorf("room info = %+v, want the live stream", info)
+	}
+	if want := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC); !info.LiveStartTime.Equal(want) {
+		t.Errorf("live start time = %v, want %v", info.LiveStartTime, want)
+	}
+	if info.Keyframe != "https://img/live-1280x720.jpg" || info.OwnerName != "Streamer" || info.OwnerID != "42" {
+		t.Errorf("room info = %+v, want keyframe and owner filled in", info)
+	}
+
+	// 令牌在有效期内复用
+	if got := fake.tokens.Load(); got != 1 {
+		t.Errorf("token requests = %d, want 1", got)
+	}
+}
+
+func TestTwitchProvider_Offline(t *testing.T) {
+	ctx := context.Background()
+	provider := newTwitchTestProvider(t, &fakeTwitch{})
+
+	status, err := provider.GetStreamStatus(ctx, "streamer")
+	if err != nil {
+		t.Fatalf("GetStreamStatus() error = %v", err)
+	}
+	if status.Status != StreamStatusOffline {
+		t.Errorf("status = %v, want offline", status.Status)
+	}
+
+	info, err := provider.GetRoomInfo(ctx, "streamer")
+	if err != nil {
+		t.Fatalf("GetRoomInfo() error = %v", err)
+	}
+	if info.Status != StreamStatusOffline || info.Title != "" || info.Cover != "https://img/offline.png" {
+		t.Errorf("room info = %+v, want offline with the offline image", info)
+	}
+
+	if _, err := provider.GetRoomInfo(ctx, "nobody"); !errors.Is(err, ErrRoomNotFound) {
+		t.Errorf("GetRoomInfo(nobody) error = %v, want ErrRoomNotFound", err)
+	}
+}
+
+func TestTwitchProvider_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	provider := newTwitchTestProvider(t, &fakeTwitch{status: http.StatusInternalServerError})
+	provider.client.SetRetryCount(0)
+	if _, err := provider.GetStreamStatus(ctx, "streamer"); err == nil {
+		t.Error("GetStreamStatus() with a failing API succeeded, want error")
+	}
+
+	client := resty.New()
+	defer client.Close()
+	unconfigured := NewTwitchProvider(client, TwitchConfig{})
+	if _, err := unconfigured.GetStreamStatus(ctx, "streamer"); err == nil || !strings.Contains(err.Error(), "not configured") {
+		t.Errorf("GetStreamStatus() without credentials error = %v, want not configured", err)
+	}
+
+	// 被吊销的令牌刷新一次后重试
+	fake := &fakeTwitch{live: true, revokeFirst: true}
+	provider = newTwitchTestProvider(t, fake)
+	status, err := provider.GetStreamStatus(ctx, "streamer")
+	if err != nil {
+		t.Fatalf("GetStreamStatus() after revocation error = %v", err)
+	}
+	if status.Status != StreamStatusOnline || !fake.rejected.Load() || fake.tokens.Load() != 2 {
+		t.Errorf("status = %v, rejected = %v, tokens = %d; want online after one refresh", status.Status, fake.rejected.Load(), fake.tokens.Load())
+	}
+}
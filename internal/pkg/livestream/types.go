@@ -1,6 +1,9 @@
 package livestream
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 // StreamStatus represents the status of a live stream
 type StreamStatus string
@@ -19,19 +22,55 @@ type StreamInfo struct {
 
 // RoomInfo contains detailed information about a live room
 type RoomInfo struct {
-	Platform      string       `json:"platform"`
-	RoomID        string       `json:"room_id"`
-	Status        StreamStatus `json:"status"`
-	Title         string       `json:"title,omitempty"`
-	Description   string       `json:"description,omitempty"`
-	Cover         string       `json:"cover,omitempty"`
-	Keyframe      string       `json:"keyframe,omitempty"`
-	OwnerID       string       `json:"owner_id,omitempty"`
-	OwnerName     string       `json:"owner_name,omitempty"`
-	OwnerAvatar   string       `json:"owner_avatar,omitempty"`
-	LiveStartTime int64        `json:"live_start_time,omitempty"`
-	ViewerCount   int64        `json:"viewer_count,omitempty"`
-	Category      string       `json:"category,omitempty"`
+	Platform      string        `json:"platform"`
+	RoomID        string        `json:"room_id"`
+	Status        StreamStatus  `json:"status"`
+	Title         string        `json:"title,omitempty"`
+	Description   string        `json:"description,omitempty"`
+	Cover         string        `json:"cover,omitempty"`
+	Keyframe      string        `json:"keyframe,omitempty"`
+	OwnerID       string        `json:"owner_id,omitempty"`
+	OwnerName     string        `json:"owner_name,omitempty"`
+	OwnerAvatar   string        `json:"owner_avatar,omitempty"`
+	LiveStartTime time.Time     `json:"live_start_time"` // UTC, zero when offline
+	LiveDuration  time.Duration `json:"live_duration"`   // zero when offline
+	ViewerCount   int64         `json:"viewer_count,omitempty"`
+	Category      string        `json:"category,omitempty"`
+	Streams       []StreamURL   `json:"streams,omitempty"`
+}
+
+// normalizeLiveTime converts the start time to UTC and computes the live duration,
+// clearing both for offline rooms
+func (r *RoomInfo) normalizeLiveTime(now time.Time) {
+	if r.Status != StreamStatusOnline || r.LiveStartTime.IsZero() {
+		r.LiveStartTime = time.Time{}
+		r.LiveDuration = 0
+		return
+	}
+
+	r.LiveStartTime = r.LiveStartTime.UTC()
+	r.LiveDuration = now.Sub(r.LiveStartTime)
+	if r.LiveDuration < 0 {
+		r.LiveDuration = 0
+	}
+}
+
+// ResolvedRoom contains the canonical ID of a room and the short ID it can also be reached by
+type ResolvedRoom struct {
+	Platform string `json:"platform"`
+	// RoomID is the real room ID accepted by every platform API
+	RoomID string `json:"room_id"`
+	// ShortID is the vanity room ID, empty when the room has none
+	ShortID string `json:"short_id,omitempty"`
+	// OwnerID is the platform user ID of the streamer
+	OwnerID string `json:"owner_id"`
+}
+
+// StreamURL contains a playable stream address of a live room
+type StreamURL struct {
+	Quality string `json:"quality"`
+	Format  string `json:"format,omitempty"`
+	URL     string `json:"url"`
 }
 
 // Common errors
@@ -39,4 +78,6 @@ var (
 	ErrRoomNotFound     = errors.New("live room not found")
 	ErrPlatformNotFound = errors.New("platform not supported")
 	ErrInvalidRoomID    = errors.New("invalid room ID")
+	ErrNotSupported     = errors.New("operation not supported by platform")
+	ErrInvalidQuality   = errors.New("invalid stream quality")
 )
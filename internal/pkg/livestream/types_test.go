@@ -0,0 +1,59 @@
+package livestream
+
+import (
+	"testing"
+	"time"
+)
+
+// 斗鱼的秒级时间戳和B站的北京时间字符串解析为同一个UTC时间
+func TestParseLiveStartTime(t *testing.T) {
+	want := time.Date(2026, 1, 2, 4, 30, 0, 0, time.UTC)
+
+	douyu := parseDouyuShowTime(want.Unix())
+	bilibili := parseBilibiliLiveTime("2026-01-02 12:30:00")
+	for name, got := range map[string]time.Time{"douyu": douyu, "bilibili": bilibili} {
+		if !got.Equal(want) || got.Location() != time.UTC {
+			t.Errorf("%s start time = %v, want %v", name, got, want)
+		}
+	}
+
+	// 未开播的取值解析为零值
+	for _, liveTime := range []string{"", "0000-00-00 00:00:00", "not a time"} {
+		if got := parseBilibiliLiveTime(liveTime); !got.IsZero() {
+			t.Errorf("parseBilibiliLiveTime(%q) = %v, want zero", liveTime, got)
+		}
+	}
+	for _, showTime := range []int64{0, -1} {
+		if got := parseDouyuShowTime(showTime); !got.IsZero() {
+			t.Errorf("parseDouyuShowTime(%d) = %v, want zero", showTime, got)
+		}
+	}
+}
+
+func TestRoomInfo_NormalizeLiveTime(t *testing.T) {
+	start := time.Date(2026, 1, 2, 12, 30, 0, 0, bilibiliTimeZone)
+	now := time.Date(2026, 1, 2, 6, 0, 0, 0, time.UTC)
+
+	online := &RoomInfo{Status: StreamStatusOnline, LiveStartTime: start}
+	online.normalizeLiveTime(now)
+	if online.LiveStartTime.Location() != time.UTC || !online.LiveStartTime.Equal(start) {
+		t.Errorf("live_start_time = %v, want %v in UTC", online.LiveStartTime, start)
+	}
+	if online.LiveDuration != 90*time.Minute {
+		t.Errorf("live_duration = %v, want 1h30m", online.LiveDuration)
+	}
+
+	// 开播时间晚于当前时间（时钟偏差）时时长不为负
+	skewed := &RoomInfo{Status: StreamStatusOnline, LiveStartTime: now.Add(time.Minute)}
+	skewed.normalizeLiveTime(now)
+	if skewed.LiveDuration != 0 {
+		t.Errorf("live_duration with a future start = %v, want 0", skewed.LiveDuration)
+	}
+
+	// 未开播的房间清空开播时间和时长
+	offline := &RoomInfo{Status: StreamStatusOffline, LiveStartTime: start, LiveDuration: time.Hour}
+	offline.normalizeLiveTime(now)
+	if !offline.LiveStartTime.IsZero() || offline.LiveDuration != 0 {
+		t.Errorf("offline room = {start: %v, duration: %v}, want zero values", offline.LiveStartTime, offline.LiveDuration)
+	}
+}
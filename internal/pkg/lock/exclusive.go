@@ -0,0 +1,101 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// releaseTimeout bounds the release of a lock after the work stopped, which may happen during shutdown
+const releaseTimeout = 5 * time.Second
+
+// RunExclusive runs fn while holding the lock key, so it runs on only one replica at a time.
+//
+// Acquisition is retried every ttl/3 until ctx is done. While fn runs the lock is renewed every
+// ttl/3; the context passed to fn is cancelled once the lock is lost, i.e. it was taken over or
+// could not be renewed before its TTL ran out. When fn returns the lock is released and
+// acquisition starts over, so fn should only return early when ctx is done or it wants to retry.
+// RunExclusive returns when ctx is done.
+func (l *Locker) RunExclusive(ctx context.Context, key string, ttl time.Duration, fn func(ctx context.Context)) {
+	interval := ttl / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		lock, err := l.TryAcquire(ctx, key, ttl)
+		switch {
+		case err == nil:
+			logger.Info("Lock acquired, running exclusive work", zap.String("key", lock.Key()))
+			l.hold(ctx, lock, interval, fn)
+		case !errors.Is(err, ErrNotAcquired) && ctx.Err() == nil:
+			logger.Warn("Failed to acquire lock", zap.String("key", l.prefix+key), zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// hold runs fn and renews lock until fn returns or the lock is lost, then releases it
+func (l *Locker) hold(ctx context.Context, lock *Lock, interval time.Duration, fn func(ctx context.Context)) {
+	workCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(workCtx)
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// A failed renewal keeps the work running as long as the lock has not expired yet
+	validUntil := time.Now().Add(lock.TTL())
+	for {
+		select {
+		case <-done:
+			releaseCtx, cancelRelease := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+			err := lock.Release(releaseCtx)
+			cancelRelease()
+			if err != nil {
+				logger.Warn("Failed to release lock", zap.String("key", lock.Key()), zap.Error(err))
+			} else {
+				logger.Info("Lock released", zap.String("key", lock.Key()))
+			}
+			return
+		case <-ticker.C:
+		}
+
+		renewedAt := time.Now()
+		err := lock.Renew(ctx)
+		switch {
+		case err == nil:
+			validUntil = renewedAt.Add(lock.TTL())
+			continue
+		case ctx.Err() != nil:
+			// Shutting down, release once fn returns
+			continue
+		case errors.Is(err, ErrLockLost):
+			logger.Warn("Lock lost, stopping exclusive work", zap.String("key", lock.Key()))
+		case time.Now().Add(interval).Before(validUntil):
+			logger.Warn("Failed to renew lock, retrying", zap.String("key", lock.Key()), zap.Error(err))
+			continue
+		default:
+			logger.Warn("Failed to renew lock before it expires, stopping exclusive work",
+				zap.String("key", lock.Key()), zap.Error(err))
+		}
+
+		// The lock is gone and may already belong to another replica, so stop without releasing it
+		cancel()
+		<-done
+		return
+	}
+}
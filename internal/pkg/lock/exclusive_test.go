@@ -0,0 +1,81 @@
+package lock_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"nebula-live/internal/pkg/lock"
+	"nebula-live/internal/testutil"
+)
+
+func TestLocker_RunExclusiveRunsOnOneReplica(t *testing.T) {
+	testutil.InitLogger()
+	server, client := testutil.NewRedis(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// 两个副本竞争同一个锁，工作一直运行到ctx取消
+	var running, started atomic.Int32
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lock.NewLocker(client, "").RunExclusive(ctx, "job", 150*time.Millisecond, func(ctx context.Context) {
+				started.Add(1)
+				if running.Add(1) > 1 {
+					t.Error("exclusive work running on two replicas")
+				}
+				<-ctx.Done()
+				running.Add(-1)
+			})
+		}()
+	}
+
+	time.Sleep(300 * time.Millisecond)
+	if got := started.Load(); got != 1 {
+		t.Errorf("work started %d times, want 1", got)
+	}
+
+	cancel()
+	wg.Wait()
+	if server.Exists("job") {
+		t.Error("lock key still exists after shutdown, want it released")
+	}
+}
+
+func TestLocker_RunExclusiveStopsWhenLockIsTakenOver(t *testing.T) {
+	testutil.InitLogger()
+	server, client := testutil.NewRedis(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	acquired := make(chan struct{})
+	stopped := make(chan struct{})
+	go lock.NewLocker(client, "").RunExclusive(ctx, "job", 150*time.Millisecond, func(ctx context.Context) {
+		close(acquired)
+		<-ctx.Done()
+		close(stopped)
+	})
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("lock was not acquired")
+	}
+
+	// 锁过期后被其他副本领取，下次续期时停止工作
+	if err := server.Set("job", "other-owner"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("work kept running after the lock was taken over")
+	}
+	if got, _ := server.Get("job"); got != "other-owner" {
+		t.Errorf("lock value = %q, want the new owner's lock kept", got)
+	}
+}
@@ -0,0 +1,122 @@
+// Package lock provides a Redis-based distributed lock for work that must run on a single replica
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrNotAcquired is returned by TryAcquire when another owner holds the lock
+	ErrNotAcquired = errors.New("lock is held by another owner")
+	// ErrLockLost is returned by Renew and Release when the lock expired or was taken over
+	ErrLockLost = errors.New("lock is no longer held")
+)
+
+// renewScript extends the TTL only while the lock still holds our token
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript deletes the lock only while it still holds our token
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Locker creates distributed locks stored in Redis, so work can run on a single replica at a time
+type Locker struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewLocker creates a locker, prefix is prepended to every lock key
+func NewLocker(client redis.UniversalClient, prefix string) *Locker {
+	return &Locker{client: client, prefix: prefix}
+}
+
+// Lock is a lock held in Redis. It expires after its TTL unless renewed,
+// so an owner that crashes never blocks the others for longer than that.
+type Lock struct {
+	client redis.UniversalClient
+	key    string
+	token  string
+	ttl    time.Duration
+}
+
+// TryAcquire takes the lock without waiting, returning ErrNotAcquired when another owner holds it
+func (l *Locker) TryAcquire(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("lock ttl must be positive, got %s", ttl)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	key = l.prefix + key
+	ok, err := l.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock %s: %w", key, err)
+	}
+	if !ok {
+		return nil, ErrNotAcquired
+	}
+
+	return &Lock{client: l.client, key: key, token: token, ttl: ttl}, nil
+}
+
+// Key returns the Redis key of the lock
+func (l *Lock) Key() string {
+	return l.key
+}
+
+// TTL returns the time the lock stays valid after acquisition or the last renewal
+func (l *Lock) TTL() time.Duration {
+	return l.ttl
+}
+
+// Renew resets the TTL of the lock, returning ErrLockLost when it already expired or was taken over
+func (l *Lock) Renew(ctx context.Context) error {
+	renewed, err := renewScript.Run(ctx, l.client, []string{l.key}, l.token, l.ttl.Milliseconds()).Int()
+	if err != nil {
+		return fmt.Errorf("failed to renew lock %s: %w", l.key, err)
+	}
+	if renewed == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// Release deletes the lock, returning ErrLockLost when it already expired or was taken over
+func (l *Lock) Release(ctx context.Context) error {
+	released, err := releaseScript.Run(ctx, l.client, []string{l.key}, l.token).Int()
+	if err != nil {
+		return fmt.Errorf("failed to release lock %s: %w", l.key, err)
+	}
+	if released == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// newToken returns a random value identifying one acquisition of a lock
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,97 @@
+package lock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nebula-live/internal/pkg/lock"
+	"nebula-live/internal/testutil"
+)
+
+func TestLocker_AcquireContentionAndExpiry(t *testing.T) {
+	ctx := context.Background()
+	server, client := testutil.NewRedis(t)
+	locker := lock.NewLocker(client, "app:")
+
+	first, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil {
+		t.Fatalf("TryAcquire() error = %v", err)
+	}
+	if first.Key() != "app:job" {
+		t.Errorf("Key() = %q, want app:job", first.Key())
+	}
+	if _, err := locker.TryAcquire(ctx, "job", time.Minute); !errors.Is(err, lock.ErrNotAcquired) {
+		t.Errorf("TryAcquire() while held error = %v, want ErrNotAcquired", err)
+	}
+	if _, err := locker.TryAcquire(ctx, "other", time.Minute); err != nil {
+		t.Errorf("TryAcquire(other key) error = %v", err)
+	}
+
+	// 持有者崩溃后锁在TTL到期时释放
+	server.FastForward(time.Minute)
+	if _, err := locker.TryAcquire(ctx, "job", time.Minute); err != nil {
+		t.Errorf("TryAcquire() after expiry error = %v", err)
+	}
+	if _, err := locker.TryAcquire(ctx, "job", 0); err == nil {
+		t.Error("TryAcquire() with zero TTL succeeded, want error")
+	}
+}
+
+func TestLock_RenewExtendsTTL(t *testing.T) {
+	ctx := context.Background()
+	server, client := testutil.NewRedis(t)
+	locker := lock.NewLocker(client, "")
+
+	held, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil {
+		t.Fatalf("TryAcquire() error = %v", err)
+	}
+
+	server.FastForward(40 * time.Second)
+	if err := held.Renew(ctx); err != nil {
+		t.Fatalf("Renew() error = %v", err)
+	}
+	// 未续期时此时已经过期
+	server.FastForward(40 * time.Second)
+	if _, err := locker.TryAcquire(ctx, "job", time.Minute); !errors.Is(err, lock.ErrNotAcquired) {
+		t.Errorf("TryAcquire() after renewal error = %v, want ErrNotAcquired", err)
+	}
+	if err := held.Release(ctx); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if server.Exists("job") {
+		t.Error("lock key still exists after Release()")
+	}
+}
+
+func TestLock_ExpiredOwnerCannotRenewOrRelease(t *testing.T) {
+	ctx := context.Background()
+	server, client := testutil.NewRedis(t)
+	locker := lock.NewLocker(client, "")
+
+	stale, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil {
+		t.Fatalf("TryAcquire() error = %v", err)
+	}
+	server.FastForward(time.Minute)
+	current, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil {
+		t.Fatalf("TryAcquire() after expiry error = %v", err)
+	}
+
+	// 过期的持有者不能释放或续期新持有者的锁
+	if err := stale.Release(ctx); !errors.Is(err, lock.ErrLockLost) {
+		t.Errorf("stale Release() error = %v, want ErrLockLost", err)
+	}
+	if err := stale.Renew(ctx); !errors.Is(err, lock.ErrLockLost) {
+		t.Errorf("stale Renew() error = %v, want ErrLockLost", err)
+	}
+	if _, err := locker.TryAcquire(ctx, "job", time.Minute); !errors.Is(err, lock.ErrNotAcquired) {
+		t.Errorf("TryAcquire() error = %v, want the current owner to keep the lock", err)
+	}
+	if err := current.Release(ctx); err != nil {
+		t.Errorf("current Release() error = %v", err)
+	}
+}
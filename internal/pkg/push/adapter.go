@@ -0,0 +1,56 @@
+package push
+
+// AdaptMessage translates the common PushMessage into the subset a provider supports.
+// It returns a copy in which every field missing from the provider's capabilities is
+// reset to its zero value, so provider-specific fields such as sound, group or level
+// never leak to providers that do not understand them. The original message is not
+// modified, which keeps SendToAll safe across heterogeneous providers.
+func AdaptMessage(capabilities Capabilities, message *PushMessage) *PushMessage {
+	adapted := *message
+
+	if message.Extra != nil {
+		adapted.Extra = make(map[string]string, len(message.Extra))
+		for k, v := range message.Extra {
+			adapted.Extra[k] = v
+		}
+	}
+
+	if !capabilities.SupportsField(FieldTitle) {
+		adapted.Title = ""
+	}
+	if !capabilities.SupportsField(FieldSubtitle) {
+		adapted.Subtitle = ""
+	}
+	if !capabilities.SupportsField(FieldBadge) {
+		adapted.Badge = 0
+	}
+	if !capabilities.SupportsField(FieldSound) {
+		adapted.Sound = ""
+	}
+	if !capabilities.SupportsField(FieldIcon) {
+		adapted.Icon = ""
+	}
+	if !capabilities.SupportsField(FieldImage) {
+		adapted.Image = ""
+	}
+	if !capabilities.SupportsField(FieldGroup) {
+		adapted.Group = ""
+	}
+	if !capabilities.SupportsField(FieldURL) {
+		adapted.URL = ""
+	}
+	if !capabilities.SupportsField(FieldLevel) || capabilities.Levels.Map(message.Level) == "" {
+		adapted.Level = ""
+	}
+	if !capabilities.SupportsField(FieldCall) {
+		adapted.Call = false
+	}
+	if !capabilities.SupportsField(FieldAutoCopy) {
+		adapted.AutoCopy = false
+	}
+	if !capabilities.SupportsField(FieldCopy) {
+		adapted.Copy = ""
+	}
+
+	return &adapted
+}
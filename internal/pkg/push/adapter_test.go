@@ -0,0 +1,29 @@
+package push_test
+
+import (
+	"testing"
+
+	"nebula-live/internal/pkg/push"
+)
+
+func TestAdaptMessage_ImageOnlyForBark(t *testing.T) {
+	message := &push.PushMessage{
+		DeviceID: "alice@example.com",
+		Title:    "开播提醒",
+		Body:     "主播开播了",
+		Image:    "https://example.com/cover.jpg",
+	}
+
+	bark := push.AdaptMessage(push.NewBarkProvider(nil, push.BarkConfig{}).Capabilities(), message)
+	if bark.Image != message.Image {
+		t.Errorf("bark image = %q, want %q", bark.Image, message.Image)
+	}
+
+	email := push.AdaptMessage(push.NewEmailProvider(push.SMTPConfig{}).Capabilities(), message)
+	if email.Image != "" {
+		t.Errorf("email image = %q, want it dropped", email.Image)
+	}
+	if message.Image == "" {
+		t.Error("AdaptMessage modified the original message")
+	}
+}
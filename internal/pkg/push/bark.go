@@ -3,23 +3,30 @@ package push
 import (
 	"context"
 	"fmt"
+	"strings"
 	"nebula-live/pkg/logger"
 
 	"go.uber.org/zap"
 	"resty.dev/v3"
 )
 
+// DefaultBarkBaseURL is the public Bark server used when no server is configured
+const DefaultBarkBaseURL = "https://api.day.app"
+
 // Bark provider implementation
 type barkProvider struct {
-	client  *resty.Client
-	baseURL string
-	enabled bool
+	client             *resty.Client
+	baseURL            string
+	enabled            bool
+	captureRawResponse bool
 }
 
 // BarkConfig holds the configuration for Bark provider
 type BarkConfig struct {
 	BaseURL string `mapstructure:"base_url"`
 	Enabled bool   `mapstructure:"enabled"`
+	// CaptureRawResponse keeps the response body in PushResponse.RawResponse for debugging
+	CaptureRawResponse bool `mapstructure:"capture_raw_response"`
 }
 
 // barkRequest represents the Bark API request payload
@@ -30,6 +37,7 @@ type barkRequest struct {
 	Badge    int    `json:"badge,omitempty"`
 	Sound    string `json:"sound,omitempty"`
 	Icon     string `json:"icon,omitempty"`
+	Image    string `json:"image,omitempty"`
 	Group    string `json:"group,omitempty"`
 	URL      string `json:"url,omitempty"`
 	Level    string `json:"level,omitempty"`
@@ -49,13 +57,14 @@ type barkResponse struct {
 func NewBarkProvider(client *resty.Client, config BarkConfig) Provider {
 	baseURL := config.BaseURL
 	if baseURL == "" {
-		baseURL = "https://api.day.app" // Default Bark server
+		baseURL = DefaultBarkBaseURL
 	}
 
 	return &barkProvider{
-		client:  client,
-		baseURL: baseURL,
-		enabled: config.Enabled,
+		client:             client,
+		baseURL:            baseURL,
+		enabled:            config.Enabled,
+		captureRawResponse: config.CaptureRawResponse,
 	}
 }
 
@@ -69,6 +78,56 @@ func (b *barkProvider) IsEnabled() bool {
 	return b.enabled
 }
 
+// Capabilities returns the fields and settings supported by Bark
+func (b *barkProvider) Capabilities() Capabilities {
+	return Capabilities{
+		Name:        b.GetProviderName(),
+		DisplayName: "Bark",
+		Description: "iOS Bark push notification service",
+		Platform:    "ios",
+		Fields: []string{
+			FieldTitle, FieldSubtitle, FieldBody, FieldBadge, FieldSound, FieldIcon,
+			FieldImage, FieldGroup, FieldURL, FieldLevel, FieldCall, FieldAutoCopy, FieldCopy,
+		},
+		Settings: []SettingField{
+			{Name: "base_url", Type: SettingTypeURL, Description: "Custom Bark server URL (optional)"},
+			{Name: "sound", Type: SettingTypeString, Description: "Notification sound (optional)"},
+			{Name: "icon", Type: SettingTypeURL, Description: "Notification icon URL (optional)"},
+			{Name: "group", Type: SettingTypeString, Description: "Notification group (optional)"},
+			{
+				Name:        "level",
+				Type:        SettingTypeEnum,
+				Description: "Notification level: active, critical, timeSensitive, passive (optional)",
+				Options: []string{
+					string(PushLevelActive), string(PushLevelCritical),
+					string(PushLevelTimeSensitive), string(PushLevelPassive),
+				},
+			},
+			{Name: "auto_copy", Type: SettingTypeBool, Description: "Auto copy message to clipboard (optional)"},
+			{Name: "call", Type: SettingTypeBool, Description: "Ring for 30 seconds (optional)"},
+		},
+		Levels: barkLevels,
+	}
+}
+
+// CheckHealth checks whether the Bark server is reachable.
+// Any HTTP response below 500 means the server is up; retries are disabled to fail fast.
+func (b *barkProvider) CheckHealth(ctx context.Context) error {
+	resp, err := b.client.R().
+		SetContext(ctx).
+		SetRetryCount(0).
+		Head(b.baseURL)
+	if err != nil {
+		return fmt.Errorf("bark server unreachable: %w", err)
+	}
+
+	if resp.StatusCode() >= 500 {
+		return fmt.Errorf("bark server returned status code: %d", resp.StatusCode())
+	}
+
+	return nil
+}
+
 // ValidateMessage validates the message for Bark provider
 func (b *barkProvider) ValidateMessage(message *PushMessage) error {
 	if message.DeviceID == "" {
@@ -77,6 +136,11 @@ func (b *barkProvider) ValidateMessage(message *PushMessage) error {
 	if message.Body == "" {
 		return ErrEmptyMessage
 	}
+	if message.Image != "" {
+		if err := ValidateImageURL(message.Image); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -98,14 +162,15 @@ func (b *barkProvider) SendMessage(ctx context.Context, message *PushMessage) (*
 		Badge:    message.Badge,
 		Sound:    message.Sound,
 		Icon:     message.Icon,
+		Image:    message.Image,
 		Group:    message.Group,
 		URL:      message.URL,
+		// Copy is what the user gets when copying the notification, with or without autoCopy
+		Copy: message.Copy,
 	}
 
-	// Convert level to string
-	if message.Level != "" {
-		barkReq.Level = string(message.Level)
-	}
+	// Translate the common level to the Bark interruption level
+	barkReq.Level = barkLevels.Map(message.Level)
 
 	// Convert boolean flags to string for Bark API
 	if message.Call {
@@ -113,7 +178,6 @@ func (b *barkProvider) SendMessage(ctx context.Context, message *PushMessage) (*
 	}
 	if message.AutoCopy {
 		barkReq.AutoCopy = "1"
-		barkReq.Copy = message.Copy
 	}
 
 	// Build the API endpoint
@@ -121,8 +185,8 @@ func (b *barkProvider) SendMessage(ctx context.Context, message *PushMessage) (*
 	
 	// Log the request for debugging
 	logger.Debug("Sending Bark notification",
-		zap.String("endpoint", endpoint),
-		zap.String("device_id", message.DeviceID),
+		zap.String("base_url", b.baseURL),
+		zap.String("device_id", MaskDeviceID(message.DeviceID)),
 		zap.String("title", message.Title),
 		zap.String("body", message.Body))
 
@@ -136,12 +200,15 @@ func (b *barkProvider) SendMessage(ctx context.Context, message *PushMessage) (*
 		Post(endpoint)
 
 	if err != nil {
+		// the transport error quotes the request URL, which ends with the device key
+		errText := strings.ReplaceAll(err.Error(), message.DeviceID, MaskDeviceID(message.DeviceID))
 		logger.Error("Failed to send Bark notification", 
-			zap.String("endpoint", endpoint),
-			zap.Error(err))
+			zap.String("base_url", b.baseURL),
+			zap.String("device_id", MaskDeviceID(message.DeviceID)),
+			zap.String("error", errText))
 		return &PushResponse{
 			Success:  false,
-			Error:    fmt.Sprintf("failed to send bark notification: %v", err),
+			Error:    "failed to send bark notification: " + errText,
 			Provider: b.GetProviderName(),
 		}, nil
 	}
@@ -153,29 +220,38 @@ func (b *barkProvider) SendMessage(ctx context.Context, message *PushMessage) (*
 		zap.Int("bark_code", barkResp.Code),
 		zap.String("bark_message", barkResp.Message))
 
+	pushResp := &PushResponse{
+		Provider:   b.GetProviderName(),
+		StatusCode: resp.StatusCode(),
+	}
+	if b.captureRawResponse {
+		pushResp.RawResponse = truncateRawResponse(resp.String())
+	}
+
 	if resp.StatusCode() != 200 {
 		logger.Error("Bark API returned non-200 status", 
 			zap.Int("status_code", resp.StatusCode()),
 			zap.String("response_body", resp.String()))
-		return &PushResponse{
-			Success:  false,
-			Error:    fmt.Sprintf("bark API returned status code: %d, response: %s", resp.StatusCode(), resp.String()),
-			Provider: b.GetProviderName(),
-		}, nil
+		// The body may leak upstream details, it is only exposed through RawResponse when capturing is enabled
+		pushResp.Error = fmt.Sprintf("bark API returned status code: %d", resp.StatusCode())
+		pushResp.DeviceRejected = isBarkDeviceRejection(resp.StatusCode())
+		return pushResp, nil
 	}
 
 	// Check Bark response code
 	if barkResp.Code != 200 {
-		return &PushResponse{
-			Success:  false,
-			Error:    fmt.Sprintf("bark API error: %s (code: %d)", barkResp.Message, barkResp.Code),
-			Provider: b.GetProviderName(),
-		}, nil
+		pushResp.Error = fmt.Sprintf("bark API error: %s (code: %d)", barkResp.Message, barkResp.Code)
+		pushResp.DeviceRejected = isBarkDeviceRejection(barkResp.Code)
+		return pushResp, nil
 	}
 
-	return &PushResponse{
-		Success:   true,
-		MessageID: fmt.Sprintf("%d", barkResp.Timestamp),
-		Provider:  b.GetProviderName(),
-	}, nil
+	pushResp.Success = true
+	pushResp.MessageID = fmt.Sprintf("%d", barkResp.Timestamp)
+	return pushResp, nil
+}
+
+// isBarkDeviceRejection reports whether a Bark status refuses the device itself. The Bark server
+// answers 4xx for an unknown or invalid device key; 408 and 429 are temporary and 5xx is an outage.
+func isBarkDeviceRejection(code int) bool {
+	return code >= 400 && code < 500 && code != 408 && code != 429
 }
@@ -0,0 +1,77 @@
+package push_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/pkg/push"
+	"nebula-live/internal/testutil"
+
+	"resty.dev/v3"
+)
+
+func TestBarkProvider_ErrorStatusKeepsBodyOutOfError(t *testing.T) {
+	testutil.InitLogger()
+	const body = `{"code":400,"message":"failed to get device token: internal detail"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	for _, capture := range []bool{false, true} {
+		client := resty.New()
+		t.Cleanup(func() { client.Close() })
+		provider := push.NewBarkProvider(client, push.BarkConfig{Enabled: true, BaseURL: server.URL, CaptureRawResponse: capture})
+
+		resp, err := provider.SendMessage(context.Background(), &push.PushMessage{DeviceID: "device-key", Body: "内容"})
+		if err != nil {
+			t.Fatalf("capture=%v: SendMessage() error = %v", capture, err)
+		}
+		if resp.Success || !resp.DeviceRejected {
+			t.Errorf("capture=%v: success = %v, device rejected = %v; want a rejected device", capture, resp.Success, resp.DeviceRejected)
+		}
+		if resp.Error != "bark API returned status code: 400" {
+			t.Errorf("capture=%v: error = %q, want only the status code", capture, resp.Error)
+		}
+		if got := strings.Contains(resp.RawResponse, "internal detail"); got != capture {
+			t.Errorf("capture=%v: raw response = %q", capture, resp.RawResponse)
+		}
+	}
+}
+
+// roundTripFunc records requests without touching the network
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestBarkProvider_DefaultServer(t *testing.T) {
+	testutil.InitLogger()
+	var requested string
+	client := resty.New().SetTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL.String()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"code":200,"message":"success"}`)),
+			Request:    req,
+		}, nil
+	}))
+	t.Cleanup(func() { client.Close() })
+
+	// Without a configured server the public Bark server is used
+	provider := push.NewBarkProvider(client, push.BarkConfig{Enabled: true})
+	if _, err := provider.SendMessage(context.Background(), &push.PushMessage{DeviceID: "device-key", Body: "内容"}); err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+	if want := push.DefaultBarkBaseURL + "/device-key"; requested != want {
+		t.Errorf("requested %q, want %q", requested, want)
+	}
+}
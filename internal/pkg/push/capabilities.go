@@ -0,0 +1,60 @@
+package push
+
+// Message fields that a provider may support, named after the PushMessage JSON keys
+const (
+	FieldTitle    = "title"
+	FieldSubtitle = "subtitle"
+	FieldBody     = "body"
+	FieldBadge    = "badge"
+	FieldSound    = "sound"
+	FieldIcon     = "icon"
+	FieldImage    = "image"
+	FieldGroup    = "group"
+	FieldURL      = "url"
+	FieldLevel    = "level"
+	FieldCall     = "call"
+	FieldAutoCopy = "auto_copy"
+	FieldCopy     = "copy"
+)
+
+// Setting field types
+const (
+	SettingTypeString = "string"
+	SettingTypeBool   = "bool"
+	SettingTypeURL    = "url"
+	SettingTypeEnum   = "enum"
+)
+
+// SettingField describes a provider-specific setting a user can configure on a device
+type SettingField struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required"`
+	Options     []string `json:"options,omitempty"`
+	MaxLength   int      `json:"max_length,omitempty"`
+}
+
+// Capabilities describes what a provider supports
+type Capabilities struct {
+	Name        string         `json:"name"`
+	DisplayName string         `json:"display_name"`
+	Description string         `json:"description"`
+	Platform    string         `json:"platform"`
+	Fields      []string       `json:"fields"`
+	Settings    []SettingField `json:"settings"`
+	// Levels translates the common levels into the provider's own scheme, set when FieldLevel is supported
+	Levels LevelMapping `json:"levels,omitempty"`
+	// Enabled is filled in by the client from the provider's configuration
+	Enabled bool `json:"enabled"`
+}
+
+// SupportsField reports whether the provider honors the given message field
+func (c Capabilities) SupportsField(field string) bool {
+	for _, f := range c.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -3,13 +3,19 @@ package push
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
+	"nebula-live/internal/pkg/httpproxy"
+
 	"resty.dev/v3"
 )
 
-// Client provides a unified interface for push notification providers
+// Client provides a unified interface for push notification providers.
+// It is safe for concurrent use, providers may be registered while messages are being sent.
 type Client struct {
+	mu         sync.RWMutex
 	providers  map[string]Provider
 	httpClient *resty.Client
 }
@@ -17,6 +23,10 @@ type Client struct {
 // ClientConfig holds the configuration for all push providers
 type ClientConfig struct {
 	Bark BarkConfig `mapstructure:"bark"`
+	// Email configures the SMTP server of the email provider
+	Email SMTPConfig `mapstructure:"email"`
+	// Proxy routes outbound requests through an upstream proxy when set
+	Proxy httpproxy.Config `mapstructure:"proxy"`
 }
 
 // NewClient creates a new push notification client
@@ -26,6 +36,10 @@ func NewClient(config ClientConfig) *Client {
 	httpClient.SetRetryCount(3)
 	httpClient.SetRetryWaitTime(1 * time.Second)
 
+	if err := httpproxy.Apply(httpClient, config.Proxy); err != nil {
+		httpClient.Logger().Errorf("failed to configure proxy: %v", err)
+	}
+
 	client := &Client{
 		providers:  make(map[string]Provider),
 		httpClient: httpClient,
@@ -33,18 +47,41 @@ func NewClient(config ClientConfig) *Client {
 
 	// Register providers
 	client.RegisterProvider(NewBarkProvider(httpClient, config.Bark))
+	client.RegisterProvider(NewEmailProvider(config.Email))
 
 	return client
 }
 
 // RegisterProvider registers a new push notification provider
 func (c *Client) RegisterProvider(provider Provider) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.providers[provider.GetProviderName()] = provider
 }
 
+// getProvider looks up a registered provider by name
+func (c *Client) getProvider(providerName string) (Provider, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	provider, exists := c.providers[providerName]
+	return provider, exists
+}
+
+// snapshotProviders returns the registered providers, so callers can iterate
+// them without holding the lock during slow operations such as sending
+func (c *Client) snapshotProviders() map[string]Provider {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	providers := make(map[string]Provider, len(c.providers))
+	for name, provider := range c.providers {
+		providers[name] = provider
+	}
+	return providers
+}
+
 // SendMessage sends a push notification via the specified provider
 func (c *Client) SendMessage(ctx context.Context, providerName string, message *PushMessage) (*PushResponse, error) {
-	provider, exists := c.providers[providerName]
+	provider, exists := c.getProvider(providerName)
 	if !exists {
 		return nil, ErrProviderNotFound
 	}
@@ -53,7 +90,7 @@ func (c *Client) SendMessage(ctx context.Context, providerName string, message *
 		return nil, ErrProviderNotEnabled
 	}
 
-	return provider.SendMessage(ctx, message)
+	return provider.SendMessage(ctx, AdaptMessage(provider.Capabilities(), message))
 }
 
 // SendToAll sends a push notification to all enabled providers
@@ -61,14 +98,13 @@ func (c *Client) SendToAll(ctx context.Context, message *PushMessage) ([]*PushRe
 	var responses []*PushResponse
 	var lastError error
 
-	for _, provider := range c.providers {
+	for _, provider := range c.snapshotProviders() {
 		if !provider.IsEnabled() {
 			continue
 		}
 
-		// Create a copy of the message for each provider
-		msgCopy := *message
-		resp, err := provider.SendMessage(ctx, &msgCopy)
+		// Each provider gets its own adapted copy so fields never leak between providers
+		resp, err := provider.SendMessage(ctx, AdaptMessage(provider.Capabilities(), message))
 		if err != nil {
 			lastError = err
 			// Create error response if provider returned an error
@@ -96,6 +132,8 @@ func (c *Client) SendToAll(ctx context.Context, message *PushMessage) ([]*PushRe
 
 // GetSupportedProviders returns a list of supported providers
 func (c *Client) GetSupportedProviders() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	providers := make([]string, 0, len(c.providers))
 	for name := range c.providers {
 		providers = append(providers, name)
@@ -103,10 +141,36 @@ func (c *Client) GetSupportedProviders() []string {
 	return providers
 }
 
+// GetProviderCapabilities returns the capabilities of all registered providers, sorted by name
+func (c *Client) GetProviderCapabilities() []Capabilities {
+	providers := c.snapshotProviders()
+	capabilities := make([]Capabilities, 0, len(providers))
+	for _, provider := range providers {
+		capability := provider.Capabilities()
+		capability.Enabled = provider.IsEnabled()
+		capabilities = append(capabilities, capability)
+	}
+	sort.Slice(capabilities, func(i, j int) bool {
+		return capabilities[i].Name < capabilities[j].Name
+	})
+	return capabilities
+}
+
+// GetProviderCapability returns the capabilities of a registered provider
+func (c *Client) GetProviderCapability(providerName string) (Capabilities, bool) {
+	provider, exists := c.getProvider(providerName)
+	if !exists {
+		return Capabilities{}, false
+	}
+	capability := provider.Capabilities()
+	capability.Enabled = provider.IsEnabled()
+	return capability, true
+}
+
 // GetEnabledProviders returns a list of enabled providers
 func (c *Client) GetEnabledProviders() []string {
 	var providers []string
-	for name, provider := range c.providers {
+	for name, provider := range c.snapshotProviders() {
 		if provider.IsEnabled() {
 			providers = append(providers, name)
 		}
@@ -116,7 +180,7 @@ func (c *Client) GetEnabledProviders() []string {
 
 // IsProviderEnabled checks if a specific provider is enabled
 func (c *Client) IsProviderEnabled(providerName string) bool {
-	provider, exists := c.providers[providerName]
+	provider, exists := c.getProvider(providerName)
 	if !exists {
 		return false
 	}
@@ -0,0 +1,163 @@
+package push_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"nebula-live/internal/pkg/push"
+	"nebula-live/internal/testutil"
+)
+
+// TestClient_ConcurrentRegisterAndSend registers providers while others look them up and send.
+// It only detects data races when run with -race (make test-race).
+func TestClient_ConcurrentRegisterAndSend(t *testing.T) {
+	ctx := context.Background()
+	client := push.NewClient(push.ClientConfig{})
+	base := testutil.NewFakePushProvider("fake")
+	client.RegisterProvider(base)
+
+	const workers = 8
+	const iterations = 50
+	message := &push.PushMessage{Title: "title", Body: "body", DeviceID: "device-key"}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				client.RegisterProvider(testutil.NewFakePushProvider(fmt.Sprintf("fake-%d-%d", w, i)))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				client.GetSupportedProviders()
+				client.GetEnabledProviders()
+				client.GetProviderCapabilities()
+				client.GetProviderCapability("fake")
+				client.IsProviderEnabled("fake")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				if _, err := client.SendMessage(ctx, "fake", message); err != nil {
+					t.Errorf("SendMessage() error = %v", err)
+					return
+				}
+				if _, err := client.SendToAll(ctx, message); err != nil {
+					t.Errorf("SendToAll() error = %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// bark and email are registered by NewClient
+	if got, want := len(client.GetSupportedProviders()), 3+workers*iterations; got != want {
+		t.Errorf("providers = %d, want %d", got, want)
+	}
+	// Every iteration reaches the base provider through both SendMessage and SendToAll
+	if got, want := base.Calls(), workers*iterations*2; got != want {
+		t.Errorf("base provider calls = %d, want %d", got, want)
+	}
+}
+
+func TestClient_ProviderCapabilitiesIncludeRegisteredProviders(t *testing.T) {
+	client := push.NewClient(push.ClientConfig{Bark: push.BarkConfig{Enabled: true}})
+
+	names := func() []string {
+		var names []string
+		for _, capability := range client.GetProviderCapabilities() {
+			names = append(names, capability.Name)
+		}
+		return names
+	}
+	if got := strings.Join(names(), ","); got != "bark,email" {
+		t.Fatalf("capabilities = %s, want bark,email", got)
+	}
+	bark, ok := client.GetProviderCapability("bark")
+	if !ok || !bark.Enabled || !bark.SupportsField(push.FieldImage) || bark.Levels.Map(push.PushLevelCritical) != "critical" {
+		t.Errorf("bark capability = %+v, want enabled with image and level support", bark)
+	}
+
+	// Newly registered providers show up automatically, with the enabled state taken from the provider
+	disabled := testutil.NewFakePushProvider("aaa")
+	disabled.Enabled = false
+	client.RegisterProvider(disabled)
+	client.RegisterProvider(testutil.NewFakePushProvider("webhook"))
+	if got := strings.Join(names(), ","); got != "aaa,bark,email,webhook" {
+		t.Errorf("capabilities = %s, want aaa,bark,email,webhook", got)
+	}
+	if capability, ok := client.GetProviderCapability("aaa"); !ok || capability.Enabled {
+		t.Errorf("aaa capability = %+v, %v; want registered and disabled", capability, ok)
+	}
+	if _, ok := client.GetProviderCapability("unknown"); ok {
+		t.Error("GetProviderCapability(unknown) found a provider")
+	}
+}
+
+// Bark-only fields reach Bark but are dropped for a provider that does not declare them
+func TestClient_SendToAllAdaptsMessagePerProvider(t *testing.T) {
+	testutil.InitLogger()
+	var (
+		mu      sync.Mutex
+		payload map[string]any
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"code":200,"message":"success","timestamp":1700000000}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := push.NewClient(push.ClientConfig{Bark: push.BarkConfig{Enabled: true, BaseURL: server.URL}})
+	webhook := testutil.NewFakePushProvider("webhook")
+	client.RegisterProvider(webhook)
+
+	message := &push.PushMessage{
+		DeviceID: "device-key",
+		Title:    "开播提醒",
+		Body:     "主播开播了",
+		Sound:    "alarm",
+		Group:    "live",
+		Level:    push.PushLevelTimeSensitive,
+	}
+	responses, err := client.SendToAll(context.Background(), message)
+	if err != nil {
+		t.Fatalf("SendToAll() error = %v", err)
+	}
+	for _, resp := range responses {
+		// The email provider is registered but disabled without SMTP settings
+		if resp.Provider != "email" && !resp.Success {
+			t.Errorf("%s response = %+v, want success", resp.Provider, resp)
+		}
+	}
+
+	mu.Lock()
+	if payload["sound"] != "alarm" || payload["group"] != "live" || payload["level"] != "timeSensitive" {
+		t.Errorf("bark payload = %v, want sound, group and level", payload)
+	}
+	mu.Unlock()
+
+	received := webhook.Messages()
+	if len(received) != 1 {
+		t.Fatalf("webhook received %d messages, want 1", len(received))
+	}
+	if got := received[0]; got.Sound != "" || got.Group != "" || got.Level != "" || got.Title != "开播提醒" || got.Body != "主播开播了" {
+		t.Errorf("webhook message = %+v, want title and body without Bark-only fields", got)
+	}
+	if message.Sound != "alarm" || message.Level != push.PushLevelTimeSensitive {
+		t.Error("SendToAll modified the caller's message")
+	}
+}
@@ -0,0 +1,370 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// SMTP TLS modes
+const (
+	// SMTPTLSStartTLS upgrades a plain connection with STARTTLS and fails when the server does not offer it
+	SMTPTLSStartTLS = "starttls"
+	// SMTPTLSImplicit connects over TLS from the start, usually on port 465
+	SMTPTLSImplicit = "tls"
+	// SMTPTLSNone sends in plain text, only meant for local relays and testing
+	SMTPTLSNone = "none"
+)
+
+// defaultEmailSubject is used for messages without a title
+const defaultEmailSubject = "Notification"
+
+// ErrInvalidEmailAddress is returned for a recipient that is not a plain email address
+var ErrInvalidEmailAddress = errors.New("invalid email address")
+
+// SMTPConfig holds the configuration for the email provider
+type SMTPConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// From is the sender address, optionally with a display name, e.g. "Nebula Live <noreply@example.com>"
+	From string `mapstructure:"from"`
+	// TLS is one of SMTPTLSStartTLS (default), SMTPTLSImplicit or SMTPTLSNone
+	TLS string `mapstructure:"tls"`
+}
+
+// IsValidSMTPTLSMode reports whether mode is a known TLS mode, empty means SMTPTLSStartTLS
+func IsValidSMTPTLSMode(mode string) bool {
+	switch mode {
+	case "", SMTPTLSStartTLS, SMTPTLSImplicit, SMTPTLSNone:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateEmailAddress checks that address is a bare email address such as "user@example.com",
+// display names and angle brackets are rejected since the device ID is the recipient
+func ValidateEmailAddress(address string) error {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil || parsed.Address != address || parsed.Name != "" {
+		return ErrInvalidEmailAddress
+	}
+	return nil
+}
+
+// Email provider implementation, the device ID of a message is the recipient address
+type emailProvider struct {
+	config SMTPConfig
+}
+
+// NewEmailProvider creates a new email provider that delivers messages over SMTP
+func NewEmailProvider(config SMTPConfig) Provider {
+	if config.TLS == "" {
+		config.TLS = SMTPTLSStartTLS
+	}
+	return &emailProvider{config: config}
+}
+
+// GetProviderName returns the provider name
+func (e *emailProvider) GetProviderName() string {
+	return "email"
+}
+
+// IsEnabled returns whether the provider is enabled, it needs an SMTP server and a sender
+func (e *emailProvider) IsEnabled() bool {
+	return e.config.Enabled && e.config.Host != "" && e.config.From != ""
+}
+
+// Capabilities returns the fields and settings supported by email
+func (e *emailProvider) Capabilities() Capabilities {
+	return Capabilities{
+		Name:        e.GetProviderName(),
+		DisplayName: "Email",
+		Description: "Email notifications delivered over SMTP, the device ID is the recipient address",
+		Platform:    "email",
+		Fields:      []string{FieldTitle, FieldBody, FieldURL},
+		Settings:    []SettingField{},
+	}
+}
+
+// CheckHealth checks whether the SMTP server accepts connections and greets
+func (e *emailProvider) CheckHealth(ctx context.Context) error {
+	client, release, err := e.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("smtp server unreachable: %w", err)
+	}
+	defer release()
+	return client.Quit()
+}
+
+// ValidateMessage validates the message for email provider
+func (e *emailProvider) ValidateMessage(message *PushMessage) error {
+	if message.DeviceID == "" {
+		return ErrInvalidDeviceID
+	}
+	if err := ValidateEmailAddress(message.DeviceID); err != nil {
+		return err
+	}
+	if message.Body == "" {
+		return ErrEmptyMessage
+	}
+	return nil
+}
+
+// SendMessage sends the message as an email with a plain text and an HTML part
+func (e *emailProvider) SendMessage(ctx context.Context, message *PushMessage) (*PushResponse, error) {
+	if !e.IsEnabled() {
+		return nil, ErrProviderNotEnabled
+	}
+
+	if err := e.ValidateMessage(message); err != nil {
+		return nil, err
+	}
+
+	from, err := mail.ParseAddress(e.config.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", e.config.From, err)
+	}
+
+	messageID := newEmailMessageID(from.Address)
+	content, err := buildEmail(from, message, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("Sending email notification",
+		zap.String("host", e.config.Host),
+		zap.String("recipient", MaskDeviceID(message.DeviceID)),
+		zap.String("title", message.Title))
+
+	if err := e.deliver(ctx, from.Address, message.DeviceID, content); err != nil {
+		logger.Error("Failed to send email notification",
+			zap.String("host", e.config.Host),
+			zap.Error(err))
+		pushResp := &PushResponse{
+			Success:  false,
+			Error:    fmt.Sprintf("failed to send email notification: %v", err),
+			Provider: e.GetProviderName(),
+		}
+		// The SMTP reply of a server that rejected the message is its status code
+		var reply *textproto.Error
+		if errors.As(err, &reply) {
+			pushResp.StatusCode = reply.Code
+			pushResp.DeviceRejected = isMailboxRejection(reply.Code)
+		}
+		return pushResp, nil
+	}
+
+	return &PushResponse{
+		Success:   true,
+		MessageID: messageID,
+		Provider:  e.GetProviderName(),
+	}, nil
+}
+
+// deliver runs one SMTP transaction for a single recipient
+func (e *emailProvider) deliver(ctx context.Context, from, to string, content []byte) error {
+	client, release, err := e.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if e.config.Username != "" {
+		auth := smtp.PlainAuth("", e.config.Username, e.config.Password, e.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return fmt.Errorf("smtp MAIL FROM: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp RCPT TO: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	if _, err := writer.Write(content); err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// dial connects to the SMTP server and negotiates TLS according to the configured mode.
+// The connection follows ctx, so a cancelled request does not hang on a slow server;
+// release closes the connection and must be called once the client is no longer used.
+func (e *emailProvider) dial(ctx context.Context) (client *smtp.Client, release func(), err error) {
+	port := e.config.Port
+	if port == 0 {
+		port = 587
+		if e.config.TLS == SMTPTLSImplicit {
+			port = 465
+		}
+	}
+	addr := net.JoinHostPort(e.config.Host, strconv.Itoa(port))
+	tlsConfig := &tls.Config{ServerName: e.config.Host}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	var conn net.Conn
+	if e.config.TLS == SMTPTLSImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	// Closing the connection on cancellation aborts a transaction that is in progress
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	release = func() {
+		stop()
+		conn.Close()
+	}
+
+	client, err = smtp.NewClient(conn, e.config.Host)
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+
+	if e.config.TLS == SMTPTLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			release()
+			return nil, nil, errors.New("smtp server does not support STARTTLS")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			release()
+			return nil, nil, fmt.Errorf("smtp STARTTLS: %w", err)
+		}
+	}
+
+	return client, release, nil
+}
+
+// emailTemplate renders the HTML part of a notification
+var emailTemplate = template.Must(template.New("email").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; line-height: 1.5;">
+{{- if .Title}}
+<h2>{{.Title}}</h2>
+{{- end}}
+<p style="white-space: pre-wrap;">{{.Body}}</p>
+{{- if .URL}}
+<p><a href="{{.URL}}">{{.URL}}</a></p>
+{{- end}}
+</body>
+</html>
+`))
+
+// buildEmail renders the message as a multipart/alternative email
+func buildEmail(from *mail.Address, message *PushMessage, messageID string) ([]byte, error) {
+	subject := message.Title
+	if subject == "" {
+		subject = defaultEmailSubject
+	}
+
+	var html bytes.Buffer
+	if err := emailTemplate.Execute(&html, message); err != nil {
+		return nil, fmt.Errorf("render email: %w", err)
+	}
+
+	text := message.Body
+	if message.URL != "" {
+		text += "\n\n" + message.URL
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from.String() + "\r\n")
+	buf.WriteString("To: " + message.DeviceID + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	buf.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("Message-ID: " + messageID + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: multipart/alternative; boundary=" + parts.Boundary() + "\r\n")
+	buf.WriteString("\r\n")
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", text},
+		{"text/html; charset=utf-8", html.String()},
+	} {
+		writer, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(writer)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+// newEmailMessageID returns a unique Message-ID in the domain of the sender
+func newEmailMessageID(sender string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(sender, "@"); at >= 0 {
+		domain = sender[at+1:]
+	}
+
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain)
+}
+
+// isMailboxRejection reports whether an SMTP reply permanently refuses the recipient mailbox:
+// 550 mailbox unavailable, 551 user not local and 553 mailbox name not allowed.
+// 4xx replies are temporary and other 5xx replies are problems of the server or the message.
+func isMailboxRejection(code int) bool {
+	return code == 550 || code == 551 || code == 553
+}
@@ -0,0 +1,132 @@
+package push_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/pkg/push"
+	"nebula-live/internal/testutil"
+)
+
+// newTestEmailProvider 创建发往假SMTP服务器的邮件提供商
+func newTestEmailProvider(server *testutil.FakeSMTPServer) push.Provider {
+	return push.NewEmailProvider(push.SMTPConfig{
+		Enabled: true,
+		Host:    server.Host(),
+		Port:    server.Port(),
+		From:    "Nebula Live <noreply@example.com>",
+		TLS:     push.SMTPTLSNone,
+	})
+}
+
+func TestEmailProvider_SendMessage(t *testing.T) {
+	testutil.InitLogger()
+	server := testutil.NewFakeSMTPServer(t)
+	provider := newTestEmailProvider(server)
+
+	resp, err := provider.SendMessage(context.Background(), &push.PushMessage{
+		DeviceID: "alice@example.com",
+		Title:    "开播提醒",
+		Body:     "主播 <六神> 开播了",
+		URL:      "https://live.bilibili.com/5440",
+	})
+	if err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+	if !resp.Success || resp.MessageID == "" {
+		t.Fatalf("response = %+v, want success with a message ID", resp)
+	}
+
+	messages := server.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("server received %d messages, want 1", len(messages))
+	}
+	received := messages[0]
+	if received.From != "noreply@example.com" || len(received.To) != 1 || received.To[0] != "alice@example.com" {
+		t.Errorf("envelope from = %q, to = %v", received.From, received.To)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(received.Data))
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "开播提醒" {
+		t.Errorf("subject = %q, %v; want %q", subject, err, "开播提醒")
+	}
+	if got := msg.Header.Get("Message-ID"); got != resp.MessageID {
+		t.Errorf("Message-ID = %q, want %q", got, resp.MessageID)
+	}
+
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("ParseMediaType() error = %v", err)
+	}
+	parts := make(map[string]string)
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("NextPart() error = %v", err)
+		}
+		mediaType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		content, _ := io.ReadAll(part)
+		parts[mediaType] = string(content)
+	}
+
+	if text := parts["text/plain"]; !strings.Contains(text, "主播 <六神> 开播了") || !strings.Contains(text, "https://live.bilibili.com/5440") {
+		t.Errorf("text part = %q", text)
+	}
+	html := parts["text/html"]
+	if !strings.Contains(html, "<h2>开播提醒</h2>") || !strings.Contains(html, "主播 &lt;六神&gt; 开播了") {
+		t.Errorf("html part = %q, want escaped title and body", html)
+	}
+	if !strings.Contains(html, `href="https://live.bilibili.com/5440"`) {
+		t.Errorf("html part = %q, want link to the url", html)
+	}
+}
+
+func TestEmailProvider_RejectedMailbox(t *testing.T) {
+	testutil.InitLogger()
+	server := testutil.NewFakeSMTPServer(t)
+	server.RejectRecipient("gone@example.com", 550)
+	provider := newTestEmailProvider(server)
+
+	resp, err := provider.SendMessage(context.Background(), &push.PushMessage{
+		DeviceID: "gone@example.com",
+		Title:    "开播提醒",
+		Body:     "主播开播了",
+	})
+	if err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+	if resp.Success || resp.StatusCode != 550 || !resp.DeviceRejected {
+		t.Errorf("response = %+v, want failure with status 550 and device rejected", resp)
+	}
+	if got := len(server.Messages()); got != 0 {
+		t.Errorf("server received %d messages, want 0", got)
+	}
+}
+
+func TestEmailProvider_InvalidRecipient(t *testing.T) {
+	testutil.InitLogger()
+	server := testutil.NewFakeSMTPServer(t)
+	provider := newTestEmailProvider(server)
+
+	for _, recipient := range []string{"not-an-email", "Alice <alice@example.com>"} {
+		_, err := provider.SendMessage(context.Background(), &push.PushMessage{DeviceID: recipient, Body: "hi"})
+		if !errors.Is(err, push.ErrInvalidEmailAddress) {
+			t.Errorf("SendMessage(%q) error = %v, want ErrInvalidEmailAddress", recipient, err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package push
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ProviderHealth represents the reachability of a push provider upstream
+type ProviderHealth struct {
+	Provider  string    `json:"provider"`
+	Reachable bool      `json:"reachable"`
+	LatencyMs int64     `json:"latency_ms"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// CheckHealth checks the reachability of all enabled providers concurrently, sorted by name.
+// Each check is bounded by the given timeout.
+func (c *Client) CheckHealth(ctx context.Context, timeout time.Duration) []ProviderHealth {
+	providers := c.snapshotProviders()
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make([]ProviderHealth, 0, len(providers))
+	)
+
+	for _, provider := range providers {
+		if !provider.IsEnabled() {
+			continue
+		}
+
+		wg.Add(1)
+		go func(provider Provider) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := provider.CheckHealth(checkCtx)
+			health := ProviderHealth{
+				Provider:  provider.GetProviderName(),
+				Reachable: err == nil,
+				LatencyMs: time.Since(start).Milliseconds(),
+				CheckedAt: start,
+			}
+			if err != nil {
+				health.Error = err.Error()
+			}
+
+			mu.Lock()
+			results = append(results, health)
+			mu.Unlock()
+		}(provider)
+	}
+
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Provider < results[j].Provider
+	})
+	return results
+}
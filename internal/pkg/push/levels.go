@@ -0,0 +1,33 @@
+package push
+
+import "strings"
+
+// Levels lists the common notification levels from lowest to highest priority
+var Levels = []PushLevel{PushLevelPassive, PushLevelActive, PushLevelTimeSensitive, PushLevelCritical}
+
+// LevelNames returns the common levels joined for messages, e.g. "passive, active, timeSensitive, critical"
+func LevelNames() string {
+	names := make([]string, len(Levels))
+	for i, level := range Levels {
+		names[i] = string(level)
+	}
+	return strings.Join(names, ", ")
+}
+
+// LevelMapping translates the common PushLevel into a provider's own priority scheme,
+// e.g. Bark interruption levels, ntfy priorities "1"-"5" or FCM "normal"/"high".
+// Every provider that supports FieldLevel declares one in its Capabilities.
+type LevelMapping map[PushLevel]string
+
+// Map returns the provider value of the level, empty for an empty or unmapped level
+func (m LevelMapping) Map(level PushLevel) string {
+	return m[level]
+}
+
+// barkLevels maps the common levels to Bark interruption levels, which share the same names
+var barkLevels = LevelMapping{
+	PushLevelPassive:       "passive",
+	PushLevelActive:        "active",
+	PushLevelTimeSensitive: "timeSensitive",
+	PushLevelCritical:      "critical",
+}
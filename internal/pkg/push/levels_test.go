@@ -0,0 +1,62 @@
+package push_test
+
+import (
+	"testing"
+
+	"nebula-live/internal/pkg/push"
+	"nebula-live/internal/testutil"
+)
+
+func TestAdaptMessage_LevelMappedPerProvider(t *testing.T) {
+	// ntfy风格的提供商只映射部分级别
+	ntfy := push.Capabilities{
+		Name:   "ntfy",
+		Fields: []string{push.FieldBody, push.FieldLevel},
+		Levels: push.LevelMapping{push.PushLevelCritical: "5", push.PushLevelPassive: "1"},
+	}
+	providers := map[string]push.Capabilities{
+		"bark":  push.NewBarkProvider(nil, push.BarkConfig{}).Capabilities(),
+		"email": push.NewEmailProvider(push.SMTPConfig{}).Capabilities(),
+		"fake":  testutil.NewFakePushProvider("fake").Capabilities(),
+		"ntfy":  ntfy,
+	}
+
+	tests := []struct {
+		provider  string
+		level     push.PushLevel
+		wantLevel push.PushLevel
+		wantValue string
+	}{
+		{"bark", push.PushLevelPassive, push.PushLevelPassive, "passive"},
+		{"bark", push.PushLevelActive, push.PushLevelActive, "active"},
+		{"bark", push.PushLevelTimeSensitive, push.PushLevelTimeSensitive, "timeSensitive"},
+		{"bark", push.PushLevelCritical, push.PushLevelCritical, "critical"},
+		{"email", push.PushLevelCritical, "", ""},
+		{"fake", push.PushLevelTimeSensitive, "", ""},
+		{"ntfy", push.PushLevelCritical, push.PushLevelCritical, "5"},
+		{"ntfy", push.PushLevelActive, "", ""}, // 未映射的级别不下发
+	}
+	for _, tt := range tests {
+		capabilities := providers[tt.provider]
+		adapted := push.AdaptMessage(capabilities, &push.PushMessage{Body: "内容", Level: tt.level})
+		if adapted.Level != tt.wantLevel {
+			t.Errorf("%s level %q adapted to %q, want %q", tt.provider, tt.level, adapted.Level, tt.wantLevel)
+		}
+		if got := capabilities.Levels.Map(adapted.Level); got != tt.wantValue {
+			t.Errorf("%s level %q maps to %q, want %q", tt.provider, tt.level, got, tt.wantValue)
+		}
+	}
+}
+
+func TestPushLevel_IsValid(t *testing.T) {
+	for _, level := range push.Levels {
+		if !level.IsValid() {
+			t.Errorf("%q.IsValid() = false, want true", level)
+		}
+	}
+	for _, level := range []push.PushLevel{"urgent", "Critical", "high"} {
+		if level.IsValid() {
+			t.Errorf("%q.IsValid() = true, want false", level)
+		}
+	}
+}
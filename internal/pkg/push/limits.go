@@ -0,0 +1,79 @@
+package push
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// LengthPolicy decides what happens to a message that exceeds a provider's length limits
+type LengthPolicy string
+
+const (
+	// LengthPolicyTruncate shortens the title and body to the limit, ending them with an ellipsis
+	LengthPolicyTruncate LengthPolicy = "truncate"
+	// LengthPolicyReject fails the send with ErrMessageTooLong
+	LengthPolicyReject LengthPolicy = "reject"
+)
+
+// ellipsis marks truncated text, it counts towards the limit
+const ellipsis = "…"
+
+// ErrMessageTooLong is returned by LengthLimits.Apply under LengthPolicyReject
+var ErrMessageTooLong = errors.New("message exceeds provider length limit")
+
+// IsValid reports whether the policy is known, empty means LengthPolicyTruncate
+func (p LengthPolicy) IsValid() bool {
+	switch p {
+	case "", LengthPolicyTruncate, LengthPolicyReject:
+		return true
+	default:
+		return false
+	}
+}
+
+// LengthLimits caps the title and body of messages sent through one provider.
+// Lengths are counted in characters (runes), 0 means unlimited.
+type LengthLimits struct {
+	MaxTitle int
+	MaxBody  int
+	Policy   LengthPolicy
+}
+
+// Apply enforces the limits on message in place, truncating or returning ErrMessageTooLong by policy
+func (l LengthLimits) Apply(message *PushMessage) error {
+	titleOver := exceeds(message.Title, l.MaxTitle)
+	bodyOver := exceeds(message.Body, l.MaxBody)
+	if !titleOver && !bodyOver {
+		return nil
+	}
+
+	if l.Policy == LengthPolicyReject {
+		if titleOver {
+			return fmt.Errorf("%w: title longer than %d characters", ErrMessageTooLong, l.MaxTitle)
+		}
+		return fmt.Errorf("%w: body longer than %d characters", ErrMessageTooLong, l.MaxBody)
+	}
+
+	if titleOver {
+		message.Title = truncate(message.Title, l.MaxTitle)
+	}
+	if bodyOver {
+		message.Body = truncate(message.Body, l.MaxBody)
+	}
+	return nil
+}
+
+// exceeds reports whether text is longer than max characters, max 0 means unlimited
+func exceeds(text string, max int) bool {
+	return max > 0 && utf8.RuneCountInString(text) > max
+}
+
+// truncate shortens text to max characters including the trailing ellipsis
+func truncate(text string, max int) string {
+	keep := max - utf8.RuneCountInString(ellipsis)
+	if keep < 1 {
+		return string([]rune(text)[:max])
+	}
+	return string([]rune(text)[:keep]) + ellipsis
+}
@@ -0,0 +1,42 @@
+package push_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"nebula-live/internal/pkg/push"
+)
+
+func TestLengthLimits_Apply(t *testing.T) {
+	tests := []struct {
+		name      string
+		limits    push.LengthLimits
+		title     string
+		body      string
+		wantTitle string
+		wantBody  string
+		wantErr   bool
+	}{
+		{"within limits", push.LengthLimits{MaxTitle: 5, MaxBody: 10}, "标题", "内容", "标题", "内容", false},
+		{"unlimited", push.LengthLimits{}, strings.Repeat("t", 500), strings.Repeat("b", 5000), strings.Repeat("t", 500), strings.Repeat("b", 5000), false},
+		{"truncate body", push.LengthLimits{MaxBody: 5}, "标题", "一二三四五六七", "标题", "一二三四…", false},
+		{"truncate both", push.LengthLimits{MaxTitle: 3, MaxBody: 4, Policy: push.LengthPolicyTruncate}, "abcdef", "123456", "ab…", "123…", false},
+		{"exact length kept", push.LengthLimits{MaxBody: 3}, "", "一二三", "", "一二三", false},
+		{"limit of one", push.LengthLimits{MaxBody: 1}, "", "abc", "", "a", false},
+		{"reject body", push.LengthLimits{MaxBody: 3, Policy: push.LengthPolicyReject}, "标题", "一二三四", "标题", "一二三四", true},
+		{"reject title", push.LengthLimits{MaxTitle: 1, Policy: push.LengthPolicyReject}, "标题", "内容", "标题", "内容", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &push.PushMessage{Title: tt.title, Body: tt.body}
+			err := tt.limits.Apply(message)
+			if tt.wantErr != errors.Is(err, push.ErrMessageTooLong) {
+				t.Fatalf("Apply() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if message.Title != tt.wantTitle || message.Body != tt.wantBody {
+				t.Errorf("Apply() = {title: %q, body: %q}, want {%q, %q}", message.Title, message.Body, tt.wantTitle, tt.wantBody)
+			}
+		})
+	}
+}
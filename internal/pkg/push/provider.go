@@ -17,4 +17,10 @@ type Provider interface {
 
 	// ValidateMessage validates if the message is compatible with this provider
 	ValidateMessage(message *PushMessage) error
+
+	// Capabilities returns the message fields and settings supported by the provider
+	Capabilities() Capabilities
+
+	// CheckHealth performs a lightweight reachability check against the provider upstream
+	CheckHealth(ctx context.Context) error
 }
@@ -2,6 +2,8 @@ package push
 
 import (
 	"errors"
+	"net/url"
+	"strings"
 )
 
 // PushLevel represents the notification level
@@ -14,7 +16,20 @@ const (
 	PushLevelPassive       PushLevel = "passive"
 )
 
-// PushMessage represents a push notification message
+// IsValid reports whether the level is one of the known notification levels
+func (l PushLevel) IsValid() bool {
+	switch l {
+	case PushLevelCritical, PushLevelActive, PushLevelTimeSensitive, PushLevelPassive:
+		return true
+	default:
+		return false
+	}
+}
+
+// PushMessage represents a push notification message shared by all providers.
+// Apart from Body and DeviceID, fields are optional and only honored by providers that
+// list them in their Capabilities; unsupported fields are no-ops and are stripped by
+// AdaptMessage before the message reaches the provider.
 type PushMessage struct {
 	Title    string            `json:"title,omitempty"`
 	Subtitle string            `json:"subtitle,omitempty"`
@@ -23,6 +38,7 @@ type PushMessage struct {
 	Badge    int               `json:"badge,omitempty"`
 	Sound    string            `json:"sound,omitempty"`
 	Icon     string            `json:"icon,omitempty"`
+	Image    string            `json:"image,omitempty"`
 	Group    string            `json:"group,omitempty"`
 	URL      string            `json:"url,omitempty"`
 	Level    PushLevel         `json:"level,omitempty"`
@@ -30,6 +46,8 @@ type PushMessage struct {
 	AutoCopy bool              `json:"auto_copy,omitempty"`
 	Copy     string            `json:"copy,omitempty"`
 	Extra    map[string]string `json:"extra,omitempty"`
+	// ServerURL overrides the provider server (e.g. a self-hosted Bark server) for this message only
+	ServerURL string `json:"server_url,omitempty"`
 }
 
 // PushResponse represents the response from a push provider
@@ -38,13 +56,86 @@ type PushResponse struct {
 	MessageID string `json:"message_id,omitempty"`
 	Error     string `json:"error,omitempty"`
 	Provider  string `json:"provider"`
+	// Cancelled marks a send that was skipped or aborted because the context was cancelled
+	Cancelled bool `json:"cancelled,omitempty"`
+	// TestMode marks a synthetic response of a message that was only logged, not sent
+	TestMode bool `json:"test_mode,omitempty"`
+	// Rejected marks a message refused before sending, e.g. over the provider's length limit,
+	// which is not a failure of the device
+	Rejected bool `json:"rejected,omitempty"`
+	// DeviceRejected marks a failure where the upstream refused the recipient itself, e.g. an
+	// unregistered Bark device key or an unknown mailbox. Only these failures count toward
+	// auto-disabling a device; network errors, timeouts, 429 and 5xx are outages of the upstream.
+	DeviceRejected bool `json:"device_rejected,omitempty"`
+	// StatusCode is the status returned by the upstream, the HTTP status code for Bark and the
+	// SMTP reply code of a rejected email, 0 when no response was received
+	StatusCode int `json:"status_code,omitempty"`
+	// RawResponse is the upstream response body, truncated to MaxRawResponseSize bytes.
+	// It is only captured when the provider is configured to, since bodies can be large.
+	RawResponse string `json:"raw_response,omitempty"`
+}
+
+// MaxRawResponseSize caps the length of PushResponse.RawResponse
+const MaxRawResponseSize = 4096
+
+// truncateRawResponse shortens a response body to MaxRawResponseSize bytes
+func truncateRawResponse(body string) string {
+	if len(body) <= MaxRawResponseSize {
+		return body
+	}
+	return body[:MaxRawResponseSize]
 }
 
 // Common errors for push notifications
 var (
-	ErrInvalidDeviceID    = errors.New("invalid device ID")
-	ErrEmptyMessage       = errors.New("message body cannot be empty")
-	ErrProviderNotFound   = errors.New("push provider not found")
-	ErrProviderNotEnabled = errors.New("push provider not enabled")
-	ErrSendFailed         = errors.New("failed to send push notification")
+	ErrInvalidDeviceID     = errors.New("invalid device ID")
+	ErrEmptyMessage        = errors.New("message body cannot be empty")
+	ErrProviderNotFound    = errors.New("push provider not found")
+	ErrProviderNotEnabled  = errors.New("push provider not enabled")
+	ErrSendFailed          = errors.New("failed to send push notification")
+	ErrInvalidImageURL     = errors.New("image must be an absolute https URL")
+	ErrURLSchemeNotAllowed = errors.New("url scheme is not allowed")
 )
+
+// DefaultAllowedURLSchemes is used when no URL scheme allowlist is configured
+var DefaultAllowedURLSchemes = []string{"https"}
+
+// ValidateImageURL checks that an image attached to a message is an absolute https URL,
+// devices fetch the image themselves and iOS refuses plain http
+func ValidateImageURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return ErrInvalidImageURL
+	}
+	return nil
+}
+
+// ValidateURLScheme checks that the link opened when a notification is tapped uses one of the
+// allowed schemes, so a message cannot deep link into arbitrary apps or run javascript: URLs.
+// Schemes are compared case-insensitively and an empty allowlist means DefaultAllowedURLSchemes.
+func ValidateURLScheme(raw string, allowed []string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" {
+		return ErrURLSchemeNotAllowed
+	}
+	if len(allowed) == 0 {
+		allowed = DefaultAllowedURLSchemes
+	}
+	for _, scheme := range allowed {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return nil
+		}
+	}
+	return ErrURLSchemeNotAllowed
+}
+
+// MaskDeviceID hides the middle of a device ID for responses and logs, Bark device keys and
+// FCM tokens are credentials. Only the first and last 4 characters are kept and IDs of up to
+// 8 characters are hidden completely.
+func MaskDeviceID(deviceID string) string {
+	runes := []rune(deviceID)
+	if len(runes) <= 8 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:4]) + "****" + string(runes[len(runes)-4:])
+}
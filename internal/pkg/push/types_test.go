@@ -0,0 +1,57 @@
+package push_test
+
+import (
+	"errors"
+	"testing"
+
+	"nebula-live/internal/pkg/push"
+)
+
+func TestValidateURLScheme(t *testing.T) {
+	appSchemes := []string{"https", "bilibili"}
+	tests := []struct {
+		url     string
+		allowed []string
+		wantErr bool
+	}{
+		{"https://live.bilibili.com/5440", nil, false},
+		{"HTTPS://live.bilibili.com/5440", nil, false},
+		{"http://live.bilibili.com/5440", nil, true},
+		{"javascript:alert(1)", nil, true},
+		{"JavaScript:alert(1)", appSchemes, true},
+		{"data:text/html,<script>alert(1)</script>", appSchemes, true},
+		{"bilibili://live/5440", nil, true},
+		{"bilibili://live/5440", appSchemes, false},
+		{"live.bilibili.com/5440", appSchemes, true}, // 没有scheme
+		{"://broken", appSchemes, true},
+	}
+	for _, tt := range tests {
+		err := push.ValidateURLScheme(tt.url, tt.allowed)
+		if tt.wantErr != errors.Is(err, push.ErrURLSchemeNotAllowed) {
+			t.Errorf("ValidateURLScheme(%q, %v) error = %v, wantErr %v", tt.url, tt.allowed, err, tt.wantErr)
+		}
+	}
+}
+
+func TestMaskDeviceID(t *testing.T) {
+	tests := []struct {
+		deviceID string
+		want     string
+	}{
+		{"", ""},
+		{"abc", "***"},
+		{"abcdefgh", "********"}, // 8个字符以内完全隐藏
+		{"abcdefghi", "abcd****fghi"},
+		{"xYz7AbCdEfGhIjKlMnOp1234", "xYz7****1234"},
+		{"设备密钥一二三四五六", "设备密钥****三四五六"},
+	}
+	for _, tt := range tests {
+		got := push.MaskDeviceID(tt.deviceID)
+		if got != tt.want {
+			t.Errorf("MaskDeviceID(%q) = %q, want %q", tt.deviceID, got, tt.want)
+		}
+		if len(tt.deviceID) > 0 && got == tt.deviceID {
+			t.Errorf("MaskDeviceID(%q) returned the device ID unmasked", tt.deviceID)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Package webhook delivers signed JSON event payloads to external HTTP endpoints
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"nebula-live/internal/pkg/httpproxy"
+
+	"resty.dev/v3"
+)
+
+// Request headers sent with every delivery
+const (
+	// HeaderEvent carries the event type, e.g. user.created
+	HeaderEvent = "X-Nebula-Event"
+	// HeaderDelivery carries the delivery ID, identical across retries of the same delivery
+	HeaderDelivery = "X-Nebula-Delivery"
+	// HeaderTimestamp carries the unix time in seconds at which the request was signed
+	HeaderTimestamp = "X-Nebula-Timestamp"
+	// HeaderSignature carries "sha256=" followed by the hex HMAC-SHA256 of "{timestamp}.{body}"
+	HeaderSignature = "X-Nebula-Signature"
+)
+
+const signaturePrefix = "sha256="
+
+// ErrInvalidSignature is returned by Verify when the signature does not match the payload
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Event is the JSON payload posted to endpoints
+type Event struct {
+	// ID identifies the event, receivers can use it to drop duplicates caused by retries
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// Sign returns the signature header value of body signed at timestamp
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a received signature in constant time, receivers should also reject old timestamps to prevent replays
+func Verify(secret string, timestamp int64, body []byte, signature string) error {
+	if !hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ClientConfig holds the HTTP options of the webhook client
+type ClientConfig struct {
+	// Timeout bounds a single delivery attempt
+	Timeout time.Duration
+	// Proxy routes outbound requests through an upstream proxy when set
+	Proxy httpproxy.Config
+}
+
+// Client posts signed payloads, retries are left to the caller so every attempt can be recorded
+type Client struct {
+	httpClient *resty.Client
+}
+
+// NewClient creates a webhook client
+func NewClient(config ClientConfig) *Client {
+	httpClient := resty.New()
+	if config.Timeout > 0 {
+		httpClient.SetTimeout(config.Timeout)
+	}
+
+	if err := httpproxy.Apply(httpClient, config.Proxy); err != nil {
+		httpClient.Logger().Errorf("failed to configure proxy: %v", err)
+	}
+
+	return &Client{httpClient: httpClient}
+}
+
+// Send posts body to url signed with secret and returns the response status code.
+// A non-2xx status is returned as an error together with the status code.
+func (c *Client) Send(ctx context.Context, url, secret, eventType, deliveryID string, body []byte) (int, error) {
+	timestamp := time.Now().Unix()
+
+	req := c.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader(HeaderEvent, eventType).
+		SetHeader(HeaderDelivery, deliveryID).
+		SetHeader(HeaderTimestamp, strconv.FormatInt(timestamp, 10)).
+		SetBody(body)
+	if secret != "" {
+		req.SetHeader(HeaderSignature, Sign(secret, timestamp, body))
+	}
+
+	resp, err := req.Post(url)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return resp.StatusCode(), fmt.Errorf("webhook endpoint returned status code: %d", resp.StatusCode())
+	}
+
+	return resp.StatusCode(), nil
+}
@@ -0,0 +1,38 @@
+package testutil
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock 可手动推进的时钟，实现clock.Clock，用于测试令牌过期和带期限的角色分配而无需等待
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock 创建停在指定时间的时钟
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now 返回时钟的当前时间
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance 将时钟向前推进d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set 将时钟设置为指定时间
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+}
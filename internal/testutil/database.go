@@ -0,0 +1,139 @@
+package testutil
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"nebula-live/ent"
+	"nebula-live/internal/domain/event"
+	"nebula-live/internal/domain/service"
+	"nebula-live/internal/infrastructure/persistence"
+	"nebula-live/internal/pkg/eventbus"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+)
+
+// NewEntClient 创建使用临时SQLite数据库的Ent客户端并完成迁移，测试结束时自动关闭
+//
+// 每个测试使用独立的数据库文件，测试之间互不影响。
+func NewEntClient(t testing.TB) *ent.Client {
+	t.Helper()
+
+	dsn := filepath.Join(t.TempDir(), "test.db") + "?_pragma=foreign_keys(1)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatalf("failed to open test database: %v", err)
+	}
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	return client
+}
+
+// NewRBACService 创建基于测试数据库的内置RBAC服务并初始化系统角色和权限
+func NewRBACService(t testing.TB, client *ent.Client) service.RBACService {
+	t.Helper()
+	return NewRBACServiceWithBus(t, client, NewEventBus(t))
+}
+
+// NewRBACServiceWithBus 与 NewRBACService 相同，权限变更事件发布到指定的事件总线
+func NewRBACServiceWithBus(t testing.TB, client *ent.Client, bus *eventbus.Bus) service.RBACService {
+	t.Helper()
+	InitLogger()
+
+	rbacService, err := service.NewRBACService(
+		persistence.NewRoleRepository(client),
+		persistence.NewPermissionRepository(client),
+		persistence.NewUserRoleRepository(client, nil),
+		persistence.NewRolePermissionRepository(client, nil),
+		bus,
+		service.RBACServiceConfig{Engine: service.RBACEngineBuiltin},
+	)
+	if err != nil {
+		t.Fatalf("failed to create rbac service: %v", err)
+	}
+
+	if err := rbacService.InitializeSystemData(context.Background()); err != nil {
+		t.Fatalf("failed to initialize rbac system data: %v", err)
+	}
+
+	return rbacService
+}
+
+// NewPermissionCache 创建权限缓存并订阅事件总线上的权限变更事件，与服务启动时的注册方式一致
+func NewPermissionCache(t testing.TB, rbacService service.RBACService, bus *eventbus.Bus, ttl time.Duration) *service.PermissionCache {
+	t.Helper()
+
+	cache := service.NewPermissionCache(rbacService, service.PermissionCacheConfig{TTL: ttl})
+	if err := bus.SubscribeSync("permission-cache", cache.HandleEvent, event.NamePermissionsChanged); err != nil {
+		t.Fatalf("failed to subscribe permission cache: %v", err)
+	}
+
+	return cache
+}
+
+// NewUserService 创建基于测试数据库的用户服务，新注册用户会被分配普通用户角色
+func NewUserService(t testing.TB, client *ent.Client, rbacService service.RBACService) service.UserService {
+	t.Helper()
+	InitLogger()
+
+	return service.NewUserService(
+		persistence.NewUserRepository(client),
+		rbacService,
+		NewEventBus(t),
+		service.UserServiceConfig{RequireRole: true},
+	)
+}
+
+// NewSessionService 创建基于测试数据库的会话服务，会话有效期为一天
+func NewSessionService(t testing.TB, client *ent.Client) service.SessionService {
+	t.Helper()
+	InitLogger()
+
+	return service.NewSessionService(
+		persistence.NewUserSessionRepository(client),
+		persistence.NewUserRepository(client),
+		service.SessionServiceConfig{TTL: 24 * time.Hour},
+	)
+}
+
+// NewEventBus 创建没有订阅者的领域事件总线，测试可自行订阅，测试结束时处理完已发布的事件
+func NewEventBus(t testing.TB) *eventbus.Bus {
+	t.Helper()
+	InitLogger()
+
+	bus := eventbus.New(eventbus.Config{})
+	t.Cleanup(func() {
+		bus.Close(context.Background())
+	})
+
+	return bus
+}
+
+// NewWebhookService 创建基于测试数据库的Webhook服务，测试结束时等待进行中的投递完成
+func NewWebhookService(t testing.TB, client *ent.Client, endpoints ...service.WebhookEndpoint) service.WebhookService {
+	t.Helper()
+	InitLogger()
+
+	webhookService := service.NewWebhookService(
+		persistence.NewWebhookDeliveryRepository(client),
+		service.WebhookServiceConfig{Endpoints: endpoints},
+	)
+	t.Cleanup(func() {
+		webhookService.Close(context.Background())
+	})
+
+	return webhookService
+}
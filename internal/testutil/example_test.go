@@ -0,0 +1,124 @@
+package testutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"nebula-live/internal/pkg/livestream"
+	"nebula-live/internal/pkg/push"
+	"nebula-live/internal/testutil"
+)
+
+func TestFakeLiveStreamProvider(t *testing.T) {
+	ctx := context.Background()
+
+	provider := testutil.NewFakeLiveStreamProvider("douyu")
+	provider.SetRoom(&livestream.RoomInfo{RoomID: "534740", Status: livestream.StreamStatusOnline, Title: "游戏室"})
+	provider.SetError("1", errors.New("upstream unavailable"))
+
+	client := livestream.NewClient(livestream.ClientConfig{})
+	client.RegisterProvider(provider)
+
+	// 别名同样解析到注册的提供商
+	status, err := client.GetStreamStatus(ctx, "斗鱼", "534740")
+	if err != nil {
+		t.Fatalf("GetStreamStatus() error = %v", err)
+	}
+	if status.Status != livestream.StreamStatusOnline {
+		t.Errorf("status = %q, want %q", status.Status, livestream.StreamStatusOnline)
+	}
+
+	info, err := client.GetRoomInfo(ctx, "douyu", "534740")
+	if err != nil {
+		t.Fatalf("GetRoomInfo() error = %v", err)
+	}
+	if info.Title != "游戏室" || info.Platform != "douyu" {
+		t.Errorf("room info = %+v", info)
+	}
+
+	if _, err := client.GetRoomInfo(ctx, "douyu", "404"); !errors.Is(err, livestream.ErrRoomNotFound) {
+		t.Errorf("unknown room error = %v, want ErrRoomNotFound", err)
+	}
+	if _, err := client.GetRoomInfo(ctx, "douyu", "1"); err == nil || err.Error() != "upstream unavailable" {
+		t.Errorf("programmed error = %v", err)
+	}
+
+	if got := provider.StatusCalls(); got != 1 {
+		t.Errorf("StatusCalls() = %d, want 1", got)
+	}
+	if got := provider.RoomCalls(); got != 3 {
+		t.Errorf("RoomCalls() = %d, want 3", got)
+	}
+}
+
+func TestFakePushProvider(t *testing.T) {
+	ctx := context.Background()
+
+	provider := testutil.NewFakePushProvider("bark")
+	client := push.NewClient(push.ClientConfig{})
+	client.RegisterProvider(provider)
+
+	message := &push.PushMessage{Title: "标题", Body: "内容", DeviceID: "device-key"}
+	response, err := client.SendMessage(ctx, "bark", message)
+	if err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+	if !response.Success || response.MessageID == "" {
+		t.Errorf("response = %+v, want success with a message ID", response)
+	}
+
+	sendErr := errors.New("device not registered")
+	provider.FailWith(sendErr)
+	response, err = client.SendMessage(ctx, "bark", message)
+	if !errors.Is(err, sendErr) {
+		t.Errorf("SendMessage() error = %v, want %v", err, sendErr)
+	}
+	if response == nil || response.Success {
+		t.Errorf("response = %+v, want failure", response)
+	}
+
+	if got := provider.Calls(); got != 2 {
+		t.Errorf("Calls() = %d, want 2", got)
+	}
+	if got := provider.Messages()[0].Title; got != "标题" {
+		t.Errorf("recorded title = %q", got)
+	}
+}
+
+func TestNewUserService(t *testing.T) {
+	ctx := context.Background()
+
+	client := testutil.NewEntClient(t)
+	rbacService := testutil.NewRBACService(t, client)
+	userService := testutil.NewUserService(t, client, rbacService)
+
+	user, err := userService.CreateUser(ctx, "alice", "alice@example.com", "Password123!", "Alice")
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	// 新注册用户被分配普通用户角色
+	isUser, err := rbacService.HasRole(ctx, user.ID, "user")
+	if err != nil {
+		t.Fatalf("HasRole() error = %v", err)
+	}
+	if !isUser {
+		t.Error("new user should have the user role")
+	}
+
+	if _, err := userService.ValidateUser(ctx, "alice", "Password123!"); err != nil {
+		t.Errorf("ValidateUser() error = %v", err)
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := testutil.NewFakeClock(start)
+
+	clock.Advance(time.Hour)
+	if got := clock.Now(); !got.Equal(start.Add(time.Hour)) {
+		t.Errorf("Now() = %v, want %v", got, start.Add(time.Hour))
+	}
+}
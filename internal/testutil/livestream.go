@@ -0,0 +1,112 @@
+// Package testutil 提供测试用的内存实现和辅助函数，避免测试访问真实的直播平台、推送服务和数据库
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"nebula-live/internal/pkg/livestream"
+)
+
+// FakeLiveStreamProvider 可编程的直播平台提供商，按房间号返回预设结果并统计调用次数
+type FakeLiveStreamProvider struct {
+	Platform string
+
+	mu          sync.Mutex
+	rooms       map[string]*livestream.RoomInfo
+	errs        map[string]error
+	statusCalls int
+	roomCalls   int
+}
+
+// NewFakeLiveStreamProvider 创建指定平台名的直播平台提供商
+func NewFakeLiveStreamProvider(platform string) *FakeLiveStreamProvider {
+	return &FakeLiveStreamProvider{
+		Platform: platform,
+		rooms:    make(map[string]*livestream.RoomInfo),
+		errs:     make(map[string]error),
+	}
+}
+
+// SetRoom 设置房间的返回信息，同时用于GetStreamStatus和GetRoomInfo
+func (p *FakeLiveStreamProvider) SetRoom(room *livestream.RoomInfo) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rooms[room.RoomID] = room
+	delete(p.errs, room.RoomID)
+}
+
+// SetError 设置查询房间时返回的错误
+func (p *FakeLiveStreamProvider) SetError(roomID string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.errs[roomID] = err
+}
+
+// StatusCalls 返回GetStreamStatus的调用次数
+func (p *FakeLiveStreamProvider) StatusCalls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.statusCalls
+}
+
+// RoomCalls 返回GetRoomInfo的调用次数
+func (p *FakeLiveStreamProvider) RoomCalls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.roomCalls
+}
+
+// GetPlatformName 返回平台名
+func (p *FakeLiveStreamProvider) GetPlatformName() string {
+	return p.Platform
+}
+
+// GetStreamStatus 返回预设房间的直播状态，未设置的房间返回ErrRoomNotFound
+func (p *FakeLiveStreamProvider) GetStreamStatus(ctx context.Context, roomID string) (*livestream.StreamInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.statusCalls++
+
+	room, err := p.lookup(roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &livestream.StreamInfo{
+		Platform: p.Platform,
+		RoomID:   roomID,
+		Status:   room.Status,
+	}, nil
+}
+
+// GetRoomInfo 返回预设房间信息的副本，未设置的房间返回ErrRoomNotFound
+func (p *FakeLiveStreamProvider) GetRoomInfo(ctx context.Context, roomID string) (*livestream.RoomInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.roomCalls++
+
+	room, err := p.lookup(roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	info := *room
+	info.Platform = p.Platform
+	return &info, nil
+}
+
+// lookup 查找房间的预设结果，调用方需持有锁
+func (p *FakeLiveStreamProvider) lookup(roomID string) (*livestream.RoomInfo, error) {
+	if roomID == "" {
+		return nil, livestream.ErrInvalidRoomID
+	}
+	if err, ok := p.errs[roomID]; ok {
+		return nil, err
+	}
+	room, ok := p.rooms[roomID]
+	if !ok {
+		return nil, livestream.ErrRoomNotFound
+	}
+	return room, nil
+}
@@ -0,0 +1,14 @@
+package testutil
+
+import (
+	"nebula-live/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// InitLogger 在全局logger未初始化时设置为空操作logger，服务层通过全局logger记录日志
+func InitLogger() {
+	if logger.Logger == nil {
+		logger.Initialize(zap.NewNop())
+	}
+}
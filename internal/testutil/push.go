@@ -0,0 +1,106 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"nebula-live/internal/pkg/push"
+)
+
+// FakePushProvider 可编程的推送提供商，记录收到的消息，不访问网络
+type FakePushProvider struct {
+	Name    string
+	Enabled bool
+
+	// SendFunc 自定义发送结果，为nil时总是发送成功
+	SendFunc func(ctx context.Context, message *push.PushMessage) (*push.PushResponse, error)
+	// HealthErr CheckHealth返回的错误
+	HealthErr error
+
+	mu       sync.Mutex
+	messages []push.PushMessage
+}
+
+// NewFakePushProvider 创建已启用的推送提供商
+func NewFakePushProvider(name string) *FakePushProvider {
+	return &FakePushProvider{
+		Name:    name,
+		Enabled: true,
+	}
+}
+
+// FailWith 使之后的发送都返回指定错误
+func (p *FakePushProvider) FailWith(err error) {
+	p.SendFunc = func(ctx context.Context, message *push.PushMessage) (*push.PushResponse, error) {
+		return &push.PushResponse{Success: false, Error: err.Error(), Provider: p.Name}, err
+	}
+}
+
+// Calls 返回SendMessage的调用次数
+func (p *FakePushProvider) Calls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.messages)
+}
+
+// Messages 返回收到的消息副本
+func (p *FakePushProvider) Messages() []push.PushMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]push.PushMessage(nil), p.messages...)
+}
+
+// SendMessage 记录消息并返回SendFunc的结果
+func (p *FakePushProvider) SendMessage(ctx context.Context, message *push.PushMessage) (*push.PushResponse, error) {
+	p.mu.Lock()
+	p.messages = append(p.messages, *message)
+	count := len(p.messages)
+	p.mu.Unlock()
+
+	if p.SendFunc != nil {
+		return p.SendFunc(ctx, message)
+	}
+
+	return &push.PushResponse{
+		Success:   true,
+		MessageID: fmt.Sprintf("%s-%d", p.Name, count),
+		Provider:  p.Name,
+	}, nil
+}
+
+// GetProviderName 返回提供商名称
+func (p *FakePushProvider) GetProviderName() string {
+	return p.Name
+}
+
+// IsEnabled 返回是否启用
+func (p *FakePushProvider) IsEnabled() bool {
+	return p.Enabled
+}
+
+// ValidateMessage 只校验消息内容和设备ID不为空
+func (p *FakePushProvider) ValidateMessage(message *push.PushMessage) error {
+	if message.Body == "" {
+		return push.ErrEmptyMessage
+	}
+	if message.DeviceID == "" {
+		return push.ErrInvalidDeviceID
+	}
+	return nil
+}
+
+// Capabilities 声明支持标题和内容字段
+func (p *FakePushProvider) Capabilities() push.Capabilities {
+	return push.Capabilities{
+		Name:        p.Name,
+		DisplayName: p.Name,
+		Description: "Fake push provider for tests",
+		Fields:      []string{push.FieldTitle, push.FieldBody},
+	}
+}
+
+// CheckHealth 返回HealthErr
+func (p *FakePushProvider) CheckHealth(ctx context.Context) error {
+	return p.HealthErr
+}
@@ -0,0 +1,22 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// NewRedis 启动内存中的Redis服务器并返回连接它的客户端，测试结束时自动关闭
+// 返回的服务器可用FastForward推进键的过期时间
+func NewRedis(t testing.TB) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	return server, client
+}
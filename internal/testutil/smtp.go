@@ -0,0 +1,145 @@
+package testutil
+
+import (
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// SMTPMessage 假SMTP服务器收到的一封邮件
+type SMTPMessage struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+// FakeSMTPServer 只支持明文的最小SMTP服务器，记录收到的邮件，测试结束时自动关闭
+type FakeSMTPServer struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	messages []SMTPMessage
+	rejects  map[string]int
+}
+
+// NewFakeSMTPServer 在本地随机端口启动SMTP服务器
+func NewFakeSMTPServer(t *testing.T) *FakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start fake smtp server: %v", err)
+	}
+
+	s := &FakeSMTPServer{
+		listener: listener,
+		rejects:  make(map[string]int),
+	}
+	go s.serve()
+	t.Cleanup(func() { listener.Close() })
+	return s
+}
+
+// Host 返回服务器监听的主机
+func (s *FakeSMTPServer) Host() string {
+	return s.listener.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port 返回服务器监听的端口
+func (s *FakeSMTPServer) Port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+// RejectRecipient 使发往address的RCPT TO返回指定的SMTP错误码
+func (s *FakeSMTPServer) RejectRecipient(address string, code int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejects[address] = code
+}
+
+// Messages 返回收到的邮件副本
+func (s *FakeSMTPServer) Messages() []SMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SMTPMessage(nil), s.messages...)
+}
+
+func (s *FakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+// handle 处理一个连接上的SMTP会话
+func (s *FakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	reply := func(code int, msg string) {
+		_ = text.PrintfLine("%d %s", code, msg)
+	}
+
+	reply(220, "fake smtp ready")
+
+	var current SMTPMessage
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply(250, "fake smtp")
+		case "MAIL":
+			current = SMTPMessage{From: trimSMTPPath(arg, "FROM:")}
+			reply(250, "OK")
+		case "RCPT":
+			to := trimSMTPPath(arg, "TO:")
+			s.mu.Lock()
+			code, rejected := s.rejects[to]
+			s.mu.Unlock()
+			if rejected {
+				reply(code, "mailbox unavailable")
+				continue
+			}
+			current.To = append(current.To, to)
+			reply(250, "OK")
+		case "DATA":
+			reply(354, "end data with <CR><LF>.<CR><LF>")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			current.Data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, current)
+			s.mu.Unlock()
+			reply(250, "OK: queued")
+		case "RSET", "NOOP":
+			reply(250, "OK")
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, fmt.Sprintf("command %s not implemented", verb))
+		}
+	}
+}
+
+// trimSMTPPath 从 "FROM:<a@b>" 形式的参数中取出地址
+func trimSMTPPath(arg, prefix string) string {
+	arg = strings.TrimSpace(arg)
+	if len(arg) >= len(prefix) && strings.EqualFold(arg[:len(prefix)], prefix) {
+		arg = arg[len(prefix):]
+	}
+	addr, _, _ := strings.Cut(strings.TrimSpace(arg), " ")
+	return strings.Trim(addr, "<>")
+}
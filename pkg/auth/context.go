@@ -9,6 +9,12 @@ const (
 	AuthContextKey = "auth_user"
 	// UserIDContextKey 用户ID上下文键
 	UserIDContextKey = "user_id"
+	// ResourceOwnerIDContextKey 资源所有者ID上下文键
+	ResourceOwnerIDContextKey = "resource_owner_id"
+	// ImpersonatorIDContextKey 模拟登录的管理员ID上下文键，仅模拟会话设置
+	ImpersonatorIDContextKey = "impersonator_id"
+	// ImpersonatedByHeader 模拟会话的响应头，值为发起模拟的管理员ID
+	ImpersonatedByHeader = "X-Impersonated-By"
 )
 
 // GetCurrentUser 从上下文中获取当前用户信息
@@ -33,6 +39,42 @@ func GetCurrentUserID(c *fiber.Ctx) (uint, bool) {
 	return id, ok
 }
 
+// GetResourceOwnerID 从上下文中获取资源所有者ID（由所有权校验中间件设置）
+func GetResourceOwnerID(c *fiber.Ctx) (uint, bool) {
+	ownerID := c.Locals(ResourceOwnerIDContextKey)
+	if ownerID == nil {
+		return 0, false
+	}
+
+	id, ok := ownerID.(uint)
+	return id, ok
+}
+
+// GetTargetUserID 获取请求操作的目标用户ID：已解析资源所有者时返回所有者ID，否则返回当前用户ID
+func GetTargetUserID(c *fiber.Ctx) (uint, bool) {
+	if ownerID, ok := GetResourceOwnerID(c); ok {
+		return ownerID, true
+	}
+	return GetCurrentUserID(c)
+}
+
+// GetImpersonatorID 获取模拟当前会话的管理员ID，非模拟会话返回false
+func GetImpersonatorID(c *fiber.Ctx) (uint, bool) {
+	impersonatorID := c.Locals(ImpersonatorIDContextKey)
+	if impersonatorID == nil {
+		return 0, false
+	}
+
+	id, ok := impersonatorID.(uint)
+	return id, ok
+}
+
+// IsImpersonated 检查当前会话是否为管理员模拟登录
+func IsImpersonated(c *fiber.Ctx) bool {
+	_, ok := GetImpersonatorID(c)
+	return ok
+}
+
 // MustGetCurrentUser 从上下文中获取当前用户信息（必须存在，否则panic）
 func MustGetCurrentUser(c *fiber.Ctx) *UserClaims {
 	user, exists := GetCurrentUser(c)
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"nebula-live/pkg/clock"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
@@ -12,22 +14,40 @@ var (
 	ErrInvalidToken = errors.New("invalid token")
 	ErrExpiredToken = errors.New("token has expired")
 	ErrTokenClaims  = errors.New("invalid token claims")
+	// ErrWrongTokenType 令牌的类型与用途不符，例如把刷新令牌当作访问令牌使用
+	ErrWrongTokenType = errors.New("wrong token type")
+)
+
+// 令牌类型，写入typ声明，访问令牌和刷新令牌不能互相替代
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
 )
 
 // TokenConfig JWT配置
 type TokenConfig struct {
-	SecretKey       string
-	AccessTokenTTL  time.Duration
-	RefreshTokenTTL time.Duration
-	Issuer          string
+	SecretKey string
+	// PreviousSecretKeys 轮换前使用过的密钥，只用于验证，使旧令牌在过期前仍然有效；新令牌始终用SecretKey签名
+	PreviousSecretKeys []string
+	AccessTokenTTL     time.Duration
+	RefreshTokenTTL    time.Duration
+	// Issuer 签发者，非空时签发的令牌带有iss且验证时要求iss一致
+	Issuer string
+	// Audience 受众，非空时签发的令牌带有aud且验证时要求aud包含该值
+	Audience string
+	// ImpersonationTTL 模拟登录令牌的有效期，为0时使用AccessTokenTTL
+	ImpersonationTTL time.Duration
+	// Clock 签发和验证令牌使用的时间来源，为nil时使用系统时间
+	Clock clock.Clock
 }
 
 // DefaultTokenConfig 默认JWT配置
 var DefaultTokenConfig = &TokenConfig{
-	SecretKey:       "your-secret-key-change-this-in-production",
-	AccessTokenTTL:  15 * time.Minute,
-	RefreshTokenTTL: 7 * 24 * time.Hour, // 7 days
-	Issuer:          "nebula-live",
+	SecretKey:        "your-secret-key-change-this-in-production",
+	AccessTokenTTL:   15 * time.Minute,
+	RefreshTokenTTL:  7 * 24 * time.Hour, // 7 days
+	Issuer:           "nebula-live",
+	ImpersonationTTL: 10 * time.Minute,
 }
 
 // UserClaims 用户JWT声明
@@ -35,9 +55,20 @@ type UserClaims struct {
 	UserID   uint   `json:"user_id"`
 	Username string `json:"username"`
 	Email    string `json:"email"`
+	// SessionID 登录会话ID，刷新令牌的jti为会话当前的刷新令牌ID
+	SessionID uint `json:"sid,omitempty"`
+	// ImpersonatedBy 模拟登录时为发起模拟的管理员ID，普通登录为0
+	ImpersonatedBy uint `json:"impersonated_by,omitempty"`
+	// TokenType 令牌类型，TokenTypeAccess 或 TokenTypeRefresh
+	TokenType string `json:"typ"`
 	jwt.RegisteredClaims
 }
 
+// IsImpersonated 检查令牌是否为管理员模拟登录签发
+func (c *UserClaims) IsImpersonated() bool {
+	return c.ImpersonatedBy != 0
+}
+
 // TokenPair 令牌对
 type TokenPair struct {
 	AccessToken  string `json:"access_token"`
@@ -49,6 +80,7 @@ type TokenPair struct {
 // JWTManager JWT管理器
 type JWTManager struct {
 	config *TokenConfig
+	clock  clock.Clock
 }
 
 // NewJWTManager 创建JWT管理器
@@ -56,21 +88,24 @@ func NewJWTManager(config *TokenConfig) *JWTManager {
 	if config == nil {
 		config = DefaultTokenConfig
 	}
-	return &JWTManager{config: config}
+	return &JWTManager{
+		config: config,
+		clock:  clock.OrReal(config.Clock),
+	}
 }
 
-// GenerateTokenPair 生成访问令牌和刷新令牌对
-func (j *JWTManager) GenerateTokenPair(userID uint, username, email string) (*TokenPair, error) {
-	now := time.Now()
+// GenerateSessionTokenPair 为登录会话生成令牌对，两个令牌都携带会话ID，刷新令牌的jti为refreshTokenID
+func (j *JWTManager) GenerateSessionTokenPair(userID uint, username, email string, sessionID uint, refreshTokenID string) (*TokenPair, error) {
+	now := j.clock.Now()
 
 	// 生成访问令牌
-	accessToken, err := j.generateToken(userID, username, email, now.Add(j.config.AccessTokenTTL))
+	accessToken, err := j.generateToken(TokenTypeAccess, userID, username, email, sessionID, "", 0, now.Add(j.config.AccessTokenTTL))
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	// 生成刷新令牌
-	refreshToken, err := j.generateToken(userID, username, email, now.Add(j.config.RefreshTokenTTL))
+	refreshToken, err := j.generateToken(TokenTypeRefresh, userID, username, email, sessionID, refreshTokenID, 0, now.Add(j.config.RefreshTokenTTL))
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -83,33 +118,67 @@ func (j *JWTManager) GenerateTokenPair(userID uint, username, email string) (*To
 	}, nil
 }
 
+// GenerateImpersonationToken 为管理员模拟登录生成目标用户的访问令牌，返回令牌和过期时间
+//
+// 令牌携带impersonated_by声明且不属于任何会话，因此没有刷新令牌，过期后需要重新发起模拟。
+func (j *JWTManager) GenerateImpersonationToken(userID uint, username, email string, impersonatorID uint) (string, time.Time, error) {
+	ttl := j.config.ImpersonationTTL
+	if ttl <= 0 {
+		ttl = j.config.AccessTokenTTL
+	}
+	expiresAt := j.clock.Now().Add(ttl)
+
+	token, err := j.generateToken(TokenTypeAccess, userID, username, email, 0, "", impersonatorID, expiresAt)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate impersonation token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
 // generateToken 生成JWT令牌
-func (j *JWTManager) generateToken(userID uint, username, email string, expiresAt time.Time) (string, error) {
+func (j *JWTManager) generateToken(tokenType string, userID uint, username, email string, sessionID uint, tokenID string, impersonatedBy uint, expiresAt time.Time) (string, error) {
+	now := j.clock.Now()
 	claims := UserClaims{
-		UserID:   userID,
-		Username: username,
-		Email:    email,
+		UserID:         userID,
+		Username:       username,
+		Email:          email,
+		SessionID:      sessionID,
+		ImpersonatedBy: impersonatedBy,
+		TokenType:      tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    j.config.Issuer,
 			Subject:   fmt.Sprintf("user_%d", userID),
 		},
 	}
+	if j.config.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{j.config.Audience}
+	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(j.config.SecretKey))
 }
 
-// ValidateToken 验证JWT令牌
+// ValidateToken 验证JWT令牌，签名可以来自当前密钥或任一旧密钥，配置了签发者或受众时拒绝其他签发者或受众的令牌
 func (j *JWTManager) ValidateToken(tokenString string) (*UserClaims, error) {
+	// 过期和生效时间按管理器的时钟检查
+	options := []jwt.ParserOption{jwt.WithTimeFunc(j.clock.Now)}
+	if j.config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(j.config.Issuer))
+	}
+	if j.config.Audience != "" {
+		options = append(options, jwt.WithAudience(j.config.Audience))
+	}
+
 	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(j.config.SecretKey), nil
-	})
+		return j.verificationKeys(), nil
+	}, options...)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -126,20 +195,47 @@ func (j *JWTManager) ValidateToken(tokenString string) (*UserClaims, error) {
 	return claims, nil
 }
 
-// RefreshToken 刷新访问令牌
-func (j *JWTManager) RefreshToken(refreshTokenString string) (*TokenPair, error) {
-	claims, err := j.ValidateToken(refreshTokenString)
+// verificationKeys 返回验证签名可用的密钥，配置了旧密钥时依次尝试当前密钥和旧密钥
+func (j *JWTManager) verificationKeys() interface{} {
+	if len(j.config.PreviousSecretKeys) == 0 {
+		return []byte(j.config.SecretKey)
+	}
+
+	keys := make([]jwt.VerificationKey, 0, len(j.config.PreviousSecretKeys)+1)
+	keys = append(keys, []byte(j.config.SecretKey))
+	for _, secret := range j.config.PreviousSecretKeys {
+		keys = append(keys, []byte(secret))
+	}
+	return jwt.VerificationKeySet{Keys: keys}
+}
+
+// ValidateAccessToken 验证用于访问接口的令牌，刷新令牌和未标明类型的令牌返回 ErrWrongTokenType
+func (j *JWTManager) ValidateAccessToken(tokenString string) (*UserClaims, error) {
+	claims, err := j.ValidateToken(tokenString)
 	if err != nil {
-		return nil, fmt.Errorf("invalid refresh token: %w", err)
+		return nil, err
 	}
+	if claims.TokenType != TokenTypeAccess {
+		return nil, ErrWrongTokenType
+	}
+	return claims, nil
+}
 
-	// 生成新的令牌对
-	return j.GenerateTokenPair(claims.UserID, claims.Username, claims.Email)
+// ValidateRefreshToken 验证刷新令牌，访问令牌（包括模拟登录令牌）和不属于会话的令牌返回 ErrWrongTokenType
+func (j *JWTManager) ValidateRefreshToken(tokenString string) (*UserClaims, error) {
+	claims, err := j.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != TokenTypeRefresh || claims.IsImpersonated() || claims.SessionID == 0 || claims.ID == "" {
+		return nil, ErrWrongTokenType
+	}
+	return claims, nil
 }
 
 // ExtractUserID 从令牌中提取用户ID
 func (j *JWTManager) ExtractUserID(tokenString string) (uint, error) {
-	claims, err := j.ValidateToken(tokenString)
+	claims, err := j.ValidateAccessToken(tokenString)
 	if err != nil {
 		return 0, err
 	}
@@ -0,0 +1,193 @@
+package auth_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"nebula-live/internal/testutil"
+	"nebula-live/pkg/auth"
+)
+
+// newTestManager 创建使用固定密钥的JWT管理器
+func newTestManager(t *testing.T) *auth.JWTManager {
+	t.Helper()
+	return auth.NewJWTManager(&auth.TokenConfig{
+		SecretKey:       "test-secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+		Issuer:          "nebula-live",
+	})
+}
+
+func TestJWTManager_TokenTypesAreNotInterchangeable(t *testing.T) {
+	manager := newTestManager(t)
+
+	pair, err := manager.GenerateSessionTokenPair(1, "alice", "alice@example.com", 7, "refresh-id")
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+	}
+
+	access, err := manager.ValidateAccessToken(pair.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateAccessToken(access) error = %v", err)
+	}
+	if access.TokenType != auth.TokenTypeAccess || access.SessionID != 7 {
+		t.Errorf("access claims typ = %q, sid = %d; want %q, 7", access.TokenType, access.SessionID, auth.TokenTypeAccess)
+	}
+	refresh, err := manager.ValidateRefreshToken(pair.RefreshToken)
+	if err != nil {
+		t.Fatalf("ValidateRefreshToken(refresh) error = %v", err)
+	}
+	if refresh.TokenType != auth.TokenTypeRefresh || refresh.ID != "refresh-id" {
+		t.Errorf("refresh claims typ = %q, jti = %q; want %q, refresh-id", refresh.TokenType, refresh.ID, auth.TokenTypeRefresh)
+	}
+
+	// 刷新令牌不能访问接口
+	if _, err := manager.ValidateAccessToken(pair.RefreshToken); !errors.Is(err, auth.ErrWrongTokenType) {
+		t.Errorf("ValidateAccessToken(refresh) error = %v, want ErrWrongTokenType", err)
+	}
+	if _, err := manager.ExtractUserID(pair.RefreshToken); !errors.Is(err, auth.ErrWrongTokenType) {
+		t.Errorf("ExtractUserID(refresh) error = %v, want ErrWrongTokenType", err)
+	}
+
+	// 访问令牌和模拟登录令牌不能刷新
+	if _, err := manager.ValidateRefreshToken(pair.AccessToken); !errors.Is(err, auth.ErrWrongTokenType) {
+		t.Errorf("ValidateRefreshToken(access) error = %v, want ErrWrongTokenType", err)
+	}
+	impersonation, _, err := manager.GenerateImpersonationToken(2, "bob", "bob@example.com", 1)
+	if err != nil {
+		t.Fatalf("GenerateImpersonationToken() error = %v", err)
+	}
+	if _, err := manager.ValidateRefreshToken(impersonation); !errors.Is(err, auth.ErrWrongTokenType) {
+		t.Errorf("ValidateRefreshToken(impersonation) error = %v, want ErrWrongTokenType", err)
+	}
+	if _, err := manager.ValidateAccessToken(impersonation); err != nil {
+		t.Errorf("ValidateAccessToken(impersonation) error = %v", err)
+	}
+}
+
+func TestJWTManager_TokenExpiresByClock(t *testing.T) {
+	clk := testutil.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	manager := auth.NewJWTManager(&auth.TokenConfig{
+		SecretKey:       "test-secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+		Clock:           clk,
+	})
+
+	pair, err := manager.GenerateSessionTokenPair(1, "alice", "alice@example.com", 7, "refresh-id")
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+	}
+	if want := clk.Now().Add(15 * time.Minute).Unix(); pair.ExpiresAt != want {
+		t.Errorf("ExpiresAt = %d, want %d", pair.ExpiresAt, want)
+	}
+
+	clk.Advance(15*time.Minute - time.Second)
+	if _, err := manager.ValidateAccessToken(pair.AccessToken); err != nil {
+		t.Fatalf("ValidateAccessToken() before expiry error = %v", err)
+	}
+
+	clk.Advance(time.Second)
+	if _, err := manager.ValidateAccessToken(pair.AccessToken); !errors.Is(err, auth.ErrExpiredToken) {
+		t.Errorf("ValidateAccessToken() after expiry error = %v, want ErrExpiredToken", err)
+	}
+	// 刷新令牌的有效期更长
+	if _, err := manager.ValidateRefreshToken(pair.RefreshToken); err != nil {
+		t.Errorf("ValidateRefreshToken() error = %v", err)
+	}
+	clk.Advance(24 * time.Hour)
+	if _, err := manager.ValidateRefreshToken(pair.RefreshToken); !errors.Is(err, auth.ErrExpiredToken) {
+		t.Errorf("ValidateRefreshToken() after expiry error = %v, want ErrExpiredToken", err)
+	}
+}
+
+func TestJWTManager_SecretRotation(t *testing.T) {
+	config := auth.TokenConfig{
+		SecretKey:       "old-secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+	}
+	before := auth.NewJWTManager(&config)
+	oldPair, err := before.GenerateSessionTokenPair(1, "alice", "alice@example.com", 7, "refresh-id")
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+	}
+
+	// 新密钥生效，旧密钥移到PreviousSecretKeys
+	rotated := config
+	rotated.SecretKey = "new-secret"
+	rotated.PreviousSecretKeys = []string{"old-secret"}
+	during := auth.NewJWTManager(&rotated)
+
+	if _, err := during.ValidateAccessToken(oldPair.AccessToken); err != nil {
+		t.Errorf("ValidateAccessToken(old token) error = %v, want accepted during rotation", err)
+	}
+
+	newPair, err := during.GenerateSessionTokenPair(1, "alice", "alice@example.com", 7, "refresh-id-2")
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+	}
+	// 新令牌只用当前密钥签名，不认识旧密钥的管理器也能验证
+	onlyNew := rotated
+	onlyNew.PreviousSecretKeys = nil
+	if _, err := auth.NewJWTManager(&onlyNew).ValidateAccessToken(newPair.AccessToken); err != nil {
+		t.Errorf("ValidateAccessToken(new token) with the current key only error = %v", err)
+	}
+	if _, err := before.ValidateAccessToken(newPair.AccessToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("ValidateAccessToken(new token) with the old key error = %v, want ErrInvalidToken", err)
+	}
+
+	// 旧密钥移除后，用它签名的令牌失效
+	after := auth.NewJWTManager(&onlyNew)
+	if _, err := after.ValidateAccessToken(oldPair.AccessToken); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Errorf("ValidateAccessToken(old token) after removal error = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestJWTManager_RejectsOtherIssuerAndAudience(t *testing.T) {
+	config := auth.TokenConfig{
+		SecretKey:       "shared-secret",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+		Issuer:          "nebula-live",
+		Audience:        "nebula-live-api",
+	}
+	manager := auth.NewJWTManager(&config)
+
+	pair, err := manager.GenerateSessionTokenPair(1, "alice", "alice@example.com", 7, "refresh-id")
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+	}
+	claims, err := manager.ValidateAccessToken(pair.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateAccessToken() error = %v", err)
+	}
+	if claims.Issuer != "nebula-live" || len(claims.Audience) != 1 || claims.Audience[0] != "nebula-live-api" {
+		t.Errorf("claims iss = %q, aud = %v; want nebula-live, [nebula-live-api]", claims.Issuer, claims.Audience)
+	}
+
+	// 共用密钥的其他服务签发的令牌
+	tests := map[string]func(c *auth.TokenConfig){
+		"wrong audience": func(c *auth.TokenConfig) { c.Audience = "admin-api" },
+		"wrong issuer":   func(c *auth.TokenConfig) { c.Issuer = "other-service" },
+		"no audience":    func(c *auth.TokenConfig) { c.Audience = "" },
+	}
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			other := config
+			modify(&other)
+			foreign, err := auth.NewJWTManager(&other).GenerateSessionTokenPair(1, "alice", "alice@example.com", 7, "refresh-id")
+			if err != nil {
+				t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+			}
+			if _, err := manager.ValidateAccessToken(foreign.AccessToken); !errors.Is(err, auth.ErrInvalidToken) {
+				t.Errorf("ValidateAccessToken() error = %v, want ErrInvalidToken", err)
+			}
+			if _, err := manager.ValidateRefreshToken(foreign.RefreshToken); !errors.Is(err, auth.ErrInvalidToken) {
+				t.Errorf("ValidateRefreshToken() error = %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+}
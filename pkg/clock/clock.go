@@ -0,0 +1,27 @@
+// Package clock 提供可替换的时间来源，令牌签发、过期检查和时间戳通过它获取当前时间，测试中可使用可控时钟
+package clock
+
+import "time"
+
+// Clock 时间来源
+type Clock interface {
+	// Now 返回当前时间
+	Now() time.Time
+}
+
+// Real 使用系统时间的时钟
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// OrReal 返回c，c为nil时返回系统时钟，供配置中的可选时钟使用
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real
+	}
+	return c
+}
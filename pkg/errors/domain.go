@@ -0,0 +1,100 @@
+package errors
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrorKind 领域错误的类别，处理器据此决定HTTP状态码
+type ErrorKind int
+
+const (
+	// KindInternal 未预期的错误（如数据库错误），不向客户端暴露错误消息
+	KindInternal ErrorKind = iota
+	// KindInvalid 请求的参数或状态变更不合法
+	KindInvalid
+	// KindNotFound 请求的资源不存在
+	KindNotFound
+	// KindConflict 资源已存在或与当前状态冲突
+	KindConflict
+	// KindForbidden 操作不被允许
+	KindForbidden
+)
+
+// String 返回类别名称
+func (k ErrorKind) String() string {
+	switch k {
+	case KindInvalid:
+		return "invalid"
+	case KindNotFound:
+		return "not_found"
+	case KindConflict:
+		return "conflict"
+	case KindForbidden:
+		return "forbidden"
+	default:
+		return "internal"
+	}
+}
+
+// HTTPStatus 返回类别对应的HTTP状态码
+func (k ErrorKind) HTTPStatus() int {
+	switch k {
+	case KindInvalid:
+		return http.StatusBadRequest
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict:
+		return http.StatusConflict
+	case KindForbidden:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// DomainError 带类别的领域错误，服务层的哨兵错误使用它定义，errors.Is比较仍然有效
+type DomainError struct {
+	Kind    ErrorKind
+	Message string
+	// Err 被包装的底层错误，可为空
+	Err error
+}
+
+// NewDomainError 创建领域错误
+func NewDomainError(kind ErrorKind, message string) *DomainError {
+	return &DomainError{Kind: kind, Message: message}
+}
+
+// WrapDomainError 以指定类别包装错误，err为nil时返回nil
+func WrapDomainError(kind ErrorKind, message string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &DomainError{Kind: kind, Message: message, Err: err}
+}
+
+// Error 返回错误消息
+func (e *DomainError) Error() string {
+	if e.Err == nil {
+		return e.Message
+	}
+	if e.Message == "" {
+		return e.Err.Error()
+	}
+	return e.Message + ": " + e.Err.Error()
+}
+
+// Unwrap 返回被包装的底层错误
+func (e *DomainError) Unwrap() error {
+	return e.Err
+}
+
+// KindOf 返回错误链中第一个领域错误的类别，不是领域错误时为KindInternal
+func KindOf(err error) ErrorKind {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr.Kind
+	}
+	return KindInternal
+}
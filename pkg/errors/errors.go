@@ -14,9 +14,10 @@ var (
 
 // API错误响应
 type APIError struct {
-	Code    int    `json:"code"`
-	Error   string `json:"error"`
-	Message string `json:"message"`
+	Code    int                    `json:"code"`
+	Error   string                 `json:"error"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
 }
 
 // NewAPIError 创建API错误
@@ -27,3 +28,9 @@ func NewAPIError(code int, error, message string) *APIError {
 		Message: message,
 	}
 }
+
+// WithDetails 附加错误详情
+func (e *APIError) WithDetails(details map[string]interface{}) *APIError {
+	e.Details = details
+	return e
+}
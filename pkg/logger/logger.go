@@ -9,9 +9,9 @@ var (
 	Logger *zap.Logger
 )
 
-// Initialize 初始化全局logger
+// Initialize 初始化全局logger，跳过本包的包装函数以记录实际调用位置
 func Initialize(logger *zap.Logger) {
-	Logger = logger
+	Logger = logger.WithOptions(zap.AddCallerSkip(1))
 }
 
 // Info 信息级别日志
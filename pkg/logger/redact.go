@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// redactedMask 脱敏后的占位字符
+	redactedMask = "***"
+	// redactedPrefixLen 脱敏时保留的前缀长度
+	redactedPrefixLen = 4
+	// redactedMinLen 保留前缀所需的最小长度，更短的值完全隐藏
+	redactedMinLen = 12
+)
+
+// sensitiveKeys 已知的敏感字段名（小写）
+var sensitiveKeys = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"authorization",
+	"cookie",
+	"api_key",
+	"apikey",
+	"private_key",
+	"dsn",
+}
+
+// dsnPasswordPattern 匹配key=value形式连接字符串中的密码
+var dsnPasswordPattern = regexp.MustCompile(`(?i)(password\s*=\s*)('[^']*'|\S+)`)
+
+// Redact 脱敏字符串，仅保留少量前缀用于排查问题
+func Redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	if len(value) < redactedMinLen {
+		return redactedMask
+	}
+	return value[:redactedPrefixLen] + redactedMask
+}
+
+// RedactedString 创建脱敏后的字符串日志字段
+func RedactedString(key, value string) zap.Field {
+	return zap.String(key, Redact(value))
+}
+
+// IsSensitiveKey 判断字段名是否属于敏感字段
+func IsSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(lower, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactDSN 隐藏数据库连接字符串中的密码，支持URL和key=value两种格式
+func RedactDSN(dsn string) string {
+	if strings.Contains(dsn, "://") {
+		if u, err := url.Parse(dsn); err == nil {
+			return u.Redacted()
+		}
+	}
+	return dsnPasswordPattern.ReplaceAllString(dsn, "${1}"+redactedMask)
+}
@@ -70,6 +70,16 @@ func VerifyPassword(password, encodedHash string) (bool, error) {
 	return subtle.ConstantTimeCompare(hash, otherHash) == 1, nil
 }
 
+// GeneratePassword 生成随机密码，n为随机字节数，结果为URL安全的base64字符串
+func GeneratePassword(n uint32) (string, error) {
+	b, err := generateRandomBytes(n)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 // generateRandomBytes 生成指定长度的随机字节
 func generateRandomBytes(n uint32) ([]byte, error) {
 	b := make([]byte, n)